@@ -17,6 +17,7 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
 	"github.com/cloudreve/Cloudreve/v4/pkg/crontab"
 	"github.com/cloudreve/Cloudreve/v4/pkg/email"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver/googledrive"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver/onedrive"
 	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
 	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
@@ -48,6 +49,7 @@ type server struct {
 	config      conf.ConfigProvider
 	server      *http.Server
 	pprofServer *http.Server
+	metrics     *http.Server
 	kv          cache.Driver
 	mailQueue   email.Driver
 }
@@ -94,6 +96,15 @@ func (s *server) Start() error {
 		if err := s.dep.CredManager().Upsert(context.Background(), credentials...); err != nil {
 			return fmt.Errorf("failed to upsert OneDrive credentials to CredManager: %w", err)
 		}
+
+		// Initialize Google Drive credentials
+		credentials, err = googledrive.RetrieveGoogleDriveCredentials(context.Background(), s.dep.StoragePolicyClient())
+		if err != nil {
+			return fmt.Errorf("failed to retrieve Google Drive credentials for CredManager: %w", err)
+		}
+		if err := s.dep.CredManager().Upsert(context.Background(), credentials...); err != nil {
+			return fmt.Errorf("failed to upsert Google Drive credentials to CredManager: %w", err)
+		}
 		crontab.Register(setting.CronTypeOauthCredRefresh, func(ctx context.Context) {
 			dep := dependency.FromContext(ctx)
 			cred := dep.CredManager()
@@ -108,6 +119,7 @@ func (s *server) Start() error {
 		s.dep.EntityRecycleQueue(context.Background()).Start()
 		s.dep.IoIntenseQueue(context.Background()).Start()
 		s.dep.RemoteDownloadQueue(context.Background()).Start()
+		s.dep.WebhookQueue(context.Background()).Start()
 
 		// Start cron jobs
 		c, err := crontab.NewCron(context.Background(), s.dep)
@@ -143,6 +155,23 @@ func (s *server) Start() error {
 		}()
 	}
 
+	// Start metrics server if configured
+	if metricsAddr := s.config.Metrics().Listen; metricsAddr != "" {
+		s.metrics = &http.Server{
+			Addr:    metricsAddr,
+			Handler: routers.InitMetricsRouter(s.dep),
+		}
+		if s.config.Metrics().Token == "" {
+			s.logger.Warning("Metrics token is not set, metrics server on %q is not authenticated.", metricsAddr)
+		}
+		go func() {
+			s.logger.Info("Metrics server listening on %q", metricsAddr)
+			if err := s.metrics.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				s.logger.Error("Metrics server error: %s", err)
+			}
+		}()
+	}
+
 	// 如果启用了SSL
 	if s.config.SSL().CertPath != "" {
 		s.logger.Info("Listening to %q", s.config.SSL().Listen)
@@ -211,6 +240,13 @@ func (s *server) Close() {
 		}
 	}
 
+	// Shutdown metrics server
+	if s.metrics != nil {
+		if err := s.metrics.Shutdown(ctx); err != nil {
+			s.logger.Error("Failed to shutdown metrics server: %s", err)
+		}
+	}
+
 	if s.kv != nil {
 		if err := s.kv.Persist(util.DataPath(cache.DefaultCacheFile)); err != nil {
 			s.logger.Warning("Failed to persist cache: %s", err)
@@ -24,6 +24,7 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
 	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
 	"github.com/cloudreve/Cloudreve/v4/pkg/mediameta"
+	"github.com/cloudreve/Cloudreve/v4/pkg/metrics"
 	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
 	"github.com/cloudreve/Cloudreve/v4/pkg/request"
 	"github.com/cloudreve/Cloudreve/v4/pkg/searcher"
@@ -90,6 +91,12 @@ type Dep interface {
 	DavAccountClient() inventory.DavAccountClient
 	// DirectLinkClient Creates a new inventory.DirectLinkClient instance for access DB direct link store.
 	DirectLinkClient() inventory.DirectLinkClient
+	// TeamClient Creates a new inventory.TeamClient instance for access DB team store.
+	TeamClient() inventory.TeamClient
+	// WebhookClient Creates a new inventory.WebhookClient instance for access DB webhook store.
+	WebhookClient() inventory.WebhookClient
+	// ScheduleClient Creates a new inventory.ScheduleClient instance for access DB schedule store.
+	ScheduleClient() inventory.ScheduleClient
 	// OAuthClientClient Creates a new inventory.OAuthClientClient instance for access DB OAuth client store.
 	OAuthClientClient() inventory.OAuthClientClient
 	// HashIDEncoder Get a singleton hashid.Encoder instance for encoding/decoding hashids.
@@ -128,6 +135,8 @@ type Dep interface {
 	IoIntenseQueue(ctx context.Context) queue.Queue
 	// RemoteDownloadQueue Get a singleton queue.Queue instance for remote download tasks.
 	RemoteDownloadQueue(ctx context.Context) queue.Queue
+	// WebhookQueue Get a singleton queue.Queue instance for webhook deliveries.
+	WebhookQueue(ctx context.Context) queue.Queue
 	// NodePool Get a singleton cluster.NodePool instance for node pool management.
 	NodePool(ctx context.Context) (cluster.NodePool, error)
 	// TaskRegistry Get a singleton queue.TaskRegistry instance for task registration.
@@ -146,6 +155,10 @@ type Dep interface {
 	SearchIndexer(ctx context.Context) searcher.SearchIndexer
 	// TextExtractor Get a singleton searcher.TextExtractor instance for text extraction.
 	TextExtractor(ctx context.Context) searcher.TextExtractor
+	// Metrics Get a singleton metrics.Registry instance for Prometheus scrape endpoint.
+	Metrics() *metrics.Registry
+	// RequestMetrics Get a singleton metrics.HistogramVec instance for HTTP request latency.
+	RequestMetrics() *metrics.HistogramVec
 }
 
 type dependency struct {
@@ -168,6 +181,9 @@ type dependency struct {
 	nodeClient            inventory.NodeClient
 	davAccountClient      inventory.DavAccountClient
 	directLinkClient      inventory.DirectLinkClient
+	teamClient            inventory.TeamClient
+	webhookClient         inventory.WebhookClient
+	scheduleClient        inventory.ScheduleClient
 	fsEventClient         inventory.FsEventClient
 	oAuthClient           inventory.OAuthClientClient
 	emailClient           email.Driver
@@ -182,6 +198,7 @@ type dependency struct {
 	entityRecycleQueue    queue.Queue
 	slaveQueue            queue.Queue
 	remoteDownloadQueue   queue.Queue
+	webhookQueue          queue.Queue
 	ioIntenseQueueTask    queue.Task
 	mediaMeta             mediameta.Extractor
 	thumbPipeline         thumb.Generator
@@ -196,6 +213,8 @@ type dependency struct {
 	eventHub              eventhub.EventHub
 	searchIndexer         searcher.SearchIndexer
 	textExtractor         searcher.TextExtractor
+	metrics               *metrics.Registry
+	requestMetrics        *metrics.HistogramVec
 
 	configPath        string
 	isPro             bool
@@ -530,6 +549,10 @@ func (d *dependency) NodePool(ctx context.Context) (cluster.NodePool, error) {
 			return nil, err
 		}
 
+		if d.nodePool != nil {
+			d.nodePool.Shutdown()
+		}
+
 		d.nodePool = np
 	} else {
 		d.nodePool = cluster.NewSlaveDummyNodePool(ctx, d.ConfigProvider(), d.SettingProvider())
@@ -615,6 +638,7 @@ func (d *dependency) ThumbQueue(ctx context.Context) queue.Queue {
 		queue.WithRetryDelay(queueSetting.RetryDelay),
 		queue.WithWorkerCount(queueSetting.WorkerNum),
 		queue.WithName("ThumbQueue"),
+		queue.WithScheduler(d.queueScheduler(queueSetting)),
 		queue.WithMaxTaskExecution(queueSetting.MaxExecution),
 	)
 	return d.thumbQueue
@@ -643,7 +667,9 @@ func (d *dependency) MediaMetaQueue(ctx context.Context) queue.Queue {
 		queue.WithRetryDelay(queueSetting.RetryDelay),
 		queue.WithWorkerCount(queueSetting.WorkerNum),
 		queue.WithName("MediaMetadataQueue"),
+		queue.WithScheduler(d.queueScheduler(queueSetting)),
 		queue.WithMaxTaskExecution(queueSetting.MaxExecution),
+		queue.WithLease(d.instanceID(), 0),
 		queue.WithResumeTaskType(
 			queue.MediaMetaTaskType,
 			queue.FullTextIndexTaskType,
@@ -679,8 +705,18 @@ func (d *dependency) IoIntenseQueue(ctx context.Context) queue.Queue {
 		queue.WithRetryDelay(queueSetting.RetryDelay),
 		queue.WithWorkerCount(queueSetting.WorkerNum),
 		queue.WithName("IoIntenseQueue"),
+		queue.WithScheduler(d.queueScheduler(queueSetting)),
 		queue.WithMaxTaskExecution(queueSetting.MaxExecution),
-		queue.WithResumeTaskType(queue.CreateArchiveTaskType, queue.ExtractArchiveTaskType, queue.RelocateTaskType, queue.ImportTaskType),
+		queue.WithLease(d.instanceID(), 0),
+		queue.WithResumeTaskType(
+			queue.CreateArchiveTaskType,
+			queue.ExtractArchiveTaskType,
+			queue.RelocateTaskType,
+			queue.ImportTaskType,
+			queue.ScrubTaskType,
+			queue.MigratePolicyTaskType,
+			queue.EntityChecksumTaskType,
+		),
 		queue.WithTaskPullInterval(10*time.Second),
 	)
 	return d.ioIntenseQueue
@@ -709,13 +745,47 @@ func (d *dependency) RemoteDownloadQueue(ctx context.Context) queue.Queue {
 		queue.WithRetryDelay(queueSetting.RetryDelay),
 		queue.WithWorkerCount(queueSetting.WorkerNum),
 		queue.WithName("RemoteDownloadQueue"),
+		queue.WithScheduler(d.queueScheduler(queueSetting)),
 		queue.WithMaxTaskExecution(queueSetting.MaxExecution),
+		queue.WithLease(d.instanceID(), 0),
 		queue.WithResumeTaskType(queue.RemoteDownloadTaskType),
 		queue.WithTaskPullInterval(10*time.Second),
 	)
 	return d.remoteDownloadQueue
 }
 
+func (d *dependency) WebhookQueue(ctx context.Context) queue.Queue {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	_, reload := ctx.Value(ReloadCtx{}).(bool)
+	if d.webhookQueue != nil && !reload {
+		return d.webhookQueue
+	}
+
+	if d.webhookQueue != nil {
+		d.webhookQueue.Shutdown()
+	}
+
+	settings := d.SettingProvider()
+	queueSetting := settings.Queue(context.Background(), setting.QueueTypeWebhook)
+
+	d.webhookQueue = queue.New(d.Logger(), d.TaskClient(), nil, d,
+		queue.WithBackoffFactor(queueSetting.BackoffFactor),
+		queue.WithMaxRetry(queueSetting.MaxRetry),
+		queue.WithBackoffMaxDuration(queueSetting.BackoffMaxDuration),
+		queue.WithRetryDelay(queueSetting.RetryDelay),
+		queue.WithWorkerCount(queueSetting.WorkerNum),
+		queue.WithName("WebhookQueue"),
+		queue.WithScheduler(d.queueScheduler(queueSetting)),
+		queue.WithMaxTaskExecution(queueSetting.MaxExecution),
+		queue.WithLease(d.instanceID(), 0),
+		queue.WithResumeTaskType(queue.WebhookDeliveryTaskType),
+		queue.WithTaskPullInterval(10*time.Second),
+	)
+	return d.webhookQueue
+}
+
 func (d *dependency) EntityRecycleQueue(ctx context.Context) queue.Queue {
 	d.mu.Lock()
 	defer d.mu.Unlock()
@@ -739,7 +809,9 @@ func (d *dependency) EntityRecycleQueue(ctx context.Context) queue.Queue {
 		queue.WithRetryDelay(queueSetting.RetryDelay),
 		queue.WithWorkerCount(queueSetting.WorkerNum),
 		queue.WithName("EntityRecycleQueue"),
+		queue.WithScheduler(d.queueScheduler(queueSetting)),
 		queue.WithMaxTaskExecution(queueSetting.MaxExecution),
+		queue.WithLease(d.instanceID(), 0),
 		queue.WithResumeTaskType(queue.EntityRecycleRoutineTaskType, queue.ExplicitEntityRecycleTaskType, queue.UploadSentinelCheckTaskType),
 		queue.WithTaskPullInterval(10*time.Second),
 	)
@@ -769,6 +841,7 @@ func (d *dependency) SlaveQueue(ctx context.Context) queue.Queue {
 		queue.WithRetryDelay(queueSetting.RetryDelay),
 		queue.WithWorkerCount(queueSetting.WorkerNum),
 		queue.WithName("SlaveQueue"),
+		queue.WithScheduler(d.queueScheduler(queueSetting)),
 		queue.WithMaxTaskExecution(queueSetting.MaxExecution),
 	)
 	return d.slaveQueue
@@ -836,6 +909,30 @@ func (d *dependency) DirectLinkClient() inventory.DirectLinkClient {
 	return inventory.NewDirectLinkClient(d.DBClient(), d.ConfigProvider().Database().Type, d.HashIDEncoder())
 }
 
+func (d *dependency) TeamClient() inventory.TeamClient {
+	if d.teamClient != nil {
+		return d.teamClient
+	}
+
+	return inventory.NewTeamClient(d.DBClient(), d.ConfigProvider().Database().Type, d.HashIDEncoder())
+}
+
+func (d *dependency) WebhookClient() inventory.WebhookClient {
+	if d.webhookClient != nil {
+		return d.webhookClient
+	}
+
+	return inventory.NewWebhookClient(d.DBClient(), d.ConfigProvider().Database().Type, d.KV())
+}
+
+func (d *dependency) ScheduleClient() inventory.ScheduleClient {
+	if d.scheduleClient != nil {
+		return d.scheduleClient
+	}
+
+	return inventory.NewScheduleClient(d.DBClient(), d.ConfigProvider().Database().Type)
+}
+
 func (d *dependency) HashIDEncoder() hashid.Encoder {
 	if d.hashidEncoder != nil {
 		return d.hashidEncoder
@@ -873,11 +970,31 @@ func (d *dependency) TokenAuth() auth.TokenAuth {
 	return d.tokenAuth
 }
 
+// instanceID returns the ID of this master instance used to lease tasks, empty if not configured,
+// in which case task leasing is disabled.
+func (d *dependency) instanceID() string {
+	return d.ConfigProvider().System().InstanceID
+}
+
+// queueScheduler returns the task scheduler of a queue according to its setting.
+func (d *dependency) queueScheduler(s *setting.QueueSetting) queue.Scheduler {
+	if s.FairScheduling {
+		return queue.NewFairScheduler(0, d.Logger())
+	}
+
+	return queue.NewFifoScheduler(0, d.Logger())
+}
+
 func (d *dependency) LockSystem() lock.LockSystem {
 	if d.lockSystem != nil {
 		return d.lockSystem
 	}
 
+	if d.ConfigProvider().System().LockSystem == conf.LockSystemDatabase {
+		d.lockSystem = lock.NewDBLS(d.DBClient(), d.HashIDEncoder(), d.Logger())
+		return d.lockSystem
+	}
+
 	d.lockSystem = lock.NewMemLS(d.HashIDEncoder(), d.Logger())
 	return d.lockSystem
 }
@@ -915,6 +1032,10 @@ func (d *dependency) Shutdown(ctx context.Context) error {
 		d.emailClient.Close()
 	}
 
+	if d.nodePool != nil {
+		d.nodePool.Shutdown()
+	}
+
 	wg := sync.WaitGroup{}
 
 	if d.mediaMetaQueue != nil {
@@ -965,6 +1086,14 @@ func (d *dependency) Shutdown(ctx context.Context) error {
 		}()
 	}
 
+	if d.webhookQueue != nil {
+		wg.Add(1)
+		go func() {
+			d.webhookQueue.Shutdown()
+			defer wg.Done()
+		}()
+	}
+
 	if d.eventHub != nil {
 		wg.Add(1)
 		go func() {
@@ -0,0 +1,104 @@
+// Package deptest provides a Dep backed by a throwaway SQLite database for tests.
+package deptest
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cloudreve/Cloudreve/v4/application/constants"
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cache"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+)
+
+const confTemplate = `[System]
+Mode = master
+Listen = :5212
+LogLevel = error
+
+[Database]
+Type = sqlite
+DBFile = %s
+`
+
+// New creates a Dep with a freshly migrated SQLite database in a temporary working directory.
+// Local storage policies write into that directory, which is removed after the test.
+func New(t testing.TB, opts ...dependency.Option) dependency.Dep {
+	t.Helper()
+
+	dir := t.TempDir()
+	t.Chdir(dir)
+	util.UseWorkingDir = true
+
+	confPath := filepath.Join(dir, "conf.ini")
+	if err := os.WriteFile(confPath, []byte(fmt.Sprintf(confTemplate, filepath.Join(dir, "cloudreve.db"))), 0600); err != nil {
+		t.Fatalf("failed to write config file: %s", err)
+	}
+
+	l := logging.NewConsoleLogger(logging.LevelError)
+	dep := dependency.NewDependency(append([]dependency.Option{
+		dependency.WithConfigPath(confPath),
+		dependency.WithLogger(l),
+		dependency.WithKV(cache.NewMemoStore("", l)),
+		dependency.WithRequiredDbVersion(constants.BackendVersion),
+	}, opts...)...)
+	dep.DBClient()
+
+	t.Cleanup(func() {
+		_ = dep.DBClient().Close()
+	})
+	return dep
+}
+
+// Context returns a context carrying dep, u and a correlation ID, as what middlewares set for a request.
+func Context(dep dependency.Dep, u *ent.User) context.Context {
+	ctx := context.WithValue(context.Background(), dependency.DepCtx{}, dep)
+	ctx = context.WithValue(ctx, logging.LoggerCtx{}, dep.Logger())
+	ctx = context.WithValue(ctx, logging.CorrelationIDCtx{}, uuid.Must(uuid.NewV4()))
+	if u != nil {
+		ctx = context.WithValue(ctx, inventory.UserCtx{}, u)
+	}
+
+	return ctx
+}
+
+// GinContext returns a gin context of a request carrying ctx, with context fallback enabled as
+// what the router sets.
+func GinContext(ctx context.Context) *gin.Context {
+	c, engine := gin.CreateTestContext(httptest.NewRecorder())
+	engine.ContextWithFallback = true
+	c.Request = httptest.NewRequest("GET", "/", nil).WithContext(ctx)
+	return c
+}
+
+// NewUser creates an active user in given group, with its group and storage policy eager loaded.
+func NewUser(t testing.TB, dep dependency.Dep, email string, groupID int) *ent.User {
+	t.Helper()
+
+	ctx := context.Background()
+	u, err := dep.UserClient().Create(ctx, &inventory.NewUserArgs{
+		Email:   email,
+		Status:  user.StatusActive,
+		GroupID: groupID,
+	})
+	if err != nil {
+		t.Fatalf("failed to create user: %s", err)
+	}
+
+	u, err = dep.UserClient().GetLoginUserByID(ctx, u.ID)
+	if err != nil {
+		t.Fatalf("failed to load user: %s", err)
+	}
+
+	return u
+}
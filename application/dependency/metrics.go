@@ -0,0 +1,218 @@
+package dependency
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/constants"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cache"
+	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
+	"github.com/cloudreve/Cloudreve/v4/pkg/metrics"
+	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+)
+
+const (
+	// metricsDBCacheTTL is how long metrics collected from database are reused.
+	metricsDBCacheTTL = time.Minute
+)
+
+func (d *dependency) Metrics() *metrics.Registry {
+	if d.metrics != nil {
+		return d.metrics
+	}
+
+	d.metrics = metrics.NewRegistry()
+	d.metrics.Register(
+		d.RequestMetrics(),
+		metrics.CollectorFunc(d.collectBuildInfo),
+		metrics.CollectorFunc(d.collectQueues),
+		metrics.CollectorFunc(d.collectCache),
+	)
+
+	if d.ConfigProvider().System().Mode == conf.MasterMode {
+		d.metrics.Register(
+			metrics.CollectorFunc(d.collectEventHub),
+			metrics.CollectorFunc(d.collectNodes),
+			metrics.Cached(metricsDBCacheTTL, metrics.CollectorFunc(d.collectUploadSessions)),
+			metrics.Cached(metricsDBCacheTTL, metrics.CollectorFunc(d.collectPolicyStorage)),
+		)
+	}
+
+	return d.metrics
+}
+
+func (d *dependency) RequestMetrics() *metrics.HistogramVec {
+	if d.requestMetrics != nil {
+		return d.requestMetrics
+	}
+
+	d.requestMetrics = metrics.NewHistogramVec(
+		"http_request_duration_seconds",
+		"Latency of HTTP requests by route group.",
+		metrics.DefBuckets,
+		"group", "method", "code",
+	)
+	return d.requestMetrics
+}
+
+func (d *dependency) collectBuildInfo(ctx context.Context) []*metrics.Family {
+	return []*metrics.Family{
+		metrics.NewGauge("build_info", "Version of running Cloudreve.", metrics.Sample{
+			Labels: metrics.Labels{
+				"version", constants.BackendVersion,
+				"commit", constants.LastCommit,
+				"mode", string(d.ConfigProvider().System().Mode),
+			},
+			Value: 1,
+		}),
+	}
+}
+
+// collectQueues collects metrics of started queues. Queues are not initialized here.
+func (d *dependency) collectQueues(ctx context.Context) []*metrics.Family {
+	queues := map[setting.QueueType]queue.Queue{
+		setting.QueueTypeMediaMeta:      d.mediaMetaQueue,
+		setting.QueueTypeEntityRecycle:  d.entityRecycleQueue,
+		setting.QueueTypeIOIntense:      d.ioIntenseQueue,
+		setting.QueueTypeRemoteDownload: d.remoteDownloadQueue,
+		setting.QueueTypeThumb:          d.thumbQueue,
+		setting.QueueTypeWebhook:        d.webhookQueue,
+		setting.QueueTypeSlave:          d.slaveQueue,
+	}
+
+	busy := metrics.NewGauge("queue_busy_workers", "Number of workers running tasks.")
+	suspending := metrics.NewGauge("queue_suspending_tasks", "Number of suspended tasks waiting to be resumed.")
+	success := metrics.NewCounter("queue_success_tasks_total", "Number of completed tasks.")
+	failure := metrics.NewCounter("queue_failure_tasks_total", "Number of failed tasks.")
+	submitted := metrics.NewCounter("queue_submitted_tasks_total", "Number of submitted tasks.")
+	for name, q := range queues {
+		if q == nil {
+			continue
+		}
+
+		labels := metrics.Labels{"queue", string(name)}
+		busy.Samples = append(busy.Samples, metrics.Sample{Labels: labels, Value: float64(q.BusyWorkers())})
+		suspending.Samples = append(suspending.Samples, metrics.Sample{Labels: labels, Value: float64(q.SuspendingTasks())})
+		success.Samples = append(success.Samples, metrics.Sample{Labels: labels, Value: float64(q.SuccessTasks())})
+		failure.Samples = append(failure.Samples, metrics.Sample{Labels: labels, Value: float64(q.FailureTasks())})
+		submitted.Samples = append(submitted.Samples, metrics.Sample{Labels: labels, Value: float64(q.SubmittedTasks())})
+	}
+
+	return []*metrics.Family{busy, suspending, success, failure, submitted}
+}
+
+func (d *dependency) collectCache(ctx context.Context) []*metrics.Family {
+	stores := map[string]cache.Driver{
+		"kv":              d.kv,
+		"navigator_state": d.navigatorStateKv,
+	}
+
+	hits := metrics.NewCounter("cache_hits_total", "Number of cache lookups found.")
+	misses := metrics.NewCounter("cache_misses_total", "Number of cache lookups missed.")
+	ratio := metrics.NewGauge("cache_hit_ratio", "Ratio of cache lookups found since started.")
+	for name, store := range stores {
+		if store == nil {
+			continue
+		}
+
+		stats := store.Stats()
+		labels := metrics.Labels{"store", name}
+		hits.Samples = append(hits.Samples, metrics.Sample{Labels: labels, Value: float64(stats.Hits)})
+		misses.Samples = append(misses.Samples, metrics.Sample{Labels: labels, Value: float64(stats.Misses)})
+		if total := stats.Hits + stats.Misses; total > 0 {
+			ratio.Samples = append(ratio.Samples, metrics.Sample{Labels: labels, Value: float64(stats.Hits) / float64(total)})
+		}
+	}
+
+	return []*metrics.Family{hits, misses, ratio}
+}
+
+func (d *dependency) collectEventHub(ctx context.Context) []*metrics.Family {
+	if d.eventHub == nil {
+		return nil
+	}
+
+	online, offline := d.eventHub.SubscriberCount()
+	return []*metrics.Family{
+		metrics.NewGauge("eventhub_subscribers", "Number of file event subscribers.",
+			metrics.Sample{Labels: metrics.Labels{"state", "online"}, Value: float64(online)},
+			metrics.Sample{Labels: metrics.Labels{"state", "offline"}, Value: float64(offline)},
+		),
+	}
+}
+
+func (d *dependency) collectNodes(ctx context.Context) []*metrics.Family {
+	if d.nodePool == nil {
+		return nil
+	}
+
+	healthy := metrics.NewGauge("node_healthy", "Whether the node is healthy according to heartbeats.")
+	failures := metrics.NewGauge("node_heartbeat_failures", "Number of consecutive failed heartbeats.")
+	busy := metrics.NewGauge("node_busy_workers", "Number of busy workers reported by slave node.")
+	workers := metrics.NewGauge("node_workers", "Number of workers reported by slave node.")
+	for _, n := range d.nodePool.Nodes() {
+		labels := metrics.Labels{"node_id", strconv.Itoa(n.ID()), "node", n.Name()}
+		if n.IsMaster() {
+			healthy.Samples = append(healthy.Samples, metrics.Sample{Labels: labels, Value: 1})
+			continue
+		}
+
+		h := d.nodePool.Health(n.ID())
+		if h == nil {
+			// No heartbeat yet
+			continue
+		}
+
+		healthy.Samples = append(healthy.Samples, metrics.Sample{Labels: labels, Value: boolValue(h.Healthy)})
+		failures.Samples = append(failures.Samples, metrics.Sample{Labels: labels, Value: float64(h.Failures)})
+		busy.Samples = append(busy.Samples, metrics.Sample{Labels: labels, Value: float64(h.BusyWorkers)})
+		workers.Samples = append(workers.Samples, metrics.Sample{Labels: labels, Value: float64(h.Workers)})
+	}
+
+	return []*metrics.Family{healthy, failures, busy, workers}
+}
+
+func (d *dependency) collectUploadSessions(ctx context.Context) []*metrics.Family {
+	count, err := d.FileClient().CountUploadingEntities(ctx)
+	if err != nil {
+		d.Logger().Warning("Failed to count upload sessions for metrics: %s", err)
+		return nil
+	}
+
+	return []*metrics.Family{
+		metrics.NewGauge("upload_sessions", "Number of upload sessions in progress.",
+			metrics.Sample{Value: float64(count)}),
+	}
+}
+
+func (d *dependency) collectPolicyStorage(ctx context.Context) []*metrics.Family {
+	stats, err := d.FileClient().CountEntityGroupByStoragePolicy(ctx)
+	if err != nil {
+		d.Logger().Warning("Failed to count storage policy usage for metrics: %s", err)
+		return nil
+	}
+
+	bytes := metrics.NewGauge("storage_policy_bytes", "Total size of blobs stored in the storage policy.")
+	entities := metrics.NewGauge("storage_policy_entities", "Number of blobs stored in the storage policy.")
+	for _, s := range stats {
+		name := ""
+		if policy, err := d.StoragePolicyClient().GetPolicyByID(ctx, s.StoragePolicyID); err == nil {
+			name = policy.Name
+		}
+
+		labels := metrics.Labels{"policy_id", strconv.Itoa(s.StoragePolicyID), "policy", name}
+		bytes.Samples = append(bytes.Samples, metrics.Sample{Labels: labels, Value: float64(s.Sum)})
+		entities.Samples = append(entities.Samples, metrics.Sample{Labels: labels, Value: float64(s.Count)})
+	}
+
+	return []*metrics.Family{bytes, entities}
+}
+
+func boolValue(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
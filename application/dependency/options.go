@@ -7,6 +7,7 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/inventory"
 	"github.com/cloudreve/Cloudreve/v4/pkg/auth"
 	"github.com/cloudreve/Cloudreve/v4/pkg/cache"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cluster"
 	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
 	"github.com/cloudreve/Cloudreve/v4/pkg/email"
 	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
@@ -173,3 +174,10 @@ func WithTextExtractor(s searcher.TextExtractor) Option {
 		o.textExtractor = s
 	})
 }
+
+// WithNodePool Set the default node pool
+func WithNodePool(s cluster.NodePool) Option {
+	return optionFunc(func(o *dependency) {
+		o.nodePool = s
+	})
+}
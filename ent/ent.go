@@ -17,17 +17,24 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/ent/entity"
 	"github.com/cloudreve/Cloudreve/v4/ent/file"
 	"github.com/cloudreve/Cloudreve/v4/ent/fsevent"
+	"github.com/cloudreve/Cloudreve/v4/ent/fslock"
 	"github.com/cloudreve/Cloudreve/v4/ent/group"
 	"github.com/cloudreve/Cloudreve/v4/ent/metadata"
 	"github.com/cloudreve/Cloudreve/v4/ent/node"
 	"github.com/cloudreve/Cloudreve/v4/ent/oauthclient"
 	"github.com/cloudreve/Cloudreve/v4/ent/oauthgrant"
 	"github.com/cloudreve/Cloudreve/v4/ent/passkey"
+	"github.com/cloudreve/Cloudreve/v4/ent/schedule"
+	"github.com/cloudreve/Cloudreve/v4/ent/schedulerun"
 	"github.com/cloudreve/Cloudreve/v4/ent/setting"
 	"github.com/cloudreve/Cloudreve/v4/ent/share"
+	"github.com/cloudreve/Cloudreve/v4/ent/shareaccess"
 	"github.com/cloudreve/Cloudreve/v4/ent/storagepolicy"
 	"github.com/cloudreve/Cloudreve/v4/ent/task"
+	"github.com/cloudreve/Cloudreve/v4/ent/team"
 	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/ent/webhook"
+	"github.com/cloudreve/Cloudreve/v4/ent/webhookdelivery"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -88,22 +95,29 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			davaccount.Table:    davaccount.ValidColumn,
-			directlink.Table:    directlink.ValidColumn,
-			entity.Table:        entity.ValidColumn,
-			file.Table:          file.ValidColumn,
-			fsevent.Table:       fsevent.ValidColumn,
-			group.Table:         group.ValidColumn,
-			metadata.Table:      metadata.ValidColumn,
-			node.Table:          node.ValidColumn,
-			oauthclient.Table:   oauthclient.ValidColumn,
-			oauthgrant.Table:    oauthgrant.ValidColumn,
-			passkey.Table:       passkey.ValidColumn,
-			setting.Table:       setting.ValidColumn,
-			share.Table:         share.ValidColumn,
-			storagepolicy.Table: storagepolicy.ValidColumn,
-			task.Table:          task.ValidColumn,
-			user.Table:          user.ValidColumn,
+			davaccount.Table:      davaccount.ValidColumn,
+			directlink.Table:      directlink.ValidColumn,
+			entity.Table:          entity.ValidColumn,
+			file.Table:            file.ValidColumn,
+			fsevent.Table:         fsevent.ValidColumn,
+			fslock.Table:          fslock.ValidColumn,
+			group.Table:           group.ValidColumn,
+			metadata.Table:        metadata.ValidColumn,
+			node.Table:            node.ValidColumn,
+			oauthclient.Table:     oauthclient.ValidColumn,
+			oauthgrant.Table:      oauthgrant.ValidColumn,
+			passkey.Table:         passkey.ValidColumn,
+			schedule.Table:        schedule.ValidColumn,
+			schedulerun.Table:     schedulerun.ValidColumn,
+			setting.Table:         setting.ValidColumn,
+			share.Table:           share.ValidColumn,
+			shareaccess.Table:     shareaccess.ValidColumn,
+			storagepolicy.Table:   storagepolicy.ValidColumn,
+			task.Table:            task.ValidColumn,
+			team.Table:            team.ValidColumn,
+			user.Table:            user.ValidColumn,
+			webhook.Table:         webhook.ValidColumn,
+			webhookdelivery.Table: webhookdelivery.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
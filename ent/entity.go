@@ -44,6 +44,8 @@ type Entity struct {
 	UploadSessionID *uuid.UUID `json:"upload_session_id,omitempty"`
 	// Props holds the value of the "props" field.
 	Props *types.EntityProps `json:"props,omitempty"`
+	// Checksum holds the value of the "checksum" field.
+	Checksum string `json:"checksum,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the EntityQuery when eager-loading is set.
 	Edges        EntityEdges `json:"edges"`
@@ -109,7 +111,7 @@ func (*Entity) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case entity.FieldID, entity.FieldType, entity.FieldSize, entity.FieldReferenceCount, entity.FieldStoragePolicyEntities, entity.FieldCreatedBy:
 			values[i] = new(sql.NullInt64)
-		case entity.FieldSource:
+		case entity.FieldSource, entity.FieldChecksum:
 			values[i] = new(sql.NullString)
 		case entity.FieldCreatedAt, entity.FieldUpdatedAt, entity.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
@@ -204,6 +206,12 @@ func (e *Entity) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field props: %w", err)
 				}
 			}
+		case entity.FieldChecksum:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field checksum", values[i])
+			} else if value.Valid {
+				e.Checksum = value.String
+			}
 		default:
 			e.selectValues.Set(columns[i], values[i])
 		}
@@ -291,6 +299,9 @@ func (e *Entity) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("props=")
 	builder.WriteString(fmt.Sprintf("%v", e.Props))
+	builder.WriteString(", ")
+	builder.WriteString("checksum=")
+	builder.WriteString(e.Checksum)
 	builder.WriteByte(')')
 	return builder.String()
 }
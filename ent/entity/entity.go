@@ -37,6 +37,8 @@ const (
 	FieldUploadSessionID = "upload_session_id"
 	// FieldProps holds the string denoting the props field in the database.
 	FieldProps = "recycle_options"
+	// FieldChecksum holds the string denoting the checksum field in the database.
+	FieldChecksum = "checksum"
 	// EdgeFile holds the string denoting the file edge name in mutations.
 	EdgeFile = "file"
 	// EdgeUser holds the string denoting the user edge name in mutations.
@@ -80,6 +82,7 @@ var Columns = []string{
 	FieldCreatedBy,
 	FieldUploadSessionID,
 	FieldProps,
+	FieldChecksum,
 }
 
 var (
@@ -174,6 +177,11 @@ func ByUploadSessionID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUploadSessionID, opts...).ToFunc()
 }
 
+// ByChecksum orders the results by the checksum field.
+func ByChecksum(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChecksum, opts...).ToFunc()
+}
+
 // ByFileCount orders the results by file count.
 func ByFileCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
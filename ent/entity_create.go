@@ -141,6 +141,20 @@ func (ec *EntityCreate) SetProps(tp *types.EntityProps) *EntityCreate {
 	return ec
 }
 
+// SetChecksum sets the "checksum" field.
+func (ec *EntityCreate) SetChecksum(s string) *EntityCreate {
+	ec.mutation.SetChecksum(s)
+	return ec
+}
+
+// SetNillableChecksum sets the "checksum" field if the given value is not nil.
+func (ec *EntityCreate) SetNillableChecksum(s *string) *EntityCreate {
+	if s != nil {
+		ec.SetChecksum(*s)
+	}
+	return ec
+}
+
 // AddFileIDs adds the "file" edge to the File entity by IDs.
 func (ec *EntityCreate) AddFileIDs(ids ...int) *EntityCreate {
 	ec.mutation.AddFileIDs(ids...)
@@ -340,6 +354,10 @@ func (ec *EntityCreate) createSpec() (*Entity, *sqlgraph.CreateSpec) {
 		_spec.SetField(entity.FieldProps, field.TypeJSON, value)
 		_node.Props = value
 	}
+	if value, ok := ec.mutation.Checksum(); ok {
+		_spec.SetField(entity.FieldChecksum, field.TypeString, value)
+		_node.Checksum = value
+	}
 	if nodes := ec.mutation.FileIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -604,6 +622,24 @@ func (u *EntityUpsert) ClearProps() *EntityUpsert {
 	return u
 }
 
+// SetChecksum sets the "checksum" field.
+func (u *EntityUpsert) SetChecksum(v string) *EntityUpsert {
+	u.Set(entity.FieldChecksum, v)
+	return u
+}
+
+// UpdateChecksum sets the "checksum" field to the value that was provided on create.
+func (u *EntityUpsert) UpdateChecksum() *EntityUpsert {
+	u.SetExcluded(entity.FieldChecksum)
+	return u
+}
+
+// ClearChecksum clears the value of the "checksum" field.
+func (u *EntityUpsert) ClearChecksum() *EntityUpsert {
+	u.SetNull(entity.FieldChecksum)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -838,6 +874,27 @@ func (u *EntityUpsertOne) ClearProps() *EntityUpsertOne {
 	})
 }
 
+// SetChecksum sets the "checksum" field.
+func (u *EntityUpsertOne) SetChecksum(v string) *EntityUpsertOne {
+	return u.Update(func(s *EntityUpsert) {
+		s.SetChecksum(v)
+	})
+}
+
+// UpdateChecksum sets the "checksum" field to the value that was provided on create.
+func (u *EntityUpsertOne) UpdateChecksum() *EntityUpsertOne {
+	return u.Update(func(s *EntityUpsert) {
+		s.UpdateChecksum()
+	})
+}
+
+// ClearChecksum clears the value of the "checksum" field.
+func (u *EntityUpsertOne) ClearChecksum() *EntityUpsertOne {
+	return u.Update(func(s *EntityUpsert) {
+		s.ClearChecksum()
+	})
+}
+
 // Exec executes the query.
 func (u *EntityUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1243,6 +1300,27 @@ func (u *EntityUpsertBulk) ClearProps() *EntityUpsertBulk {
 	})
 }
 
+// SetChecksum sets the "checksum" field.
+func (u *EntityUpsertBulk) SetChecksum(v string) *EntityUpsertBulk {
+	return u.Update(func(s *EntityUpsert) {
+		s.SetChecksum(v)
+	})
+}
+
+// UpdateChecksum sets the "checksum" field to the value that was provided on create.
+func (u *EntityUpsertBulk) UpdateChecksum() *EntityUpsertBulk {
+	return u.Update(func(s *EntityUpsert) {
+		s.UpdateChecksum()
+	})
+}
+
+// ClearChecksum clears the value of the "checksum" field.
+func (u *EntityUpsertBulk) ClearChecksum() *EntityUpsertBulk {
+	return u.Update(func(s *EntityUpsert) {
+		s.ClearChecksum()
+	})
+}
+
 // Exec executes the query.
 func (u *EntityUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
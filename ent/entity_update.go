@@ -202,6 +202,26 @@ func (eu *EntityUpdate) ClearProps() *EntityUpdate {
 	return eu
 }
 
+// SetChecksum sets the "checksum" field.
+func (eu *EntityUpdate) SetChecksum(s string) *EntityUpdate {
+	eu.mutation.SetChecksum(s)
+	return eu
+}
+
+// SetNillableChecksum sets the "checksum" field if the given value is not nil.
+func (eu *EntityUpdate) SetNillableChecksum(s *string) *EntityUpdate {
+	if s != nil {
+		eu.SetChecksum(*s)
+	}
+	return eu
+}
+
+// ClearChecksum clears the value of the "checksum" field.
+func (eu *EntityUpdate) ClearChecksum() *EntityUpdate {
+	eu.mutation.ClearChecksum()
+	return eu
+}
+
 // AddFileIDs adds the "file" edge to the File entity by IDs.
 func (eu *EntityUpdate) AddFileIDs(ids ...int) *EntityUpdate {
 	eu.mutation.AddFileIDs(ids...)
@@ -389,6 +409,12 @@ func (eu *EntityUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if eu.mutation.PropsCleared() {
 		_spec.ClearField(entity.FieldProps, field.TypeJSON)
 	}
+	if value, ok := eu.mutation.Checksum(); ok {
+		_spec.SetField(entity.FieldChecksum, field.TypeString, value)
+	}
+	if eu.mutation.ChecksumCleared() {
+		_spec.ClearField(entity.FieldChecksum, field.TypeString)
+	}
 	if eu.mutation.FileCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -681,6 +707,26 @@ func (euo *EntityUpdateOne) ClearProps() *EntityUpdateOne {
 	return euo
 }
 
+// SetChecksum sets the "checksum" field.
+func (euo *EntityUpdateOne) SetChecksum(s string) *EntityUpdateOne {
+	euo.mutation.SetChecksum(s)
+	return euo
+}
+
+// SetNillableChecksum sets the "checksum" field if the given value is not nil.
+func (euo *EntityUpdateOne) SetNillableChecksum(s *string) *EntityUpdateOne {
+	if s != nil {
+		euo.SetChecksum(*s)
+	}
+	return euo
+}
+
+// ClearChecksum clears the value of the "checksum" field.
+func (euo *EntityUpdateOne) ClearChecksum() *EntityUpdateOne {
+	euo.mutation.ClearChecksum()
+	return euo
+}
+
 // AddFileIDs adds the "file" edge to the File entity by IDs.
 func (euo *EntityUpdateOne) AddFileIDs(ids ...int) *EntityUpdateOne {
 	euo.mutation.AddFileIDs(ids...)
@@ -898,6 +944,12 @@ func (euo *EntityUpdateOne) sqlSave(ctx context.Context) (_node *Entity, err err
 	if euo.mutation.PropsCleared() {
 		_spec.ClearField(entity.FieldProps, field.TypeJSON)
 	}
+	if value, ok := euo.mutation.Checksum(); ok {
+		_spec.SetField(entity.FieldChecksum, field.TypeString, value)
+	}
+	if euo.mutation.ChecksumCleared() {
+		_spec.ClearField(entity.FieldChecksum, field.TypeString)
+	}
 	if euo.mutation.FileCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -0,0 +1,238 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/ent/fslock"
+)
+
+// FsLock is the model entity for the FsLock schema.
+type FsLock struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Token holds the value of the "token" field.
+	Token string `json:"token,omitempty"`
+	// Ns holds the value of the "ns" field.
+	Ns string `json:"ns,omitempty"`
+	// Root holds the value of the "root" field.
+	Root string `json:"root,omitempty"`
+	// ZeroDepth holds the value of the "zero_depth" field.
+	ZeroDepth bool `json:"zero_depth,omitempty"`
+	// FileType holds the value of the "file_type" field.
+	FileType int `json:"file_type,omitempty"`
+	// Owner holds the value of the "owner" field.
+	Owner string `json:"owner,omitempty"`
+	// Duration holds the value of the "duration" field.
+	Duration int64 `json:"duration,omitempty"`
+	// ExpireAt holds the value of the "expire_at" field.
+	ExpireAt *time.Time `json:"expire_at,omitempty"`
+	// HeldUntil holds the value of the "held_until" field.
+	HeldUntil    *time.Time `json:"held_until,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*FsLock) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case fslock.FieldZeroDepth:
+			values[i] = new(sql.NullBool)
+		case fslock.FieldID, fslock.FieldFileType, fslock.FieldDuration:
+			values[i] = new(sql.NullInt64)
+		case fslock.FieldToken, fslock.FieldNs, fslock.FieldRoot, fslock.FieldOwner:
+			values[i] = new(sql.NullString)
+		case fslock.FieldCreatedAt, fslock.FieldUpdatedAt, fslock.FieldDeletedAt, fslock.FieldExpireAt, fslock.FieldHeldUntil:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the FsLock fields.
+func (fl *FsLock) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case fslock.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			fl.ID = int(value.Int64)
+		case fslock.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				fl.CreatedAt = value.Time
+			}
+		case fslock.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				fl.UpdatedAt = value.Time
+			}
+		case fslock.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				fl.DeletedAt = new(time.Time)
+				*fl.DeletedAt = value.Time
+			}
+		case fslock.FieldToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token", values[i])
+			} else if value.Valid {
+				fl.Token = value.String
+			}
+		case fslock.FieldNs:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ns", values[i])
+			} else if value.Valid {
+				fl.Ns = value.String
+			}
+		case fslock.FieldRoot:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field root", values[i])
+			} else if value.Valid {
+				fl.Root = value.String
+			}
+		case fslock.FieldZeroDepth:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field zero_depth", values[i])
+			} else if value.Valid {
+				fl.ZeroDepth = value.Bool
+			}
+		case fslock.FieldFileType:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field file_type", values[i])
+			} else if value.Valid {
+				fl.FileType = int(value.Int64)
+			}
+		case fslock.FieldOwner:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner", values[i])
+			} else if value.Valid {
+				fl.Owner = value.String
+			}
+		case fslock.FieldDuration:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration", values[i])
+			} else if value.Valid {
+				fl.Duration = value.Int64
+			}
+		case fslock.FieldExpireAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expire_at", values[i])
+			} else if value.Valid {
+				fl.ExpireAt = new(time.Time)
+				*fl.ExpireAt = value.Time
+			}
+		case fslock.FieldHeldUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field held_until", values[i])
+			} else if value.Valid {
+				fl.HeldUntil = new(time.Time)
+				*fl.HeldUntil = value.Time
+			}
+		default:
+			fl.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the FsLock.
+// This includes values selected through modifiers, order, etc.
+func (fl *FsLock) Value(name string) (ent.Value, error) {
+	return fl.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this FsLock.
+// Note that you need to call FsLock.Unwrap() before calling this method if this FsLock
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (fl *FsLock) Update() *FsLockUpdateOne {
+	return NewFsLockClient(fl.config).UpdateOne(fl)
+}
+
+// Unwrap unwraps the FsLock entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (fl *FsLock) Unwrap() *FsLock {
+	_tx, ok := fl.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FsLock is not a transactional entity")
+	}
+	fl.config.driver = _tx.drv
+	return fl
+}
+
+// String implements the fmt.Stringer.
+func (fl *FsLock) String() string {
+	var builder strings.Builder
+	builder.WriteString("FsLock(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", fl.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(fl.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fl.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := fl.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("token=")
+	builder.WriteString(fl.Token)
+	builder.WriteString(", ")
+	builder.WriteString("ns=")
+	builder.WriteString(fl.Ns)
+	builder.WriteString(", ")
+	builder.WriteString("root=")
+	builder.WriteString(fl.Root)
+	builder.WriteString(", ")
+	builder.WriteString("zero_depth=")
+	builder.WriteString(fmt.Sprintf("%v", fl.ZeroDepth))
+	builder.WriteString(", ")
+	builder.WriteString("file_type=")
+	builder.WriteString(fmt.Sprintf("%v", fl.FileType))
+	builder.WriteString(", ")
+	builder.WriteString("owner=")
+	builder.WriteString(fl.Owner)
+	builder.WriteString(", ")
+	builder.WriteString("duration=")
+	builder.WriteString(fmt.Sprintf("%v", fl.Duration))
+	builder.WriteString(", ")
+	if v := fl.ExpireAt; v != nil {
+		builder.WriteString("expire_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := fl.HeldUntil; v != nil {
+		builder.WriteString("held_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// FsLocks is a parsable slice of FsLock.
+type FsLocks []*FsLock
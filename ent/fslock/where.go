@@ -0,0 +1,720 @@
+// Code generated by ent, DO NOT EDIT.
+
+package fslock
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.FsLock {
+	return predicate.FsLock(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.FsLock {
+	return predicate.FsLock(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.FsLock {
+	return predicate.FsLock(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.FsLock {
+	return predicate.FsLock(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.FsLock {
+	return predicate.FsLock(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.FsLock {
+	return predicate.FsLock(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.FsLock {
+	return predicate.FsLock(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.FsLock {
+	return predicate.FsLock(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.FsLock {
+	return predicate.FsLock(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.FsLock {
+	return predicate.FsLock(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.FsLock {
+	return predicate.FsLock(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.FsLock {
+	return predicate.FsLock(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// Token applies equality check predicate on the "token" field. It's identical to TokenEQ.
+func Token(v string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldEQ(FieldToken, v))
+}
+
+// Ns applies equality check predicate on the "ns" field. It's identical to NsEQ.
+func Ns(v string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldEQ(FieldNs, v))
+}
+
+// Root applies equality check predicate on the "root" field. It's identical to RootEQ.
+func Root(v string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldEQ(FieldRoot, v))
+}
+
+// ZeroDepth applies equality check predicate on the "zero_depth" field. It's identical to ZeroDepthEQ.
+func ZeroDepth(v bool) predicate.FsLock {
+	return predicate.FsLock(sql.FieldEQ(FieldZeroDepth, v))
+}
+
+// FileType applies equality check predicate on the "file_type" field. It's identical to FileTypeEQ.
+func FileType(v int) predicate.FsLock {
+	return predicate.FsLock(sql.FieldEQ(FieldFileType, v))
+}
+
+// Owner applies equality check predicate on the "owner" field. It's identical to OwnerEQ.
+func Owner(v string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldEQ(FieldOwner, v))
+}
+
+// Duration applies equality check predicate on the "duration" field. It's identical to DurationEQ.
+func Duration(v int64) predicate.FsLock {
+	return predicate.FsLock(sql.FieldEQ(FieldDuration, v))
+}
+
+// ExpireAt applies equality check predicate on the "expire_at" field. It's identical to ExpireAtEQ.
+func ExpireAt(v time.Time) predicate.FsLock {
+	return predicate.FsLock(sql.FieldEQ(FieldExpireAt, v))
+}
+
+// HeldUntil applies equality check predicate on the "held_until" field. It's identical to HeldUntilEQ.
+func HeldUntil(v time.Time) predicate.FsLock {
+	return predicate.FsLock(sql.FieldEQ(FieldHeldUntil, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.FsLock {
+	return predicate.FsLock(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.FsLock {
+	return predicate.FsLock(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.FsLock {
+	return predicate.FsLock(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.FsLock {
+	return predicate.FsLock(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.FsLock {
+	return predicate.FsLock(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.FsLock {
+	return predicate.FsLock(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.FsLock {
+	return predicate.FsLock(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.FsLock {
+	return predicate.FsLock(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.FsLock {
+	return predicate.FsLock(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.FsLock {
+	return predicate.FsLock(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.FsLock {
+	return predicate.FsLock(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.FsLock {
+	return predicate.FsLock(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.FsLock {
+	return predicate.FsLock(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.FsLock {
+	return predicate.FsLock(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.FsLock {
+	return predicate.FsLock(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.FsLock {
+	return predicate.FsLock(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.FsLock {
+	return predicate.FsLock(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.FsLock {
+	return predicate.FsLock(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.FsLock {
+	return predicate.FsLock(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.FsLock {
+	return predicate.FsLock(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.FsLock {
+	return predicate.FsLock(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.FsLock {
+	return predicate.FsLock(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.FsLock {
+	return predicate.FsLock(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.FsLock {
+	return predicate.FsLock(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.FsLock {
+	return predicate.FsLock(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.FsLock {
+	return predicate.FsLock(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// TokenEQ applies the EQ predicate on the "token" field.
+func TokenEQ(v string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldEQ(FieldToken, v))
+}
+
+// TokenNEQ applies the NEQ predicate on the "token" field.
+func TokenNEQ(v string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldNEQ(FieldToken, v))
+}
+
+// TokenIn applies the In predicate on the "token" field.
+func TokenIn(vs ...string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldIn(FieldToken, vs...))
+}
+
+// TokenNotIn applies the NotIn predicate on the "token" field.
+func TokenNotIn(vs ...string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldNotIn(FieldToken, vs...))
+}
+
+// TokenGT applies the GT predicate on the "token" field.
+func TokenGT(v string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldGT(FieldToken, v))
+}
+
+// TokenGTE applies the GTE predicate on the "token" field.
+func TokenGTE(v string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldGTE(FieldToken, v))
+}
+
+// TokenLT applies the LT predicate on the "token" field.
+func TokenLT(v string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldLT(FieldToken, v))
+}
+
+// TokenLTE applies the LTE predicate on the "token" field.
+func TokenLTE(v string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldLTE(FieldToken, v))
+}
+
+// TokenContains applies the Contains predicate on the "token" field.
+func TokenContains(v string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldContains(FieldToken, v))
+}
+
+// TokenHasPrefix applies the HasPrefix predicate on the "token" field.
+func TokenHasPrefix(v string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldHasPrefix(FieldToken, v))
+}
+
+// TokenHasSuffix applies the HasSuffix predicate on the "token" field.
+func TokenHasSuffix(v string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldHasSuffix(FieldToken, v))
+}
+
+// TokenEqualFold applies the EqualFold predicate on the "token" field.
+func TokenEqualFold(v string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldEqualFold(FieldToken, v))
+}
+
+// TokenContainsFold applies the ContainsFold predicate on the "token" field.
+func TokenContainsFold(v string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldContainsFold(FieldToken, v))
+}
+
+// NsEQ applies the EQ predicate on the "ns" field.
+func NsEQ(v string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldEQ(FieldNs, v))
+}
+
+// NsNEQ applies the NEQ predicate on the "ns" field.
+func NsNEQ(v string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldNEQ(FieldNs, v))
+}
+
+// NsIn applies the In predicate on the "ns" field.
+func NsIn(vs ...string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldIn(FieldNs, vs...))
+}
+
+// NsNotIn applies the NotIn predicate on the "ns" field.
+func NsNotIn(vs ...string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldNotIn(FieldNs, vs...))
+}
+
+// NsGT applies the GT predicate on the "ns" field.
+func NsGT(v string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldGT(FieldNs, v))
+}
+
+// NsGTE applies the GTE predicate on the "ns" field.
+func NsGTE(v string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldGTE(FieldNs, v))
+}
+
+// NsLT applies the LT predicate on the "ns" field.
+func NsLT(v string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldLT(FieldNs, v))
+}
+
+// NsLTE applies the LTE predicate on the "ns" field.
+func NsLTE(v string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldLTE(FieldNs, v))
+}
+
+// NsContains applies the Contains predicate on the "ns" field.
+func NsContains(v string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldContains(FieldNs, v))
+}
+
+// NsHasPrefix applies the HasPrefix predicate on the "ns" field.
+func NsHasPrefix(v string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldHasPrefix(FieldNs, v))
+}
+
+// NsHasSuffix applies the HasSuffix predicate on the "ns" field.
+func NsHasSuffix(v string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldHasSuffix(FieldNs, v))
+}
+
+// NsEqualFold applies the EqualFold predicate on the "ns" field.
+func NsEqualFold(v string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldEqualFold(FieldNs, v))
+}
+
+// NsContainsFold applies the ContainsFold predicate on the "ns" field.
+func NsContainsFold(v string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldContainsFold(FieldNs, v))
+}
+
+// RootEQ applies the EQ predicate on the "root" field.
+func RootEQ(v string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldEQ(FieldRoot, v))
+}
+
+// RootNEQ applies the NEQ predicate on the "root" field.
+func RootNEQ(v string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldNEQ(FieldRoot, v))
+}
+
+// RootIn applies the In predicate on the "root" field.
+func RootIn(vs ...string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldIn(FieldRoot, vs...))
+}
+
+// RootNotIn applies the NotIn predicate on the "root" field.
+func RootNotIn(vs ...string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldNotIn(FieldRoot, vs...))
+}
+
+// RootGT applies the GT predicate on the "root" field.
+func RootGT(v string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldGT(FieldRoot, v))
+}
+
+// RootGTE applies the GTE predicate on the "root" field.
+func RootGTE(v string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldGTE(FieldRoot, v))
+}
+
+// RootLT applies the LT predicate on the "root" field.
+func RootLT(v string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldLT(FieldRoot, v))
+}
+
+// RootLTE applies the LTE predicate on the "root" field.
+func RootLTE(v string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldLTE(FieldRoot, v))
+}
+
+// RootContains applies the Contains predicate on the "root" field.
+func RootContains(v string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldContains(FieldRoot, v))
+}
+
+// RootHasPrefix applies the HasPrefix predicate on the "root" field.
+func RootHasPrefix(v string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldHasPrefix(FieldRoot, v))
+}
+
+// RootHasSuffix applies the HasSuffix predicate on the "root" field.
+func RootHasSuffix(v string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldHasSuffix(FieldRoot, v))
+}
+
+// RootEqualFold applies the EqualFold predicate on the "root" field.
+func RootEqualFold(v string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldEqualFold(FieldRoot, v))
+}
+
+// RootContainsFold applies the ContainsFold predicate on the "root" field.
+func RootContainsFold(v string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldContainsFold(FieldRoot, v))
+}
+
+// ZeroDepthEQ applies the EQ predicate on the "zero_depth" field.
+func ZeroDepthEQ(v bool) predicate.FsLock {
+	return predicate.FsLock(sql.FieldEQ(FieldZeroDepth, v))
+}
+
+// ZeroDepthNEQ applies the NEQ predicate on the "zero_depth" field.
+func ZeroDepthNEQ(v bool) predicate.FsLock {
+	return predicate.FsLock(sql.FieldNEQ(FieldZeroDepth, v))
+}
+
+// FileTypeEQ applies the EQ predicate on the "file_type" field.
+func FileTypeEQ(v int) predicate.FsLock {
+	return predicate.FsLock(sql.FieldEQ(FieldFileType, v))
+}
+
+// FileTypeNEQ applies the NEQ predicate on the "file_type" field.
+func FileTypeNEQ(v int) predicate.FsLock {
+	return predicate.FsLock(sql.FieldNEQ(FieldFileType, v))
+}
+
+// FileTypeIn applies the In predicate on the "file_type" field.
+func FileTypeIn(vs ...int) predicate.FsLock {
+	return predicate.FsLock(sql.FieldIn(FieldFileType, vs...))
+}
+
+// FileTypeNotIn applies the NotIn predicate on the "file_type" field.
+func FileTypeNotIn(vs ...int) predicate.FsLock {
+	return predicate.FsLock(sql.FieldNotIn(FieldFileType, vs...))
+}
+
+// FileTypeGT applies the GT predicate on the "file_type" field.
+func FileTypeGT(v int) predicate.FsLock {
+	return predicate.FsLock(sql.FieldGT(FieldFileType, v))
+}
+
+// FileTypeGTE applies the GTE predicate on the "file_type" field.
+func FileTypeGTE(v int) predicate.FsLock {
+	return predicate.FsLock(sql.FieldGTE(FieldFileType, v))
+}
+
+// FileTypeLT applies the LT predicate on the "file_type" field.
+func FileTypeLT(v int) predicate.FsLock {
+	return predicate.FsLock(sql.FieldLT(FieldFileType, v))
+}
+
+// FileTypeLTE applies the LTE predicate on the "file_type" field.
+func FileTypeLTE(v int) predicate.FsLock {
+	return predicate.FsLock(sql.FieldLTE(FieldFileType, v))
+}
+
+// OwnerEQ applies the EQ predicate on the "owner" field.
+func OwnerEQ(v string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldEQ(FieldOwner, v))
+}
+
+// OwnerNEQ applies the NEQ predicate on the "owner" field.
+func OwnerNEQ(v string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldNEQ(FieldOwner, v))
+}
+
+// OwnerIn applies the In predicate on the "owner" field.
+func OwnerIn(vs ...string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldIn(FieldOwner, vs...))
+}
+
+// OwnerNotIn applies the NotIn predicate on the "owner" field.
+func OwnerNotIn(vs ...string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldNotIn(FieldOwner, vs...))
+}
+
+// OwnerGT applies the GT predicate on the "owner" field.
+func OwnerGT(v string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldGT(FieldOwner, v))
+}
+
+// OwnerGTE applies the GTE predicate on the "owner" field.
+func OwnerGTE(v string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldGTE(FieldOwner, v))
+}
+
+// OwnerLT applies the LT predicate on the "owner" field.
+func OwnerLT(v string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldLT(FieldOwner, v))
+}
+
+// OwnerLTE applies the LTE predicate on the "owner" field.
+func OwnerLTE(v string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldLTE(FieldOwner, v))
+}
+
+// OwnerContains applies the Contains predicate on the "owner" field.
+func OwnerContains(v string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldContains(FieldOwner, v))
+}
+
+// OwnerHasPrefix applies the HasPrefix predicate on the "owner" field.
+func OwnerHasPrefix(v string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldHasPrefix(FieldOwner, v))
+}
+
+// OwnerHasSuffix applies the HasSuffix predicate on the "owner" field.
+func OwnerHasSuffix(v string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldHasSuffix(FieldOwner, v))
+}
+
+// OwnerIsNil applies the IsNil predicate on the "owner" field.
+func OwnerIsNil() predicate.FsLock {
+	return predicate.FsLock(sql.FieldIsNull(FieldOwner))
+}
+
+// OwnerNotNil applies the NotNil predicate on the "owner" field.
+func OwnerNotNil() predicate.FsLock {
+	return predicate.FsLock(sql.FieldNotNull(FieldOwner))
+}
+
+// OwnerEqualFold applies the EqualFold predicate on the "owner" field.
+func OwnerEqualFold(v string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldEqualFold(FieldOwner, v))
+}
+
+// OwnerContainsFold applies the ContainsFold predicate on the "owner" field.
+func OwnerContainsFold(v string) predicate.FsLock {
+	return predicate.FsLock(sql.FieldContainsFold(FieldOwner, v))
+}
+
+// DurationEQ applies the EQ predicate on the "duration" field.
+func DurationEQ(v int64) predicate.FsLock {
+	return predicate.FsLock(sql.FieldEQ(FieldDuration, v))
+}
+
+// DurationNEQ applies the NEQ predicate on the "duration" field.
+func DurationNEQ(v int64) predicate.FsLock {
+	return predicate.FsLock(sql.FieldNEQ(FieldDuration, v))
+}
+
+// DurationIn applies the In predicate on the "duration" field.
+func DurationIn(vs ...int64) predicate.FsLock {
+	return predicate.FsLock(sql.FieldIn(FieldDuration, vs...))
+}
+
+// DurationNotIn applies the NotIn predicate on the "duration" field.
+func DurationNotIn(vs ...int64) predicate.FsLock {
+	return predicate.FsLock(sql.FieldNotIn(FieldDuration, vs...))
+}
+
+// DurationGT applies the GT predicate on the "duration" field.
+func DurationGT(v int64) predicate.FsLock {
+	return predicate.FsLock(sql.FieldGT(FieldDuration, v))
+}
+
+// DurationGTE applies the GTE predicate on the "duration" field.
+func DurationGTE(v int64) predicate.FsLock {
+	return predicate.FsLock(sql.FieldGTE(FieldDuration, v))
+}
+
+// DurationLT applies the LT predicate on the "duration" field.
+func DurationLT(v int64) predicate.FsLock {
+	return predicate.FsLock(sql.FieldLT(FieldDuration, v))
+}
+
+// DurationLTE applies the LTE predicate on the "duration" field.
+func DurationLTE(v int64) predicate.FsLock {
+	return predicate.FsLock(sql.FieldLTE(FieldDuration, v))
+}
+
+// ExpireAtEQ applies the EQ predicate on the "expire_at" field.
+func ExpireAtEQ(v time.Time) predicate.FsLock {
+	return predicate.FsLock(sql.FieldEQ(FieldExpireAt, v))
+}
+
+// ExpireAtNEQ applies the NEQ predicate on the "expire_at" field.
+func ExpireAtNEQ(v time.Time) predicate.FsLock {
+	return predicate.FsLock(sql.FieldNEQ(FieldExpireAt, v))
+}
+
+// ExpireAtIn applies the In predicate on the "expire_at" field.
+func ExpireAtIn(vs ...time.Time) predicate.FsLock {
+	return predicate.FsLock(sql.FieldIn(FieldExpireAt, vs...))
+}
+
+// ExpireAtNotIn applies the NotIn predicate on the "expire_at" field.
+func ExpireAtNotIn(vs ...time.Time) predicate.FsLock {
+	return predicate.FsLock(sql.FieldNotIn(FieldExpireAt, vs...))
+}
+
+// ExpireAtGT applies the GT predicate on the "expire_at" field.
+func ExpireAtGT(v time.Time) predicate.FsLock {
+	return predicate.FsLock(sql.FieldGT(FieldExpireAt, v))
+}
+
+// ExpireAtGTE applies the GTE predicate on the "expire_at" field.
+func ExpireAtGTE(v time.Time) predicate.FsLock {
+	return predicate.FsLock(sql.FieldGTE(FieldExpireAt, v))
+}
+
+// ExpireAtLT applies the LT predicate on the "expire_at" field.
+func ExpireAtLT(v time.Time) predicate.FsLock {
+	return predicate.FsLock(sql.FieldLT(FieldExpireAt, v))
+}
+
+// ExpireAtLTE applies the LTE predicate on the "expire_at" field.
+func ExpireAtLTE(v time.Time) predicate.FsLock {
+	return predicate.FsLock(sql.FieldLTE(FieldExpireAt, v))
+}
+
+// ExpireAtIsNil applies the IsNil predicate on the "expire_at" field.
+func ExpireAtIsNil() predicate.FsLock {
+	return predicate.FsLock(sql.FieldIsNull(FieldExpireAt))
+}
+
+// ExpireAtNotNil applies the NotNil predicate on the "expire_at" field.
+func ExpireAtNotNil() predicate.FsLock {
+	return predicate.FsLock(sql.FieldNotNull(FieldExpireAt))
+}
+
+// HeldUntilEQ applies the EQ predicate on the "held_until" field.
+func HeldUntilEQ(v time.Time) predicate.FsLock {
+	return predicate.FsLock(sql.FieldEQ(FieldHeldUntil, v))
+}
+
+// HeldUntilNEQ applies the NEQ predicate on the "held_until" field.
+func HeldUntilNEQ(v time.Time) predicate.FsLock {
+	return predicate.FsLock(sql.FieldNEQ(FieldHeldUntil, v))
+}
+
+// HeldUntilIn applies the In predicate on the "held_until" field.
+func HeldUntilIn(vs ...time.Time) predicate.FsLock {
+	return predicate.FsLock(sql.FieldIn(FieldHeldUntil, vs...))
+}
+
+// HeldUntilNotIn applies the NotIn predicate on the "held_until" field.
+func HeldUntilNotIn(vs ...time.Time) predicate.FsLock {
+	return predicate.FsLock(sql.FieldNotIn(FieldHeldUntil, vs...))
+}
+
+// HeldUntilGT applies the GT predicate on the "held_until" field.
+func HeldUntilGT(v time.Time) predicate.FsLock {
+	return predicate.FsLock(sql.FieldGT(FieldHeldUntil, v))
+}
+
+// HeldUntilGTE applies the GTE predicate on the "held_until" field.
+func HeldUntilGTE(v time.Time) predicate.FsLock {
+	return predicate.FsLock(sql.FieldGTE(FieldHeldUntil, v))
+}
+
+// HeldUntilLT applies the LT predicate on the "held_until" field.
+func HeldUntilLT(v time.Time) predicate.FsLock {
+	return predicate.FsLock(sql.FieldLT(FieldHeldUntil, v))
+}
+
+// HeldUntilLTE applies the LTE predicate on the "held_until" field.
+func HeldUntilLTE(v time.Time) predicate.FsLock {
+	return predicate.FsLock(sql.FieldLTE(FieldHeldUntil, v))
+}
+
+// HeldUntilIsNil applies the IsNil predicate on the "held_until" field.
+func HeldUntilIsNil() predicate.FsLock {
+	return predicate.FsLock(sql.FieldIsNull(FieldHeldUntil))
+}
+
+// HeldUntilNotNil applies the NotNil predicate on the "held_until" field.
+func HeldUntilNotNil() predicate.FsLock {
+	return predicate.FsLock(sql.FieldNotNull(FieldHeldUntil))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.FsLock) predicate.FsLock {
+	return predicate.FsLock(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.FsLock) predicate.FsLock {
+	return predicate.FsLock(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.FsLock) predicate.FsLock {
+	return predicate.FsLock(sql.NotPredicates(p))
+}
@@ -0,0 +1,1237 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/fslock"
+)
+
+// FsLockCreate is the builder for creating a FsLock entity.
+type FsLockCreate struct {
+	config
+	mutation *FsLockMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (flc *FsLockCreate) SetCreatedAt(t time.Time) *FsLockCreate {
+	flc.mutation.SetCreatedAt(t)
+	return flc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (flc *FsLockCreate) SetNillableCreatedAt(t *time.Time) *FsLockCreate {
+	if t != nil {
+		flc.SetCreatedAt(*t)
+	}
+	return flc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (flc *FsLockCreate) SetUpdatedAt(t time.Time) *FsLockCreate {
+	flc.mutation.SetUpdatedAt(t)
+	return flc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (flc *FsLockCreate) SetNillableUpdatedAt(t *time.Time) *FsLockCreate {
+	if t != nil {
+		flc.SetUpdatedAt(*t)
+	}
+	return flc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (flc *FsLockCreate) SetDeletedAt(t time.Time) *FsLockCreate {
+	flc.mutation.SetDeletedAt(t)
+	return flc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (flc *FsLockCreate) SetNillableDeletedAt(t *time.Time) *FsLockCreate {
+	if t != nil {
+		flc.SetDeletedAt(*t)
+	}
+	return flc
+}
+
+// SetToken sets the "token" field.
+func (flc *FsLockCreate) SetToken(s string) *FsLockCreate {
+	flc.mutation.SetToken(s)
+	return flc
+}
+
+// SetNs sets the "ns" field.
+func (flc *FsLockCreate) SetNs(s string) *FsLockCreate {
+	flc.mutation.SetNs(s)
+	return flc
+}
+
+// SetRoot sets the "root" field.
+func (flc *FsLockCreate) SetRoot(s string) *FsLockCreate {
+	flc.mutation.SetRoot(s)
+	return flc
+}
+
+// SetZeroDepth sets the "zero_depth" field.
+func (flc *FsLockCreate) SetZeroDepth(b bool) *FsLockCreate {
+	flc.mutation.SetZeroDepth(b)
+	return flc
+}
+
+// SetNillableZeroDepth sets the "zero_depth" field if the given value is not nil.
+func (flc *FsLockCreate) SetNillableZeroDepth(b *bool) *FsLockCreate {
+	if b != nil {
+		flc.SetZeroDepth(*b)
+	}
+	return flc
+}
+
+// SetFileType sets the "file_type" field.
+func (flc *FsLockCreate) SetFileType(i int) *FsLockCreate {
+	flc.mutation.SetFileType(i)
+	return flc
+}
+
+// SetNillableFileType sets the "file_type" field if the given value is not nil.
+func (flc *FsLockCreate) SetNillableFileType(i *int) *FsLockCreate {
+	if i != nil {
+		flc.SetFileType(*i)
+	}
+	return flc
+}
+
+// SetOwner sets the "owner" field.
+func (flc *FsLockCreate) SetOwner(s string) *FsLockCreate {
+	flc.mutation.SetOwner(s)
+	return flc
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (flc *FsLockCreate) SetNillableOwner(s *string) *FsLockCreate {
+	if s != nil {
+		flc.SetOwner(*s)
+	}
+	return flc
+}
+
+// SetDuration sets the "duration" field.
+func (flc *FsLockCreate) SetDuration(i int64) *FsLockCreate {
+	flc.mutation.SetDuration(i)
+	return flc
+}
+
+// SetNillableDuration sets the "duration" field if the given value is not nil.
+func (flc *FsLockCreate) SetNillableDuration(i *int64) *FsLockCreate {
+	if i != nil {
+		flc.SetDuration(*i)
+	}
+	return flc
+}
+
+// SetExpireAt sets the "expire_at" field.
+func (flc *FsLockCreate) SetExpireAt(t time.Time) *FsLockCreate {
+	flc.mutation.SetExpireAt(t)
+	return flc
+}
+
+// SetNillableExpireAt sets the "expire_at" field if the given value is not nil.
+func (flc *FsLockCreate) SetNillableExpireAt(t *time.Time) *FsLockCreate {
+	if t != nil {
+		flc.SetExpireAt(*t)
+	}
+	return flc
+}
+
+// SetHeldUntil sets the "held_until" field.
+func (flc *FsLockCreate) SetHeldUntil(t time.Time) *FsLockCreate {
+	flc.mutation.SetHeldUntil(t)
+	return flc
+}
+
+// SetNillableHeldUntil sets the "held_until" field if the given value is not nil.
+func (flc *FsLockCreate) SetNillableHeldUntil(t *time.Time) *FsLockCreate {
+	if t != nil {
+		flc.SetHeldUntil(*t)
+	}
+	return flc
+}
+
+// Mutation returns the FsLockMutation object of the builder.
+func (flc *FsLockCreate) Mutation() *FsLockMutation {
+	return flc.mutation
+}
+
+// Save creates the FsLock in the database.
+func (flc *FsLockCreate) Save(ctx context.Context) (*FsLock, error) {
+	if err := flc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, flc.sqlSave, flc.mutation, flc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (flc *FsLockCreate) SaveX(ctx context.Context) *FsLock {
+	v, err := flc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (flc *FsLockCreate) Exec(ctx context.Context) error {
+	_, err := flc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (flc *FsLockCreate) ExecX(ctx context.Context) {
+	if err := flc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (flc *FsLockCreate) defaults() error {
+	if _, ok := flc.mutation.CreatedAt(); !ok {
+		if fslock.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized fslock.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := fslock.DefaultCreatedAt()
+		flc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := flc.mutation.UpdatedAt(); !ok {
+		if fslock.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized fslock.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := fslock.DefaultUpdatedAt()
+		flc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := flc.mutation.ZeroDepth(); !ok {
+		v := fslock.DefaultZeroDepth
+		flc.mutation.SetZeroDepth(v)
+	}
+	if _, ok := flc.mutation.FileType(); !ok {
+		v := fslock.DefaultFileType
+		flc.mutation.SetFileType(v)
+	}
+	if _, ok := flc.mutation.Duration(); !ok {
+		v := fslock.DefaultDuration
+		flc.mutation.SetDuration(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (flc *FsLockCreate) check() error {
+	if _, ok := flc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "FsLock.created_at"`)}
+	}
+	if _, ok := flc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "FsLock.updated_at"`)}
+	}
+	if _, ok := flc.mutation.Token(); !ok {
+		return &ValidationError{Name: "token", err: errors.New(`ent: missing required field "FsLock.token"`)}
+	}
+	if _, ok := flc.mutation.Ns(); !ok {
+		return &ValidationError{Name: "ns", err: errors.New(`ent: missing required field "FsLock.ns"`)}
+	}
+	if _, ok := flc.mutation.Root(); !ok {
+		return &ValidationError{Name: "root", err: errors.New(`ent: missing required field "FsLock.root"`)}
+	}
+	if _, ok := flc.mutation.ZeroDepth(); !ok {
+		return &ValidationError{Name: "zero_depth", err: errors.New(`ent: missing required field "FsLock.zero_depth"`)}
+	}
+	if _, ok := flc.mutation.FileType(); !ok {
+		return &ValidationError{Name: "file_type", err: errors.New(`ent: missing required field "FsLock.file_type"`)}
+	}
+	if _, ok := flc.mutation.Duration(); !ok {
+		return &ValidationError{Name: "duration", err: errors.New(`ent: missing required field "FsLock.duration"`)}
+	}
+	return nil
+}
+
+func (flc *FsLockCreate) sqlSave(ctx context.Context) (*FsLock, error) {
+	if err := flc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := flc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, flc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	flc.mutation.id = &_node.ID
+	flc.mutation.done = true
+	return _node, nil
+}
+
+func (flc *FsLockCreate) createSpec() (*FsLock, *sqlgraph.CreateSpec) {
+	var (
+		_node = &FsLock{config: flc.config}
+		_spec = sqlgraph.NewCreateSpec(fslock.Table, sqlgraph.NewFieldSpec(fslock.FieldID, field.TypeInt))
+	)
+
+	if id, ok := flc.mutation.ID(); ok {
+		_node.ID = id
+		id64 := int64(id)
+		_spec.ID.Value = id64
+	}
+
+	_spec.OnConflict = flc.conflict
+	if value, ok := flc.mutation.CreatedAt(); ok {
+		_spec.SetField(fslock.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := flc.mutation.UpdatedAt(); ok {
+		_spec.SetField(fslock.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := flc.mutation.DeletedAt(); ok {
+		_spec.SetField(fslock.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := flc.mutation.Token(); ok {
+		_spec.SetField(fslock.FieldToken, field.TypeString, value)
+		_node.Token = value
+	}
+	if value, ok := flc.mutation.Ns(); ok {
+		_spec.SetField(fslock.FieldNs, field.TypeString, value)
+		_node.Ns = value
+	}
+	if value, ok := flc.mutation.Root(); ok {
+		_spec.SetField(fslock.FieldRoot, field.TypeString, value)
+		_node.Root = value
+	}
+	if value, ok := flc.mutation.ZeroDepth(); ok {
+		_spec.SetField(fslock.FieldZeroDepth, field.TypeBool, value)
+		_node.ZeroDepth = value
+	}
+	if value, ok := flc.mutation.FileType(); ok {
+		_spec.SetField(fslock.FieldFileType, field.TypeInt, value)
+		_node.FileType = value
+	}
+	if value, ok := flc.mutation.Owner(); ok {
+		_spec.SetField(fslock.FieldOwner, field.TypeString, value)
+		_node.Owner = value
+	}
+	if value, ok := flc.mutation.Duration(); ok {
+		_spec.SetField(fslock.FieldDuration, field.TypeInt64, value)
+		_node.Duration = value
+	}
+	if value, ok := flc.mutation.ExpireAt(); ok {
+		_spec.SetField(fslock.FieldExpireAt, field.TypeTime, value)
+		_node.ExpireAt = &value
+	}
+	if value, ok := flc.mutation.HeldUntil(); ok {
+		_spec.SetField(fslock.FieldHeldUntil, field.TypeTime, value)
+		_node.HeldUntil = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.FsLock.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.FsLockUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (flc *FsLockCreate) OnConflict(opts ...sql.ConflictOption) *FsLockUpsertOne {
+	flc.conflict = opts
+	return &FsLockUpsertOne{
+		create: flc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.FsLock.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (flc *FsLockCreate) OnConflictColumns(columns ...string) *FsLockUpsertOne {
+	flc.conflict = append(flc.conflict, sql.ConflictColumns(columns...))
+	return &FsLockUpsertOne{
+		create: flc,
+	}
+}
+
+type (
+	// FsLockUpsertOne is the builder for "upsert"-ing
+	//  one FsLock node.
+	FsLockUpsertOne struct {
+		create *FsLockCreate
+	}
+
+	// FsLockUpsert is the "OnConflict" setter.
+	FsLockUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *FsLockUpsert) SetUpdatedAt(v time.Time) *FsLockUpsert {
+	u.Set(fslock.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *FsLockUpsert) UpdateUpdatedAt() *FsLockUpsert {
+	u.SetExcluded(fslock.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *FsLockUpsert) SetDeletedAt(v time.Time) *FsLockUpsert {
+	u.Set(fslock.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *FsLockUpsert) UpdateDeletedAt() *FsLockUpsert {
+	u.SetExcluded(fslock.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *FsLockUpsert) ClearDeletedAt() *FsLockUpsert {
+	u.SetNull(fslock.FieldDeletedAt)
+	return u
+}
+
+// SetToken sets the "token" field.
+func (u *FsLockUpsert) SetToken(v string) *FsLockUpsert {
+	u.Set(fslock.FieldToken, v)
+	return u
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *FsLockUpsert) UpdateToken() *FsLockUpsert {
+	u.SetExcluded(fslock.FieldToken)
+	return u
+}
+
+// SetNs sets the "ns" field.
+func (u *FsLockUpsert) SetNs(v string) *FsLockUpsert {
+	u.Set(fslock.FieldNs, v)
+	return u
+}
+
+// UpdateNs sets the "ns" field to the value that was provided on create.
+func (u *FsLockUpsert) UpdateNs() *FsLockUpsert {
+	u.SetExcluded(fslock.FieldNs)
+	return u
+}
+
+// SetRoot sets the "root" field.
+func (u *FsLockUpsert) SetRoot(v string) *FsLockUpsert {
+	u.Set(fslock.FieldRoot, v)
+	return u
+}
+
+// UpdateRoot sets the "root" field to the value that was provided on create.
+func (u *FsLockUpsert) UpdateRoot() *FsLockUpsert {
+	u.SetExcluded(fslock.FieldRoot)
+	return u
+}
+
+// SetZeroDepth sets the "zero_depth" field.
+func (u *FsLockUpsert) SetZeroDepth(v bool) *FsLockUpsert {
+	u.Set(fslock.FieldZeroDepth, v)
+	return u
+}
+
+// UpdateZeroDepth sets the "zero_depth" field to the value that was provided on create.
+func (u *FsLockUpsert) UpdateZeroDepth() *FsLockUpsert {
+	u.SetExcluded(fslock.FieldZeroDepth)
+	return u
+}
+
+// SetFileType sets the "file_type" field.
+func (u *FsLockUpsert) SetFileType(v int) *FsLockUpsert {
+	u.Set(fslock.FieldFileType, v)
+	return u
+}
+
+// UpdateFileType sets the "file_type" field to the value that was provided on create.
+func (u *FsLockUpsert) UpdateFileType() *FsLockUpsert {
+	u.SetExcluded(fslock.FieldFileType)
+	return u
+}
+
+// AddFileType adds v to the "file_type" field.
+func (u *FsLockUpsert) AddFileType(v int) *FsLockUpsert {
+	u.Add(fslock.FieldFileType, v)
+	return u
+}
+
+// SetOwner sets the "owner" field.
+func (u *FsLockUpsert) SetOwner(v string) *FsLockUpsert {
+	u.Set(fslock.FieldOwner, v)
+	return u
+}
+
+// UpdateOwner sets the "owner" field to the value that was provided on create.
+func (u *FsLockUpsert) UpdateOwner() *FsLockUpsert {
+	u.SetExcluded(fslock.FieldOwner)
+	return u
+}
+
+// ClearOwner clears the value of the "owner" field.
+func (u *FsLockUpsert) ClearOwner() *FsLockUpsert {
+	u.SetNull(fslock.FieldOwner)
+	return u
+}
+
+// SetDuration sets the "duration" field.
+func (u *FsLockUpsert) SetDuration(v int64) *FsLockUpsert {
+	u.Set(fslock.FieldDuration, v)
+	return u
+}
+
+// UpdateDuration sets the "duration" field to the value that was provided on create.
+func (u *FsLockUpsert) UpdateDuration() *FsLockUpsert {
+	u.SetExcluded(fslock.FieldDuration)
+	return u
+}
+
+// AddDuration adds v to the "duration" field.
+func (u *FsLockUpsert) AddDuration(v int64) *FsLockUpsert {
+	u.Add(fslock.FieldDuration, v)
+	return u
+}
+
+// SetExpireAt sets the "expire_at" field.
+func (u *FsLockUpsert) SetExpireAt(v time.Time) *FsLockUpsert {
+	u.Set(fslock.FieldExpireAt, v)
+	return u
+}
+
+// UpdateExpireAt sets the "expire_at" field to the value that was provided on create.
+func (u *FsLockUpsert) UpdateExpireAt() *FsLockUpsert {
+	u.SetExcluded(fslock.FieldExpireAt)
+	return u
+}
+
+// ClearExpireAt clears the value of the "expire_at" field.
+func (u *FsLockUpsert) ClearExpireAt() *FsLockUpsert {
+	u.SetNull(fslock.FieldExpireAt)
+	return u
+}
+
+// SetHeldUntil sets the "held_until" field.
+func (u *FsLockUpsert) SetHeldUntil(v time.Time) *FsLockUpsert {
+	u.Set(fslock.FieldHeldUntil, v)
+	return u
+}
+
+// UpdateHeldUntil sets the "held_until" field to the value that was provided on create.
+func (u *FsLockUpsert) UpdateHeldUntil() *FsLockUpsert {
+	u.SetExcluded(fslock.FieldHeldUntil)
+	return u
+}
+
+// ClearHeldUntil clears the value of the "held_until" field.
+func (u *FsLockUpsert) ClearHeldUntil() *FsLockUpsert {
+	u.SetNull(fslock.FieldHeldUntil)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.FsLock.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *FsLockUpsertOne) UpdateNewValues() *FsLockUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(fslock.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.FsLock.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *FsLockUpsertOne) Ignore() *FsLockUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *FsLockUpsertOne) DoNothing() *FsLockUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the FsLockCreate.OnConflict
+// documentation for more info.
+func (u *FsLockUpsertOne) Update(set func(*FsLockUpsert)) *FsLockUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&FsLockUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *FsLockUpsertOne) SetUpdatedAt(v time.Time) *FsLockUpsertOne {
+	return u.Update(func(s *FsLockUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *FsLockUpsertOne) UpdateUpdatedAt() *FsLockUpsertOne {
+	return u.Update(func(s *FsLockUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *FsLockUpsertOne) SetDeletedAt(v time.Time) *FsLockUpsertOne {
+	return u.Update(func(s *FsLockUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *FsLockUpsertOne) UpdateDeletedAt() *FsLockUpsertOne {
+	return u.Update(func(s *FsLockUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *FsLockUpsertOne) ClearDeletedAt() *FsLockUpsertOne {
+	return u.Update(func(s *FsLockUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetToken sets the "token" field.
+func (u *FsLockUpsertOne) SetToken(v string) *FsLockUpsertOne {
+	return u.Update(func(s *FsLockUpsert) {
+		s.SetToken(v)
+	})
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *FsLockUpsertOne) UpdateToken() *FsLockUpsertOne {
+	return u.Update(func(s *FsLockUpsert) {
+		s.UpdateToken()
+	})
+}
+
+// SetNs sets the "ns" field.
+func (u *FsLockUpsertOne) SetNs(v string) *FsLockUpsertOne {
+	return u.Update(func(s *FsLockUpsert) {
+		s.SetNs(v)
+	})
+}
+
+// UpdateNs sets the "ns" field to the value that was provided on create.
+func (u *FsLockUpsertOne) UpdateNs() *FsLockUpsertOne {
+	return u.Update(func(s *FsLockUpsert) {
+		s.UpdateNs()
+	})
+}
+
+// SetRoot sets the "root" field.
+func (u *FsLockUpsertOne) SetRoot(v string) *FsLockUpsertOne {
+	return u.Update(func(s *FsLockUpsert) {
+		s.SetRoot(v)
+	})
+}
+
+// UpdateRoot sets the "root" field to the value that was provided on create.
+func (u *FsLockUpsertOne) UpdateRoot() *FsLockUpsertOne {
+	return u.Update(func(s *FsLockUpsert) {
+		s.UpdateRoot()
+	})
+}
+
+// SetZeroDepth sets the "zero_depth" field.
+func (u *FsLockUpsertOne) SetZeroDepth(v bool) *FsLockUpsertOne {
+	return u.Update(func(s *FsLockUpsert) {
+		s.SetZeroDepth(v)
+	})
+}
+
+// UpdateZeroDepth sets the "zero_depth" field to the value that was provided on create.
+func (u *FsLockUpsertOne) UpdateZeroDepth() *FsLockUpsertOne {
+	return u.Update(func(s *FsLockUpsert) {
+		s.UpdateZeroDepth()
+	})
+}
+
+// SetFileType sets the "file_type" field.
+func (u *FsLockUpsertOne) SetFileType(v int) *FsLockUpsertOne {
+	return u.Update(func(s *FsLockUpsert) {
+		s.SetFileType(v)
+	})
+}
+
+// AddFileType adds v to the "file_type" field.
+func (u *FsLockUpsertOne) AddFileType(v int) *FsLockUpsertOne {
+	return u.Update(func(s *FsLockUpsert) {
+		s.AddFileType(v)
+	})
+}
+
+// UpdateFileType sets the "file_type" field to the value that was provided on create.
+func (u *FsLockUpsertOne) UpdateFileType() *FsLockUpsertOne {
+	return u.Update(func(s *FsLockUpsert) {
+		s.UpdateFileType()
+	})
+}
+
+// SetOwner sets the "owner" field.
+func (u *FsLockUpsertOne) SetOwner(v string) *FsLockUpsertOne {
+	return u.Update(func(s *FsLockUpsert) {
+		s.SetOwner(v)
+	})
+}
+
+// UpdateOwner sets the "owner" field to the value that was provided on create.
+func (u *FsLockUpsertOne) UpdateOwner() *FsLockUpsertOne {
+	return u.Update(func(s *FsLockUpsert) {
+		s.UpdateOwner()
+	})
+}
+
+// ClearOwner clears the value of the "owner" field.
+func (u *FsLockUpsertOne) ClearOwner() *FsLockUpsertOne {
+	return u.Update(func(s *FsLockUpsert) {
+		s.ClearOwner()
+	})
+}
+
+// SetDuration sets the "duration" field.
+func (u *FsLockUpsertOne) SetDuration(v int64) *FsLockUpsertOne {
+	return u.Update(func(s *FsLockUpsert) {
+		s.SetDuration(v)
+	})
+}
+
+// AddDuration adds v to the "duration" field.
+func (u *FsLockUpsertOne) AddDuration(v int64) *FsLockUpsertOne {
+	return u.Update(func(s *FsLockUpsert) {
+		s.AddDuration(v)
+	})
+}
+
+// UpdateDuration sets the "duration" field to the value that was provided on create.
+func (u *FsLockUpsertOne) UpdateDuration() *FsLockUpsertOne {
+	return u.Update(func(s *FsLockUpsert) {
+		s.UpdateDuration()
+	})
+}
+
+// SetExpireAt sets the "expire_at" field.
+func (u *FsLockUpsertOne) SetExpireAt(v time.Time) *FsLockUpsertOne {
+	return u.Update(func(s *FsLockUpsert) {
+		s.SetExpireAt(v)
+	})
+}
+
+// UpdateExpireAt sets the "expire_at" field to the value that was provided on create.
+func (u *FsLockUpsertOne) UpdateExpireAt() *FsLockUpsertOne {
+	return u.Update(func(s *FsLockUpsert) {
+		s.UpdateExpireAt()
+	})
+}
+
+// ClearExpireAt clears the value of the "expire_at" field.
+func (u *FsLockUpsertOne) ClearExpireAt() *FsLockUpsertOne {
+	return u.Update(func(s *FsLockUpsert) {
+		s.ClearExpireAt()
+	})
+}
+
+// SetHeldUntil sets the "held_until" field.
+func (u *FsLockUpsertOne) SetHeldUntil(v time.Time) *FsLockUpsertOne {
+	return u.Update(func(s *FsLockUpsert) {
+		s.SetHeldUntil(v)
+	})
+}
+
+// UpdateHeldUntil sets the "held_until" field to the value that was provided on create.
+func (u *FsLockUpsertOne) UpdateHeldUntil() *FsLockUpsertOne {
+	return u.Update(func(s *FsLockUpsert) {
+		s.UpdateHeldUntil()
+	})
+}
+
+// ClearHeldUntil clears the value of the "held_until" field.
+func (u *FsLockUpsertOne) ClearHeldUntil() *FsLockUpsertOne {
+	return u.Update(func(s *FsLockUpsert) {
+		s.ClearHeldUntil()
+	})
+}
+
+// Exec executes the query.
+func (u *FsLockUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for FsLockCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *FsLockUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *FsLockUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *FsLockUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+func (m *FsLockCreate) SetRawID(t int) *FsLockCreate {
+	m.mutation.SetRawID(t)
+	return m
+}
+
+// FsLockCreateBulk is the builder for creating many FsLock entities in bulk.
+type FsLockCreateBulk struct {
+	config
+	err      error
+	builders []*FsLockCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the FsLock entities in the database.
+func (flcb *FsLockCreateBulk) Save(ctx context.Context) ([]*FsLock, error) {
+	if flcb.err != nil {
+		return nil, flcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(flcb.builders))
+	nodes := make([]*FsLock, len(flcb.builders))
+	mutators := make([]Mutator, len(flcb.builders))
+	for i := range flcb.builders {
+		func(i int, root context.Context) {
+			builder := flcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FsLockMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, flcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = flcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, flcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, flcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (flcb *FsLockCreateBulk) SaveX(ctx context.Context) []*FsLock {
+	v, err := flcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (flcb *FsLockCreateBulk) Exec(ctx context.Context) error {
+	_, err := flcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (flcb *FsLockCreateBulk) ExecX(ctx context.Context) {
+	if err := flcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.FsLock.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.FsLockUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (flcb *FsLockCreateBulk) OnConflict(opts ...sql.ConflictOption) *FsLockUpsertBulk {
+	flcb.conflict = opts
+	return &FsLockUpsertBulk{
+		create: flcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.FsLock.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (flcb *FsLockCreateBulk) OnConflictColumns(columns ...string) *FsLockUpsertBulk {
+	flcb.conflict = append(flcb.conflict, sql.ConflictColumns(columns...))
+	return &FsLockUpsertBulk{
+		create: flcb,
+	}
+}
+
+// FsLockUpsertBulk is the builder for "upsert"-ing
+// a bulk of FsLock nodes.
+type FsLockUpsertBulk struct {
+	create *FsLockCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.FsLock.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *FsLockUpsertBulk) UpdateNewValues() *FsLockUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(fslock.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.FsLock.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *FsLockUpsertBulk) Ignore() *FsLockUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *FsLockUpsertBulk) DoNothing() *FsLockUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the FsLockCreateBulk.OnConflict
+// documentation for more info.
+func (u *FsLockUpsertBulk) Update(set func(*FsLockUpsert)) *FsLockUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&FsLockUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *FsLockUpsertBulk) SetUpdatedAt(v time.Time) *FsLockUpsertBulk {
+	return u.Update(func(s *FsLockUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *FsLockUpsertBulk) UpdateUpdatedAt() *FsLockUpsertBulk {
+	return u.Update(func(s *FsLockUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *FsLockUpsertBulk) SetDeletedAt(v time.Time) *FsLockUpsertBulk {
+	return u.Update(func(s *FsLockUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *FsLockUpsertBulk) UpdateDeletedAt() *FsLockUpsertBulk {
+	return u.Update(func(s *FsLockUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *FsLockUpsertBulk) ClearDeletedAt() *FsLockUpsertBulk {
+	return u.Update(func(s *FsLockUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetToken sets the "token" field.
+func (u *FsLockUpsertBulk) SetToken(v string) *FsLockUpsertBulk {
+	return u.Update(func(s *FsLockUpsert) {
+		s.SetToken(v)
+	})
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *FsLockUpsertBulk) UpdateToken() *FsLockUpsertBulk {
+	return u.Update(func(s *FsLockUpsert) {
+		s.UpdateToken()
+	})
+}
+
+// SetNs sets the "ns" field.
+func (u *FsLockUpsertBulk) SetNs(v string) *FsLockUpsertBulk {
+	return u.Update(func(s *FsLockUpsert) {
+		s.SetNs(v)
+	})
+}
+
+// UpdateNs sets the "ns" field to the value that was provided on create.
+func (u *FsLockUpsertBulk) UpdateNs() *FsLockUpsertBulk {
+	return u.Update(func(s *FsLockUpsert) {
+		s.UpdateNs()
+	})
+}
+
+// SetRoot sets the "root" field.
+func (u *FsLockUpsertBulk) SetRoot(v string) *FsLockUpsertBulk {
+	return u.Update(func(s *FsLockUpsert) {
+		s.SetRoot(v)
+	})
+}
+
+// UpdateRoot sets the "root" field to the value that was provided on create.
+func (u *FsLockUpsertBulk) UpdateRoot() *FsLockUpsertBulk {
+	return u.Update(func(s *FsLockUpsert) {
+		s.UpdateRoot()
+	})
+}
+
+// SetZeroDepth sets the "zero_depth" field.
+func (u *FsLockUpsertBulk) SetZeroDepth(v bool) *FsLockUpsertBulk {
+	return u.Update(func(s *FsLockUpsert) {
+		s.SetZeroDepth(v)
+	})
+}
+
+// UpdateZeroDepth sets the "zero_depth" field to the value that was provided on create.
+func (u *FsLockUpsertBulk) UpdateZeroDepth() *FsLockUpsertBulk {
+	return u.Update(func(s *FsLockUpsert) {
+		s.UpdateZeroDepth()
+	})
+}
+
+// SetFileType sets the "file_type" field.
+func (u *FsLockUpsertBulk) SetFileType(v int) *FsLockUpsertBulk {
+	return u.Update(func(s *FsLockUpsert) {
+		s.SetFileType(v)
+	})
+}
+
+// AddFileType adds v to the "file_type" field.
+func (u *FsLockUpsertBulk) AddFileType(v int) *FsLockUpsertBulk {
+	return u.Update(func(s *FsLockUpsert) {
+		s.AddFileType(v)
+	})
+}
+
+// UpdateFileType sets the "file_type" field to the value that was provided on create.
+func (u *FsLockUpsertBulk) UpdateFileType() *FsLockUpsertBulk {
+	return u.Update(func(s *FsLockUpsert) {
+		s.UpdateFileType()
+	})
+}
+
+// SetOwner sets the "owner" field.
+func (u *FsLockUpsertBulk) SetOwner(v string) *FsLockUpsertBulk {
+	return u.Update(func(s *FsLockUpsert) {
+		s.SetOwner(v)
+	})
+}
+
+// UpdateOwner sets the "owner" field to the value that was provided on create.
+func (u *FsLockUpsertBulk) UpdateOwner() *FsLockUpsertBulk {
+	return u.Update(func(s *FsLockUpsert) {
+		s.UpdateOwner()
+	})
+}
+
+// ClearOwner clears the value of the "owner" field.
+func (u *FsLockUpsertBulk) ClearOwner() *FsLockUpsertBulk {
+	return u.Update(func(s *FsLockUpsert) {
+		s.ClearOwner()
+	})
+}
+
+// SetDuration sets the "duration" field.
+func (u *FsLockUpsertBulk) SetDuration(v int64) *FsLockUpsertBulk {
+	return u.Update(func(s *FsLockUpsert) {
+		s.SetDuration(v)
+	})
+}
+
+// AddDuration adds v to the "duration" field.
+func (u *FsLockUpsertBulk) AddDuration(v int64) *FsLockUpsertBulk {
+	return u.Update(func(s *FsLockUpsert) {
+		s.AddDuration(v)
+	})
+}
+
+// UpdateDuration sets the "duration" field to the value that was provided on create.
+func (u *FsLockUpsertBulk) UpdateDuration() *FsLockUpsertBulk {
+	return u.Update(func(s *FsLockUpsert) {
+		s.UpdateDuration()
+	})
+}
+
+// SetExpireAt sets the "expire_at" field.
+func (u *FsLockUpsertBulk) SetExpireAt(v time.Time) *FsLockUpsertBulk {
+	return u.Update(func(s *FsLockUpsert) {
+		s.SetExpireAt(v)
+	})
+}
+
+// UpdateExpireAt sets the "expire_at" field to the value that was provided on create.
+func (u *FsLockUpsertBulk) UpdateExpireAt() *FsLockUpsertBulk {
+	return u.Update(func(s *FsLockUpsert) {
+		s.UpdateExpireAt()
+	})
+}
+
+// ClearExpireAt clears the value of the "expire_at" field.
+func (u *FsLockUpsertBulk) ClearExpireAt() *FsLockUpsertBulk {
+	return u.Update(func(s *FsLockUpsert) {
+		s.ClearExpireAt()
+	})
+}
+
+// SetHeldUntil sets the "held_until" field.
+func (u *FsLockUpsertBulk) SetHeldUntil(v time.Time) *FsLockUpsertBulk {
+	return u.Update(func(s *FsLockUpsert) {
+		s.SetHeldUntil(v)
+	})
+}
+
+// UpdateHeldUntil sets the "held_until" field to the value that was provided on create.
+func (u *FsLockUpsertBulk) UpdateHeldUntil() *FsLockUpsertBulk {
+	return u.Update(func(s *FsLockUpsert) {
+		s.UpdateHeldUntil()
+	})
+}
+
+// ClearHeldUntil clears the value of the "held_until" field.
+func (u *FsLockUpsertBulk) ClearHeldUntil() *FsLockUpsertBulk {
+	return u.Update(func(s *FsLockUpsert) {
+		s.ClearHeldUntil()
+	})
+}
+
+// Exec executes the query.
+func (u *FsLockUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the FsLockCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for FsLockCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *FsLockUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
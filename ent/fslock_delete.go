@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/fslock"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+)
+
+// FsLockDelete is the builder for deleting a FsLock entity.
+type FsLockDelete struct {
+	config
+	hooks    []Hook
+	mutation *FsLockMutation
+}
+
+// Where appends a list predicates to the FsLockDelete builder.
+func (fld *FsLockDelete) Where(ps ...predicate.FsLock) *FsLockDelete {
+	fld.mutation.Where(ps...)
+	return fld
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (fld *FsLockDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, fld.sqlExec, fld.mutation, fld.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fld *FsLockDelete) ExecX(ctx context.Context) int {
+	n, err := fld.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (fld *FsLockDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(fslock.Table, sqlgraph.NewFieldSpec(fslock.FieldID, field.TypeInt))
+	if ps := fld.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, fld.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	fld.mutation.done = true
+	return affected, err
+}
+
+// FsLockDeleteOne is the builder for deleting a single FsLock entity.
+type FsLockDeleteOne struct {
+	fld *FsLockDelete
+}
+
+// Where appends a list predicates to the FsLockDelete builder.
+func (fldo *FsLockDeleteOne) Where(ps ...predicate.FsLock) *FsLockDeleteOne {
+	fldo.fld.mutation.Where(ps...)
+	return fldo
+}
+
+// Exec executes the deletion query.
+func (fldo *FsLockDeleteOne) Exec(ctx context.Context) error {
+	n, err := fldo.fld.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{fslock.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fldo *FsLockDeleteOne) ExecX(ctx context.Context) {
+	if err := fldo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,526 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/fslock"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+)
+
+// FsLockQuery is the builder for querying FsLock entities.
+type FsLockQuery struct {
+	config
+	ctx        *QueryContext
+	order      []fslock.OrderOption
+	inters     []Interceptor
+	predicates []predicate.FsLock
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the FsLockQuery builder.
+func (flq *FsLockQuery) Where(ps ...predicate.FsLock) *FsLockQuery {
+	flq.predicates = append(flq.predicates, ps...)
+	return flq
+}
+
+// Limit the number of records to be returned by this query.
+func (flq *FsLockQuery) Limit(limit int) *FsLockQuery {
+	flq.ctx.Limit = &limit
+	return flq
+}
+
+// Offset to start from.
+func (flq *FsLockQuery) Offset(offset int) *FsLockQuery {
+	flq.ctx.Offset = &offset
+	return flq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (flq *FsLockQuery) Unique(unique bool) *FsLockQuery {
+	flq.ctx.Unique = &unique
+	return flq
+}
+
+// Order specifies how the records should be ordered.
+func (flq *FsLockQuery) Order(o ...fslock.OrderOption) *FsLockQuery {
+	flq.order = append(flq.order, o...)
+	return flq
+}
+
+// First returns the first FsLock entity from the query.
+// Returns a *NotFoundError when no FsLock was found.
+func (flq *FsLockQuery) First(ctx context.Context) (*FsLock, error) {
+	nodes, err := flq.Limit(1).All(setContextOp(ctx, flq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{fslock.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (flq *FsLockQuery) FirstX(ctx context.Context) *FsLock {
+	node, err := flq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first FsLock ID from the query.
+// Returns a *NotFoundError when no FsLock ID was found.
+func (flq *FsLockQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = flq.Limit(1).IDs(setContextOp(ctx, flq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{fslock.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (flq *FsLockQuery) FirstIDX(ctx context.Context) int {
+	id, err := flq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single FsLock entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one FsLock entity is found.
+// Returns a *NotFoundError when no FsLock entities are found.
+func (flq *FsLockQuery) Only(ctx context.Context) (*FsLock, error) {
+	nodes, err := flq.Limit(2).All(setContextOp(ctx, flq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{fslock.Label}
+	default:
+		return nil, &NotSingularError{fslock.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (flq *FsLockQuery) OnlyX(ctx context.Context) *FsLock {
+	node, err := flq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only FsLock ID in the query.
+// Returns a *NotSingularError when more than one FsLock ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (flq *FsLockQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = flq.Limit(2).IDs(setContextOp(ctx, flq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{fslock.Label}
+	default:
+		err = &NotSingularError{fslock.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (flq *FsLockQuery) OnlyIDX(ctx context.Context) int {
+	id, err := flq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of FsLocks.
+func (flq *FsLockQuery) All(ctx context.Context) ([]*FsLock, error) {
+	ctx = setContextOp(ctx, flq.ctx, "All")
+	if err := flq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*FsLock, *FsLockQuery]()
+	return withInterceptors[[]*FsLock](ctx, flq, qr, flq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (flq *FsLockQuery) AllX(ctx context.Context) []*FsLock {
+	nodes, err := flq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of FsLock IDs.
+func (flq *FsLockQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if flq.ctx.Unique == nil && flq.path != nil {
+		flq.Unique(true)
+	}
+	ctx = setContextOp(ctx, flq.ctx, "IDs")
+	if err = flq.Select(fslock.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (flq *FsLockQuery) IDsX(ctx context.Context) []int {
+	ids, err := flq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (flq *FsLockQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, flq.ctx, "Count")
+	if err := flq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, flq, querierCount[*FsLockQuery](), flq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (flq *FsLockQuery) CountX(ctx context.Context) int {
+	count, err := flq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (flq *FsLockQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, flq.ctx, "Exist")
+	switch _, err := flq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (flq *FsLockQuery) ExistX(ctx context.Context) bool {
+	exist, err := flq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the FsLockQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (flq *FsLockQuery) Clone() *FsLockQuery {
+	if flq == nil {
+		return nil
+	}
+	return &FsLockQuery{
+		config:     flq.config,
+		ctx:        flq.ctx.Clone(),
+		order:      append([]fslock.OrderOption{}, flq.order...),
+		inters:     append([]Interceptor{}, flq.inters...),
+		predicates: append([]predicate.FsLock{}, flq.predicates...),
+		// clone intermediate query.
+		sql:  flq.sql.Clone(),
+		path: flq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.FsLock.Query().
+//		GroupBy(fslock.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (flq *FsLockQuery) GroupBy(field string, fields ...string) *FsLockGroupBy {
+	flq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &FsLockGroupBy{build: flq}
+	grbuild.flds = &flq.ctx.Fields
+	grbuild.label = fslock.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.FsLock.Query().
+//		Select(fslock.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (flq *FsLockQuery) Select(fields ...string) *FsLockSelect {
+	flq.ctx.Fields = append(flq.ctx.Fields, fields...)
+	sbuild := &FsLockSelect{FsLockQuery: flq}
+	sbuild.label = fslock.Label
+	sbuild.flds, sbuild.scan = &flq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a FsLockSelect configured with the given aggregations.
+func (flq *FsLockQuery) Aggregate(fns ...AggregateFunc) *FsLockSelect {
+	return flq.Select().Aggregate(fns...)
+}
+
+func (flq *FsLockQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range flq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, flq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range flq.ctx.Fields {
+		if !fslock.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if flq.path != nil {
+		prev, err := flq.path(ctx)
+		if err != nil {
+			return err
+		}
+		flq.sql = prev
+	}
+	return nil
+}
+
+func (flq *FsLockQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*FsLock, error) {
+	var (
+		nodes = []*FsLock{}
+		_spec = flq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*FsLock).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &FsLock{config: flq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, flq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (flq *FsLockQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := flq.querySpec()
+	_spec.Node.Columns = flq.ctx.Fields
+	if len(flq.ctx.Fields) > 0 {
+		_spec.Unique = flq.ctx.Unique != nil && *flq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, flq.driver, _spec)
+}
+
+func (flq *FsLockQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(fslock.Table, fslock.Columns, sqlgraph.NewFieldSpec(fslock.FieldID, field.TypeInt))
+	_spec.From = flq.sql
+	if unique := flq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if flq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := flq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, fslock.FieldID)
+		for i := range fields {
+			if fields[i] != fslock.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := flq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := flq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := flq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := flq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (flq *FsLockQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(flq.driver.Dialect())
+	t1 := builder.Table(fslock.Table)
+	columns := flq.ctx.Fields
+	if len(columns) == 0 {
+		columns = fslock.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if flq.sql != nil {
+		selector = flq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if flq.ctx.Unique != nil && *flq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range flq.predicates {
+		p(selector)
+	}
+	for _, p := range flq.order {
+		p(selector)
+	}
+	if offset := flq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := flq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// FsLockGroupBy is the group-by builder for FsLock entities.
+type FsLockGroupBy struct {
+	selector
+	build *FsLockQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (flgb *FsLockGroupBy) Aggregate(fns ...AggregateFunc) *FsLockGroupBy {
+	flgb.fns = append(flgb.fns, fns...)
+	return flgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (flgb *FsLockGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, flgb.build.ctx, "GroupBy")
+	if err := flgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*FsLockQuery, *FsLockGroupBy](ctx, flgb.build, flgb, flgb.build.inters, v)
+}
+
+func (flgb *FsLockGroupBy) sqlScan(ctx context.Context, root *FsLockQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(flgb.fns))
+	for _, fn := range flgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*flgb.flds)+len(flgb.fns))
+		for _, f := range *flgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*flgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := flgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// FsLockSelect is the builder for selecting fields of FsLock entities.
+type FsLockSelect struct {
+	*FsLockQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (fls *FsLockSelect) Aggregate(fns ...AggregateFunc) *FsLockSelect {
+	fls.fns = append(fls.fns, fns...)
+	return fls
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (fls *FsLockSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, fls.ctx, "Select")
+	if err := fls.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*FsLockQuery, *FsLockSelect](ctx, fls.FsLockQuery, fls, fls.inters, v)
+}
+
+func (fls *FsLockSelect) sqlScan(ctx context.Context, root *FsLockQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(fls.fns))
+	for _, fn := range fls.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*fls.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := fls.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
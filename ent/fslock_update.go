@@ -0,0 +1,676 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/fslock"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+)
+
+// FsLockUpdate is the builder for updating FsLock entities.
+type FsLockUpdate struct {
+	config
+	hooks    []Hook
+	mutation *FsLockMutation
+}
+
+// Where appends a list predicates to the FsLockUpdate builder.
+func (flu *FsLockUpdate) Where(ps ...predicate.FsLock) *FsLockUpdate {
+	flu.mutation.Where(ps...)
+	return flu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (flu *FsLockUpdate) SetUpdatedAt(t time.Time) *FsLockUpdate {
+	flu.mutation.SetUpdatedAt(t)
+	return flu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (flu *FsLockUpdate) SetDeletedAt(t time.Time) *FsLockUpdate {
+	flu.mutation.SetDeletedAt(t)
+	return flu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (flu *FsLockUpdate) SetNillableDeletedAt(t *time.Time) *FsLockUpdate {
+	if t != nil {
+		flu.SetDeletedAt(*t)
+	}
+	return flu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (flu *FsLockUpdate) ClearDeletedAt() *FsLockUpdate {
+	flu.mutation.ClearDeletedAt()
+	return flu
+}
+
+// SetToken sets the "token" field.
+func (flu *FsLockUpdate) SetToken(s string) *FsLockUpdate {
+	flu.mutation.SetToken(s)
+	return flu
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (flu *FsLockUpdate) SetNillableToken(s *string) *FsLockUpdate {
+	if s != nil {
+		flu.SetToken(*s)
+	}
+	return flu
+}
+
+// SetNs sets the "ns" field.
+func (flu *FsLockUpdate) SetNs(s string) *FsLockUpdate {
+	flu.mutation.SetNs(s)
+	return flu
+}
+
+// SetNillableNs sets the "ns" field if the given value is not nil.
+func (flu *FsLockUpdate) SetNillableNs(s *string) *FsLockUpdate {
+	if s != nil {
+		flu.SetNs(*s)
+	}
+	return flu
+}
+
+// SetRoot sets the "root" field.
+func (flu *FsLockUpdate) SetRoot(s string) *FsLockUpdate {
+	flu.mutation.SetRoot(s)
+	return flu
+}
+
+// SetNillableRoot sets the "root" field if the given value is not nil.
+func (flu *FsLockUpdate) SetNillableRoot(s *string) *FsLockUpdate {
+	if s != nil {
+		flu.SetRoot(*s)
+	}
+	return flu
+}
+
+// SetZeroDepth sets the "zero_depth" field.
+func (flu *FsLockUpdate) SetZeroDepth(b bool) *FsLockUpdate {
+	flu.mutation.SetZeroDepth(b)
+	return flu
+}
+
+// SetNillableZeroDepth sets the "zero_depth" field if the given value is not nil.
+func (flu *FsLockUpdate) SetNillableZeroDepth(b *bool) *FsLockUpdate {
+	if b != nil {
+		flu.SetZeroDepth(*b)
+	}
+	return flu
+}
+
+// SetFileType sets the "file_type" field.
+func (flu *FsLockUpdate) SetFileType(i int) *FsLockUpdate {
+	flu.mutation.ResetFileType()
+	flu.mutation.SetFileType(i)
+	return flu
+}
+
+// SetNillableFileType sets the "file_type" field if the given value is not nil.
+func (flu *FsLockUpdate) SetNillableFileType(i *int) *FsLockUpdate {
+	if i != nil {
+		flu.SetFileType(*i)
+	}
+	return flu
+}
+
+// AddFileType adds i to the "file_type" field.
+func (flu *FsLockUpdate) AddFileType(i int) *FsLockUpdate {
+	flu.mutation.AddFileType(i)
+	return flu
+}
+
+// SetOwner sets the "owner" field.
+func (flu *FsLockUpdate) SetOwner(s string) *FsLockUpdate {
+	flu.mutation.SetOwner(s)
+	return flu
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (flu *FsLockUpdate) SetNillableOwner(s *string) *FsLockUpdate {
+	if s != nil {
+		flu.SetOwner(*s)
+	}
+	return flu
+}
+
+// ClearOwner clears the value of the "owner" field.
+func (flu *FsLockUpdate) ClearOwner() *FsLockUpdate {
+	flu.mutation.ClearOwner()
+	return flu
+}
+
+// SetDuration sets the "duration" field.
+func (flu *FsLockUpdate) SetDuration(i int64) *FsLockUpdate {
+	flu.mutation.ResetDuration()
+	flu.mutation.SetDuration(i)
+	return flu
+}
+
+// SetNillableDuration sets the "duration" field if the given value is not nil.
+func (flu *FsLockUpdate) SetNillableDuration(i *int64) *FsLockUpdate {
+	if i != nil {
+		flu.SetDuration(*i)
+	}
+	return flu
+}
+
+// AddDuration adds i to the "duration" field.
+func (flu *FsLockUpdate) AddDuration(i int64) *FsLockUpdate {
+	flu.mutation.AddDuration(i)
+	return flu
+}
+
+// SetExpireAt sets the "expire_at" field.
+func (flu *FsLockUpdate) SetExpireAt(t time.Time) *FsLockUpdate {
+	flu.mutation.SetExpireAt(t)
+	return flu
+}
+
+// SetNillableExpireAt sets the "expire_at" field if the given value is not nil.
+func (flu *FsLockUpdate) SetNillableExpireAt(t *time.Time) *FsLockUpdate {
+	if t != nil {
+		flu.SetExpireAt(*t)
+	}
+	return flu
+}
+
+// ClearExpireAt clears the value of the "expire_at" field.
+func (flu *FsLockUpdate) ClearExpireAt() *FsLockUpdate {
+	flu.mutation.ClearExpireAt()
+	return flu
+}
+
+// SetHeldUntil sets the "held_until" field.
+func (flu *FsLockUpdate) SetHeldUntil(t time.Time) *FsLockUpdate {
+	flu.mutation.SetHeldUntil(t)
+	return flu
+}
+
+// SetNillableHeldUntil sets the "held_until" field if the given value is not nil.
+func (flu *FsLockUpdate) SetNillableHeldUntil(t *time.Time) *FsLockUpdate {
+	if t != nil {
+		flu.SetHeldUntil(*t)
+	}
+	return flu
+}
+
+// ClearHeldUntil clears the value of the "held_until" field.
+func (flu *FsLockUpdate) ClearHeldUntil() *FsLockUpdate {
+	flu.mutation.ClearHeldUntil()
+	return flu
+}
+
+// Mutation returns the FsLockMutation object of the builder.
+func (flu *FsLockUpdate) Mutation() *FsLockMutation {
+	return flu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (flu *FsLockUpdate) Save(ctx context.Context) (int, error) {
+	if err := flu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, flu.sqlSave, flu.mutation, flu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (flu *FsLockUpdate) SaveX(ctx context.Context) int {
+	affected, err := flu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (flu *FsLockUpdate) Exec(ctx context.Context) error {
+	_, err := flu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (flu *FsLockUpdate) ExecX(ctx context.Context) {
+	if err := flu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (flu *FsLockUpdate) defaults() error {
+	if _, ok := flu.mutation.UpdatedAt(); !ok {
+		if fslock.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized fslock.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := fslock.UpdateDefaultUpdatedAt()
+		flu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+func (flu *FsLockUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(fslock.Table, fslock.Columns, sqlgraph.NewFieldSpec(fslock.FieldID, field.TypeInt))
+	if ps := flu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := flu.mutation.UpdatedAt(); ok {
+		_spec.SetField(fslock.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := flu.mutation.DeletedAt(); ok {
+		_spec.SetField(fslock.FieldDeletedAt, field.TypeTime, value)
+	}
+	if flu.mutation.DeletedAtCleared() {
+		_spec.ClearField(fslock.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := flu.mutation.Token(); ok {
+		_spec.SetField(fslock.FieldToken, field.TypeString, value)
+	}
+	if value, ok := flu.mutation.Ns(); ok {
+		_spec.SetField(fslock.FieldNs, field.TypeString, value)
+	}
+	if value, ok := flu.mutation.Root(); ok {
+		_spec.SetField(fslock.FieldRoot, field.TypeString, value)
+	}
+	if value, ok := flu.mutation.ZeroDepth(); ok {
+		_spec.SetField(fslock.FieldZeroDepth, field.TypeBool, value)
+	}
+	if value, ok := flu.mutation.FileType(); ok {
+		_spec.SetField(fslock.FieldFileType, field.TypeInt, value)
+	}
+	if value, ok := flu.mutation.AddedFileType(); ok {
+		_spec.AddField(fslock.FieldFileType, field.TypeInt, value)
+	}
+	if value, ok := flu.mutation.Owner(); ok {
+		_spec.SetField(fslock.FieldOwner, field.TypeString, value)
+	}
+	if flu.mutation.OwnerCleared() {
+		_spec.ClearField(fslock.FieldOwner, field.TypeString)
+	}
+	if value, ok := flu.mutation.Duration(); ok {
+		_spec.SetField(fslock.FieldDuration, field.TypeInt64, value)
+	}
+	if value, ok := flu.mutation.AddedDuration(); ok {
+		_spec.AddField(fslock.FieldDuration, field.TypeInt64, value)
+	}
+	if value, ok := flu.mutation.ExpireAt(); ok {
+		_spec.SetField(fslock.FieldExpireAt, field.TypeTime, value)
+	}
+	if flu.mutation.ExpireAtCleared() {
+		_spec.ClearField(fslock.FieldExpireAt, field.TypeTime)
+	}
+	if value, ok := flu.mutation.HeldUntil(); ok {
+		_spec.SetField(fslock.FieldHeldUntil, field.TypeTime, value)
+	}
+	if flu.mutation.HeldUntilCleared() {
+		_spec.ClearField(fslock.FieldHeldUntil, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, flu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{fslock.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	flu.mutation.done = true
+	return n, nil
+}
+
+// FsLockUpdateOne is the builder for updating a single FsLock entity.
+type FsLockUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *FsLockMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (fluo *FsLockUpdateOne) SetUpdatedAt(t time.Time) *FsLockUpdateOne {
+	fluo.mutation.SetUpdatedAt(t)
+	return fluo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (fluo *FsLockUpdateOne) SetDeletedAt(t time.Time) *FsLockUpdateOne {
+	fluo.mutation.SetDeletedAt(t)
+	return fluo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (fluo *FsLockUpdateOne) SetNillableDeletedAt(t *time.Time) *FsLockUpdateOne {
+	if t != nil {
+		fluo.SetDeletedAt(*t)
+	}
+	return fluo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (fluo *FsLockUpdateOne) ClearDeletedAt() *FsLockUpdateOne {
+	fluo.mutation.ClearDeletedAt()
+	return fluo
+}
+
+// SetToken sets the "token" field.
+func (fluo *FsLockUpdateOne) SetToken(s string) *FsLockUpdateOne {
+	fluo.mutation.SetToken(s)
+	return fluo
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (fluo *FsLockUpdateOne) SetNillableToken(s *string) *FsLockUpdateOne {
+	if s != nil {
+		fluo.SetToken(*s)
+	}
+	return fluo
+}
+
+// SetNs sets the "ns" field.
+func (fluo *FsLockUpdateOne) SetNs(s string) *FsLockUpdateOne {
+	fluo.mutation.SetNs(s)
+	return fluo
+}
+
+// SetNillableNs sets the "ns" field if the given value is not nil.
+func (fluo *FsLockUpdateOne) SetNillableNs(s *string) *FsLockUpdateOne {
+	if s != nil {
+		fluo.SetNs(*s)
+	}
+	return fluo
+}
+
+// SetRoot sets the "root" field.
+func (fluo *FsLockUpdateOne) SetRoot(s string) *FsLockUpdateOne {
+	fluo.mutation.SetRoot(s)
+	return fluo
+}
+
+// SetNillableRoot sets the "root" field if the given value is not nil.
+func (fluo *FsLockUpdateOne) SetNillableRoot(s *string) *FsLockUpdateOne {
+	if s != nil {
+		fluo.SetRoot(*s)
+	}
+	return fluo
+}
+
+// SetZeroDepth sets the "zero_depth" field.
+func (fluo *FsLockUpdateOne) SetZeroDepth(b bool) *FsLockUpdateOne {
+	fluo.mutation.SetZeroDepth(b)
+	return fluo
+}
+
+// SetNillableZeroDepth sets the "zero_depth" field if the given value is not nil.
+func (fluo *FsLockUpdateOne) SetNillableZeroDepth(b *bool) *FsLockUpdateOne {
+	if b != nil {
+		fluo.SetZeroDepth(*b)
+	}
+	return fluo
+}
+
+// SetFileType sets the "file_type" field.
+func (fluo *FsLockUpdateOne) SetFileType(i int) *FsLockUpdateOne {
+	fluo.mutation.ResetFileType()
+	fluo.mutation.SetFileType(i)
+	return fluo
+}
+
+// SetNillableFileType sets the "file_type" field if the given value is not nil.
+func (fluo *FsLockUpdateOne) SetNillableFileType(i *int) *FsLockUpdateOne {
+	if i != nil {
+		fluo.SetFileType(*i)
+	}
+	return fluo
+}
+
+// AddFileType adds i to the "file_type" field.
+func (fluo *FsLockUpdateOne) AddFileType(i int) *FsLockUpdateOne {
+	fluo.mutation.AddFileType(i)
+	return fluo
+}
+
+// SetOwner sets the "owner" field.
+func (fluo *FsLockUpdateOne) SetOwner(s string) *FsLockUpdateOne {
+	fluo.mutation.SetOwner(s)
+	return fluo
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (fluo *FsLockUpdateOne) SetNillableOwner(s *string) *FsLockUpdateOne {
+	if s != nil {
+		fluo.SetOwner(*s)
+	}
+	return fluo
+}
+
+// ClearOwner clears the value of the "owner" field.
+func (fluo *FsLockUpdateOne) ClearOwner() *FsLockUpdateOne {
+	fluo.mutation.ClearOwner()
+	return fluo
+}
+
+// SetDuration sets the "duration" field.
+func (fluo *FsLockUpdateOne) SetDuration(i int64) *FsLockUpdateOne {
+	fluo.mutation.ResetDuration()
+	fluo.mutation.SetDuration(i)
+	return fluo
+}
+
+// SetNillableDuration sets the "duration" field if the given value is not nil.
+func (fluo *FsLockUpdateOne) SetNillableDuration(i *int64) *FsLockUpdateOne {
+	if i != nil {
+		fluo.SetDuration(*i)
+	}
+	return fluo
+}
+
+// AddDuration adds i to the "duration" field.
+func (fluo *FsLockUpdateOne) AddDuration(i int64) *FsLockUpdateOne {
+	fluo.mutation.AddDuration(i)
+	return fluo
+}
+
+// SetExpireAt sets the "expire_at" field.
+func (fluo *FsLockUpdateOne) SetExpireAt(t time.Time) *FsLockUpdateOne {
+	fluo.mutation.SetExpireAt(t)
+	return fluo
+}
+
+// SetNillableExpireAt sets the "expire_at" field if the given value is not nil.
+func (fluo *FsLockUpdateOne) SetNillableExpireAt(t *time.Time) *FsLockUpdateOne {
+	if t != nil {
+		fluo.SetExpireAt(*t)
+	}
+	return fluo
+}
+
+// ClearExpireAt clears the value of the "expire_at" field.
+func (fluo *FsLockUpdateOne) ClearExpireAt() *FsLockUpdateOne {
+	fluo.mutation.ClearExpireAt()
+	return fluo
+}
+
+// SetHeldUntil sets the "held_until" field.
+func (fluo *FsLockUpdateOne) SetHeldUntil(t time.Time) *FsLockUpdateOne {
+	fluo.mutation.SetHeldUntil(t)
+	return fluo
+}
+
+// SetNillableHeldUntil sets the "held_until" field if the given value is not nil.
+func (fluo *FsLockUpdateOne) SetNillableHeldUntil(t *time.Time) *FsLockUpdateOne {
+	if t != nil {
+		fluo.SetHeldUntil(*t)
+	}
+	return fluo
+}
+
+// ClearHeldUntil clears the value of the "held_until" field.
+func (fluo *FsLockUpdateOne) ClearHeldUntil() *FsLockUpdateOne {
+	fluo.mutation.ClearHeldUntil()
+	return fluo
+}
+
+// Mutation returns the FsLockMutation object of the builder.
+func (fluo *FsLockUpdateOne) Mutation() *FsLockMutation {
+	return fluo.mutation
+}
+
+// Where appends a list predicates to the FsLockUpdate builder.
+func (fluo *FsLockUpdateOne) Where(ps ...predicate.FsLock) *FsLockUpdateOne {
+	fluo.mutation.Where(ps...)
+	return fluo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (fluo *FsLockUpdateOne) Select(field string, fields ...string) *FsLockUpdateOne {
+	fluo.fields = append([]string{field}, fields...)
+	return fluo
+}
+
+// Save executes the query and returns the updated FsLock entity.
+func (fluo *FsLockUpdateOne) Save(ctx context.Context) (*FsLock, error) {
+	if err := fluo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, fluo.sqlSave, fluo.mutation, fluo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fluo *FsLockUpdateOne) SaveX(ctx context.Context) *FsLock {
+	node, err := fluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (fluo *FsLockUpdateOne) Exec(ctx context.Context) error {
+	_, err := fluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fluo *FsLockUpdateOne) ExecX(ctx context.Context) {
+	if err := fluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (fluo *FsLockUpdateOne) defaults() error {
+	if _, ok := fluo.mutation.UpdatedAt(); !ok {
+		if fslock.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized fslock.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := fslock.UpdateDefaultUpdatedAt()
+		fluo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+func (fluo *FsLockUpdateOne) sqlSave(ctx context.Context) (_node *FsLock, err error) {
+	_spec := sqlgraph.NewUpdateSpec(fslock.Table, fslock.Columns, sqlgraph.NewFieldSpec(fslock.FieldID, field.TypeInt))
+	id, ok := fluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "FsLock.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := fluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, fslock.FieldID)
+		for _, f := range fields {
+			if !fslock.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != fslock.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := fluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := fluo.mutation.UpdatedAt(); ok {
+		_spec.SetField(fslock.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := fluo.mutation.DeletedAt(); ok {
+		_spec.SetField(fslock.FieldDeletedAt, field.TypeTime, value)
+	}
+	if fluo.mutation.DeletedAtCleared() {
+		_spec.ClearField(fslock.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := fluo.mutation.Token(); ok {
+		_spec.SetField(fslock.FieldToken, field.TypeString, value)
+	}
+	if value, ok := fluo.mutation.Ns(); ok {
+		_spec.SetField(fslock.FieldNs, field.TypeString, value)
+	}
+	if value, ok := fluo.mutation.Root(); ok {
+		_spec.SetField(fslock.FieldRoot, field.TypeString, value)
+	}
+	if value, ok := fluo.mutation.ZeroDepth(); ok {
+		_spec.SetField(fslock.FieldZeroDepth, field.TypeBool, value)
+	}
+	if value, ok := fluo.mutation.FileType(); ok {
+		_spec.SetField(fslock.FieldFileType, field.TypeInt, value)
+	}
+	if value, ok := fluo.mutation.AddedFileType(); ok {
+		_spec.AddField(fslock.FieldFileType, field.TypeInt, value)
+	}
+	if value, ok := fluo.mutation.Owner(); ok {
+		_spec.SetField(fslock.FieldOwner, field.TypeString, value)
+	}
+	if fluo.mutation.OwnerCleared() {
+		_spec.ClearField(fslock.FieldOwner, field.TypeString)
+	}
+	if value, ok := fluo.mutation.Duration(); ok {
+		_spec.SetField(fslock.FieldDuration, field.TypeInt64, value)
+	}
+	if value, ok := fluo.mutation.AddedDuration(); ok {
+		_spec.AddField(fslock.FieldDuration, field.TypeInt64, value)
+	}
+	if value, ok := fluo.mutation.ExpireAt(); ok {
+		_spec.SetField(fslock.FieldExpireAt, field.TypeTime, value)
+	}
+	if fluo.mutation.ExpireAtCleared() {
+		_spec.ClearField(fslock.FieldExpireAt, field.TypeTime)
+	}
+	if value, ok := fluo.mutation.HeldUntil(); ok {
+		_spec.SetField(fslock.FieldHeldUntil, field.TypeTime, value)
+	}
+	if fluo.mutation.HeldUntilCleared() {
+		_spec.ClearField(fslock.FieldHeldUntil, field.TypeTime)
+	}
+	_node = &FsLock{config: fluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, fluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{fslock.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	fluo.mutation.done = true
+	return _node, nil
+}
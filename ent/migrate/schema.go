@@ -79,6 +79,7 @@ var (
 		{Name: "reference_count", Type: field.TypeInt, Default: 1},
 		{Name: "upload_session_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "recycle_options", Type: field.TypeJSON, Nullable: true},
+		{Name: "checksum", Type: field.TypeString, Nullable: true},
 		{Name: "storage_policy_entities", Type: field.TypeInt},
 		{Name: "created_by", Type: field.TypeInt, Nullable: true},
 	}
@@ -90,17 +91,24 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "entities_storage_policies_entities",
-				Columns:    []*schema.Column{EntitiesColumns[10]},
+				Columns:    []*schema.Column{EntitiesColumns[11]},
 				RefColumns: []*schema.Column{StoragePoliciesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "entities_users_entities",
-				Columns:    []*schema.Column{EntitiesColumns[11]},
+				Columns:    []*schema.Column{EntitiesColumns[12]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "entity_storage_policy_entities_checksum",
+				Unique:  false,
+				Columns: []*schema.Column{EntitiesColumns[11], EntitiesColumns[10]},
+			},
+		},
 	}
 	// FilesColumns holds the columns for the "files" table.
 	FilesColumns = []*schema.Column{
@@ -189,6 +197,40 @@ var (
 			},
 		},
 	}
+	// FsLocksColumns holds the columns for the "fs_locks" table.
+	FsLocksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "token", Type: field.TypeString, Unique: true},
+		{Name: "ns", Type: field.TypeString},
+		{Name: "root", Type: field.TypeString, Size: 2147483647},
+		{Name: "zero_depth", Type: field.TypeBool, Default: false},
+		{Name: "file_type", Type: field.TypeInt, Default: 0},
+		{Name: "owner", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "duration", Type: field.TypeInt64, Default: -1},
+		{Name: "expire_at", Type: field.TypeTime, Nullable: true},
+		{Name: "held_until", Type: field.TypeTime, Nullable: true},
+	}
+	// FsLocksTable holds the schema information for the "fs_locks" table.
+	FsLocksTable = &schema.Table{
+		Name:       "fs_locks",
+		Columns:    FsLocksColumns,
+		PrimaryKey: []*schema.Column{FsLocksColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "fslock_ns",
+				Unique:  false,
+				Columns: []*schema.Column{FsLocksColumns[5]},
+			},
+			{
+				Name:    "fslock_expire_at",
+				Unique:  false,
+				Columns: []*schema.Column{FsLocksColumns[11]},
+			},
+		},
+	}
 	// GroupsColumns holds the columns for the "groups" table.
 	GroupsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -361,6 +403,62 @@ var (
 			},
 		},
 	}
+	// SchedulesColumns holds the columns for the "schedules" table.
+	SchedulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "name", Type: field.TypeString},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"archive", "extract", "download"}},
+		{Name: "spec", Type: field.TypeString},
+		{Name: "props", Type: field.TypeJSON},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "owner_id", Type: field.TypeInt},
+		{Name: "next_run_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_run_at", Type: field.TypeTime, Nullable: true},
+	}
+	// SchedulesTable holds the schema information for the "schedules" table.
+	SchedulesTable = &schema.Table{
+		Name:       "schedules",
+		Columns:    SchedulesColumns,
+		PrimaryKey: []*schema.Column{SchedulesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "schedule_owner_id",
+				Unique:  false,
+				Columns: []*schema.Column{SchedulesColumns[9]},
+			},
+			{
+				Name:    "schedule_enabled_next_run_at",
+				Unique:  false,
+				Columns: []*schema.Column{SchedulesColumns[8], SchedulesColumns[10]},
+			},
+		},
+	}
+	// ScheduleRunsColumns holds the columns for the "schedule_runs" table.
+	ScheduleRunsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "schedule_id", Type: field.TypeInt},
+		{Name: "task_id", Type: field.TypeInt, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+	}
+	// ScheduleRunsTable holds the schema information for the "schedule_runs" table.
+	ScheduleRunsTable = &schema.Table{
+		Name:       "schedule_runs",
+		Columns:    ScheduleRunsColumns,
+		PrimaryKey: []*schema.Column{ScheduleRunsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "schedulerun_schedule_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ScheduleRunsColumns[4], ScheduleRunsColumns[1]},
+			},
+		},
+	}
 	// SettingsColumns holds the columns for the "settings" table.
 	SettingsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -385,6 +483,7 @@ var (
 		{Name: "password", Type: field.TypeString, Nullable: true},
 		{Name: "views", Type: field.TypeInt, Default: 0},
 		{Name: "downloads", Type: field.TypeInt, Default: 0},
+		{Name: "uploads", Type: field.TypeInt, Default: 0},
 		{Name: "expires", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
 		{Name: "remain_downloads", Type: field.TypeInt, Nullable: true},
 		{Name: "props", Type: field.TypeJSON, Nullable: true},
@@ -399,18 +498,49 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "shares_files_shares",
-				Columns:    []*schema.Column{SharesColumns[10]},
+				Columns:    []*schema.Column{SharesColumns[11]},
 				RefColumns: []*schema.Column{FilesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "shares_users_shares",
-				Columns:    []*schema.Column{SharesColumns[11]},
+				Columns:    []*schema.Column{SharesColumns[12]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 	}
+	// ShareAccessesColumns holds the columns for the "share_accesses" table.
+	ShareAccessesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "share_id", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeInt, Nullable: true},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"view", "download", "archive"}},
+		{Name: "ip", Type: field.TypeString, Nullable: true},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "path", Type: field.TypeString, Nullable: true, Size: 2147483647},
+	}
+	// ShareAccessesTable holds the schema information for the "share_accesses" table.
+	ShareAccessesTable = &schema.Table{
+		Name:       "share_accesses",
+		Columns:    ShareAccessesColumns,
+		PrimaryKey: []*schema.Column{ShareAccessesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "shareaccess_share_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ShareAccessesColumns[4], ShareAccessesColumns[1]},
+			},
+			{
+				Name:    "shareaccess_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ShareAccessesColumns[1]},
+			},
+		},
+	}
 	// StoragePoliciesColumns holds the columns for the "storage_policies" table.
 	StoragePoliciesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -455,6 +585,8 @@ var (
 		{Name: "public_state", Type: field.TypeJSON},
 		{Name: "private_state", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "correlation_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "lease_owner", Type: field.TypeString, Nullable: true},
+		{Name: "lease_expire_at", Type: field.TypeTime, Nullable: true},
 		{Name: "user_tasks", Type: field.TypeInt, Nullable: true},
 	}
 	// TasksTable holds the schema information for the "tasks" table.
@@ -465,11 +597,42 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "tasks_users_tasks",
-				Columns:    []*schema.Column{TasksColumns[9]},
+				Columns:    []*schema.Column{TasksColumns[11]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "task_status_lease_expire_at",
+				Unique:  false,
+				Columns: []*schema.Column{TasksColumns[5], TasksColumns[10]},
+			},
+		},
+	}
+	// TeamsColumns holds the columns for the "teams" table.
+	TeamsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "settings", Type: field.TypeJSON, Nullable: true},
+		{Name: "owner_id", Type: field.TypeInt},
+	}
+	// TeamsTable holds the schema information for the "teams" table.
+	TeamsTable = &schema.Table{
+		Name:       "teams",
+		Columns:    TeamsColumns,
+		PrimaryKey: []*schema.Column{TeamsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "teams_users_teams",
+				Columns:    []*schema.Column{TeamsColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
 	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
@@ -482,6 +645,7 @@ var (
 		{Name: "password", Type: field.TypeString, Nullable: true},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"active", "inactive", "manual_banned", "sys_banned"}, Default: "active"},
 		{Name: "storage", Type: field.TypeInt64, Default: 0},
+		{Name: "file_count", Type: field.TypeInt, Default: 0},
 		{Name: "two_factor_secret", Type: field.TypeString, Nullable: true},
 		{Name: "avatar", Type: field.TypeString, Nullable: true},
 		{Name: "settings", Type: field.TypeJSON, Nullable: true},
@@ -495,12 +659,66 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "users_groups_users",
-				Columns:    []*schema.Column{UsersColumns[12]},
+				Columns:    []*schema.Column{UsersColumns[13]},
 				RefColumns: []*schema.Column{GroupsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 	}
+	// WebhooksColumns holds the columns for the "webhooks" table.
+	WebhooksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "name", Type: field.TypeString},
+		{Name: "url", Type: field.TypeString, Size: 2147483647},
+		{Name: "secret", Type: field.TypeString},
+		{Name: "events", Type: field.TypeJSON, Nullable: true},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "owner_id", Type: field.TypeInt, Nullable: true},
+	}
+	// WebhooksTable holds the schema information for the "webhooks" table.
+	WebhooksTable = &schema.Table{
+		Name:       "webhooks",
+		Columns:    WebhooksColumns,
+		PrimaryKey: []*schema.Column{WebhooksColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhook_owner_id",
+				Unique:  false,
+				Columns: []*schema.Column{WebhooksColumns[9]},
+			},
+		},
+	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "webhook_id", Type: field.TypeInt},
+		{Name: "event", Type: field.TypeString},
+		{Name: "delivery_id", Type: field.TypeUUID},
+		{Name: "payload", Type: field.TypeString, Size: 2147483647},
+		{Name: "status_code", Type: field.TypeInt, Default: 0},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "attempt", Type: field.TypeInt, Default: 1},
+		{Name: "duration", Type: field.TypeInt64, Default: 0},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_webhook_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[4], WebhookDeliveriesColumns[1]},
+			},
+		},
+	}
 	// FileEntitiesColumns holds the columns for the "file_entities" table.
 	FileEntitiesColumns = []*schema.Column{
 		{Name: "file_id", Type: field.TypeInt},
@@ -533,17 +751,24 @@ var (
 		EntitiesTable,
 		FilesTable,
 		FsEventsTable,
+		FsLocksTable,
 		GroupsTable,
 		MetadataTable,
 		NodesTable,
 		OauthClientsTable,
 		OauthGrantsTable,
 		PasskeysTable,
+		SchedulesTable,
+		ScheduleRunsTable,
 		SettingsTable,
 		SharesTable,
+		ShareAccessesTable,
 		StoragePoliciesTable,
 		TasksTable,
+		TeamsTable,
 		UsersTable,
+		WebhooksTable,
+		WebhookDeliveriesTable,
 		FileEntitiesTable,
 	}
 )
@@ -566,6 +791,7 @@ func init() {
 	SharesTable.ForeignKeys[1].RefTable = UsersTable
 	StoragePoliciesTable.ForeignKeys[0].RefTable = NodesTable
 	TasksTable.ForeignKeys[0].RefTable = UsersTable
+	TeamsTable.ForeignKeys[0].RefTable = UsersTable
 	UsersTable.ForeignKeys[0].RefTable = GroupsTable
 	FileEntitiesTable.ForeignKeys[0].RefTable = FilesTable
 	FileEntitiesTable.ForeignKeys[1].RefTable = EntitiesTable
@@ -16,6 +16,7 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/ent/entity"
 	"github.com/cloudreve/Cloudreve/v4/ent/file"
 	"github.com/cloudreve/Cloudreve/v4/ent/fsevent"
+	"github.com/cloudreve/Cloudreve/v4/ent/fslock"
 	"github.com/cloudreve/Cloudreve/v4/ent/group"
 	"github.com/cloudreve/Cloudreve/v4/ent/metadata"
 	"github.com/cloudreve/Cloudreve/v4/ent/node"
@@ -23,11 +24,17 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/ent/oauthgrant"
 	"github.com/cloudreve/Cloudreve/v4/ent/passkey"
 	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+	"github.com/cloudreve/Cloudreve/v4/ent/schedule"
+	"github.com/cloudreve/Cloudreve/v4/ent/schedulerun"
 	"github.com/cloudreve/Cloudreve/v4/ent/setting"
 	"github.com/cloudreve/Cloudreve/v4/ent/share"
+	"github.com/cloudreve/Cloudreve/v4/ent/shareaccess"
 	"github.com/cloudreve/Cloudreve/v4/ent/storagepolicy"
 	"github.com/cloudreve/Cloudreve/v4/ent/task"
+	"github.com/cloudreve/Cloudreve/v4/ent/team"
 	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/ent/webhook"
+	"github.com/cloudreve/Cloudreve/v4/ent/webhookdelivery"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
 	"github.com/cloudreve/Cloudreve/v4/pkg/boolset"
 	"github.com/go-webauthn/webauthn/webauthn"
@@ -43,22 +50,29 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeDavAccount    = "DavAccount"
-	TypeDirectLink    = "DirectLink"
-	TypeEntity        = "Entity"
-	TypeFile          = "File"
-	TypeFsEvent       = "FsEvent"
-	TypeGroup         = "Group"
-	TypeMetadata      = "Metadata"
-	TypeNode          = "Node"
-	TypeOAuthClient   = "OAuthClient"
-	TypeOAuthGrant    = "OAuthGrant"
-	TypePasskey       = "Passkey"
-	TypeSetting       = "Setting"
-	TypeShare         = "Share"
-	TypeStoragePolicy = "StoragePolicy"
-	TypeTask          = "Task"
-	TypeUser          = "User"
+	TypeDavAccount      = "DavAccount"
+	TypeDirectLink      = "DirectLink"
+	TypeEntity          = "Entity"
+	TypeFile            = "File"
+	TypeFsEvent         = "FsEvent"
+	TypeFsLock          = "FsLock"
+	TypeGroup           = "Group"
+	TypeMetadata        = "Metadata"
+	TypeNode            = "Node"
+	TypeOAuthClient     = "OAuthClient"
+	TypeOAuthGrant      = "OAuthGrant"
+	TypePasskey         = "Passkey"
+	TypeSchedule        = "Schedule"
+	TypeScheduleRun     = "ScheduleRun"
+	TypeSetting         = "Setting"
+	TypeShare           = "Share"
+	TypeShareAccess     = "ShareAccess"
+	TypeStoragePolicy   = "StoragePolicy"
+	TypeTask            = "Task"
+	TypeTeam            = "Team"
+	TypeUser            = "User"
+	TypeWebhook         = "Webhook"
+	TypeWebhookDelivery = "WebhookDelivery"
 )
 
 // DavAccountMutation represents an operation that mutates the DavAccount nodes in the graph.
@@ -1730,6 +1744,7 @@ type EntityMutation struct {
 	addreference_count    *int
 	upload_session_id     *uuid.UUID
 	props                 **types.EntityProps
+	checksum              *string
 	clearedFields         map[string]struct{}
 	file                  map[int]struct{}
 	removedfile           map[int]struct{}
@@ -2349,6 +2364,55 @@ func (m *EntityMutation) ResetProps() {
 	delete(m.clearedFields, entity.FieldProps)
 }
 
+// SetChecksum sets the "checksum" field.
+func (m *EntityMutation) SetChecksum(s string) {
+	m.checksum = &s
+}
+
+// Checksum returns the value of the "checksum" field in the mutation.
+func (m *EntityMutation) Checksum() (r string, exists bool) {
+	v := m.checksum
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChecksum returns the old "checksum" field's value of the Entity entity.
+// If the Entity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntityMutation) OldChecksum(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChecksum is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChecksum requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChecksum: %w", err)
+	}
+	return oldValue.Checksum, nil
+}
+
+// ClearChecksum clears the value of the "checksum" field.
+func (m *EntityMutation) ClearChecksum() {
+	m.checksum = nil
+	m.clearedFields[entity.FieldChecksum] = struct{}{}
+}
+
+// ChecksumCleared returns if the "checksum" field was cleared in this mutation.
+func (m *EntityMutation) ChecksumCleared() bool {
+	_, ok := m.clearedFields[entity.FieldChecksum]
+	return ok
+}
+
+// ResetChecksum resets all changes to the "checksum" field.
+func (m *EntityMutation) ResetChecksum() {
+	m.checksum = nil
+	delete(m.clearedFields, entity.FieldChecksum)
+}
+
 // AddFileIDs adds the "file" edge to the File entity by ids.
 func (m *EntityMutation) AddFileIDs(ids ...int) {
 	if m.file == nil {
@@ -2517,7 +2581,7 @@ func (m *EntityMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *EntityMutation) Fields() []string {
-	fields := make([]string, 0, 11)
+	fields := make([]string, 0, 12)
 	if m.created_at != nil {
 		fields = append(fields, entity.FieldCreatedAt)
 	}
@@ -2551,6 +2615,9 @@ func (m *EntityMutation) Fields() []string {
 	if m.props != nil {
 		fields = append(fields, entity.FieldProps)
 	}
+	if m.checksum != nil {
+		fields = append(fields, entity.FieldChecksum)
+	}
 	return fields
 }
 
@@ -2581,6 +2648,8 @@ func (m *EntityMutation) Field(name string) (ent.Value, bool) {
 		return m.UploadSessionID()
 	case entity.FieldProps:
 		return m.Props()
+	case entity.FieldChecksum:
+		return m.Checksum()
 	}
 	return nil, false
 }
@@ -2612,6 +2681,8 @@ func (m *EntityMutation) OldField(ctx context.Context, name string) (ent.Value,
 		return m.OldUploadSessionID(ctx)
 	case entity.FieldProps:
 		return m.OldProps(ctx)
+	case entity.FieldChecksum:
+		return m.OldChecksum(ctx)
 	}
 	return nil, fmt.Errorf("unknown Entity field %s", name)
 }
@@ -2698,6 +2769,13 @@ func (m *EntityMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetProps(v)
 		return nil
+	case entity.FieldChecksum:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChecksum(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Entity field %s", name)
 }
@@ -2779,6 +2857,9 @@ func (m *EntityMutation) ClearedFields() []string {
 	if m.FieldCleared(entity.FieldProps) {
 		fields = append(fields, entity.FieldProps)
 	}
+	if m.FieldCleared(entity.FieldChecksum) {
+		fields = append(fields, entity.FieldChecksum)
+	}
 	return fields
 }
 
@@ -2805,6 +2886,9 @@ func (m *EntityMutation) ClearField(name string) error {
 	case entity.FieldProps:
 		m.ClearProps()
 		return nil
+	case entity.FieldChecksum:
+		m.ClearChecksum()
+		return nil
 	}
 	return fmt.Errorf("unknown Entity nullable field %s", name)
 }
@@ -2846,6 +2930,9 @@ func (m *EntityMutation) ResetField(name string) error {
 	case entity.FieldProps:
 		m.ResetProps()
 		return nil
+	case entity.FieldChecksum:
+		m.ResetChecksum()
+		return nil
 	}
 	return fmt.Errorf("unknown Entity field %s", name)
 }
@@ -5314,44 +5401,43 @@ func (m *FsEventMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown FsEvent edge %s", name)
 }
 
-// GroupMutation represents an operation that mutates the Group nodes in the graph.
-type GroupMutation struct {
+// FsLockMutation represents an operation that mutates the FsLock nodes in the graph.
+type FsLockMutation struct {
 	config
-	op                      Op
-	typ                     string
-	id                      *int
-	created_at              *time.Time
-	updated_at              *time.Time
-	deleted_at              *time.Time
-	name                    *string
-	max_storage             *int64
-	addmax_storage          *int64
-	speed_limit             *int
-	addspeed_limit          *int
-	permissions             **boolset.BooleanSet
-	settings                **types.GroupSetting
-	clearedFields           map[string]struct{}
-	users                   map[int]struct{}
-	removedusers            map[int]struct{}
-	clearedusers            bool
-	storage_policies        *int
-	clearedstorage_policies bool
-	done                    bool
-	oldValue                func(context.Context) (*Group, error)
-	predicates              []predicate.Group
+	op            Op
+	typ           string
+	id            *int
+	created_at    *time.Time
+	updated_at    *time.Time
+	deleted_at    *time.Time
+	token         *string
+	ns            *string
+	root          *string
+	zero_depth    *bool
+	file_type     *int
+	addfile_type  *int
+	owner         *string
+	duration      *int64
+	addduration   *int64
+	expire_at     *time.Time
+	held_until    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*FsLock, error)
+	predicates    []predicate.FsLock
 }
 
-var _ ent.Mutation = (*GroupMutation)(nil)
+var _ ent.Mutation = (*FsLockMutation)(nil)
 
-// groupOption allows management of the mutation configuration using functional options.
-type groupOption func(*GroupMutation)
+// fslockOption allows management of the mutation configuration using functional options.
+type fslockOption func(*FsLockMutation)
 
-// newGroupMutation creates new mutation for the Group entity.
-func newGroupMutation(c config, op Op, opts ...groupOption) *GroupMutation {
-	m := &GroupMutation{
+// newFsLockMutation creates new mutation for the FsLock entity.
+func newFsLockMutation(c config, op Op, opts ...fslockOption) *FsLockMutation {
+	m := &FsLockMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeGroup,
+		typ:           TypeFsLock,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5360,20 +5446,20 @@ func newGroupMutation(c config, op Op, opts ...groupOption) *GroupMutation {
 	return m
 }
 
-// withGroupID sets the ID field of the mutation.
-func withGroupID(id int) groupOption {
-	return func(m *GroupMutation) {
+// withFsLockID sets the ID field of the mutation.
+func withFsLockID(id int) fslockOption {
+	return func(m *FsLockMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Group
+			value *FsLock
 		)
-		m.oldValue = func(ctx context.Context) (*Group, error) {
+		m.oldValue = func(ctx context.Context) (*FsLock, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Group.Get(ctx, id)
+					value, err = m.Client().FsLock.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5382,10 +5468,10 @@ func withGroupID(id int) groupOption {
 	}
 }
 
-// withGroup sets the old Group of the mutation.
-func withGroup(node *Group) groupOption {
-	return func(m *GroupMutation) {
-		m.oldValue = func(context.Context) (*Group, error) {
+// withFsLock sets the old FsLock of the mutation.
+func withFsLock(node *FsLock) fslockOption {
+	return func(m *FsLockMutation) {
+		m.oldValue = func(context.Context) (*FsLock, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5394,7 +5480,7 @@ func withGroup(node *Group) groupOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m GroupMutation) Client() *Client {
+func (m FsLockMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -5402,7 +5488,7 @@ func (m GroupMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m GroupMutation) Tx() (*Tx, error) {
+func (m FsLockMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -5413,7 +5499,7 @@ func (m GroupMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *GroupMutation) ID() (id int, exists bool) {
+func (m *FsLockMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -5424,7 +5510,7 @@ func (m *GroupMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *GroupMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *FsLockMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -5433,19 +5519,19 @@ func (m *GroupMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Group.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().FsLock.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *GroupMutation) SetCreatedAt(t time.Time) {
+func (m *FsLockMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *GroupMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *FsLockMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -5453,10 +5539,10 @@ func (m *GroupMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Group entity.
-// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the FsLock entity.
+// If the FsLock object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *FsLockMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -5471,17 +5557,17 @@ func (m *GroupMutation) OldCreatedAt(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *GroupMutation) ResetCreatedAt() {
+func (m *FsLockMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *GroupMutation) SetUpdatedAt(t time.Time) {
+func (m *FsLockMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *GroupMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *FsLockMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -5489,10 +5575,10 @@ func (m *GroupMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Group entity.
-// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the FsLock entity.
+// If the FsLock object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *FsLockMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -5507,17 +5593,17 @@ func (m *GroupMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *GroupMutation) ResetUpdatedAt() {
+func (m *FsLockMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
 // SetDeletedAt sets the "deleted_at" field.
-func (m *GroupMutation) SetDeletedAt(t time.Time) {
+func (m *FsLockMutation) SetDeletedAt(t time.Time) {
 	m.deleted_at = &t
 }
 
 // DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *GroupMutation) DeletedAt() (r time.Time, exists bool) {
+func (m *FsLockMutation) DeletedAt() (r time.Time, exists bool) {
 	v := m.deleted_at
 	if v == nil {
 		return
@@ -5525,10 +5611,10 @@ func (m *GroupMutation) DeletedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the Group entity.
-// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedAt returns the old "deleted_at" field's value of the FsLock entity.
+// If the FsLock object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *FsLockMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
@@ -5543,436 +5629,435 @@ func (m *GroupMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err err
 }
 
 // ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *GroupMutation) ClearDeletedAt() {
+func (m *FsLockMutation) ClearDeletedAt() {
 	m.deleted_at = nil
-	m.clearedFields[group.FieldDeletedAt] = struct{}{}
+	m.clearedFields[fslock.FieldDeletedAt] = struct{}{}
 }
 
 // DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *GroupMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[group.FieldDeletedAt]
+func (m *FsLockMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[fslock.FieldDeletedAt]
 	return ok
 }
 
 // ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *GroupMutation) ResetDeletedAt() {
+func (m *FsLockMutation) ResetDeletedAt() {
 	m.deleted_at = nil
-	delete(m.clearedFields, group.FieldDeletedAt)
+	delete(m.clearedFields, fslock.FieldDeletedAt)
 }
 
-// SetName sets the "name" field.
-func (m *GroupMutation) SetName(s string) {
-	m.name = &s
+// SetToken sets the "token" field.
+func (m *FsLockMutation) SetToken(s string) {
+	m.token = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *GroupMutation) Name() (r string, exists bool) {
-	v := m.name
+// Token returns the value of the "token" field in the mutation.
+func (m *FsLockMutation) Token() (r string, exists bool) {
+	v := m.token
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Group entity.
-// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// OldToken returns the old "token" field's value of the FsLock entity.
+// If the FsLock object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *FsLockMutation) OldToken(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldToken requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Token, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *GroupMutation) ResetName() {
-	m.name = nil
+// ResetToken resets all changes to the "token" field.
+func (m *FsLockMutation) ResetToken() {
+	m.token = nil
 }
 
-// SetMaxStorage sets the "max_storage" field.
-func (m *GroupMutation) SetMaxStorage(i int64) {
-	m.max_storage = &i
-	m.addmax_storage = nil
+// SetNs sets the "ns" field.
+func (m *FsLockMutation) SetNs(s string) {
+	m.ns = &s
 }
 
-// MaxStorage returns the value of the "max_storage" field in the mutation.
-func (m *GroupMutation) MaxStorage() (r int64, exists bool) {
-	v := m.max_storage
+// Ns returns the value of the "ns" field in the mutation.
+func (m *FsLockMutation) Ns() (r string, exists bool) {
+	v := m.ns
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMaxStorage returns the old "max_storage" field's value of the Group entity.
-// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// OldNs returns the old "ns" field's value of the FsLock entity.
+// If the FsLock object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMutation) OldMaxStorage(ctx context.Context) (v int64, err error) {
+func (m *FsLockMutation) OldNs(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMaxStorage is only allowed on UpdateOne operations")
+		return v, errors.New("OldNs is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMaxStorage requires an ID field in the mutation")
+		return v, errors.New("OldNs requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMaxStorage: %w", err)
+		return v, fmt.Errorf("querying old value for OldNs: %w", err)
 	}
-	return oldValue.MaxStorage, nil
+	return oldValue.Ns, nil
 }
 
-// AddMaxStorage adds i to the "max_storage" field.
-func (m *GroupMutation) AddMaxStorage(i int64) {
-	if m.addmax_storage != nil {
-		*m.addmax_storage += i
-	} else {
-		m.addmax_storage = &i
-	}
+// ResetNs resets all changes to the "ns" field.
+func (m *FsLockMutation) ResetNs() {
+	m.ns = nil
 }
 
-// AddedMaxStorage returns the value that was added to the "max_storage" field in this mutation.
-func (m *GroupMutation) AddedMaxStorage() (r int64, exists bool) {
-	v := m.addmax_storage
+// SetRoot sets the "root" field.
+func (m *FsLockMutation) SetRoot(s string) {
+	m.root = &s
+}
+
+// Root returns the value of the "root" field in the mutation.
+func (m *FsLockMutation) Root() (r string, exists bool) {
+	v := m.root
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearMaxStorage clears the value of the "max_storage" field.
-func (m *GroupMutation) ClearMaxStorage() {
-	m.max_storage = nil
-	m.addmax_storage = nil
-	m.clearedFields[group.FieldMaxStorage] = struct{}{}
-}
-
-// MaxStorageCleared returns if the "max_storage" field was cleared in this mutation.
-func (m *GroupMutation) MaxStorageCleared() bool {
-	_, ok := m.clearedFields[group.FieldMaxStorage]
-	return ok
+// OldRoot returns the old "root" field's value of the FsLock entity.
+// If the FsLock object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FsLockMutation) OldRoot(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoot is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoot requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoot: %w", err)
+	}
+	return oldValue.Root, nil
 }
 
-// ResetMaxStorage resets all changes to the "max_storage" field.
-func (m *GroupMutation) ResetMaxStorage() {
-	m.max_storage = nil
-	m.addmax_storage = nil
-	delete(m.clearedFields, group.FieldMaxStorage)
+// ResetRoot resets all changes to the "root" field.
+func (m *FsLockMutation) ResetRoot() {
+	m.root = nil
 }
 
-// SetSpeedLimit sets the "speed_limit" field.
-func (m *GroupMutation) SetSpeedLimit(i int) {
-	m.speed_limit = &i
-	m.addspeed_limit = nil
+// SetZeroDepth sets the "zero_depth" field.
+func (m *FsLockMutation) SetZeroDepth(b bool) {
+	m.zero_depth = &b
 }
 
-// SpeedLimit returns the value of the "speed_limit" field in the mutation.
-func (m *GroupMutation) SpeedLimit() (r int, exists bool) {
-	v := m.speed_limit
+// ZeroDepth returns the value of the "zero_depth" field in the mutation.
+func (m *FsLockMutation) ZeroDepth() (r bool, exists bool) {
+	v := m.zero_depth
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSpeedLimit returns the old "speed_limit" field's value of the Group entity.
-// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// OldZeroDepth returns the old "zero_depth" field's value of the FsLock entity.
+// If the FsLock object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMutation) OldSpeedLimit(ctx context.Context) (v int, err error) {
+func (m *FsLockMutation) OldZeroDepth(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSpeedLimit is only allowed on UpdateOne operations")
+		return v, errors.New("OldZeroDepth is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSpeedLimit requires an ID field in the mutation")
+		return v, errors.New("OldZeroDepth requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSpeedLimit: %w", err)
+		return v, fmt.Errorf("querying old value for OldZeroDepth: %w", err)
 	}
-	return oldValue.SpeedLimit, nil
+	return oldValue.ZeroDepth, nil
 }
 
-// AddSpeedLimit adds i to the "speed_limit" field.
-func (m *GroupMutation) AddSpeedLimit(i int) {
-	if m.addspeed_limit != nil {
-		*m.addspeed_limit += i
-	} else {
-		m.addspeed_limit = &i
-	}
+// ResetZeroDepth resets all changes to the "zero_depth" field.
+func (m *FsLockMutation) ResetZeroDepth() {
+	m.zero_depth = nil
 }
 
-// AddedSpeedLimit returns the value that was added to the "speed_limit" field in this mutation.
-func (m *GroupMutation) AddedSpeedLimit() (r int, exists bool) {
-	v := m.addspeed_limit
+// SetFileType sets the "file_type" field.
+func (m *FsLockMutation) SetFileType(i int) {
+	m.file_type = &i
+	m.addfile_type = nil
+}
+
+// FileType returns the value of the "file_type" field in the mutation.
+func (m *FsLockMutation) FileType() (r int, exists bool) {
+	v := m.file_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearSpeedLimit clears the value of the "speed_limit" field.
-func (m *GroupMutation) ClearSpeedLimit() {
-	m.speed_limit = nil
-	m.addspeed_limit = nil
-	m.clearedFields[group.FieldSpeedLimit] = struct{}{}
+// OldFileType returns the old "file_type" field's value of the FsLock entity.
+// If the FsLock object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FsLockMutation) OldFileType(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFileType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFileType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFileType: %w", err)
+	}
+	return oldValue.FileType, nil
 }
 
-// SpeedLimitCleared returns if the "speed_limit" field was cleared in this mutation.
-func (m *GroupMutation) SpeedLimitCleared() bool {
-	_, ok := m.clearedFields[group.FieldSpeedLimit]
-	return ok
+// AddFileType adds i to the "file_type" field.
+func (m *FsLockMutation) AddFileType(i int) {
+	if m.addfile_type != nil {
+		*m.addfile_type += i
+	} else {
+		m.addfile_type = &i
+	}
 }
 
-// ResetSpeedLimit resets all changes to the "speed_limit" field.
-func (m *GroupMutation) ResetSpeedLimit() {
-	m.speed_limit = nil
-	m.addspeed_limit = nil
-	delete(m.clearedFields, group.FieldSpeedLimit)
+// AddedFileType returns the value that was added to the "file_type" field in this mutation.
+func (m *FsLockMutation) AddedFileType() (r int, exists bool) {
+	v := m.addfile_type
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetPermissions sets the "permissions" field.
-func (m *GroupMutation) SetPermissions(bs *boolset.BooleanSet) {
-	m.permissions = &bs
+// ResetFileType resets all changes to the "file_type" field.
+func (m *FsLockMutation) ResetFileType() {
+	m.file_type = nil
+	m.addfile_type = nil
 }
 
-// Permissions returns the value of the "permissions" field in the mutation.
-func (m *GroupMutation) Permissions() (r *boolset.BooleanSet, exists bool) {
-	v := m.permissions
+// SetOwner sets the "owner" field.
+func (m *FsLockMutation) SetOwner(s string) {
+	m.owner = &s
+}
+
+// Owner returns the value of the "owner" field in the mutation.
+func (m *FsLockMutation) Owner() (r string, exists bool) {
+	v := m.owner
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPermissions returns the old "permissions" field's value of the Group entity.
-// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// OldOwner returns the old "owner" field's value of the FsLock entity.
+// If the FsLock object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMutation) OldPermissions(ctx context.Context) (v *boolset.BooleanSet, err error) {
+func (m *FsLockMutation) OldOwner(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPermissions is only allowed on UpdateOne operations")
+		return v, errors.New("OldOwner is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPermissions requires an ID field in the mutation")
+		return v, errors.New("OldOwner requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPermissions: %w", err)
+		return v, fmt.Errorf("querying old value for OldOwner: %w", err)
 	}
-	return oldValue.Permissions, nil
+	return oldValue.Owner, nil
 }
 
-// ResetPermissions resets all changes to the "permissions" field.
-func (m *GroupMutation) ResetPermissions() {
-	m.permissions = nil
+// ClearOwner clears the value of the "owner" field.
+func (m *FsLockMutation) ClearOwner() {
+	m.owner = nil
+	m.clearedFields[fslock.FieldOwner] = struct{}{}
 }
 
-// SetSettings sets the "settings" field.
-func (m *GroupMutation) SetSettings(ts *types.GroupSetting) {
-	m.settings = &ts
+// OwnerCleared returns if the "owner" field was cleared in this mutation.
+func (m *FsLockMutation) OwnerCleared() bool {
+	_, ok := m.clearedFields[fslock.FieldOwner]
+	return ok
 }
 
-// Settings returns the value of the "settings" field in the mutation.
-func (m *GroupMutation) Settings() (r *types.GroupSetting, exists bool) {
-	v := m.settings
+// ResetOwner resets all changes to the "owner" field.
+func (m *FsLockMutation) ResetOwner() {
+	m.owner = nil
+	delete(m.clearedFields, fslock.FieldOwner)
+}
+
+// SetDuration sets the "duration" field.
+func (m *FsLockMutation) SetDuration(i int64) {
+	m.duration = &i
+	m.addduration = nil
+}
+
+// Duration returns the value of the "duration" field in the mutation.
+func (m *FsLockMutation) Duration() (r int64, exists bool) {
+	v := m.duration
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSettings returns the old "settings" field's value of the Group entity.
-// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// OldDuration returns the old "duration" field's value of the FsLock entity.
+// If the FsLock object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMutation) OldSettings(ctx context.Context) (v *types.GroupSetting, err error) {
+func (m *FsLockMutation) OldDuration(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSettings is only allowed on UpdateOne operations")
+		return v, errors.New("OldDuration is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSettings requires an ID field in the mutation")
+		return v, errors.New("OldDuration requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSettings: %w", err)
+		return v, fmt.Errorf("querying old value for OldDuration: %w", err)
 	}
-	return oldValue.Settings, nil
+	return oldValue.Duration, nil
 }
 
-// ClearSettings clears the value of the "settings" field.
-func (m *GroupMutation) ClearSettings() {
-	m.settings = nil
-	m.clearedFields[group.FieldSettings] = struct{}{}
+// AddDuration adds i to the "duration" field.
+func (m *FsLockMutation) AddDuration(i int64) {
+	if m.addduration != nil {
+		*m.addduration += i
+	} else {
+		m.addduration = &i
+	}
 }
 
-// SettingsCleared returns if the "settings" field was cleared in this mutation.
-func (m *GroupMutation) SettingsCleared() bool {
-	_, ok := m.clearedFields[group.FieldSettings]
-	return ok
+// AddedDuration returns the value that was added to the "duration" field in this mutation.
+func (m *FsLockMutation) AddedDuration() (r int64, exists bool) {
+	v := m.addduration
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetSettings resets all changes to the "settings" field.
-func (m *GroupMutation) ResetSettings() {
-	m.settings = nil
-	delete(m.clearedFields, group.FieldSettings)
+// ResetDuration resets all changes to the "duration" field.
+func (m *FsLockMutation) ResetDuration() {
+	m.duration = nil
+	m.addduration = nil
 }
 
-// SetStoragePolicyID sets the "storage_policy_id" field.
-func (m *GroupMutation) SetStoragePolicyID(i int) {
-	m.storage_policies = &i
+// SetExpireAt sets the "expire_at" field.
+func (m *FsLockMutation) SetExpireAt(t time.Time) {
+	m.expire_at = &t
 }
 
-// StoragePolicyID returns the value of the "storage_policy_id" field in the mutation.
-func (m *GroupMutation) StoragePolicyID() (r int, exists bool) {
-	v := m.storage_policies
+// ExpireAt returns the value of the "expire_at" field in the mutation.
+func (m *FsLockMutation) ExpireAt() (r time.Time, exists bool) {
+	v := m.expire_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStoragePolicyID returns the old "storage_policy_id" field's value of the Group entity.
-// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// OldExpireAt returns the old "expire_at" field's value of the FsLock entity.
+// If the FsLock object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMutation) OldStoragePolicyID(ctx context.Context) (v int, err error) {
+func (m *FsLockMutation) OldExpireAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStoragePolicyID is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpireAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStoragePolicyID requires an ID field in the mutation")
+		return v, errors.New("OldExpireAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStoragePolicyID: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpireAt: %w", err)
 	}
-	return oldValue.StoragePolicyID, nil
+	return oldValue.ExpireAt, nil
 }
 
-// ClearStoragePolicyID clears the value of the "storage_policy_id" field.
-func (m *GroupMutation) ClearStoragePolicyID() {
-	m.storage_policies = nil
-	m.clearedFields[group.FieldStoragePolicyID] = struct{}{}
+// ClearExpireAt clears the value of the "expire_at" field.
+func (m *FsLockMutation) ClearExpireAt() {
+	m.expire_at = nil
+	m.clearedFields[fslock.FieldExpireAt] = struct{}{}
 }
 
-// StoragePolicyIDCleared returns if the "storage_policy_id" field was cleared in this mutation.
-func (m *GroupMutation) StoragePolicyIDCleared() bool {
-	_, ok := m.clearedFields[group.FieldStoragePolicyID]
+// ExpireAtCleared returns if the "expire_at" field was cleared in this mutation.
+func (m *FsLockMutation) ExpireAtCleared() bool {
+	_, ok := m.clearedFields[fslock.FieldExpireAt]
 	return ok
 }
 
-// ResetStoragePolicyID resets all changes to the "storage_policy_id" field.
-func (m *GroupMutation) ResetStoragePolicyID() {
-	m.storage_policies = nil
-	delete(m.clearedFields, group.FieldStoragePolicyID)
-}
-
-// AddUserIDs adds the "users" edge to the User entity by ids.
-func (m *GroupMutation) AddUserIDs(ids ...int) {
-	if m.users == nil {
-		m.users = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.users[ids[i]] = struct{}{}
-	}
-}
-
-// ClearUsers clears the "users" edge to the User entity.
-func (m *GroupMutation) ClearUsers() {
-	m.clearedusers = true
+// ResetExpireAt resets all changes to the "expire_at" field.
+func (m *FsLockMutation) ResetExpireAt() {
+	m.expire_at = nil
+	delete(m.clearedFields, fslock.FieldExpireAt)
 }
 
-// UsersCleared reports if the "users" edge to the User entity was cleared.
-func (m *GroupMutation) UsersCleared() bool {
-	return m.clearedusers
+// SetHeldUntil sets the "held_until" field.
+func (m *FsLockMutation) SetHeldUntil(t time.Time) {
+	m.held_until = &t
 }
 
-// RemoveUserIDs removes the "users" edge to the User entity by IDs.
-func (m *GroupMutation) RemoveUserIDs(ids ...int) {
-	if m.removedusers == nil {
-		m.removedusers = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.users, ids[i])
-		m.removedusers[ids[i]] = struct{}{}
+// HeldUntil returns the value of the "held_until" field in the mutation.
+func (m *FsLockMutation) HeldUntil() (r time.Time, exists bool) {
+	v := m.held_until
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedUsers returns the removed IDs of the "users" edge to the User entity.
-func (m *GroupMutation) RemovedUsersIDs() (ids []int) {
-	for id := range m.removedusers {
-		ids = append(ids, id)
+// OldHeldUntil returns the old "held_until" field's value of the FsLock entity.
+// If the FsLock object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FsLockMutation) OldHeldUntil(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHeldUntil is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// UsersIDs returns the "users" edge IDs in the mutation.
-func (m *GroupMutation) UsersIDs() (ids []int) {
-	for id := range m.users {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHeldUntil requires an ID field in the mutation")
 	}
-	return
-}
-
-// ResetUsers resets all changes to the "users" edge.
-func (m *GroupMutation) ResetUsers() {
-	m.users = nil
-	m.clearedusers = false
-	m.removedusers = nil
-}
-
-// SetStoragePoliciesID sets the "storage_policies" edge to the StoragePolicy entity by id.
-func (m *GroupMutation) SetStoragePoliciesID(id int) {
-	m.storage_policies = &id
-}
-
-// ClearStoragePolicies clears the "storage_policies" edge to the StoragePolicy entity.
-func (m *GroupMutation) ClearStoragePolicies() {
-	m.clearedstorage_policies = true
-	m.clearedFields[group.FieldStoragePolicyID] = struct{}{}
-}
-
-// StoragePoliciesCleared reports if the "storage_policies" edge to the StoragePolicy entity was cleared.
-func (m *GroupMutation) StoragePoliciesCleared() bool {
-	return m.StoragePolicyIDCleared() || m.clearedstorage_policies
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHeldUntil: %w", err)
+	}
+	return oldValue.HeldUntil, nil
 }
 
-// StoragePoliciesID returns the "storage_policies" edge ID in the mutation.
-func (m *GroupMutation) StoragePoliciesID() (id int, exists bool) {
-	if m.storage_policies != nil {
-		return *m.storage_policies, true
-	}
-	return
+// ClearHeldUntil clears the value of the "held_until" field.
+func (m *FsLockMutation) ClearHeldUntil() {
+	m.held_until = nil
+	m.clearedFields[fslock.FieldHeldUntil] = struct{}{}
 }
 
-// StoragePoliciesIDs returns the "storage_policies" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// StoragePoliciesID instead. It exists only for internal usage by the builders.
-func (m *GroupMutation) StoragePoliciesIDs() (ids []int) {
-	if id := m.storage_policies; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// HeldUntilCleared returns if the "held_until" field was cleared in this mutation.
+func (m *FsLockMutation) HeldUntilCleared() bool {
+	_, ok := m.clearedFields[fslock.FieldHeldUntil]
+	return ok
 }
 
-// ResetStoragePolicies resets all changes to the "storage_policies" edge.
-func (m *GroupMutation) ResetStoragePolicies() {
-	m.storage_policies = nil
-	m.clearedstorage_policies = false
+// ResetHeldUntil resets all changes to the "held_until" field.
+func (m *FsLockMutation) ResetHeldUntil() {
+	m.held_until = nil
+	delete(m.clearedFields, fslock.FieldHeldUntil)
 }
 
-// Where appends a list predicates to the GroupMutation builder.
-func (m *GroupMutation) Where(ps ...predicate.Group) {
+// Where appends a list predicates to the FsLockMutation builder.
+func (m *FsLockMutation) Where(ps ...predicate.FsLock) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the GroupMutation builder. Using this method,
+// WhereP appends storage-level predicates to the FsLockMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *GroupMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Group, len(ps))
+func (m *FsLockMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.FsLock, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5980,51 +6065,60 @@ func (m *GroupMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *GroupMutation) Op() Op {
+func (m *FsLockMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *GroupMutation) SetOp(op Op) {
+func (m *FsLockMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Group).
-func (m *GroupMutation) Type() string {
+// Type returns the node type of this mutation (FsLock).
+func (m *FsLockMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *GroupMutation) Fields() []string {
-	fields := make([]string, 0, 9)
+func (m *FsLockMutation) Fields() []string {
+	fields := make([]string, 0, 12)
 	if m.created_at != nil {
-		fields = append(fields, group.FieldCreatedAt)
+		fields = append(fields, fslock.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, group.FieldUpdatedAt)
+		fields = append(fields, fslock.FieldUpdatedAt)
 	}
 	if m.deleted_at != nil {
-		fields = append(fields, group.FieldDeletedAt)
+		fields = append(fields, fslock.FieldDeletedAt)
 	}
-	if m.name != nil {
-		fields = append(fields, group.FieldName)
+	if m.token != nil {
+		fields = append(fields, fslock.FieldToken)
 	}
-	if m.max_storage != nil {
-		fields = append(fields, group.FieldMaxStorage)
+	if m.ns != nil {
+		fields = append(fields, fslock.FieldNs)
 	}
-	if m.speed_limit != nil {
-		fields = append(fields, group.FieldSpeedLimit)
+	if m.root != nil {
+		fields = append(fields, fslock.FieldRoot)
 	}
-	if m.permissions != nil {
-		fields = append(fields, group.FieldPermissions)
+	if m.zero_depth != nil {
+		fields = append(fields, fslock.FieldZeroDepth)
 	}
-	if m.settings != nil {
-		fields = append(fields, group.FieldSettings)
+	if m.file_type != nil {
+		fields = append(fields, fslock.FieldFileType)
 	}
-	if m.storage_policies != nil {
-		fields = append(fields, group.FieldStoragePolicyID)
+	if m.owner != nil {
+		fields = append(fields, fslock.FieldOwner)
+	}
+	if m.duration != nil {
+		fields = append(fields, fslock.FieldDuration)
+	}
+	if m.expire_at != nil {
+		fields = append(fields, fslock.FieldExpireAt)
+	}
+	if m.held_until != nil {
+		fields = append(fields, fslock.FieldHeldUntil)
 	}
 	return fields
 }
@@ -6032,26 +6126,32 @@ func (m *GroupMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *GroupMutation) Field(name string) (ent.Value, bool) {
+func (m *FsLockMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case group.FieldCreatedAt:
+	case fslock.FieldCreatedAt:
 		return m.CreatedAt()
-	case group.FieldUpdatedAt:
+	case fslock.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case group.FieldDeletedAt:
+	case fslock.FieldDeletedAt:
 		return m.DeletedAt()
-	case group.FieldName:
-		return m.Name()
-	case group.FieldMaxStorage:
-		return m.MaxStorage()
-	case group.FieldSpeedLimit:
-		return m.SpeedLimit()
-	case group.FieldPermissions:
-		return m.Permissions()
-	case group.FieldSettings:
-		return m.Settings()
-	case group.FieldStoragePolicyID:
-		return m.StoragePolicyID()
+	case fslock.FieldToken:
+		return m.Token()
+	case fslock.FieldNs:
+		return m.Ns()
+	case fslock.FieldRoot:
+		return m.Root()
+	case fslock.FieldZeroDepth:
+		return m.ZeroDepth()
+	case fslock.FieldFileType:
+		return m.FileType()
+	case fslock.FieldOwner:
+		return m.Owner()
+	case fslock.FieldDuration:
+		return m.Duration()
+	case fslock.FieldExpireAt:
+		return m.ExpireAt()
+	case fslock.FieldHeldUntil:
+		return m.HeldUntil()
 	}
 	return nil, false
 }
@@ -6059,111 +6159,138 @@ func (m *GroupMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *GroupMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *FsLockMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case group.FieldCreatedAt:
+	case fslock.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case group.FieldUpdatedAt:
+	case fslock.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case group.FieldDeletedAt:
+	case fslock.FieldDeletedAt:
 		return m.OldDeletedAt(ctx)
-	case group.FieldName:
-		return m.OldName(ctx)
-	case group.FieldMaxStorage:
-		return m.OldMaxStorage(ctx)
-	case group.FieldSpeedLimit:
-		return m.OldSpeedLimit(ctx)
-	case group.FieldPermissions:
-		return m.OldPermissions(ctx)
-	case group.FieldSettings:
-		return m.OldSettings(ctx)
-	case group.FieldStoragePolicyID:
-		return m.OldStoragePolicyID(ctx)
-	}
-	return nil, fmt.Errorf("unknown Group field %s", name)
+	case fslock.FieldToken:
+		return m.OldToken(ctx)
+	case fslock.FieldNs:
+		return m.OldNs(ctx)
+	case fslock.FieldRoot:
+		return m.OldRoot(ctx)
+	case fslock.FieldZeroDepth:
+		return m.OldZeroDepth(ctx)
+	case fslock.FieldFileType:
+		return m.OldFileType(ctx)
+	case fslock.FieldOwner:
+		return m.OldOwner(ctx)
+	case fslock.FieldDuration:
+		return m.OldDuration(ctx)
+	case fslock.FieldExpireAt:
+		return m.OldExpireAt(ctx)
+	case fslock.FieldHeldUntil:
+		return m.OldHeldUntil(ctx)
+	}
+	return nil, fmt.Errorf("unknown FsLock field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *GroupMutation) SetField(name string, value ent.Value) error {
+func (m *FsLockMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case group.FieldCreatedAt:
+	case fslock.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case group.FieldUpdatedAt:
+	case fslock.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case group.FieldDeletedAt:
+	case fslock.FieldDeletedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDeletedAt(v)
 		return nil
-	case group.FieldName:
+	case fslock.FieldToken:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetToken(v)
 		return nil
-	case group.FieldMaxStorage:
-		v, ok := value.(int64)
+	case fslock.FieldNs:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMaxStorage(v)
+		m.SetNs(v)
 		return nil
-	case group.FieldSpeedLimit:
+	case fslock.FieldRoot:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoot(v)
+		return nil
+	case fslock.FieldZeroDepth:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetZeroDepth(v)
+		return nil
+	case fslock.FieldFileType:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSpeedLimit(v)
+		m.SetFileType(v)
 		return nil
-	case group.FieldPermissions:
-		v, ok := value.(*boolset.BooleanSet)
+	case fslock.FieldOwner:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPermissions(v)
+		m.SetOwner(v)
 		return nil
-	case group.FieldSettings:
-		v, ok := value.(*types.GroupSetting)
+	case fslock.FieldDuration:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSettings(v)
+		m.SetDuration(v)
 		return nil
-	case group.FieldStoragePolicyID:
-		v, ok := value.(int)
+	case fslock.FieldExpireAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStoragePolicyID(v)
+		m.SetExpireAt(v)
+		return nil
+	case fslock.FieldHeldUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeldUntil(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Group field %s", name)
+	return fmt.Errorf("unknown FsLock field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *GroupMutation) AddedFields() []string {
+func (m *FsLockMutation) AddedFields() []string {
 	var fields []string
-	if m.addmax_storage != nil {
-		fields = append(fields, group.FieldMaxStorage)
+	if m.addfile_type != nil {
+		fields = append(fields, fslock.FieldFileType)
 	}
-	if m.addspeed_limit != nil {
-		fields = append(fields, group.FieldSpeedLimit)
+	if m.addduration != nil {
+		fields = append(fields, fslock.FieldDuration)
 	}
 	return fields
 }
@@ -6171,12 +6298,12 @@ func (m *GroupMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *GroupMutation) AddedField(name string) (ent.Value, bool) {
+func (m *FsLockMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case group.FieldMaxStorage:
-		return m.AddedMaxStorage()
-	case group.FieldSpeedLimit:
-		return m.AddedSpeedLimit()
+	case fslock.FieldFileType:
+		return m.AddedFileType()
+	case fslock.FieldDuration:
+		return m.AddedDuration()
 	}
 	return nil, false
 }
@@ -6184,246 +6311,202 @@ func (m *GroupMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *GroupMutation) AddField(name string, value ent.Value) error {
+func (m *FsLockMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case group.FieldMaxStorage:
-		v, ok := value.(int64)
+	case fslock.FieldFileType:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddMaxStorage(v)
+		m.AddFileType(v)
 		return nil
-	case group.FieldSpeedLimit:
-		v, ok := value.(int)
+	case fslock.FieldDuration:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddSpeedLimit(v)
+		m.AddDuration(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Group numeric field %s", name)
+	return fmt.Errorf("unknown FsLock numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *GroupMutation) ClearedFields() []string {
+func (m *FsLockMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(group.FieldDeletedAt) {
-		fields = append(fields, group.FieldDeletedAt)
+	if m.FieldCleared(fslock.FieldDeletedAt) {
+		fields = append(fields, fslock.FieldDeletedAt)
 	}
-	if m.FieldCleared(group.FieldMaxStorage) {
-		fields = append(fields, group.FieldMaxStorage)
-	}
-	if m.FieldCleared(group.FieldSpeedLimit) {
-		fields = append(fields, group.FieldSpeedLimit)
+	if m.FieldCleared(fslock.FieldOwner) {
+		fields = append(fields, fslock.FieldOwner)
 	}
-	if m.FieldCleared(group.FieldSettings) {
-		fields = append(fields, group.FieldSettings)
+	if m.FieldCleared(fslock.FieldExpireAt) {
+		fields = append(fields, fslock.FieldExpireAt)
 	}
-	if m.FieldCleared(group.FieldStoragePolicyID) {
-		fields = append(fields, group.FieldStoragePolicyID)
+	if m.FieldCleared(fslock.FieldHeldUntil) {
+		fields = append(fields, fslock.FieldHeldUntil)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *GroupMutation) FieldCleared(name string) bool {
+func (m *FsLockMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *GroupMutation) ClearField(name string) error {
+func (m *FsLockMutation) ClearField(name string) error {
 	switch name {
-	case group.FieldDeletedAt:
+	case fslock.FieldDeletedAt:
 		m.ClearDeletedAt()
 		return nil
-	case group.FieldMaxStorage:
-		m.ClearMaxStorage()
-		return nil
-	case group.FieldSpeedLimit:
-		m.ClearSpeedLimit()
+	case fslock.FieldOwner:
+		m.ClearOwner()
 		return nil
-	case group.FieldSettings:
-		m.ClearSettings()
+	case fslock.FieldExpireAt:
+		m.ClearExpireAt()
 		return nil
-	case group.FieldStoragePolicyID:
-		m.ClearStoragePolicyID()
+	case fslock.FieldHeldUntil:
+		m.ClearHeldUntil()
 		return nil
 	}
-	return fmt.Errorf("unknown Group nullable field %s", name)
+	return fmt.Errorf("unknown FsLock nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *GroupMutation) ResetField(name string) error {
+func (m *FsLockMutation) ResetField(name string) error {
 	switch name {
-	case group.FieldCreatedAt:
+	case fslock.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case group.FieldUpdatedAt:
+	case fslock.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case group.FieldDeletedAt:
+	case fslock.FieldDeletedAt:
 		m.ResetDeletedAt()
 		return nil
-	case group.FieldName:
-		m.ResetName()
+	case fslock.FieldToken:
+		m.ResetToken()
 		return nil
-	case group.FieldMaxStorage:
-		m.ResetMaxStorage()
+	case fslock.FieldNs:
+		m.ResetNs()
 		return nil
-	case group.FieldSpeedLimit:
-		m.ResetSpeedLimit()
+	case fslock.FieldRoot:
+		m.ResetRoot()
 		return nil
-	case group.FieldPermissions:
-		m.ResetPermissions()
+	case fslock.FieldZeroDepth:
+		m.ResetZeroDepth()
 		return nil
-	case group.FieldSettings:
-		m.ResetSettings()
+	case fslock.FieldFileType:
+		m.ResetFileType()
 		return nil
-	case group.FieldStoragePolicyID:
-		m.ResetStoragePolicyID()
+	case fslock.FieldOwner:
+		m.ResetOwner()
+		return nil
+	case fslock.FieldDuration:
+		m.ResetDuration()
+		return nil
+	case fslock.FieldExpireAt:
+		m.ResetExpireAt()
+		return nil
+	case fslock.FieldHeldUntil:
+		m.ResetHeldUntil()
 		return nil
 	}
-	return fmt.Errorf("unknown Group field %s", name)
+	return fmt.Errorf("unknown FsLock field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *GroupMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.users != nil {
-		edges = append(edges, group.EdgeUsers)
-	}
-	if m.storage_policies != nil {
-		edges = append(edges, group.EdgeStoragePolicies)
-	}
+func (m *FsLockMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *GroupMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case group.EdgeUsers:
-		ids := make([]ent.Value, 0, len(m.users))
-		for id := range m.users {
-			ids = append(ids, id)
-		}
-		return ids
-	case group.EdgeStoragePolicies:
-		if id := m.storage_policies; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *FsLockMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *GroupMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedusers != nil {
-		edges = append(edges, group.EdgeUsers)
-	}
+func (m *FsLockMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *GroupMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case group.EdgeUsers:
-		ids := make([]ent.Value, 0, len(m.removedusers))
-		for id := range m.removedusers {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *FsLockMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *GroupMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedusers {
-		edges = append(edges, group.EdgeUsers)
-	}
-	if m.clearedstorage_policies {
-		edges = append(edges, group.EdgeStoragePolicies)
-	}
+func (m *FsLockMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *GroupMutation) EdgeCleared(name string) bool {
-	switch name {
-	case group.EdgeUsers:
-		return m.clearedusers
-	case group.EdgeStoragePolicies:
-		return m.clearedstorage_policies
-	}
+func (m *FsLockMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *GroupMutation) ClearEdge(name string) error {
-	switch name {
-	case group.EdgeStoragePolicies:
-		m.ClearStoragePolicies()
-		return nil
-	}
-	return fmt.Errorf("unknown Group unique edge %s", name)
+func (m *FsLockMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown FsLock unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *GroupMutation) ResetEdge(name string) error {
-	switch name {
-	case group.EdgeUsers:
-		m.ResetUsers()
-		return nil
-	case group.EdgeStoragePolicies:
-		m.ResetStoragePolicies()
-		return nil
-	}
-	return fmt.Errorf("unknown Group edge %s", name)
+func (m *FsLockMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown FsLock edge %s", name)
 }
 
-// MetadataMutation represents an operation that mutates the Metadata nodes in the graph.
-type MetadataMutation struct {
+// GroupMutation represents an operation that mutates the Group nodes in the graph.
+type GroupMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	created_at    *time.Time
-	updated_at    *time.Time
-	deleted_at    *time.Time
-	name          *string
-	value         *string
-	is_public     *bool
-	clearedFields map[string]struct{}
-	file          *int
-	clearedfile   bool
-	done          bool
-	oldValue      func(context.Context) (*Metadata, error)
-	predicates    []predicate.Metadata
+	op                      Op
+	typ                     string
+	id                      *int
+	created_at              *time.Time
+	updated_at              *time.Time
+	deleted_at              *time.Time
+	name                    *string
+	max_storage             *int64
+	addmax_storage          *int64
+	speed_limit             *int
+	addspeed_limit          *int
+	permissions             **boolset.BooleanSet
+	settings                **types.GroupSetting
+	clearedFields           map[string]struct{}
+	users                   map[int]struct{}
+	removedusers            map[int]struct{}
+	clearedusers            bool
+	storage_policies        *int
+	clearedstorage_policies bool
+	done                    bool
+	oldValue                func(context.Context) (*Group, error)
+	predicates              []predicate.Group
 }
 
-var _ ent.Mutation = (*MetadataMutation)(nil)
+var _ ent.Mutation = (*GroupMutation)(nil)
 
-// metadataOption allows management of the mutation configuration using functional options.
-type metadataOption func(*MetadataMutation)
+// groupOption allows management of the mutation configuration using functional options.
+type groupOption func(*GroupMutation)
 
-// newMetadataMutation creates new mutation for the Metadata entity.
-func newMetadataMutation(c config, op Op, opts ...metadataOption) *MetadataMutation {
-	m := &MetadataMutation{
+// newGroupMutation creates new mutation for the Group entity.
+func newGroupMutation(c config, op Op, opts ...groupOption) *GroupMutation {
+	m := &GroupMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMetadata,
+		typ:           TypeGroup,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -6432,20 +6515,20 @@ func newMetadataMutation(c config, op Op, opts ...metadataOption) *MetadataMutat
 	return m
 }
 
-// withMetadataID sets the ID field of the mutation.
-func withMetadataID(id int) metadataOption {
-	return func(m *MetadataMutation) {
+// withGroupID sets the ID field of the mutation.
+func withGroupID(id int) groupOption {
+	return func(m *GroupMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Metadata
+			value *Group
 		)
-		m.oldValue = func(ctx context.Context) (*Metadata, error) {
+		m.oldValue = func(ctx context.Context) (*Group, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Metadata.Get(ctx, id)
+					value, err = m.Client().Group.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -6454,10 +6537,10 @@ func withMetadataID(id int) metadataOption {
 	}
 }
 
-// withMetadata sets the old Metadata of the mutation.
-func withMetadata(node *Metadata) metadataOption {
-	return func(m *MetadataMutation) {
-		m.oldValue = func(context.Context) (*Metadata, error) {
+// withGroup sets the old Group of the mutation.
+func withGroup(node *Group) groupOption {
+	return func(m *GroupMutation) {
+		m.oldValue = func(context.Context) (*Group, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -6466,7 +6549,7 @@ func withMetadata(node *Metadata) metadataOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MetadataMutation) Client() *Client {
+func (m GroupMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -6474,7 +6557,7 @@ func (m MetadataMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MetadataMutation) Tx() (*Tx, error) {
+func (m GroupMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -6485,7 +6568,7 @@ func (m MetadataMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MetadataMutation) ID() (id int, exists bool) {
+func (m *GroupMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -6496,7 +6579,7 @@ func (m *MetadataMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MetadataMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *GroupMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -6505,19 +6588,19 @@ func (m *MetadataMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Metadata.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Group.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *MetadataMutation) SetCreatedAt(t time.Time) {
+func (m *GroupMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *MetadataMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *GroupMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -6525,10 +6608,10 @@ func (m *MetadataMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Metadata entity.
-// If the Metadata object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MetadataMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *GroupMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -6543,17 +6626,17 @@ func (m *MetadataMutation) OldCreatedAt(ctx context.Context) (v time.Time, err e
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *MetadataMutation) ResetCreatedAt() {
+func (m *GroupMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *MetadataMutation) SetUpdatedAt(t time.Time) {
+func (m *GroupMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *MetadataMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *GroupMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -6561,10 +6644,10 @@ func (m *MetadataMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Metadata entity.
-// If the Metadata object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MetadataMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *GroupMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -6579,17 +6662,17 @@ func (m *MetadataMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err e
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *MetadataMutation) ResetUpdatedAt() {
+func (m *GroupMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
 // SetDeletedAt sets the "deleted_at" field.
-func (m *MetadataMutation) SetDeletedAt(t time.Time) {
+func (m *GroupMutation) SetDeletedAt(t time.Time) {
 	m.deleted_at = &t
 }
 
 // DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *MetadataMutation) DeletedAt() (r time.Time, exists bool) {
+func (m *GroupMutation) DeletedAt() (r time.Time, exists bool) {
 	v := m.deleted_at
 	if v == nil {
 		return
@@ -6597,10 +6680,10 @@ func (m *MetadataMutation) DeletedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the Metadata entity.
-// If the Metadata object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedAt returns the old "deleted_at" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MetadataMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *GroupMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
@@ -6615,30 +6698,30 @@ func (m *MetadataMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err
 }
 
 // ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *MetadataMutation) ClearDeletedAt() {
+func (m *GroupMutation) ClearDeletedAt() {
 	m.deleted_at = nil
-	m.clearedFields[metadata.FieldDeletedAt] = struct{}{}
+	m.clearedFields[group.FieldDeletedAt] = struct{}{}
 }
 
 // DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *MetadataMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[metadata.FieldDeletedAt]
+func (m *GroupMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[group.FieldDeletedAt]
 	return ok
 }
 
 // ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *MetadataMutation) ResetDeletedAt() {
+func (m *GroupMutation) ResetDeletedAt() {
 	m.deleted_at = nil
-	delete(m.clearedFields, metadata.FieldDeletedAt)
+	delete(m.clearedFields, group.FieldDeletedAt)
 }
 
 // SetName sets the "name" field.
-func (m *MetadataMutation) SetName(s string) {
+func (m *GroupMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *MetadataMutation) Name() (r string, exists bool) {
+func (m *GroupMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -6646,10 +6729,10 @@ func (m *MetadataMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Metadata entity.
-// If the Metadata object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MetadataMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *GroupMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -6664,200 +6747,439 @@ func (m *MetadataMutation) OldName(ctx context.Context) (v string, err error) {
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *MetadataMutation) ResetName() {
+func (m *GroupMutation) ResetName() {
 	m.name = nil
 }
 
-// SetValue sets the "value" field.
-func (m *MetadataMutation) SetValue(s string) {
-	m.value = &s
+// SetMaxStorage sets the "max_storage" field.
+func (m *GroupMutation) SetMaxStorage(i int64) {
+	m.max_storage = &i
+	m.addmax_storage = nil
 }
 
-// Value returns the value of the "value" field in the mutation.
-func (m *MetadataMutation) Value() (r string, exists bool) {
-	v := m.value
+// MaxStorage returns the value of the "max_storage" field in the mutation.
+func (m *GroupMutation) MaxStorage() (r int64, exists bool) {
+	v := m.max_storage
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldValue returns the old "value" field's value of the Metadata entity.
-// If the Metadata object wasn't provided to the builder, the object is fetched from the database.
+// OldMaxStorage returns the old "max_storage" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MetadataMutation) OldValue(ctx context.Context) (v string, err error) {
+func (m *GroupMutation) OldMaxStorage(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+		return v, errors.New("OldMaxStorage is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldValue requires an ID field in the mutation")
+		return v, errors.New("OldMaxStorage requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+		return v, fmt.Errorf("querying old value for OldMaxStorage: %w", err)
 	}
-	return oldValue.Value, nil
+	return oldValue.MaxStorage, nil
 }
 
-// ResetValue resets all changes to the "value" field.
-func (m *MetadataMutation) ResetValue() {
-	m.value = nil
+// AddMaxStorage adds i to the "max_storage" field.
+func (m *GroupMutation) AddMaxStorage(i int64) {
+	if m.addmax_storage != nil {
+		*m.addmax_storage += i
+	} else {
+		m.addmax_storage = &i
+	}
 }
 
-// SetFileID sets the "file_id" field.
-func (m *MetadataMutation) SetFileID(i int) {
-	m.file = &i
+// AddedMaxStorage returns the value that was added to the "max_storage" field in this mutation.
+func (m *GroupMutation) AddedMaxStorage() (r int64, exists bool) {
+	v := m.addmax_storage
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// FileID returns the value of the "file_id" field in the mutation.
-func (m *MetadataMutation) FileID() (r int, exists bool) {
-	v := m.file
+// ClearMaxStorage clears the value of the "max_storage" field.
+func (m *GroupMutation) ClearMaxStorage() {
+	m.max_storage = nil
+	m.addmax_storage = nil
+	m.clearedFields[group.FieldMaxStorage] = struct{}{}
+}
+
+// MaxStorageCleared returns if the "max_storage" field was cleared in this mutation.
+func (m *GroupMutation) MaxStorageCleared() bool {
+	_, ok := m.clearedFields[group.FieldMaxStorage]
+	return ok
+}
+
+// ResetMaxStorage resets all changes to the "max_storage" field.
+func (m *GroupMutation) ResetMaxStorage() {
+	m.max_storage = nil
+	m.addmax_storage = nil
+	delete(m.clearedFields, group.FieldMaxStorage)
+}
+
+// SetSpeedLimit sets the "speed_limit" field.
+func (m *GroupMutation) SetSpeedLimit(i int) {
+	m.speed_limit = &i
+	m.addspeed_limit = nil
+}
+
+// SpeedLimit returns the value of the "speed_limit" field in the mutation.
+func (m *GroupMutation) SpeedLimit() (r int, exists bool) {
+	v := m.speed_limit
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFileID returns the old "file_id" field's value of the Metadata entity.
-// If the Metadata object wasn't provided to the builder, the object is fetched from the database.
+// OldSpeedLimit returns the old "speed_limit" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MetadataMutation) OldFileID(ctx context.Context) (v int, err error) {
+func (m *GroupMutation) OldSpeedLimit(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFileID is only allowed on UpdateOne operations")
+		return v, errors.New("OldSpeedLimit is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFileID requires an ID field in the mutation")
+		return v, errors.New("OldSpeedLimit requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFileID: %w", err)
+		return v, fmt.Errorf("querying old value for OldSpeedLimit: %w", err)
 	}
-	return oldValue.FileID, nil
+	return oldValue.SpeedLimit, nil
 }
 
-// ResetFileID resets all changes to the "file_id" field.
-func (m *MetadataMutation) ResetFileID() {
-	m.file = nil
+// AddSpeedLimit adds i to the "speed_limit" field.
+func (m *GroupMutation) AddSpeedLimit(i int) {
+	if m.addspeed_limit != nil {
+		*m.addspeed_limit += i
+	} else {
+		m.addspeed_limit = &i
+	}
 }
 
-// SetIsPublic sets the "is_public" field.
-func (m *MetadataMutation) SetIsPublic(b bool) {
-	m.is_public = &b
+// AddedSpeedLimit returns the value that was added to the "speed_limit" field in this mutation.
+func (m *GroupMutation) AddedSpeedLimit() (r int, exists bool) {
+	v := m.addspeed_limit
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// IsPublic returns the value of the "is_public" field in the mutation.
-func (m *MetadataMutation) IsPublic() (r bool, exists bool) {
-	v := m.is_public
+// ClearSpeedLimit clears the value of the "speed_limit" field.
+func (m *GroupMutation) ClearSpeedLimit() {
+	m.speed_limit = nil
+	m.addspeed_limit = nil
+	m.clearedFields[group.FieldSpeedLimit] = struct{}{}
+}
+
+// SpeedLimitCleared returns if the "speed_limit" field was cleared in this mutation.
+func (m *GroupMutation) SpeedLimitCleared() bool {
+	_, ok := m.clearedFields[group.FieldSpeedLimit]
+	return ok
+}
+
+// ResetSpeedLimit resets all changes to the "speed_limit" field.
+func (m *GroupMutation) ResetSpeedLimit() {
+	m.speed_limit = nil
+	m.addspeed_limit = nil
+	delete(m.clearedFields, group.FieldSpeedLimit)
+}
+
+// SetPermissions sets the "permissions" field.
+func (m *GroupMutation) SetPermissions(bs *boolset.BooleanSet) {
+	m.permissions = &bs
+}
+
+// Permissions returns the value of the "permissions" field in the mutation.
+func (m *GroupMutation) Permissions() (r *boolset.BooleanSet, exists bool) {
+	v := m.permissions
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsPublic returns the old "is_public" field's value of the Metadata entity.
-// If the Metadata object wasn't provided to the builder, the object is fetched from the database.
+// OldPermissions returns the old "permissions" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MetadataMutation) OldIsPublic(ctx context.Context) (v bool, err error) {
+func (m *GroupMutation) OldPermissions(ctx context.Context) (v *boolset.BooleanSet, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsPublic is only allowed on UpdateOne operations")
+		return v, errors.New("OldPermissions is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsPublic requires an ID field in the mutation")
+		return v, errors.New("OldPermissions requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsPublic: %w", err)
+		return v, fmt.Errorf("querying old value for OldPermissions: %w", err)
 	}
-	return oldValue.IsPublic, nil
+	return oldValue.Permissions, nil
 }
 
-// ResetIsPublic resets all changes to the "is_public" field.
-func (m *MetadataMutation) ResetIsPublic() {
-	m.is_public = nil
+// ResetPermissions resets all changes to the "permissions" field.
+func (m *GroupMutation) ResetPermissions() {
+	m.permissions = nil
 }
 
-// ClearFile clears the "file" edge to the File entity.
-func (m *MetadataMutation) ClearFile() {
-	m.clearedfile = true
-	m.clearedFields[metadata.FieldFileID] = struct{}{}
+// SetSettings sets the "settings" field.
+func (m *GroupMutation) SetSettings(ts *types.GroupSetting) {
+	m.settings = &ts
 }
 
-// FileCleared reports if the "file" edge to the File entity was cleared.
-func (m *MetadataMutation) FileCleared() bool {
-	return m.clearedfile
+// Settings returns the value of the "settings" field in the mutation.
+func (m *GroupMutation) Settings() (r *types.GroupSetting, exists bool) {
+	v := m.settings
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// FileIDs returns the "file" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// FileID instead. It exists only for internal usage by the builders.
-func (m *MetadataMutation) FileIDs() (ids []int) {
-	if id := m.file; id != nil {
-		ids = append(ids, *id)
+// OldSettings returns the old "settings" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMutation) OldSettings(ctx context.Context) (v *types.GroupSetting, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSettings is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSettings requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSettings: %w", err)
+	}
+	return oldValue.Settings, nil
 }
 
-// ResetFile resets all changes to the "file" edge.
-func (m *MetadataMutation) ResetFile() {
-	m.file = nil
-	m.clearedfile = false
+// ClearSettings clears the value of the "settings" field.
+func (m *GroupMutation) ClearSettings() {
+	m.settings = nil
+	m.clearedFields[group.FieldSettings] = struct{}{}
 }
 
-// Where appends a list predicates to the MetadataMutation builder.
-func (m *MetadataMutation) Where(ps ...predicate.Metadata) {
-	m.predicates = append(m.predicates, ps...)
+// SettingsCleared returns if the "settings" field was cleared in this mutation.
+func (m *GroupMutation) SettingsCleared() bool {
+	_, ok := m.clearedFields[group.FieldSettings]
+	return ok
 }
 
-// WhereP appends storage-level predicates to the MetadataMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MetadataMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Metadata, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
+// ResetSettings resets all changes to the "settings" field.
+func (m *GroupMutation) ResetSettings() {
+	m.settings = nil
+	delete(m.clearedFields, group.FieldSettings)
 }
 
-// Op returns the operation name.
-func (m *MetadataMutation) Op() Op {
-	return m.op
+// SetStoragePolicyID sets the "storage_policy_id" field.
+func (m *GroupMutation) SetStoragePolicyID(i int) {
+	m.storage_policies = &i
+}
+
+// StoragePolicyID returns the value of the "storage_policy_id" field in the mutation.
+func (m *GroupMutation) StoragePolicyID() (r int, exists bool) {
+	v := m.storage_policies
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStoragePolicyID returns the old "storage_policy_id" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMutation) OldStoragePolicyID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStoragePolicyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStoragePolicyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStoragePolicyID: %w", err)
+	}
+	return oldValue.StoragePolicyID, nil
+}
+
+// ClearStoragePolicyID clears the value of the "storage_policy_id" field.
+func (m *GroupMutation) ClearStoragePolicyID() {
+	m.storage_policies = nil
+	m.clearedFields[group.FieldStoragePolicyID] = struct{}{}
+}
+
+// StoragePolicyIDCleared returns if the "storage_policy_id" field was cleared in this mutation.
+func (m *GroupMutation) StoragePolicyIDCleared() bool {
+	_, ok := m.clearedFields[group.FieldStoragePolicyID]
+	return ok
+}
+
+// ResetStoragePolicyID resets all changes to the "storage_policy_id" field.
+func (m *GroupMutation) ResetStoragePolicyID() {
+	m.storage_policies = nil
+	delete(m.clearedFields, group.FieldStoragePolicyID)
+}
+
+// AddUserIDs adds the "users" edge to the User entity by ids.
+func (m *GroupMutation) AddUserIDs(ids ...int) {
+	if m.users == nil {
+		m.users = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.users[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUsers clears the "users" edge to the User entity.
+func (m *GroupMutation) ClearUsers() {
+	m.clearedusers = true
+}
+
+// UsersCleared reports if the "users" edge to the User entity was cleared.
+func (m *GroupMutation) UsersCleared() bool {
+	return m.clearedusers
+}
+
+// RemoveUserIDs removes the "users" edge to the User entity by IDs.
+func (m *GroupMutation) RemoveUserIDs(ids ...int) {
+	if m.removedusers == nil {
+		m.removedusers = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.users, ids[i])
+		m.removedusers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUsers returns the removed IDs of the "users" edge to the User entity.
+func (m *GroupMutation) RemovedUsersIDs() (ids []int) {
+	for id := range m.removedusers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UsersIDs returns the "users" edge IDs in the mutation.
+func (m *GroupMutation) UsersIDs() (ids []int) {
+	for id := range m.users {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUsers resets all changes to the "users" edge.
+func (m *GroupMutation) ResetUsers() {
+	m.users = nil
+	m.clearedusers = false
+	m.removedusers = nil
+}
+
+// SetStoragePoliciesID sets the "storage_policies" edge to the StoragePolicy entity by id.
+func (m *GroupMutation) SetStoragePoliciesID(id int) {
+	m.storage_policies = &id
+}
+
+// ClearStoragePolicies clears the "storage_policies" edge to the StoragePolicy entity.
+func (m *GroupMutation) ClearStoragePolicies() {
+	m.clearedstorage_policies = true
+	m.clearedFields[group.FieldStoragePolicyID] = struct{}{}
+}
+
+// StoragePoliciesCleared reports if the "storage_policies" edge to the StoragePolicy entity was cleared.
+func (m *GroupMutation) StoragePoliciesCleared() bool {
+	return m.StoragePolicyIDCleared() || m.clearedstorage_policies
+}
+
+// StoragePoliciesID returns the "storage_policies" edge ID in the mutation.
+func (m *GroupMutation) StoragePoliciesID() (id int, exists bool) {
+	if m.storage_policies != nil {
+		return *m.storage_policies, true
+	}
+	return
+}
+
+// StoragePoliciesIDs returns the "storage_policies" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// StoragePoliciesID instead. It exists only for internal usage by the builders.
+func (m *GroupMutation) StoragePoliciesIDs() (ids []int) {
+	if id := m.storage_policies; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetStoragePolicies resets all changes to the "storage_policies" edge.
+func (m *GroupMutation) ResetStoragePolicies() {
+	m.storage_policies = nil
+	m.clearedstorage_policies = false
+}
+
+// Where appends a list predicates to the GroupMutation builder.
+func (m *GroupMutation) Where(ps ...predicate.Group) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GroupMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GroupMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Group, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GroupMutation) Op() Op {
+	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MetadataMutation) SetOp(op Op) {
+func (m *GroupMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Metadata).
-func (m *MetadataMutation) Type() string {
+// Type returns the node type of this mutation (Group).
+func (m *GroupMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MetadataMutation) Fields() []string {
-	fields := make([]string, 0, 7)
+func (m *GroupMutation) Fields() []string {
+	fields := make([]string, 0, 9)
 	if m.created_at != nil {
-		fields = append(fields, metadata.FieldCreatedAt)
+		fields = append(fields, group.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, metadata.FieldUpdatedAt)
+		fields = append(fields, group.FieldUpdatedAt)
 	}
 	if m.deleted_at != nil {
-		fields = append(fields, metadata.FieldDeletedAt)
+		fields = append(fields, group.FieldDeletedAt)
 	}
 	if m.name != nil {
-		fields = append(fields, metadata.FieldName)
+		fields = append(fields, group.FieldName)
 	}
-	if m.value != nil {
-		fields = append(fields, metadata.FieldValue)
+	if m.max_storage != nil {
+		fields = append(fields, group.FieldMaxStorage)
 	}
-	if m.file != nil {
-		fields = append(fields, metadata.FieldFileID)
+	if m.speed_limit != nil {
+		fields = append(fields, group.FieldSpeedLimit)
 	}
-	if m.is_public != nil {
-		fields = append(fields, metadata.FieldIsPublic)
+	if m.permissions != nil {
+		fields = append(fields, group.FieldPermissions)
+	}
+	if m.settings != nil {
+		fields = append(fields, group.FieldSettings)
+	}
+	if m.storage_policies != nil {
+		fields = append(fields, group.FieldStoragePolicyID)
 	}
 	return fields
 }
@@ -6865,22 +7187,26 @@ func (m *MetadataMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MetadataMutation) Field(name string) (ent.Value, bool) {
+func (m *GroupMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case metadata.FieldCreatedAt:
+	case group.FieldCreatedAt:
 		return m.CreatedAt()
-	case metadata.FieldUpdatedAt:
+	case group.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case metadata.FieldDeletedAt:
+	case group.FieldDeletedAt:
 		return m.DeletedAt()
-	case metadata.FieldName:
+	case group.FieldName:
 		return m.Name()
-	case metadata.FieldValue:
-		return m.Value()
-	case metadata.FieldFileID:
-		return m.FileID()
-	case metadata.FieldIsPublic:
-		return m.IsPublic()
+	case group.FieldMaxStorage:
+		return m.MaxStorage()
+	case group.FieldSpeedLimit:
+		return m.SpeedLimit()
+	case group.FieldPermissions:
+		return m.Permissions()
+	case group.FieldSettings:
+		return m.Settings()
+	case group.FieldStoragePolicyID:
+		return m.StoragePolicyID()
 	}
 	return nil, false
 }
@@ -6888,96 +7214,124 @@ func (m *MetadataMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MetadataMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *GroupMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case metadata.FieldCreatedAt:
+	case group.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case metadata.FieldUpdatedAt:
+	case group.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case metadata.FieldDeletedAt:
+	case group.FieldDeletedAt:
 		return m.OldDeletedAt(ctx)
-	case metadata.FieldName:
+	case group.FieldName:
 		return m.OldName(ctx)
-	case metadata.FieldValue:
-		return m.OldValue(ctx)
-	case metadata.FieldFileID:
-		return m.OldFileID(ctx)
-	case metadata.FieldIsPublic:
-		return m.OldIsPublic(ctx)
+	case group.FieldMaxStorage:
+		return m.OldMaxStorage(ctx)
+	case group.FieldSpeedLimit:
+		return m.OldSpeedLimit(ctx)
+	case group.FieldPermissions:
+		return m.OldPermissions(ctx)
+	case group.FieldSettings:
+		return m.OldSettings(ctx)
+	case group.FieldStoragePolicyID:
+		return m.OldStoragePolicyID(ctx)
 	}
-	return nil, fmt.Errorf("unknown Metadata field %s", name)
+	return nil, fmt.Errorf("unknown Group field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MetadataMutation) SetField(name string, value ent.Value) error {
+func (m *GroupMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case metadata.FieldCreatedAt:
+	case group.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case metadata.FieldUpdatedAt:
+	case group.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case metadata.FieldDeletedAt:
+	case group.FieldDeletedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDeletedAt(v)
 		return nil
-	case metadata.FieldName:
+	case group.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case metadata.FieldValue:
-		v, ok := value.(string)
+	case group.FieldMaxStorage:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetValue(v)
+		m.SetMaxStorage(v)
 		return nil
-	case metadata.FieldFileID:
+	case group.FieldSpeedLimit:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFileID(v)
+		m.SetSpeedLimit(v)
 		return nil
-	case metadata.FieldIsPublic:
-		v, ok := value.(bool)
+	case group.FieldPermissions:
+		v, ok := value.(*boolset.BooleanSet)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsPublic(v)
+		m.SetPermissions(v)
+		return nil
+	case group.FieldSettings:
+		v, ok := value.(*types.GroupSetting)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSettings(v)
+		return nil
+	case group.FieldStoragePolicyID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStoragePolicyID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Metadata field %s", name)
+	return fmt.Errorf("unknown Group field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MetadataMutation) AddedFields() []string {
+func (m *GroupMutation) AddedFields() []string {
 	var fields []string
+	if m.addmax_storage != nil {
+		fields = append(fields, group.FieldMaxStorage)
+	}
+	if m.addspeed_limit != nil {
+		fields = append(fields, group.FieldSpeedLimit)
+	}
 	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MetadataMutation) AddedField(name string) (ent.Value, bool) {
+func (m *GroupMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
+	case group.FieldMaxStorage:
+		return m.AddedMaxStorage()
+	case group.FieldSpeedLimit:
+		return m.AddedSpeedLimit()
 	}
 	return nil, false
 }
@@ -6985,84 +7339,137 @@ func (m *MetadataMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MetadataMutation) AddField(name string, value ent.Value) error {
+func (m *GroupMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case group.FieldMaxStorage:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxStorage(v)
+		return nil
+	case group.FieldSpeedLimit:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSpeedLimit(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Metadata numeric field %s", name)
+	return fmt.Errorf("unknown Group numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MetadataMutation) ClearedFields() []string {
+func (m *GroupMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(metadata.FieldDeletedAt) {
-		fields = append(fields, metadata.FieldDeletedAt)
+	if m.FieldCleared(group.FieldDeletedAt) {
+		fields = append(fields, group.FieldDeletedAt)
+	}
+	if m.FieldCleared(group.FieldMaxStorage) {
+		fields = append(fields, group.FieldMaxStorage)
+	}
+	if m.FieldCleared(group.FieldSpeedLimit) {
+		fields = append(fields, group.FieldSpeedLimit)
+	}
+	if m.FieldCleared(group.FieldSettings) {
+		fields = append(fields, group.FieldSettings)
+	}
+	if m.FieldCleared(group.FieldStoragePolicyID) {
+		fields = append(fields, group.FieldStoragePolicyID)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MetadataMutation) FieldCleared(name string) bool {
+func (m *GroupMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MetadataMutation) ClearField(name string) error {
+func (m *GroupMutation) ClearField(name string) error {
 	switch name {
-	case metadata.FieldDeletedAt:
+	case group.FieldDeletedAt:
 		m.ClearDeletedAt()
 		return nil
+	case group.FieldMaxStorage:
+		m.ClearMaxStorage()
+		return nil
+	case group.FieldSpeedLimit:
+		m.ClearSpeedLimit()
+		return nil
+	case group.FieldSettings:
+		m.ClearSettings()
+		return nil
+	case group.FieldStoragePolicyID:
+		m.ClearStoragePolicyID()
+		return nil
 	}
-	return fmt.Errorf("unknown Metadata nullable field %s", name)
+	return fmt.Errorf("unknown Group nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MetadataMutation) ResetField(name string) error {
+func (m *GroupMutation) ResetField(name string) error {
 	switch name {
-	case metadata.FieldCreatedAt:
+	case group.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case metadata.FieldUpdatedAt:
+	case group.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case metadata.FieldDeletedAt:
+	case group.FieldDeletedAt:
 		m.ResetDeletedAt()
 		return nil
-	case metadata.FieldName:
+	case group.FieldName:
 		m.ResetName()
 		return nil
-	case metadata.FieldValue:
-		m.ResetValue()
+	case group.FieldMaxStorage:
+		m.ResetMaxStorage()
 		return nil
-	case metadata.FieldFileID:
-		m.ResetFileID()
+	case group.FieldSpeedLimit:
+		m.ResetSpeedLimit()
 		return nil
-	case metadata.FieldIsPublic:
-		m.ResetIsPublic()
+	case group.FieldPermissions:
+		m.ResetPermissions()
+		return nil
+	case group.FieldSettings:
+		m.ResetSettings()
+		return nil
+	case group.FieldStoragePolicyID:
+		m.ResetStoragePolicyID()
 		return nil
 	}
-	return fmt.Errorf("unknown Metadata field %s", name)
+	return fmt.Errorf("unknown Group field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MetadataMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.file != nil {
-		edges = append(edges, metadata.EdgeFile)
+func (m *GroupMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.users != nil {
+		edges = append(edges, group.EdgeUsers)
+	}
+	if m.storage_policies != nil {
+		edges = append(edges, group.EdgeStoragePolicies)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MetadataMutation) AddedIDs(name string) []ent.Value {
+func (m *GroupMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case metadata.EdgeFile:
-		if id := m.file; id != nil {
+	case group.EdgeUsers:
+		ids := make([]ent.Value, 0, len(m.users))
+		for id := range m.users {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeStoragePolicies:
+		if id := m.storage_policies; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -7070,96 +7477,108 @@ func (m *MetadataMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MetadataMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *GroupMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedusers != nil {
+		edges = append(edges, group.EdgeUsers)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MetadataMutation) RemovedIDs(name string) []ent.Value {
+func (m *GroupMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case group.EdgeUsers:
+		ids := make([]ent.Value, 0, len(m.removedusers))
+		for id := range m.removedusers {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MetadataMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedfile {
-		edges = append(edges, metadata.EdgeFile)
+func (m *GroupMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedusers {
+		edges = append(edges, group.EdgeUsers)
+	}
+	if m.clearedstorage_policies {
+		edges = append(edges, group.EdgeStoragePolicies)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MetadataMutation) EdgeCleared(name string) bool {
+func (m *GroupMutation) EdgeCleared(name string) bool {
 	switch name {
-	case metadata.EdgeFile:
-		return m.clearedfile
+	case group.EdgeUsers:
+		return m.clearedusers
+	case group.EdgeStoragePolicies:
+		return m.clearedstorage_policies
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MetadataMutation) ClearEdge(name string) error {
+func (m *GroupMutation) ClearEdge(name string) error {
 	switch name {
-	case metadata.EdgeFile:
-		m.ClearFile()
+	case group.EdgeStoragePolicies:
+		m.ClearStoragePolicies()
 		return nil
 	}
-	return fmt.Errorf("unknown Metadata unique edge %s", name)
+	return fmt.Errorf("unknown Group unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MetadataMutation) ResetEdge(name string) error {
+func (m *GroupMutation) ResetEdge(name string) error {
 	switch name {
-	case metadata.EdgeFile:
-		m.ResetFile()
+	case group.EdgeUsers:
+		m.ResetUsers()
+		return nil
+	case group.EdgeStoragePolicies:
+		m.ResetStoragePolicies()
 		return nil
 	}
-	return fmt.Errorf("unknown Metadata edge %s", name)
+	return fmt.Errorf("unknown Group edge %s", name)
 }
 
-// NodeMutation represents an operation that mutates the Node nodes in the graph.
-type NodeMutation struct {
+// MetadataMutation represents an operation that mutates the Metadata nodes in the graph.
+type MetadataMutation struct {
 	config
-	op                    Op
-	typ                   string
-	id                    *int
-	created_at            *time.Time
-	updated_at            *time.Time
-	deleted_at            *time.Time
-	status                *node.Status
-	name                  *string
-	_type                 *node.Type
-	server                *string
-	slave_key             *string
-	capabilities          **boolset.BooleanSet
-	settings              **types.NodeSetting
-	weight                *int
-	addweight             *int
-	clearedFields         map[string]struct{}
-	storage_policy        map[int]struct{}
-	removedstorage_policy map[int]struct{}
-	clearedstorage_policy bool
-	done                  bool
-	oldValue              func(context.Context) (*Node, error)
-	predicates            []predicate.Node
+	op            Op
+	typ           string
+	id            *int
+	created_at    *time.Time
+	updated_at    *time.Time
+	deleted_at    *time.Time
+	name          *string
+	value         *string
+	is_public     *bool
+	clearedFields map[string]struct{}
+	file          *int
+	clearedfile   bool
+	done          bool
+	oldValue      func(context.Context) (*Metadata, error)
+	predicates    []predicate.Metadata
 }
 
-var _ ent.Mutation = (*NodeMutation)(nil)
+var _ ent.Mutation = (*MetadataMutation)(nil)
 
-// nodeOption allows management of the mutation configuration using functional options.
-type nodeOption func(*NodeMutation)
+// metadataOption allows management of the mutation configuration using functional options.
+type metadataOption func(*MetadataMutation)
 
-// newNodeMutation creates new mutation for the Node entity.
-func newNodeMutation(c config, op Op, opts ...nodeOption) *NodeMutation {
-	m := &NodeMutation{
+// newMetadataMutation creates new mutation for the Metadata entity.
+func newMetadataMutation(c config, op Op, opts ...metadataOption) *MetadataMutation {
+	m := &MetadataMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeNode,
+		typ:           TypeMetadata,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -7168,20 +7587,20 @@ func newNodeMutation(c config, op Op, opts ...nodeOption) *NodeMutation {
 	return m
 }
 
-// withNodeID sets the ID field of the mutation.
-func withNodeID(id int) nodeOption {
-	return func(m *NodeMutation) {
+// withMetadataID sets the ID field of the mutation.
+func withMetadataID(id int) metadataOption {
+	return func(m *MetadataMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Node
+			value *Metadata
 		)
-		m.oldValue = func(ctx context.Context) (*Node, error) {
+		m.oldValue = func(ctx context.Context) (*Metadata, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Node.Get(ctx, id)
+					value, err = m.Client().Metadata.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -7190,10 +7609,10 @@ func withNodeID(id int) nodeOption {
 	}
 }
 
-// withNode sets the old Node of the mutation.
-func withNode(node *Node) nodeOption {
-	return func(m *NodeMutation) {
-		m.oldValue = func(context.Context) (*Node, error) {
+// withMetadata sets the old Metadata of the mutation.
+func withMetadata(node *Metadata) metadataOption {
+	return func(m *MetadataMutation) {
+		m.oldValue = func(context.Context) (*Metadata, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -7202,7 +7621,7 @@ func withNode(node *Node) nodeOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m NodeMutation) Client() *Client {
+func (m MetadataMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -7210,7 +7629,7 @@ func (m NodeMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m NodeMutation) Tx() (*Tx, error) {
+func (m MetadataMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -7221,7 +7640,7 @@ func (m NodeMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *NodeMutation) ID() (id int, exists bool) {
+func (m *MetadataMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -7232,7 +7651,7 @@ func (m *NodeMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *NodeMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *MetadataMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -7241,19 +7660,19 @@ func (m *NodeMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Node.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Metadata.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *NodeMutation) SetCreatedAt(t time.Time) {
+func (m *MetadataMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *NodeMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *MetadataMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -7261,10 +7680,10 @@ func (m *NodeMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Node entity.
-// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Metadata entity.
+// If the Metadata object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NodeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *MetadataMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -7279,17 +7698,17 @@ func (m *NodeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *NodeMutation) ResetCreatedAt() {
+func (m *MetadataMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *NodeMutation) SetUpdatedAt(t time.Time) {
+func (m *MetadataMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *NodeMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *MetadataMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -7297,10 +7716,10 @@ func (m *NodeMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Node entity.
-// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Metadata entity.
+// If the Metadata object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NodeMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *MetadataMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -7315,17 +7734,17 @@ func (m *NodeMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *NodeMutation) ResetUpdatedAt() {
+func (m *MetadataMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
 // SetDeletedAt sets the "deleted_at" field.
-func (m *NodeMutation) SetDeletedAt(t time.Time) {
+func (m *MetadataMutation) SetDeletedAt(t time.Time) {
 	m.deleted_at = &t
 }
 
 // DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *NodeMutation) DeletedAt() (r time.Time, exists bool) {
+func (m *MetadataMutation) DeletedAt() (r time.Time, exists bool) {
 	v := m.deleted_at
 	if v == nil {
 		return
@@ -7333,10 +7752,10 @@ func (m *NodeMutation) DeletedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the Node entity.
-// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedAt returns the old "deleted_at" field's value of the Metadata entity.
+// If the Metadata object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NodeMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *MetadataMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
@@ -7351,66 +7770,30 @@ func (m *NodeMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err erro
 }
 
 // ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *NodeMutation) ClearDeletedAt() {
+func (m *MetadataMutation) ClearDeletedAt() {
 	m.deleted_at = nil
-	m.clearedFields[node.FieldDeletedAt] = struct{}{}
+	m.clearedFields[metadata.FieldDeletedAt] = struct{}{}
 }
 
 // DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *NodeMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[node.FieldDeletedAt]
+func (m *MetadataMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[metadata.FieldDeletedAt]
 	return ok
 }
 
 // ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *NodeMutation) ResetDeletedAt() {
+func (m *MetadataMutation) ResetDeletedAt() {
 	m.deleted_at = nil
-	delete(m.clearedFields, node.FieldDeletedAt)
-}
-
-// SetStatus sets the "status" field.
-func (m *NodeMutation) SetStatus(n node.Status) {
-	m.status = &n
-}
-
-// Status returns the value of the "status" field in the mutation.
-func (m *NodeMutation) Status() (r node.Status, exists bool) {
-	v := m.status
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldStatus returns the old "status" field's value of the Node entity.
-// If the Node object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NodeMutation) OldStatus(ctx context.Context) (v node.Status, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
-	}
-	return oldValue.Status, nil
-}
-
-// ResetStatus resets all changes to the "status" field.
-func (m *NodeMutation) ResetStatus() {
-	m.status = nil
+	delete(m.clearedFields, metadata.FieldDeletedAt)
 }
 
 // SetName sets the "name" field.
-func (m *NodeMutation) SetName(s string) {
+func (m *MetadataMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *NodeMutation) Name() (r string, exists bool) {
+func (m *MetadataMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -7418,10 +7801,10 @@ func (m *NodeMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Node entity.
-// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Metadata entity.
+// If the Metadata object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NodeMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *MetadataMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -7436,348 +7819,154 @@ func (m *NodeMutation) OldName(ctx context.Context) (v string, err error) {
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *NodeMutation) ResetName() {
+func (m *MetadataMutation) ResetName() {
 	m.name = nil
 }
 
-// SetType sets the "type" field.
-func (m *NodeMutation) SetType(n node.Type) {
-	m._type = &n
+// SetValue sets the "value" field.
+func (m *MetadataMutation) SetValue(s string) {
+	m.value = &s
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *NodeMutation) GetType() (r node.Type, exists bool) {
-	v := m._type
+// Value returns the value of the "value" field in the mutation.
+func (m *MetadataMutation) Value() (r string, exists bool) {
+	v := m.value
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the Node entity.
-// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// OldValue returns the old "value" field's value of the Metadata entity.
+// If the Metadata object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NodeMutation) OldType(ctx context.Context) (v node.Type, err error) {
+func (m *MetadataMutation) OldValue(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldValue requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
 	}
-	return oldValue.Type, nil
+	return oldValue.Value, nil
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *NodeMutation) ResetType() {
-	m._type = nil
+// ResetValue resets all changes to the "value" field.
+func (m *MetadataMutation) ResetValue() {
+	m.value = nil
 }
 
-// SetServer sets the "server" field.
-func (m *NodeMutation) SetServer(s string) {
-	m.server = &s
+// SetFileID sets the "file_id" field.
+func (m *MetadataMutation) SetFileID(i int) {
+	m.file = &i
 }
 
-// Server returns the value of the "server" field in the mutation.
-func (m *NodeMutation) Server() (r string, exists bool) {
-	v := m.server
+// FileID returns the value of the "file_id" field in the mutation.
+func (m *MetadataMutation) FileID() (r int, exists bool) {
+	v := m.file
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldServer returns the old "server" field's value of the Node entity.
-// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// OldFileID returns the old "file_id" field's value of the Metadata entity.
+// If the Metadata object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NodeMutation) OldServer(ctx context.Context) (v string, err error) {
+func (m *MetadataMutation) OldFileID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldServer is only allowed on UpdateOne operations")
+		return v, errors.New("OldFileID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldServer requires an ID field in the mutation")
+		return v, errors.New("OldFileID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldServer: %w", err)
+		return v, fmt.Errorf("querying old value for OldFileID: %w", err)
 	}
-	return oldValue.Server, nil
-}
-
-// ClearServer clears the value of the "server" field.
-func (m *NodeMutation) ClearServer() {
-	m.server = nil
-	m.clearedFields[node.FieldServer] = struct{}{}
-}
-
-// ServerCleared returns if the "server" field was cleared in this mutation.
-func (m *NodeMutation) ServerCleared() bool {
-	_, ok := m.clearedFields[node.FieldServer]
-	return ok
+	return oldValue.FileID, nil
 }
 
-// ResetServer resets all changes to the "server" field.
-func (m *NodeMutation) ResetServer() {
-	m.server = nil
-	delete(m.clearedFields, node.FieldServer)
+// ResetFileID resets all changes to the "file_id" field.
+func (m *MetadataMutation) ResetFileID() {
+	m.file = nil
 }
 
-// SetSlaveKey sets the "slave_key" field.
-func (m *NodeMutation) SetSlaveKey(s string) {
-	m.slave_key = &s
+// SetIsPublic sets the "is_public" field.
+func (m *MetadataMutation) SetIsPublic(b bool) {
+	m.is_public = &b
 }
 
-// SlaveKey returns the value of the "slave_key" field in the mutation.
-func (m *NodeMutation) SlaveKey() (r string, exists bool) {
-	v := m.slave_key
+// IsPublic returns the value of the "is_public" field in the mutation.
+func (m *MetadataMutation) IsPublic() (r bool, exists bool) {
+	v := m.is_public
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSlaveKey returns the old "slave_key" field's value of the Node entity.
-// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// OldIsPublic returns the old "is_public" field's value of the Metadata entity.
+// If the Metadata object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NodeMutation) OldSlaveKey(ctx context.Context) (v string, err error) {
+func (m *MetadataMutation) OldIsPublic(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSlaveKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldIsPublic is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSlaveKey requires an ID field in the mutation")
+		return v, errors.New("OldIsPublic requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSlaveKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldIsPublic: %w", err)
 	}
-	return oldValue.SlaveKey, nil
+	return oldValue.IsPublic, nil
 }
 
-// ClearSlaveKey clears the value of the "slave_key" field.
-func (m *NodeMutation) ClearSlaveKey() {
-	m.slave_key = nil
-	m.clearedFields[node.FieldSlaveKey] = struct{}{}
+// ResetIsPublic resets all changes to the "is_public" field.
+func (m *MetadataMutation) ResetIsPublic() {
+	m.is_public = nil
 }
 
-// SlaveKeyCleared returns if the "slave_key" field was cleared in this mutation.
-func (m *NodeMutation) SlaveKeyCleared() bool {
-	_, ok := m.clearedFields[node.FieldSlaveKey]
-	return ok
+// ClearFile clears the "file" edge to the File entity.
+func (m *MetadataMutation) ClearFile() {
+	m.clearedfile = true
+	m.clearedFields[metadata.FieldFileID] = struct{}{}
 }
 
-// ResetSlaveKey resets all changes to the "slave_key" field.
-func (m *NodeMutation) ResetSlaveKey() {
-	m.slave_key = nil
-	delete(m.clearedFields, node.FieldSlaveKey)
-}
-
-// SetCapabilities sets the "capabilities" field.
-func (m *NodeMutation) SetCapabilities(bs *boolset.BooleanSet) {
-	m.capabilities = &bs
-}
-
-// Capabilities returns the value of the "capabilities" field in the mutation.
-func (m *NodeMutation) Capabilities() (r *boolset.BooleanSet, exists bool) {
-	v := m.capabilities
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldCapabilities returns the old "capabilities" field's value of the Node entity.
-// If the Node object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NodeMutation) OldCapabilities(ctx context.Context) (v *boolset.BooleanSet, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCapabilities is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCapabilities requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCapabilities: %w", err)
-	}
-	return oldValue.Capabilities, nil
-}
-
-// ResetCapabilities resets all changes to the "capabilities" field.
-func (m *NodeMutation) ResetCapabilities() {
-	m.capabilities = nil
-}
-
-// SetSettings sets the "settings" field.
-func (m *NodeMutation) SetSettings(ts *types.NodeSetting) {
-	m.settings = &ts
-}
-
-// Settings returns the value of the "settings" field in the mutation.
-func (m *NodeMutation) Settings() (r *types.NodeSetting, exists bool) {
-	v := m.settings
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldSettings returns the old "settings" field's value of the Node entity.
-// If the Node object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NodeMutation) OldSettings(ctx context.Context) (v *types.NodeSetting, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSettings is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSettings requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSettings: %w", err)
-	}
-	return oldValue.Settings, nil
-}
-
-// ClearSettings clears the value of the "settings" field.
-func (m *NodeMutation) ClearSettings() {
-	m.settings = nil
-	m.clearedFields[node.FieldSettings] = struct{}{}
-}
-
-// SettingsCleared returns if the "settings" field was cleared in this mutation.
-func (m *NodeMutation) SettingsCleared() bool {
-	_, ok := m.clearedFields[node.FieldSettings]
-	return ok
-}
-
-// ResetSettings resets all changes to the "settings" field.
-func (m *NodeMutation) ResetSettings() {
-	m.settings = nil
-	delete(m.clearedFields, node.FieldSettings)
-}
-
-// SetWeight sets the "weight" field.
-func (m *NodeMutation) SetWeight(i int) {
-	m.weight = &i
-	m.addweight = nil
-}
-
-// Weight returns the value of the "weight" field in the mutation.
-func (m *NodeMutation) Weight() (r int, exists bool) {
-	v := m.weight
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldWeight returns the old "weight" field's value of the Node entity.
-// If the Node object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NodeMutation) OldWeight(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldWeight is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldWeight requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWeight: %w", err)
-	}
-	return oldValue.Weight, nil
-}
-
-// AddWeight adds i to the "weight" field.
-func (m *NodeMutation) AddWeight(i int) {
-	if m.addweight != nil {
-		*m.addweight += i
-	} else {
-		m.addweight = &i
-	}
-}
-
-// AddedWeight returns the value that was added to the "weight" field in this mutation.
-func (m *NodeMutation) AddedWeight() (r int, exists bool) {
-	v := m.addweight
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetWeight resets all changes to the "weight" field.
-func (m *NodeMutation) ResetWeight() {
-	m.weight = nil
-	m.addweight = nil
-}
-
-// AddStoragePolicyIDs adds the "storage_policy" edge to the StoragePolicy entity by ids.
-func (m *NodeMutation) AddStoragePolicyIDs(ids ...int) {
-	if m.storage_policy == nil {
-		m.storage_policy = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.storage_policy[ids[i]] = struct{}{}
-	}
-}
-
-// ClearStoragePolicy clears the "storage_policy" edge to the StoragePolicy entity.
-func (m *NodeMutation) ClearStoragePolicy() {
-	m.clearedstorage_policy = true
-}
-
-// StoragePolicyCleared reports if the "storage_policy" edge to the StoragePolicy entity was cleared.
-func (m *NodeMutation) StoragePolicyCleared() bool {
-	return m.clearedstorage_policy
-}
-
-// RemoveStoragePolicyIDs removes the "storage_policy" edge to the StoragePolicy entity by IDs.
-func (m *NodeMutation) RemoveStoragePolicyIDs(ids ...int) {
-	if m.removedstorage_policy == nil {
-		m.removedstorage_policy = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.storage_policy, ids[i])
-		m.removedstorage_policy[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedStoragePolicy returns the removed IDs of the "storage_policy" edge to the StoragePolicy entity.
-func (m *NodeMutation) RemovedStoragePolicyIDs() (ids []int) {
-	for id := range m.removedstorage_policy {
-		ids = append(ids, id)
-	}
-	return
+// FileCleared reports if the "file" edge to the File entity was cleared.
+func (m *MetadataMutation) FileCleared() bool {
+	return m.clearedfile
 }
 
-// StoragePolicyIDs returns the "storage_policy" edge IDs in the mutation.
-func (m *NodeMutation) StoragePolicyIDs() (ids []int) {
-	for id := range m.storage_policy {
-		ids = append(ids, id)
+// FileIDs returns the "file" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FileID instead. It exists only for internal usage by the builders.
+func (m *MetadataMutation) FileIDs() (ids []int) {
+	if id := m.file; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetStoragePolicy resets all changes to the "storage_policy" edge.
-func (m *NodeMutation) ResetStoragePolicy() {
-	m.storage_policy = nil
-	m.clearedstorage_policy = false
-	m.removedstorage_policy = nil
+// ResetFile resets all changes to the "file" edge.
+func (m *MetadataMutation) ResetFile() {
+	m.file = nil
+	m.clearedfile = false
 }
 
-// Where appends a list predicates to the NodeMutation builder.
-func (m *NodeMutation) Where(ps ...predicate.Node) {
+// Where appends a list predicates to the MetadataMutation builder.
+func (m *MetadataMutation) Where(ps ...predicate.Metadata) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the NodeMutation builder. Using this method,
+// WhereP appends storage-level predicates to the MetadataMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *NodeMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Node, len(ps))
+func (m *MetadataMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Metadata, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -7785,57 +7974,45 @@ func (m *NodeMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *NodeMutation) Op() Op {
+func (m *MetadataMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *NodeMutation) SetOp(op Op) {
+func (m *MetadataMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Node).
-func (m *NodeMutation) Type() string {
+// Type returns the node type of this mutation (Metadata).
+func (m *MetadataMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *NodeMutation) Fields() []string {
-	fields := make([]string, 0, 11)
+func (m *MetadataMutation) Fields() []string {
+	fields := make([]string, 0, 7)
 	if m.created_at != nil {
-		fields = append(fields, node.FieldCreatedAt)
+		fields = append(fields, metadata.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, node.FieldUpdatedAt)
+		fields = append(fields, metadata.FieldUpdatedAt)
 	}
 	if m.deleted_at != nil {
-		fields = append(fields, node.FieldDeletedAt)
-	}
-	if m.status != nil {
-		fields = append(fields, node.FieldStatus)
+		fields = append(fields, metadata.FieldDeletedAt)
 	}
 	if m.name != nil {
-		fields = append(fields, node.FieldName)
-	}
-	if m._type != nil {
-		fields = append(fields, node.FieldType)
-	}
-	if m.server != nil {
-		fields = append(fields, node.FieldServer)
+		fields = append(fields, metadata.FieldName)
 	}
-	if m.slave_key != nil {
-		fields = append(fields, node.FieldSlaveKey)
+	if m.value != nil {
+		fields = append(fields, metadata.FieldValue)
 	}
-	if m.capabilities != nil {
-		fields = append(fields, node.FieldCapabilities)
+	if m.file != nil {
+		fields = append(fields, metadata.FieldFileID)
 	}
-	if m.settings != nil {
-		fields = append(fields, node.FieldSettings)
-	}
-	if m.weight != nil {
-		fields = append(fields, node.FieldWeight)
+	if m.is_public != nil {
+		fields = append(fields, metadata.FieldIsPublic)
 	}
 	return fields
 }
@@ -7843,30 +8020,22 @@ func (m *NodeMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *NodeMutation) Field(name string) (ent.Value, bool) {
+func (m *MetadataMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case node.FieldCreatedAt:
+	case metadata.FieldCreatedAt:
 		return m.CreatedAt()
-	case node.FieldUpdatedAt:
+	case metadata.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case node.FieldDeletedAt:
+	case metadata.FieldDeletedAt:
 		return m.DeletedAt()
-	case node.FieldStatus:
-		return m.Status()
-	case node.FieldName:
+	case metadata.FieldName:
 		return m.Name()
-	case node.FieldType:
-		return m.GetType()
-	case node.FieldServer:
-		return m.Server()
-	case node.FieldSlaveKey:
-		return m.SlaveKey()
-	case node.FieldCapabilities:
-		return m.Capabilities()
-	case node.FieldSettings:
-		return m.Settings()
-	case node.FieldWeight:
-		return m.Weight()
+	case metadata.FieldValue:
+		return m.Value()
+	case metadata.FieldFileID:
+		return m.FileID()
+	case metadata.FieldIsPublic:
+		return m.IsPublic()
 	}
 	return nil, false
 }
@@ -7874,137 +8043,96 @@ func (m *NodeMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *NodeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *MetadataMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case node.FieldCreatedAt:
+	case metadata.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case node.FieldUpdatedAt:
+	case metadata.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case node.FieldDeletedAt:
+	case metadata.FieldDeletedAt:
 		return m.OldDeletedAt(ctx)
-	case node.FieldStatus:
-		return m.OldStatus(ctx)
-	case node.FieldName:
+	case metadata.FieldName:
 		return m.OldName(ctx)
-	case node.FieldType:
-		return m.OldType(ctx)
-	case node.FieldServer:
-		return m.OldServer(ctx)
-	case node.FieldSlaveKey:
-		return m.OldSlaveKey(ctx)
-	case node.FieldCapabilities:
-		return m.OldCapabilities(ctx)
-	case node.FieldSettings:
-		return m.OldSettings(ctx)
-	case node.FieldWeight:
-		return m.OldWeight(ctx)
+	case metadata.FieldValue:
+		return m.OldValue(ctx)
+	case metadata.FieldFileID:
+		return m.OldFileID(ctx)
+	case metadata.FieldIsPublic:
+		return m.OldIsPublic(ctx)
 	}
-	return nil, fmt.Errorf("unknown Node field %s", name)
+	return nil, fmt.Errorf("unknown Metadata field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *NodeMutation) SetField(name string, value ent.Value) error {
+func (m *MetadataMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case node.FieldCreatedAt:
+	case metadata.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case node.FieldUpdatedAt:
+	case metadata.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case node.FieldDeletedAt:
+	case metadata.FieldDeletedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDeletedAt(v)
 		return nil
-	case node.FieldStatus:
-		v, ok := value.(node.Status)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case node.FieldName:
+	case metadata.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case node.FieldType:
-		v, ok := value.(node.Type)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetType(v)
-		return nil
-	case node.FieldServer:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetServer(v)
-		return nil
-	case node.FieldSlaveKey:
+	case metadata.FieldValue:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSlaveKey(v)
-		return nil
-	case node.FieldCapabilities:
-		v, ok := value.(*boolset.BooleanSet)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCapabilities(v)
+		m.SetValue(v)
 		return nil
-	case node.FieldSettings:
-		v, ok := value.(*types.NodeSetting)
+	case metadata.FieldFileID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSettings(v)
+		m.SetFileID(v)
 		return nil
-	case node.FieldWeight:
-		v, ok := value.(int)
+	case metadata.FieldIsPublic:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetWeight(v)
+		m.SetIsPublic(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Node field %s", name)
+	return fmt.Errorf("unknown Metadata field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *NodeMutation) AddedFields() []string {
+func (m *MetadataMutation) AddedFields() []string {
 	var fields []string
-	if m.addweight != nil {
-		fields = append(fields, node.FieldWeight)
-	}
 	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *NodeMutation) AddedField(name string) (ent.Value, bool) {
+func (m *MetadataMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case node.FieldWeight:
-		return m.AddedWeight()
 	}
 	return nil, false
 }
@@ -8012,229 +8140,181 @@ func (m *NodeMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *NodeMutation) AddField(name string, value ent.Value) error {
+func (m *MetadataMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case node.FieldWeight:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddWeight(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Node numeric field %s", name)
+	return fmt.Errorf("unknown Metadata numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *NodeMutation) ClearedFields() []string {
+func (m *MetadataMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(node.FieldDeletedAt) {
-		fields = append(fields, node.FieldDeletedAt)
-	}
-	if m.FieldCleared(node.FieldServer) {
-		fields = append(fields, node.FieldServer)
-	}
-	if m.FieldCleared(node.FieldSlaveKey) {
-		fields = append(fields, node.FieldSlaveKey)
-	}
-	if m.FieldCleared(node.FieldSettings) {
-		fields = append(fields, node.FieldSettings)
+	if m.FieldCleared(metadata.FieldDeletedAt) {
+		fields = append(fields, metadata.FieldDeletedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *NodeMutation) FieldCleared(name string) bool {
+func (m *MetadataMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *NodeMutation) ClearField(name string) error {
+func (m *MetadataMutation) ClearField(name string) error {
 	switch name {
-	case node.FieldDeletedAt:
+	case metadata.FieldDeletedAt:
 		m.ClearDeletedAt()
 		return nil
-	case node.FieldServer:
-		m.ClearServer()
-		return nil
-	case node.FieldSlaveKey:
-		m.ClearSlaveKey()
-		return nil
-	case node.FieldSettings:
-		m.ClearSettings()
-		return nil
 	}
-	return fmt.Errorf("unknown Node nullable field %s", name)
+	return fmt.Errorf("unknown Metadata nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *NodeMutation) ResetField(name string) error {
+func (m *MetadataMutation) ResetField(name string) error {
 	switch name {
-	case node.FieldCreatedAt:
+	case metadata.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case node.FieldUpdatedAt:
+	case metadata.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case node.FieldDeletedAt:
+	case metadata.FieldDeletedAt:
 		m.ResetDeletedAt()
 		return nil
-	case node.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case node.FieldName:
+	case metadata.FieldName:
 		m.ResetName()
 		return nil
-	case node.FieldType:
-		m.ResetType()
-		return nil
-	case node.FieldServer:
-		m.ResetServer()
-		return nil
-	case node.FieldSlaveKey:
-		m.ResetSlaveKey()
-		return nil
-	case node.FieldCapabilities:
-		m.ResetCapabilities()
+	case metadata.FieldValue:
+		m.ResetValue()
 		return nil
-	case node.FieldSettings:
-		m.ResetSettings()
+	case metadata.FieldFileID:
+		m.ResetFileID()
 		return nil
-	case node.FieldWeight:
-		m.ResetWeight()
+	case metadata.FieldIsPublic:
+		m.ResetIsPublic()
 		return nil
 	}
-	return fmt.Errorf("unknown Node field %s", name)
+	return fmt.Errorf("unknown Metadata field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *NodeMutation) AddedEdges() []string {
+func (m *MetadataMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.storage_policy != nil {
-		edges = append(edges, node.EdgeStoragePolicy)
+	if m.file != nil {
+		edges = append(edges, metadata.EdgeFile)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *NodeMutation) AddedIDs(name string) []ent.Value {
+func (m *MetadataMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case node.EdgeStoragePolicy:
-		ids := make([]ent.Value, 0, len(m.storage_policy))
-		for id := range m.storage_policy {
-			ids = append(ids, id)
+	case metadata.EdgeFile:
+		if id := m.file; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *NodeMutation) RemovedEdges() []string {
+func (m *MetadataMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.removedstorage_policy != nil {
-		edges = append(edges, node.EdgeStoragePolicy)
-	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *NodeMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case node.EdgeStoragePolicy:
-		ids := make([]ent.Value, 0, len(m.removedstorage_policy))
-		for id := range m.removedstorage_policy {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *MetadataMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *NodeMutation) ClearedEdges() []string {
+func (m *MetadataMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.clearedstorage_policy {
-		edges = append(edges, node.EdgeStoragePolicy)
+	if m.clearedfile {
+		edges = append(edges, metadata.EdgeFile)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *NodeMutation) EdgeCleared(name string) bool {
+func (m *MetadataMutation) EdgeCleared(name string) bool {
 	switch name {
-	case node.EdgeStoragePolicy:
-		return m.clearedstorage_policy
+	case metadata.EdgeFile:
+		return m.clearedfile
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *NodeMutation) ClearEdge(name string) error {
+func (m *MetadataMutation) ClearEdge(name string) error {
 	switch name {
+	case metadata.EdgeFile:
+		m.ClearFile()
+		return nil
 	}
-	return fmt.Errorf("unknown Node unique edge %s", name)
+	return fmt.Errorf("unknown Metadata unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *NodeMutation) ResetEdge(name string) error {
+func (m *MetadataMutation) ResetEdge(name string) error {
 	switch name {
-	case node.EdgeStoragePolicy:
-		m.ResetStoragePolicy()
+	case metadata.EdgeFile:
+		m.ResetFile()
 		return nil
 	}
-	return fmt.Errorf("unknown Node edge %s", name)
+	return fmt.Errorf("unknown Metadata edge %s", name)
 }
 
-// OAuthClientMutation represents an operation that mutates the OAuthClient nodes in the graph.
-type OAuthClientMutation struct {
+// NodeMutation represents an operation that mutates the Node nodes in the graph.
+type NodeMutation struct {
 	config
-	op                  Op
-	typ                 string
-	id                  *int
-	created_at          *time.Time
-	updated_at          *time.Time
-	deleted_at          *time.Time
-	guid                *string
-	secret              *string
-	name                *string
-	homepage_url        *string
-	redirect_uris       *[]string
-	appendredirect_uris []string
-	scopes              *[]string
-	appendscopes        []string
-	props               **types.OAuthClientProps
-	is_enabled          *bool
-	clearedFields       map[string]struct{}
-	grants              map[int]struct{}
-	removedgrants       map[int]struct{}
-	clearedgrants       bool
-	done                bool
-	oldValue            func(context.Context) (*OAuthClient, error)
-	predicates          []predicate.OAuthClient
+	op                    Op
+	typ                   string
+	id                    *int
+	created_at            *time.Time
+	updated_at            *time.Time
+	deleted_at            *time.Time
+	status                *node.Status
+	name                  *string
+	_type                 *node.Type
+	server                *string
+	slave_key             *string
+	capabilities          **boolset.BooleanSet
+	settings              **types.NodeSetting
+	weight                *int
+	addweight             *int
+	clearedFields         map[string]struct{}
+	storage_policy        map[int]struct{}
+	removedstorage_policy map[int]struct{}
+	clearedstorage_policy bool
+	done                  bool
+	oldValue              func(context.Context) (*Node, error)
+	predicates            []predicate.Node
 }
 
-var _ ent.Mutation = (*OAuthClientMutation)(nil)
+var _ ent.Mutation = (*NodeMutation)(nil)
 
-// oauthclientOption allows management of the mutation configuration using functional options.
-type oauthclientOption func(*OAuthClientMutation)
+// nodeOption allows management of the mutation configuration using functional options.
+type nodeOption func(*NodeMutation)
 
-// newOAuthClientMutation creates new mutation for the OAuthClient entity.
-func newOAuthClientMutation(c config, op Op, opts ...oauthclientOption) *OAuthClientMutation {
-	m := &OAuthClientMutation{
+// newNodeMutation creates new mutation for the Node entity.
+func newNodeMutation(c config, op Op, opts ...nodeOption) *NodeMutation {
+	m := &NodeMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeOAuthClient,
+		typ:           TypeNode,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -8243,20 +8323,20 @@ func newOAuthClientMutation(c config, op Op, opts ...oauthclientOption) *OAuthCl
 	return m
 }
 
-// withOAuthClientID sets the ID field of the mutation.
-func withOAuthClientID(id int) oauthclientOption {
-	return func(m *OAuthClientMutation) {
+// withNodeID sets the ID field of the mutation.
+func withNodeID(id int) nodeOption {
+	return func(m *NodeMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *OAuthClient
+			value *Node
 		)
-		m.oldValue = func(ctx context.Context) (*OAuthClient, error) {
+		m.oldValue = func(ctx context.Context) (*Node, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().OAuthClient.Get(ctx, id)
+					value, err = m.Client().Node.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -8265,10 +8345,10 @@ func withOAuthClientID(id int) oauthclientOption {
 	}
 }
 
-// withOAuthClient sets the old OAuthClient of the mutation.
-func withOAuthClient(node *OAuthClient) oauthclientOption {
-	return func(m *OAuthClientMutation) {
-		m.oldValue = func(context.Context) (*OAuthClient, error) {
+// withNode sets the old Node of the mutation.
+func withNode(node *Node) nodeOption {
+	return func(m *NodeMutation) {
+		m.oldValue = func(context.Context) (*Node, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -8277,7 +8357,7 @@ func withOAuthClient(node *OAuthClient) oauthclientOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m OAuthClientMutation) Client() *Client {
+func (m NodeMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -8285,7 +8365,7 @@ func (m OAuthClientMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m OAuthClientMutation) Tx() (*Tx, error) {
+func (m NodeMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -8296,7 +8376,7 @@ func (m OAuthClientMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *OAuthClientMutation) ID() (id int, exists bool) {
+func (m *NodeMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -8307,7 +8387,7 @@ func (m *OAuthClientMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *OAuthClientMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *NodeMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -8316,19 +8396,19 @@ func (m *OAuthClientMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().OAuthClient.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Node.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *OAuthClientMutation) SetCreatedAt(t time.Time) {
+func (m *NodeMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *OAuthClientMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *NodeMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -8336,10 +8416,10 @@ func (m *OAuthClientMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the OAuthClient entity.
-// If the OAuthClient object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OAuthClientMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *NodeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -8354,17 +8434,17 @@ func (m *OAuthClientMutation) OldCreatedAt(ctx context.Context) (v time.Time, er
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *OAuthClientMutation) ResetCreatedAt() {
+func (m *NodeMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *OAuthClientMutation) SetUpdatedAt(t time.Time) {
+func (m *NodeMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *OAuthClientMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *NodeMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -8372,10 +8452,10 @@ func (m *OAuthClientMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the OAuthClient entity.
-// If the OAuthClient object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OAuthClientMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *NodeMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -8390,17 +8470,17 @@ func (m *OAuthClientMutation) OldUpdatedAt(ctx context.Context) (v time.Time, er
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *OAuthClientMutation) ResetUpdatedAt() {
+func (m *NodeMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
 // SetDeletedAt sets the "deleted_at" field.
-func (m *OAuthClientMutation) SetDeletedAt(t time.Time) {
+func (m *NodeMutation) SetDeletedAt(t time.Time) {
 	m.deleted_at = &t
 }
 
 // DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *OAuthClientMutation) DeletedAt() (r time.Time, exists bool) {
+func (m *NodeMutation) DeletedAt() (r time.Time, exists bool) {
 	v := m.deleted_at
 	if v == nil {
 		return
@@ -8408,10 +8488,10 @@ func (m *OAuthClientMutation) DeletedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the OAuthClient entity.
-// If the OAuthClient object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedAt returns the old "deleted_at" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OAuthClientMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *NodeMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
@@ -8426,102 +8506,66 @@ func (m *OAuthClientMutation) OldDeletedAt(ctx context.Context) (v *time.Time, e
 }
 
 // ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *OAuthClientMutation) ClearDeletedAt() {
+func (m *NodeMutation) ClearDeletedAt() {
 	m.deleted_at = nil
-	m.clearedFields[oauthclient.FieldDeletedAt] = struct{}{}
+	m.clearedFields[node.FieldDeletedAt] = struct{}{}
 }
 
 // DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *OAuthClientMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[oauthclient.FieldDeletedAt]
+func (m *NodeMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[node.FieldDeletedAt]
 	return ok
 }
 
 // ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *OAuthClientMutation) ResetDeletedAt() {
+func (m *NodeMutation) ResetDeletedAt() {
 	m.deleted_at = nil
-	delete(m.clearedFields, oauthclient.FieldDeletedAt)
-}
-
-// SetGUID sets the "guid" field.
-func (m *OAuthClientMutation) SetGUID(s string) {
-	m.guid = &s
-}
-
-// GUID returns the value of the "guid" field in the mutation.
-func (m *OAuthClientMutation) GUID() (r string, exists bool) {
-	v := m.guid
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldGUID returns the old "guid" field's value of the OAuthClient entity.
-// If the OAuthClient object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OAuthClientMutation) OldGUID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGUID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGUID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGUID: %w", err)
-	}
-	return oldValue.GUID, nil
-}
-
-// ResetGUID resets all changes to the "guid" field.
-func (m *OAuthClientMutation) ResetGUID() {
-	m.guid = nil
+	delete(m.clearedFields, node.FieldDeletedAt)
 }
 
-// SetSecret sets the "secret" field.
-func (m *OAuthClientMutation) SetSecret(s string) {
-	m.secret = &s
+// SetStatus sets the "status" field.
+func (m *NodeMutation) SetStatus(n node.Status) {
+	m.status = &n
 }
 
-// Secret returns the value of the "secret" field in the mutation.
-func (m *OAuthClientMutation) Secret() (r string, exists bool) {
-	v := m.secret
+// Status returns the value of the "status" field in the mutation.
+func (m *NodeMutation) Status() (r node.Status, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSecret returns the old "secret" field's value of the OAuthClient entity.
-// If the OAuthClient object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OAuthClientMutation) OldSecret(ctx context.Context) (v string, err error) {
+func (m *NodeMutation) OldStatus(ctx context.Context) (v node.Status, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSecret requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.Secret, nil
+	return oldValue.Status, nil
 }
 
-// ResetSecret resets all changes to the "secret" field.
-func (m *OAuthClientMutation) ResetSecret() {
-	m.secret = nil
+// ResetStatus resets all changes to the "status" field.
+func (m *NodeMutation) ResetStatus() {
+	m.status = nil
 }
 
 // SetName sets the "name" field.
-func (m *OAuthClientMutation) SetName(s string) {
+func (m *NodeMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *OAuthClientMutation) Name() (r string, exists bool) {
+func (m *NodeMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -8529,10 +8573,10 @@ func (m *OAuthClientMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the OAuthClient entity.
-// If the OAuthClient object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OAuthClientMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *NodeMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -8547,296 +8591,348 @@ func (m *OAuthClientMutation) OldName(ctx context.Context) (v string, err error)
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *OAuthClientMutation) ResetName() {
+func (m *NodeMutation) ResetName() {
 	m.name = nil
 }
 
-// SetHomepageURL sets the "homepage_url" field.
-func (m *OAuthClientMutation) SetHomepageURL(s string) {
-	m.homepage_url = &s
+// SetType sets the "type" field.
+func (m *NodeMutation) SetType(n node.Type) {
+	m._type = &n
 }
 
-// HomepageURL returns the value of the "homepage_url" field in the mutation.
-func (m *OAuthClientMutation) HomepageURL() (r string, exists bool) {
-	v := m.homepage_url
+// GetType returns the value of the "type" field in the mutation.
+func (m *NodeMutation) GetType() (r node.Type, exists bool) {
+	v := m._type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldHomepageURL returns the old "homepage_url" field's value of the OAuthClient entity.
-// If the OAuthClient object wasn't provided to the builder, the object is fetched from the database.
+// OldType returns the old "type" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OAuthClientMutation) OldHomepageURL(ctx context.Context) (v string, err error) {
+func (m *NodeMutation) OldType(ctx context.Context) (v node.Type, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHomepageURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHomepageURL requires an ID field in the mutation")
+		return v, errors.New("OldType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHomepageURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
 	}
-	return oldValue.HomepageURL, nil
+	return oldValue.Type, nil
 }
 
-// ClearHomepageURL clears the value of the "homepage_url" field.
-func (m *OAuthClientMutation) ClearHomepageURL() {
-	m.homepage_url = nil
-	m.clearedFields[oauthclient.FieldHomepageURL] = struct{}{}
-}
-
-// HomepageURLCleared returns if the "homepage_url" field was cleared in this mutation.
-func (m *OAuthClientMutation) HomepageURLCleared() bool {
-	_, ok := m.clearedFields[oauthclient.FieldHomepageURL]
-	return ok
-}
-
-// ResetHomepageURL resets all changes to the "homepage_url" field.
-func (m *OAuthClientMutation) ResetHomepageURL() {
-	m.homepage_url = nil
-	delete(m.clearedFields, oauthclient.FieldHomepageURL)
+// ResetType resets all changes to the "type" field.
+func (m *NodeMutation) ResetType() {
+	m._type = nil
 }
 
-// SetRedirectUris sets the "redirect_uris" field.
-func (m *OAuthClientMutation) SetRedirectUris(s []string) {
-	m.redirect_uris = &s
-	m.appendredirect_uris = nil
+// SetServer sets the "server" field.
+func (m *NodeMutation) SetServer(s string) {
+	m.server = &s
 }
 
-// RedirectUris returns the value of the "redirect_uris" field in the mutation.
-func (m *OAuthClientMutation) RedirectUris() (r []string, exists bool) {
-	v := m.redirect_uris
+// Server returns the value of the "server" field in the mutation.
+func (m *NodeMutation) Server() (r string, exists bool) {
+	v := m.server
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRedirectUris returns the old "redirect_uris" field's value of the OAuthClient entity.
-// If the OAuthClient object wasn't provided to the builder, the object is fetched from the database.
+// OldServer returns the old "server" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OAuthClientMutation) OldRedirectUris(ctx context.Context) (v []string, err error) {
+func (m *NodeMutation) OldServer(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRedirectUris is only allowed on UpdateOne operations")
+		return v, errors.New("OldServer is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRedirectUris requires an ID field in the mutation")
+		return v, errors.New("OldServer requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRedirectUris: %w", err)
+		return v, fmt.Errorf("querying old value for OldServer: %w", err)
 	}
-	return oldValue.RedirectUris, nil
+	return oldValue.Server, nil
 }
 
-// AppendRedirectUris adds s to the "redirect_uris" field.
-func (m *OAuthClientMutation) AppendRedirectUris(s []string) {
-	m.appendredirect_uris = append(m.appendredirect_uris, s...)
+// ClearServer clears the value of the "server" field.
+func (m *NodeMutation) ClearServer() {
+	m.server = nil
+	m.clearedFields[node.FieldServer] = struct{}{}
 }
 
-// AppendedRedirectUris returns the list of values that were appended to the "redirect_uris" field in this mutation.
-func (m *OAuthClientMutation) AppendedRedirectUris() ([]string, bool) {
-	if len(m.appendredirect_uris) == 0 {
-		return nil, false
-	}
-	return m.appendredirect_uris, true
+// ServerCleared returns if the "server" field was cleared in this mutation.
+func (m *NodeMutation) ServerCleared() bool {
+	_, ok := m.clearedFields[node.FieldServer]
+	return ok
 }
 
-// ResetRedirectUris resets all changes to the "redirect_uris" field.
-func (m *OAuthClientMutation) ResetRedirectUris() {
-	m.redirect_uris = nil
-	m.appendredirect_uris = nil
+// ResetServer resets all changes to the "server" field.
+func (m *NodeMutation) ResetServer() {
+	m.server = nil
+	delete(m.clearedFields, node.FieldServer)
 }
 
-// SetScopes sets the "scopes" field.
-func (m *OAuthClientMutation) SetScopes(s []string) {
-	m.scopes = &s
-	m.appendscopes = nil
+// SetSlaveKey sets the "slave_key" field.
+func (m *NodeMutation) SetSlaveKey(s string) {
+	m.slave_key = &s
 }
 
-// Scopes returns the value of the "scopes" field in the mutation.
-func (m *OAuthClientMutation) Scopes() (r []string, exists bool) {
-	v := m.scopes
+// SlaveKey returns the value of the "slave_key" field in the mutation.
+func (m *NodeMutation) SlaveKey() (r string, exists bool) {
+	v := m.slave_key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldScopes returns the old "scopes" field's value of the OAuthClient entity.
-// If the OAuthClient object wasn't provided to the builder, the object is fetched from the database.
+// OldSlaveKey returns the old "slave_key" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OAuthClientMutation) OldScopes(ctx context.Context) (v []string, err error) {
+func (m *NodeMutation) OldSlaveKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
+		return v, errors.New("OldSlaveKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldScopes requires an ID field in the mutation")
+		return v, errors.New("OldSlaveKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
+		return v, fmt.Errorf("querying old value for OldSlaveKey: %w", err)
 	}
-	return oldValue.Scopes, nil
+	return oldValue.SlaveKey, nil
 }
 
-// AppendScopes adds s to the "scopes" field.
-func (m *OAuthClientMutation) AppendScopes(s []string) {
-	m.appendscopes = append(m.appendscopes, s...)
+// ClearSlaveKey clears the value of the "slave_key" field.
+func (m *NodeMutation) ClearSlaveKey() {
+	m.slave_key = nil
+	m.clearedFields[node.FieldSlaveKey] = struct{}{}
 }
 
-// AppendedScopes returns the list of values that were appended to the "scopes" field in this mutation.
-func (m *OAuthClientMutation) AppendedScopes() ([]string, bool) {
-	if len(m.appendscopes) == 0 {
-		return nil, false
-	}
-	return m.appendscopes, true
+// SlaveKeyCleared returns if the "slave_key" field was cleared in this mutation.
+func (m *NodeMutation) SlaveKeyCleared() bool {
+	_, ok := m.clearedFields[node.FieldSlaveKey]
+	return ok
 }
 
-// ResetScopes resets all changes to the "scopes" field.
-func (m *OAuthClientMutation) ResetScopes() {
-	m.scopes = nil
-	m.appendscopes = nil
+// ResetSlaveKey resets all changes to the "slave_key" field.
+func (m *NodeMutation) ResetSlaveKey() {
+	m.slave_key = nil
+	delete(m.clearedFields, node.FieldSlaveKey)
 }
 
-// SetProps sets the "props" field.
-func (m *OAuthClientMutation) SetProps(tacp *types.OAuthClientProps) {
-	m.props = &tacp
+// SetCapabilities sets the "capabilities" field.
+func (m *NodeMutation) SetCapabilities(bs *boolset.BooleanSet) {
+	m.capabilities = &bs
 }
 
-// Props returns the value of the "props" field in the mutation.
-func (m *OAuthClientMutation) Props() (r *types.OAuthClientProps, exists bool) {
-	v := m.props
+// Capabilities returns the value of the "capabilities" field in the mutation.
+func (m *NodeMutation) Capabilities() (r *boolset.BooleanSet, exists bool) {
+	v := m.capabilities
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProps returns the old "props" field's value of the OAuthClient entity.
-// If the OAuthClient object wasn't provided to the builder, the object is fetched from the database.
+// OldCapabilities returns the old "capabilities" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OAuthClientMutation) OldProps(ctx context.Context) (v *types.OAuthClientProps, err error) {
+func (m *NodeMutation) OldCapabilities(ctx context.Context) (v *boolset.BooleanSet, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProps is only allowed on UpdateOne operations")
+		return v, errors.New("OldCapabilities is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProps requires an ID field in the mutation")
+		return v, errors.New("OldCapabilities requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProps: %w", err)
+		return v, fmt.Errorf("querying old value for OldCapabilities: %w", err)
 	}
-	return oldValue.Props, nil
+	return oldValue.Capabilities, nil
 }
 
-// ResetProps resets all changes to the "props" field.
-func (m *OAuthClientMutation) ResetProps() {
-	m.props = nil
+// ResetCapabilities resets all changes to the "capabilities" field.
+func (m *NodeMutation) ResetCapabilities() {
+	m.capabilities = nil
 }
 
-// SetIsEnabled sets the "is_enabled" field.
-func (m *OAuthClientMutation) SetIsEnabled(b bool) {
-	m.is_enabled = &b
+// SetSettings sets the "settings" field.
+func (m *NodeMutation) SetSettings(ts *types.NodeSetting) {
+	m.settings = &ts
 }
 
-// IsEnabled returns the value of the "is_enabled" field in the mutation.
-func (m *OAuthClientMutation) IsEnabled() (r bool, exists bool) {
-	v := m.is_enabled
+// Settings returns the value of the "settings" field in the mutation.
+func (m *NodeMutation) Settings() (r *types.NodeSetting, exists bool) {
+	v := m.settings
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsEnabled returns the old "is_enabled" field's value of the OAuthClient entity.
-// If the OAuthClient object wasn't provided to the builder, the object is fetched from the database.
+// OldSettings returns the old "settings" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OAuthClientMutation) OldIsEnabled(ctx context.Context) (v bool, err error) {
+func (m *NodeMutation) OldSettings(ctx context.Context) (v *types.NodeSetting, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsEnabled is only allowed on UpdateOne operations")
+		return v, errors.New("OldSettings is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsEnabled requires an ID field in the mutation")
+		return v, errors.New("OldSettings requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsEnabled: %w", err)
+		return v, fmt.Errorf("querying old value for OldSettings: %w", err)
 	}
-	return oldValue.IsEnabled, nil
+	return oldValue.Settings, nil
 }
 
-// ResetIsEnabled resets all changes to the "is_enabled" field.
-func (m *OAuthClientMutation) ResetIsEnabled() {
-	m.is_enabled = nil
+// ClearSettings clears the value of the "settings" field.
+func (m *NodeMutation) ClearSettings() {
+	m.settings = nil
+	m.clearedFields[node.FieldSettings] = struct{}{}
 }
 
-// AddGrantIDs adds the "grants" edge to the OAuthGrant entity by ids.
-func (m *OAuthClientMutation) AddGrantIDs(ids ...int) {
-	if m.grants == nil {
-		m.grants = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.grants[ids[i]] = struct{}{}
-	}
+// SettingsCleared returns if the "settings" field was cleared in this mutation.
+func (m *NodeMutation) SettingsCleared() bool {
+	_, ok := m.clearedFields[node.FieldSettings]
+	return ok
 }
 
-// ClearGrants clears the "grants" edge to the OAuthGrant entity.
-func (m *OAuthClientMutation) ClearGrants() {
-	m.clearedgrants = true
+// ResetSettings resets all changes to the "settings" field.
+func (m *NodeMutation) ResetSettings() {
+	m.settings = nil
+	delete(m.clearedFields, node.FieldSettings)
 }
 
-// GrantsCleared reports if the "grants" edge to the OAuthGrant entity was cleared.
-func (m *OAuthClientMutation) GrantsCleared() bool {
-	return m.clearedgrants
+// SetWeight sets the "weight" field.
+func (m *NodeMutation) SetWeight(i int) {
+	m.weight = &i
+	m.addweight = nil
 }
 
-// RemoveGrantIDs removes the "grants" edge to the OAuthGrant entity by IDs.
-func (m *OAuthClientMutation) RemoveGrantIDs(ids ...int) {
-	if m.removedgrants == nil {
-		m.removedgrants = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.grants, ids[i])
-		m.removedgrants[ids[i]] = struct{}{}
+// Weight returns the value of the "weight" field in the mutation.
+func (m *NodeMutation) Weight() (r int, exists bool) {
+	v := m.weight
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedGrants returns the removed IDs of the "grants" edge to the OAuthGrant entity.
-func (m *OAuthClientMutation) RemovedGrantsIDs() (ids []int) {
-	for id := range m.removedgrants {
-		ids = append(ids, id)
+// OldWeight returns the old "weight" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldWeight(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWeight is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWeight: %w", err)
+	}
+	return oldValue.Weight, nil
 }
 
-// GrantsIDs returns the "grants" edge IDs in the mutation.
-func (m *OAuthClientMutation) GrantsIDs() (ids []int) {
-	for id := range m.grants {
-		ids = append(ids, id)
+// AddWeight adds i to the "weight" field.
+func (m *NodeMutation) AddWeight(i int) {
+	if m.addweight != nil {
+		*m.addweight += i
+	} else {
+		m.addweight = &i
 	}
-	return
 }
 
-// ResetGrants resets all changes to the "grants" edge.
-func (m *OAuthClientMutation) ResetGrants() {
-	m.grants = nil
-	m.clearedgrants = false
-	m.removedgrants = nil
+// AddedWeight returns the value that was added to the "weight" field in this mutation.
+func (m *NodeMutation) AddedWeight() (r int, exists bool) {
+	v := m.addweight
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Where appends a list predicates to the OAuthClientMutation builder.
-func (m *OAuthClientMutation) Where(ps ...predicate.OAuthClient) {
+// ResetWeight resets all changes to the "weight" field.
+func (m *NodeMutation) ResetWeight() {
+	m.weight = nil
+	m.addweight = nil
+}
+
+// AddStoragePolicyIDs adds the "storage_policy" edge to the StoragePolicy entity by ids.
+func (m *NodeMutation) AddStoragePolicyIDs(ids ...int) {
+	if m.storage_policy == nil {
+		m.storage_policy = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.storage_policy[ids[i]] = struct{}{}
+	}
+}
+
+// ClearStoragePolicy clears the "storage_policy" edge to the StoragePolicy entity.
+func (m *NodeMutation) ClearStoragePolicy() {
+	m.clearedstorage_policy = true
+}
+
+// StoragePolicyCleared reports if the "storage_policy" edge to the StoragePolicy entity was cleared.
+func (m *NodeMutation) StoragePolicyCleared() bool {
+	return m.clearedstorage_policy
+}
+
+// RemoveStoragePolicyIDs removes the "storage_policy" edge to the StoragePolicy entity by IDs.
+func (m *NodeMutation) RemoveStoragePolicyIDs(ids ...int) {
+	if m.removedstorage_policy == nil {
+		m.removedstorage_policy = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.storage_policy, ids[i])
+		m.removedstorage_policy[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedStoragePolicy returns the removed IDs of the "storage_policy" edge to the StoragePolicy entity.
+func (m *NodeMutation) RemovedStoragePolicyIDs() (ids []int) {
+	for id := range m.removedstorage_policy {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// StoragePolicyIDs returns the "storage_policy" edge IDs in the mutation.
+func (m *NodeMutation) StoragePolicyIDs() (ids []int) {
+	for id := range m.storage_policy {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetStoragePolicy resets all changes to the "storage_policy" edge.
+func (m *NodeMutation) ResetStoragePolicy() {
+	m.storage_policy = nil
+	m.clearedstorage_policy = false
+	m.removedstorage_policy = nil
+}
+
+// Where appends a list predicates to the NodeMutation builder.
+func (m *NodeMutation) Where(ps ...predicate.Node) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the OAuthClientMutation builder. Using this method,
+// WhereP appends storage-level predicates to the NodeMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *OAuthClientMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.OAuthClient, len(ps))
+func (m *NodeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Node, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -8844,57 +8940,57 @@ func (m *OAuthClientMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *OAuthClientMutation) Op() Op {
+func (m *NodeMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *OAuthClientMutation) SetOp(op Op) {
+func (m *NodeMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (OAuthClient).
-func (m *OAuthClientMutation) Type() string {
+// Type returns the node type of this mutation (Node).
+func (m *NodeMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *OAuthClientMutation) Fields() []string {
+func (m *NodeMutation) Fields() []string {
 	fields := make([]string, 0, 11)
 	if m.created_at != nil {
-		fields = append(fields, oauthclient.FieldCreatedAt)
+		fields = append(fields, node.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, oauthclient.FieldUpdatedAt)
+		fields = append(fields, node.FieldUpdatedAt)
 	}
 	if m.deleted_at != nil {
-		fields = append(fields, oauthclient.FieldDeletedAt)
-	}
-	if m.guid != nil {
-		fields = append(fields, oauthclient.FieldGUID)
+		fields = append(fields, node.FieldDeletedAt)
 	}
-	if m.secret != nil {
-		fields = append(fields, oauthclient.FieldSecret)
+	if m.status != nil {
+		fields = append(fields, node.FieldStatus)
 	}
 	if m.name != nil {
-		fields = append(fields, oauthclient.FieldName)
+		fields = append(fields, node.FieldName)
 	}
-	if m.homepage_url != nil {
-		fields = append(fields, oauthclient.FieldHomepageURL)
+	if m._type != nil {
+		fields = append(fields, node.FieldType)
 	}
-	if m.redirect_uris != nil {
-		fields = append(fields, oauthclient.FieldRedirectUris)
+	if m.server != nil {
+		fields = append(fields, node.FieldServer)
 	}
-	if m.scopes != nil {
-		fields = append(fields, oauthclient.FieldScopes)
+	if m.slave_key != nil {
+		fields = append(fields, node.FieldSlaveKey)
 	}
-	if m.props != nil {
-		fields = append(fields, oauthclient.FieldProps)
+	if m.capabilities != nil {
+		fields = append(fields, node.FieldCapabilities)
 	}
-	if m.is_enabled != nil {
-		fields = append(fields, oauthclient.FieldIsEnabled)
+	if m.settings != nil {
+		fields = append(fields, node.FieldSettings)
+	}
+	if m.weight != nil {
+		fields = append(fields, node.FieldWeight)
 	}
 	return fields
 }
@@ -8902,30 +8998,30 @@ func (m *OAuthClientMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *OAuthClientMutation) Field(name string) (ent.Value, bool) {
+func (m *NodeMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case oauthclient.FieldCreatedAt:
+	case node.FieldCreatedAt:
 		return m.CreatedAt()
-	case oauthclient.FieldUpdatedAt:
+	case node.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case oauthclient.FieldDeletedAt:
+	case node.FieldDeletedAt:
 		return m.DeletedAt()
-	case oauthclient.FieldGUID:
-		return m.GUID()
-	case oauthclient.FieldSecret:
-		return m.Secret()
-	case oauthclient.FieldName:
+	case node.FieldStatus:
+		return m.Status()
+	case node.FieldName:
 		return m.Name()
-	case oauthclient.FieldHomepageURL:
-		return m.HomepageURL()
-	case oauthclient.FieldRedirectUris:
-		return m.RedirectUris()
-	case oauthclient.FieldScopes:
-		return m.Scopes()
-	case oauthclient.FieldProps:
-		return m.Props()
-	case oauthclient.FieldIsEnabled:
-		return m.IsEnabled()
+	case node.FieldType:
+		return m.GetType()
+	case node.FieldServer:
+		return m.Server()
+	case node.FieldSlaveKey:
+		return m.SlaveKey()
+	case node.FieldCapabilities:
+		return m.Capabilities()
+	case node.FieldSettings:
+		return m.Settings()
+	case node.FieldWeight:
+		return m.Weight()
 	}
 	return nil, false
 }
@@ -8933,233 +9029,260 @@ func (m *OAuthClientMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *OAuthClientMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *NodeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case oauthclient.FieldCreatedAt:
+	case node.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case oauthclient.FieldUpdatedAt:
+	case node.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case oauthclient.FieldDeletedAt:
+	case node.FieldDeletedAt:
 		return m.OldDeletedAt(ctx)
-	case oauthclient.FieldGUID:
-		return m.OldGUID(ctx)
-	case oauthclient.FieldSecret:
-		return m.OldSecret(ctx)
-	case oauthclient.FieldName:
+	case node.FieldStatus:
+		return m.OldStatus(ctx)
+	case node.FieldName:
 		return m.OldName(ctx)
-	case oauthclient.FieldHomepageURL:
-		return m.OldHomepageURL(ctx)
-	case oauthclient.FieldRedirectUris:
-		return m.OldRedirectUris(ctx)
-	case oauthclient.FieldScopes:
-		return m.OldScopes(ctx)
-	case oauthclient.FieldProps:
-		return m.OldProps(ctx)
-	case oauthclient.FieldIsEnabled:
-		return m.OldIsEnabled(ctx)
+	case node.FieldType:
+		return m.OldType(ctx)
+	case node.FieldServer:
+		return m.OldServer(ctx)
+	case node.FieldSlaveKey:
+		return m.OldSlaveKey(ctx)
+	case node.FieldCapabilities:
+		return m.OldCapabilities(ctx)
+	case node.FieldSettings:
+		return m.OldSettings(ctx)
+	case node.FieldWeight:
+		return m.OldWeight(ctx)
 	}
-	return nil, fmt.Errorf("unknown OAuthClient field %s", name)
+	return nil, fmt.Errorf("unknown Node field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *OAuthClientMutation) SetField(name string, value ent.Value) error {
+func (m *NodeMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case oauthclient.FieldCreatedAt:
+	case node.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case oauthclient.FieldUpdatedAt:
+	case node.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case oauthclient.FieldDeletedAt:
+	case node.FieldDeletedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDeletedAt(v)
 		return nil
-	case oauthclient.FieldGUID:
-		v, ok := value.(string)
+	case node.FieldStatus:
+		v, ok := value.(node.Status)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetGUID(v)
+		m.SetStatus(v)
 		return nil
-	case oauthclient.FieldSecret:
+	case node.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSecret(v)
+		m.SetName(v)
 		return nil
-	case oauthclient.FieldName:
-		v, ok := value.(string)
+	case node.FieldType:
+		v, ok := value.(node.Type)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetType(v)
 		return nil
-	case oauthclient.FieldHomepageURL:
+	case node.FieldServer:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetHomepageURL(v)
+		m.SetServer(v)
 		return nil
-	case oauthclient.FieldRedirectUris:
-		v, ok := value.([]string)
+	case node.FieldSlaveKey:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRedirectUris(v)
+		m.SetSlaveKey(v)
 		return nil
-	case oauthclient.FieldScopes:
-		v, ok := value.([]string)
+	case node.FieldCapabilities:
+		v, ok := value.(*boolset.BooleanSet)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetScopes(v)
+		m.SetCapabilities(v)
 		return nil
-	case oauthclient.FieldProps:
-		v, ok := value.(*types.OAuthClientProps)
+	case node.FieldSettings:
+		v, ok := value.(*types.NodeSetting)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProps(v)
+		m.SetSettings(v)
 		return nil
-	case oauthclient.FieldIsEnabled:
-		v, ok := value.(bool)
+	case node.FieldWeight:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsEnabled(v)
+		m.SetWeight(v)
 		return nil
 	}
-	return fmt.Errorf("unknown OAuthClient field %s", name)
+	return fmt.Errorf("unknown Node field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *OAuthClientMutation) AddedFields() []string {
-	return nil
+func (m *NodeMutation) AddedFields() []string {
+	var fields []string
+	if m.addweight != nil {
+		fields = append(fields, node.FieldWeight)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *OAuthClientMutation) AddedField(name string) (ent.Value, bool) {
+func (m *NodeMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case node.FieldWeight:
+		return m.AddedWeight()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *OAuthClientMutation) AddField(name string, value ent.Value) error {
+func (m *NodeMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case node.FieldWeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWeight(v)
+		return nil
 	}
-	return fmt.Errorf("unknown OAuthClient numeric field %s", name)
+	return fmt.Errorf("unknown Node numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *OAuthClientMutation) ClearedFields() []string {
+func (m *NodeMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(oauthclient.FieldDeletedAt) {
-		fields = append(fields, oauthclient.FieldDeletedAt)
+	if m.FieldCleared(node.FieldDeletedAt) {
+		fields = append(fields, node.FieldDeletedAt)
 	}
-	if m.FieldCleared(oauthclient.FieldHomepageURL) {
-		fields = append(fields, oauthclient.FieldHomepageURL)
+	if m.FieldCleared(node.FieldServer) {
+		fields = append(fields, node.FieldServer)
+	}
+	if m.FieldCleared(node.FieldSlaveKey) {
+		fields = append(fields, node.FieldSlaveKey)
+	}
+	if m.FieldCleared(node.FieldSettings) {
+		fields = append(fields, node.FieldSettings)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *OAuthClientMutation) FieldCleared(name string) bool {
+func (m *NodeMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *OAuthClientMutation) ClearField(name string) error {
+func (m *NodeMutation) ClearField(name string) error {
 	switch name {
-	case oauthclient.FieldDeletedAt:
+	case node.FieldDeletedAt:
 		m.ClearDeletedAt()
 		return nil
-	case oauthclient.FieldHomepageURL:
-		m.ClearHomepageURL()
+	case node.FieldServer:
+		m.ClearServer()
+		return nil
+	case node.FieldSlaveKey:
+		m.ClearSlaveKey()
+		return nil
+	case node.FieldSettings:
+		m.ClearSettings()
 		return nil
 	}
-	return fmt.Errorf("unknown OAuthClient nullable field %s", name)
+	return fmt.Errorf("unknown Node nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *OAuthClientMutation) ResetField(name string) error {
+func (m *NodeMutation) ResetField(name string) error {
 	switch name {
-	case oauthclient.FieldCreatedAt:
+	case node.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case oauthclient.FieldUpdatedAt:
+	case node.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case oauthclient.FieldDeletedAt:
+	case node.FieldDeletedAt:
 		m.ResetDeletedAt()
 		return nil
-	case oauthclient.FieldGUID:
-		m.ResetGUID()
-		return nil
-	case oauthclient.FieldSecret:
-		m.ResetSecret()
+	case node.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case oauthclient.FieldName:
+	case node.FieldName:
 		m.ResetName()
 		return nil
-	case oauthclient.FieldHomepageURL:
-		m.ResetHomepageURL()
+	case node.FieldType:
+		m.ResetType()
 		return nil
-	case oauthclient.FieldRedirectUris:
-		m.ResetRedirectUris()
+	case node.FieldServer:
+		m.ResetServer()
 		return nil
-	case oauthclient.FieldScopes:
-		m.ResetScopes()
+	case node.FieldSlaveKey:
+		m.ResetSlaveKey()
 		return nil
-	case oauthclient.FieldProps:
-		m.ResetProps()
+	case node.FieldCapabilities:
+		m.ResetCapabilities()
 		return nil
-	case oauthclient.FieldIsEnabled:
-		m.ResetIsEnabled()
+	case node.FieldSettings:
+		m.ResetSettings()
+		return nil
+	case node.FieldWeight:
+		m.ResetWeight()
 		return nil
 	}
-	return fmt.Errorf("unknown OAuthClient field %s", name)
+	return fmt.Errorf("unknown Node field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *OAuthClientMutation) AddedEdges() []string {
+func (m *NodeMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.grants != nil {
-		edges = append(edges, oauthclient.EdgeGrants)
+	if m.storage_policy != nil {
+		edges = append(edges, node.EdgeStoragePolicy)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *OAuthClientMutation) AddedIDs(name string) []ent.Value {
+func (m *NodeMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case oauthclient.EdgeGrants:
-		ids := make([]ent.Value, 0, len(m.grants))
-		for id := range m.grants {
+	case node.EdgeStoragePolicy:
+		ids := make([]ent.Value, 0, len(m.storage_policy))
+		for id := range m.storage_policy {
 			ids = append(ids, id)
 		}
 		return ids
@@ -9168,21 +9291,21 @@ func (m *OAuthClientMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *OAuthClientMutation) RemovedEdges() []string {
+func (m *NodeMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.removedgrants != nil {
-		edges = append(edges, oauthclient.EdgeGrants)
+	if m.removedstorage_policy != nil {
+		edges = append(edges, node.EdgeStoragePolicy)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *OAuthClientMutation) RemovedIDs(name string) []ent.Value {
+func (m *NodeMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case oauthclient.EdgeGrants:
-		ids := make([]ent.Value, 0, len(m.removedgrants))
-		for id := range m.removedgrants {
+	case node.EdgeStoragePolicy:
+		ids := make([]ent.Value, 0, len(m.removedstorage_policy))
+		for id := range m.removedstorage_policy {
 			ids = append(ids, id)
 		}
 		return ids
@@ -9191,76 +9314,82 @@ func (m *OAuthClientMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *OAuthClientMutation) ClearedEdges() []string {
+func (m *NodeMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.clearedgrants {
-		edges = append(edges, oauthclient.EdgeGrants)
+	if m.clearedstorage_policy {
+		edges = append(edges, node.EdgeStoragePolicy)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *OAuthClientMutation) EdgeCleared(name string) bool {
+func (m *NodeMutation) EdgeCleared(name string) bool {
 	switch name {
-	case oauthclient.EdgeGrants:
-		return m.clearedgrants
+	case node.EdgeStoragePolicy:
+		return m.clearedstorage_policy
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *OAuthClientMutation) ClearEdge(name string) error {
+func (m *NodeMutation) ClearEdge(name string) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown OAuthClient unique edge %s", name)
+	return fmt.Errorf("unknown Node unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *OAuthClientMutation) ResetEdge(name string) error {
+func (m *NodeMutation) ResetEdge(name string) error {
 	switch name {
-	case oauthclient.EdgeGrants:
-		m.ResetGrants()
+	case node.EdgeStoragePolicy:
+		m.ResetStoragePolicy()
 		return nil
 	}
-	return fmt.Errorf("unknown OAuthClient edge %s", name)
+	return fmt.Errorf("unknown Node edge %s", name)
 }
 
-// OAuthGrantMutation represents an operation that mutates the OAuthGrant nodes in the graph.
-type OAuthGrantMutation struct {
+// OAuthClientMutation represents an operation that mutates the OAuthClient nodes in the graph.
+type OAuthClientMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	created_at    *time.Time
-	updated_at    *time.Time
-	deleted_at    *time.Time
-	scopes        *[]string
-	appendscopes  []string
-	last_used_at  *time.Time
-	clearedFields map[string]struct{}
-	user          *int
-	cleareduser   bool
-	client        *int
-	clearedclient bool
-	done          bool
-	oldValue      func(context.Context) (*OAuthGrant, error)
-	predicates    []predicate.OAuthGrant
+	op                  Op
+	typ                 string
+	id                  *int
+	created_at          *time.Time
+	updated_at          *time.Time
+	deleted_at          *time.Time
+	guid                *string
+	secret              *string
+	name                *string
+	homepage_url        *string
+	redirect_uris       *[]string
+	appendredirect_uris []string
+	scopes              *[]string
+	appendscopes        []string
+	props               **types.OAuthClientProps
+	is_enabled          *bool
+	clearedFields       map[string]struct{}
+	grants              map[int]struct{}
+	removedgrants       map[int]struct{}
+	clearedgrants       bool
+	done                bool
+	oldValue            func(context.Context) (*OAuthClient, error)
+	predicates          []predicate.OAuthClient
 }
 
-var _ ent.Mutation = (*OAuthGrantMutation)(nil)
+var _ ent.Mutation = (*OAuthClientMutation)(nil)
 
-// oauthgrantOption allows management of the mutation configuration using functional options.
-type oauthgrantOption func(*OAuthGrantMutation)
+// oauthclientOption allows management of the mutation configuration using functional options.
+type oauthclientOption func(*OAuthClientMutation)
 
-// newOAuthGrantMutation creates new mutation for the OAuthGrant entity.
-func newOAuthGrantMutation(c config, op Op, opts ...oauthgrantOption) *OAuthGrantMutation {
-	m := &OAuthGrantMutation{
+// newOAuthClientMutation creates new mutation for the OAuthClient entity.
+func newOAuthClientMutation(c config, op Op, opts ...oauthclientOption) *OAuthClientMutation {
+	m := &OAuthClientMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeOAuthGrant,
+		typ:           TypeOAuthClient,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -9269,20 +9398,20 @@ func newOAuthGrantMutation(c config, op Op, opts ...oauthgrantOption) *OAuthGran
 	return m
 }
 
-// withOAuthGrantID sets the ID field of the mutation.
-func withOAuthGrantID(id int) oauthgrantOption {
-	return func(m *OAuthGrantMutation) {
+// withOAuthClientID sets the ID field of the mutation.
+func withOAuthClientID(id int) oauthclientOption {
+	return func(m *OAuthClientMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *OAuthGrant
+			value *OAuthClient
 		)
-		m.oldValue = func(ctx context.Context) (*OAuthGrant, error) {
+		m.oldValue = func(ctx context.Context) (*OAuthClient, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().OAuthGrant.Get(ctx, id)
+					value, err = m.Client().OAuthClient.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -9291,10 +9420,10 @@ func withOAuthGrantID(id int) oauthgrantOption {
 	}
 }
 
-// withOAuthGrant sets the old OAuthGrant of the mutation.
-func withOAuthGrant(node *OAuthGrant) oauthgrantOption {
-	return func(m *OAuthGrantMutation) {
-		m.oldValue = func(context.Context) (*OAuthGrant, error) {
+// withOAuthClient sets the old OAuthClient of the mutation.
+func withOAuthClient(node *OAuthClient) oauthclientOption {
+	return func(m *OAuthClientMutation) {
+		m.oldValue = func(context.Context) (*OAuthClient, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -9303,7 +9432,7 @@ func withOAuthGrant(node *OAuthGrant) oauthgrantOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m OAuthGrantMutation) Client() *Client {
+func (m OAuthClientMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -9311,7 +9440,7 @@ func (m OAuthGrantMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m OAuthGrantMutation) Tx() (*Tx, error) {
+func (m OAuthClientMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -9322,7 +9451,7 @@ func (m OAuthGrantMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *OAuthGrantMutation) ID() (id int, exists bool) {
+func (m *OAuthClientMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -9333,7 +9462,7 @@ func (m *OAuthGrantMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *OAuthGrantMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *OAuthClientMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -9342,19 +9471,19 @@ func (m *OAuthGrantMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().OAuthGrant.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().OAuthClient.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *OAuthGrantMutation) SetCreatedAt(t time.Time) {
+func (m *OAuthClientMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *OAuthGrantMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *OAuthClientMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -9362,10 +9491,10 @@ func (m *OAuthGrantMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the OAuthGrant entity.
-// If the OAuthGrant object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the OAuthClient entity.
+// If the OAuthClient object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OAuthGrantMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *OAuthClientMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -9380,17 +9509,17 @@ func (m *OAuthGrantMutation) OldCreatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *OAuthGrantMutation) ResetCreatedAt() {
+func (m *OAuthClientMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *OAuthGrantMutation) SetUpdatedAt(t time.Time) {
+func (m *OAuthClientMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *OAuthGrantMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *OAuthClientMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -9398,10 +9527,10 @@ func (m *OAuthGrantMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the OAuthGrant entity.
-// If the OAuthGrant object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the OAuthClient entity.
+// If the OAuthClient object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OAuthGrantMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *OAuthClientMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -9416,17 +9545,17 @@ func (m *OAuthGrantMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *OAuthGrantMutation) ResetUpdatedAt() {
+func (m *OAuthClientMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
 // SetDeletedAt sets the "deleted_at" field.
-func (m *OAuthGrantMutation) SetDeletedAt(t time.Time) {
+func (m *OAuthClientMutation) SetDeletedAt(t time.Time) {
 	m.deleted_at = &t
 }
 
 // DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *OAuthGrantMutation) DeletedAt() (r time.Time, exists bool) {
+func (m *OAuthClientMutation) DeletedAt() (r time.Time, exists bool) {
 	v := m.deleted_at
 	if v == nil {
 		return
@@ -9434,10 +9563,10 @@ func (m *OAuthGrantMutation) DeletedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the OAuthGrant entity.
-// If the OAuthGrant object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedAt returns the old "deleted_at" field's value of the OAuthClient entity.
+// If the OAuthClient object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OAuthGrantMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *OAuthClientMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
@@ -9452,258 +9581,417 @@ func (m *OAuthGrantMutation) OldDeletedAt(ctx context.Context) (v *time.Time, er
 }
 
 // ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *OAuthGrantMutation) ClearDeletedAt() {
+func (m *OAuthClientMutation) ClearDeletedAt() {
 	m.deleted_at = nil
-	m.clearedFields[oauthgrant.FieldDeletedAt] = struct{}{}
+	m.clearedFields[oauthclient.FieldDeletedAt] = struct{}{}
 }
 
 // DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *OAuthGrantMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[oauthgrant.FieldDeletedAt]
+func (m *OAuthClientMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[oauthclient.FieldDeletedAt]
 	return ok
 }
 
 // ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *OAuthGrantMutation) ResetDeletedAt() {
+func (m *OAuthClientMutation) ResetDeletedAt() {
 	m.deleted_at = nil
-	delete(m.clearedFields, oauthgrant.FieldDeletedAt)
+	delete(m.clearedFields, oauthclient.FieldDeletedAt)
 }
 
-// SetUserID sets the "user_id" field.
-func (m *OAuthGrantMutation) SetUserID(i int) {
-	m.user = &i
+// SetGUID sets the "guid" field.
+func (m *OAuthClientMutation) SetGUID(s string) {
+	m.guid = &s
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *OAuthGrantMutation) UserID() (r int, exists bool) {
-	v := m.user
+// GUID returns the value of the "guid" field in the mutation.
+func (m *OAuthClientMutation) GUID() (r string, exists bool) {
+	v := m.guid
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the OAuthGrant entity.
-// If the OAuthGrant object wasn't provided to the builder, the object is fetched from the database.
+// OldGUID returns the old "guid" field's value of the OAuthClient entity.
+// If the OAuthClient object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OAuthGrantMutation) OldUserID(ctx context.Context) (v int, err error) {
+func (m *OAuthClientMutation) OldGUID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldGUID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldGUID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldGUID: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.GUID, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *OAuthGrantMutation) ResetUserID() {
-	m.user = nil
+// ResetGUID resets all changes to the "guid" field.
+func (m *OAuthClientMutation) ResetGUID() {
+	m.guid = nil
 }
 
-// SetClientID sets the "client_id" field.
-func (m *OAuthGrantMutation) SetClientID(i int) {
-	m.client = &i
+// SetSecret sets the "secret" field.
+func (m *OAuthClientMutation) SetSecret(s string) {
+	m.secret = &s
 }
 
-// ClientID returns the value of the "client_id" field in the mutation.
-func (m *OAuthGrantMutation) ClientID() (r int, exists bool) {
-	v := m.client
+// Secret returns the value of the "secret" field in the mutation.
+func (m *OAuthClientMutation) Secret() (r string, exists bool) {
+	v := m.secret
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldClientID returns the old "client_id" field's value of the OAuthGrant entity.
-// If the OAuthGrant object wasn't provided to the builder, the object is fetched from the database.
+// OldSecret returns the old "secret" field's value of the OAuthClient entity.
+// If the OAuthClient object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OAuthGrantMutation) OldClientID(ctx context.Context) (v int, err error) {
+func (m *OAuthClientMutation) OldSecret(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClientID is only allowed on UpdateOne operations")
+		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClientID requires an ID field in the mutation")
+		return v, errors.New("OldSecret requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldClientID: %w", err)
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
 	}
-	return oldValue.ClientID, nil
+	return oldValue.Secret, nil
 }
 
-// ResetClientID resets all changes to the "client_id" field.
-func (m *OAuthGrantMutation) ResetClientID() {
-	m.client = nil
+// ResetSecret resets all changes to the "secret" field.
+func (m *OAuthClientMutation) ResetSecret() {
+	m.secret = nil
 }
 
-// SetScopes sets the "scopes" field.
-func (m *OAuthGrantMutation) SetScopes(s []string) {
-	m.scopes = &s
-	m.appendscopes = nil
+// SetName sets the "name" field.
+func (m *OAuthClientMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Scopes returns the value of the "scopes" field in the mutation.
-func (m *OAuthGrantMutation) Scopes() (r []string, exists bool) {
-	v := m.scopes
+// Name returns the value of the "name" field in the mutation.
+func (m *OAuthClientMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldScopes returns the old "scopes" field's value of the OAuthGrant entity.
-// If the OAuthGrant object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the OAuthClient entity.
+// If the OAuthClient object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OAuthGrantMutation) OldScopes(ctx context.Context) (v []string, err error) {
+func (m *OAuthClientMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldScopes requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
-	}
-	return oldValue.Scopes, nil
-}
-
-// AppendScopes adds s to the "scopes" field.
-func (m *OAuthGrantMutation) AppendScopes(s []string) {
-	m.appendscopes = append(m.appendscopes, s...)
-}
-
-// AppendedScopes returns the list of values that were appended to the "scopes" field in this mutation.
-func (m *OAuthGrantMutation) AppendedScopes() ([]string, bool) {
-	if len(m.appendscopes) == 0 {
-		return nil, false
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return m.appendscopes, true
+	return oldValue.Name, nil
 }
 
-// ResetScopes resets all changes to the "scopes" field.
-func (m *OAuthGrantMutation) ResetScopes() {
-	m.scopes = nil
-	m.appendscopes = nil
+// ResetName resets all changes to the "name" field.
+func (m *OAuthClientMutation) ResetName() {
+	m.name = nil
 }
 
-// SetLastUsedAt sets the "last_used_at" field.
-func (m *OAuthGrantMutation) SetLastUsedAt(t time.Time) {
-	m.last_used_at = &t
+// SetHomepageURL sets the "homepage_url" field.
+func (m *OAuthClientMutation) SetHomepageURL(s string) {
+	m.homepage_url = &s
 }
 
-// LastUsedAt returns the value of the "last_used_at" field in the mutation.
-func (m *OAuthGrantMutation) LastUsedAt() (r time.Time, exists bool) {
-	v := m.last_used_at
+// HomepageURL returns the value of the "homepage_url" field in the mutation.
+func (m *OAuthClientMutation) HomepageURL() (r string, exists bool) {
+	v := m.homepage_url
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastUsedAt returns the old "last_used_at" field's value of the OAuthGrant entity.
-// If the OAuthGrant object wasn't provided to the builder, the object is fetched from the database.
+// OldHomepageURL returns the old "homepage_url" field's value of the OAuthClient entity.
+// If the OAuthClient object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OAuthGrantMutation) OldLastUsedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *OAuthClientMutation) OldHomepageURL(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldHomepageURL is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
+		return v, errors.New("OldHomepageURL requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldHomepageURL: %w", err)
 	}
-	return oldValue.LastUsedAt, nil
+	return oldValue.HomepageURL, nil
 }
 
-// ClearLastUsedAt clears the value of the "last_used_at" field.
-func (m *OAuthGrantMutation) ClearLastUsedAt() {
-	m.last_used_at = nil
-	m.clearedFields[oauthgrant.FieldLastUsedAt] = struct{}{}
+// ClearHomepageURL clears the value of the "homepage_url" field.
+func (m *OAuthClientMutation) ClearHomepageURL() {
+	m.homepage_url = nil
+	m.clearedFields[oauthclient.FieldHomepageURL] = struct{}{}
 }
 
-// LastUsedAtCleared returns if the "last_used_at" field was cleared in this mutation.
-func (m *OAuthGrantMutation) LastUsedAtCleared() bool {
-	_, ok := m.clearedFields[oauthgrant.FieldLastUsedAt]
+// HomepageURLCleared returns if the "homepage_url" field was cleared in this mutation.
+func (m *OAuthClientMutation) HomepageURLCleared() bool {
+	_, ok := m.clearedFields[oauthclient.FieldHomepageURL]
 	return ok
 }
 
-// ResetLastUsedAt resets all changes to the "last_used_at" field.
-func (m *OAuthGrantMutation) ResetLastUsedAt() {
-	m.last_used_at = nil
-	delete(m.clearedFields, oauthgrant.FieldLastUsedAt)
+// ResetHomepageURL resets all changes to the "homepage_url" field.
+func (m *OAuthClientMutation) ResetHomepageURL() {
+	m.homepage_url = nil
+	delete(m.clearedFields, oauthclient.FieldHomepageURL)
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *OAuthGrantMutation) ClearUser() {
-	m.cleareduser = true
-	m.clearedFields[oauthgrant.FieldUserID] = struct{}{}
+// SetRedirectUris sets the "redirect_uris" field.
+func (m *OAuthClientMutation) SetRedirectUris(s []string) {
+	m.redirect_uris = &s
+	m.appendredirect_uris = nil
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *OAuthGrantMutation) UserCleared() bool {
-	return m.cleareduser
+// RedirectUris returns the value of the "redirect_uris" field in the mutation.
+func (m *OAuthClientMutation) RedirectUris() (r []string, exists bool) {
+	v := m.redirect_uris
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *OAuthGrantMutation) UserIDs() (ids []int) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// OldRedirectUris returns the old "redirect_uris" field's value of the OAuthClient entity.
+// If the OAuthClient object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OAuthClientMutation) OldRedirectUris(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRedirectUris is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRedirectUris requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRedirectUris: %w", err)
+	}
+	return oldValue.RedirectUris, nil
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *OAuthGrantMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// AppendRedirectUris adds s to the "redirect_uris" field.
+func (m *OAuthClientMutation) AppendRedirectUris(s []string) {
+	m.appendredirect_uris = append(m.appendredirect_uris, s...)
 }
 
-// ClearClient clears the "client" edge to the OAuthClient entity.
-func (m *OAuthGrantMutation) ClearClient() {
-	m.clearedclient = true
-	m.clearedFields[oauthgrant.FieldClientID] = struct{}{}
+// AppendedRedirectUris returns the list of values that were appended to the "redirect_uris" field in this mutation.
+func (m *OAuthClientMutation) AppendedRedirectUris() ([]string, bool) {
+	if len(m.appendredirect_uris) == 0 {
+		return nil, false
+	}
+	return m.appendredirect_uris, true
 }
 
-// ClientCleared reports if the "client" edge to the OAuthClient entity was cleared.
-func (m *OAuthGrantMutation) ClientCleared() bool {
-	return m.clearedclient
-}
+// ResetRedirectUris resets all changes to the "redirect_uris" field.
+func (m *OAuthClientMutation) ResetRedirectUris() {
+	m.redirect_uris = nil
+	m.appendredirect_uris = nil
+}
 
-// ClientIDs returns the "client" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ClientID instead. It exists only for internal usage by the builders.
-func (m *OAuthGrantMutation) ClientIDs() (ids []int) {
-	if id := m.client; id != nil {
-		ids = append(ids, *id)
+// SetScopes sets the "scopes" field.
+func (m *OAuthClientMutation) SetScopes(s []string) {
+	m.scopes = &s
+	m.appendscopes = nil
+}
+
+// Scopes returns the value of the "scopes" field in the mutation.
+func (m *OAuthClientMutation) Scopes() (r []string, exists bool) {
+	v := m.scopes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScopes returns the old "scopes" field's value of the OAuthClient entity.
+// If the OAuthClient object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OAuthClientMutation) OldScopes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScopes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
+	}
+	return oldValue.Scopes, nil
+}
+
+// AppendScopes adds s to the "scopes" field.
+func (m *OAuthClientMutation) AppendScopes(s []string) {
+	m.appendscopes = append(m.appendscopes, s...)
+}
+
+// AppendedScopes returns the list of values that were appended to the "scopes" field in this mutation.
+func (m *OAuthClientMutation) AppendedScopes() ([]string, bool) {
+	if len(m.appendscopes) == 0 {
+		return nil, false
+	}
+	return m.appendscopes, true
+}
+
+// ResetScopes resets all changes to the "scopes" field.
+func (m *OAuthClientMutation) ResetScopes() {
+	m.scopes = nil
+	m.appendscopes = nil
+}
+
+// SetProps sets the "props" field.
+func (m *OAuthClientMutation) SetProps(tacp *types.OAuthClientProps) {
+	m.props = &tacp
+}
+
+// Props returns the value of the "props" field in the mutation.
+func (m *OAuthClientMutation) Props() (r *types.OAuthClientProps, exists bool) {
+	v := m.props
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProps returns the old "props" field's value of the OAuthClient entity.
+// If the OAuthClient object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OAuthClientMutation) OldProps(ctx context.Context) (v *types.OAuthClientProps, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProps is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProps requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProps: %w", err)
+	}
+	return oldValue.Props, nil
+}
+
+// ResetProps resets all changes to the "props" field.
+func (m *OAuthClientMutation) ResetProps() {
+	m.props = nil
+}
+
+// SetIsEnabled sets the "is_enabled" field.
+func (m *OAuthClientMutation) SetIsEnabled(b bool) {
+	m.is_enabled = &b
+}
+
+// IsEnabled returns the value of the "is_enabled" field in the mutation.
+func (m *OAuthClientMutation) IsEnabled() (r bool, exists bool) {
+	v := m.is_enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsEnabled returns the old "is_enabled" field's value of the OAuthClient entity.
+// If the OAuthClient object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OAuthClientMutation) OldIsEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsEnabled: %w", err)
+	}
+	return oldValue.IsEnabled, nil
+}
+
+// ResetIsEnabled resets all changes to the "is_enabled" field.
+func (m *OAuthClientMutation) ResetIsEnabled() {
+	m.is_enabled = nil
+}
+
+// AddGrantIDs adds the "grants" edge to the OAuthGrant entity by ids.
+func (m *OAuthClientMutation) AddGrantIDs(ids ...int) {
+	if m.grants == nil {
+		m.grants = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.grants[ids[i]] = struct{}{}
+	}
+}
+
+// ClearGrants clears the "grants" edge to the OAuthGrant entity.
+func (m *OAuthClientMutation) ClearGrants() {
+	m.clearedgrants = true
+}
+
+// GrantsCleared reports if the "grants" edge to the OAuthGrant entity was cleared.
+func (m *OAuthClientMutation) GrantsCleared() bool {
+	return m.clearedgrants
+}
+
+// RemoveGrantIDs removes the "grants" edge to the OAuthGrant entity by IDs.
+func (m *OAuthClientMutation) RemoveGrantIDs(ids ...int) {
+	if m.removedgrants == nil {
+		m.removedgrants = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.grants, ids[i])
+		m.removedgrants[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedGrants returns the removed IDs of the "grants" edge to the OAuthGrant entity.
+func (m *OAuthClientMutation) RemovedGrantsIDs() (ids []int) {
+	for id := range m.removedgrants {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetClient resets all changes to the "client" edge.
-func (m *OAuthGrantMutation) ResetClient() {
-	m.client = nil
-	m.clearedclient = false
+// GrantsIDs returns the "grants" edge IDs in the mutation.
+func (m *OAuthClientMutation) GrantsIDs() (ids []int) {
+	for id := range m.grants {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// Where appends a list predicates to the OAuthGrantMutation builder.
-func (m *OAuthGrantMutation) Where(ps ...predicate.OAuthGrant) {
+// ResetGrants resets all changes to the "grants" edge.
+func (m *OAuthClientMutation) ResetGrants() {
+	m.grants = nil
+	m.clearedgrants = false
+	m.removedgrants = nil
+}
+
+// Where appends a list predicates to the OAuthClientMutation builder.
+func (m *OAuthClientMutation) Where(ps ...predicate.OAuthClient) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the OAuthGrantMutation builder. Using this method,
+// WhereP appends storage-level predicates to the OAuthClientMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *OAuthGrantMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.OAuthGrant, len(ps))
+func (m *OAuthClientMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.OAuthClient, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -9711,45 +9999,57 @@ func (m *OAuthGrantMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *OAuthGrantMutation) Op() Op {
+func (m *OAuthClientMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *OAuthGrantMutation) SetOp(op Op) {
+func (m *OAuthClientMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (OAuthGrant).
-func (m *OAuthGrantMutation) Type() string {
+// Type returns the node type of this mutation (OAuthClient).
+func (m *OAuthClientMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *OAuthGrantMutation) Fields() []string {
-	fields := make([]string, 0, 7)
+func (m *OAuthClientMutation) Fields() []string {
+	fields := make([]string, 0, 11)
 	if m.created_at != nil {
-		fields = append(fields, oauthgrant.FieldCreatedAt)
+		fields = append(fields, oauthclient.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, oauthgrant.FieldUpdatedAt)
+		fields = append(fields, oauthclient.FieldUpdatedAt)
 	}
 	if m.deleted_at != nil {
-		fields = append(fields, oauthgrant.FieldDeletedAt)
+		fields = append(fields, oauthclient.FieldDeletedAt)
 	}
-	if m.user != nil {
-		fields = append(fields, oauthgrant.FieldUserID)
+	if m.guid != nil {
+		fields = append(fields, oauthclient.FieldGUID)
 	}
-	if m.client != nil {
-		fields = append(fields, oauthgrant.FieldClientID)
+	if m.secret != nil {
+		fields = append(fields, oauthclient.FieldSecret)
+	}
+	if m.name != nil {
+		fields = append(fields, oauthclient.FieldName)
+	}
+	if m.homepage_url != nil {
+		fields = append(fields, oauthclient.FieldHomepageURL)
+	}
+	if m.redirect_uris != nil {
+		fields = append(fields, oauthclient.FieldRedirectUris)
 	}
 	if m.scopes != nil {
-		fields = append(fields, oauthgrant.FieldScopes)
+		fields = append(fields, oauthclient.FieldScopes)
 	}
-	if m.last_used_at != nil {
-		fields = append(fields, oauthgrant.FieldLastUsedAt)
+	if m.props != nil {
+		fields = append(fields, oauthclient.FieldProps)
+	}
+	if m.is_enabled != nil {
+		fields = append(fields, oauthclient.FieldIsEnabled)
 	}
 	return fields
 }
@@ -9757,22 +10057,30 @@ func (m *OAuthGrantMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *OAuthGrantMutation) Field(name string) (ent.Value, bool) {
+func (m *OAuthClientMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case oauthgrant.FieldCreatedAt:
+	case oauthclient.FieldCreatedAt:
 		return m.CreatedAt()
-	case oauthgrant.FieldUpdatedAt:
+	case oauthclient.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case oauthgrant.FieldDeletedAt:
+	case oauthclient.FieldDeletedAt:
 		return m.DeletedAt()
-	case oauthgrant.FieldUserID:
-		return m.UserID()
-	case oauthgrant.FieldClientID:
-		return m.ClientID()
-	case oauthgrant.FieldScopes:
-		return m.Scopes()
-	case oauthgrant.FieldLastUsedAt:
-		return m.LastUsedAt()
+	case oauthclient.FieldGUID:
+		return m.GUID()
+	case oauthclient.FieldSecret:
+		return m.Secret()
+	case oauthclient.FieldName:
+		return m.Name()
+	case oauthclient.FieldHomepageURL:
+		return m.HomepageURL()
+	case oauthclient.FieldRedirectUris:
+		return m.RedirectUris()
+	case oauthclient.FieldScopes:
+		return m.Scopes()
+	case oauthclient.FieldProps:
+		return m.Props()
+	case oauthclient.FieldIsEnabled:
+		return m.IsEnabled()
 	}
 	return nil, false
 }
@@ -9780,266 +10088,303 @@ func (m *OAuthGrantMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *OAuthGrantMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *OAuthClientMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case oauthgrant.FieldCreatedAt:
+	case oauthclient.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case oauthgrant.FieldUpdatedAt:
+	case oauthclient.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case oauthgrant.FieldDeletedAt:
+	case oauthclient.FieldDeletedAt:
 		return m.OldDeletedAt(ctx)
-	case oauthgrant.FieldUserID:
-		return m.OldUserID(ctx)
-	case oauthgrant.FieldClientID:
-		return m.OldClientID(ctx)
-	case oauthgrant.FieldScopes:
+	case oauthclient.FieldGUID:
+		return m.OldGUID(ctx)
+	case oauthclient.FieldSecret:
+		return m.OldSecret(ctx)
+	case oauthclient.FieldName:
+		return m.OldName(ctx)
+	case oauthclient.FieldHomepageURL:
+		return m.OldHomepageURL(ctx)
+	case oauthclient.FieldRedirectUris:
+		return m.OldRedirectUris(ctx)
+	case oauthclient.FieldScopes:
 		return m.OldScopes(ctx)
-	case oauthgrant.FieldLastUsedAt:
-		return m.OldLastUsedAt(ctx)
+	case oauthclient.FieldProps:
+		return m.OldProps(ctx)
+	case oauthclient.FieldIsEnabled:
+		return m.OldIsEnabled(ctx)
 	}
-	return nil, fmt.Errorf("unknown OAuthGrant field %s", name)
+	return nil, fmt.Errorf("unknown OAuthClient field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *OAuthGrantMutation) SetField(name string, value ent.Value) error {
+func (m *OAuthClientMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case oauthgrant.FieldCreatedAt:
+	case oauthclient.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case oauthgrant.FieldUpdatedAt:
+	case oauthclient.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case oauthgrant.FieldDeletedAt:
+	case oauthclient.FieldDeletedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDeletedAt(v)
 		return nil
-	case oauthgrant.FieldUserID:
-		v, ok := value.(int)
+	case oauthclient.FieldGUID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserID(v)
+		m.SetGUID(v)
 		return nil
-	case oauthgrant.FieldClientID:
-		v, ok := value.(int)
+	case oauthclient.FieldSecret:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetClientID(v)
+		m.SetSecret(v)
 		return nil
-	case oauthgrant.FieldScopes:
+	case oauthclient.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case oauthclient.FieldHomepageURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHomepageURL(v)
+		return nil
+	case oauthclient.FieldRedirectUris:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRedirectUris(v)
+		return nil
+	case oauthclient.FieldScopes:
 		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetScopes(v)
 		return nil
-	case oauthgrant.FieldLastUsedAt:
-		v, ok := value.(time.Time)
+	case oauthclient.FieldProps:
+		v, ok := value.(*types.OAuthClientProps)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLastUsedAt(v)
+		m.SetProps(v)
+		return nil
+	case oauthclient.FieldIsEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsEnabled(v)
 		return nil
 	}
-	return fmt.Errorf("unknown OAuthGrant field %s", name)
+	return fmt.Errorf("unknown OAuthClient field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *OAuthGrantMutation) AddedFields() []string {
-	var fields []string
-	return fields
+func (m *OAuthClientMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *OAuthGrantMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	}
+func (m *OAuthClientMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *OAuthGrantMutation) AddField(name string, value ent.Value) error {
+func (m *OAuthClientMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown OAuthGrant numeric field %s", name)
+	return fmt.Errorf("unknown OAuthClient numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *OAuthGrantMutation) ClearedFields() []string {
+func (m *OAuthClientMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(oauthgrant.FieldDeletedAt) {
-		fields = append(fields, oauthgrant.FieldDeletedAt)
+	if m.FieldCleared(oauthclient.FieldDeletedAt) {
+		fields = append(fields, oauthclient.FieldDeletedAt)
 	}
-	if m.FieldCleared(oauthgrant.FieldLastUsedAt) {
-		fields = append(fields, oauthgrant.FieldLastUsedAt)
+	if m.FieldCleared(oauthclient.FieldHomepageURL) {
+		fields = append(fields, oauthclient.FieldHomepageURL)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *OAuthGrantMutation) FieldCleared(name string) bool {
+func (m *OAuthClientMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *OAuthGrantMutation) ClearField(name string) error {
+func (m *OAuthClientMutation) ClearField(name string) error {
 	switch name {
-	case oauthgrant.FieldDeletedAt:
+	case oauthclient.FieldDeletedAt:
 		m.ClearDeletedAt()
 		return nil
-	case oauthgrant.FieldLastUsedAt:
-		m.ClearLastUsedAt()
+	case oauthclient.FieldHomepageURL:
+		m.ClearHomepageURL()
 		return nil
 	}
-	return fmt.Errorf("unknown OAuthGrant nullable field %s", name)
+	return fmt.Errorf("unknown OAuthClient nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *OAuthGrantMutation) ResetField(name string) error {
+func (m *OAuthClientMutation) ResetField(name string) error {
 	switch name {
-	case oauthgrant.FieldCreatedAt:
+	case oauthclient.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case oauthgrant.FieldUpdatedAt:
+	case oauthclient.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case oauthgrant.FieldDeletedAt:
+	case oauthclient.FieldDeletedAt:
 		m.ResetDeletedAt()
 		return nil
-	case oauthgrant.FieldUserID:
-		m.ResetUserID()
+	case oauthclient.FieldGUID:
+		m.ResetGUID()
 		return nil
-	case oauthgrant.FieldClientID:
-		m.ResetClientID()
+	case oauthclient.FieldSecret:
+		m.ResetSecret()
 		return nil
-	case oauthgrant.FieldScopes:
+	case oauthclient.FieldName:
+		m.ResetName()
+		return nil
+	case oauthclient.FieldHomepageURL:
+		m.ResetHomepageURL()
+		return nil
+	case oauthclient.FieldRedirectUris:
+		m.ResetRedirectUris()
+		return nil
+	case oauthclient.FieldScopes:
 		m.ResetScopes()
 		return nil
-	case oauthgrant.FieldLastUsedAt:
-		m.ResetLastUsedAt()
+	case oauthclient.FieldProps:
+		m.ResetProps()
+		return nil
+	case oauthclient.FieldIsEnabled:
+		m.ResetIsEnabled()
 		return nil
 	}
-	return fmt.Errorf("unknown OAuthGrant field %s", name)
+	return fmt.Errorf("unknown OAuthClient field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *OAuthGrantMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.user != nil {
-		edges = append(edges, oauthgrant.EdgeUser)
-	}
-	if m.client != nil {
-		edges = append(edges, oauthgrant.EdgeClient)
+func (m *OAuthClientMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.grants != nil {
+		edges = append(edges, oauthclient.EdgeGrants)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *OAuthGrantMutation) AddedIDs(name string) []ent.Value {
+func (m *OAuthClientMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case oauthgrant.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
-	case oauthgrant.EdgeClient:
-		if id := m.client; id != nil {
-			return []ent.Value{*id}
+	case oauthclient.EdgeGrants:
+		ids := make([]ent.Value, 0, len(m.grants))
+		for id := range m.grants {
+			ids = append(ids, id)
 		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *OAuthGrantMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *OAuthClientMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedgrants != nil {
+		edges = append(edges, oauthclient.EdgeGrants)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *OAuthGrantMutation) RemovedIDs(name string) []ent.Value {
+func (m *OAuthClientMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case oauthclient.EdgeGrants:
+		ids := make([]ent.Value, 0, len(m.removedgrants))
+		for id := range m.removedgrants {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *OAuthGrantMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.cleareduser {
-		edges = append(edges, oauthgrant.EdgeUser)
-	}
-	if m.clearedclient {
-		edges = append(edges, oauthgrant.EdgeClient)
+func (m *OAuthClientMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedgrants {
+		edges = append(edges, oauthclient.EdgeGrants)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *OAuthGrantMutation) EdgeCleared(name string) bool {
+func (m *OAuthClientMutation) EdgeCleared(name string) bool {
 	switch name {
-	case oauthgrant.EdgeUser:
-		return m.cleareduser
-	case oauthgrant.EdgeClient:
-		return m.clearedclient
+	case oauthclient.EdgeGrants:
+		return m.clearedgrants
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *OAuthGrantMutation) ClearEdge(name string) error {
+func (m *OAuthClientMutation) ClearEdge(name string) error {
 	switch name {
-	case oauthgrant.EdgeUser:
-		m.ClearUser()
-		return nil
-	case oauthgrant.EdgeClient:
-		m.ClearClient()
-		return nil
 	}
-	return fmt.Errorf("unknown OAuthGrant unique edge %s", name)
+	return fmt.Errorf("unknown OAuthClient unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *OAuthGrantMutation) ResetEdge(name string) error {
+func (m *OAuthClientMutation) ResetEdge(name string) error {
 	switch name {
-	case oauthgrant.EdgeUser:
-		m.ResetUser()
-		return nil
-	case oauthgrant.EdgeClient:
-		m.ResetClient()
+	case oauthclient.EdgeGrants:
+		m.ResetGrants()
 		return nil
 	}
-	return fmt.Errorf("unknown OAuthGrant edge %s", name)
+	return fmt.Errorf("unknown OAuthClient edge %s", name)
 }
 
-// PasskeyMutation represents an operation that mutates the Passkey nodes in the graph.
-type PasskeyMutation struct {
+// OAuthGrantMutation represents an operation that mutates the OAuthGrant nodes in the graph.
+type OAuthGrantMutation struct {
 	config
 	op            Op
 	typ           string
@@ -10047,29 +10392,30 @@ type PasskeyMutation struct {
 	created_at    *time.Time
 	updated_at    *time.Time
 	deleted_at    *time.Time
-	credential_id *string
-	name          *string
-	credential    **webauthn.Credential
-	used_at       *time.Time
+	scopes        *[]string
+	appendscopes  []string
+	last_used_at  *time.Time
 	clearedFields map[string]struct{}
 	user          *int
 	cleareduser   bool
+	client        *int
+	clearedclient bool
 	done          bool
-	oldValue      func(context.Context) (*Passkey, error)
-	predicates    []predicate.Passkey
+	oldValue      func(context.Context) (*OAuthGrant, error)
+	predicates    []predicate.OAuthGrant
 }
 
-var _ ent.Mutation = (*PasskeyMutation)(nil)
+var _ ent.Mutation = (*OAuthGrantMutation)(nil)
 
-// passkeyOption allows management of the mutation configuration using functional options.
-type passkeyOption func(*PasskeyMutation)
+// oauthgrantOption allows management of the mutation configuration using functional options.
+type oauthgrantOption func(*OAuthGrantMutation)
 
-// newPasskeyMutation creates new mutation for the Passkey entity.
-func newPasskeyMutation(c config, op Op, opts ...passkeyOption) *PasskeyMutation {
-	m := &PasskeyMutation{
+// newOAuthGrantMutation creates new mutation for the OAuthGrant entity.
+func newOAuthGrantMutation(c config, op Op, opts ...oauthgrantOption) *OAuthGrantMutation {
+	m := &OAuthGrantMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePasskey,
+		typ:           TypeOAuthGrant,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -10078,20 +10424,20 @@ func newPasskeyMutation(c config, op Op, opts ...passkeyOption) *PasskeyMutation
 	return m
 }
 
-// withPasskeyID sets the ID field of the mutation.
-func withPasskeyID(id int) passkeyOption {
-	return func(m *PasskeyMutation) {
+// withOAuthGrantID sets the ID field of the mutation.
+func withOAuthGrantID(id int) oauthgrantOption {
+	return func(m *OAuthGrantMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Passkey
+			value *OAuthGrant
 		)
-		m.oldValue = func(ctx context.Context) (*Passkey, error) {
+		m.oldValue = func(ctx context.Context) (*OAuthGrant, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Passkey.Get(ctx, id)
+					value, err = m.Client().OAuthGrant.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -10100,10 +10446,10 @@ func withPasskeyID(id int) passkeyOption {
 	}
 }
 
-// withPasskey sets the old Passkey of the mutation.
-func withPasskey(node *Passkey) passkeyOption {
-	return func(m *PasskeyMutation) {
-		m.oldValue = func(context.Context) (*Passkey, error) {
+// withOAuthGrant sets the old OAuthGrant of the mutation.
+func withOAuthGrant(node *OAuthGrant) oauthgrantOption {
+	return func(m *OAuthGrantMutation) {
+		m.oldValue = func(context.Context) (*OAuthGrant, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -10112,7 +10458,7 @@ func withPasskey(node *Passkey) passkeyOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PasskeyMutation) Client() *Client {
+func (m OAuthGrantMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -10120,7 +10466,7 @@ func (m PasskeyMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PasskeyMutation) Tx() (*Tx, error) {
+func (m OAuthGrantMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -10131,7 +10477,7 @@ func (m PasskeyMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PasskeyMutation) ID() (id int, exists bool) {
+func (m *OAuthGrantMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -10142,7 +10488,7 @@ func (m *PasskeyMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PasskeyMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *OAuthGrantMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -10151,19 +10497,19 @@ func (m *PasskeyMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Passkey.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().OAuthGrant.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *PasskeyMutation) SetCreatedAt(t time.Time) {
+func (m *OAuthGrantMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PasskeyMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *OAuthGrantMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -10171,10 +10517,10 @@ func (m *PasskeyMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Passkey entity.
-// If the Passkey object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the OAuthGrant entity.
+// If the OAuthGrant object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PasskeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *OAuthGrantMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -10189,17 +10535,17 @@ func (m *PasskeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err er
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PasskeyMutation) ResetCreatedAt() {
+func (m *OAuthGrantMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *PasskeyMutation) SetUpdatedAt(t time.Time) {
+func (m *OAuthGrantMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *PasskeyMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *OAuthGrantMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -10207,10 +10553,10 @@ func (m *PasskeyMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Passkey entity.
-// If the Passkey object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the OAuthGrant entity.
+// If the OAuthGrant object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PasskeyMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *OAuthGrantMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -10225,17 +10571,17 @@ func (m *PasskeyMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err er
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *PasskeyMutation) ResetUpdatedAt() {
+func (m *OAuthGrantMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
 // SetDeletedAt sets the "deleted_at" field.
-func (m *PasskeyMutation) SetDeletedAt(t time.Time) {
+func (m *OAuthGrantMutation) SetDeletedAt(t time.Time) {
 	m.deleted_at = &t
 }
 
 // DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *PasskeyMutation) DeletedAt() (r time.Time, exists bool) {
+func (m *OAuthGrantMutation) DeletedAt() (r time.Time, exists bool) {
 	v := m.deleted_at
 	if v == nil {
 		return
@@ -10243,10 +10589,10 @@ func (m *PasskeyMutation) DeletedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the Passkey entity.
-// If the Passkey object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedAt returns the old "deleted_at" field's value of the OAuthGrant entity.
+// If the OAuthGrant object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PasskeyMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *OAuthGrantMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
@@ -10261,30 +10607,30 @@ func (m *PasskeyMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err e
 }
 
 // ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *PasskeyMutation) ClearDeletedAt() {
+func (m *OAuthGrantMutation) ClearDeletedAt() {
 	m.deleted_at = nil
-	m.clearedFields[passkey.FieldDeletedAt] = struct{}{}
+	m.clearedFields[oauthgrant.FieldDeletedAt] = struct{}{}
 }
 
 // DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *PasskeyMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[passkey.FieldDeletedAt]
+func (m *OAuthGrantMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[oauthgrant.FieldDeletedAt]
 	return ok
 }
 
 // ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *PasskeyMutation) ResetDeletedAt() {
+func (m *OAuthGrantMutation) ResetDeletedAt() {
 	m.deleted_at = nil
-	delete(m.clearedFields, passkey.FieldDeletedAt)
+	delete(m.clearedFields, oauthgrant.FieldDeletedAt)
 }
 
 // SetUserID sets the "user_id" field.
-func (m *PasskeyMutation) SetUserID(i int) {
+func (m *OAuthGrantMutation) SetUserID(i int) {
 	m.user = &i
 }
 
 // UserID returns the value of the "user_id" field in the mutation.
-func (m *PasskeyMutation) UserID() (r int, exists bool) {
+func (m *OAuthGrantMutation) UserID() (r int, exists bool) {
 	v := m.user
 	if v == nil {
 		return
@@ -10292,10 +10638,10 @@ func (m *PasskeyMutation) UserID() (r int, exists bool) {
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the Passkey entity.
-// If the Passkey object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the OAuthGrant entity.
+// If the OAuthGrant object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PasskeyMutation) OldUserID(ctx context.Context) (v int, err error) {
+func (m *OAuthGrantMutation) OldUserID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
@@ -10310,182 +10656,161 @@ func (m *PasskeyMutation) OldUserID(ctx context.Context) (v int, err error) {
 }
 
 // ResetUserID resets all changes to the "user_id" field.
-func (m *PasskeyMutation) ResetUserID() {
+func (m *OAuthGrantMutation) ResetUserID() {
 	m.user = nil
 }
 
-// SetCredentialID sets the "credential_id" field.
-func (m *PasskeyMutation) SetCredentialID(s string) {
-	m.credential_id = &s
+// SetClientID sets the "client_id" field.
+func (m *OAuthGrantMutation) SetClientID(i int) {
+	m.client = &i
 }
 
-// CredentialID returns the value of the "credential_id" field in the mutation.
-func (m *PasskeyMutation) CredentialID() (r string, exists bool) {
-	v := m.credential_id
+// ClientID returns the value of the "client_id" field in the mutation.
+func (m *OAuthGrantMutation) ClientID() (r int, exists bool) {
+	v := m.client
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCredentialID returns the old "credential_id" field's value of the Passkey entity.
-// If the Passkey object wasn't provided to the builder, the object is fetched from the database.
+// OldClientID returns the old "client_id" field's value of the OAuthGrant entity.
+// If the OAuthGrant object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PasskeyMutation) OldCredentialID(ctx context.Context) (v string, err error) {
+func (m *OAuthGrantMutation) OldClientID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCredentialID is only allowed on UpdateOne operations")
+		return v, errors.New("OldClientID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCredentialID requires an ID field in the mutation")
+		return v, errors.New("OldClientID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCredentialID: %w", err)
+		return v, fmt.Errorf("querying old value for OldClientID: %w", err)
 	}
-	return oldValue.CredentialID, nil
+	return oldValue.ClientID, nil
 }
 
-// ResetCredentialID resets all changes to the "credential_id" field.
-func (m *PasskeyMutation) ResetCredentialID() {
-	m.credential_id = nil
+// ResetClientID resets all changes to the "client_id" field.
+func (m *OAuthGrantMutation) ResetClientID() {
+	m.client = nil
 }
 
-// SetName sets the "name" field.
-func (m *PasskeyMutation) SetName(s string) {
-	m.name = &s
+// SetScopes sets the "scopes" field.
+func (m *OAuthGrantMutation) SetScopes(s []string) {
+	m.scopes = &s
+	m.appendscopes = nil
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *PasskeyMutation) Name() (r string, exists bool) {
-	v := m.name
+// Scopes returns the value of the "scopes" field in the mutation.
+func (m *OAuthGrantMutation) Scopes() (r []string, exists bool) {
+	v := m.scopes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Passkey entity.
-// If the Passkey object wasn't provided to the builder, the object is fetched from the database.
+// OldScopes returns the old "scopes" field's value of the OAuthGrant entity.
+// If the OAuthGrant object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PasskeyMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *OAuthGrantMutation) OldScopes(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldScopes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Scopes, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *PasskeyMutation) ResetName() {
-	m.name = nil
+// AppendScopes adds s to the "scopes" field.
+func (m *OAuthGrantMutation) AppendScopes(s []string) {
+	m.appendscopes = append(m.appendscopes, s...)
 }
 
-// SetCredential sets the "credential" field.
-func (m *PasskeyMutation) SetCredential(w *webauthn.Credential) {
-	m.credential = &w
+// AppendedScopes returns the list of values that were appended to the "scopes" field in this mutation.
+func (m *OAuthGrantMutation) AppendedScopes() ([]string, bool) {
+	if len(m.appendscopes) == 0 {
+		return nil, false
+	}
+	return m.appendscopes, true
 }
 
-// Credential returns the value of the "credential" field in the mutation.
-func (m *PasskeyMutation) Credential() (r *webauthn.Credential, exists bool) {
-	v := m.credential
+// ResetScopes resets all changes to the "scopes" field.
+func (m *OAuthGrantMutation) ResetScopes() {
+	m.scopes = nil
+	m.appendscopes = nil
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (m *OAuthGrantMutation) SetLastUsedAt(t time.Time) {
+	m.last_used_at = &t
+}
+
+// LastUsedAt returns the value of the "last_used_at" field in the mutation.
+func (m *OAuthGrantMutation) LastUsedAt() (r time.Time, exists bool) {
+	v := m.last_used_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCredential returns the old "credential" field's value of the Passkey entity.
-// If the Passkey object wasn't provided to the builder, the object is fetched from the database.
+// OldLastUsedAt returns the old "last_used_at" field's value of the OAuthGrant entity.
+// If the OAuthGrant object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PasskeyMutation) OldCredential(ctx context.Context) (v *webauthn.Credential, err error) {
+func (m *OAuthGrantMutation) OldLastUsedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCredential is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCredential requires an ID field in the mutation")
+		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCredential: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
 	}
-	return oldValue.Credential, nil
+	return oldValue.LastUsedAt, nil
 }
 
-// ResetCredential resets all changes to the "credential" field.
-func (m *PasskeyMutation) ResetCredential() {
-	m.credential = nil
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (m *OAuthGrantMutation) ClearLastUsedAt() {
+	m.last_used_at = nil
+	m.clearedFields[oauthgrant.FieldLastUsedAt] = struct{}{}
 }
 
-// SetUsedAt sets the "used_at" field.
-func (m *PasskeyMutation) SetUsedAt(t time.Time) {
-	m.used_at = &t
+// LastUsedAtCleared returns if the "last_used_at" field was cleared in this mutation.
+func (m *OAuthGrantMutation) LastUsedAtCleared() bool {
+	_, ok := m.clearedFields[oauthgrant.FieldLastUsedAt]
+	return ok
 }
 
-// UsedAt returns the value of the "used_at" field in the mutation.
-func (m *PasskeyMutation) UsedAt() (r time.Time, exists bool) {
-	v := m.used_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldUsedAt returns the old "used_at" field's value of the Passkey entity.
-// If the Passkey object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PasskeyMutation) OldUsedAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUsedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUsedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUsedAt: %w", err)
-	}
-	return oldValue.UsedAt, nil
-}
-
-// ClearUsedAt clears the value of the "used_at" field.
-func (m *PasskeyMutation) ClearUsedAt() {
-	m.used_at = nil
-	m.clearedFields[passkey.FieldUsedAt] = struct{}{}
-}
-
-// UsedAtCleared returns if the "used_at" field was cleared in this mutation.
-func (m *PasskeyMutation) UsedAtCleared() bool {
-	_, ok := m.clearedFields[passkey.FieldUsedAt]
-	return ok
-}
-
-// ResetUsedAt resets all changes to the "used_at" field.
-func (m *PasskeyMutation) ResetUsedAt() {
-	m.used_at = nil
-	delete(m.clearedFields, passkey.FieldUsedAt)
+// ResetLastUsedAt resets all changes to the "last_used_at" field.
+func (m *OAuthGrantMutation) ResetLastUsedAt() {
+	m.last_used_at = nil
+	delete(m.clearedFields, oauthgrant.FieldLastUsedAt)
 }
 
 // ClearUser clears the "user" edge to the User entity.
-func (m *PasskeyMutation) ClearUser() {
+func (m *OAuthGrantMutation) ClearUser() {
 	m.cleareduser = true
-	m.clearedFields[passkey.FieldUserID] = struct{}{}
+	m.clearedFields[oauthgrant.FieldUserID] = struct{}{}
 }
 
 // UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *PasskeyMutation) UserCleared() bool {
+func (m *OAuthGrantMutation) UserCleared() bool {
 	return m.cleareduser
 }
 
 // UserIDs returns the "user" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // UserID instead. It exists only for internal usage by the builders.
-func (m *PasskeyMutation) UserIDs() (ids []int) {
+func (m *OAuthGrantMutation) UserIDs() (ids []int) {
 	if id := m.user; id != nil {
 		ids = append(ids, *id)
 	}
@@ -10493,20 +10818,47 @@ func (m *PasskeyMutation) UserIDs() (ids []int) {
 }
 
 // ResetUser resets all changes to the "user" edge.
-func (m *PasskeyMutation) ResetUser() {
+func (m *OAuthGrantMutation) ResetUser() {
 	m.user = nil
 	m.cleareduser = false
 }
 
-// Where appends a list predicates to the PasskeyMutation builder.
-func (m *PasskeyMutation) Where(ps ...predicate.Passkey) {
+// ClearClient clears the "client" edge to the OAuthClient entity.
+func (m *OAuthGrantMutation) ClearClient() {
+	m.clearedclient = true
+	m.clearedFields[oauthgrant.FieldClientID] = struct{}{}
+}
+
+// ClientCleared reports if the "client" edge to the OAuthClient entity was cleared.
+func (m *OAuthGrantMutation) ClientCleared() bool {
+	return m.clearedclient
+}
+
+// ClientIDs returns the "client" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ClientID instead. It exists only for internal usage by the builders.
+func (m *OAuthGrantMutation) ClientIDs() (ids []int) {
+	if id := m.client; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetClient resets all changes to the "client" edge.
+func (m *OAuthGrantMutation) ResetClient() {
+	m.client = nil
+	m.clearedclient = false
+}
+
+// Where appends a list predicates to the OAuthGrantMutation builder.
+func (m *OAuthGrantMutation) Where(ps ...predicate.OAuthGrant) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PasskeyMutation builder. Using this method,
+// WhereP appends storage-level predicates to the OAuthGrantMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PasskeyMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Passkey, len(ps))
+func (m *OAuthGrantMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.OAuthGrant, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -10514,48 +10866,45 @@ func (m *PasskeyMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PasskeyMutation) Op() Op {
+func (m *OAuthGrantMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PasskeyMutation) SetOp(op Op) {
+func (m *OAuthGrantMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Passkey).
-func (m *PasskeyMutation) Type() string {
+// Type returns the node type of this mutation (OAuthGrant).
+func (m *OAuthGrantMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PasskeyMutation) Fields() []string {
-	fields := make([]string, 0, 8)
+func (m *OAuthGrantMutation) Fields() []string {
+	fields := make([]string, 0, 7)
 	if m.created_at != nil {
-		fields = append(fields, passkey.FieldCreatedAt)
+		fields = append(fields, oauthgrant.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, passkey.FieldUpdatedAt)
+		fields = append(fields, oauthgrant.FieldUpdatedAt)
 	}
 	if m.deleted_at != nil {
-		fields = append(fields, passkey.FieldDeletedAt)
+		fields = append(fields, oauthgrant.FieldDeletedAt)
 	}
 	if m.user != nil {
-		fields = append(fields, passkey.FieldUserID)
-	}
-	if m.credential_id != nil {
-		fields = append(fields, passkey.FieldCredentialID)
+		fields = append(fields, oauthgrant.FieldUserID)
 	}
-	if m.name != nil {
-		fields = append(fields, passkey.FieldName)
+	if m.client != nil {
+		fields = append(fields, oauthgrant.FieldClientID)
 	}
-	if m.credential != nil {
-		fields = append(fields, passkey.FieldCredential)
+	if m.scopes != nil {
+		fields = append(fields, oauthgrant.FieldScopes)
 	}
-	if m.used_at != nil {
-		fields = append(fields, passkey.FieldUsedAt)
+	if m.last_used_at != nil {
+		fields = append(fields, oauthgrant.FieldLastUsedAt)
 	}
 	return fields
 }
@@ -10563,24 +10912,22 @@ func (m *PasskeyMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PasskeyMutation) Field(name string) (ent.Value, bool) {
+func (m *OAuthGrantMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case passkey.FieldCreatedAt:
+	case oauthgrant.FieldCreatedAt:
 		return m.CreatedAt()
-	case passkey.FieldUpdatedAt:
+	case oauthgrant.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case passkey.FieldDeletedAt:
+	case oauthgrant.FieldDeletedAt:
 		return m.DeletedAt()
-	case passkey.FieldUserID:
+	case oauthgrant.FieldUserID:
 		return m.UserID()
-	case passkey.FieldCredentialID:
-		return m.CredentialID()
-	case passkey.FieldName:
-		return m.Name()
-	case passkey.FieldCredential:
-		return m.Credential()
-	case passkey.FieldUsedAt:
-		return m.UsedAt()
+	case oauthgrant.FieldClientID:
+		return m.ClientID()
+	case oauthgrant.FieldScopes:
+		return m.Scopes()
+	case oauthgrant.FieldLastUsedAt:
+		return m.LastUsedAt()
 	}
 	return nil, false
 }
@@ -10588,96 +10935,87 @@ func (m *PasskeyMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PasskeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *OAuthGrantMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case passkey.FieldCreatedAt:
+	case oauthgrant.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case passkey.FieldUpdatedAt:
+	case oauthgrant.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case passkey.FieldDeletedAt:
+	case oauthgrant.FieldDeletedAt:
 		return m.OldDeletedAt(ctx)
-	case passkey.FieldUserID:
+	case oauthgrant.FieldUserID:
 		return m.OldUserID(ctx)
-	case passkey.FieldCredentialID:
-		return m.OldCredentialID(ctx)
-	case passkey.FieldName:
-		return m.OldName(ctx)
-	case passkey.FieldCredential:
-		return m.OldCredential(ctx)
-	case passkey.FieldUsedAt:
-		return m.OldUsedAt(ctx)
+	case oauthgrant.FieldClientID:
+		return m.OldClientID(ctx)
+	case oauthgrant.FieldScopes:
+		return m.OldScopes(ctx)
+	case oauthgrant.FieldLastUsedAt:
+		return m.OldLastUsedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Passkey field %s", name)
+	return nil, fmt.Errorf("unknown OAuthGrant field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PasskeyMutation) SetField(name string, value ent.Value) error {
+func (m *OAuthGrantMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case passkey.FieldCreatedAt:
+	case oauthgrant.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case passkey.FieldUpdatedAt:
+	case oauthgrant.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case passkey.FieldDeletedAt:
+	case oauthgrant.FieldDeletedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDeletedAt(v)
 		return nil
-	case passkey.FieldUserID:
+	case oauthgrant.FieldUserID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUserID(v)
 		return nil
-	case passkey.FieldCredentialID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCredentialID(v)
-		return nil
-	case passkey.FieldName:
-		v, ok := value.(string)
+	case oauthgrant.FieldClientID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetClientID(v)
 		return nil
-	case passkey.FieldCredential:
-		v, ok := value.(*webauthn.Credential)
+	case oauthgrant.FieldScopes:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCredential(v)
+		m.SetScopes(v)
 		return nil
-	case passkey.FieldUsedAt:
+	case oauthgrant.FieldLastUsedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUsedAt(v)
+		m.SetLastUsedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Passkey field %s", name)
+	return fmt.Errorf("unknown OAuthGrant field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PasskeyMutation) AddedFields() []string {
+func (m *OAuthGrantMutation) AddedFields() []string {
 	var fields []string
 	return fields
 }
@@ -10685,7 +11023,7 @@ func (m *PasskeyMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PasskeyMutation) AddedField(name string) (ent.Value, bool) {
+func (m *OAuthGrantMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
 	}
 	return nil, false
@@ -10694,154 +11032,169 @@ func (m *PasskeyMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PasskeyMutation) AddField(name string, value ent.Value) error {
+func (m *OAuthGrantMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Passkey numeric field %s", name)
+	return fmt.Errorf("unknown OAuthGrant numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PasskeyMutation) ClearedFields() []string {
+func (m *OAuthGrantMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(passkey.FieldDeletedAt) {
-		fields = append(fields, passkey.FieldDeletedAt)
+	if m.FieldCleared(oauthgrant.FieldDeletedAt) {
+		fields = append(fields, oauthgrant.FieldDeletedAt)
 	}
-	if m.FieldCleared(passkey.FieldUsedAt) {
-		fields = append(fields, passkey.FieldUsedAt)
+	if m.FieldCleared(oauthgrant.FieldLastUsedAt) {
+		fields = append(fields, oauthgrant.FieldLastUsedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PasskeyMutation) FieldCleared(name string) bool {
+func (m *OAuthGrantMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PasskeyMutation) ClearField(name string) error {
+func (m *OAuthGrantMutation) ClearField(name string) error {
 	switch name {
-	case passkey.FieldDeletedAt:
+	case oauthgrant.FieldDeletedAt:
 		m.ClearDeletedAt()
 		return nil
-	case passkey.FieldUsedAt:
-		m.ClearUsedAt()
+	case oauthgrant.FieldLastUsedAt:
+		m.ClearLastUsedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Passkey nullable field %s", name)
+	return fmt.Errorf("unknown OAuthGrant nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PasskeyMutation) ResetField(name string) error {
+func (m *OAuthGrantMutation) ResetField(name string) error {
 	switch name {
-	case passkey.FieldCreatedAt:
+	case oauthgrant.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case passkey.FieldUpdatedAt:
+	case oauthgrant.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case passkey.FieldDeletedAt:
+	case oauthgrant.FieldDeletedAt:
 		m.ResetDeletedAt()
 		return nil
-	case passkey.FieldUserID:
+	case oauthgrant.FieldUserID:
 		m.ResetUserID()
 		return nil
-	case passkey.FieldCredentialID:
-		m.ResetCredentialID()
-		return nil
-	case passkey.FieldName:
-		m.ResetName()
+	case oauthgrant.FieldClientID:
+		m.ResetClientID()
 		return nil
-	case passkey.FieldCredential:
-		m.ResetCredential()
+	case oauthgrant.FieldScopes:
+		m.ResetScopes()
 		return nil
-	case passkey.FieldUsedAt:
-		m.ResetUsedAt()
+	case oauthgrant.FieldLastUsedAt:
+		m.ResetLastUsedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Passkey field %s", name)
+	return fmt.Errorf("unknown OAuthGrant field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PasskeyMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *OAuthGrantMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
 	if m.user != nil {
-		edges = append(edges, passkey.EdgeUser)
+		edges = append(edges, oauthgrant.EdgeUser)
+	}
+	if m.client != nil {
+		edges = append(edges, oauthgrant.EdgeClient)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PasskeyMutation) AddedIDs(name string) []ent.Value {
+func (m *OAuthGrantMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case passkey.EdgeUser:
+	case oauthgrant.EdgeUser:
 		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
+	case oauthgrant.EdgeClient:
+		if id := m.client; id != nil {
+			return []ent.Value{*id}
+		}
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PasskeyMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *OAuthGrantMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PasskeyMutation) RemovedIDs(name string) []ent.Value {
+func (m *OAuthGrantMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PasskeyMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *OAuthGrantMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
 	if m.cleareduser {
-		edges = append(edges, passkey.EdgeUser)
+		edges = append(edges, oauthgrant.EdgeUser)
+	}
+	if m.clearedclient {
+		edges = append(edges, oauthgrant.EdgeClient)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PasskeyMutation) EdgeCleared(name string) bool {
+func (m *OAuthGrantMutation) EdgeCleared(name string) bool {
 	switch name {
-	case passkey.EdgeUser:
+	case oauthgrant.EdgeUser:
 		return m.cleareduser
+	case oauthgrant.EdgeClient:
+		return m.clearedclient
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PasskeyMutation) ClearEdge(name string) error {
+func (m *OAuthGrantMutation) ClearEdge(name string) error {
 	switch name {
-	case passkey.EdgeUser:
+	case oauthgrant.EdgeUser:
 		m.ClearUser()
 		return nil
+	case oauthgrant.EdgeClient:
+		m.ClearClient()
+		return nil
 	}
-	return fmt.Errorf("unknown Passkey unique edge %s", name)
+	return fmt.Errorf("unknown OAuthGrant unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PasskeyMutation) ResetEdge(name string) error {
+func (m *OAuthGrantMutation) ResetEdge(name string) error {
 	switch name {
-	case passkey.EdgeUser:
+	case oauthgrant.EdgeUser:
 		m.ResetUser()
 		return nil
+	case oauthgrant.EdgeClient:
+		m.ResetClient()
+		return nil
 	}
-	return fmt.Errorf("unknown Passkey edge %s", name)
+	return fmt.Errorf("unknown OAuthGrant edge %s", name)
 }
 
-// SettingMutation represents an operation that mutates the Setting nodes in the graph.
-type SettingMutation struct {
+// PasskeyMutation represents an operation that mutates the Passkey nodes in the graph.
+type PasskeyMutation struct {
 	config
 	op            Op
 	typ           string
@@ -10849,25 +11202,29 @@ type SettingMutation struct {
 	created_at    *time.Time
 	updated_at    *time.Time
 	deleted_at    *time.Time
+	credential_id *string
 	name          *string
-	value         *string
+	credential    **webauthn.Credential
+	used_at       *time.Time
 	clearedFields map[string]struct{}
+	user          *int
+	cleareduser   bool
 	done          bool
-	oldValue      func(context.Context) (*Setting, error)
-	predicates    []predicate.Setting
+	oldValue      func(context.Context) (*Passkey, error)
+	predicates    []predicate.Passkey
 }
 
-var _ ent.Mutation = (*SettingMutation)(nil)
+var _ ent.Mutation = (*PasskeyMutation)(nil)
 
-// settingOption allows management of the mutation configuration using functional options.
-type settingOption func(*SettingMutation)
+// passkeyOption allows management of the mutation configuration using functional options.
+type passkeyOption func(*PasskeyMutation)
 
-// newSettingMutation creates new mutation for the Setting entity.
-func newSettingMutation(c config, op Op, opts ...settingOption) *SettingMutation {
-	m := &SettingMutation{
+// newPasskeyMutation creates new mutation for the Passkey entity.
+func newPasskeyMutation(c config, op Op, opts ...passkeyOption) *PasskeyMutation {
+	m := &PasskeyMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSetting,
+		typ:           TypePasskey,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -10876,20 +11233,20 @@ func newSettingMutation(c config, op Op, opts ...settingOption) *SettingMutation
 	return m
 }
 
-// withSettingID sets the ID field of the mutation.
-func withSettingID(id int) settingOption {
-	return func(m *SettingMutation) {
+// withPasskeyID sets the ID field of the mutation.
+func withPasskeyID(id int) passkeyOption {
+	return func(m *PasskeyMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Setting
+			value *Passkey
 		)
-		m.oldValue = func(ctx context.Context) (*Setting, error) {
+		m.oldValue = func(ctx context.Context) (*Passkey, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Setting.Get(ctx, id)
+					value, err = m.Client().Passkey.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -10898,10 +11255,10 @@ func withSettingID(id int) settingOption {
 	}
 }
 
-// withSetting sets the old Setting of the mutation.
-func withSetting(node *Setting) settingOption {
-	return func(m *SettingMutation) {
-		m.oldValue = func(context.Context) (*Setting, error) {
+// withPasskey sets the old Passkey of the mutation.
+func withPasskey(node *Passkey) passkeyOption {
+	return func(m *PasskeyMutation) {
+		m.oldValue = func(context.Context) (*Passkey, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -10910,7 +11267,7 @@ func withSetting(node *Setting) settingOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SettingMutation) Client() *Client {
+func (m PasskeyMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -10918,7 +11275,7 @@ func (m SettingMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SettingMutation) Tx() (*Tx, error) {
+func (m PasskeyMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -10929,7 +11286,7 @@ func (m SettingMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SettingMutation) ID() (id int, exists bool) {
+func (m *PasskeyMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -10940,7 +11297,7 @@ func (m *SettingMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SettingMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *PasskeyMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -10949,19 +11306,19 @@ func (m *SettingMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Setting.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Passkey.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *SettingMutation) SetCreatedAt(t time.Time) {
+func (m *PasskeyMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *SettingMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *PasskeyMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -10969,10 +11326,10 @@ func (m *SettingMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Setting entity.
-// If the Setting object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Passkey entity.
+// If the Passkey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *PasskeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -10987,17 +11344,17 @@ func (m *SettingMutation) OldCreatedAt(ctx context.Context) (v time.Time, err er
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *SettingMutation) ResetCreatedAt() {
+func (m *PasskeyMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *SettingMutation) SetUpdatedAt(t time.Time) {
+func (m *PasskeyMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *SettingMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *PasskeyMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -11005,10 +11362,10 @@ func (m *SettingMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Setting entity.
-// If the Setting object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Passkey entity.
+// If the Passkey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *PasskeyMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -11023,17 +11380,17 @@ func (m *SettingMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err er
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *SettingMutation) ResetUpdatedAt() {
+func (m *PasskeyMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
 // SetDeletedAt sets the "deleted_at" field.
-func (m *SettingMutation) SetDeletedAt(t time.Time) {
+func (m *PasskeyMutation) SetDeletedAt(t time.Time) {
 	m.deleted_at = &t
 }
 
 // DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *SettingMutation) DeletedAt() (r time.Time, exists bool) {
+func (m *PasskeyMutation) DeletedAt() (r time.Time, exists bool) {
 	v := m.deleted_at
 	if v == nil {
 		return
@@ -11041,10 +11398,10 @@ func (m *SettingMutation) DeletedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the Setting entity.
-// If the Setting object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedAt returns the old "deleted_at" field's value of the Passkey entity.
+// If the Passkey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *PasskeyMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
@@ -11059,157 +11416,301 @@ func (m *SettingMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err e
 }
 
 // ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *SettingMutation) ClearDeletedAt() {
+func (m *PasskeyMutation) ClearDeletedAt() {
 	m.deleted_at = nil
-	m.clearedFields[setting.FieldDeletedAt] = struct{}{}
+	m.clearedFields[passkey.FieldDeletedAt] = struct{}{}
 }
 
 // DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *SettingMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[setting.FieldDeletedAt]
+func (m *PasskeyMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[passkey.FieldDeletedAt]
 	return ok
 }
 
 // ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *SettingMutation) ResetDeletedAt() {
+func (m *PasskeyMutation) ResetDeletedAt() {
 	m.deleted_at = nil
-	delete(m.clearedFields, setting.FieldDeletedAt)
+	delete(m.clearedFields, passkey.FieldDeletedAt)
 }
 
-// SetName sets the "name" field.
-func (m *SettingMutation) SetName(s string) {
-	m.name = &s
+// SetUserID sets the "user_id" field.
+func (m *PasskeyMutation) SetUserID(i int) {
+	m.user = &i
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *SettingMutation) Name() (r string, exists bool) {
-	v := m.name
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *PasskeyMutation) UserID() (r int, exists bool) {
+	v := m.user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Setting entity.
-// If the Setting object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the Passkey entity.
+// If the Passkey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *PasskeyMutation) OldUserID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.UserID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *SettingMutation) ResetName() {
-	m.name = nil
+// ResetUserID resets all changes to the "user_id" field.
+func (m *PasskeyMutation) ResetUserID() {
+	m.user = nil
 }
 
-// SetValue sets the "value" field.
-func (m *SettingMutation) SetValue(s string) {
-	m.value = &s
+// SetCredentialID sets the "credential_id" field.
+func (m *PasskeyMutation) SetCredentialID(s string) {
+	m.credential_id = &s
 }
 
-// Value returns the value of the "value" field in the mutation.
-func (m *SettingMutation) Value() (r string, exists bool) {
-	v := m.value
+// CredentialID returns the value of the "credential_id" field in the mutation.
+func (m *PasskeyMutation) CredentialID() (r string, exists bool) {
+	v := m.credential_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldValue returns the old "value" field's value of the Setting entity.
-// If the Setting object wasn't provided to the builder, the object is fetched from the database.
+// OldCredentialID returns the old "credential_id" field's value of the Passkey entity.
+// If the Passkey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingMutation) OldValue(ctx context.Context) (v string, err error) {
+func (m *PasskeyMutation) OldCredentialID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+		return v, errors.New("OldCredentialID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldValue requires an ID field in the mutation")
+		return v, errors.New("OldCredentialID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+		return v, fmt.Errorf("querying old value for OldCredentialID: %w", err)
 	}
-	return oldValue.Value, nil
-}
-
-// ClearValue clears the value of the "value" field.
-func (m *SettingMutation) ClearValue() {
-	m.value = nil
-	m.clearedFields[setting.FieldValue] = struct{}{}
-}
-
-// ValueCleared returns if the "value" field was cleared in this mutation.
-func (m *SettingMutation) ValueCleared() bool {
-	_, ok := m.clearedFields[setting.FieldValue]
-	return ok
+	return oldValue.CredentialID, nil
 }
 
-// ResetValue resets all changes to the "value" field.
-func (m *SettingMutation) ResetValue() {
-	m.value = nil
-	delete(m.clearedFields, setting.FieldValue)
+// ResetCredentialID resets all changes to the "credential_id" field.
+func (m *PasskeyMutation) ResetCredentialID() {
+	m.credential_id = nil
 }
 
-// Where appends a list predicates to the SettingMutation builder.
-func (m *SettingMutation) Where(ps ...predicate.Setting) {
-	m.predicates = append(m.predicates, ps...)
+// SetName sets the "name" field.
+func (m *PasskeyMutation) SetName(s string) {
+	m.name = &s
 }
 
-// WhereP appends storage-level predicates to the SettingMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SettingMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Setting, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// Name returns the value of the "name" field in the mutation.
+func (m *PasskeyMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *SettingMutation) Op() Op {
+// OldName returns the old "name" field's value of the Passkey entity.
+// If the Passkey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasskeyMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *PasskeyMutation) ResetName() {
+	m.name = nil
+}
+
+// SetCredential sets the "credential" field.
+func (m *PasskeyMutation) SetCredential(w *webauthn.Credential) {
+	m.credential = &w
+}
+
+// Credential returns the value of the "credential" field in the mutation.
+func (m *PasskeyMutation) Credential() (r *webauthn.Credential, exists bool) {
+	v := m.credential
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCredential returns the old "credential" field's value of the Passkey entity.
+// If the Passkey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasskeyMutation) OldCredential(ctx context.Context) (v *webauthn.Credential, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCredential is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCredential requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCredential: %w", err)
+	}
+	return oldValue.Credential, nil
+}
+
+// ResetCredential resets all changes to the "credential" field.
+func (m *PasskeyMutation) ResetCredential() {
+	m.credential = nil
+}
+
+// SetUsedAt sets the "used_at" field.
+func (m *PasskeyMutation) SetUsedAt(t time.Time) {
+	m.used_at = &t
+}
+
+// UsedAt returns the value of the "used_at" field in the mutation.
+func (m *PasskeyMutation) UsedAt() (r time.Time, exists bool) {
+	v := m.used_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsedAt returns the old "used_at" field's value of the Passkey entity.
+// If the Passkey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasskeyMutation) OldUsedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsedAt: %w", err)
+	}
+	return oldValue.UsedAt, nil
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (m *PasskeyMutation) ClearUsedAt() {
+	m.used_at = nil
+	m.clearedFields[passkey.FieldUsedAt] = struct{}{}
+}
+
+// UsedAtCleared returns if the "used_at" field was cleared in this mutation.
+func (m *PasskeyMutation) UsedAtCleared() bool {
+	_, ok := m.clearedFields[passkey.FieldUsedAt]
+	return ok
+}
+
+// ResetUsedAt resets all changes to the "used_at" field.
+func (m *PasskeyMutation) ResetUsedAt() {
+	m.used_at = nil
+	delete(m.clearedFields, passkey.FieldUsedAt)
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *PasskeyMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[passkey.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *PasskeyMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *PasskeyMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *PasskeyMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the PasskeyMutation builder.
+func (m *PasskeyMutation) Where(ps ...predicate.Passkey) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PasskeyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PasskeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Passkey, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PasskeyMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SettingMutation) SetOp(op Op) {
+func (m *PasskeyMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Setting).
-func (m *SettingMutation) Type() string {
+// Type returns the node type of this mutation (Passkey).
+func (m *PasskeyMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SettingMutation) Fields() []string {
-	fields := make([]string, 0, 5)
+func (m *PasskeyMutation) Fields() []string {
+	fields := make([]string, 0, 8)
 	if m.created_at != nil {
-		fields = append(fields, setting.FieldCreatedAt)
+		fields = append(fields, passkey.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, setting.FieldUpdatedAt)
+		fields = append(fields, passkey.FieldUpdatedAt)
 	}
 	if m.deleted_at != nil {
-		fields = append(fields, setting.FieldDeletedAt)
+		fields = append(fields, passkey.FieldDeletedAt)
+	}
+	if m.user != nil {
+		fields = append(fields, passkey.FieldUserID)
+	}
+	if m.credential_id != nil {
+		fields = append(fields, passkey.FieldCredentialID)
 	}
 	if m.name != nil {
-		fields = append(fields, setting.FieldName)
+		fields = append(fields, passkey.FieldName)
 	}
-	if m.value != nil {
-		fields = append(fields, setting.FieldValue)
+	if m.credential != nil {
+		fields = append(fields, passkey.FieldCredential)
+	}
+	if m.used_at != nil {
+		fields = append(fields, passkey.FieldUsedAt)
 	}
 	return fields
 }
@@ -11217,18 +11718,24 @@ func (m *SettingMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SettingMutation) Field(name string) (ent.Value, bool) {
+func (m *PasskeyMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case setting.FieldCreatedAt:
+	case passkey.FieldCreatedAt:
 		return m.CreatedAt()
-	case setting.FieldUpdatedAt:
+	case passkey.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case setting.FieldDeletedAt:
+	case passkey.FieldDeletedAt:
 		return m.DeletedAt()
-	case setting.FieldName:
+	case passkey.FieldUserID:
+		return m.UserID()
+	case passkey.FieldCredentialID:
+		return m.CredentialID()
+	case passkey.FieldName:
 		return m.Name()
-	case setting.FieldValue:
-		return m.Value()
+	case passkey.FieldCredential:
+		return m.Credential()
+	case passkey.FieldUsedAt:
+		return m.UsedAt()
 	}
 	return nil, false
 }
@@ -11236,232 +11743,293 @@ func (m *SettingMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SettingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *PasskeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case setting.FieldCreatedAt:
+	case passkey.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case setting.FieldUpdatedAt:
+	case passkey.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case setting.FieldDeletedAt:
+	case passkey.FieldDeletedAt:
 		return m.OldDeletedAt(ctx)
-	case setting.FieldName:
+	case passkey.FieldUserID:
+		return m.OldUserID(ctx)
+	case passkey.FieldCredentialID:
+		return m.OldCredentialID(ctx)
+	case passkey.FieldName:
 		return m.OldName(ctx)
-	case setting.FieldValue:
-		return m.OldValue(ctx)
+	case passkey.FieldCredential:
+		return m.OldCredential(ctx)
+	case passkey.FieldUsedAt:
+		return m.OldUsedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Setting field %s", name)
+	return nil, fmt.Errorf("unknown Passkey field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SettingMutation) SetField(name string, value ent.Value) error {
+func (m *PasskeyMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case setting.FieldCreatedAt:
+	case passkey.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case setting.FieldUpdatedAt:
+	case passkey.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case setting.FieldDeletedAt:
+	case passkey.FieldDeletedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDeletedAt(v)
 		return nil
-	case setting.FieldName:
+	case passkey.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case passkey.FieldCredentialID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetCredentialID(v)
 		return nil
-	case setting.FieldValue:
+	case passkey.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetValue(v)
+		m.SetName(v)
 		return nil
-	}
-	return fmt.Errorf("unknown Setting field %s", name)
-}
-
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *SettingMutation) AddedFields() []string {
-	return nil
+	case passkey.FieldCredential:
+		v, ok := value.(*webauthn.Credential)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCredential(v)
+		return nil
+	case passkey.FieldUsedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Passkey field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PasskeyMutation) AddedFields() []string {
+	var fields []string
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SettingMutation) AddedField(name string) (ent.Value, bool) {
+func (m *PasskeyMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SettingMutation) AddField(name string, value ent.Value) error {
+func (m *PasskeyMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Setting numeric field %s", name)
+	return fmt.Errorf("unknown Passkey numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SettingMutation) ClearedFields() []string {
+func (m *PasskeyMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(setting.FieldDeletedAt) {
-		fields = append(fields, setting.FieldDeletedAt)
+	if m.FieldCleared(passkey.FieldDeletedAt) {
+		fields = append(fields, passkey.FieldDeletedAt)
 	}
-	if m.FieldCleared(setting.FieldValue) {
-		fields = append(fields, setting.FieldValue)
+	if m.FieldCleared(passkey.FieldUsedAt) {
+		fields = append(fields, passkey.FieldUsedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SettingMutation) FieldCleared(name string) bool {
+func (m *PasskeyMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SettingMutation) ClearField(name string) error {
+func (m *PasskeyMutation) ClearField(name string) error {
 	switch name {
-	case setting.FieldDeletedAt:
+	case passkey.FieldDeletedAt:
 		m.ClearDeletedAt()
 		return nil
-	case setting.FieldValue:
-		m.ClearValue()
+	case passkey.FieldUsedAt:
+		m.ClearUsedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Setting nullable field %s", name)
+	return fmt.Errorf("unknown Passkey nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SettingMutation) ResetField(name string) error {
+func (m *PasskeyMutation) ResetField(name string) error {
 	switch name {
-	case setting.FieldCreatedAt:
+	case passkey.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case setting.FieldUpdatedAt:
+	case passkey.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case setting.FieldDeletedAt:
+	case passkey.FieldDeletedAt:
 		m.ResetDeletedAt()
 		return nil
-	case setting.FieldName:
+	case passkey.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case passkey.FieldCredentialID:
+		m.ResetCredentialID()
+		return nil
+	case passkey.FieldName:
 		m.ResetName()
 		return nil
-	case setting.FieldValue:
-		m.ResetValue()
+	case passkey.FieldCredential:
+		m.ResetCredential()
+		return nil
+	case passkey.FieldUsedAt:
+		m.ResetUsedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Setting field %s", name)
+	return fmt.Errorf("unknown Passkey field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SettingMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *PasskeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, passkey.EdgeUser)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SettingMutation) AddedIDs(name string) []ent.Value {
+func (m *PasskeyMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case passkey.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SettingMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *PasskeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SettingMutation) RemovedIDs(name string) []ent.Value {
+func (m *PasskeyMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SettingMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *PasskeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, passkey.EdgeUser)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SettingMutation) EdgeCleared(name string) bool {
+func (m *PasskeyMutation) EdgeCleared(name string) bool {
+	switch name {
+	case passkey.EdgeUser:
+		return m.cleareduser
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SettingMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Setting unique edge %s", name)
+func (m *PasskeyMutation) ClearEdge(name string) error {
+	switch name {
+	case passkey.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Passkey unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SettingMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Setting edge %s", name)
+func (m *PasskeyMutation) ResetEdge(name string) error {
+	switch name {
+	case passkey.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Passkey edge %s", name)
 }
 
-// ShareMutation represents an operation that mutates the Share nodes in the graph.
-type ShareMutation struct {
+// ScheduleMutation represents an operation that mutates the Schedule nodes in the graph.
+type ScheduleMutation struct {
 	config
-	op                  Op
-	typ                 string
-	id                  *int
-	created_at          *time.Time
-	updated_at          *time.Time
-	deleted_at          *time.Time
-	password            *string
-	views               *int
-	addviews            *int
-	downloads           *int
-	adddownloads        *int
-	expires             *time.Time
-	remain_downloads    *int
-	addremain_downloads *int
-	props               **types.ShareProps
-	clearedFields       map[string]struct{}
-	user                *int
-	cleareduser         bool
-	file                *int
-	clearedfile         bool
-	done                bool
-	oldValue            func(context.Context) (*Share, error)
-	predicates          []predicate.Share
+	op            Op
+	typ           string
+	id            *int
+	created_at    *time.Time
+	updated_at    *time.Time
+	deleted_at    *time.Time
+	name          *string
+	_type         *schedule.Type
+	spec          *string
+	props         **types.ScheduleProps
+	enabled       *bool
+	owner_id      *int
+	addowner_id   *int
+	next_run_at   *time.Time
+	last_run_at   *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Schedule, error)
+	predicates    []predicate.Schedule
 }
 
-var _ ent.Mutation = (*ShareMutation)(nil)
+var _ ent.Mutation = (*ScheduleMutation)(nil)
 
-// shareOption allows management of the mutation configuration using functional options.
-type shareOption func(*ShareMutation)
+// scheduleOption allows management of the mutation configuration using functional options.
+type scheduleOption func(*ScheduleMutation)
 
-// newShareMutation creates new mutation for the Share entity.
-func newShareMutation(c config, op Op, opts ...shareOption) *ShareMutation {
-	m := &ShareMutation{
+// newScheduleMutation creates new mutation for the Schedule entity.
+func newScheduleMutation(c config, op Op, opts ...scheduleOption) *ScheduleMutation {
+	m := &ScheduleMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeShare,
+		typ:           TypeSchedule,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -11470,20 +12038,20 @@ func newShareMutation(c config, op Op, opts ...shareOption) *ShareMutation {
 	return m
 }
 
-// withShareID sets the ID field of the mutation.
-func withShareID(id int) shareOption {
-	return func(m *ShareMutation) {
+// withScheduleID sets the ID field of the mutation.
+func withScheduleID(id int) scheduleOption {
+	return func(m *ScheduleMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Share
+			value *Schedule
 		)
-		m.oldValue = func(ctx context.Context) (*Share, error) {
+		m.oldValue = func(ctx context.Context) (*Schedule, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Share.Get(ctx, id)
+					value, err = m.Client().Schedule.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -11492,10 +12060,10 @@ func withShareID(id int) shareOption {
 	}
 }
 
-// withShare sets the old Share of the mutation.
-func withShare(node *Share) shareOption {
-	return func(m *ShareMutation) {
-		m.oldValue = func(context.Context) (*Share, error) {
+// withSchedule sets the old Schedule of the mutation.
+func withSchedule(node *Schedule) scheduleOption {
+	return func(m *ScheduleMutation) {
+		m.oldValue = func(context.Context) (*Schedule, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -11504,7 +12072,7 @@ func withShare(node *Share) shareOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ShareMutation) Client() *Client {
+func (m ScheduleMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -11512,7 +12080,7 @@ func (m ShareMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ShareMutation) Tx() (*Tx, error) {
+func (m ScheduleMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -11523,7 +12091,7 @@ func (m ShareMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ShareMutation) ID() (id int, exists bool) {
+func (m *ScheduleMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -11534,7 +12102,7 @@ func (m *ShareMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ShareMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ScheduleMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -11543,19 +12111,19 @@ func (m *ShareMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Share.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Schedule.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *ShareMutation) SetCreatedAt(t time.Time) {
+func (m *ScheduleMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ShareMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *ScheduleMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -11563,10 +12131,10 @@ func (m *ShareMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Share entity.
-// If the Share object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Schedule entity.
+// If the Schedule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ShareMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ScheduleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -11581,17 +12149,17 @@ func (m *ShareMutation) OldCreatedAt(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ShareMutation) ResetCreatedAt() {
+func (m *ScheduleMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *ShareMutation) SetUpdatedAt(t time.Time) {
+func (m *ScheduleMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ShareMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *ScheduleMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -11599,10 +12167,10 @@ func (m *ShareMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Share entity.
-// If the Share object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Schedule entity.
+// If the Schedule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ShareMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ScheduleMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -11617,17 +12185,17 @@ func (m *ShareMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ShareMutation) ResetUpdatedAt() {
+func (m *ScheduleMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
 // SetDeletedAt sets the "deleted_at" field.
-func (m *ShareMutation) SetDeletedAt(t time.Time) {
+func (m *ScheduleMutation) SetDeletedAt(t time.Time) {
 	m.deleted_at = &t
 }
 
 // DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *ShareMutation) DeletedAt() (r time.Time, exists bool) {
+func (m *ScheduleMutation) DeletedAt() (r time.Time, exists bool) {
 	v := m.deleted_at
 	if v == nil {
 		return
@@ -11635,10 +12203,10 @@ func (m *ShareMutation) DeletedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the Share entity.
-// If the Share object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedAt returns the old "deleted_at" field's value of the Schedule entity.
+// If the Schedule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ShareMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *ScheduleMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
@@ -11653,491 +12221,424 @@ func (m *ShareMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err err
 }
 
 // ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *ShareMutation) ClearDeletedAt() {
+func (m *ScheduleMutation) ClearDeletedAt() {
 	m.deleted_at = nil
-	m.clearedFields[share.FieldDeletedAt] = struct{}{}
+	m.clearedFields[schedule.FieldDeletedAt] = struct{}{}
 }
 
 // DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *ShareMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[share.FieldDeletedAt]
+func (m *ScheduleMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[schedule.FieldDeletedAt]
 	return ok
 }
 
 // ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *ShareMutation) ResetDeletedAt() {
+func (m *ScheduleMutation) ResetDeletedAt() {
 	m.deleted_at = nil
-	delete(m.clearedFields, share.FieldDeletedAt)
+	delete(m.clearedFields, schedule.FieldDeletedAt)
 }
 
-// SetPassword sets the "password" field.
-func (m *ShareMutation) SetPassword(s string) {
-	m.password = &s
+// SetName sets the "name" field.
+func (m *ScheduleMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Password returns the value of the "password" field in the mutation.
-func (m *ShareMutation) Password() (r string, exists bool) {
-	v := m.password
+// Name returns the value of the "name" field in the mutation.
+func (m *ScheduleMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPassword returns the old "password" field's value of the Share entity.
-// If the Share object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Schedule entity.
+// If the Schedule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ShareMutation) OldPassword(ctx context.Context) (v string, err error) {
+func (m *ScheduleMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPassword requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Password, nil
+	return oldValue.Name, nil
 }
 
-// ClearPassword clears the value of the "password" field.
-func (m *ShareMutation) ClearPassword() {
-	m.password = nil
-	m.clearedFields[share.FieldPassword] = struct{}{}
+// ResetName resets all changes to the "name" field.
+func (m *ScheduleMutation) ResetName() {
+	m.name = nil
 }
 
-// PasswordCleared returns if the "password" field was cleared in this mutation.
-func (m *ShareMutation) PasswordCleared() bool {
-	_, ok := m.clearedFields[share.FieldPassword]
-	return ok
+// SetType sets the "type" field.
+func (m *ScheduleMutation) SetType(s schedule.Type) {
+	m._type = &s
 }
 
-// ResetPassword resets all changes to the "password" field.
-func (m *ShareMutation) ResetPassword() {
-	m.password = nil
-	delete(m.clearedFields, share.FieldPassword)
+// GetType returns the value of the "type" field in the mutation.
+func (m *ScheduleMutation) GetType() (r schedule.Type, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetViews sets the "views" field.
-func (m *ShareMutation) SetViews(i int) {
-	m.views = &i
-	m.addviews = nil
-}
-
-// Views returns the value of the "views" field in the mutation.
-func (m *ShareMutation) Views() (r int, exists bool) {
-	v := m.views
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldViews returns the old "views" field's value of the Share entity.
-// If the Share object wasn't provided to the builder, the object is fetched from the database.
+// OldType returns the old "type" field's value of the Schedule entity.
+// If the Schedule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ShareMutation) OldViews(ctx context.Context) (v int, err error) {
+func (m *ScheduleMutation) OldType(ctx context.Context) (v schedule.Type, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldViews is only allowed on UpdateOne operations")
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldViews requires an ID field in the mutation")
+		return v, errors.New("OldType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldViews: %w", err)
-	}
-	return oldValue.Views, nil
-}
-
-// AddViews adds i to the "views" field.
-func (m *ShareMutation) AddViews(i int) {
-	if m.addviews != nil {
-		*m.addviews += i
-	} else {
-		m.addviews = &i
-	}
-}
-
-// AddedViews returns the value that was added to the "views" field in this mutation.
-func (m *ShareMutation) AddedViews() (r int, exists bool) {
-	v := m.addviews
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
 	}
-	return *v, true
+	return oldValue.Type, nil
 }
 
-// ResetViews resets all changes to the "views" field.
-func (m *ShareMutation) ResetViews() {
-	m.views = nil
-	m.addviews = nil
+// ResetType resets all changes to the "type" field.
+func (m *ScheduleMutation) ResetType() {
+	m._type = nil
 }
 
-// SetDownloads sets the "downloads" field.
-func (m *ShareMutation) SetDownloads(i int) {
-	m.downloads = &i
-	m.adddownloads = nil
+// SetSpec sets the "spec" field.
+func (m *ScheduleMutation) SetSpec(s string) {
+	m.spec = &s
 }
 
-// Downloads returns the value of the "downloads" field in the mutation.
-func (m *ShareMutation) Downloads() (r int, exists bool) {
-	v := m.downloads
+// Spec returns the value of the "spec" field in the mutation.
+func (m *ScheduleMutation) Spec() (r string, exists bool) {
+	v := m.spec
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDownloads returns the old "downloads" field's value of the Share entity.
-// If the Share object wasn't provided to the builder, the object is fetched from the database.
+// OldSpec returns the old "spec" field's value of the Schedule entity.
+// If the Schedule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ShareMutation) OldDownloads(ctx context.Context) (v int, err error) {
+func (m *ScheduleMutation) OldSpec(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDownloads is only allowed on UpdateOne operations")
+		return v, errors.New("OldSpec is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDownloads requires an ID field in the mutation")
+		return v, errors.New("OldSpec requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDownloads: %w", err)
+		return v, fmt.Errorf("querying old value for OldSpec: %w", err)
 	}
-	return oldValue.Downloads, nil
+	return oldValue.Spec, nil
 }
 
-// AddDownloads adds i to the "downloads" field.
-func (m *ShareMutation) AddDownloads(i int) {
-	if m.adddownloads != nil {
-		*m.adddownloads += i
-	} else {
-		m.adddownloads = &i
-	}
+// ResetSpec resets all changes to the "spec" field.
+func (m *ScheduleMutation) ResetSpec() {
+	m.spec = nil
 }
 
-// AddedDownloads returns the value that was added to the "downloads" field in this mutation.
-func (m *ShareMutation) AddedDownloads() (r int, exists bool) {
-	v := m.adddownloads
+// SetProps sets the "props" field.
+func (m *ScheduleMutation) SetProps(tp *types.ScheduleProps) {
+	m.props = &tp
+}
+
+// Props returns the value of the "props" field in the mutation.
+func (m *ScheduleMutation) Props() (r *types.ScheduleProps, exists bool) {
+	v := m.props
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetDownloads resets all changes to the "downloads" field.
-func (m *ShareMutation) ResetDownloads() {
-	m.downloads = nil
-	m.adddownloads = nil
+// OldProps returns the old "props" field's value of the Schedule entity.
+// If the Schedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleMutation) OldProps(ctx context.Context) (v *types.ScheduleProps, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProps is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProps requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProps: %w", err)
+	}
+	return oldValue.Props, nil
 }
 
-// SetExpires sets the "expires" field.
-func (m *ShareMutation) SetExpires(t time.Time) {
-	m.expires = &t
+// ResetProps resets all changes to the "props" field.
+func (m *ScheduleMutation) ResetProps() {
+	m.props = nil
 }
 
-// Expires returns the value of the "expires" field in the mutation.
-func (m *ShareMutation) Expires() (r time.Time, exists bool) {
-	v := m.expires
+// SetEnabled sets the "enabled" field.
+func (m *ScheduleMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *ScheduleMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExpires returns the old "expires" field's value of the Share entity.
-// If the Share object wasn't provided to the builder, the object is fetched from the database.
+// OldEnabled returns the old "enabled" field's value of the Schedule entity.
+// If the Schedule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ShareMutation) OldExpires(ctx context.Context) (v *time.Time, err error) {
+func (m *ScheduleMutation) OldEnabled(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpires is only allowed on UpdateOne operations")
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpires requires an ID field in the mutation")
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpires: %w", err)
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
 	}
-	return oldValue.Expires, nil
-}
-
-// ClearExpires clears the value of the "expires" field.
-func (m *ShareMutation) ClearExpires() {
-	m.expires = nil
-	m.clearedFields[share.FieldExpires] = struct{}{}
-}
-
-// ExpiresCleared returns if the "expires" field was cleared in this mutation.
-func (m *ShareMutation) ExpiresCleared() bool {
-	_, ok := m.clearedFields[share.FieldExpires]
-	return ok
+	return oldValue.Enabled, nil
 }
 
-// ResetExpires resets all changes to the "expires" field.
-func (m *ShareMutation) ResetExpires() {
-	m.expires = nil
-	delete(m.clearedFields, share.FieldExpires)
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *ScheduleMutation) ResetEnabled() {
+	m.enabled = nil
 }
 
-// SetRemainDownloads sets the "remain_downloads" field.
-func (m *ShareMutation) SetRemainDownloads(i int) {
-	m.remain_downloads = &i
-	m.addremain_downloads = nil
+// SetOwnerID sets the "owner_id" field.
+func (m *ScheduleMutation) SetOwnerID(i int) {
+	m.owner_id = &i
+	m.addowner_id = nil
 }
 
-// RemainDownloads returns the value of the "remain_downloads" field in the mutation.
-func (m *ShareMutation) RemainDownloads() (r int, exists bool) {
-	v := m.remain_downloads
+// OwnerID returns the value of the "owner_id" field in the mutation.
+func (m *ScheduleMutation) OwnerID() (r int, exists bool) {
+	v := m.owner_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRemainDownloads returns the old "remain_downloads" field's value of the Share entity.
-// If the Share object wasn't provided to the builder, the object is fetched from the database.
+// OldOwnerID returns the old "owner_id" field's value of the Schedule entity.
+// If the Schedule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ShareMutation) OldRemainDownloads(ctx context.Context) (v *int, err error) {
+func (m *ScheduleMutation) OldOwnerID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRemainDownloads is only allowed on UpdateOne operations")
+		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRemainDownloads requires an ID field in the mutation")
+		return v, errors.New("OldOwnerID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRemainDownloads: %w", err)
+		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
 	}
-	return oldValue.RemainDownloads, nil
+	return oldValue.OwnerID, nil
 }
 
-// AddRemainDownloads adds i to the "remain_downloads" field.
-func (m *ShareMutation) AddRemainDownloads(i int) {
-	if m.addremain_downloads != nil {
-		*m.addremain_downloads += i
+// AddOwnerID adds i to the "owner_id" field.
+func (m *ScheduleMutation) AddOwnerID(i int) {
+	if m.addowner_id != nil {
+		*m.addowner_id += i
 	} else {
-		m.addremain_downloads = &i
+		m.addowner_id = &i
 	}
 }
 
-// AddedRemainDownloads returns the value that was added to the "remain_downloads" field in this mutation.
-func (m *ShareMutation) AddedRemainDownloads() (r int, exists bool) {
-	v := m.addremain_downloads
+// AddedOwnerID returns the value that was added to the "owner_id" field in this mutation.
+func (m *ScheduleMutation) AddedOwnerID() (r int, exists bool) {
+	v := m.addowner_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearRemainDownloads clears the value of the "remain_downloads" field.
-func (m *ShareMutation) ClearRemainDownloads() {
-	m.remain_downloads = nil
-	m.addremain_downloads = nil
-	m.clearedFields[share.FieldRemainDownloads] = struct{}{}
-}
-
-// RemainDownloadsCleared returns if the "remain_downloads" field was cleared in this mutation.
-func (m *ShareMutation) RemainDownloadsCleared() bool {
-	_, ok := m.clearedFields[share.FieldRemainDownloads]
-	return ok
-}
-
-// ResetRemainDownloads resets all changes to the "remain_downloads" field.
-func (m *ShareMutation) ResetRemainDownloads() {
-	m.remain_downloads = nil
-	m.addremain_downloads = nil
-	delete(m.clearedFields, share.FieldRemainDownloads)
+// ResetOwnerID resets all changes to the "owner_id" field.
+func (m *ScheduleMutation) ResetOwnerID() {
+	m.owner_id = nil
+	m.addowner_id = nil
 }
 
-// SetProps sets the "props" field.
-func (m *ShareMutation) SetProps(tp *types.ShareProps) {
-	m.props = &tp
+// SetNextRunAt sets the "next_run_at" field.
+func (m *ScheduleMutation) SetNextRunAt(t time.Time) {
+	m.next_run_at = &t
 }
 
-// Props returns the value of the "props" field in the mutation.
-func (m *ShareMutation) Props() (r *types.ShareProps, exists bool) {
-	v := m.props
+// NextRunAt returns the value of the "next_run_at" field in the mutation.
+func (m *ScheduleMutation) NextRunAt() (r time.Time, exists bool) {
+	v := m.next_run_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProps returns the old "props" field's value of the Share entity.
-// If the Share object wasn't provided to the builder, the object is fetched from the database.
+// OldNextRunAt returns the old "next_run_at" field's value of the Schedule entity.
+// If the Schedule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ShareMutation) OldProps(ctx context.Context) (v *types.ShareProps, err error) {
+func (m *ScheduleMutation) OldNextRunAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProps is only allowed on UpdateOne operations")
+		return v, errors.New("OldNextRunAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProps requires an ID field in the mutation")
+		return v, errors.New("OldNextRunAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProps: %w", err)
+		return v, fmt.Errorf("querying old value for OldNextRunAt: %w", err)
 	}
-	return oldValue.Props, nil
+	return oldValue.NextRunAt, nil
 }
 
-// ClearProps clears the value of the "props" field.
-func (m *ShareMutation) ClearProps() {
-	m.props = nil
-	m.clearedFields[share.FieldProps] = struct{}{}
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (m *ScheduleMutation) ClearNextRunAt() {
+	m.next_run_at = nil
+	m.clearedFields[schedule.FieldNextRunAt] = struct{}{}
 }
 
-// PropsCleared returns if the "props" field was cleared in this mutation.
-func (m *ShareMutation) PropsCleared() bool {
-	_, ok := m.clearedFields[share.FieldProps]
+// NextRunAtCleared returns if the "next_run_at" field was cleared in this mutation.
+func (m *ScheduleMutation) NextRunAtCleared() bool {
+	_, ok := m.clearedFields[schedule.FieldNextRunAt]
 	return ok
 }
 
-// ResetProps resets all changes to the "props" field.
-func (m *ShareMutation) ResetProps() {
-	m.props = nil
-	delete(m.clearedFields, share.FieldProps)
-}
-
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *ShareMutation) SetUserID(id int) {
-	m.user = &id
-}
-
-// ClearUser clears the "user" edge to the User entity.
-func (m *ShareMutation) ClearUser() {
-	m.cleareduser = true
+// ResetNextRunAt resets all changes to the "next_run_at" field.
+func (m *ScheduleMutation) ResetNextRunAt() {
+	m.next_run_at = nil
+	delete(m.clearedFields, schedule.FieldNextRunAt)
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *ShareMutation) UserCleared() bool {
-	return m.cleareduser
+// SetLastRunAt sets the "last_run_at" field.
+func (m *ScheduleMutation) SetLastRunAt(t time.Time) {
+	m.last_run_at = &t
 }
 
-// UserID returns the "user" edge ID in the mutation.
-func (m *ShareMutation) UserID() (id int, exists bool) {
-	if m.user != nil {
-		return *m.user, true
+// LastRunAt returns the value of the "last_run_at" field in the mutation.
+func (m *ScheduleMutation) LastRunAt() (r time.Time, exists bool) {
+	v := m.last_run_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *ShareMutation) UserIDs() (ids []int) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// OldLastRunAt returns the old "last_run_at" field's value of the Schedule entity.
+// If the Schedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleMutation) OldLastRunAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastRunAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastRunAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastRunAt: %w", err)
+	}
+	return oldValue.LastRunAt, nil
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *ShareMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (m *ScheduleMutation) ClearLastRunAt() {
+	m.last_run_at = nil
+	m.clearedFields[schedule.FieldLastRunAt] = struct{}{}
 }
 
-// SetFileID sets the "file" edge to the File entity by id.
-func (m *ShareMutation) SetFileID(id int) {
-	m.file = &id
+// LastRunAtCleared returns if the "last_run_at" field was cleared in this mutation.
+func (m *ScheduleMutation) LastRunAtCleared() bool {
+	_, ok := m.clearedFields[schedule.FieldLastRunAt]
+	return ok
 }
 
-// ClearFile clears the "file" edge to the File entity.
-func (m *ShareMutation) ClearFile() {
-	m.clearedfile = true
+// ResetLastRunAt resets all changes to the "last_run_at" field.
+func (m *ScheduleMutation) ResetLastRunAt() {
+	m.last_run_at = nil
+	delete(m.clearedFields, schedule.FieldLastRunAt)
 }
 
-// FileCleared reports if the "file" edge to the File entity was cleared.
-func (m *ShareMutation) FileCleared() bool {
-	return m.clearedfile
+// Where appends a list predicates to the ScheduleMutation builder.
+func (m *ScheduleMutation) Where(ps ...predicate.Schedule) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// FileID returns the "file" edge ID in the mutation.
-func (m *ShareMutation) FileID() (id int, exists bool) {
-	if m.file != nil {
-		return *m.file, true
+// WhereP appends storage-level predicates to the ScheduleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ScheduleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Schedule, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return
-}
-
-// FileIDs returns the "file" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// FileID instead. It exists only for internal usage by the builders.
-func (m *ShareMutation) FileIDs() (ids []int) {
-	if id := m.file; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetFile resets all changes to the "file" edge.
-func (m *ShareMutation) ResetFile() {
-	m.file = nil
-	m.clearedfile = false
-}
-
-// Where appends a list predicates to the ShareMutation builder.
-func (m *ShareMutation) Where(ps ...predicate.Share) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the ShareMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ShareMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Share, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
+	m.Where(p...)
 }
 
 // Op returns the operation name.
-func (m *ShareMutation) Op() Op {
+func (m *ScheduleMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ShareMutation) SetOp(op Op) {
+func (m *ScheduleMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Share).
-func (m *ShareMutation) Type() string {
+// Type returns the node type of this mutation (Schedule).
+func (m *ScheduleMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ShareMutation) Fields() []string {
-	fields := make([]string, 0, 9)
+func (m *ScheduleMutation) Fields() []string {
+	fields := make([]string, 0, 11)
 	if m.created_at != nil {
-		fields = append(fields, share.FieldCreatedAt)
+		fields = append(fields, schedule.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, share.FieldUpdatedAt)
+		fields = append(fields, schedule.FieldUpdatedAt)
 	}
 	if m.deleted_at != nil {
-		fields = append(fields, share.FieldDeletedAt)
+		fields = append(fields, schedule.FieldDeletedAt)
 	}
-	if m.password != nil {
-		fields = append(fields, share.FieldPassword)
+	if m.name != nil {
+		fields = append(fields, schedule.FieldName)
 	}
-	if m.views != nil {
-		fields = append(fields, share.FieldViews)
+	if m._type != nil {
+		fields = append(fields, schedule.FieldType)
 	}
-	if m.downloads != nil {
-		fields = append(fields, share.FieldDownloads)
+	if m.spec != nil {
+		fields = append(fields, schedule.FieldSpec)
 	}
-	if m.expires != nil {
-		fields = append(fields, share.FieldExpires)
+	if m.props != nil {
+		fields = append(fields, schedule.FieldProps)
 	}
-	if m.remain_downloads != nil {
-		fields = append(fields, share.FieldRemainDownloads)
+	if m.enabled != nil {
+		fields = append(fields, schedule.FieldEnabled)
 	}
-	if m.props != nil {
-		fields = append(fields, share.FieldProps)
+	if m.owner_id != nil {
+		fields = append(fields, schedule.FieldOwnerID)
+	}
+	if m.next_run_at != nil {
+		fields = append(fields, schedule.FieldNextRunAt)
+	}
+	if m.last_run_at != nil {
+		fields = append(fields, schedule.FieldLastRunAt)
 	}
 	return fields
 }
@@ -12145,26 +12646,30 @@ func (m *ShareMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ShareMutation) Field(name string) (ent.Value, bool) {
+func (m *ScheduleMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case share.FieldCreatedAt:
+	case schedule.FieldCreatedAt:
 		return m.CreatedAt()
-	case share.FieldUpdatedAt:
+	case schedule.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case share.FieldDeletedAt:
+	case schedule.FieldDeletedAt:
 		return m.DeletedAt()
-	case share.FieldPassword:
-		return m.Password()
-	case share.FieldViews:
-		return m.Views()
-	case share.FieldDownloads:
-		return m.Downloads()
-	case share.FieldExpires:
-		return m.Expires()
-	case share.FieldRemainDownloads:
-		return m.RemainDownloads()
-	case share.FieldProps:
+	case schedule.FieldName:
+		return m.Name()
+	case schedule.FieldType:
+		return m.GetType()
+	case schedule.FieldSpec:
+		return m.Spec()
+	case schedule.FieldProps:
 		return m.Props()
+	case schedule.FieldEnabled:
+		return m.Enabled()
+	case schedule.FieldOwnerID:
+		return m.OwnerID()
+	case schedule.FieldNextRunAt:
+		return m.NextRunAt()
+	case schedule.FieldLastRunAt:
+		return m.LastRunAt()
 	}
 	return nil, false
 }
@@ -12172,114 +12677,126 @@ func (m *ShareMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ShareMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ScheduleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case share.FieldCreatedAt:
+	case schedule.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case share.FieldUpdatedAt:
+	case schedule.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case share.FieldDeletedAt:
+	case schedule.FieldDeletedAt:
 		return m.OldDeletedAt(ctx)
-	case share.FieldPassword:
-		return m.OldPassword(ctx)
-	case share.FieldViews:
-		return m.OldViews(ctx)
-	case share.FieldDownloads:
-		return m.OldDownloads(ctx)
-	case share.FieldExpires:
-		return m.OldExpires(ctx)
-	case share.FieldRemainDownloads:
-		return m.OldRemainDownloads(ctx)
-	case share.FieldProps:
+	case schedule.FieldName:
+		return m.OldName(ctx)
+	case schedule.FieldType:
+		return m.OldType(ctx)
+	case schedule.FieldSpec:
+		return m.OldSpec(ctx)
+	case schedule.FieldProps:
 		return m.OldProps(ctx)
+	case schedule.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case schedule.FieldOwnerID:
+		return m.OldOwnerID(ctx)
+	case schedule.FieldNextRunAt:
+		return m.OldNextRunAt(ctx)
+	case schedule.FieldLastRunAt:
+		return m.OldLastRunAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Share field %s", name)
+	return nil, fmt.Errorf("unknown Schedule field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ShareMutation) SetField(name string, value ent.Value) error {
+func (m *ScheduleMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case share.FieldCreatedAt:
+	case schedule.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case share.FieldUpdatedAt:
+	case schedule.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case share.FieldDeletedAt:
+	case schedule.FieldDeletedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDeletedAt(v)
 		return nil
-	case share.FieldPassword:
+	case schedule.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPassword(v)
+		m.SetName(v)
 		return nil
-	case share.FieldViews:
-		v, ok := value.(int)
+	case schedule.FieldType:
+		v, ok := value.(schedule.Type)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetViews(v)
+		m.SetType(v)
 		return nil
-	case share.FieldDownloads:
-		v, ok := value.(int)
+	case schedule.FieldSpec:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDownloads(v)
+		m.SetSpec(v)
 		return nil
-	case share.FieldExpires:
-		v, ok := value.(time.Time)
+	case schedule.FieldProps:
+		v, ok := value.(*types.ScheduleProps)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExpires(v)
+		m.SetProps(v)
 		return nil
-	case share.FieldRemainDownloads:
+	case schedule.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case schedule.FieldOwnerID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRemainDownloads(v)
+		m.SetOwnerID(v)
 		return nil
-	case share.FieldProps:
-		v, ok := value.(*types.ShareProps)
+	case schedule.FieldNextRunAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProps(v)
+		m.SetNextRunAt(v)
+		return nil
+	case schedule.FieldLastRunAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastRunAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Share field %s", name)
+	return fmt.Errorf("unknown Schedule field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ShareMutation) AddedFields() []string {
+func (m *ScheduleMutation) AddedFields() []string {
 	var fields []string
-	if m.addviews != nil {
-		fields = append(fields, share.FieldViews)
-	}
-	if m.adddownloads != nil {
-		fields = append(fields, share.FieldDownloads)
-	}
-	if m.addremain_downloads != nil {
-		fields = append(fields, share.FieldRemainDownloads)
+	if m.addowner_id != nil {
+		fields = append(fields, schedule.FieldOwnerID)
 	}
 	return fields
 }
@@ -12287,14 +12804,10 @@ func (m *ShareMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ShareMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ScheduleMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case share.FieldViews:
-		return m.AddedViews()
-	case share.FieldDownloads:
-		return m.AddedDownloads()
-	case share.FieldRemainDownloads:
-		return m.AddedRemainDownloads()
+	case schedule.FieldOwnerID:
+		return m.AddedOwnerID()
 	}
 	return nil, false
 }
@@ -12302,261 +12815,179 @@ func (m *ShareMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ShareMutation) AddField(name string, value ent.Value) error {
+func (m *ScheduleMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case share.FieldViews:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddViews(v)
-		return nil
-	case share.FieldDownloads:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddDownloads(v)
-		return nil
-	case share.FieldRemainDownloads:
+	case schedule.FieldOwnerID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddRemainDownloads(v)
+		m.AddOwnerID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Share numeric field %s", name)
+	return fmt.Errorf("unknown Schedule numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ShareMutation) ClearedFields() []string {
+func (m *ScheduleMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(share.FieldDeletedAt) {
-		fields = append(fields, share.FieldDeletedAt)
+	if m.FieldCleared(schedule.FieldDeletedAt) {
+		fields = append(fields, schedule.FieldDeletedAt)
 	}
-	if m.FieldCleared(share.FieldPassword) {
-		fields = append(fields, share.FieldPassword)
-	}
-	if m.FieldCleared(share.FieldExpires) {
-		fields = append(fields, share.FieldExpires)
-	}
-	if m.FieldCleared(share.FieldRemainDownloads) {
-		fields = append(fields, share.FieldRemainDownloads)
+	if m.FieldCleared(schedule.FieldNextRunAt) {
+		fields = append(fields, schedule.FieldNextRunAt)
 	}
-	if m.FieldCleared(share.FieldProps) {
-		fields = append(fields, share.FieldProps)
+	if m.FieldCleared(schedule.FieldLastRunAt) {
+		fields = append(fields, schedule.FieldLastRunAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ShareMutation) FieldCleared(name string) bool {
+func (m *ScheduleMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ShareMutation) ClearField(name string) error {
+func (m *ScheduleMutation) ClearField(name string) error {
 	switch name {
-	case share.FieldDeletedAt:
+	case schedule.FieldDeletedAt:
 		m.ClearDeletedAt()
 		return nil
-	case share.FieldPassword:
-		m.ClearPassword()
-		return nil
-	case share.FieldExpires:
-		m.ClearExpires()
-		return nil
-	case share.FieldRemainDownloads:
-		m.ClearRemainDownloads()
+	case schedule.FieldNextRunAt:
+		m.ClearNextRunAt()
 		return nil
-	case share.FieldProps:
-		m.ClearProps()
+	case schedule.FieldLastRunAt:
+		m.ClearLastRunAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Share nullable field %s", name)
+	return fmt.Errorf("unknown Schedule nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ShareMutation) ResetField(name string) error {
+func (m *ScheduleMutation) ResetField(name string) error {
 	switch name {
-	case share.FieldCreatedAt:
+	case schedule.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case share.FieldUpdatedAt:
+	case schedule.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case share.FieldDeletedAt:
+	case schedule.FieldDeletedAt:
 		m.ResetDeletedAt()
 		return nil
-	case share.FieldPassword:
-		m.ResetPassword()
+	case schedule.FieldName:
+		m.ResetName()
 		return nil
-	case share.FieldViews:
-		m.ResetViews()
+	case schedule.FieldType:
+		m.ResetType()
 		return nil
-	case share.FieldDownloads:
-		m.ResetDownloads()
+	case schedule.FieldSpec:
+		m.ResetSpec()
 		return nil
-	case share.FieldExpires:
-		m.ResetExpires()
+	case schedule.FieldProps:
+		m.ResetProps()
 		return nil
-	case share.FieldRemainDownloads:
-		m.ResetRemainDownloads()
+	case schedule.FieldEnabled:
+		m.ResetEnabled()
 		return nil
-	case share.FieldProps:
-		m.ResetProps()
+	case schedule.FieldOwnerID:
+		m.ResetOwnerID()
+		return nil
+	case schedule.FieldNextRunAt:
+		m.ResetNextRunAt()
+		return nil
+	case schedule.FieldLastRunAt:
+		m.ResetLastRunAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Share field %s", name)
+	return fmt.Errorf("unknown Schedule field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ShareMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.user != nil {
-		edges = append(edges, share.EdgeUser)
-	}
-	if m.file != nil {
-		edges = append(edges, share.EdgeFile)
-	}
+func (m *ScheduleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ShareMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case share.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
-	case share.EdgeFile:
-		if id := m.file; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *ScheduleMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ShareMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *ScheduleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ShareMutation) RemovedIDs(name string) []ent.Value {
+func (m *ScheduleMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ShareMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.cleareduser {
-		edges = append(edges, share.EdgeUser)
-	}
-	if m.clearedfile {
-		edges = append(edges, share.EdgeFile)
-	}
+func (m *ScheduleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ShareMutation) EdgeCleared(name string) bool {
-	switch name {
-	case share.EdgeUser:
-		return m.cleareduser
-	case share.EdgeFile:
-		return m.clearedfile
-	}
+func (m *ScheduleMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ShareMutation) ClearEdge(name string) error {
-	switch name {
-	case share.EdgeUser:
-		m.ClearUser()
-		return nil
-	case share.EdgeFile:
-		m.ClearFile()
-		return nil
-	}
-	return fmt.Errorf("unknown Share unique edge %s", name)
+func (m *ScheduleMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Schedule unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ShareMutation) ResetEdge(name string) error {
-	switch name {
-	case share.EdgeUser:
-		m.ResetUser()
-		return nil
-	case share.EdgeFile:
-		m.ResetFile()
-		return nil
-	}
-	return fmt.Errorf("unknown Share edge %s", name)
+func (m *ScheduleMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Schedule edge %s", name)
 }
 
-// StoragePolicyMutation represents an operation that mutates the StoragePolicy nodes in the graph.
-type StoragePolicyMutation struct {
+// ScheduleRunMutation represents an operation that mutates the ScheduleRun nodes in the graph.
+type ScheduleRunMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *int
-	created_at      *time.Time
-	updated_at      *time.Time
-	deleted_at      *time.Time
-	name            *string
-	_type           *string
-	server          *string
-	bucket_name     *string
-	is_private      *bool
-	access_key      *string
-	secret_key      *string
-	max_size        *int64
-	addmax_size     *int64
-	dir_name_rule   *string
-	file_name_rule  *string
-	settings        **types.PolicySetting
-	clearedFields   map[string]struct{}
-	groups          map[int]struct{}
-	removedgroups   map[int]struct{}
-	clearedgroups   bool
-	files           map[int]struct{}
-	removedfiles    map[int]struct{}
-	clearedfiles    bool
-	entities        map[int]struct{}
-	removedentities map[int]struct{}
-	clearedentities bool
-	node            *int
-	clearednode     bool
-	done            bool
-	oldValue        func(context.Context) (*StoragePolicy, error)
-	predicates      []predicate.StoragePolicy
+	op             Op
+	typ            string
+	id             *int
+	created_at     *time.Time
+	updated_at     *time.Time
+	deleted_at     *time.Time
+	schedule_id    *int
+	addschedule_id *int
+	task_id        *int
+	addtask_id     *int
+	error          *string
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*ScheduleRun, error)
+	predicates     []predicate.ScheduleRun
 }
 
-var _ ent.Mutation = (*StoragePolicyMutation)(nil)
+var _ ent.Mutation = (*ScheduleRunMutation)(nil)
 
-// storagepolicyOption allows management of the mutation configuration using functional options.
-type storagepolicyOption func(*StoragePolicyMutation)
+// schedulerunOption allows management of the mutation configuration using functional options.
+type schedulerunOption func(*ScheduleRunMutation)
 
-// newStoragePolicyMutation creates new mutation for the StoragePolicy entity.
-func newStoragePolicyMutation(c config, op Op, opts ...storagepolicyOption) *StoragePolicyMutation {
-	m := &StoragePolicyMutation{
+// newScheduleRunMutation creates new mutation for the ScheduleRun entity.
+func newScheduleRunMutation(c config, op Op, opts ...schedulerunOption) *ScheduleRunMutation {
+	m := &ScheduleRunMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeStoragePolicy,
+		typ:           TypeScheduleRun,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -12565,20 +12996,20 @@ func newStoragePolicyMutation(c config, op Op, opts ...storagepolicyOption) *Sto
 	return m
 }
 
-// withStoragePolicyID sets the ID field of the mutation.
-func withStoragePolicyID(id int) storagepolicyOption {
-	return func(m *StoragePolicyMutation) {
+// withScheduleRunID sets the ID field of the mutation.
+func withScheduleRunID(id int) schedulerunOption {
+	return func(m *ScheduleRunMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *StoragePolicy
+			value *ScheduleRun
 		)
-		m.oldValue = func(ctx context.Context) (*StoragePolicy, error) {
+		m.oldValue = func(ctx context.Context) (*ScheduleRun, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().StoragePolicy.Get(ctx, id)
+					value, err = m.Client().ScheduleRun.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -12587,10 +13018,10 @@ func withStoragePolicyID(id int) storagepolicyOption {
 	}
 }
 
-// withStoragePolicy sets the old StoragePolicy of the mutation.
-func withStoragePolicy(node *StoragePolicy) storagepolicyOption {
-	return func(m *StoragePolicyMutation) {
-		m.oldValue = func(context.Context) (*StoragePolicy, error) {
+// withScheduleRun sets the old ScheduleRun of the mutation.
+func withScheduleRun(node *ScheduleRun) schedulerunOption {
+	return func(m *ScheduleRunMutation) {
+		m.oldValue = func(context.Context) (*ScheduleRun, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -12599,7 +13030,7 @@ func withStoragePolicy(node *StoragePolicy) storagepolicyOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m StoragePolicyMutation) Client() *Client {
+func (m ScheduleRunMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -12607,7 +13038,7 @@ func (m StoragePolicyMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m StoragePolicyMutation) Tx() (*Tx, error) {
+func (m ScheduleRunMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -12618,7 +13049,7 @@ func (m StoragePolicyMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *StoragePolicyMutation) ID() (id int, exists bool) {
+func (m *ScheduleRunMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -12629,7 +13060,7 @@ func (m *StoragePolicyMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *StoragePolicyMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ScheduleRunMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -12638,19 +13069,19 @@ func (m *StoragePolicyMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().StoragePolicy.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ScheduleRun.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *StoragePolicyMutation) SetCreatedAt(t time.Time) {
+func (m *ScheduleRunMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *StoragePolicyMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *ScheduleRunMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -12658,10 +13089,10 @@ func (m *StoragePolicyMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the StoragePolicy entity.
-// If the StoragePolicy object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the ScheduleRun entity.
+// If the ScheduleRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StoragePolicyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ScheduleRunMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -12676,17 +13107,17 @@ func (m *StoragePolicyMutation) OldCreatedAt(ctx context.Context) (v time.Time,
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *StoragePolicyMutation) ResetCreatedAt() {
+func (m *ScheduleRunMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *StoragePolicyMutation) SetUpdatedAt(t time.Time) {
+func (m *ScheduleRunMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *StoragePolicyMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *ScheduleRunMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -12694,10 +13125,10 @@ func (m *StoragePolicyMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the StoragePolicy entity.
-// If the StoragePolicy object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the ScheduleRun entity.
+// If the ScheduleRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StoragePolicyMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ScheduleRunMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -12712,17 +13143,17 @@ func (m *StoragePolicyMutation) OldUpdatedAt(ctx context.Context) (v time.Time,
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *StoragePolicyMutation) ResetUpdatedAt() {
+func (m *ScheduleRunMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
 // SetDeletedAt sets the "deleted_at" field.
-func (m *StoragePolicyMutation) SetDeletedAt(t time.Time) {
+func (m *ScheduleRunMutation) SetDeletedAt(t time.Time) {
 	m.deleted_at = &t
 }
 
 // DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *StoragePolicyMutation) DeletedAt() (r time.Time, exists bool) {
+func (m *ScheduleRunMutation) DeletedAt() (r time.Time, exists bool) {
 	v := m.deleted_at
 	if v == nil {
 		return
@@ -12730,10 +13161,10 @@ func (m *StoragePolicyMutation) DeletedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the StoragePolicy entity.
-// If the StoragePolicy object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedAt returns the old "deleted_at" field's value of the ScheduleRun entity.
+// If the ScheduleRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StoragePolicyMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *ScheduleRunMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
@@ -12748,874 +13179,880 @@ func (m *StoragePolicyMutation) OldDeletedAt(ctx context.Context) (v *time.Time,
 }
 
 // ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *StoragePolicyMutation) ClearDeletedAt() {
+func (m *ScheduleRunMutation) ClearDeletedAt() {
 	m.deleted_at = nil
-	m.clearedFields[storagepolicy.FieldDeletedAt] = struct{}{}
+	m.clearedFields[schedulerun.FieldDeletedAt] = struct{}{}
 }
 
 // DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *StoragePolicyMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[storagepolicy.FieldDeletedAt]
+func (m *ScheduleRunMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[schedulerun.FieldDeletedAt]
 	return ok
 }
 
 // ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *StoragePolicyMutation) ResetDeletedAt() {
+func (m *ScheduleRunMutation) ResetDeletedAt() {
 	m.deleted_at = nil
-	delete(m.clearedFields, storagepolicy.FieldDeletedAt)
+	delete(m.clearedFields, schedulerun.FieldDeletedAt)
 }
 
-// SetName sets the "name" field.
-func (m *StoragePolicyMutation) SetName(s string) {
-	m.name = &s
+// SetScheduleID sets the "schedule_id" field.
+func (m *ScheduleRunMutation) SetScheduleID(i int) {
+	m.schedule_id = &i
+	m.addschedule_id = nil
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *StoragePolicyMutation) Name() (r string, exists bool) {
-	v := m.name
+// ScheduleID returns the value of the "schedule_id" field in the mutation.
+func (m *ScheduleRunMutation) ScheduleID() (r int, exists bool) {
+	v := m.schedule_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the StoragePolicy entity.
-// If the StoragePolicy object wasn't provided to the builder, the object is fetched from the database.
+// OldScheduleID returns the old "schedule_id" field's value of the ScheduleRun entity.
+// If the ScheduleRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StoragePolicyMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *ScheduleRunMutation) OldScheduleID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldScheduleID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldScheduleID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldScheduleID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.ScheduleID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *StoragePolicyMutation) ResetName() {
-	m.name = nil
-}
-
-// SetType sets the "type" field.
-func (m *StoragePolicyMutation) SetType(s string) {
-	m._type = &s
+// AddScheduleID adds i to the "schedule_id" field.
+func (m *ScheduleRunMutation) AddScheduleID(i int) {
+	if m.addschedule_id != nil {
+		*m.addschedule_id += i
+	} else {
+		m.addschedule_id = &i
+	}
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *StoragePolicyMutation) GetType() (r string, exists bool) {
-	v := m._type
+// AddedScheduleID returns the value that was added to the "schedule_id" field in this mutation.
+func (m *ScheduleRunMutation) AddedScheduleID() (r int, exists bool) {
+	v := m.addschedule_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the StoragePolicy entity.
-// If the StoragePolicy object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StoragePolicyMutation) OldType(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
-	}
-	return oldValue.Type, nil
-}
-
-// ResetType resets all changes to the "type" field.
-func (m *StoragePolicyMutation) ResetType() {
-	m._type = nil
+// ResetScheduleID resets all changes to the "schedule_id" field.
+func (m *ScheduleRunMutation) ResetScheduleID() {
+	m.schedule_id = nil
+	m.addschedule_id = nil
 }
 
-// SetServer sets the "server" field.
-func (m *StoragePolicyMutation) SetServer(s string) {
-	m.server = &s
+// SetTaskID sets the "task_id" field.
+func (m *ScheduleRunMutation) SetTaskID(i int) {
+	m.task_id = &i
+	m.addtask_id = nil
 }
 
-// Server returns the value of the "server" field in the mutation.
-func (m *StoragePolicyMutation) Server() (r string, exists bool) {
-	v := m.server
+// TaskID returns the value of the "task_id" field in the mutation.
+func (m *ScheduleRunMutation) TaskID() (r int, exists bool) {
+	v := m.task_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldServer returns the old "server" field's value of the StoragePolicy entity.
-// If the StoragePolicy object wasn't provided to the builder, the object is fetched from the database.
+// OldTaskID returns the old "task_id" field's value of the ScheduleRun entity.
+// If the ScheduleRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StoragePolicyMutation) OldServer(ctx context.Context) (v string, err error) {
+func (m *ScheduleRunMutation) OldTaskID(ctx context.Context) (v *int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldServer is only allowed on UpdateOne operations")
+		return v, errors.New("OldTaskID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldServer requires an ID field in the mutation")
+		return v, errors.New("OldTaskID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldServer: %w", err)
+		return v, fmt.Errorf("querying old value for OldTaskID: %w", err)
 	}
-	return oldValue.Server, nil
-}
-
-// ClearServer clears the value of the "server" field.
-func (m *StoragePolicyMutation) ClearServer() {
-	m.server = nil
-	m.clearedFields[storagepolicy.FieldServer] = struct{}{}
-}
-
-// ServerCleared returns if the "server" field was cleared in this mutation.
-func (m *StoragePolicyMutation) ServerCleared() bool {
-	_, ok := m.clearedFields[storagepolicy.FieldServer]
-	return ok
-}
-
-// ResetServer resets all changes to the "server" field.
-func (m *StoragePolicyMutation) ResetServer() {
-	m.server = nil
-	delete(m.clearedFields, storagepolicy.FieldServer)
+	return oldValue.TaskID, nil
 }
 
-// SetBucketName sets the "bucket_name" field.
-func (m *StoragePolicyMutation) SetBucketName(s string) {
-	m.bucket_name = &s
+// AddTaskID adds i to the "task_id" field.
+func (m *ScheduleRunMutation) AddTaskID(i int) {
+	if m.addtask_id != nil {
+		*m.addtask_id += i
+	} else {
+		m.addtask_id = &i
+	}
 }
 
-// BucketName returns the value of the "bucket_name" field in the mutation.
-func (m *StoragePolicyMutation) BucketName() (r string, exists bool) {
-	v := m.bucket_name
+// AddedTaskID returns the value that was added to the "task_id" field in this mutation.
+func (m *ScheduleRunMutation) AddedTaskID() (r int, exists bool) {
+	v := m.addtask_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBucketName returns the old "bucket_name" field's value of the StoragePolicy entity.
-// If the StoragePolicy object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StoragePolicyMutation) OldBucketName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBucketName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBucketName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBucketName: %w", err)
-	}
-	return oldValue.BucketName, nil
-}
-
-// ClearBucketName clears the value of the "bucket_name" field.
-func (m *StoragePolicyMutation) ClearBucketName() {
-	m.bucket_name = nil
-	m.clearedFields[storagepolicy.FieldBucketName] = struct{}{}
+// ClearTaskID clears the value of the "task_id" field.
+func (m *ScheduleRunMutation) ClearTaskID() {
+	m.task_id = nil
+	m.addtask_id = nil
+	m.clearedFields[schedulerun.FieldTaskID] = struct{}{}
 }
 
-// BucketNameCleared returns if the "bucket_name" field was cleared in this mutation.
-func (m *StoragePolicyMutation) BucketNameCleared() bool {
-	_, ok := m.clearedFields[storagepolicy.FieldBucketName]
+// TaskIDCleared returns if the "task_id" field was cleared in this mutation.
+func (m *ScheduleRunMutation) TaskIDCleared() bool {
+	_, ok := m.clearedFields[schedulerun.FieldTaskID]
 	return ok
 }
 
-// ResetBucketName resets all changes to the "bucket_name" field.
-func (m *StoragePolicyMutation) ResetBucketName() {
-	m.bucket_name = nil
-	delete(m.clearedFields, storagepolicy.FieldBucketName)
+// ResetTaskID resets all changes to the "task_id" field.
+func (m *ScheduleRunMutation) ResetTaskID() {
+	m.task_id = nil
+	m.addtask_id = nil
+	delete(m.clearedFields, schedulerun.FieldTaskID)
 }
 
-// SetIsPrivate sets the "is_private" field.
-func (m *StoragePolicyMutation) SetIsPrivate(b bool) {
-	m.is_private = &b
+// SetError sets the "error" field.
+func (m *ScheduleRunMutation) SetError(s string) {
+	m.error = &s
 }
 
-// IsPrivate returns the value of the "is_private" field in the mutation.
-func (m *StoragePolicyMutation) IsPrivate() (r bool, exists bool) {
-	v := m.is_private
+// Error returns the value of the "error" field in the mutation.
+func (m *ScheduleRunMutation) Error() (r string, exists bool) {
+	v := m.error
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsPrivate returns the old "is_private" field's value of the StoragePolicy entity.
-// If the StoragePolicy object wasn't provided to the builder, the object is fetched from the database.
+// OldError returns the old "error" field's value of the ScheduleRun entity.
+// If the ScheduleRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StoragePolicyMutation) OldIsPrivate(ctx context.Context) (v bool, err error) {
+func (m *ScheduleRunMutation) OldError(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsPrivate is only allowed on UpdateOne operations")
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsPrivate requires an ID field in the mutation")
+		return v, errors.New("OldError requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsPrivate: %w", err)
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
 	}
-	return oldValue.IsPrivate, nil
+	return oldValue.Error, nil
 }
 
-// ClearIsPrivate clears the value of the "is_private" field.
-func (m *StoragePolicyMutation) ClearIsPrivate() {
-	m.is_private = nil
-	m.clearedFields[storagepolicy.FieldIsPrivate] = struct{}{}
+// ClearError clears the value of the "error" field.
+func (m *ScheduleRunMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[schedulerun.FieldError] = struct{}{}
 }
 
-// IsPrivateCleared returns if the "is_private" field was cleared in this mutation.
-func (m *StoragePolicyMutation) IsPrivateCleared() bool {
-	_, ok := m.clearedFields[storagepolicy.FieldIsPrivate]
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *ScheduleRunMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[schedulerun.FieldError]
 	return ok
 }
 
-// ResetIsPrivate resets all changes to the "is_private" field.
-func (m *StoragePolicyMutation) ResetIsPrivate() {
-	m.is_private = nil
-	delete(m.clearedFields, storagepolicy.FieldIsPrivate)
+// ResetError resets all changes to the "error" field.
+func (m *ScheduleRunMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, schedulerun.FieldError)
 }
 
-// SetAccessKey sets the "access_key" field.
-func (m *StoragePolicyMutation) SetAccessKey(s string) {
-	m.access_key = &s
+// Where appends a list predicates to the ScheduleRunMutation builder.
+func (m *ScheduleRunMutation) Where(ps ...predicate.ScheduleRun) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// AccessKey returns the value of the "access_key" field in the mutation.
-func (m *StoragePolicyMutation) AccessKey() (r string, exists bool) {
-	v := m.access_key
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the ScheduleRunMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ScheduleRunMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ScheduleRun, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
-}
-
-// OldAccessKey returns the old "access_key" field's value of the StoragePolicy entity.
-// If the StoragePolicy object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StoragePolicyMutation) OldAccessKey(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAccessKey is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAccessKey requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAccessKey: %w", err)
-	}
-	return oldValue.AccessKey, nil
-}
-
-// ClearAccessKey clears the value of the "access_key" field.
-func (m *StoragePolicyMutation) ClearAccessKey() {
-	m.access_key = nil
-	m.clearedFields[storagepolicy.FieldAccessKey] = struct{}{}
+	m.Where(p...)
 }
 
-// AccessKeyCleared returns if the "access_key" field was cleared in this mutation.
-func (m *StoragePolicyMutation) AccessKeyCleared() bool {
-	_, ok := m.clearedFields[storagepolicy.FieldAccessKey]
-	return ok
+// Op returns the operation name.
+func (m *ScheduleRunMutation) Op() Op {
+	return m.op
 }
 
-// ResetAccessKey resets all changes to the "access_key" field.
-func (m *StoragePolicyMutation) ResetAccessKey() {
-	m.access_key = nil
-	delete(m.clearedFields, storagepolicy.FieldAccessKey)
+// SetOp allows setting the mutation operation.
+func (m *ScheduleRunMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SetSecretKey sets the "secret_key" field.
-func (m *StoragePolicyMutation) SetSecretKey(s string) {
-	m.secret_key = &s
+// Type returns the node type of this mutation (ScheduleRun).
+func (m *ScheduleRunMutation) Type() string {
+	return m.typ
 }
 
-// SecretKey returns the value of the "secret_key" field in the mutation.
-func (m *StoragePolicyMutation) SecretKey() (r string, exists bool) {
-	v := m.secret_key
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ScheduleRunMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.created_at != nil {
+		fields = append(fields, schedulerun.FieldCreatedAt)
 	}
-	return *v, true
-}
-
-// OldSecretKey returns the old "secret_key" field's value of the StoragePolicy entity.
-// If the StoragePolicy object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StoragePolicyMutation) OldSecretKey(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSecretKey is only allowed on UpdateOne operations")
+	if m.updated_at != nil {
+		fields = append(fields, schedulerun.FieldUpdatedAt)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSecretKey requires an ID field in the mutation")
+	if m.deleted_at != nil {
+		fields = append(fields, schedulerun.FieldDeletedAt)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSecretKey: %w", err)
+	if m.schedule_id != nil {
+		fields = append(fields, schedulerun.FieldScheduleID)
 	}
-	return oldValue.SecretKey, nil
+	if m.task_id != nil {
+		fields = append(fields, schedulerun.FieldTaskID)
+	}
+	if m.error != nil {
+		fields = append(fields, schedulerun.FieldError)
+	}
+	return fields
 }
 
-// ClearSecretKey clears the value of the "secret_key" field.
-func (m *StoragePolicyMutation) ClearSecretKey() {
-	m.secret_key = nil
-	m.clearedFields[storagepolicy.FieldSecretKey] = struct{}{}
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ScheduleRunMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case schedulerun.FieldCreatedAt:
+		return m.CreatedAt()
+	case schedulerun.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case schedulerun.FieldDeletedAt:
+		return m.DeletedAt()
+	case schedulerun.FieldScheduleID:
+		return m.ScheduleID()
+	case schedulerun.FieldTaskID:
+		return m.TaskID()
+	case schedulerun.FieldError:
+		return m.Error()
+	}
+	return nil, false
 }
 
-// SecretKeyCleared returns if the "secret_key" field was cleared in this mutation.
-func (m *StoragePolicyMutation) SecretKeyCleared() bool {
-	_, ok := m.clearedFields[storagepolicy.FieldSecretKey]
-	return ok
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ScheduleRunMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case schedulerun.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case schedulerun.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case schedulerun.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case schedulerun.FieldScheduleID:
+		return m.OldScheduleID(ctx)
+	case schedulerun.FieldTaskID:
+		return m.OldTaskID(ctx)
+	case schedulerun.FieldError:
+		return m.OldError(ctx)
+	}
+	return nil, fmt.Errorf("unknown ScheduleRun field %s", name)
 }
 
-// ResetSecretKey resets all changes to the "secret_key" field.
-func (m *StoragePolicyMutation) ResetSecretKey() {
-	m.secret_key = nil
-	delete(m.clearedFields, storagepolicy.FieldSecretKey)
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ScheduleRunMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case schedulerun.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case schedulerun.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case schedulerun.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case schedulerun.FieldScheduleID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScheduleID(v)
+		return nil
+	case schedulerun.FieldTaskID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTaskID(v)
+		return nil
+	case schedulerun.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ScheduleRun field %s", name)
 }
 
-// SetMaxSize sets the "max_size" field.
-func (m *StoragePolicyMutation) SetMaxSize(i int64) {
-	m.max_size = &i
-	m.addmax_size = nil
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ScheduleRunMutation) AddedFields() []string {
+	var fields []string
+	if m.addschedule_id != nil {
+		fields = append(fields, schedulerun.FieldScheduleID)
+	}
+	if m.addtask_id != nil {
+		fields = append(fields, schedulerun.FieldTaskID)
+	}
+	return fields
 }
 
-// MaxSize returns the value of the "max_size" field in the mutation.
-func (m *StoragePolicyMutation) MaxSize() (r int64, exists bool) {
-	v := m.max_size
-	if v == nil {
-		return
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ScheduleRunMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case schedulerun.FieldScheduleID:
+		return m.AddedScheduleID()
+	case schedulerun.FieldTaskID:
+		return m.AddedTaskID()
 	}
-	return *v, true
+	return nil, false
 }
 
-// OldMaxSize returns the old "max_size" field's value of the StoragePolicy entity.
-// If the StoragePolicy object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StoragePolicyMutation) OldMaxSize(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMaxSize is only allowed on UpdateOne operations")
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ScheduleRunMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case schedulerun.FieldScheduleID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddScheduleID(v)
+		return nil
+	case schedulerun.FieldTaskID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTaskID(v)
+		return nil
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMaxSize requires an ID field in the mutation")
+	return fmt.Errorf("unknown ScheduleRun numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ScheduleRunMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(schedulerun.FieldDeletedAt) {
+		fields = append(fields, schedulerun.FieldDeletedAt)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMaxSize: %w", err)
+	if m.FieldCleared(schedulerun.FieldTaskID) {
+		fields = append(fields, schedulerun.FieldTaskID)
 	}
-	return oldValue.MaxSize, nil
+	if m.FieldCleared(schedulerun.FieldError) {
+		fields = append(fields, schedulerun.FieldError)
+	}
+	return fields
 }
 
-// AddMaxSize adds i to the "max_size" field.
-func (m *StoragePolicyMutation) AddMaxSize(i int64) {
-	if m.addmax_size != nil {
-		*m.addmax_size += i
-	} else {
-		m.addmax_size = &i
-	}
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ScheduleRunMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// AddedMaxSize returns the value that was added to the "max_size" field in this mutation.
-func (m *StoragePolicyMutation) AddedMaxSize() (r int64, exists bool) {
-	v := m.addmax_size
-	if v == nil {
-		return
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ScheduleRunMutation) ClearField(name string) error {
+	switch name {
+	case schedulerun.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case schedulerun.FieldTaskID:
+		m.ClearTaskID()
+		return nil
+	case schedulerun.FieldError:
+		m.ClearError()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown ScheduleRun nullable field %s", name)
 }
 
-// ClearMaxSize clears the value of the "max_size" field.
-func (m *StoragePolicyMutation) ClearMaxSize() {
-	m.max_size = nil
-	m.addmax_size = nil
-	m.clearedFields[storagepolicy.FieldMaxSize] = struct{}{}
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ScheduleRunMutation) ResetField(name string) error {
+	switch name {
+	case schedulerun.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case schedulerun.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case schedulerun.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case schedulerun.FieldScheduleID:
+		m.ResetScheduleID()
+		return nil
+	case schedulerun.FieldTaskID:
+		m.ResetTaskID()
+		return nil
+	case schedulerun.FieldError:
+		m.ResetError()
+		return nil
+	}
+	return fmt.Errorf("unknown ScheduleRun field %s", name)
 }
 
-// MaxSizeCleared returns if the "max_size" field was cleared in this mutation.
-func (m *StoragePolicyMutation) MaxSizeCleared() bool {
-	_, ok := m.clearedFields[storagepolicy.FieldMaxSize]
-	return ok
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ScheduleRunMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ResetMaxSize resets all changes to the "max_size" field.
-func (m *StoragePolicyMutation) ResetMaxSize() {
-	m.max_size = nil
-	m.addmax_size = nil
-	delete(m.clearedFields, storagepolicy.FieldMaxSize)
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ScheduleRunMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// SetDirNameRule sets the "dir_name_rule" field.
-func (m *StoragePolicyMutation) SetDirNameRule(s string) {
-	m.dir_name_rule = &s
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ScheduleRunMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// DirNameRule returns the value of the "dir_name_rule" field in the mutation.
-func (m *StoragePolicyMutation) DirNameRule() (r string, exists bool) {
-	v := m.dir_name_rule
-	if v == nil {
-		return
-	}
-	return *v, true
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ScheduleRunMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// OldDirNameRule returns the old "dir_name_rule" field's value of the StoragePolicy entity.
-// If the StoragePolicy object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StoragePolicyMutation) OldDirNameRule(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDirNameRule is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDirNameRule requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDirNameRule: %w", err)
-	}
-	return oldValue.DirNameRule, nil
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ScheduleRunMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ClearDirNameRule clears the value of the "dir_name_rule" field.
-func (m *StoragePolicyMutation) ClearDirNameRule() {
-	m.dir_name_rule = nil
-	m.clearedFields[storagepolicy.FieldDirNameRule] = struct{}{}
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ScheduleRunMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// DirNameRuleCleared returns if the "dir_name_rule" field was cleared in this mutation.
-func (m *StoragePolicyMutation) DirNameRuleCleared() bool {
-	_, ok := m.clearedFields[storagepolicy.FieldDirNameRule]
-	return ok
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ScheduleRunMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ScheduleRun unique edge %s", name)
 }
 
-// ResetDirNameRule resets all changes to the "dir_name_rule" field.
-func (m *StoragePolicyMutation) ResetDirNameRule() {
-	m.dir_name_rule = nil
-	delete(m.clearedFields, storagepolicy.FieldDirNameRule)
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ScheduleRunMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ScheduleRun edge %s", name)
 }
 
-// SetFileNameRule sets the "file_name_rule" field.
-func (m *StoragePolicyMutation) SetFileNameRule(s string) {
-	m.file_name_rule = &s
+// SettingMutation represents an operation that mutates the Setting nodes in the graph.
+type SettingMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	created_at    *time.Time
+	updated_at    *time.Time
+	deleted_at    *time.Time
+	name          *string
+	value         *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Setting, error)
+	predicates    []predicate.Setting
 }
 
-// FileNameRule returns the value of the "file_name_rule" field in the mutation.
-func (m *StoragePolicyMutation) FileNameRule() (r string, exists bool) {
-	v := m.file_name_rule
-	if v == nil {
-		return
+var _ ent.Mutation = (*SettingMutation)(nil)
+
+// settingOption allows management of the mutation configuration using functional options.
+type settingOption func(*SettingMutation)
+
+// newSettingMutation creates new mutation for the Setting entity.
+func newSettingMutation(c config, op Op, opts ...settingOption) *SettingMutation {
+	m := &SettingMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSetting,
+		clearedFields: make(map[string]struct{}),
 	}
-	return *v, true
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// OldFileNameRule returns the old "file_name_rule" field's value of the StoragePolicy entity.
-// If the StoragePolicy object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StoragePolicyMutation) OldFileNameRule(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFileNameRule is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFileNameRule requires an ID field in the mutation")
+// withSettingID sets the ID field of the mutation.
+func withSettingID(id int) settingOption {
+	return func(m *SettingMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Setting
+		)
+		m.oldValue = func(ctx context.Context) (*Setting, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Setting.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFileNameRule: %w", err)
+}
+
+// withSetting sets the old Setting of the mutation.
+func withSetting(node *Setting) settingOption {
+	return func(m *SettingMutation) {
+		m.oldValue = func(context.Context) (*Setting, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return oldValue.FileNameRule, nil
 }
 
-// ClearFileNameRule clears the value of the "file_name_rule" field.
-func (m *StoragePolicyMutation) ClearFileNameRule() {
-	m.file_name_rule = nil
-	m.clearedFields[storagepolicy.FieldFileNameRule] = struct{}{}
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SettingMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// FileNameRuleCleared returns if the "file_name_rule" field was cleared in this mutation.
-func (m *StoragePolicyMutation) FileNameRuleCleared() bool {
-	_, ok := m.clearedFields[storagepolicy.FieldFileNameRule]
-	return ok
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SettingMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ResetFileNameRule resets all changes to the "file_name_rule" field.
-func (m *StoragePolicyMutation) ResetFileNameRule() {
-	m.file_name_rule = nil
-	delete(m.clearedFields, storagepolicy.FieldFileNameRule)
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SettingMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// SetSettings sets the "settings" field.
-func (m *StoragePolicyMutation) SetSettings(ts *types.PolicySetting) {
-	m.settings = &ts
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SettingMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Setting.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// Settings returns the value of the "settings" field in the mutation.
-func (m *StoragePolicyMutation) Settings() (r *types.PolicySetting, exists bool) {
-	v := m.settings
+// SetCreatedAt sets the "created_at" field.
+func (m *SettingMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SettingMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSettings returns the old "settings" field's value of the StoragePolicy entity.
-// If the StoragePolicy object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Setting entity.
+// If the Setting object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StoragePolicyMutation) OldSettings(ctx context.Context) (v *types.PolicySetting, err error) {
+func (m *SettingMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSettings is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSettings requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSettings: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Settings, nil
-}
-
-// ClearSettings clears the value of the "settings" field.
-func (m *StoragePolicyMutation) ClearSettings() {
-	m.settings = nil
-	m.clearedFields[storagepolicy.FieldSettings] = struct{}{}
-}
-
-// SettingsCleared returns if the "settings" field was cleared in this mutation.
-func (m *StoragePolicyMutation) SettingsCleared() bool {
-	_, ok := m.clearedFields[storagepolicy.FieldSettings]
-	return ok
+	return oldValue.CreatedAt, nil
 }
 
-// ResetSettings resets all changes to the "settings" field.
-func (m *StoragePolicyMutation) ResetSettings() {
-	m.settings = nil
-	delete(m.clearedFields, storagepolicy.FieldSettings)
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SettingMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetNodeID sets the "node_id" field.
-func (m *StoragePolicyMutation) SetNodeID(i int) {
-	m.node = &i
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SettingMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// NodeID returns the value of the "node_id" field in the mutation.
-func (m *StoragePolicyMutation) NodeID() (r int, exists bool) {
-	v := m.node
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SettingMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNodeID returns the old "node_id" field's value of the StoragePolicy entity.
-// If the StoragePolicy object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Setting entity.
+// If the Setting object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StoragePolicyMutation) OldNodeID(ctx context.Context) (v int, err error) {
+func (m *SettingMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNodeID is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNodeID requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNodeID: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.NodeID, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ClearNodeID clears the value of the "node_id" field.
-func (m *StoragePolicyMutation) ClearNodeID() {
-	m.node = nil
-	m.clearedFields[storagepolicy.FieldNodeID] = struct{}{}
-}
-
-// NodeIDCleared returns if the "node_id" field was cleared in this mutation.
-func (m *StoragePolicyMutation) NodeIDCleared() bool {
-	_, ok := m.clearedFields[storagepolicy.FieldNodeID]
-	return ok
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SettingMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// ResetNodeID resets all changes to the "node_id" field.
-func (m *StoragePolicyMutation) ResetNodeID() {
-	m.node = nil
-	delete(m.clearedFields, storagepolicy.FieldNodeID)
+// SetDeletedAt sets the "deleted_at" field.
+func (m *SettingMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
 }
 
-// AddGroupIDs adds the "groups" edge to the Group entity by ids.
-func (m *StoragePolicyMutation) AddGroupIDs(ids ...int) {
-	if m.groups == nil {
-		m.groups = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.groups[ids[i]] = struct{}{}
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *SettingMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearGroups clears the "groups" edge to the Group entity.
-func (m *StoragePolicyMutation) ClearGroups() {
-	m.clearedgroups = true
-}
-
-// GroupsCleared reports if the "groups" edge to the Group entity was cleared.
-func (m *StoragePolicyMutation) GroupsCleared() bool {
-	return m.clearedgroups
-}
-
-// RemoveGroupIDs removes the "groups" edge to the Group entity by IDs.
-func (m *StoragePolicyMutation) RemoveGroupIDs(ids ...int) {
-	if m.removedgroups == nil {
-		m.removedgroups = make(map[int]struct{})
+// OldDeletedAt returns the old "deleted_at" field's value of the Setting entity.
+// If the Setting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.groups, ids[i])
-		m.removedgroups[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
 	}
-}
-
-// RemovedGroups returns the removed IDs of the "groups" edge to the Group entity.
-func (m *StoragePolicyMutation) RemovedGroupsIDs() (ids []int) {
-	for id := range m.removedgroups {
-		ids = append(ids, id)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
 	}
-	return
+	return oldValue.DeletedAt, nil
 }
 
-// GroupsIDs returns the "groups" edge IDs in the mutation.
-func (m *StoragePolicyMutation) GroupsIDs() (ids []int) {
-	for id := range m.groups {
-		ids = append(ids, id)
-	}
-	return
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *SettingMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[setting.FieldDeletedAt] = struct{}{}
 }
 
-// ResetGroups resets all changes to the "groups" edge.
-func (m *StoragePolicyMutation) ResetGroups() {
-	m.groups = nil
-	m.clearedgroups = false
-	m.removedgroups = nil
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *SettingMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[setting.FieldDeletedAt]
+	return ok
 }
 
-// AddFileIDs adds the "files" edge to the File entity by ids.
-func (m *StoragePolicyMutation) AddFileIDs(ids ...int) {
-	if m.files == nil {
-		m.files = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.files[ids[i]] = struct{}{}
-	}
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *SettingMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, setting.FieldDeletedAt)
 }
 
-// ClearFiles clears the "files" edge to the File entity.
-func (m *StoragePolicyMutation) ClearFiles() {
-	m.clearedfiles = true
+// SetName sets the "name" field.
+func (m *SettingMutation) SetName(s string) {
+	m.name = &s
 }
 
-// FilesCleared reports if the "files" edge to the File entity was cleared.
-func (m *StoragePolicyMutation) FilesCleared() bool {
-	return m.clearedfiles
+// Name returns the value of the "name" field in the mutation.
+func (m *SettingMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveFileIDs removes the "files" edge to the File entity by IDs.
-func (m *StoragePolicyMutation) RemoveFileIDs(ids ...int) {
-	if m.removedfiles == nil {
-		m.removedfiles = make(map[int]struct{})
+// OldName returns the old "name" field's value of the Setting entity.
+// If the Setting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.files, ids[i])
-		m.removedfiles[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
+	return oldValue.Name, nil
 }
 
-// RemovedFiles returns the removed IDs of the "files" edge to the File entity.
-func (m *StoragePolicyMutation) RemovedFilesIDs() (ids []int) {
-	for id := range m.removedfiles {
-		ids = append(ids, id)
-	}
-	return
+// ResetName resets all changes to the "name" field.
+func (m *SettingMutation) ResetName() {
+	m.name = nil
 }
 
-// FilesIDs returns the "files" edge IDs in the mutation.
-func (m *StoragePolicyMutation) FilesIDs() (ids []int) {
-	for id := range m.files {
-		ids = append(ids, id)
-	}
-	return
+// SetValue sets the "value" field.
+func (m *SettingMutation) SetValue(s string) {
+	m.value = &s
 }
 
-// ResetFiles resets all changes to the "files" edge.
-func (m *StoragePolicyMutation) ResetFiles() {
-	m.files = nil
-	m.clearedfiles = false
-	m.removedfiles = nil
+// Value returns the value of the "value" field in the mutation.
+func (m *SettingMutation) Value() (r string, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddEntityIDs adds the "entities" edge to the Entity entity by ids.
-func (m *StoragePolicyMutation) AddEntityIDs(ids ...int) {
-	if m.entities == nil {
-		m.entities = make(map[int]struct{})
+// OldValue returns the old "value" field's value of the Setting entity.
+// If the Setting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingMutation) OldValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.entities[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
 	}
+	return oldValue.Value, nil
 }
 
-// ClearEntities clears the "entities" edge to the Entity entity.
-func (m *StoragePolicyMutation) ClearEntities() {
-	m.clearedentities = true
+// ClearValue clears the value of the "value" field.
+func (m *SettingMutation) ClearValue() {
+	m.value = nil
+	m.clearedFields[setting.FieldValue] = struct{}{}
 }
 
-// EntitiesCleared reports if the "entities" edge to the Entity entity was cleared.
-func (m *StoragePolicyMutation) EntitiesCleared() bool {
-	return m.clearedentities
+// ValueCleared returns if the "value" field was cleared in this mutation.
+func (m *SettingMutation) ValueCleared() bool {
+	_, ok := m.clearedFields[setting.FieldValue]
+	return ok
 }
 
-// RemoveEntityIDs removes the "entities" edge to the Entity entity by IDs.
-func (m *StoragePolicyMutation) RemoveEntityIDs(ids ...int) {
-	if m.removedentities == nil {
-		m.removedentities = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.entities, ids[i])
-		m.removedentities[ids[i]] = struct{}{}
-	}
+// ResetValue resets all changes to the "value" field.
+func (m *SettingMutation) ResetValue() {
+	m.value = nil
+	delete(m.clearedFields, setting.FieldValue)
 }
 
-// RemovedEntities returns the removed IDs of the "entities" edge to the Entity entity.
-func (m *StoragePolicyMutation) RemovedEntitiesIDs() (ids []int) {
-	for id := range m.removedentities {
-		ids = append(ids, id)
-	}
-	return
+// Where appends a list predicates to the SettingMutation builder.
+func (m *SettingMutation) Where(ps ...predicate.Setting) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// EntitiesIDs returns the "entities" edge IDs in the mutation.
-func (m *StoragePolicyMutation) EntitiesIDs() (ids []int) {
-	for id := range m.entities {
-		ids = append(ids, id)
+// WhereP appends storage-level predicates to the SettingMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SettingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Setting, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return
+	m.Where(p...)
 }
 
-// ResetEntities resets all changes to the "entities" edge.
-func (m *StoragePolicyMutation) ResetEntities() {
-	m.entities = nil
-	m.clearedentities = false
-	m.removedentities = nil
+// Op returns the operation name.
+func (m *SettingMutation) Op() Op {
+	return m.op
 }
 
-// ClearNode clears the "node" edge to the Node entity.
-func (m *StoragePolicyMutation) ClearNode() {
-	m.clearednode = true
-	m.clearedFields[storagepolicy.FieldNodeID] = struct{}{}
+// SetOp allows setting the mutation operation.
+func (m *SettingMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// NodeCleared reports if the "node" edge to the Node entity was cleared.
-func (m *StoragePolicyMutation) NodeCleared() bool {
-	return m.NodeIDCleared() || m.clearednode
-}
-
-// NodeIDs returns the "node" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// NodeID instead. It exists only for internal usage by the builders.
-func (m *StoragePolicyMutation) NodeIDs() (ids []int) {
-	if id := m.node; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetNode resets all changes to the "node" edge.
-func (m *StoragePolicyMutation) ResetNode() {
-	m.node = nil
-	m.clearednode = false
-}
-
-// Where appends a list predicates to the StoragePolicyMutation builder.
-func (m *StoragePolicyMutation) Where(ps ...predicate.StoragePolicy) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the StoragePolicyMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *StoragePolicyMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.StoragePolicy, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
-}
-
-// Op returns the operation name.
-func (m *StoragePolicyMutation) Op() Op {
-	return m.op
-}
-
-// SetOp allows setting the mutation operation.
-func (m *StoragePolicyMutation) SetOp(op Op) {
-	m.op = op
-}
-
-// Type returns the node type of this mutation (StoragePolicy).
-func (m *StoragePolicyMutation) Type() string {
-	return m.typ
+// Type returns the node type of this mutation (Setting).
+func (m *SettingMutation) Type() string {
+	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *StoragePolicyMutation) Fields() []string {
-	fields := make([]string, 0, 15)
+func (m *SettingMutation) Fields() []string {
+	fields := make([]string, 0, 5)
 	if m.created_at != nil {
-		fields = append(fields, storagepolicy.FieldCreatedAt)
+		fields = append(fields, setting.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, storagepolicy.FieldUpdatedAt)
+		fields = append(fields, setting.FieldUpdatedAt)
 	}
 	if m.deleted_at != nil {
-		fields = append(fields, storagepolicy.FieldDeletedAt)
+		fields = append(fields, setting.FieldDeletedAt)
 	}
 	if m.name != nil {
-		fields = append(fields, storagepolicy.FieldName)
-	}
-	if m._type != nil {
-		fields = append(fields, storagepolicy.FieldType)
-	}
-	if m.server != nil {
-		fields = append(fields, storagepolicy.FieldServer)
-	}
-	if m.bucket_name != nil {
-		fields = append(fields, storagepolicy.FieldBucketName)
-	}
-	if m.is_private != nil {
-		fields = append(fields, storagepolicy.FieldIsPrivate)
-	}
-	if m.access_key != nil {
-		fields = append(fields, storagepolicy.FieldAccessKey)
-	}
-	if m.secret_key != nil {
-		fields = append(fields, storagepolicy.FieldSecretKey)
-	}
-	if m.max_size != nil {
-		fields = append(fields, storagepolicy.FieldMaxSize)
-	}
-	if m.dir_name_rule != nil {
-		fields = append(fields, storagepolicy.FieldDirNameRule)
-	}
-	if m.file_name_rule != nil {
-		fields = append(fields, storagepolicy.FieldFileNameRule)
-	}
-	if m.settings != nil {
-		fields = append(fields, storagepolicy.FieldSettings)
+		fields = append(fields, setting.FieldName)
 	}
-	if m.node != nil {
-		fields = append(fields, storagepolicy.FieldNodeID)
+	if m.value != nil {
+		fields = append(fields, setting.FieldValue)
 	}
 	return fields
 }
@@ -13623,38 +14060,18 @@ func (m *StoragePolicyMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *StoragePolicyMutation) Field(name string) (ent.Value, bool) {
+func (m *SettingMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case storagepolicy.FieldCreatedAt:
+	case setting.FieldCreatedAt:
 		return m.CreatedAt()
-	case storagepolicy.FieldUpdatedAt:
+	case setting.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case storagepolicy.FieldDeletedAt:
+	case setting.FieldDeletedAt:
 		return m.DeletedAt()
-	case storagepolicy.FieldName:
+	case setting.FieldName:
 		return m.Name()
-	case storagepolicy.FieldType:
-		return m.GetType()
-	case storagepolicy.FieldServer:
-		return m.Server()
-	case storagepolicy.FieldBucketName:
-		return m.BucketName()
-	case storagepolicy.FieldIsPrivate:
-		return m.IsPrivate()
-	case storagepolicy.FieldAccessKey:
-		return m.AccessKey()
-	case storagepolicy.FieldSecretKey:
-		return m.SecretKey()
-	case storagepolicy.FieldMaxSize:
-		return m.MaxSize()
-	case storagepolicy.FieldDirNameRule:
-		return m.DirNameRule()
-	case storagepolicy.FieldFileNameRule:
-		return m.FileNameRule()
-	case storagepolicy.FieldSettings:
-		return m.Settings()
-	case storagepolicy.FieldNodeID:
-		return m.NodeID()
+	case setting.FieldValue:
+		return m.Value()
 	}
 	return nil, false
 }
@@ -13662,521 +14079,234 @@ func (m *StoragePolicyMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *StoragePolicyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SettingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case storagepolicy.FieldCreatedAt:
+	case setting.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case storagepolicy.FieldUpdatedAt:
+	case setting.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case storagepolicy.FieldDeletedAt:
+	case setting.FieldDeletedAt:
 		return m.OldDeletedAt(ctx)
-	case storagepolicy.FieldName:
+	case setting.FieldName:
 		return m.OldName(ctx)
-	case storagepolicy.FieldType:
-		return m.OldType(ctx)
-	case storagepolicy.FieldServer:
-		return m.OldServer(ctx)
-	case storagepolicy.FieldBucketName:
-		return m.OldBucketName(ctx)
-	case storagepolicy.FieldIsPrivate:
-		return m.OldIsPrivate(ctx)
-	case storagepolicy.FieldAccessKey:
-		return m.OldAccessKey(ctx)
-	case storagepolicy.FieldSecretKey:
-		return m.OldSecretKey(ctx)
-	case storagepolicy.FieldMaxSize:
-		return m.OldMaxSize(ctx)
-	case storagepolicy.FieldDirNameRule:
-		return m.OldDirNameRule(ctx)
-	case storagepolicy.FieldFileNameRule:
-		return m.OldFileNameRule(ctx)
-	case storagepolicy.FieldSettings:
-		return m.OldSettings(ctx)
-	case storagepolicy.FieldNodeID:
-		return m.OldNodeID(ctx)
+	case setting.FieldValue:
+		return m.OldValue(ctx)
 	}
-	return nil, fmt.Errorf("unknown StoragePolicy field %s", name)
+	return nil, fmt.Errorf("unknown Setting field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *StoragePolicyMutation) SetField(name string, value ent.Value) error {
+func (m *SettingMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case storagepolicy.FieldCreatedAt:
+	case setting.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case storagepolicy.FieldUpdatedAt:
+	case setting.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case storagepolicy.FieldDeletedAt:
+	case setting.FieldDeletedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDeletedAt(v)
 		return nil
-	case storagepolicy.FieldName:
+	case setting.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case storagepolicy.FieldType:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetType(v)
-		return nil
-	case storagepolicy.FieldServer:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetServer(v)
-		return nil
-	case storagepolicy.FieldBucketName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetBucketName(v)
-		return nil
-	case storagepolicy.FieldIsPrivate:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsPrivate(v)
-		return nil
-	case storagepolicy.FieldAccessKey:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAccessKey(v)
-		return nil
-	case storagepolicy.FieldSecretKey:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSecretKey(v)
-		return nil
-	case storagepolicy.FieldMaxSize:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMaxSize(v)
-		return nil
-	case storagepolicy.FieldDirNameRule:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDirNameRule(v)
-		return nil
-	case storagepolicy.FieldFileNameRule:
+	case setting.FieldValue:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFileNameRule(v)
-		return nil
-	case storagepolicy.FieldSettings:
-		v, ok := value.(*types.PolicySetting)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSettings(v)
-		return nil
-	case storagepolicy.FieldNodeID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetNodeID(v)
+		m.SetValue(v)
 		return nil
 	}
-	return fmt.Errorf("unknown StoragePolicy field %s", name)
+	return fmt.Errorf("unknown Setting field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *StoragePolicyMutation) AddedFields() []string {
-	var fields []string
-	if m.addmax_size != nil {
-		fields = append(fields, storagepolicy.FieldMaxSize)
-	}
-	return fields
+func (m *SettingMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *StoragePolicyMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case storagepolicy.FieldMaxSize:
-		return m.AddedMaxSize()
-	}
+func (m *SettingMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *StoragePolicyMutation) AddField(name string, value ent.Value) error {
+func (m *SettingMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case storagepolicy.FieldMaxSize:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddMaxSize(v)
-		return nil
 	}
-	return fmt.Errorf("unknown StoragePolicy numeric field %s", name)
+	return fmt.Errorf("unknown Setting numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *StoragePolicyMutation) ClearedFields() []string {
+func (m *SettingMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(storagepolicy.FieldDeletedAt) {
-		fields = append(fields, storagepolicy.FieldDeletedAt)
-	}
-	if m.FieldCleared(storagepolicy.FieldServer) {
-		fields = append(fields, storagepolicy.FieldServer)
-	}
-	if m.FieldCleared(storagepolicy.FieldBucketName) {
-		fields = append(fields, storagepolicy.FieldBucketName)
-	}
-	if m.FieldCleared(storagepolicy.FieldIsPrivate) {
-		fields = append(fields, storagepolicy.FieldIsPrivate)
-	}
-	if m.FieldCleared(storagepolicy.FieldAccessKey) {
-		fields = append(fields, storagepolicy.FieldAccessKey)
-	}
-	if m.FieldCleared(storagepolicy.FieldSecretKey) {
-		fields = append(fields, storagepolicy.FieldSecretKey)
-	}
-	if m.FieldCleared(storagepolicy.FieldMaxSize) {
-		fields = append(fields, storagepolicy.FieldMaxSize)
-	}
-	if m.FieldCleared(storagepolicy.FieldDirNameRule) {
-		fields = append(fields, storagepolicy.FieldDirNameRule)
-	}
-	if m.FieldCleared(storagepolicy.FieldFileNameRule) {
-		fields = append(fields, storagepolicy.FieldFileNameRule)
-	}
-	if m.FieldCleared(storagepolicy.FieldSettings) {
-		fields = append(fields, storagepolicy.FieldSettings)
+	if m.FieldCleared(setting.FieldDeletedAt) {
+		fields = append(fields, setting.FieldDeletedAt)
 	}
-	if m.FieldCleared(storagepolicy.FieldNodeID) {
-		fields = append(fields, storagepolicy.FieldNodeID)
+	if m.FieldCleared(setting.FieldValue) {
+		fields = append(fields, setting.FieldValue)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *StoragePolicyMutation) FieldCleared(name string) bool {
+func (m *SettingMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *StoragePolicyMutation) ClearField(name string) error {
+func (m *SettingMutation) ClearField(name string) error {
 	switch name {
-	case storagepolicy.FieldDeletedAt:
+	case setting.FieldDeletedAt:
 		m.ClearDeletedAt()
 		return nil
-	case storagepolicy.FieldServer:
-		m.ClearServer()
-		return nil
-	case storagepolicy.FieldBucketName:
-		m.ClearBucketName()
-		return nil
-	case storagepolicy.FieldIsPrivate:
-		m.ClearIsPrivate()
-		return nil
-	case storagepolicy.FieldAccessKey:
-		m.ClearAccessKey()
-		return nil
-	case storagepolicy.FieldSecretKey:
-		m.ClearSecretKey()
-		return nil
-	case storagepolicy.FieldMaxSize:
-		m.ClearMaxSize()
-		return nil
-	case storagepolicy.FieldDirNameRule:
-		m.ClearDirNameRule()
-		return nil
-	case storagepolicy.FieldFileNameRule:
-		m.ClearFileNameRule()
-		return nil
-	case storagepolicy.FieldSettings:
-		m.ClearSettings()
-		return nil
-	case storagepolicy.FieldNodeID:
-		m.ClearNodeID()
+	case setting.FieldValue:
+		m.ClearValue()
 		return nil
 	}
-	return fmt.Errorf("unknown StoragePolicy nullable field %s", name)
+	return fmt.Errorf("unknown Setting nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *StoragePolicyMutation) ResetField(name string) error {
+func (m *SettingMutation) ResetField(name string) error {
 	switch name {
-	case storagepolicy.FieldCreatedAt:
+	case setting.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case storagepolicy.FieldUpdatedAt:
+	case setting.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case storagepolicy.FieldDeletedAt:
+	case setting.FieldDeletedAt:
 		m.ResetDeletedAt()
 		return nil
-	case storagepolicy.FieldName:
+	case setting.FieldName:
 		m.ResetName()
 		return nil
-	case storagepolicy.FieldType:
-		m.ResetType()
-		return nil
-	case storagepolicy.FieldServer:
-		m.ResetServer()
-		return nil
-	case storagepolicy.FieldBucketName:
-		m.ResetBucketName()
-		return nil
-	case storagepolicy.FieldIsPrivate:
-		m.ResetIsPrivate()
-		return nil
-	case storagepolicy.FieldAccessKey:
-		m.ResetAccessKey()
-		return nil
-	case storagepolicy.FieldSecretKey:
-		m.ResetSecretKey()
-		return nil
-	case storagepolicy.FieldMaxSize:
-		m.ResetMaxSize()
-		return nil
-	case storagepolicy.FieldDirNameRule:
-		m.ResetDirNameRule()
-		return nil
-	case storagepolicy.FieldFileNameRule:
-		m.ResetFileNameRule()
-		return nil
-	case storagepolicy.FieldSettings:
-		m.ResetSettings()
-		return nil
-	case storagepolicy.FieldNodeID:
-		m.ResetNodeID()
+	case setting.FieldValue:
+		m.ResetValue()
 		return nil
 	}
-	return fmt.Errorf("unknown StoragePolicy field %s", name)
+	return fmt.Errorf("unknown Setting field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *StoragePolicyMutation) AddedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.groups != nil {
-		edges = append(edges, storagepolicy.EdgeGroups)
-	}
-	if m.files != nil {
-		edges = append(edges, storagepolicy.EdgeFiles)
-	}
-	if m.entities != nil {
-		edges = append(edges, storagepolicy.EdgeEntities)
-	}
-	if m.node != nil {
-		edges = append(edges, storagepolicy.EdgeNode)
-	}
+func (m *SettingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *StoragePolicyMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case storagepolicy.EdgeGroups:
-		ids := make([]ent.Value, 0, len(m.groups))
-		for id := range m.groups {
-			ids = append(ids, id)
-		}
-		return ids
-	case storagepolicy.EdgeFiles:
-		ids := make([]ent.Value, 0, len(m.files))
-		for id := range m.files {
-			ids = append(ids, id)
-		}
-		return ids
-	case storagepolicy.EdgeEntities:
-		ids := make([]ent.Value, 0, len(m.entities))
-		for id := range m.entities {
-			ids = append(ids, id)
-		}
-		return ids
-	case storagepolicy.EdgeNode:
-		if id := m.node; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *SettingMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *StoragePolicyMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.removedgroups != nil {
-		edges = append(edges, storagepolicy.EdgeGroups)
-	}
-	if m.removedfiles != nil {
-		edges = append(edges, storagepolicy.EdgeFiles)
-	}
-	if m.removedentities != nil {
-		edges = append(edges, storagepolicy.EdgeEntities)
-	}
+func (m *SettingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *StoragePolicyMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case storagepolicy.EdgeGroups:
-		ids := make([]ent.Value, 0, len(m.removedgroups))
-		for id := range m.removedgroups {
-			ids = append(ids, id)
-		}
-		return ids
-	case storagepolicy.EdgeFiles:
-		ids := make([]ent.Value, 0, len(m.removedfiles))
-		for id := range m.removedfiles {
-			ids = append(ids, id)
-		}
-		return ids
-	case storagepolicy.EdgeEntities:
-		ids := make([]ent.Value, 0, len(m.removedentities))
-		for id := range m.removedentities {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *SettingMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *StoragePolicyMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.clearedgroups {
-		edges = append(edges, storagepolicy.EdgeGroups)
-	}
-	if m.clearedfiles {
-		edges = append(edges, storagepolicy.EdgeFiles)
-	}
-	if m.clearedentities {
-		edges = append(edges, storagepolicy.EdgeEntities)
-	}
-	if m.clearednode {
-		edges = append(edges, storagepolicy.EdgeNode)
-	}
+func (m *SettingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *StoragePolicyMutation) EdgeCleared(name string) bool {
-	switch name {
-	case storagepolicy.EdgeGroups:
-		return m.clearedgroups
-	case storagepolicy.EdgeFiles:
-		return m.clearedfiles
-	case storagepolicy.EdgeEntities:
-		return m.clearedentities
-	case storagepolicy.EdgeNode:
-		return m.clearednode
-	}
+func (m *SettingMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *StoragePolicyMutation) ClearEdge(name string) error {
-	switch name {
-	case storagepolicy.EdgeNode:
-		m.ClearNode()
-		return nil
-	}
-	return fmt.Errorf("unknown StoragePolicy unique edge %s", name)
+func (m *SettingMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Setting unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *StoragePolicyMutation) ResetEdge(name string) error {
-	switch name {
-	case storagepolicy.EdgeGroups:
-		m.ResetGroups()
-		return nil
-	case storagepolicy.EdgeFiles:
-		m.ResetFiles()
-		return nil
-	case storagepolicy.EdgeEntities:
-		m.ResetEntities()
-		return nil
-	case storagepolicy.EdgeNode:
-		m.ResetNode()
-		return nil
-	}
-	return fmt.Errorf("unknown StoragePolicy edge %s", name)
+func (m *SettingMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Setting edge %s", name)
 }
 
-// TaskMutation represents an operation that mutates the Task nodes in the graph.
-type TaskMutation struct {
+// ShareMutation represents an operation that mutates the Share nodes in the graph.
+type ShareMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *int
-	created_at     *time.Time
-	updated_at     *time.Time
-	deleted_at     *time.Time
-	_type          *string
-	status         *task.Status
-	public_state   **types.TaskPublicState
-	private_state  *string
-	correlation_id *uuid.UUID
-	clearedFields  map[string]struct{}
-	user           *int
-	cleareduser    bool
-	done           bool
-	oldValue       func(context.Context) (*Task, error)
-	predicates     []predicate.Task
+	op                  Op
+	typ                 string
+	id                  *int
+	created_at          *time.Time
+	updated_at          *time.Time
+	deleted_at          *time.Time
+	password            *string
+	views               *int
+	addviews            *int
+	downloads           *int
+	adddownloads        *int
+	uploads             *int
+	adduploads          *int
+	expires             *time.Time
+	remain_downloads    *int
+	addremain_downloads *int
+	props               **types.ShareProps
+	clearedFields       map[string]struct{}
+	user                *int
+	cleareduser         bool
+	file                *int
+	clearedfile         bool
+	done                bool
+	oldValue            func(context.Context) (*Share, error)
+	predicates          []predicate.Share
 }
 
-var _ ent.Mutation = (*TaskMutation)(nil)
+var _ ent.Mutation = (*ShareMutation)(nil)
 
-// taskOption allows management of the mutation configuration using functional options.
-type taskOption func(*TaskMutation)
+// shareOption allows management of the mutation configuration using functional options.
+type shareOption func(*ShareMutation)
 
-// newTaskMutation creates new mutation for the Task entity.
-func newTaskMutation(c config, op Op, opts ...taskOption) *TaskMutation {
-	m := &TaskMutation{
+// newShareMutation creates new mutation for the Share entity.
+func newShareMutation(c config, op Op, opts ...shareOption) *ShareMutation {
+	m := &ShareMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTask,
+		typ:           TypeShare,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -14185,20 +14315,20 @@ func newTaskMutation(c config, op Op, opts ...taskOption) *TaskMutation {
 	return m
 }
 
-// withTaskID sets the ID field of the mutation.
-func withTaskID(id int) taskOption {
-	return func(m *TaskMutation) {
+// withShareID sets the ID field of the mutation.
+func withShareID(id int) shareOption {
+	return func(m *ShareMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Task
+			value *Share
 		)
-		m.oldValue = func(ctx context.Context) (*Task, error) {
+		m.oldValue = func(ctx context.Context) (*Share, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Task.Get(ctx, id)
+					value, err = m.Client().Share.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -14207,10 +14337,10 @@ func withTaskID(id int) taskOption {
 	}
 }
 
-// withTask sets the old Task of the mutation.
-func withTask(node *Task) taskOption {
-	return func(m *TaskMutation) {
-		m.oldValue = func(context.Context) (*Task, error) {
+// withShare sets the old Share of the mutation.
+func withShare(node *Share) shareOption {
+	return func(m *ShareMutation) {
+		m.oldValue = func(context.Context) (*Share, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -14219,7 +14349,7 @@ func withTask(node *Task) taskOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TaskMutation) Client() *Client {
+func (m ShareMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -14227,7 +14357,7 @@ func (m TaskMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TaskMutation) Tx() (*Tx, error) {
+func (m ShareMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -14238,7 +14368,7 @@ func (m TaskMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TaskMutation) ID() (id int, exists bool) {
+func (m *ShareMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -14249,7 +14379,7 @@ func (m *TaskMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TaskMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ShareMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -14258,19 +14388,19 @@ func (m *TaskMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Task.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Share.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *TaskMutation) SetCreatedAt(t time.Time) {
+func (m *ShareMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *TaskMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *ShareMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -14278,10 +14408,10 @@ func (m *TaskMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Share entity.
+// If the Share object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ShareMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -14296,17 +14426,17 @@ func (m *TaskMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *TaskMutation) ResetCreatedAt() {
+func (m *ShareMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *TaskMutation) SetUpdatedAt(t time.Time) {
+func (m *ShareMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *TaskMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *ShareMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -14314,10 +14444,10 @@ func (m *TaskMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Share entity.
+// If the Share object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ShareMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -14332,17 +14462,17 @@ func (m *TaskMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *TaskMutation) ResetUpdatedAt() {
+func (m *ShareMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
 // SetDeletedAt sets the "deleted_at" field.
-func (m *TaskMutation) SetDeletedAt(t time.Time) {
+func (m *ShareMutation) SetDeletedAt(t time.Time) {
 	m.deleted_at = &t
 }
 
 // DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *TaskMutation) DeletedAt() (r time.Time, exists bool) {
+func (m *ShareMutation) DeletedAt() (r time.Time, exists bool) {
 	v := m.deleted_at
 	if v == nil {
 		return
@@ -14350,10 +14480,10 @@ func (m *TaskMutation) DeletedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedAt returns the old "deleted_at" field's value of the Share entity.
+// If the Share object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *ShareMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
@@ -14368,296 +14498,425 @@ func (m *TaskMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err erro
 }
 
 // ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *TaskMutation) ClearDeletedAt() {
+func (m *ShareMutation) ClearDeletedAt() {
 	m.deleted_at = nil
-	m.clearedFields[task.FieldDeletedAt] = struct{}{}
+	m.clearedFields[share.FieldDeletedAt] = struct{}{}
 }
 
 // DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *TaskMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[task.FieldDeletedAt]
+func (m *ShareMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[share.FieldDeletedAt]
 	return ok
 }
 
 // ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *TaskMutation) ResetDeletedAt() {
+func (m *ShareMutation) ResetDeletedAt() {
 	m.deleted_at = nil
-	delete(m.clearedFields, task.FieldDeletedAt)
+	delete(m.clearedFields, share.FieldDeletedAt)
 }
 
-// SetType sets the "type" field.
-func (m *TaskMutation) SetType(s string) {
-	m._type = &s
+// SetPassword sets the "password" field.
+func (m *ShareMutation) SetPassword(s string) {
+	m.password = &s
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *TaskMutation) GetType() (r string, exists bool) {
-	v := m._type
+// Password returns the value of the "password" field in the mutation.
+func (m *ShareMutation) Password() (r string, exists bool) {
+	v := m.password
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldPassword returns the old "password" field's value of the Share entity.
+// If the Share object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldType(ctx context.Context) (v string, err error) {
+func (m *ShareMutation) OldPassword(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldPassword requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
 	}
-	return oldValue.Type, nil
+	return oldValue.Password, nil
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *TaskMutation) ResetType() {
-	m._type = nil
+// ClearPassword clears the value of the "password" field.
+func (m *ShareMutation) ClearPassword() {
+	m.password = nil
+	m.clearedFields[share.FieldPassword] = struct{}{}
 }
 
-// SetStatus sets the "status" field.
-func (m *TaskMutation) SetStatus(t task.Status) {
-	m.status = &t
+// PasswordCleared returns if the "password" field was cleared in this mutation.
+func (m *ShareMutation) PasswordCleared() bool {
+	_, ok := m.clearedFields[share.FieldPassword]
+	return ok
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *TaskMutation) Status() (r task.Status, exists bool) {
-	v := m.status
+// ResetPassword resets all changes to the "password" field.
+func (m *ShareMutation) ResetPassword() {
+	m.password = nil
+	delete(m.clearedFields, share.FieldPassword)
+}
+
+// SetViews sets the "views" field.
+func (m *ShareMutation) SetViews(i int) {
+	m.views = &i
+	m.addviews = nil
+}
+
+// Views returns the value of the "views" field in the mutation.
+func (m *ShareMutation) Views() (r int, exists bool) {
+	v := m.views
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldViews returns the old "views" field's value of the Share entity.
+// If the Share object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldStatus(ctx context.Context) (v task.Status, err error) {
+func (m *ShareMutation) OldViews(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldViews is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldViews requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldViews: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.Views, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *TaskMutation) ResetStatus() {
-	m.status = nil
+// AddViews adds i to the "views" field.
+func (m *ShareMutation) AddViews(i int) {
+	if m.addviews != nil {
+		*m.addviews += i
+	} else {
+		m.addviews = &i
+	}
 }
 
-// SetPublicState sets the "public_state" field.
-func (m *TaskMutation) SetPublicState(tps *types.TaskPublicState) {
-	m.public_state = &tps
+// AddedViews returns the value that was added to the "views" field in this mutation.
+func (m *ShareMutation) AddedViews() (r int, exists bool) {
+	v := m.addviews
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// PublicState returns the value of the "public_state" field in the mutation.
-func (m *TaskMutation) PublicState() (r *types.TaskPublicState, exists bool) {
-	v := m.public_state
+// ResetViews resets all changes to the "views" field.
+func (m *ShareMutation) ResetViews() {
+	m.views = nil
+	m.addviews = nil
+}
+
+// SetDownloads sets the "downloads" field.
+func (m *ShareMutation) SetDownloads(i int) {
+	m.downloads = &i
+	m.adddownloads = nil
+}
+
+// Downloads returns the value of the "downloads" field in the mutation.
+func (m *ShareMutation) Downloads() (r int, exists bool) {
+	v := m.downloads
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPublicState returns the old "public_state" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldDownloads returns the old "downloads" field's value of the Share entity.
+// If the Share object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldPublicState(ctx context.Context) (v *types.TaskPublicState, err error) {
+func (m *ShareMutation) OldDownloads(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPublicState is only allowed on UpdateOne operations")
+		return v, errors.New("OldDownloads is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPublicState requires an ID field in the mutation")
+		return v, errors.New("OldDownloads requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPublicState: %w", err)
+		return v, fmt.Errorf("querying old value for OldDownloads: %w", err)
 	}
-	return oldValue.PublicState, nil
+	return oldValue.Downloads, nil
 }
 
-// ResetPublicState resets all changes to the "public_state" field.
-func (m *TaskMutation) ResetPublicState() {
-	m.public_state = nil
+// AddDownloads adds i to the "downloads" field.
+func (m *ShareMutation) AddDownloads(i int) {
+	if m.adddownloads != nil {
+		*m.adddownloads += i
+	} else {
+		m.adddownloads = &i
+	}
 }
 
-// SetPrivateState sets the "private_state" field.
-func (m *TaskMutation) SetPrivateState(s string) {
-	m.private_state = &s
+// AddedDownloads returns the value that was added to the "downloads" field in this mutation.
+func (m *ShareMutation) AddedDownloads() (r int, exists bool) {
+	v := m.adddownloads
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// PrivateState returns the value of the "private_state" field in the mutation.
-func (m *TaskMutation) PrivateState() (r string, exists bool) {
-	v := m.private_state
+// ResetDownloads resets all changes to the "downloads" field.
+func (m *ShareMutation) ResetDownloads() {
+	m.downloads = nil
+	m.adddownloads = nil
+}
+
+// SetUploads sets the "uploads" field.
+func (m *ShareMutation) SetUploads(i int) {
+	m.uploads = &i
+	m.adduploads = nil
+}
+
+// Uploads returns the value of the "uploads" field in the mutation.
+func (m *ShareMutation) Uploads() (r int, exists bool) {
+	v := m.uploads
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPrivateState returns the old "private_state" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldUploads returns the old "uploads" field's value of the Share entity.
+// If the Share object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldPrivateState(ctx context.Context) (v string, err error) {
+func (m *ShareMutation) OldUploads(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPrivateState is only allowed on UpdateOne operations")
+		return v, errors.New("OldUploads is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPrivateState requires an ID field in the mutation")
+		return v, errors.New("OldUploads requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPrivateState: %w", err)
+		return v, fmt.Errorf("querying old value for OldUploads: %w", err)
 	}
-	return oldValue.PrivateState, nil
+	return oldValue.Uploads, nil
 }
 
-// ClearPrivateState clears the value of the "private_state" field.
-func (m *TaskMutation) ClearPrivateState() {
-	m.private_state = nil
-	m.clearedFields[task.FieldPrivateState] = struct{}{}
+// AddUploads adds i to the "uploads" field.
+func (m *ShareMutation) AddUploads(i int) {
+	if m.adduploads != nil {
+		*m.adduploads += i
+	} else {
+		m.adduploads = &i
+	}
 }
 
-// PrivateStateCleared returns if the "private_state" field was cleared in this mutation.
-func (m *TaskMutation) PrivateStateCleared() bool {
-	_, ok := m.clearedFields[task.FieldPrivateState]
+// AddedUploads returns the value that was added to the "uploads" field in this mutation.
+func (m *ShareMutation) AddedUploads() (r int, exists bool) {
+	v := m.adduploads
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUploads resets all changes to the "uploads" field.
+func (m *ShareMutation) ResetUploads() {
+	m.uploads = nil
+	m.adduploads = nil
+}
+
+// SetExpires sets the "expires" field.
+func (m *ShareMutation) SetExpires(t time.Time) {
+	m.expires = &t
+}
+
+// Expires returns the value of the "expires" field in the mutation.
+func (m *ShareMutation) Expires() (r time.Time, exists bool) {
+	v := m.expires
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpires returns the old "expires" field's value of the Share entity.
+// If the Share object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareMutation) OldExpires(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpires is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpires requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpires: %w", err)
+	}
+	return oldValue.Expires, nil
+}
+
+// ClearExpires clears the value of the "expires" field.
+func (m *ShareMutation) ClearExpires() {
+	m.expires = nil
+	m.clearedFields[share.FieldExpires] = struct{}{}
+}
+
+// ExpiresCleared returns if the "expires" field was cleared in this mutation.
+func (m *ShareMutation) ExpiresCleared() bool {
+	_, ok := m.clearedFields[share.FieldExpires]
 	return ok
 }
 
-// ResetPrivateState resets all changes to the "private_state" field.
-func (m *TaskMutation) ResetPrivateState() {
-	m.private_state = nil
-	delete(m.clearedFields, task.FieldPrivateState)
+// ResetExpires resets all changes to the "expires" field.
+func (m *ShareMutation) ResetExpires() {
+	m.expires = nil
+	delete(m.clearedFields, share.FieldExpires)
 }
 
-// SetCorrelationID sets the "correlation_id" field.
-func (m *TaskMutation) SetCorrelationID(u uuid.UUID) {
-	m.correlation_id = &u
+// SetRemainDownloads sets the "remain_downloads" field.
+func (m *ShareMutation) SetRemainDownloads(i int) {
+	m.remain_downloads = &i
+	m.addremain_downloads = nil
 }
 
-// CorrelationID returns the value of the "correlation_id" field in the mutation.
-func (m *TaskMutation) CorrelationID() (r uuid.UUID, exists bool) {
-	v := m.correlation_id
+// RemainDownloads returns the value of the "remain_downloads" field in the mutation.
+func (m *ShareMutation) RemainDownloads() (r int, exists bool) {
+	v := m.remain_downloads
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCorrelationID returns the old "correlation_id" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldRemainDownloads returns the old "remain_downloads" field's value of the Share entity.
+// If the Share object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldCorrelationID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *ShareMutation) OldRemainDownloads(ctx context.Context) (v *int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCorrelationID is only allowed on UpdateOne operations")
+		return v, errors.New("OldRemainDownloads is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCorrelationID requires an ID field in the mutation")
+		return v, errors.New("OldRemainDownloads requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCorrelationID: %w", err)
+		return v, fmt.Errorf("querying old value for OldRemainDownloads: %w", err)
 	}
-	return oldValue.CorrelationID, nil
+	return oldValue.RemainDownloads, nil
 }
 
-// ClearCorrelationID clears the value of the "correlation_id" field.
-func (m *TaskMutation) ClearCorrelationID() {
-	m.correlation_id = nil
-	m.clearedFields[task.FieldCorrelationID] = struct{}{}
+// AddRemainDownloads adds i to the "remain_downloads" field.
+func (m *ShareMutation) AddRemainDownloads(i int) {
+	if m.addremain_downloads != nil {
+		*m.addremain_downloads += i
+	} else {
+		m.addremain_downloads = &i
+	}
 }
 
-// CorrelationIDCleared returns if the "correlation_id" field was cleared in this mutation.
-func (m *TaskMutation) CorrelationIDCleared() bool {
-	_, ok := m.clearedFields[task.FieldCorrelationID]
+// AddedRemainDownloads returns the value that was added to the "remain_downloads" field in this mutation.
+func (m *ShareMutation) AddedRemainDownloads() (r int, exists bool) {
+	v := m.addremain_downloads
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearRemainDownloads clears the value of the "remain_downloads" field.
+func (m *ShareMutation) ClearRemainDownloads() {
+	m.remain_downloads = nil
+	m.addremain_downloads = nil
+	m.clearedFields[share.FieldRemainDownloads] = struct{}{}
+}
+
+// RemainDownloadsCleared returns if the "remain_downloads" field was cleared in this mutation.
+func (m *ShareMutation) RemainDownloadsCleared() bool {
+	_, ok := m.clearedFields[share.FieldRemainDownloads]
 	return ok
 }
 
-// ResetCorrelationID resets all changes to the "correlation_id" field.
-func (m *TaskMutation) ResetCorrelationID() {
-	m.correlation_id = nil
-	delete(m.clearedFields, task.FieldCorrelationID)
+// ResetRemainDownloads resets all changes to the "remain_downloads" field.
+func (m *ShareMutation) ResetRemainDownloads() {
+	m.remain_downloads = nil
+	m.addremain_downloads = nil
+	delete(m.clearedFields, share.FieldRemainDownloads)
 }
 
-// SetUserTasks sets the "user_tasks" field.
-func (m *TaskMutation) SetUserTasks(i int) {
-	m.user = &i
+// SetProps sets the "props" field.
+func (m *ShareMutation) SetProps(tp *types.ShareProps) {
+	m.props = &tp
 }
 
-// UserTasks returns the value of the "user_tasks" field in the mutation.
-func (m *TaskMutation) UserTasks() (r int, exists bool) {
-	v := m.user
+// Props returns the value of the "props" field in the mutation.
+func (m *ShareMutation) Props() (r *types.ShareProps, exists bool) {
+	v := m.props
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserTasks returns the old "user_tasks" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldProps returns the old "props" field's value of the Share entity.
+// If the Share object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldUserTasks(ctx context.Context) (v int, err error) {
+func (m *ShareMutation) OldProps(ctx context.Context) (v *types.ShareProps, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserTasks is only allowed on UpdateOne operations")
+		return v, errors.New("OldProps is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserTasks requires an ID field in the mutation")
+		return v, errors.New("OldProps requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserTasks: %w", err)
+		return v, fmt.Errorf("querying old value for OldProps: %w", err)
 	}
-	return oldValue.UserTasks, nil
+	return oldValue.Props, nil
 }
 
-// ClearUserTasks clears the value of the "user_tasks" field.
-func (m *TaskMutation) ClearUserTasks() {
-	m.user = nil
-	m.clearedFields[task.FieldUserTasks] = struct{}{}
+// ClearProps clears the value of the "props" field.
+func (m *ShareMutation) ClearProps() {
+	m.props = nil
+	m.clearedFields[share.FieldProps] = struct{}{}
 }
 
-// UserTasksCleared returns if the "user_tasks" field was cleared in this mutation.
-func (m *TaskMutation) UserTasksCleared() bool {
-	_, ok := m.clearedFields[task.FieldUserTasks]
+// PropsCleared returns if the "props" field was cleared in this mutation.
+func (m *ShareMutation) PropsCleared() bool {
+	_, ok := m.clearedFields[share.FieldProps]
 	return ok
 }
 
-// ResetUserTasks resets all changes to the "user_tasks" field.
-func (m *TaskMutation) ResetUserTasks() {
-	m.user = nil
-	delete(m.clearedFields, task.FieldUserTasks)
+// ResetProps resets all changes to the "props" field.
+func (m *ShareMutation) ResetProps() {
+	m.props = nil
+	delete(m.clearedFields, share.FieldProps)
 }
 
 // SetUserID sets the "user" edge to the User entity by id.
-func (m *TaskMutation) SetUserID(id int) {
+func (m *ShareMutation) SetUserID(id int) {
 	m.user = &id
 }
 
 // ClearUser clears the "user" edge to the User entity.
-func (m *TaskMutation) ClearUser() {
+func (m *ShareMutation) ClearUser() {
 	m.cleareduser = true
-	m.clearedFields[task.FieldUserTasks] = struct{}{}
 }
 
 // UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *TaskMutation) UserCleared() bool {
-	return m.UserTasksCleared() || m.cleareduser
+func (m *ShareMutation) UserCleared() bool {
+	return m.cleareduser
 }
 
 // UserID returns the "user" edge ID in the mutation.
-func (m *TaskMutation) UserID() (id int, exists bool) {
+func (m *ShareMutation) UserID() (id int, exists bool) {
 	if m.user != nil {
 		return *m.user, true
 	}
@@ -14667,7 +14926,7 @@ func (m *TaskMutation) UserID() (id int, exists bool) {
 // UserIDs returns the "user" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // UserID instead. It exists only for internal usage by the builders.
-func (m *TaskMutation) UserIDs() (ids []int) {
+func (m *ShareMutation) UserIDs() (ids []int) {
 	if id := m.user; id != nil {
 		ids = append(ids, *id)
 	}
@@ -14675,72 +14934,114 @@ func (m *TaskMutation) UserIDs() (ids []int) {
 }
 
 // ResetUser resets all changes to the "user" edge.
-func (m *TaskMutation) ResetUser() {
+func (m *ShareMutation) ResetUser() {
 	m.user = nil
 	m.cleareduser = false
 }
 
-// Where appends a list predicates to the TaskMutation builder.
-func (m *TaskMutation) Where(ps ...predicate.Task) {
-	m.predicates = append(m.predicates, ps...)
+// SetFileID sets the "file" edge to the File entity by id.
+func (m *ShareMutation) SetFileID(id int) {
+	m.file = &id
 }
 
-// WhereP appends storage-level predicates to the TaskMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TaskMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Task, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
+// ClearFile clears the "file" edge to the File entity.
+func (m *ShareMutation) ClearFile() {
+	m.clearedfile = true
 }
 
-// Op returns the operation name.
-func (m *TaskMutation) Op() Op {
+// FileCleared reports if the "file" edge to the File entity was cleared.
+func (m *ShareMutation) FileCleared() bool {
+	return m.clearedfile
+}
+
+// FileID returns the "file" edge ID in the mutation.
+func (m *ShareMutation) FileID() (id int, exists bool) {
+	if m.file != nil {
+		return *m.file, true
+	}
+	return
+}
+
+// FileIDs returns the "file" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FileID instead. It exists only for internal usage by the builders.
+func (m *ShareMutation) FileIDs() (ids []int) {
+	if id := m.file; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFile resets all changes to the "file" edge.
+func (m *ShareMutation) ResetFile() {
+	m.file = nil
+	m.clearedfile = false
+}
+
+// Where appends a list predicates to the ShareMutation builder.
+func (m *ShareMutation) Where(ps ...predicate.Share) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ShareMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ShareMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Share, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ShareMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TaskMutation) SetOp(op Op) {
+func (m *ShareMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Task).
-func (m *TaskMutation) Type() string {
+// Type returns the node type of this mutation (Share).
+func (m *ShareMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TaskMutation) Fields() []string {
-	fields := make([]string, 0, 9)
+func (m *ShareMutation) Fields() []string {
+	fields := make([]string, 0, 10)
 	if m.created_at != nil {
-		fields = append(fields, task.FieldCreatedAt)
+		fields = append(fields, share.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, task.FieldUpdatedAt)
+		fields = append(fields, share.FieldUpdatedAt)
 	}
 	if m.deleted_at != nil {
-		fields = append(fields, task.FieldDeletedAt)
+		fields = append(fields, share.FieldDeletedAt)
 	}
-	if m._type != nil {
-		fields = append(fields, task.FieldType)
+	if m.password != nil {
+		fields = append(fields, share.FieldPassword)
 	}
-	if m.status != nil {
-		fields = append(fields, task.FieldStatus)
+	if m.views != nil {
+		fields = append(fields, share.FieldViews)
 	}
-	if m.public_state != nil {
-		fields = append(fields, task.FieldPublicState)
+	if m.downloads != nil {
+		fields = append(fields, share.FieldDownloads)
 	}
-	if m.private_state != nil {
-		fields = append(fields, task.FieldPrivateState)
+	if m.uploads != nil {
+		fields = append(fields, share.FieldUploads)
 	}
-	if m.correlation_id != nil {
-		fields = append(fields, task.FieldCorrelationID)
+	if m.expires != nil {
+		fields = append(fields, share.FieldExpires)
 	}
-	if m.user != nil {
-		fields = append(fields, task.FieldUserTasks)
+	if m.remain_downloads != nil {
+		fields = append(fields, share.FieldRemainDownloads)
+	}
+	if m.props != nil {
+		fields = append(fields, share.FieldProps)
 	}
 	return fields
 }
@@ -14748,26 +15049,28 @@ func (m *TaskMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TaskMutation) Field(name string) (ent.Value, bool) {
+func (m *ShareMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case task.FieldCreatedAt:
+	case share.FieldCreatedAt:
 		return m.CreatedAt()
-	case task.FieldUpdatedAt:
+	case share.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case task.FieldDeletedAt:
+	case share.FieldDeletedAt:
 		return m.DeletedAt()
-	case task.FieldType:
-		return m.GetType()
-	case task.FieldStatus:
-		return m.Status()
-	case task.FieldPublicState:
-		return m.PublicState()
-	case task.FieldPrivateState:
-		return m.PrivateState()
-	case task.FieldCorrelationID:
-		return m.CorrelationID()
-	case task.FieldUserTasks:
-		return m.UserTasks()
+	case share.FieldPassword:
+		return m.Password()
+	case share.FieldViews:
+		return m.Views()
+	case share.FieldDownloads:
+		return m.Downloads()
+	case share.FieldUploads:
+		return m.Uploads()
+	case share.FieldExpires:
+		return m.Expires()
+	case share.FieldRemainDownloads:
+		return m.RemainDownloads()
+	case share.FieldProps:
+		return m.Props()
 	}
 	return nil, false
 }
@@ -14775,114 +15078,143 @@ func (m *TaskMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TaskMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ShareMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case task.FieldCreatedAt:
+	case share.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case task.FieldUpdatedAt:
+	case share.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case task.FieldDeletedAt:
+	case share.FieldDeletedAt:
 		return m.OldDeletedAt(ctx)
-	case task.FieldType:
-		return m.OldType(ctx)
-	case task.FieldStatus:
-		return m.OldStatus(ctx)
-	case task.FieldPublicState:
-		return m.OldPublicState(ctx)
-	case task.FieldPrivateState:
-		return m.OldPrivateState(ctx)
-	case task.FieldCorrelationID:
-		return m.OldCorrelationID(ctx)
-	case task.FieldUserTasks:
-		return m.OldUserTasks(ctx)
+	case share.FieldPassword:
+		return m.OldPassword(ctx)
+	case share.FieldViews:
+		return m.OldViews(ctx)
+	case share.FieldDownloads:
+		return m.OldDownloads(ctx)
+	case share.FieldUploads:
+		return m.OldUploads(ctx)
+	case share.FieldExpires:
+		return m.OldExpires(ctx)
+	case share.FieldRemainDownloads:
+		return m.OldRemainDownloads(ctx)
+	case share.FieldProps:
+		return m.OldProps(ctx)
 	}
-	return nil, fmt.Errorf("unknown Task field %s", name)
+	return nil, fmt.Errorf("unknown Share field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TaskMutation) SetField(name string, value ent.Value) error {
+func (m *ShareMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case task.FieldCreatedAt:
+	case share.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case task.FieldUpdatedAt:
+	case share.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case task.FieldDeletedAt:
+	case share.FieldDeletedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDeletedAt(v)
 		return nil
-	case task.FieldType:
+	case share.FieldPassword:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetType(v)
+		m.SetPassword(v)
 		return nil
-	case task.FieldStatus:
-		v, ok := value.(task.Status)
+	case share.FieldViews:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetViews(v)
 		return nil
-	case task.FieldPublicState:
-		v, ok := value.(*types.TaskPublicState)
+	case share.FieldDownloads:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPublicState(v)
+		m.SetDownloads(v)
 		return nil
-	case task.FieldPrivateState:
-		v, ok := value.(string)
+	case share.FieldUploads:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPrivateState(v)
+		m.SetUploads(v)
 		return nil
-	case task.FieldCorrelationID:
-		v, ok := value.(uuid.UUID)
+	case share.FieldExpires:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCorrelationID(v)
+		m.SetExpires(v)
 		return nil
-	case task.FieldUserTasks:
+	case share.FieldRemainDownloads:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserTasks(v)
+		m.SetRemainDownloads(v)
+		return nil
+	case share.FieldProps:
+		v, ok := value.(*types.ShareProps)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProps(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Task field %s", name)
+	return fmt.Errorf("unknown Share field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TaskMutation) AddedFields() []string {
+func (m *ShareMutation) AddedFields() []string {
 	var fields []string
+	if m.addviews != nil {
+		fields = append(fields, share.FieldViews)
+	}
+	if m.adddownloads != nil {
+		fields = append(fields, share.FieldDownloads)
+	}
+	if m.adduploads != nil {
+		fields = append(fields, share.FieldUploads)
+	}
+	if m.addremain_downloads != nil {
+		fields = append(fields, share.FieldRemainDownloads)
+	}
 	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TaskMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ShareMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
+	case share.FieldViews:
+		return m.AddedViews()
+	case share.FieldDownloads:
+		return m.AddedDownloads()
+	case share.FieldUploads:
+		return m.AddedUploads()
+	case share.FieldRemainDownloads:
+		return m.AddedRemainDownloads()
 	}
 	return nil, false
 }
@@ -14890,228 +15222,256 @@ func (m *TaskMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TaskMutation) AddField(name string, value ent.Value) error {
+func (m *ShareMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case share.FieldViews:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddViews(v)
+		return nil
+	case share.FieldDownloads:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDownloads(v)
+		return nil
+	case share.FieldUploads:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUploads(v)
+		return nil
+	case share.FieldRemainDownloads:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRemainDownloads(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Task numeric field %s", name)
+	return fmt.Errorf("unknown Share numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TaskMutation) ClearedFields() []string {
+func (m *ShareMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(task.FieldDeletedAt) {
-		fields = append(fields, task.FieldDeletedAt)
+	if m.FieldCleared(share.FieldDeletedAt) {
+		fields = append(fields, share.FieldDeletedAt)
 	}
-	if m.FieldCleared(task.FieldPrivateState) {
-		fields = append(fields, task.FieldPrivateState)
+	if m.FieldCleared(share.FieldPassword) {
+		fields = append(fields, share.FieldPassword)
 	}
-	if m.FieldCleared(task.FieldCorrelationID) {
-		fields = append(fields, task.FieldCorrelationID)
+	if m.FieldCleared(share.FieldExpires) {
+		fields = append(fields, share.FieldExpires)
 	}
-	if m.FieldCleared(task.FieldUserTasks) {
-		fields = append(fields, task.FieldUserTasks)
+	if m.FieldCleared(share.FieldRemainDownloads) {
+		fields = append(fields, share.FieldRemainDownloads)
+	}
+	if m.FieldCleared(share.FieldProps) {
+		fields = append(fields, share.FieldProps)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TaskMutation) FieldCleared(name string) bool {
+func (m *ShareMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TaskMutation) ClearField(name string) error {
+func (m *ShareMutation) ClearField(name string) error {
 	switch name {
-	case task.FieldDeletedAt:
+	case share.FieldDeletedAt:
 		m.ClearDeletedAt()
 		return nil
-	case task.FieldPrivateState:
-		m.ClearPrivateState()
+	case share.FieldPassword:
+		m.ClearPassword()
 		return nil
-	case task.FieldCorrelationID:
-		m.ClearCorrelationID()
+	case share.FieldExpires:
+		m.ClearExpires()
 		return nil
-	case task.FieldUserTasks:
-		m.ClearUserTasks()
+	case share.FieldRemainDownloads:
+		m.ClearRemainDownloads()
+		return nil
+	case share.FieldProps:
+		m.ClearProps()
 		return nil
 	}
-	return fmt.Errorf("unknown Task nullable field %s", name)
+	return fmt.Errorf("unknown Share nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TaskMutation) ResetField(name string) error {
+func (m *ShareMutation) ResetField(name string) error {
 	switch name {
-	case task.FieldCreatedAt:
+	case share.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case task.FieldUpdatedAt:
+	case share.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case task.FieldDeletedAt:
+	case share.FieldDeletedAt:
 		m.ResetDeletedAt()
 		return nil
-	case task.FieldType:
-		m.ResetType()
+	case share.FieldPassword:
+		m.ResetPassword()
 		return nil
-	case task.FieldStatus:
-		m.ResetStatus()
+	case share.FieldViews:
+		m.ResetViews()
 		return nil
-	case task.FieldPublicState:
-		m.ResetPublicState()
+	case share.FieldDownloads:
+		m.ResetDownloads()
 		return nil
-	case task.FieldPrivateState:
-		m.ResetPrivateState()
+	case share.FieldUploads:
+		m.ResetUploads()
 		return nil
-	case task.FieldCorrelationID:
-		m.ResetCorrelationID()
+	case share.FieldExpires:
+		m.ResetExpires()
 		return nil
-	case task.FieldUserTasks:
-		m.ResetUserTasks()
+	case share.FieldRemainDownloads:
+		m.ResetRemainDownloads()
+		return nil
+	case share.FieldProps:
+		m.ResetProps()
 		return nil
 	}
-	return fmt.Errorf("unknown Task field %s", name)
+	return fmt.Errorf("unknown Share field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TaskMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *ShareMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
 	if m.user != nil {
-		edges = append(edges, task.EdgeUser)
+		edges = append(edges, share.EdgeUser)
+	}
+	if m.file != nil {
+		edges = append(edges, share.EdgeFile)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TaskMutation) AddedIDs(name string) []ent.Value {
+func (m *ShareMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case task.EdgeUser:
+	case share.EdgeUser:
 		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
+	case share.EdgeFile:
+		if id := m.file; id != nil {
+			return []ent.Value{*id}
+		}
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TaskMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *ShareMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TaskMutation) RemovedIDs(name string) []ent.Value {
+func (m *ShareMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TaskMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *ShareMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
 	if m.cleareduser {
-		edges = append(edges, task.EdgeUser)
+		edges = append(edges, share.EdgeUser)
+	}
+	if m.clearedfile {
+		edges = append(edges, share.EdgeFile)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TaskMutation) EdgeCleared(name string) bool {
+func (m *ShareMutation) EdgeCleared(name string) bool {
 	switch name {
-	case task.EdgeUser:
+	case share.EdgeUser:
 		return m.cleareduser
+	case share.EdgeFile:
+		return m.clearedfile
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TaskMutation) ClearEdge(name string) error {
+func (m *ShareMutation) ClearEdge(name string) error {
 	switch name {
-	case task.EdgeUser:
+	case share.EdgeUser:
 		m.ClearUser()
 		return nil
+	case share.EdgeFile:
+		m.ClearFile()
+		return nil
 	}
-	return fmt.Errorf("unknown Task unique edge %s", name)
+	return fmt.Errorf("unknown Share unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TaskMutation) ResetEdge(name string) error {
+func (m *ShareMutation) ResetEdge(name string) error {
 	switch name {
-	case task.EdgeUser:
+	case share.EdgeUser:
 		m.ResetUser()
 		return nil
+	case share.EdgeFile:
+		m.ResetFile()
+		return nil
 	}
-	return fmt.Errorf("unknown Task edge %s", name)
+	return fmt.Errorf("unknown Share edge %s", name)
 }
 
-// UserMutation represents an operation that mutates the User nodes in the graph.
-type UserMutation struct {
+// ShareAccessMutation represents an operation that mutates the ShareAccess nodes in the graph.
+type ShareAccessMutation struct {
 	config
-	op                  Op
-	typ                 string
-	id                  *int
-	created_at          *time.Time
-	updated_at          *time.Time
-	deleted_at          *time.Time
-	email               *string
-	nick                *string
-	password            *string
-	status              *user.Status
-	storage             *int64
-	addstorage          *int64
-	two_factor_secret   *string
-	avatar              *string
-	settings            **types.UserSetting
-	clearedFields       map[string]struct{}
-	group               *int
-	clearedgroup        bool
-	files               map[int]struct{}
-	removedfiles        map[int]struct{}
-	clearedfiles        bool
-	dav_accounts        map[int]struct{}
-	removeddav_accounts map[int]struct{}
-	cleareddav_accounts bool
-	shares              map[int]struct{}
-	removedshares       map[int]struct{}
-	clearedshares       bool
-	passkey             map[int]struct{}
-	removedpasskey      map[int]struct{}
-	clearedpasskey      bool
-	tasks               map[int]struct{}
-	removedtasks        map[int]struct{}
-	clearedtasks        bool
-	fsevents            map[int]struct{}
-	removedfsevents     map[int]struct{}
-	clearedfsevents     bool
-	entities            map[int]struct{}
-	removedentities     map[int]struct{}
-	clearedentities     bool
-	oauth_grants        map[int]struct{}
-	removedoauth_grants map[int]struct{}
-	clearedoauth_grants bool
-	done                bool
-	oldValue            func(context.Context) (*User, error)
-	predicates          []predicate.User
+	op            Op
+	typ           string
+	id            *int
+	created_at    *time.Time
+	updated_at    *time.Time
+	deleted_at    *time.Time
+	share_id      *int
+	addshare_id   *int
+	user_id       *int
+	adduser_id    *int
+	action        *shareaccess.Action
+	ip            *string
+	user_agent    *string
+	_path         *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ShareAccess, error)
+	predicates    []predicate.ShareAccess
 }
 
-var _ ent.Mutation = (*UserMutation)(nil)
+var _ ent.Mutation = (*ShareAccessMutation)(nil)
 
-// userOption allows management of the mutation configuration using functional options.
-type userOption func(*UserMutation)
+// shareaccessOption allows management of the mutation configuration using functional options.
+type shareaccessOption func(*ShareAccessMutation)
 
-// newUserMutation creates new mutation for the User entity.
-func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
-	m := &UserMutation{
+// newShareAccessMutation creates new mutation for the ShareAccess entity.
+func newShareAccessMutation(c config, op Op, opts ...shareaccessOption) *ShareAccessMutation {
+	m := &ShareAccessMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUser,
+		typ:           TypeShareAccess,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -15120,20 +15480,20 @@ func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
 	return m
 }
 
-// withUserID sets the ID field of the mutation.
-func withUserID(id int) userOption {
-	return func(m *UserMutation) {
+// withShareAccessID sets the ID field of the mutation.
+func withShareAccessID(id int) shareaccessOption {
+	return func(m *ShareAccessMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *User
+			value *ShareAccess
 		)
-		m.oldValue = func(ctx context.Context) (*User, error) {
+		m.oldValue = func(ctx context.Context) (*ShareAccess, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().User.Get(ctx, id)
+					value, err = m.Client().ShareAccess.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -15142,10 +15502,10 @@ func withUserID(id int) userOption {
 	}
 }
 
-// withUser sets the old User of the mutation.
-func withUser(node *User) userOption {
-	return func(m *UserMutation) {
-		m.oldValue = func(context.Context) (*User, error) {
+// withShareAccess sets the old ShareAccess of the mutation.
+func withShareAccess(node *ShareAccess) shareaccessOption {
+	return func(m *ShareAccessMutation) {
+		m.oldValue = func(context.Context) (*ShareAccess, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -15154,7 +15514,7 @@ func withUser(node *User) userOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserMutation) Client() *Client {
+func (m ShareAccessMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -15162,7 +15522,7 @@ func (m UserMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UserMutation) Tx() (*Tx, error) {
+func (m ShareAccessMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -15173,7 +15533,7 @@ func (m UserMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UserMutation) ID() (id int, exists bool) {
+func (m *ShareAccessMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -15184,7 +15544,7 @@ func (m *UserMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ShareAccessMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -15193,19 +15553,19 @@ func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ShareAccess.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *UserMutation) SetCreatedAt(t time.Time) {
+func (m *ShareAccessMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *ShareAccessMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -15213,10 +15573,10 @@ func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the ShareAccess entity.
+// If the ShareAccess object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ShareAccessMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -15231,17 +15591,17 @@ func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *UserMutation) ResetCreatedAt() {
+func (m *ShareAccessMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *UserMutation) SetUpdatedAt(t time.Time) {
+func (m *ShareAccessMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *ShareAccessMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -15249,10 +15609,10 @@ func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the ShareAccess entity.
+// If the ShareAccess object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ShareAccessMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -15267,17 +15627,17 @@ func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *UserMutation) ResetUpdatedAt() {
+func (m *ShareAccessMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
 // SetDeletedAt sets the "deleted_at" field.
-func (m *UserMutation) SetDeletedAt(t time.Time) {
+func (m *ShareAccessMutation) SetDeletedAt(t time.Time) {
 	m.deleted_at = &t
 }
 
 // DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *UserMutation) DeletedAt() (r time.Time, exists bool) {
+func (m *ShareAccessMutation) DeletedAt() (r time.Time, exists bool) {
 	v := m.deleted_at
 	if v == nil {
 		return
@@ -15285,10 +15645,10 @@ func (m *UserMutation) DeletedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedAt returns the old "deleted_at" field's value of the ShareAccess entity.
+// If the ShareAccess object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *ShareAccessMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
@@ -15303,1537 +15663,7957 @@ func (m *UserMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err erro
 }
 
 // ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *UserMutation) ClearDeletedAt() {
+func (m *ShareAccessMutation) ClearDeletedAt() {
 	m.deleted_at = nil
-	m.clearedFields[user.FieldDeletedAt] = struct{}{}
+	m.clearedFields[shareaccess.FieldDeletedAt] = struct{}{}
 }
 
 // DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *UserMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[user.FieldDeletedAt]
+func (m *ShareAccessMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[shareaccess.FieldDeletedAt]
 	return ok
 }
 
 // ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *UserMutation) ResetDeletedAt() {
+func (m *ShareAccessMutation) ResetDeletedAt() {
 	m.deleted_at = nil
-	delete(m.clearedFields, user.FieldDeletedAt)
+	delete(m.clearedFields, shareaccess.FieldDeletedAt)
 }
 
-// SetEmail sets the "email" field.
-func (m *UserMutation) SetEmail(s string) {
-	m.email = &s
+// SetShareID sets the "share_id" field.
+func (m *ShareAccessMutation) SetShareID(i int) {
+	m.share_id = &i
+	m.addshare_id = nil
 }
 
-// Email returns the value of the "email" field in the mutation.
-func (m *UserMutation) Email() (r string, exists bool) {
-	v := m.email
+// ShareID returns the value of the "share_id" field in the mutation.
+func (m *ShareAccessMutation) ShareID() (r int, exists bool) {
+	v := m.share_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEmail returns the old "email" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldShareID returns the old "share_id" field's value of the ShareAccess entity.
+// If the ShareAccess object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
+func (m *ShareAccessMutation) OldShareID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+		return v, errors.New("OldShareID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
+		return v, errors.New("OldShareID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+		return v, fmt.Errorf("querying old value for OldShareID: %w", err)
 	}
-	return oldValue.Email, nil
-}
-
-// ResetEmail resets all changes to the "email" field.
-func (m *UserMutation) ResetEmail() {
-	m.email = nil
+	return oldValue.ShareID, nil
 }
 
-// SetNick sets the "nick" field.
-func (m *UserMutation) SetNick(s string) {
-	m.nick = &s
+// AddShareID adds i to the "share_id" field.
+func (m *ShareAccessMutation) AddShareID(i int) {
+	if m.addshare_id != nil {
+		*m.addshare_id += i
+	} else {
+		m.addshare_id = &i
+	}
 }
 
-// Nick returns the value of the "nick" field in the mutation.
-func (m *UserMutation) Nick() (r string, exists bool) {
-	v := m.nick
+// AddedShareID returns the value that was added to the "share_id" field in this mutation.
+func (m *ShareAccessMutation) AddedShareID() (r int, exists bool) {
+	v := m.addshare_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNick returns the old "nick" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldNick(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNick is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNick requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNick: %w", err)
-	}
-	return oldValue.Nick, nil
-}
-
-// ResetNick resets all changes to the "nick" field.
-func (m *UserMutation) ResetNick() {
-	m.nick = nil
+// ResetShareID resets all changes to the "share_id" field.
+func (m *ShareAccessMutation) ResetShareID() {
+	m.share_id = nil
+	m.addshare_id = nil
 }
 
-// SetPassword sets the "password" field.
-func (m *UserMutation) SetPassword(s string) {
-	m.password = &s
+// SetUserID sets the "user_id" field.
+func (m *ShareAccessMutation) SetUserID(i int) {
+	m.user_id = &i
+	m.adduser_id = nil
 }
 
-// Password returns the value of the "password" field in the mutation.
-func (m *UserMutation) Password() (r string, exists bool) {
-	v := m.password
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ShareAccessMutation) UserID() (r int, exists bool) {
+	v := m.user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPassword returns the old "password" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the ShareAccess entity.
+// If the ShareAccess object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldPassword(ctx context.Context) (v string, err error) {
+func (m *ShareAccessMutation) OldUserID(ctx context.Context) (v *int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPassword requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.Password, nil
-}
-
-// ClearPassword clears the value of the "password" field.
-func (m *UserMutation) ClearPassword() {
-	m.password = nil
-	m.clearedFields[user.FieldPassword] = struct{}{}
-}
-
-// PasswordCleared returns if the "password" field was cleared in this mutation.
-func (m *UserMutation) PasswordCleared() bool {
-	_, ok := m.clearedFields[user.FieldPassword]
-	return ok
-}
-
-// ResetPassword resets all changes to the "password" field.
-func (m *UserMutation) ResetPassword() {
-	m.password = nil
-	delete(m.clearedFields, user.FieldPassword)
+	return oldValue.UserID, nil
 }
 
-// SetStatus sets the "status" field.
-func (m *UserMutation) SetStatus(u user.Status) {
-	m.status = &u
+// AddUserID adds i to the "user_id" field.
+func (m *ShareAccessMutation) AddUserID(i int) {
+	if m.adduser_id != nil {
+		*m.adduser_id += i
+	} else {
+		m.adduser_id = &i
+	}
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *UserMutation) Status() (r user.Status, exists bool) {
-	v := m.status
+// AddedUserID returns the value that was added to the "user_id" field in this mutation.
+func (m *ShareAccessMutation) AddedUserID() (r int, exists bool) {
+	v := m.adduser_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldStatus(ctx context.Context) (v user.Status, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
-	}
-	return oldValue.Status, nil
+// ClearUserID clears the value of the "user_id" field.
+func (m *ShareAccessMutation) ClearUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+	m.clearedFields[shareaccess.FieldUserID] = struct{}{}
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *UserMutation) ResetStatus() {
-	m.status = nil
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *ShareAccessMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[shareaccess.FieldUserID]
+	return ok
 }
 
-// SetStorage sets the "storage" field.
-func (m *UserMutation) SetStorage(i int64) {
-	m.storage = &i
-	m.addstorage = nil
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ShareAccessMutation) ResetUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+	delete(m.clearedFields, shareaccess.FieldUserID)
 }
 
-// Storage returns the value of the "storage" field in the mutation.
-func (m *UserMutation) Storage() (r int64, exists bool) {
-	v := m.storage
+// SetAction sets the "action" field.
+func (m *ShareAccessMutation) SetAction(s shareaccess.Action) {
+	m.action = &s
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *ShareAccessMutation) Action() (r shareaccess.Action, exists bool) {
+	v := m.action
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStorage returns the old "storage" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldAction returns the old "action" field's value of the ShareAccess entity.
+// If the ShareAccess object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldStorage(ctx context.Context) (v int64, err error) {
+func (m *ShareAccessMutation) OldAction(ctx context.Context) (v shareaccess.Action, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStorage is only allowed on UpdateOne operations")
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStorage requires an ID field in the mutation")
+		return v, errors.New("OldAction requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStorage: %w", err)
-	}
-	return oldValue.Storage, nil
-}
-
-// AddStorage adds i to the "storage" field.
-func (m *UserMutation) AddStorage(i int64) {
-	if m.addstorage != nil {
-		*m.addstorage += i
-	} else {
-		m.addstorage = &i
-	}
-}
-
-// AddedStorage returns the value that was added to the "storage" field in this mutation.
-func (m *UserMutation) AddedStorage() (r int64, exists bool) {
-	v := m.addstorage
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
 	}
-	return *v, true
+	return oldValue.Action, nil
 }
 
-// ResetStorage resets all changes to the "storage" field.
-func (m *UserMutation) ResetStorage() {
-	m.storage = nil
-	m.addstorage = nil
+// ResetAction resets all changes to the "action" field.
+func (m *ShareAccessMutation) ResetAction() {
+	m.action = nil
 }
 
-// SetTwoFactorSecret sets the "two_factor_secret" field.
-func (m *UserMutation) SetTwoFactorSecret(s string) {
-	m.two_factor_secret = &s
+// SetIP sets the "ip" field.
+func (m *ShareAccessMutation) SetIP(s string) {
+	m.ip = &s
 }
 
-// TwoFactorSecret returns the value of the "two_factor_secret" field in the mutation.
-func (m *UserMutation) TwoFactorSecret() (r string, exists bool) {
-	v := m.two_factor_secret
+// IP returns the value of the "ip" field in the mutation.
+func (m *ShareAccessMutation) IP() (r string, exists bool) {
+	v := m.ip
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTwoFactorSecret returns the old "two_factor_secret" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldIP returns the old "ip" field's value of the ShareAccess entity.
+// If the ShareAccess object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldTwoFactorSecret(ctx context.Context) (v string, err error) {
+func (m *ShareAccessMutation) OldIP(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTwoFactorSecret is only allowed on UpdateOne operations")
+		return v, errors.New("OldIP is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTwoFactorSecret requires an ID field in the mutation")
+		return v, errors.New("OldIP requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTwoFactorSecret: %w", err)
+		return v, fmt.Errorf("querying old value for OldIP: %w", err)
 	}
-	return oldValue.TwoFactorSecret, nil
+	return oldValue.IP, nil
 }
 
-// ClearTwoFactorSecret clears the value of the "two_factor_secret" field.
-func (m *UserMutation) ClearTwoFactorSecret() {
-	m.two_factor_secret = nil
-	m.clearedFields[user.FieldTwoFactorSecret] = struct{}{}
+// ClearIP clears the value of the "ip" field.
+func (m *ShareAccessMutation) ClearIP() {
+	m.ip = nil
+	m.clearedFields[shareaccess.FieldIP] = struct{}{}
 }
 
-// TwoFactorSecretCleared returns if the "two_factor_secret" field was cleared in this mutation.
-func (m *UserMutation) TwoFactorSecretCleared() bool {
-	_, ok := m.clearedFields[user.FieldTwoFactorSecret]
+// IPCleared returns if the "ip" field was cleared in this mutation.
+func (m *ShareAccessMutation) IPCleared() bool {
+	_, ok := m.clearedFields[shareaccess.FieldIP]
 	return ok
 }
 
-// ResetTwoFactorSecret resets all changes to the "two_factor_secret" field.
-func (m *UserMutation) ResetTwoFactorSecret() {
-	m.two_factor_secret = nil
-	delete(m.clearedFields, user.FieldTwoFactorSecret)
+// ResetIP resets all changes to the "ip" field.
+func (m *ShareAccessMutation) ResetIP() {
+	m.ip = nil
+	delete(m.clearedFields, shareaccess.FieldIP)
 }
 
-// SetAvatar sets the "avatar" field.
-func (m *UserMutation) SetAvatar(s string) {
-	m.avatar = &s
+// SetUserAgent sets the "user_agent" field.
+func (m *ShareAccessMutation) SetUserAgent(s string) {
+	m.user_agent = &s
 }
 
-// Avatar returns the value of the "avatar" field in the mutation.
-func (m *UserMutation) Avatar() (r string, exists bool) {
-	v := m.avatar
+// UserAgent returns the value of the "user_agent" field in the mutation.
+func (m *ShareAccessMutation) UserAgent() (r string, exists bool) {
+	v := m.user_agent
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAvatar returns the old "avatar" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldUserAgent returns the old "user_agent" field's value of the ShareAccess entity.
+// If the ShareAccess object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldAvatar(ctx context.Context) (v string, err error) {
+func (m *ShareAccessMutation) OldUserAgent(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAvatar is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserAgent is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAvatar requires an ID field in the mutation")
+		return v, errors.New("OldUserAgent requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAvatar: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserAgent: %w", err)
 	}
-	return oldValue.Avatar, nil
+	return oldValue.UserAgent, nil
 }
 
-// ClearAvatar clears the value of the "avatar" field.
-func (m *UserMutation) ClearAvatar() {
-	m.avatar = nil
-	m.clearedFields[user.FieldAvatar] = struct{}{}
+// ClearUserAgent clears the value of the "user_agent" field.
+func (m *ShareAccessMutation) ClearUserAgent() {
+	m.user_agent = nil
+	m.clearedFields[shareaccess.FieldUserAgent] = struct{}{}
 }
 
-// AvatarCleared returns if the "avatar" field was cleared in this mutation.
-func (m *UserMutation) AvatarCleared() bool {
-	_, ok := m.clearedFields[user.FieldAvatar]
+// UserAgentCleared returns if the "user_agent" field was cleared in this mutation.
+func (m *ShareAccessMutation) UserAgentCleared() bool {
+	_, ok := m.clearedFields[shareaccess.FieldUserAgent]
 	return ok
 }
 
-// ResetAvatar resets all changes to the "avatar" field.
-func (m *UserMutation) ResetAvatar() {
-	m.avatar = nil
-	delete(m.clearedFields, user.FieldAvatar)
+// ResetUserAgent resets all changes to the "user_agent" field.
+func (m *ShareAccessMutation) ResetUserAgent() {
+	m.user_agent = nil
+	delete(m.clearedFields, shareaccess.FieldUserAgent)
 }
 
-// SetSettings sets the "settings" field.
-func (m *UserMutation) SetSettings(ts *types.UserSetting) {
-	m.settings = &ts
+// SetPath sets the "path" field.
+func (m *ShareAccessMutation) SetPath(s string) {
+	m._path = &s
 }
 
-// Settings returns the value of the "settings" field in the mutation.
-func (m *UserMutation) Settings() (r *types.UserSetting, exists bool) {
-	v := m.settings
+// Path returns the value of the "path" field in the mutation.
+func (m *ShareAccessMutation) Path() (r string, exists bool) {
+	v := m._path
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSettings returns the old "settings" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldPath returns the old "path" field's value of the ShareAccess entity.
+// If the ShareAccess object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldSettings(ctx context.Context) (v *types.UserSetting, err error) {
+func (m *ShareAccessMutation) OldPath(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSettings is only allowed on UpdateOne operations")
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSettings requires an ID field in the mutation")
+		return v, errors.New("OldPath requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSettings: %w", err)
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
 	}
-	return oldValue.Settings, nil
+	return oldValue.Path, nil
 }
 
-// ClearSettings clears the value of the "settings" field.
-func (m *UserMutation) ClearSettings() {
-	m.settings = nil
-	m.clearedFields[user.FieldSettings] = struct{}{}
+// ClearPath clears the value of the "path" field.
+func (m *ShareAccessMutation) ClearPath() {
+	m._path = nil
+	m.clearedFields[shareaccess.FieldPath] = struct{}{}
 }
 
-// SettingsCleared returns if the "settings" field was cleared in this mutation.
-func (m *UserMutation) SettingsCleared() bool {
-	_, ok := m.clearedFields[user.FieldSettings]
+// PathCleared returns if the "path" field was cleared in this mutation.
+func (m *ShareAccessMutation) PathCleared() bool {
+	_, ok := m.clearedFields[shareaccess.FieldPath]
 	return ok
 }
 
-// ResetSettings resets all changes to the "settings" field.
-func (m *UserMutation) ResetSettings() {
-	m.settings = nil
-	delete(m.clearedFields, user.FieldSettings)
+// ResetPath resets all changes to the "path" field.
+func (m *ShareAccessMutation) ResetPath() {
+	m._path = nil
+	delete(m.clearedFields, shareaccess.FieldPath)
 }
 
-// SetGroupUsers sets the "group_users" field.
-func (m *UserMutation) SetGroupUsers(i int) {
-	m.group = &i
+// Where appends a list predicates to the ShareAccessMutation builder.
+func (m *ShareAccessMutation) Where(ps ...predicate.ShareAccess) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// GroupUsers returns the value of the "group_users" field in the mutation.
-func (m *UserMutation) GroupUsers() (r int, exists bool) {
-	v := m.group
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the ShareAccessMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ShareAccessMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ShareAccess, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldGroupUsers returns the old "group_users" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldGroupUsers(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGroupUsers is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGroupUsers requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGroupUsers: %w", err)
-	}
-	return oldValue.GroupUsers, nil
+// Op returns the operation name.
+func (m *ShareAccessMutation) Op() Op {
+	return m.op
 }
 
-// ResetGroupUsers resets all changes to the "group_users" field.
-func (m *UserMutation) ResetGroupUsers() {
-	m.group = nil
+// SetOp allows setting the mutation operation.
+func (m *ShareAccessMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SetGroupID sets the "group" edge to the Group entity by id.
-func (m *UserMutation) SetGroupID(id int) {
-	m.group = &id
+// Type returns the node type of this mutation (ShareAccess).
+func (m *ShareAccessMutation) Type() string {
+	return m.typ
 }
 
-// ClearGroup clears the "group" edge to the Group entity.
-func (m *UserMutation) ClearGroup() {
-	m.clearedgroup = true
-	m.clearedFields[user.FieldGroupUsers] = struct{}{}
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ShareAccessMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.created_at != nil {
+		fields = append(fields, shareaccess.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, shareaccess.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, shareaccess.FieldDeletedAt)
+	}
+	if m.share_id != nil {
+		fields = append(fields, shareaccess.FieldShareID)
+	}
+	if m.user_id != nil {
+		fields = append(fields, shareaccess.FieldUserID)
+	}
+	if m.action != nil {
+		fields = append(fields, shareaccess.FieldAction)
+	}
+	if m.ip != nil {
+		fields = append(fields, shareaccess.FieldIP)
+	}
+	if m.user_agent != nil {
+		fields = append(fields, shareaccess.FieldUserAgent)
+	}
+	if m._path != nil {
+		fields = append(fields, shareaccess.FieldPath)
+	}
+	return fields
 }
 
-// GroupCleared reports if the "group" edge to the Group entity was cleared.
-func (m *UserMutation) GroupCleared() bool {
-	return m.clearedgroup
-}
-
-// GroupID returns the "group" edge ID in the mutation.
-func (m *UserMutation) GroupID() (id int, exists bool) {
-	if m.group != nil {
-		return *m.group, true
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ShareAccessMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case shareaccess.FieldCreatedAt:
+		return m.CreatedAt()
+	case shareaccess.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case shareaccess.FieldDeletedAt:
+		return m.DeletedAt()
+	case shareaccess.FieldShareID:
+		return m.ShareID()
+	case shareaccess.FieldUserID:
+		return m.UserID()
+	case shareaccess.FieldAction:
+		return m.Action()
+	case shareaccess.FieldIP:
+		return m.IP()
+	case shareaccess.FieldUserAgent:
+		return m.UserAgent()
+	case shareaccess.FieldPath:
+		return m.Path()
 	}
-	return
+	return nil, false
 }
 
-// GroupIDs returns the "group" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// GroupID instead. It exists only for internal usage by the builders.
-func (m *UserMutation) GroupIDs() (ids []int) {
-	if id := m.group; id != nil {
-		ids = append(ids, *id)
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ShareAccessMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case shareaccess.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case shareaccess.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case shareaccess.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case shareaccess.FieldShareID:
+		return m.OldShareID(ctx)
+	case shareaccess.FieldUserID:
+		return m.OldUserID(ctx)
+	case shareaccess.FieldAction:
+		return m.OldAction(ctx)
+	case shareaccess.FieldIP:
+		return m.OldIP(ctx)
+	case shareaccess.FieldUserAgent:
+		return m.OldUserAgent(ctx)
+	case shareaccess.FieldPath:
+		return m.OldPath(ctx)
 	}
-	return
+	return nil, fmt.Errorf("unknown ShareAccess field %s", name)
 }
 
-// ResetGroup resets all changes to the "group" edge.
-func (m *UserMutation) ResetGroup() {
-	m.group = nil
-	m.clearedgroup = false
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ShareAccessMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case shareaccess.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case shareaccess.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case shareaccess.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case shareaccess.FieldShareID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShareID(v)
+		return nil
+	case shareaccess.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case shareaccess.FieldAction:
+		v, ok := value.(shareaccess.Action)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case shareaccess.FieldIP:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIP(v)
+		return nil
+	case shareaccess.FieldUserAgent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserAgent(v)
+		return nil
+	case shareaccess.FieldPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPath(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ShareAccess field %s", name)
 }
 
-// AddFileIDs adds the "files" edge to the File entity by ids.
-func (m *UserMutation) AddFileIDs(ids ...int) {
-	if m.files == nil {
-		m.files = make(map[int]struct{})
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ShareAccessMutation) AddedFields() []string {
+	var fields []string
+	if m.addshare_id != nil {
+		fields = append(fields, shareaccess.FieldShareID)
 	}
-	for i := range ids {
-		m.files[ids[i]] = struct{}{}
+	if m.adduser_id != nil {
+		fields = append(fields, shareaccess.FieldUserID)
 	}
+	return fields
 }
 
-// ClearFiles clears the "files" edge to the File entity.
-func (m *UserMutation) ClearFiles() {
-	m.clearedfiles = true
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ShareAccessMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case shareaccess.FieldShareID:
+		return m.AddedShareID()
+	case shareaccess.FieldUserID:
+		return m.AddedUserID()
+	}
+	return nil, false
 }
 
-// FilesCleared reports if the "files" edge to the File entity was cleared.
-func (m *UserMutation) FilesCleared() bool {
-	return m.clearedfiles
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ShareAccessMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case shareaccess.FieldShareID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddShareID(v)
+		return nil
+	case shareaccess.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ShareAccess numeric field %s", name)
 }
 
-// RemoveFileIDs removes the "files" edge to the File entity by IDs.
-func (m *UserMutation) RemoveFileIDs(ids ...int) {
-	if m.removedfiles == nil {
-		m.removedfiles = make(map[int]struct{})
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ShareAccessMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(shareaccess.FieldDeletedAt) {
+		fields = append(fields, shareaccess.FieldDeletedAt)
 	}
-	for i := range ids {
-		delete(m.files, ids[i])
-		m.removedfiles[ids[i]] = struct{}{}
+	if m.FieldCleared(shareaccess.FieldUserID) {
+		fields = append(fields, shareaccess.FieldUserID)
 	}
+	if m.FieldCleared(shareaccess.FieldIP) {
+		fields = append(fields, shareaccess.FieldIP)
+	}
+	if m.FieldCleared(shareaccess.FieldUserAgent) {
+		fields = append(fields, shareaccess.FieldUserAgent)
+	}
+	if m.FieldCleared(shareaccess.FieldPath) {
+		fields = append(fields, shareaccess.FieldPath)
+	}
+	return fields
 }
 
-// RemovedFiles returns the removed IDs of the "files" edge to the File entity.
-func (m *UserMutation) RemovedFilesIDs() (ids []int) {
-	for id := range m.removedfiles {
-		ids = append(ids, id)
-	}
-	return
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ShareAccessMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// FilesIDs returns the "files" edge IDs in the mutation.
-func (m *UserMutation) FilesIDs() (ids []int) {
-	for id := range m.files {
-		ids = append(ids, id)
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ShareAccessMutation) ClearField(name string) error {
+	switch name {
+	case shareaccess.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case shareaccess.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case shareaccess.FieldIP:
+		m.ClearIP()
+		return nil
+	case shareaccess.FieldUserAgent:
+		m.ClearUserAgent()
+		return nil
+	case shareaccess.FieldPath:
+		m.ClearPath()
+		return nil
 	}
-	return
+	return fmt.Errorf("unknown ShareAccess nullable field %s", name)
 }
 
-// ResetFiles resets all changes to the "files" edge.
-func (m *UserMutation) ResetFiles() {
-	m.files = nil
-	m.clearedfiles = false
-	m.removedfiles = nil
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ShareAccessMutation) ResetField(name string) error {
+	switch name {
+	case shareaccess.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case shareaccess.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case shareaccess.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case shareaccess.FieldShareID:
+		m.ResetShareID()
+		return nil
+	case shareaccess.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case shareaccess.FieldAction:
+		m.ResetAction()
+		return nil
+	case shareaccess.FieldIP:
+		m.ResetIP()
+		return nil
+	case shareaccess.FieldUserAgent:
+		m.ResetUserAgent()
+		return nil
+	case shareaccess.FieldPath:
+		m.ResetPath()
+		return nil
+	}
+	return fmt.Errorf("unknown ShareAccess field %s", name)
 }
 
-// AddDavAccountIDs adds the "dav_accounts" edge to the DavAccount entity by ids.
-func (m *UserMutation) AddDavAccountIDs(ids ...int) {
-	if m.dav_accounts == nil {
-		m.dav_accounts = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.dav_accounts[ids[i]] = struct{}{}
-	}
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ShareAccessMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ClearDavAccounts clears the "dav_accounts" edge to the DavAccount entity.
-func (m *UserMutation) ClearDavAccounts() {
-	m.cleareddav_accounts = true
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ShareAccessMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// DavAccountsCleared reports if the "dav_accounts" edge to the DavAccount entity was cleared.
-func (m *UserMutation) DavAccountsCleared() bool {
-	return m.cleareddav_accounts
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ShareAccessMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// RemoveDavAccountIDs removes the "dav_accounts" edge to the DavAccount entity by IDs.
-func (m *UserMutation) RemoveDavAccountIDs(ids ...int) {
-	if m.removeddav_accounts == nil {
-		m.removeddav_accounts = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.dav_accounts, ids[i])
-		m.removeddav_accounts[ids[i]] = struct{}{}
-	}
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ShareAccessMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// RemovedDavAccounts returns the removed IDs of the "dav_accounts" edge to the DavAccount entity.
-func (m *UserMutation) RemovedDavAccountsIDs() (ids []int) {
-	for id := range m.removeddav_accounts {
-		ids = append(ids, id)
-	}
-	return
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ShareAccessMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// DavAccountsIDs returns the "dav_accounts" edge IDs in the mutation.
-func (m *UserMutation) DavAccountsIDs() (ids []int) {
-	for id := range m.dav_accounts {
-		ids = append(ids, id)
-	}
-	return
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ShareAccessMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// ResetDavAccounts resets all changes to the "dav_accounts" edge.
-func (m *UserMutation) ResetDavAccounts() {
-	m.dav_accounts = nil
-	m.cleareddav_accounts = false
-	m.removeddav_accounts = nil
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ShareAccessMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ShareAccess unique edge %s", name)
 }
 
-// AddShareIDs adds the "shares" edge to the Share entity by ids.
-func (m *UserMutation) AddShareIDs(ids ...int) {
-	if m.shares == nil {
-		m.shares = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.shares[ids[i]] = struct{}{}
-	}
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ShareAccessMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ShareAccess edge %s", name)
 }
 
-// ClearShares clears the "shares" edge to the Share entity.
-func (m *UserMutation) ClearShares() {
-	m.clearedshares = true
+// StoragePolicyMutation represents an operation that mutates the StoragePolicy nodes in the graph.
+type StoragePolicyMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	created_at      *time.Time
+	updated_at      *time.Time
+	deleted_at      *time.Time
+	name            *string
+	_type           *string
+	server          *string
+	bucket_name     *string
+	is_private      *bool
+	access_key      *string
+	secret_key      *string
+	max_size        *int64
+	addmax_size     *int64
+	dir_name_rule   *string
+	file_name_rule  *string
+	settings        **types.PolicySetting
+	clearedFields   map[string]struct{}
+	groups          map[int]struct{}
+	removedgroups   map[int]struct{}
+	clearedgroups   bool
+	files           map[int]struct{}
+	removedfiles    map[int]struct{}
+	clearedfiles    bool
+	entities        map[int]struct{}
+	removedentities map[int]struct{}
+	clearedentities bool
+	node            *int
+	clearednode     bool
+	done            bool
+	oldValue        func(context.Context) (*StoragePolicy, error)
+	predicates      []predicate.StoragePolicy
 }
 
-// SharesCleared reports if the "shares" edge to the Share entity was cleared.
-func (m *UserMutation) SharesCleared() bool {
-	return m.clearedshares
-}
+var _ ent.Mutation = (*StoragePolicyMutation)(nil)
 
-// RemoveShareIDs removes the "shares" edge to the Share entity by IDs.
-func (m *UserMutation) RemoveShareIDs(ids ...int) {
-	if m.removedshares == nil {
-		m.removedshares = make(map[int]struct{})
+// storagepolicyOption allows management of the mutation configuration using functional options.
+type storagepolicyOption func(*StoragePolicyMutation)
+
+// newStoragePolicyMutation creates new mutation for the StoragePolicy entity.
+func newStoragePolicyMutation(c config, op Op, opts ...storagepolicyOption) *StoragePolicyMutation {
+	m := &StoragePolicyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeStoragePolicy,
+		clearedFields: make(map[string]struct{}),
 	}
-	for i := range ids {
-		delete(m.shares, ids[i])
-		m.removedshares[ids[i]] = struct{}{}
+	for _, opt := range opts {
+		opt(m)
 	}
+	return m
 }
 
-// RemovedShares returns the removed IDs of the "shares" edge to the Share entity.
-func (m *UserMutation) RemovedSharesIDs() (ids []int) {
-	for id := range m.removedshares {
-		ids = append(ids, id)
+// withStoragePolicyID sets the ID field of the mutation.
+func withStoragePolicyID(id int) storagepolicyOption {
+	return func(m *StoragePolicyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *StoragePolicy
+		)
+		m.oldValue = func(ctx context.Context) (*StoragePolicy, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().StoragePolicy.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return
 }
 
-// SharesIDs returns the "shares" edge IDs in the mutation.
-func (m *UserMutation) SharesIDs() (ids []int) {
-	for id := range m.shares {
-		ids = append(ids, id)
+// withStoragePolicy sets the old StoragePolicy of the mutation.
+func withStoragePolicy(node *StoragePolicy) storagepolicyOption {
+	return func(m *StoragePolicyMutation) {
+		m.oldValue = func(context.Context) (*StoragePolicy, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return
 }
 
-// ResetShares resets all changes to the "shares" edge.
-func (m *UserMutation) ResetShares() {
-	m.shares = nil
-	m.clearedshares = false
-	m.removedshares = nil
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m StoragePolicyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// AddPasskeyIDs adds the "passkey" edge to the Passkey entity by ids.
-func (m *UserMutation) AddPasskeyIDs(ids ...int) {
-	if m.passkey == nil {
-		m.passkey = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.passkey[ids[i]] = struct{}{}
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m StoragePolicyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ClearPasskey clears the "passkey" edge to the Passkey entity.
-func (m *UserMutation) ClearPasskey() {
-	m.clearedpasskey = true
-}
-
-// PasskeyCleared reports if the "passkey" edge to the Passkey entity was cleared.
-func (m *UserMutation) PasskeyCleared() bool {
-	return m.clearedpasskey
-}
-
-// RemovePasskeyIDs removes the "passkey" edge to the Passkey entity by IDs.
-func (m *UserMutation) RemovePasskeyIDs(ids ...int) {
-	if m.removedpasskey == nil {
-		m.removedpasskey = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.passkey, ids[i])
-		m.removedpasskey[ids[i]] = struct{}{}
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *StoragePolicyMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
 	}
+	return *m.id, true
 }
 
-// RemovedPasskey returns the removed IDs of the "passkey" edge to the Passkey entity.
-func (m *UserMutation) RemovedPasskeyIDs() (ids []int) {
-	for id := range m.removedpasskey {
-		ids = append(ids, id)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *StoragePolicyMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().StoragePolicy.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return
 }
 
-// PasskeyIDs returns the "passkey" edge IDs in the mutation.
-func (m *UserMutation) PasskeyIDs() (ids []int) {
-	for id := range m.passkey {
-		ids = append(ids, id)
-	}
-	return
+// SetCreatedAt sets the "created_at" field.
+func (m *StoragePolicyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// ResetPasskey resets all changes to the "passkey" edge.
-func (m *UserMutation) ResetPasskey() {
-	m.passkey = nil
-	m.clearedpasskey = false
-	m.removedpasskey = nil
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *StoragePolicyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddTaskIDs adds the "tasks" edge to the Task entity by ids.
-func (m *UserMutation) AddTaskIDs(ids ...int) {
-	if m.tasks == nil {
-		m.tasks = make(map[int]struct{})
+// OldCreatedAt returns the old "created_at" field's value of the StoragePolicy entity.
+// If the StoragePolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StoragePolicyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.tasks[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
+	return oldValue.CreatedAt, nil
 }
 
-// ClearTasks clears the "tasks" edge to the Task entity.
-func (m *UserMutation) ClearTasks() {
-	m.clearedtasks = true
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *StoragePolicyMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// TasksCleared reports if the "tasks" edge to the Task entity was cleared.
-func (m *UserMutation) TasksCleared() bool {
-	return m.clearedtasks
+// SetUpdatedAt sets the "updated_at" field.
+func (m *StoragePolicyMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// RemoveTaskIDs removes the "tasks" edge to the Task entity by IDs.
-func (m *UserMutation) RemoveTaskIDs(ids ...int) {
-	if m.removedtasks == nil {
-		m.removedtasks = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.tasks, ids[i])
-		m.removedtasks[ids[i]] = struct{}{}
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *StoragePolicyMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedTasks returns the removed IDs of the "tasks" edge to the Task entity.
-func (m *UserMutation) RemovedTasksIDs() (ids []int) {
-	for id := range m.removedtasks {
-		ids = append(ids, id)
+// OldUpdatedAt returns the old "updated_at" field's value of the StoragePolicy entity.
+// If the StoragePolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StoragePolicyMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// TasksIDs returns the "tasks" edge IDs in the mutation.
-func (m *UserMutation) TasksIDs() (ids []int) {
-	for id := range m.tasks {
-		ids = append(ids, id)
-	}
-	return
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *StoragePolicyMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// ResetTasks resets all changes to the "tasks" edge.
-func (m *UserMutation) ResetTasks() {
-	m.tasks = nil
-	m.clearedtasks = false
-	m.removedtasks = nil
+// SetDeletedAt sets the "deleted_at" field.
+func (m *StoragePolicyMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
 }
 
-// AddFseventIDs adds the "fsevents" edge to the FsEvent entity by ids.
-func (m *UserMutation) AddFseventIDs(ids ...int) {
-	if m.fsevents == nil {
-		m.fsevents = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.fsevents[ids[i]] = struct{}{}
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *StoragePolicyMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearFsevents clears the "fsevents" edge to the FsEvent entity.
-func (m *UserMutation) ClearFsevents() {
-	m.clearedfsevents = true
-}
-
-// FseventsCleared reports if the "fsevents" edge to the FsEvent entity was cleared.
-func (m *UserMutation) FseventsCleared() bool {
-	return m.clearedfsevents
-}
-
-// RemoveFseventIDs removes the "fsevents" edge to the FsEvent entity by IDs.
-func (m *UserMutation) RemoveFseventIDs(ids ...int) {
-	if m.removedfsevents == nil {
-		m.removedfsevents = make(map[int]struct{})
+// OldDeletedAt returns the old "deleted_at" field's value of the StoragePolicy entity.
+// If the StoragePolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StoragePolicyMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.fsevents, ids[i])
-		m.removedfsevents[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
 	}
-}
-
-// RemovedFsevents returns the removed IDs of the "fsevents" edge to the FsEvent entity.
-func (m *UserMutation) RemovedFseventsIDs() (ids []int) {
-	for id := range m.removedfsevents {
-		ids = append(ids, id)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
 	}
-	return
+	return oldValue.DeletedAt, nil
 }
 
-// FseventsIDs returns the "fsevents" edge IDs in the mutation.
-func (m *UserMutation) FseventsIDs() (ids []int) {
-	for id := range m.fsevents {
-		ids = append(ids, id)
-	}
-	return
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *StoragePolicyMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[storagepolicy.FieldDeletedAt] = struct{}{}
 }
 
-// ResetFsevents resets all changes to the "fsevents" edge.
-func (m *UserMutation) ResetFsevents() {
-	m.fsevents = nil
-	m.clearedfsevents = false
-	m.removedfsevents = nil
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *StoragePolicyMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[storagepolicy.FieldDeletedAt]
+	return ok
 }
 
-// AddEntityIDs adds the "entities" edge to the Entity entity by ids.
-func (m *UserMutation) AddEntityIDs(ids ...int) {
-	if m.entities == nil {
-		m.entities = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.entities[ids[i]] = struct{}{}
-	}
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *StoragePolicyMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, storagepolicy.FieldDeletedAt)
 }
 
-// ClearEntities clears the "entities" edge to the Entity entity.
-func (m *UserMutation) ClearEntities() {
-	m.clearedentities = true
+// SetName sets the "name" field.
+func (m *StoragePolicyMutation) SetName(s string) {
+	m.name = &s
 }
 
-// EntitiesCleared reports if the "entities" edge to the Entity entity was cleared.
-func (m *UserMutation) EntitiesCleared() bool {
-	return m.clearedentities
+// Name returns the value of the "name" field in the mutation.
+func (m *StoragePolicyMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveEntityIDs removes the "entities" edge to the Entity entity by IDs.
-func (m *UserMutation) RemoveEntityIDs(ids ...int) {
-	if m.removedentities == nil {
-		m.removedentities = make(map[int]struct{})
+// OldName returns the old "name" field's value of the StoragePolicy entity.
+// If the StoragePolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StoragePolicyMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.entities, ids[i])
-		m.removedentities[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
 }
 
-// RemovedEntities returns the removed IDs of the "entities" edge to the Entity entity.
-func (m *UserMutation) RemovedEntitiesIDs() (ids []int) {
-	for id := range m.removedentities {
-		ids = append(ids, id)
-	}
-	return
+// ResetName resets all changes to the "name" field.
+func (m *StoragePolicyMutation) ResetName() {
+	m.name = nil
 }
 
-// EntitiesIDs returns the "entities" edge IDs in the mutation.
-func (m *UserMutation) EntitiesIDs() (ids []int) {
-	for id := range m.entities {
-		ids = append(ids, id)
-	}
-	return
+// SetType sets the "type" field.
+func (m *StoragePolicyMutation) SetType(s string) {
+	m._type = &s
 }
 
-// ResetEntities resets all changes to the "entities" edge.
-func (m *UserMutation) ResetEntities() {
-	m.entities = nil
-	m.clearedentities = false
-	m.removedentities = nil
+// GetType returns the value of the "type" field in the mutation.
+func (m *StoragePolicyMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddOauthGrantIDs adds the "oauth_grants" edge to the OAuthGrant entity by ids.
-func (m *UserMutation) AddOauthGrantIDs(ids ...int) {
-	if m.oauth_grants == nil {
-		m.oauth_grants = make(map[int]struct{})
+// OldType returns the old "type" field's value of the StoragePolicy entity.
+// If the StoragePolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StoragePolicyMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.oauth_grants[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
 	}
+	return oldValue.Type, nil
 }
 
-// ClearOauthGrants clears the "oauth_grants" edge to the OAuthGrant entity.
-func (m *UserMutation) ClearOauthGrants() {
-	m.clearedoauth_grants = true
+// ResetType resets all changes to the "type" field.
+func (m *StoragePolicyMutation) ResetType() {
+	m._type = nil
 }
 
-// OauthGrantsCleared reports if the "oauth_grants" edge to the OAuthGrant entity was cleared.
-func (m *UserMutation) OauthGrantsCleared() bool {
-	return m.clearedoauth_grants
+// SetServer sets the "server" field.
+func (m *StoragePolicyMutation) SetServer(s string) {
+	m.server = &s
 }
 
-// RemoveOauthGrantIDs removes the "oauth_grants" edge to the OAuthGrant entity by IDs.
-func (m *UserMutation) RemoveOauthGrantIDs(ids ...int) {
-	if m.removedoauth_grants == nil {
-		m.removedoauth_grants = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.oauth_grants, ids[i])
-		m.removedoauth_grants[ids[i]] = struct{}{}
+// Server returns the value of the "server" field in the mutation.
+func (m *StoragePolicyMutation) Server() (r string, exists bool) {
+	v := m.server
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedOauthGrants returns the removed IDs of the "oauth_grants" edge to the OAuthGrant entity.
-func (m *UserMutation) RemovedOauthGrantsIDs() (ids []int) {
-	for id := range m.removedoauth_grants {
-		ids = append(ids, id)
+// OldServer returns the old "server" field's value of the StoragePolicy entity.
+// If the StoragePolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StoragePolicyMutation) OldServer(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldServer is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldServer requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldServer: %w", err)
+	}
+	return oldValue.Server, nil
 }
 
-// OauthGrantsIDs returns the "oauth_grants" edge IDs in the mutation.
-func (m *UserMutation) OauthGrantsIDs() (ids []int) {
-	for id := range m.oauth_grants {
-		ids = append(ids, id)
-	}
-	return
+// ClearServer clears the value of the "server" field.
+func (m *StoragePolicyMutation) ClearServer() {
+	m.server = nil
+	m.clearedFields[storagepolicy.FieldServer] = struct{}{}
 }
 
-// ResetOauthGrants resets all changes to the "oauth_grants" edge.
-func (m *UserMutation) ResetOauthGrants() {
-	m.oauth_grants = nil
-	m.clearedoauth_grants = false
-	m.removedoauth_grants = nil
+// ServerCleared returns if the "server" field was cleared in this mutation.
+func (m *StoragePolicyMutation) ServerCleared() bool {
+	_, ok := m.clearedFields[storagepolicy.FieldServer]
+	return ok
 }
 
-// Where appends a list predicates to the UserMutation builder.
-func (m *UserMutation) Where(ps ...predicate.User) {
-	m.predicates = append(m.predicates, ps...)
+// ResetServer resets all changes to the "server" field.
+func (m *StoragePolicyMutation) ResetServer() {
+	m.server = nil
+	delete(m.clearedFields, storagepolicy.FieldServer)
 }
 
-// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+// SetBucketName sets the "bucket_name" field.
+func (m *StoragePolicyMutation) SetBucketName(s string) {
+	m.bucket_name = &s
+}
+
+// BucketName returns the value of the "bucket_name" field in the mutation.
+func (m *StoragePolicyMutation) BucketName() (r string, exists bool) {
+	v := m.bucket_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBucketName returns the old "bucket_name" field's value of the StoragePolicy entity.
+// If the StoragePolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StoragePolicyMutation) OldBucketName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBucketName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBucketName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBucketName: %w", err)
+	}
+	return oldValue.BucketName, nil
+}
+
+// ClearBucketName clears the value of the "bucket_name" field.
+func (m *StoragePolicyMutation) ClearBucketName() {
+	m.bucket_name = nil
+	m.clearedFields[storagepolicy.FieldBucketName] = struct{}{}
+}
+
+// BucketNameCleared returns if the "bucket_name" field was cleared in this mutation.
+func (m *StoragePolicyMutation) BucketNameCleared() bool {
+	_, ok := m.clearedFields[storagepolicy.FieldBucketName]
+	return ok
+}
+
+// ResetBucketName resets all changes to the "bucket_name" field.
+func (m *StoragePolicyMutation) ResetBucketName() {
+	m.bucket_name = nil
+	delete(m.clearedFields, storagepolicy.FieldBucketName)
+}
+
+// SetIsPrivate sets the "is_private" field.
+func (m *StoragePolicyMutation) SetIsPrivate(b bool) {
+	m.is_private = &b
+}
+
+// IsPrivate returns the value of the "is_private" field in the mutation.
+func (m *StoragePolicyMutation) IsPrivate() (r bool, exists bool) {
+	v := m.is_private
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsPrivate returns the old "is_private" field's value of the StoragePolicy entity.
+// If the StoragePolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StoragePolicyMutation) OldIsPrivate(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsPrivate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsPrivate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsPrivate: %w", err)
+	}
+	return oldValue.IsPrivate, nil
+}
+
+// ClearIsPrivate clears the value of the "is_private" field.
+func (m *StoragePolicyMutation) ClearIsPrivate() {
+	m.is_private = nil
+	m.clearedFields[storagepolicy.FieldIsPrivate] = struct{}{}
+}
+
+// IsPrivateCleared returns if the "is_private" field was cleared in this mutation.
+func (m *StoragePolicyMutation) IsPrivateCleared() bool {
+	_, ok := m.clearedFields[storagepolicy.FieldIsPrivate]
+	return ok
+}
+
+// ResetIsPrivate resets all changes to the "is_private" field.
+func (m *StoragePolicyMutation) ResetIsPrivate() {
+	m.is_private = nil
+	delete(m.clearedFields, storagepolicy.FieldIsPrivate)
+}
+
+// SetAccessKey sets the "access_key" field.
+func (m *StoragePolicyMutation) SetAccessKey(s string) {
+	m.access_key = &s
+}
+
+// AccessKey returns the value of the "access_key" field in the mutation.
+func (m *StoragePolicyMutation) AccessKey() (r string, exists bool) {
+	v := m.access_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccessKey returns the old "access_key" field's value of the StoragePolicy entity.
+// If the StoragePolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StoragePolicyMutation) OldAccessKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccessKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccessKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccessKey: %w", err)
+	}
+	return oldValue.AccessKey, nil
+}
+
+// ClearAccessKey clears the value of the "access_key" field.
+func (m *StoragePolicyMutation) ClearAccessKey() {
+	m.access_key = nil
+	m.clearedFields[storagepolicy.FieldAccessKey] = struct{}{}
+}
+
+// AccessKeyCleared returns if the "access_key" field was cleared in this mutation.
+func (m *StoragePolicyMutation) AccessKeyCleared() bool {
+	_, ok := m.clearedFields[storagepolicy.FieldAccessKey]
+	return ok
+}
+
+// ResetAccessKey resets all changes to the "access_key" field.
+func (m *StoragePolicyMutation) ResetAccessKey() {
+	m.access_key = nil
+	delete(m.clearedFields, storagepolicy.FieldAccessKey)
+}
+
+// SetSecretKey sets the "secret_key" field.
+func (m *StoragePolicyMutation) SetSecretKey(s string) {
+	m.secret_key = &s
+}
+
+// SecretKey returns the value of the "secret_key" field in the mutation.
+func (m *StoragePolicyMutation) SecretKey() (r string, exists bool) {
+	v := m.secret_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSecretKey returns the old "secret_key" field's value of the StoragePolicy entity.
+// If the StoragePolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StoragePolicyMutation) OldSecretKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSecretKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSecretKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecretKey: %w", err)
+	}
+	return oldValue.SecretKey, nil
+}
+
+// ClearSecretKey clears the value of the "secret_key" field.
+func (m *StoragePolicyMutation) ClearSecretKey() {
+	m.secret_key = nil
+	m.clearedFields[storagepolicy.FieldSecretKey] = struct{}{}
+}
+
+// SecretKeyCleared returns if the "secret_key" field was cleared in this mutation.
+func (m *StoragePolicyMutation) SecretKeyCleared() bool {
+	_, ok := m.clearedFields[storagepolicy.FieldSecretKey]
+	return ok
+}
+
+// ResetSecretKey resets all changes to the "secret_key" field.
+func (m *StoragePolicyMutation) ResetSecretKey() {
+	m.secret_key = nil
+	delete(m.clearedFields, storagepolicy.FieldSecretKey)
+}
+
+// SetMaxSize sets the "max_size" field.
+func (m *StoragePolicyMutation) SetMaxSize(i int64) {
+	m.max_size = &i
+	m.addmax_size = nil
+}
+
+// MaxSize returns the value of the "max_size" field in the mutation.
+func (m *StoragePolicyMutation) MaxSize() (r int64, exists bool) {
+	v := m.max_size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxSize returns the old "max_size" field's value of the StoragePolicy entity.
+// If the StoragePolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StoragePolicyMutation) OldMaxSize(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxSize: %w", err)
+	}
+	return oldValue.MaxSize, nil
+}
+
+// AddMaxSize adds i to the "max_size" field.
+func (m *StoragePolicyMutation) AddMaxSize(i int64) {
+	if m.addmax_size != nil {
+		*m.addmax_size += i
+	} else {
+		m.addmax_size = &i
+	}
+}
+
+// AddedMaxSize returns the value that was added to the "max_size" field in this mutation.
+func (m *StoragePolicyMutation) AddedMaxSize() (r int64, exists bool) {
+	v := m.addmax_size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMaxSize clears the value of the "max_size" field.
+func (m *StoragePolicyMutation) ClearMaxSize() {
+	m.max_size = nil
+	m.addmax_size = nil
+	m.clearedFields[storagepolicy.FieldMaxSize] = struct{}{}
+}
+
+// MaxSizeCleared returns if the "max_size" field was cleared in this mutation.
+func (m *StoragePolicyMutation) MaxSizeCleared() bool {
+	_, ok := m.clearedFields[storagepolicy.FieldMaxSize]
+	return ok
+}
+
+// ResetMaxSize resets all changes to the "max_size" field.
+func (m *StoragePolicyMutation) ResetMaxSize() {
+	m.max_size = nil
+	m.addmax_size = nil
+	delete(m.clearedFields, storagepolicy.FieldMaxSize)
+}
+
+// SetDirNameRule sets the "dir_name_rule" field.
+func (m *StoragePolicyMutation) SetDirNameRule(s string) {
+	m.dir_name_rule = &s
+}
+
+// DirNameRule returns the value of the "dir_name_rule" field in the mutation.
+func (m *StoragePolicyMutation) DirNameRule() (r string, exists bool) {
+	v := m.dir_name_rule
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDirNameRule returns the old "dir_name_rule" field's value of the StoragePolicy entity.
+// If the StoragePolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StoragePolicyMutation) OldDirNameRule(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDirNameRule is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDirNameRule requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDirNameRule: %w", err)
+	}
+	return oldValue.DirNameRule, nil
+}
+
+// ClearDirNameRule clears the value of the "dir_name_rule" field.
+func (m *StoragePolicyMutation) ClearDirNameRule() {
+	m.dir_name_rule = nil
+	m.clearedFields[storagepolicy.FieldDirNameRule] = struct{}{}
+}
+
+// DirNameRuleCleared returns if the "dir_name_rule" field was cleared in this mutation.
+func (m *StoragePolicyMutation) DirNameRuleCleared() bool {
+	_, ok := m.clearedFields[storagepolicy.FieldDirNameRule]
+	return ok
+}
+
+// ResetDirNameRule resets all changes to the "dir_name_rule" field.
+func (m *StoragePolicyMutation) ResetDirNameRule() {
+	m.dir_name_rule = nil
+	delete(m.clearedFields, storagepolicy.FieldDirNameRule)
+}
+
+// SetFileNameRule sets the "file_name_rule" field.
+func (m *StoragePolicyMutation) SetFileNameRule(s string) {
+	m.file_name_rule = &s
+}
+
+// FileNameRule returns the value of the "file_name_rule" field in the mutation.
+func (m *StoragePolicyMutation) FileNameRule() (r string, exists bool) {
+	v := m.file_name_rule
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFileNameRule returns the old "file_name_rule" field's value of the StoragePolicy entity.
+// If the StoragePolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StoragePolicyMutation) OldFileNameRule(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFileNameRule is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFileNameRule requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFileNameRule: %w", err)
+	}
+	return oldValue.FileNameRule, nil
+}
+
+// ClearFileNameRule clears the value of the "file_name_rule" field.
+func (m *StoragePolicyMutation) ClearFileNameRule() {
+	m.file_name_rule = nil
+	m.clearedFields[storagepolicy.FieldFileNameRule] = struct{}{}
+}
+
+// FileNameRuleCleared returns if the "file_name_rule" field was cleared in this mutation.
+func (m *StoragePolicyMutation) FileNameRuleCleared() bool {
+	_, ok := m.clearedFields[storagepolicy.FieldFileNameRule]
+	return ok
+}
+
+// ResetFileNameRule resets all changes to the "file_name_rule" field.
+func (m *StoragePolicyMutation) ResetFileNameRule() {
+	m.file_name_rule = nil
+	delete(m.clearedFields, storagepolicy.FieldFileNameRule)
+}
+
+// SetSettings sets the "settings" field.
+func (m *StoragePolicyMutation) SetSettings(ts *types.PolicySetting) {
+	m.settings = &ts
+}
+
+// Settings returns the value of the "settings" field in the mutation.
+func (m *StoragePolicyMutation) Settings() (r *types.PolicySetting, exists bool) {
+	v := m.settings
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSettings returns the old "settings" field's value of the StoragePolicy entity.
+// If the StoragePolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StoragePolicyMutation) OldSettings(ctx context.Context) (v *types.PolicySetting, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSettings is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSettings requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSettings: %w", err)
+	}
+	return oldValue.Settings, nil
+}
+
+// ClearSettings clears the value of the "settings" field.
+func (m *StoragePolicyMutation) ClearSettings() {
+	m.settings = nil
+	m.clearedFields[storagepolicy.FieldSettings] = struct{}{}
+}
+
+// SettingsCleared returns if the "settings" field was cleared in this mutation.
+func (m *StoragePolicyMutation) SettingsCleared() bool {
+	_, ok := m.clearedFields[storagepolicy.FieldSettings]
+	return ok
+}
+
+// ResetSettings resets all changes to the "settings" field.
+func (m *StoragePolicyMutation) ResetSettings() {
+	m.settings = nil
+	delete(m.clearedFields, storagepolicy.FieldSettings)
+}
+
+// SetNodeID sets the "node_id" field.
+func (m *StoragePolicyMutation) SetNodeID(i int) {
+	m.node = &i
+}
+
+// NodeID returns the value of the "node_id" field in the mutation.
+func (m *StoragePolicyMutation) NodeID() (r int, exists bool) {
+	v := m.node
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNodeID returns the old "node_id" field's value of the StoragePolicy entity.
+// If the StoragePolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StoragePolicyMutation) OldNodeID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNodeID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNodeID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNodeID: %w", err)
+	}
+	return oldValue.NodeID, nil
+}
+
+// ClearNodeID clears the value of the "node_id" field.
+func (m *StoragePolicyMutation) ClearNodeID() {
+	m.node = nil
+	m.clearedFields[storagepolicy.FieldNodeID] = struct{}{}
+}
+
+// NodeIDCleared returns if the "node_id" field was cleared in this mutation.
+func (m *StoragePolicyMutation) NodeIDCleared() bool {
+	_, ok := m.clearedFields[storagepolicy.FieldNodeID]
+	return ok
+}
+
+// ResetNodeID resets all changes to the "node_id" field.
+func (m *StoragePolicyMutation) ResetNodeID() {
+	m.node = nil
+	delete(m.clearedFields, storagepolicy.FieldNodeID)
+}
+
+// AddGroupIDs adds the "groups" edge to the Group entity by ids.
+func (m *StoragePolicyMutation) AddGroupIDs(ids ...int) {
+	if m.groups == nil {
+		m.groups = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.groups[ids[i]] = struct{}{}
+	}
+}
+
+// ClearGroups clears the "groups" edge to the Group entity.
+func (m *StoragePolicyMutation) ClearGroups() {
+	m.clearedgroups = true
+}
+
+// GroupsCleared reports if the "groups" edge to the Group entity was cleared.
+func (m *StoragePolicyMutation) GroupsCleared() bool {
+	return m.clearedgroups
+}
+
+// RemoveGroupIDs removes the "groups" edge to the Group entity by IDs.
+func (m *StoragePolicyMutation) RemoveGroupIDs(ids ...int) {
+	if m.removedgroups == nil {
+		m.removedgroups = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.groups, ids[i])
+		m.removedgroups[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedGroups returns the removed IDs of the "groups" edge to the Group entity.
+func (m *StoragePolicyMutation) RemovedGroupsIDs() (ids []int) {
+	for id := range m.removedgroups {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// GroupsIDs returns the "groups" edge IDs in the mutation.
+func (m *StoragePolicyMutation) GroupsIDs() (ids []int) {
+	for id := range m.groups {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetGroups resets all changes to the "groups" edge.
+func (m *StoragePolicyMutation) ResetGroups() {
+	m.groups = nil
+	m.clearedgroups = false
+	m.removedgroups = nil
+}
+
+// AddFileIDs adds the "files" edge to the File entity by ids.
+func (m *StoragePolicyMutation) AddFileIDs(ids ...int) {
+	if m.files == nil {
+		m.files = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.files[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFiles clears the "files" edge to the File entity.
+func (m *StoragePolicyMutation) ClearFiles() {
+	m.clearedfiles = true
+}
+
+// FilesCleared reports if the "files" edge to the File entity was cleared.
+func (m *StoragePolicyMutation) FilesCleared() bool {
+	return m.clearedfiles
+}
+
+// RemoveFileIDs removes the "files" edge to the File entity by IDs.
+func (m *StoragePolicyMutation) RemoveFileIDs(ids ...int) {
+	if m.removedfiles == nil {
+		m.removedfiles = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.files, ids[i])
+		m.removedfiles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFiles returns the removed IDs of the "files" edge to the File entity.
+func (m *StoragePolicyMutation) RemovedFilesIDs() (ids []int) {
+	for id := range m.removedfiles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FilesIDs returns the "files" edge IDs in the mutation.
+func (m *StoragePolicyMutation) FilesIDs() (ids []int) {
+	for id := range m.files {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFiles resets all changes to the "files" edge.
+func (m *StoragePolicyMutation) ResetFiles() {
+	m.files = nil
+	m.clearedfiles = false
+	m.removedfiles = nil
+}
+
+// AddEntityIDs adds the "entities" edge to the Entity entity by ids.
+func (m *StoragePolicyMutation) AddEntityIDs(ids ...int) {
+	if m.entities == nil {
+		m.entities = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.entities[ids[i]] = struct{}{}
+	}
+}
+
+// ClearEntities clears the "entities" edge to the Entity entity.
+func (m *StoragePolicyMutation) ClearEntities() {
+	m.clearedentities = true
+}
+
+// EntitiesCleared reports if the "entities" edge to the Entity entity was cleared.
+func (m *StoragePolicyMutation) EntitiesCleared() bool {
+	return m.clearedentities
+}
+
+// RemoveEntityIDs removes the "entities" edge to the Entity entity by IDs.
+func (m *StoragePolicyMutation) RemoveEntityIDs(ids ...int) {
+	if m.removedentities == nil {
+		m.removedentities = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.entities, ids[i])
+		m.removedentities[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedEntities returns the removed IDs of the "entities" edge to the Entity entity.
+func (m *StoragePolicyMutation) RemovedEntitiesIDs() (ids []int) {
+	for id := range m.removedentities {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// EntitiesIDs returns the "entities" edge IDs in the mutation.
+func (m *StoragePolicyMutation) EntitiesIDs() (ids []int) {
+	for id := range m.entities {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetEntities resets all changes to the "entities" edge.
+func (m *StoragePolicyMutation) ResetEntities() {
+	m.entities = nil
+	m.clearedentities = false
+	m.removedentities = nil
+}
+
+// ClearNode clears the "node" edge to the Node entity.
+func (m *StoragePolicyMutation) ClearNode() {
+	m.clearednode = true
+	m.clearedFields[storagepolicy.FieldNodeID] = struct{}{}
+}
+
+// NodeCleared reports if the "node" edge to the Node entity was cleared.
+func (m *StoragePolicyMutation) NodeCleared() bool {
+	return m.NodeIDCleared() || m.clearednode
+}
+
+// NodeIDs returns the "node" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// NodeID instead. It exists only for internal usage by the builders.
+func (m *StoragePolicyMutation) NodeIDs() (ids []int) {
+	if id := m.node; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetNode resets all changes to the "node" edge.
+func (m *StoragePolicyMutation) ResetNode() {
+	m.node = nil
+	m.clearednode = false
+}
+
+// Where appends a list predicates to the StoragePolicyMutation builder.
+func (m *StoragePolicyMutation) Where(ps ...predicate.StoragePolicy) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the StoragePolicyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *StoragePolicyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.StoragePolicy, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *StoragePolicyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *StoragePolicyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (StoragePolicy).
+func (m *StoragePolicyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *StoragePolicyMutation) Fields() []string {
+	fields := make([]string, 0, 15)
+	if m.created_at != nil {
+		fields = append(fields, storagepolicy.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, storagepolicy.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, storagepolicy.FieldDeletedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, storagepolicy.FieldName)
+	}
+	if m._type != nil {
+		fields = append(fields, storagepolicy.FieldType)
+	}
+	if m.server != nil {
+		fields = append(fields, storagepolicy.FieldServer)
+	}
+	if m.bucket_name != nil {
+		fields = append(fields, storagepolicy.FieldBucketName)
+	}
+	if m.is_private != nil {
+		fields = append(fields, storagepolicy.FieldIsPrivate)
+	}
+	if m.access_key != nil {
+		fields = append(fields, storagepolicy.FieldAccessKey)
+	}
+	if m.secret_key != nil {
+		fields = append(fields, storagepolicy.FieldSecretKey)
+	}
+	if m.max_size != nil {
+		fields = append(fields, storagepolicy.FieldMaxSize)
+	}
+	if m.dir_name_rule != nil {
+		fields = append(fields, storagepolicy.FieldDirNameRule)
+	}
+	if m.file_name_rule != nil {
+		fields = append(fields, storagepolicy.FieldFileNameRule)
+	}
+	if m.settings != nil {
+		fields = append(fields, storagepolicy.FieldSettings)
+	}
+	if m.node != nil {
+		fields = append(fields, storagepolicy.FieldNodeID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *StoragePolicyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case storagepolicy.FieldCreatedAt:
+		return m.CreatedAt()
+	case storagepolicy.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case storagepolicy.FieldDeletedAt:
+		return m.DeletedAt()
+	case storagepolicy.FieldName:
+		return m.Name()
+	case storagepolicy.FieldType:
+		return m.GetType()
+	case storagepolicy.FieldServer:
+		return m.Server()
+	case storagepolicy.FieldBucketName:
+		return m.BucketName()
+	case storagepolicy.FieldIsPrivate:
+		return m.IsPrivate()
+	case storagepolicy.FieldAccessKey:
+		return m.AccessKey()
+	case storagepolicy.FieldSecretKey:
+		return m.SecretKey()
+	case storagepolicy.FieldMaxSize:
+		return m.MaxSize()
+	case storagepolicy.FieldDirNameRule:
+		return m.DirNameRule()
+	case storagepolicy.FieldFileNameRule:
+		return m.FileNameRule()
+	case storagepolicy.FieldSettings:
+		return m.Settings()
+	case storagepolicy.FieldNodeID:
+		return m.NodeID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *StoragePolicyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case storagepolicy.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case storagepolicy.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case storagepolicy.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case storagepolicy.FieldName:
+		return m.OldName(ctx)
+	case storagepolicy.FieldType:
+		return m.OldType(ctx)
+	case storagepolicy.FieldServer:
+		return m.OldServer(ctx)
+	case storagepolicy.FieldBucketName:
+		return m.OldBucketName(ctx)
+	case storagepolicy.FieldIsPrivate:
+		return m.OldIsPrivate(ctx)
+	case storagepolicy.FieldAccessKey:
+		return m.OldAccessKey(ctx)
+	case storagepolicy.FieldSecretKey:
+		return m.OldSecretKey(ctx)
+	case storagepolicy.FieldMaxSize:
+		return m.OldMaxSize(ctx)
+	case storagepolicy.FieldDirNameRule:
+		return m.OldDirNameRule(ctx)
+	case storagepolicy.FieldFileNameRule:
+		return m.OldFileNameRule(ctx)
+	case storagepolicy.FieldSettings:
+		return m.OldSettings(ctx)
+	case storagepolicy.FieldNodeID:
+		return m.OldNodeID(ctx)
+	}
+	return nil, fmt.Errorf("unknown StoragePolicy field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *StoragePolicyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case storagepolicy.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case storagepolicy.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case storagepolicy.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case storagepolicy.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case storagepolicy.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case storagepolicy.FieldServer:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetServer(v)
+		return nil
+	case storagepolicy.FieldBucketName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBucketName(v)
+		return nil
+	case storagepolicy.FieldIsPrivate:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsPrivate(v)
+		return nil
+	case storagepolicy.FieldAccessKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccessKey(v)
+		return nil
+	case storagepolicy.FieldSecretKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecretKey(v)
+		return nil
+	case storagepolicy.FieldMaxSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxSize(v)
+		return nil
+	case storagepolicy.FieldDirNameRule:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDirNameRule(v)
+		return nil
+	case storagepolicy.FieldFileNameRule:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFileNameRule(v)
+		return nil
+	case storagepolicy.FieldSettings:
+		v, ok := value.(*types.PolicySetting)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSettings(v)
+		return nil
+	case storagepolicy.FieldNodeID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNodeID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown StoragePolicy field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *StoragePolicyMutation) AddedFields() []string {
+	var fields []string
+	if m.addmax_size != nil {
+		fields = append(fields, storagepolicy.FieldMaxSize)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *StoragePolicyMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case storagepolicy.FieldMaxSize:
+		return m.AddedMaxSize()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *StoragePolicyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case storagepolicy.FieldMaxSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxSize(v)
+		return nil
+	}
+	return fmt.Errorf("unknown StoragePolicy numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *StoragePolicyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(storagepolicy.FieldDeletedAt) {
+		fields = append(fields, storagepolicy.FieldDeletedAt)
+	}
+	if m.FieldCleared(storagepolicy.FieldServer) {
+		fields = append(fields, storagepolicy.FieldServer)
+	}
+	if m.FieldCleared(storagepolicy.FieldBucketName) {
+		fields = append(fields, storagepolicy.FieldBucketName)
+	}
+	if m.FieldCleared(storagepolicy.FieldIsPrivate) {
+		fields = append(fields, storagepolicy.FieldIsPrivate)
+	}
+	if m.FieldCleared(storagepolicy.FieldAccessKey) {
+		fields = append(fields, storagepolicy.FieldAccessKey)
+	}
+	if m.FieldCleared(storagepolicy.FieldSecretKey) {
+		fields = append(fields, storagepolicy.FieldSecretKey)
+	}
+	if m.FieldCleared(storagepolicy.FieldMaxSize) {
+		fields = append(fields, storagepolicy.FieldMaxSize)
+	}
+	if m.FieldCleared(storagepolicy.FieldDirNameRule) {
+		fields = append(fields, storagepolicy.FieldDirNameRule)
+	}
+	if m.FieldCleared(storagepolicy.FieldFileNameRule) {
+		fields = append(fields, storagepolicy.FieldFileNameRule)
+	}
+	if m.FieldCleared(storagepolicy.FieldSettings) {
+		fields = append(fields, storagepolicy.FieldSettings)
+	}
+	if m.FieldCleared(storagepolicy.FieldNodeID) {
+		fields = append(fields, storagepolicy.FieldNodeID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *StoragePolicyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *StoragePolicyMutation) ClearField(name string) error {
+	switch name {
+	case storagepolicy.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case storagepolicy.FieldServer:
+		m.ClearServer()
+		return nil
+	case storagepolicy.FieldBucketName:
+		m.ClearBucketName()
+		return nil
+	case storagepolicy.FieldIsPrivate:
+		m.ClearIsPrivate()
+		return nil
+	case storagepolicy.FieldAccessKey:
+		m.ClearAccessKey()
+		return nil
+	case storagepolicy.FieldSecretKey:
+		m.ClearSecretKey()
+		return nil
+	case storagepolicy.FieldMaxSize:
+		m.ClearMaxSize()
+		return nil
+	case storagepolicy.FieldDirNameRule:
+		m.ClearDirNameRule()
+		return nil
+	case storagepolicy.FieldFileNameRule:
+		m.ClearFileNameRule()
+		return nil
+	case storagepolicy.FieldSettings:
+		m.ClearSettings()
+		return nil
+	case storagepolicy.FieldNodeID:
+		m.ClearNodeID()
+		return nil
+	}
+	return fmt.Errorf("unknown StoragePolicy nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *StoragePolicyMutation) ResetField(name string) error {
+	switch name {
+	case storagepolicy.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case storagepolicy.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case storagepolicy.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case storagepolicy.FieldName:
+		m.ResetName()
+		return nil
+	case storagepolicy.FieldType:
+		m.ResetType()
+		return nil
+	case storagepolicy.FieldServer:
+		m.ResetServer()
+		return nil
+	case storagepolicy.FieldBucketName:
+		m.ResetBucketName()
+		return nil
+	case storagepolicy.FieldIsPrivate:
+		m.ResetIsPrivate()
+		return nil
+	case storagepolicy.FieldAccessKey:
+		m.ResetAccessKey()
+		return nil
+	case storagepolicy.FieldSecretKey:
+		m.ResetSecretKey()
+		return nil
+	case storagepolicy.FieldMaxSize:
+		m.ResetMaxSize()
+		return nil
+	case storagepolicy.FieldDirNameRule:
+		m.ResetDirNameRule()
+		return nil
+	case storagepolicy.FieldFileNameRule:
+		m.ResetFileNameRule()
+		return nil
+	case storagepolicy.FieldSettings:
+		m.ResetSettings()
+		return nil
+	case storagepolicy.FieldNodeID:
+		m.ResetNodeID()
+		return nil
+	}
+	return fmt.Errorf("unknown StoragePolicy field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *StoragePolicyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.groups != nil {
+		edges = append(edges, storagepolicy.EdgeGroups)
+	}
+	if m.files != nil {
+		edges = append(edges, storagepolicy.EdgeFiles)
+	}
+	if m.entities != nil {
+		edges = append(edges, storagepolicy.EdgeEntities)
+	}
+	if m.node != nil {
+		edges = append(edges, storagepolicy.EdgeNode)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *StoragePolicyMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case storagepolicy.EdgeGroups:
+		ids := make([]ent.Value, 0, len(m.groups))
+		for id := range m.groups {
+			ids = append(ids, id)
+		}
+		return ids
+	case storagepolicy.EdgeFiles:
+		ids := make([]ent.Value, 0, len(m.files))
+		for id := range m.files {
+			ids = append(ids, id)
+		}
+		return ids
+	case storagepolicy.EdgeEntities:
+		ids := make([]ent.Value, 0, len(m.entities))
+		for id := range m.entities {
+			ids = append(ids, id)
+		}
+		return ids
+	case storagepolicy.EdgeNode:
+		if id := m.node; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *StoragePolicyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedgroups != nil {
+		edges = append(edges, storagepolicy.EdgeGroups)
+	}
+	if m.removedfiles != nil {
+		edges = append(edges, storagepolicy.EdgeFiles)
+	}
+	if m.removedentities != nil {
+		edges = append(edges, storagepolicy.EdgeEntities)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *StoragePolicyMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case storagepolicy.EdgeGroups:
+		ids := make([]ent.Value, 0, len(m.removedgroups))
+		for id := range m.removedgroups {
+			ids = append(ids, id)
+		}
+		return ids
+	case storagepolicy.EdgeFiles:
+		ids := make([]ent.Value, 0, len(m.removedfiles))
+		for id := range m.removedfiles {
+			ids = append(ids, id)
+		}
+		return ids
+	case storagepolicy.EdgeEntities:
+		ids := make([]ent.Value, 0, len(m.removedentities))
+		for id := range m.removedentities {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *StoragePolicyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedgroups {
+		edges = append(edges, storagepolicy.EdgeGroups)
+	}
+	if m.clearedfiles {
+		edges = append(edges, storagepolicy.EdgeFiles)
+	}
+	if m.clearedentities {
+		edges = append(edges, storagepolicy.EdgeEntities)
+	}
+	if m.clearednode {
+		edges = append(edges, storagepolicy.EdgeNode)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *StoragePolicyMutation) EdgeCleared(name string) bool {
+	switch name {
+	case storagepolicy.EdgeGroups:
+		return m.clearedgroups
+	case storagepolicy.EdgeFiles:
+		return m.clearedfiles
+	case storagepolicy.EdgeEntities:
+		return m.clearedentities
+	case storagepolicy.EdgeNode:
+		return m.clearednode
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *StoragePolicyMutation) ClearEdge(name string) error {
+	switch name {
+	case storagepolicy.EdgeNode:
+		m.ClearNode()
+		return nil
+	}
+	return fmt.Errorf("unknown StoragePolicy unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *StoragePolicyMutation) ResetEdge(name string) error {
+	switch name {
+	case storagepolicy.EdgeGroups:
+		m.ResetGroups()
+		return nil
+	case storagepolicy.EdgeFiles:
+		m.ResetFiles()
+		return nil
+	case storagepolicy.EdgeEntities:
+		m.ResetEntities()
+		return nil
+	case storagepolicy.EdgeNode:
+		m.ResetNode()
+		return nil
+	}
+	return fmt.Errorf("unknown StoragePolicy edge %s", name)
+}
+
+// TaskMutation represents an operation that mutates the Task nodes in the graph.
+type TaskMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	created_at      *time.Time
+	updated_at      *time.Time
+	deleted_at      *time.Time
+	_type           *string
+	status          *task.Status
+	public_state    **types.TaskPublicState
+	private_state   *string
+	correlation_id  *uuid.UUID
+	lease_owner     *string
+	lease_expire_at *time.Time
+	clearedFields   map[string]struct{}
+	user            *int
+	cleareduser     bool
+	done            bool
+	oldValue        func(context.Context) (*Task, error)
+	predicates      []predicate.Task
+}
+
+var _ ent.Mutation = (*TaskMutation)(nil)
+
+// taskOption allows management of the mutation configuration using functional options.
+type taskOption func(*TaskMutation)
+
+// newTaskMutation creates new mutation for the Task entity.
+func newTaskMutation(c config, op Op, opts ...taskOption) *TaskMutation {
+	m := &TaskMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTask,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTaskID sets the ID field of the mutation.
+func withTaskID(id int) taskOption {
+	return func(m *TaskMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Task
+		)
+		m.oldValue = func(ctx context.Context) (*Task, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Task.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTask sets the old Task of the mutation.
+func withTask(node *Task) taskOption {
+	return func(m *TaskMutation) {
+		m.oldValue = func(context.Context) (*Task, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TaskMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TaskMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TaskMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TaskMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Task.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TaskMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TaskMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TaskMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *TaskMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *TaskMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *TaskMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *TaskMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *TaskMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *TaskMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[task.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *TaskMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[task.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *TaskMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, task.FieldDeletedAt)
+}
+
+// SetType sets the "type" field.
+func (m *TaskMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *TaskMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *TaskMutation) ResetType() {
+	m._type = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *TaskMutation) SetStatus(t task.Status) {
+	m.status = &t
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *TaskMutation) Status() (r task.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldStatus(ctx context.Context) (v task.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *TaskMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetPublicState sets the "public_state" field.
+func (m *TaskMutation) SetPublicState(tps *types.TaskPublicState) {
+	m.public_state = &tps
+}
+
+// PublicState returns the value of the "public_state" field in the mutation.
+func (m *TaskMutation) PublicState() (r *types.TaskPublicState, exists bool) {
+	v := m.public_state
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublicState returns the old "public_state" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldPublicState(ctx context.Context) (v *types.TaskPublicState, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublicState is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublicState requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublicState: %w", err)
+	}
+	return oldValue.PublicState, nil
+}
+
+// ResetPublicState resets all changes to the "public_state" field.
+func (m *TaskMutation) ResetPublicState() {
+	m.public_state = nil
+}
+
+// SetPrivateState sets the "private_state" field.
+func (m *TaskMutation) SetPrivateState(s string) {
+	m.private_state = &s
+}
+
+// PrivateState returns the value of the "private_state" field in the mutation.
+func (m *TaskMutation) PrivateState() (r string, exists bool) {
+	v := m.private_state
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrivateState returns the old "private_state" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldPrivateState(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrivateState is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrivateState requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrivateState: %w", err)
+	}
+	return oldValue.PrivateState, nil
+}
+
+// ClearPrivateState clears the value of the "private_state" field.
+func (m *TaskMutation) ClearPrivateState() {
+	m.private_state = nil
+	m.clearedFields[task.FieldPrivateState] = struct{}{}
+}
+
+// PrivateStateCleared returns if the "private_state" field was cleared in this mutation.
+func (m *TaskMutation) PrivateStateCleared() bool {
+	_, ok := m.clearedFields[task.FieldPrivateState]
+	return ok
+}
+
+// ResetPrivateState resets all changes to the "private_state" field.
+func (m *TaskMutation) ResetPrivateState() {
+	m.private_state = nil
+	delete(m.clearedFields, task.FieldPrivateState)
+}
+
+// SetCorrelationID sets the "correlation_id" field.
+func (m *TaskMutation) SetCorrelationID(u uuid.UUID) {
+	m.correlation_id = &u
+}
+
+// CorrelationID returns the value of the "correlation_id" field in the mutation.
+func (m *TaskMutation) CorrelationID() (r uuid.UUID, exists bool) {
+	v := m.correlation_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCorrelationID returns the old "correlation_id" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldCorrelationID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCorrelationID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCorrelationID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCorrelationID: %w", err)
+	}
+	return oldValue.CorrelationID, nil
+}
+
+// ClearCorrelationID clears the value of the "correlation_id" field.
+func (m *TaskMutation) ClearCorrelationID() {
+	m.correlation_id = nil
+	m.clearedFields[task.FieldCorrelationID] = struct{}{}
+}
+
+// CorrelationIDCleared returns if the "correlation_id" field was cleared in this mutation.
+func (m *TaskMutation) CorrelationIDCleared() bool {
+	_, ok := m.clearedFields[task.FieldCorrelationID]
+	return ok
+}
+
+// ResetCorrelationID resets all changes to the "correlation_id" field.
+func (m *TaskMutation) ResetCorrelationID() {
+	m.correlation_id = nil
+	delete(m.clearedFields, task.FieldCorrelationID)
+}
+
+// SetUserTasks sets the "user_tasks" field.
+func (m *TaskMutation) SetUserTasks(i int) {
+	m.user = &i
+}
+
+// UserTasks returns the value of the "user_tasks" field in the mutation.
+func (m *TaskMutation) UserTasks() (r int, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserTasks returns the old "user_tasks" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldUserTasks(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserTasks is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserTasks requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserTasks: %w", err)
+	}
+	return oldValue.UserTasks, nil
+}
+
+// ClearUserTasks clears the value of the "user_tasks" field.
+func (m *TaskMutation) ClearUserTasks() {
+	m.user = nil
+	m.clearedFields[task.FieldUserTasks] = struct{}{}
+}
+
+// UserTasksCleared returns if the "user_tasks" field was cleared in this mutation.
+func (m *TaskMutation) UserTasksCleared() bool {
+	_, ok := m.clearedFields[task.FieldUserTasks]
+	return ok
+}
+
+// ResetUserTasks resets all changes to the "user_tasks" field.
+func (m *TaskMutation) ResetUserTasks() {
+	m.user = nil
+	delete(m.clearedFields, task.FieldUserTasks)
+}
+
+// SetLeaseOwner sets the "lease_owner" field.
+func (m *TaskMutation) SetLeaseOwner(s string) {
+	m.lease_owner = &s
+}
+
+// LeaseOwner returns the value of the "lease_owner" field in the mutation.
+func (m *TaskMutation) LeaseOwner() (r string, exists bool) {
+	v := m.lease_owner
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLeaseOwner returns the old "lease_owner" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldLeaseOwner(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLeaseOwner is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLeaseOwner requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLeaseOwner: %w", err)
+	}
+	return oldValue.LeaseOwner, nil
+}
+
+// ClearLeaseOwner clears the value of the "lease_owner" field.
+func (m *TaskMutation) ClearLeaseOwner() {
+	m.lease_owner = nil
+	m.clearedFields[task.FieldLeaseOwner] = struct{}{}
+}
+
+// LeaseOwnerCleared returns if the "lease_owner" field was cleared in this mutation.
+func (m *TaskMutation) LeaseOwnerCleared() bool {
+	_, ok := m.clearedFields[task.FieldLeaseOwner]
+	return ok
+}
+
+// ResetLeaseOwner resets all changes to the "lease_owner" field.
+func (m *TaskMutation) ResetLeaseOwner() {
+	m.lease_owner = nil
+	delete(m.clearedFields, task.FieldLeaseOwner)
+}
+
+// SetLeaseExpireAt sets the "lease_expire_at" field.
+func (m *TaskMutation) SetLeaseExpireAt(t time.Time) {
+	m.lease_expire_at = &t
+}
+
+// LeaseExpireAt returns the value of the "lease_expire_at" field in the mutation.
+func (m *TaskMutation) LeaseExpireAt() (r time.Time, exists bool) {
+	v := m.lease_expire_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLeaseExpireAt returns the old "lease_expire_at" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldLeaseExpireAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLeaseExpireAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLeaseExpireAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLeaseExpireAt: %w", err)
+	}
+	return oldValue.LeaseExpireAt, nil
+}
+
+// ClearLeaseExpireAt clears the value of the "lease_expire_at" field.
+func (m *TaskMutation) ClearLeaseExpireAt() {
+	m.lease_expire_at = nil
+	m.clearedFields[task.FieldLeaseExpireAt] = struct{}{}
+}
+
+// LeaseExpireAtCleared returns if the "lease_expire_at" field was cleared in this mutation.
+func (m *TaskMutation) LeaseExpireAtCleared() bool {
+	_, ok := m.clearedFields[task.FieldLeaseExpireAt]
+	return ok
+}
+
+// ResetLeaseExpireAt resets all changes to the "lease_expire_at" field.
+func (m *TaskMutation) ResetLeaseExpireAt() {
+	m.lease_expire_at = nil
+	delete(m.clearedFields, task.FieldLeaseExpireAt)
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *TaskMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *TaskMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[task.FieldUserTasks] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *TaskMutation) UserCleared() bool {
+	return m.UserTasksCleared() || m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *TaskMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *TaskMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *TaskMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the TaskMutation builder.
+func (m *TaskMutation) Where(ps ...predicate.Task) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TaskMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TaskMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Task, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TaskMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TaskMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Task).
+func (m *TaskMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TaskMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.created_at != nil {
+		fields = append(fields, task.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, task.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, task.FieldDeletedAt)
+	}
+	if m._type != nil {
+		fields = append(fields, task.FieldType)
+	}
+	if m.status != nil {
+		fields = append(fields, task.FieldStatus)
+	}
+	if m.public_state != nil {
+		fields = append(fields, task.FieldPublicState)
+	}
+	if m.private_state != nil {
+		fields = append(fields, task.FieldPrivateState)
+	}
+	if m.correlation_id != nil {
+		fields = append(fields, task.FieldCorrelationID)
+	}
+	if m.user != nil {
+		fields = append(fields, task.FieldUserTasks)
+	}
+	if m.lease_owner != nil {
+		fields = append(fields, task.FieldLeaseOwner)
+	}
+	if m.lease_expire_at != nil {
+		fields = append(fields, task.FieldLeaseExpireAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TaskMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case task.FieldCreatedAt:
+		return m.CreatedAt()
+	case task.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case task.FieldDeletedAt:
+		return m.DeletedAt()
+	case task.FieldType:
+		return m.GetType()
+	case task.FieldStatus:
+		return m.Status()
+	case task.FieldPublicState:
+		return m.PublicState()
+	case task.FieldPrivateState:
+		return m.PrivateState()
+	case task.FieldCorrelationID:
+		return m.CorrelationID()
+	case task.FieldUserTasks:
+		return m.UserTasks()
+	case task.FieldLeaseOwner:
+		return m.LeaseOwner()
+	case task.FieldLeaseExpireAt:
+		return m.LeaseExpireAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TaskMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case task.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case task.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case task.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case task.FieldType:
+		return m.OldType(ctx)
+	case task.FieldStatus:
+		return m.OldStatus(ctx)
+	case task.FieldPublicState:
+		return m.OldPublicState(ctx)
+	case task.FieldPrivateState:
+		return m.OldPrivateState(ctx)
+	case task.FieldCorrelationID:
+		return m.OldCorrelationID(ctx)
+	case task.FieldUserTasks:
+		return m.OldUserTasks(ctx)
+	case task.FieldLeaseOwner:
+		return m.OldLeaseOwner(ctx)
+	case task.FieldLeaseExpireAt:
+		return m.OldLeaseExpireAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Task field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TaskMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case task.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case task.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case task.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case task.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case task.FieldStatus:
+		v, ok := value.(task.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case task.FieldPublicState:
+		v, ok := value.(*types.TaskPublicState)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublicState(v)
+		return nil
+	case task.FieldPrivateState:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrivateState(v)
+		return nil
+	case task.FieldCorrelationID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCorrelationID(v)
+		return nil
+	case task.FieldUserTasks:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserTasks(v)
+		return nil
+	case task.FieldLeaseOwner:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLeaseOwner(v)
+		return nil
+	case task.FieldLeaseExpireAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLeaseExpireAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Task field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TaskMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TaskMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TaskMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Task numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TaskMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(task.FieldDeletedAt) {
+		fields = append(fields, task.FieldDeletedAt)
+	}
+	if m.FieldCleared(task.FieldPrivateState) {
+		fields = append(fields, task.FieldPrivateState)
+	}
+	if m.FieldCleared(task.FieldCorrelationID) {
+		fields = append(fields, task.FieldCorrelationID)
+	}
+	if m.FieldCleared(task.FieldUserTasks) {
+		fields = append(fields, task.FieldUserTasks)
+	}
+	if m.FieldCleared(task.FieldLeaseOwner) {
+		fields = append(fields, task.FieldLeaseOwner)
+	}
+	if m.FieldCleared(task.FieldLeaseExpireAt) {
+		fields = append(fields, task.FieldLeaseExpireAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TaskMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TaskMutation) ClearField(name string) error {
+	switch name {
+	case task.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case task.FieldPrivateState:
+		m.ClearPrivateState()
+		return nil
+	case task.FieldCorrelationID:
+		m.ClearCorrelationID()
+		return nil
+	case task.FieldUserTasks:
+		m.ClearUserTasks()
+		return nil
+	case task.FieldLeaseOwner:
+		m.ClearLeaseOwner()
+		return nil
+	case task.FieldLeaseExpireAt:
+		m.ClearLeaseExpireAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Task nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TaskMutation) ResetField(name string) error {
+	switch name {
+	case task.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case task.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case task.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case task.FieldType:
+		m.ResetType()
+		return nil
+	case task.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case task.FieldPublicState:
+		m.ResetPublicState()
+		return nil
+	case task.FieldPrivateState:
+		m.ResetPrivateState()
+		return nil
+	case task.FieldCorrelationID:
+		m.ResetCorrelationID()
+		return nil
+	case task.FieldUserTasks:
+		m.ResetUserTasks()
+		return nil
+	case task.FieldLeaseOwner:
+		m.ResetLeaseOwner()
+		return nil
+	case task.FieldLeaseExpireAt:
+		m.ResetLeaseExpireAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Task field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TaskMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, task.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TaskMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case task.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TaskMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TaskMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TaskMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, task.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TaskMutation) EdgeCleared(name string) bool {
+	switch name {
+	case task.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TaskMutation) ClearEdge(name string) error {
+	switch name {
+	case task.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Task unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TaskMutation) ResetEdge(name string) error {
+	switch name {
+	case task.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Task edge %s", name)
+}
+
+// TeamMutation represents an operation that mutates the Team nodes in the graph.
+type TeamMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	created_at    *time.Time
+	updated_at    *time.Time
+	deleted_at    *time.Time
+	name          *string
+	settings      **types.TeamSetting
+	clearedFields map[string]struct{}
+	owner         *int
+	clearedowner  bool
+	done          bool
+	oldValue      func(context.Context) (*Team, error)
+	predicates    []predicate.Team
+}
+
+var _ ent.Mutation = (*TeamMutation)(nil)
+
+// teamOption allows management of the mutation configuration using functional options.
+type teamOption func(*TeamMutation)
+
+// newTeamMutation creates new mutation for the Team entity.
+func newTeamMutation(c config, op Op, opts ...teamOption) *TeamMutation {
+	m := &TeamMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTeam,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTeamID sets the ID field of the mutation.
+func withTeamID(id int) teamOption {
+	return func(m *TeamMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Team
+		)
+		m.oldValue = func(ctx context.Context) (*Team, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Team.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTeam sets the old Team of the mutation.
+func withTeam(node *Team) teamOption {
+	return func(m *TeamMutation) {
+		m.oldValue = func(context.Context) (*Team, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TeamMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TeamMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TeamMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TeamMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Team.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TeamMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TeamMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TeamMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *TeamMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *TeamMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *TeamMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *TeamMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *TeamMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *TeamMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[team.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *TeamMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[team.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *TeamMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, team.FieldDeletedAt)
+}
+
+// SetName sets the "name" field.
+func (m *TeamMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *TeamMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *TeamMutation) ResetName() {
+	m.name = nil
+}
+
+// SetSettings sets the "settings" field.
+func (m *TeamMutation) SetSettings(ts *types.TeamSetting) {
+	m.settings = &ts
+}
+
+// Settings returns the value of the "settings" field in the mutation.
+func (m *TeamMutation) Settings() (r *types.TeamSetting, exists bool) {
+	v := m.settings
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSettings returns the old "settings" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldSettings(ctx context.Context) (v *types.TeamSetting, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSettings is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSettings requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSettings: %w", err)
+	}
+	return oldValue.Settings, nil
+}
+
+// ClearSettings clears the value of the "settings" field.
+func (m *TeamMutation) ClearSettings() {
+	m.settings = nil
+	m.clearedFields[team.FieldSettings] = struct{}{}
+}
+
+// SettingsCleared returns if the "settings" field was cleared in this mutation.
+func (m *TeamMutation) SettingsCleared() bool {
+	_, ok := m.clearedFields[team.FieldSettings]
+	return ok
+}
+
+// ResetSettings resets all changes to the "settings" field.
+func (m *TeamMutation) ResetSettings() {
+	m.settings = nil
+	delete(m.clearedFields, team.FieldSettings)
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (m *TeamMutation) SetOwnerID(i int) {
+	m.owner = &i
+}
+
+// OwnerID returns the value of the "owner_id" field in the mutation.
+func (m *TeamMutation) OwnerID() (r int, exists bool) {
+	v := m.owner
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwnerID returns the old "owner_id" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldOwnerID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
+	}
+	return oldValue.OwnerID, nil
+}
+
+// ResetOwnerID resets all changes to the "owner_id" field.
+func (m *TeamMutation) ResetOwnerID() {
+	m.owner = nil
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *TeamMutation) ClearOwner() {
+	m.clearedowner = true
+	m.clearedFields[team.FieldOwnerID] = struct{}{}
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *TeamMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *TeamMutation) OwnerIDs() (ids []int) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOwner resets all changes to the "owner" edge.
+func (m *TeamMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+}
+
+// Where appends a list predicates to the TeamMutation builder.
+func (m *TeamMutation) Where(ps ...predicate.Team) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TeamMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TeamMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Team, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TeamMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TeamMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Team).
+func (m *TeamMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TeamMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.created_at != nil {
+		fields = append(fields, team.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, team.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, team.FieldDeletedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, team.FieldName)
+	}
+	if m.settings != nil {
+		fields = append(fields, team.FieldSettings)
+	}
+	if m.owner != nil {
+		fields = append(fields, team.FieldOwnerID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TeamMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case team.FieldCreatedAt:
+		return m.CreatedAt()
+	case team.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case team.FieldDeletedAt:
+		return m.DeletedAt()
+	case team.FieldName:
+		return m.Name()
+	case team.FieldSettings:
+		return m.Settings()
+	case team.FieldOwnerID:
+		return m.OwnerID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TeamMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case team.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case team.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case team.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case team.FieldName:
+		return m.OldName(ctx)
+	case team.FieldSettings:
+		return m.OldSettings(ctx)
+	case team.FieldOwnerID:
+		return m.OldOwnerID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Team field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TeamMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case team.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case team.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case team.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case team.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case team.FieldSettings:
+		v, ok := value.(*types.TeamSetting)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSettings(v)
+		return nil
+	case team.FieldOwnerID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwnerID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Team field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TeamMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TeamMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TeamMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Team numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TeamMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(team.FieldDeletedAt) {
+		fields = append(fields, team.FieldDeletedAt)
+	}
+	if m.FieldCleared(team.FieldSettings) {
+		fields = append(fields, team.FieldSettings)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TeamMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TeamMutation) ClearField(name string) error {
+	switch name {
+	case team.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case team.FieldSettings:
+		m.ClearSettings()
+		return nil
+	}
+	return fmt.Errorf("unknown Team nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TeamMutation) ResetField(name string) error {
+	switch name {
+	case team.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case team.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case team.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case team.FieldName:
+		m.ResetName()
+		return nil
+	case team.FieldSettings:
+		m.ResetSettings()
+		return nil
+	case team.FieldOwnerID:
+		m.ResetOwnerID()
+		return nil
+	}
+	return fmt.Errorf("unknown Team field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TeamMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.owner != nil {
+		edges = append(edges, team.EdgeOwner)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TeamMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case team.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TeamMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TeamMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TeamMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedowner {
+		edges = append(edges, team.EdgeOwner)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TeamMutation) EdgeCleared(name string) bool {
+	switch name {
+	case team.EdgeOwner:
+		return m.clearedowner
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TeamMutation) ClearEdge(name string) error {
+	switch name {
+	case team.EdgeOwner:
+		m.ClearOwner()
+		return nil
+	}
+	return fmt.Errorf("unknown Team unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TeamMutation) ResetEdge(name string) error {
+	switch name {
+	case team.EdgeOwner:
+		m.ResetOwner()
+		return nil
+	}
+	return fmt.Errorf("unknown Team edge %s", name)
+}
+
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int
+	created_at          *time.Time
+	updated_at          *time.Time
+	deleted_at          *time.Time
+	email               *string
+	nick                *string
+	password            *string
+	status              *user.Status
+	storage             *int64
+	addstorage          *int64
+	file_count          *int
+	addfile_count       *int
+	two_factor_secret   *string
+	avatar              *string
+	settings            **types.UserSetting
+	clearedFields       map[string]struct{}
+	group               *int
+	clearedgroup        bool
+	files               map[int]struct{}
+	removedfiles        map[int]struct{}
+	clearedfiles        bool
+	dav_accounts        map[int]struct{}
+	removeddav_accounts map[int]struct{}
+	cleareddav_accounts bool
+	shares              map[int]struct{}
+	removedshares       map[int]struct{}
+	clearedshares       bool
+	passkey             map[int]struct{}
+	removedpasskey      map[int]struct{}
+	clearedpasskey      bool
+	tasks               map[int]struct{}
+	removedtasks        map[int]struct{}
+	clearedtasks        bool
+	fsevents            map[int]struct{}
+	removedfsevents     map[int]struct{}
+	clearedfsevents     bool
+	entities            map[int]struct{}
+	removedentities     map[int]struct{}
+	clearedentities     bool
+	oauth_grants        map[int]struct{}
+	removedoauth_grants map[int]struct{}
+	clearedoauth_grants bool
+	teams               map[int]struct{}
+	removedteams        map[int]struct{}
+	clearedteams        bool
+	done                bool
+	oldValue            func(context.Context) (*User, error)
+	predicates          []predicate.User
+}
+
+var _ ent.Mutation = (*UserMutation)(nil)
+
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
+
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUser,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUserID sets the ID field of the mutation.
+func withUserID(id int) userOption {
+	return func(m *UserMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *User
+		)
+		m.oldValue = func(ctx context.Context) (*User, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().User.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *UserMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UserMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *UserMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *UserMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *UserMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *UserMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *UserMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[user.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *UserMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *UserMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, user.FieldDeletedAt)
+}
+
+// SetEmail sets the "email" field.
+func (m *UserMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *UserMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *UserMutation) ResetEmail() {
+	m.email = nil
+}
+
+// SetNick sets the "nick" field.
+func (m *UserMutation) SetNick(s string) {
+	m.nick = &s
+}
+
+// Nick returns the value of the "nick" field in the mutation.
+func (m *UserMutation) Nick() (r string, exists bool) {
+	v := m.nick
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNick returns the old "nick" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldNick(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNick is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNick requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNick: %w", err)
+	}
+	return oldValue.Nick, nil
+}
+
+// ResetNick resets all changes to the "nick" field.
+func (m *UserMutation) ResetNick() {
+	m.nick = nil
+}
+
+// SetPassword sets the "password" field.
+func (m *UserMutation) SetPassword(s string) {
+	m.password = &s
+}
+
+// Password returns the value of the "password" field in the mutation.
+func (m *UserMutation) Password() (r string, exists bool) {
+	v := m.password
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPassword returns the old "password" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldPassword(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPassword requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+	}
+	return oldValue.Password, nil
+}
+
+// ClearPassword clears the value of the "password" field.
+func (m *UserMutation) ClearPassword() {
+	m.password = nil
+	m.clearedFields[user.FieldPassword] = struct{}{}
+}
+
+// PasswordCleared returns if the "password" field was cleared in this mutation.
+func (m *UserMutation) PasswordCleared() bool {
+	_, ok := m.clearedFields[user.FieldPassword]
+	return ok
+}
+
+// ResetPassword resets all changes to the "password" field.
+func (m *UserMutation) ResetPassword() {
+	m.password = nil
+	delete(m.clearedFields, user.FieldPassword)
+}
+
+// SetStatus sets the "status" field.
+func (m *UserMutation) SetStatus(u user.Status) {
+	m.status = &u
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *UserMutation) Status() (r user.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldStatus(ctx context.Context) (v user.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *UserMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetStorage sets the "storage" field.
+func (m *UserMutation) SetStorage(i int64) {
+	m.storage = &i
+	m.addstorage = nil
+}
+
+// Storage returns the value of the "storage" field in the mutation.
+func (m *UserMutation) Storage() (r int64, exists bool) {
+	v := m.storage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStorage returns the old "storage" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldStorage(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStorage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStorage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStorage: %w", err)
+	}
+	return oldValue.Storage, nil
+}
+
+// AddStorage adds i to the "storage" field.
+func (m *UserMutation) AddStorage(i int64) {
+	if m.addstorage != nil {
+		*m.addstorage += i
+	} else {
+		m.addstorage = &i
+	}
+}
+
+// AddedStorage returns the value that was added to the "storage" field in this mutation.
+func (m *UserMutation) AddedStorage() (r int64, exists bool) {
+	v := m.addstorage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStorage resets all changes to the "storage" field.
+func (m *UserMutation) ResetStorage() {
+	m.storage = nil
+	m.addstorage = nil
+}
+
+// SetFileCount sets the "file_count" field.
+func (m *UserMutation) SetFileCount(i int) {
+	m.file_count = &i
+	m.addfile_count = nil
+}
+
+// FileCount returns the value of the "file_count" field in the mutation.
+func (m *UserMutation) FileCount() (r int, exists bool) {
+	v := m.file_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFileCount returns the old "file_count" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldFileCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFileCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFileCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFileCount: %w", err)
+	}
+	return oldValue.FileCount, nil
+}
+
+// AddFileCount adds i to the "file_count" field.
+func (m *UserMutation) AddFileCount(i int) {
+	if m.addfile_count != nil {
+		*m.addfile_count += i
+	} else {
+		m.addfile_count = &i
+	}
+}
+
+// AddedFileCount returns the value that was added to the "file_count" field in this mutation.
+func (m *UserMutation) AddedFileCount() (r int, exists bool) {
+	v := m.addfile_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFileCount resets all changes to the "file_count" field.
+func (m *UserMutation) ResetFileCount() {
+	m.file_count = nil
+	m.addfile_count = nil
+}
+
+// SetTwoFactorSecret sets the "two_factor_secret" field.
+func (m *UserMutation) SetTwoFactorSecret(s string) {
+	m.two_factor_secret = &s
+}
+
+// TwoFactorSecret returns the value of the "two_factor_secret" field in the mutation.
+func (m *UserMutation) TwoFactorSecret() (r string, exists bool) {
+	v := m.two_factor_secret
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTwoFactorSecret returns the old "two_factor_secret" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldTwoFactorSecret(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTwoFactorSecret is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTwoFactorSecret requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTwoFactorSecret: %w", err)
+	}
+	return oldValue.TwoFactorSecret, nil
+}
+
+// ClearTwoFactorSecret clears the value of the "two_factor_secret" field.
+func (m *UserMutation) ClearTwoFactorSecret() {
+	m.two_factor_secret = nil
+	m.clearedFields[user.FieldTwoFactorSecret] = struct{}{}
+}
+
+// TwoFactorSecretCleared returns if the "two_factor_secret" field was cleared in this mutation.
+func (m *UserMutation) TwoFactorSecretCleared() bool {
+	_, ok := m.clearedFields[user.FieldTwoFactorSecret]
+	return ok
+}
+
+// ResetTwoFactorSecret resets all changes to the "two_factor_secret" field.
+func (m *UserMutation) ResetTwoFactorSecret() {
+	m.two_factor_secret = nil
+	delete(m.clearedFields, user.FieldTwoFactorSecret)
+}
+
+// SetAvatar sets the "avatar" field.
+func (m *UserMutation) SetAvatar(s string) {
+	m.avatar = &s
+}
+
+// Avatar returns the value of the "avatar" field in the mutation.
+func (m *UserMutation) Avatar() (r string, exists bool) {
+	v := m.avatar
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAvatar returns the old "avatar" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldAvatar(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAvatar is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAvatar requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAvatar: %w", err)
+	}
+	return oldValue.Avatar, nil
+}
+
+// ClearAvatar clears the value of the "avatar" field.
+func (m *UserMutation) ClearAvatar() {
+	m.avatar = nil
+	m.clearedFields[user.FieldAvatar] = struct{}{}
+}
+
+// AvatarCleared returns if the "avatar" field was cleared in this mutation.
+func (m *UserMutation) AvatarCleared() bool {
+	_, ok := m.clearedFields[user.FieldAvatar]
+	return ok
+}
+
+// ResetAvatar resets all changes to the "avatar" field.
+func (m *UserMutation) ResetAvatar() {
+	m.avatar = nil
+	delete(m.clearedFields, user.FieldAvatar)
+}
+
+// SetSettings sets the "settings" field.
+func (m *UserMutation) SetSettings(ts *types.UserSetting) {
+	m.settings = &ts
+}
+
+// Settings returns the value of the "settings" field in the mutation.
+func (m *UserMutation) Settings() (r *types.UserSetting, exists bool) {
+	v := m.settings
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSettings returns the old "settings" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldSettings(ctx context.Context) (v *types.UserSetting, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSettings is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSettings requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSettings: %w", err)
+	}
+	return oldValue.Settings, nil
+}
+
+// ClearSettings clears the value of the "settings" field.
+func (m *UserMutation) ClearSettings() {
+	m.settings = nil
+	m.clearedFields[user.FieldSettings] = struct{}{}
+}
+
+// SettingsCleared returns if the "settings" field was cleared in this mutation.
+func (m *UserMutation) SettingsCleared() bool {
+	_, ok := m.clearedFields[user.FieldSettings]
+	return ok
+}
+
+// ResetSettings resets all changes to the "settings" field.
+func (m *UserMutation) ResetSettings() {
+	m.settings = nil
+	delete(m.clearedFields, user.FieldSettings)
+}
+
+// SetGroupUsers sets the "group_users" field.
+func (m *UserMutation) SetGroupUsers(i int) {
+	m.group = &i
+}
+
+// GroupUsers returns the value of the "group_users" field in the mutation.
+func (m *UserMutation) GroupUsers() (r int, exists bool) {
+	v := m.group
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGroupUsers returns the old "group_users" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldGroupUsers(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGroupUsers is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGroupUsers requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGroupUsers: %w", err)
+	}
+	return oldValue.GroupUsers, nil
+}
+
+// ResetGroupUsers resets all changes to the "group_users" field.
+func (m *UserMutation) ResetGroupUsers() {
+	m.group = nil
+}
+
+// SetGroupID sets the "group" edge to the Group entity by id.
+func (m *UserMutation) SetGroupID(id int) {
+	m.group = &id
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (m *UserMutation) ClearGroup() {
+	m.clearedgroup = true
+	m.clearedFields[user.FieldGroupUsers] = struct{}{}
+}
+
+// GroupCleared reports if the "group" edge to the Group entity was cleared.
+func (m *UserMutation) GroupCleared() bool {
+	return m.clearedgroup
+}
+
+// GroupID returns the "group" edge ID in the mutation.
+func (m *UserMutation) GroupID() (id int, exists bool) {
+	if m.group != nil {
+		return *m.group, true
+	}
+	return
+}
+
+// GroupIDs returns the "group" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GroupID instead. It exists only for internal usage by the builders.
+func (m *UserMutation) GroupIDs() (ids []int) {
+	if id := m.group; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGroup resets all changes to the "group" edge.
+func (m *UserMutation) ResetGroup() {
+	m.group = nil
+	m.clearedgroup = false
+}
+
+// AddFileIDs adds the "files" edge to the File entity by ids.
+func (m *UserMutation) AddFileIDs(ids ...int) {
+	if m.files == nil {
+		m.files = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.files[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFiles clears the "files" edge to the File entity.
+func (m *UserMutation) ClearFiles() {
+	m.clearedfiles = true
+}
+
+// FilesCleared reports if the "files" edge to the File entity was cleared.
+func (m *UserMutation) FilesCleared() bool {
+	return m.clearedfiles
+}
+
+// RemoveFileIDs removes the "files" edge to the File entity by IDs.
+func (m *UserMutation) RemoveFileIDs(ids ...int) {
+	if m.removedfiles == nil {
+		m.removedfiles = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.files, ids[i])
+		m.removedfiles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFiles returns the removed IDs of the "files" edge to the File entity.
+func (m *UserMutation) RemovedFilesIDs() (ids []int) {
+	for id := range m.removedfiles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FilesIDs returns the "files" edge IDs in the mutation.
+func (m *UserMutation) FilesIDs() (ids []int) {
+	for id := range m.files {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFiles resets all changes to the "files" edge.
+func (m *UserMutation) ResetFiles() {
+	m.files = nil
+	m.clearedfiles = false
+	m.removedfiles = nil
+}
+
+// AddDavAccountIDs adds the "dav_accounts" edge to the DavAccount entity by ids.
+func (m *UserMutation) AddDavAccountIDs(ids ...int) {
+	if m.dav_accounts == nil {
+		m.dav_accounts = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.dav_accounts[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDavAccounts clears the "dav_accounts" edge to the DavAccount entity.
+func (m *UserMutation) ClearDavAccounts() {
+	m.cleareddav_accounts = true
+}
+
+// DavAccountsCleared reports if the "dav_accounts" edge to the DavAccount entity was cleared.
+func (m *UserMutation) DavAccountsCleared() bool {
+	return m.cleareddav_accounts
+}
+
+// RemoveDavAccountIDs removes the "dav_accounts" edge to the DavAccount entity by IDs.
+func (m *UserMutation) RemoveDavAccountIDs(ids ...int) {
+	if m.removeddav_accounts == nil {
+		m.removeddav_accounts = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.dav_accounts, ids[i])
+		m.removeddav_accounts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDavAccounts returns the removed IDs of the "dav_accounts" edge to the DavAccount entity.
+func (m *UserMutation) RemovedDavAccountsIDs() (ids []int) {
+	for id := range m.removeddav_accounts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DavAccountsIDs returns the "dav_accounts" edge IDs in the mutation.
+func (m *UserMutation) DavAccountsIDs() (ids []int) {
+	for id := range m.dav_accounts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDavAccounts resets all changes to the "dav_accounts" edge.
+func (m *UserMutation) ResetDavAccounts() {
+	m.dav_accounts = nil
+	m.cleareddav_accounts = false
+	m.removeddav_accounts = nil
+}
+
+// AddShareIDs adds the "shares" edge to the Share entity by ids.
+func (m *UserMutation) AddShareIDs(ids ...int) {
+	if m.shares == nil {
+		m.shares = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.shares[ids[i]] = struct{}{}
+	}
+}
+
+// ClearShares clears the "shares" edge to the Share entity.
+func (m *UserMutation) ClearShares() {
+	m.clearedshares = true
+}
+
+// SharesCleared reports if the "shares" edge to the Share entity was cleared.
+func (m *UserMutation) SharesCleared() bool {
+	return m.clearedshares
+}
+
+// RemoveShareIDs removes the "shares" edge to the Share entity by IDs.
+func (m *UserMutation) RemoveShareIDs(ids ...int) {
+	if m.removedshares == nil {
+		m.removedshares = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.shares, ids[i])
+		m.removedshares[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedShares returns the removed IDs of the "shares" edge to the Share entity.
+func (m *UserMutation) RemovedSharesIDs() (ids []int) {
+	for id := range m.removedshares {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SharesIDs returns the "shares" edge IDs in the mutation.
+func (m *UserMutation) SharesIDs() (ids []int) {
+	for id := range m.shares {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetShares resets all changes to the "shares" edge.
+func (m *UserMutation) ResetShares() {
+	m.shares = nil
+	m.clearedshares = false
+	m.removedshares = nil
+}
+
+// AddPasskeyIDs adds the "passkey" edge to the Passkey entity by ids.
+func (m *UserMutation) AddPasskeyIDs(ids ...int) {
+	if m.passkey == nil {
+		m.passkey = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.passkey[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPasskey clears the "passkey" edge to the Passkey entity.
+func (m *UserMutation) ClearPasskey() {
+	m.clearedpasskey = true
+}
+
+// PasskeyCleared reports if the "passkey" edge to the Passkey entity was cleared.
+func (m *UserMutation) PasskeyCleared() bool {
+	return m.clearedpasskey
+}
+
+// RemovePasskeyIDs removes the "passkey" edge to the Passkey entity by IDs.
+func (m *UserMutation) RemovePasskeyIDs(ids ...int) {
+	if m.removedpasskey == nil {
+		m.removedpasskey = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.passkey, ids[i])
+		m.removedpasskey[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPasskey returns the removed IDs of the "passkey" edge to the Passkey entity.
+func (m *UserMutation) RemovedPasskeyIDs() (ids []int) {
+	for id := range m.removedpasskey {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PasskeyIDs returns the "passkey" edge IDs in the mutation.
+func (m *UserMutation) PasskeyIDs() (ids []int) {
+	for id := range m.passkey {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPasskey resets all changes to the "passkey" edge.
+func (m *UserMutation) ResetPasskey() {
+	m.passkey = nil
+	m.clearedpasskey = false
+	m.removedpasskey = nil
+}
+
+// AddTaskIDs adds the "tasks" edge to the Task entity by ids.
+func (m *UserMutation) AddTaskIDs(ids ...int) {
+	if m.tasks == nil {
+		m.tasks = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.tasks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTasks clears the "tasks" edge to the Task entity.
+func (m *UserMutation) ClearTasks() {
+	m.clearedtasks = true
+}
+
+// TasksCleared reports if the "tasks" edge to the Task entity was cleared.
+func (m *UserMutation) TasksCleared() bool {
+	return m.clearedtasks
+}
+
+// RemoveTaskIDs removes the "tasks" edge to the Task entity by IDs.
+func (m *UserMutation) RemoveTaskIDs(ids ...int) {
+	if m.removedtasks == nil {
+		m.removedtasks = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.tasks, ids[i])
+		m.removedtasks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTasks returns the removed IDs of the "tasks" edge to the Task entity.
+func (m *UserMutation) RemovedTasksIDs() (ids []int) {
+	for id := range m.removedtasks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TasksIDs returns the "tasks" edge IDs in the mutation.
+func (m *UserMutation) TasksIDs() (ids []int) {
+	for id := range m.tasks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTasks resets all changes to the "tasks" edge.
+func (m *UserMutation) ResetTasks() {
+	m.tasks = nil
+	m.clearedtasks = false
+	m.removedtasks = nil
+}
+
+// AddFseventIDs adds the "fsevents" edge to the FsEvent entity by ids.
+func (m *UserMutation) AddFseventIDs(ids ...int) {
+	if m.fsevents == nil {
+		m.fsevents = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.fsevents[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFsevents clears the "fsevents" edge to the FsEvent entity.
+func (m *UserMutation) ClearFsevents() {
+	m.clearedfsevents = true
+}
+
+// FseventsCleared reports if the "fsevents" edge to the FsEvent entity was cleared.
+func (m *UserMutation) FseventsCleared() bool {
+	return m.clearedfsevents
+}
+
+// RemoveFseventIDs removes the "fsevents" edge to the FsEvent entity by IDs.
+func (m *UserMutation) RemoveFseventIDs(ids ...int) {
+	if m.removedfsevents == nil {
+		m.removedfsevents = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.fsevents, ids[i])
+		m.removedfsevents[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFsevents returns the removed IDs of the "fsevents" edge to the FsEvent entity.
+func (m *UserMutation) RemovedFseventsIDs() (ids []int) {
+	for id := range m.removedfsevents {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FseventsIDs returns the "fsevents" edge IDs in the mutation.
+func (m *UserMutation) FseventsIDs() (ids []int) {
+	for id := range m.fsevents {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFsevents resets all changes to the "fsevents" edge.
+func (m *UserMutation) ResetFsevents() {
+	m.fsevents = nil
+	m.clearedfsevents = false
+	m.removedfsevents = nil
+}
+
+// AddEntityIDs adds the "entities" edge to the Entity entity by ids.
+func (m *UserMutation) AddEntityIDs(ids ...int) {
+	if m.entities == nil {
+		m.entities = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.entities[ids[i]] = struct{}{}
+	}
+}
+
+// ClearEntities clears the "entities" edge to the Entity entity.
+func (m *UserMutation) ClearEntities() {
+	m.clearedentities = true
+}
+
+// EntitiesCleared reports if the "entities" edge to the Entity entity was cleared.
+func (m *UserMutation) EntitiesCleared() bool {
+	return m.clearedentities
+}
+
+// RemoveEntityIDs removes the "entities" edge to the Entity entity by IDs.
+func (m *UserMutation) RemoveEntityIDs(ids ...int) {
+	if m.removedentities == nil {
+		m.removedentities = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.entities, ids[i])
+		m.removedentities[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedEntities returns the removed IDs of the "entities" edge to the Entity entity.
+func (m *UserMutation) RemovedEntitiesIDs() (ids []int) {
+	for id := range m.removedentities {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// EntitiesIDs returns the "entities" edge IDs in the mutation.
+func (m *UserMutation) EntitiesIDs() (ids []int) {
+	for id := range m.entities {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetEntities resets all changes to the "entities" edge.
+func (m *UserMutation) ResetEntities() {
+	m.entities = nil
+	m.clearedentities = false
+	m.removedentities = nil
+}
+
+// AddOauthGrantIDs adds the "oauth_grants" edge to the OAuthGrant entity by ids.
+func (m *UserMutation) AddOauthGrantIDs(ids ...int) {
+	if m.oauth_grants == nil {
+		m.oauth_grants = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.oauth_grants[ids[i]] = struct{}{}
+	}
+}
+
+// ClearOauthGrants clears the "oauth_grants" edge to the OAuthGrant entity.
+func (m *UserMutation) ClearOauthGrants() {
+	m.clearedoauth_grants = true
+}
+
+// OauthGrantsCleared reports if the "oauth_grants" edge to the OAuthGrant entity was cleared.
+func (m *UserMutation) OauthGrantsCleared() bool {
+	return m.clearedoauth_grants
+}
+
+// RemoveOauthGrantIDs removes the "oauth_grants" edge to the OAuthGrant entity by IDs.
+func (m *UserMutation) RemoveOauthGrantIDs(ids ...int) {
+	if m.removedoauth_grants == nil {
+		m.removedoauth_grants = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.oauth_grants, ids[i])
+		m.removedoauth_grants[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedOauthGrants returns the removed IDs of the "oauth_grants" edge to the OAuthGrant entity.
+func (m *UserMutation) RemovedOauthGrantsIDs() (ids []int) {
+	for id := range m.removedoauth_grants {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// OauthGrantsIDs returns the "oauth_grants" edge IDs in the mutation.
+func (m *UserMutation) OauthGrantsIDs() (ids []int) {
+	for id := range m.oauth_grants {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetOauthGrants resets all changes to the "oauth_grants" edge.
+func (m *UserMutation) ResetOauthGrants() {
+	m.oauth_grants = nil
+	m.clearedoauth_grants = false
+	m.removedoauth_grants = nil
+}
+
+// AddTeamIDs adds the "teams" edge to the Team entity by ids.
+func (m *UserMutation) AddTeamIDs(ids ...int) {
+	if m.teams == nil {
+		m.teams = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.teams[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTeams clears the "teams" edge to the Team entity.
+func (m *UserMutation) ClearTeams() {
+	m.clearedteams = true
+}
+
+// TeamsCleared reports if the "teams" edge to the Team entity was cleared.
+func (m *UserMutation) TeamsCleared() bool {
+	return m.clearedteams
+}
+
+// RemoveTeamIDs removes the "teams" edge to the Team entity by IDs.
+func (m *UserMutation) RemoveTeamIDs(ids ...int) {
+	if m.removedteams == nil {
+		m.removedteams = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.teams, ids[i])
+		m.removedteams[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTeams returns the removed IDs of the "teams" edge to the Team entity.
+func (m *UserMutation) RemovedTeamsIDs() (ids []int) {
+	for id := range m.removedteams {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TeamsIDs returns the "teams" edge IDs in the mutation.
+func (m *UserMutation) TeamsIDs() (ids []int) {
+	for id := range m.teams {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTeams resets all changes to the "teams" edge.
+func (m *UserMutation) ResetTeams() {
+	m.teams = nil
+	m.clearedteams = false
+	m.removedteams = nil
+}
+
+// Where appends a list predicates to the UserMutation builder.
+func (m *UserMutation) Where(ps ...predicate.User) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
 	p := make([]predicate.User, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
-	m.Where(p...)
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *UserMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *UserMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (User).
+func (m *UserMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *UserMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.created_at != nil {
+		fields = append(fields, user.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, user.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, user.FieldDeletedAt)
+	}
+	if m.email != nil {
+		fields = append(fields, user.FieldEmail)
+	}
+	if m.nick != nil {
+		fields = append(fields, user.FieldNick)
+	}
+	if m.password != nil {
+		fields = append(fields, user.FieldPassword)
+	}
+	if m.status != nil {
+		fields = append(fields, user.FieldStatus)
+	}
+	if m.storage != nil {
+		fields = append(fields, user.FieldStorage)
+	}
+	if m.file_count != nil {
+		fields = append(fields, user.FieldFileCount)
+	}
+	if m.two_factor_secret != nil {
+		fields = append(fields, user.FieldTwoFactorSecret)
+	}
+	if m.avatar != nil {
+		fields = append(fields, user.FieldAvatar)
+	}
+	if m.settings != nil {
+		fields = append(fields, user.FieldSettings)
+	}
+	if m.group != nil {
+		fields = append(fields, user.FieldGroupUsers)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *UserMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldCreatedAt:
+		return m.CreatedAt()
+	case user.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case user.FieldDeletedAt:
+		return m.DeletedAt()
+	case user.FieldEmail:
+		return m.Email()
+	case user.FieldNick:
+		return m.Nick()
+	case user.FieldPassword:
+		return m.Password()
+	case user.FieldStatus:
+		return m.Status()
+	case user.FieldStorage:
+		return m.Storage()
+	case user.FieldFileCount:
+		return m.FileCount()
+	case user.FieldTwoFactorSecret:
+		return m.TwoFactorSecret()
+	case user.FieldAvatar:
+		return m.Avatar()
+	case user.FieldSettings:
+		return m.Settings()
+	case user.FieldGroupUsers:
+		return m.GroupUsers()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case user.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case user.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case user.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case user.FieldEmail:
+		return m.OldEmail(ctx)
+	case user.FieldNick:
+		return m.OldNick(ctx)
+	case user.FieldPassword:
+		return m.OldPassword(ctx)
+	case user.FieldStatus:
+		return m.OldStatus(ctx)
+	case user.FieldStorage:
+		return m.OldStorage(ctx)
+	case user.FieldFileCount:
+		return m.OldFileCount(ctx)
+	case user.FieldTwoFactorSecret:
+		return m.OldTwoFactorSecret(ctx)
+	case user.FieldAvatar:
+		return m.OldAvatar(ctx)
+	case user.FieldSettings:
+		return m.OldSettings(ctx)
+	case user.FieldGroupUsers:
+		return m.OldGroupUsers(ctx)
+	}
+	return nil, fmt.Errorf("unknown User field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case user.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case user.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case user.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case user.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case user.FieldNick:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNick(v)
+		return nil
+	case user.FieldPassword:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPassword(v)
+		return nil
+	case user.FieldStatus:
+		v, ok := value.(user.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case user.FieldStorage:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStorage(v)
+		return nil
+	case user.FieldFileCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFileCount(v)
+		return nil
+	case user.FieldTwoFactorSecret:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTwoFactorSecret(v)
+		return nil
+	case user.FieldAvatar:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAvatar(v)
+		return nil
+	case user.FieldSettings:
+		v, ok := value.(*types.UserSetting)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSettings(v)
+		return nil
+	case user.FieldGroupUsers:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGroupUsers(v)
+		return nil
+	}
+	return fmt.Errorf("unknown User field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *UserMutation) AddedFields() []string {
+	var fields []string
+	if m.addstorage != nil {
+		fields = append(fields, user.FieldStorage)
+	}
+	if m.addfile_count != nil {
+		fields = append(fields, user.FieldFileCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldStorage:
+		return m.AddedStorage()
+	case user.FieldFileCount:
+		return m.AddedFileCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case user.FieldStorage:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStorage(v)
+		return nil
+	case user.FieldFileCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFileCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown User numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *UserMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(user.FieldDeletedAt) {
+		fields = append(fields, user.FieldDeletedAt)
+	}
+	if m.FieldCleared(user.FieldPassword) {
+		fields = append(fields, user.FieldPassword)
+	}
+	if m.FieldCleared(user.FieldTwoFactorSecret) {
+		fields = append(fields, user.FieldTwoFactorSecret)
+	}
+	if m.FieldCleared(user.FieldAvatar) {
+		fields = append(fields, user.FieldAvatar)
+	}
+	if m.FieldCleared(user.FieldSettings) {
+		fields = append(fields, user.FieldSettings)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *UserMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *UserMutation) ClearField(name string) error {
+	switch name {
+	case user.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case user.FieldPassword:
+		m.ClearPassword()
+		return nil
+	case user.FieldTwoFactorSecret:
+		m.ClearTwoFactorSecret()
+		return nil
+	case user.FieldAvatar:
+		m.ClearAvatar()
+		return nil
+	case user.FieldSettings:
+		m.ClearSettings()
+		return nil
+	}
+	return fmt.Errorf("unknown User nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *UserMutation) ResetField(name string) error {
+	switch name {
+	case user.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case user.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case user.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case user.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case user.FieldNick:
+		m.ResetNick()
+		return nil
+	case user.FieldPassword:
+		m.ResetPassword()
+		return nil
+	case user.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case user.FieldStorage:
+		m.ResetStorage()
+		return nil
+	case user.FieldFileCount:
+		m.ResetFileCount()
+		return nil
+	case user.FieldTwoFactorSecret:
+		m.ResetTwoFactorSecret()
+		return nil
+	case user.FieldAvatar:
+		m.ResetAvatar()
+		return nil
+	case user.FieldSettings:
+		m.ResetSettings()
+		return nil
+	case user.FieldGroupUsers:
+		m.ResetGroupUsers()
+		return nil
+	}
+	return fmt.Errorf("unknown User field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *UserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 10)
+	if m.group != nil {
+		edges = append(edges, user.EdgeGroup)
+	}
+	if m.files != nil {
+		edges = append(edges, user.EdgeFiles)
+	}
+	if m.dav_accounts != nil {
+		edges = append(edges, user.EdgeDavAccounts)
+	}
+	if m.shares != nil {
+		edges = append(edges, user.EdgeShares)
+	}
+	if m.passkey != nil {
+		edges = append(edges, user.EdgePasskey)
+	}
+	if m.tasks != nil {
+		edges = append(edges, user.EdgeTasks)
+	}
+	if m.fsevents != nil {
+		edges = append(edges, user.EdgeFsevents)
+	}
+	if m.entities != nil {
+		edges = append(edges, user.EdgeEntities)
+	}
+	if m.oauth_grants != nil {
+		edges = append(edges, user.EdgeOauthGrants)
+	}
+	if m.teams != nil {
+		edges = append(edges, user.EdgeTeams)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *UserMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeGroup:
+		if id := m.group; id != nil {
+			return []ent.Value{*id}
+		}
+	case user.EdgeFiles:
+		ids := make([]ent.Value, 0, len(m.files))
+		for id := range m.files {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeDavAccounts:
+		ids := make([]ent.Value, 0, len(m.dav_accounts))
+		for id := range m.dav_accounts {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeShares:
+		ids := make([]ent.Value, 0, len(m.shares))
+		for id := range m.shares {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgePasskey:
+		ids := make([]ent.Value, 0, len(m.passkey))
+		for id := range m.passkey {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeTasks:
+		ids := make([]ent.Value, 0, len(m.tasks))
+		for id := range m.tasks {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeFsevents:
+		ids := make([]ent.Value, 0, len(m.fsevents))
+		for id := range m.fsevents {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeEntities:
+		ids := make([]ent.Value, 0, len(m.entities))
+		for id := range m.entities {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeOauthGrants:
+		ids := make([]ent.Value, 0, len(m.oauth_grants))
+		for id := range m.oauth_grants {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeTeams:
+		ids := make([]ent.Value, 0, len(m.teams))
+		for id := range m.teams {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *UserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 10)
+	if m.removedfiles != nil {
+		edges = append(edges, user.EdgeFiles)
+	}
+	if m.removeddav_accounts != nil {
+		edges = append(edges, user.EdgeDavAccounts)
+	}
+	if m.removedshares != nil {
+		edges = append(edges, user.EdgeShares)
+	}
+	if m.removedpasskey != nil {
+		edges = append(edges, user.EdgePasskey)
+	}
+	if m.removedtasks != nil {
+		edges = append(edges, user.EdgeTasks)
+	}
+	if m.removedfsevents != nil {
+		edges = append(edges, user.EdgeFsevents)
+	}
+	if m.removedentities != nil {
+		edges = append(edges, user.EdgeEntities)
+	}
+	if m.removedoauth_grants != nil {
+		edges = append(edges, user.EdgeOauthGrants)
+	}
+	if m.removedteams != nil {
+		edges = append(edges, user.EdgeTeams)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeFiles:
+		ids := make([]ent.Value, 0, len(m.removedfiles))
+		for id := range m.removedfiles {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeDavAccounts:
+		ids := make([]ent.Value, 0, len(m.removeddav_accounts))
+		for id := range m.removeddav_accounts {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeShares:
+		ids := make([]ent.Value, 0, len(m.removedshares))
+		for id := range m.removedshares {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgePasskey:
+		ids := make([]ent.Value, 0, len(m.removedpasskey))
+		for id := range m.removedpasskey {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeTasks:
+		ids := make([]ent.Value, 0, len(m.removedtasks))
+		for id := range m.removedtasks {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeFsevents:
+		ids := make([]ent.Value, 0, len(m.removedfsevents))
+		for id := range m.removedfsevents {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeEntities:
+		ids := make([]ent.Value, 0, len(m.removedentities))
+		for id := range m.removedentities {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeOauthGrants:
+		ids := make([]ent.Value, 0, len(m.removedoauth_grants))
+		for id := range m.removedoauth_grants {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeTeams:
+		ids := make([]ent.Value, 0, len(m.removedteams))
+		for id := range m.removedteams {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *UserMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 10)
+	if m.clearedgroup {
+		edges = append(edges, user.EdgeGroup)
+	}
+	if m.clearedfiles {
+		edges = append(edges, user.EdgeFiles)
+	}
+	if m.cleareddav_accounts {
+		edges = append(edges, user.EdgeDavAccounts)
+	}
+	if m.clearedshares {
+		edges = append(edges, user.EdgeShares)
+	}
+	if m.clearedpasskey {
+		edges = append(edges, user.EdgePasskey)
+	}
+	if m.clearedtasks {
+		edges = append(edges, user.EdgeTasks)
+	}
+	if m.clearedfsevents {
+		edges = append(edges, user.EdgeFsevents)
+	}
+	if m.clearedentities {
+		edges = append(edges, user.EdgeEntities)
+	}
+	if m.clearedoauth_grants {
+		edges = append(edges, user.EdgeOauthGrants)
+	}
+	if m.clearedteams {
+		edges = append(edges, user.EdgeTeams)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *UserMutation) EdgeCleared(name string) bool {
+	switch name {
+	case user.EdgeGroup:
+		return m.clearedgroup
+	case user.EdgeFiles:
+		return m.clearedfiles
+	case user.EdgeDavAccounts:
+		return m.cleareddav_accounts
+	case user.EdgeShares:
+		return m.clearedshares
+	case user.EdgePasskey:
+		return m.clearedpasskey
+	case user.EdgeTasks:
+		return m.clearedtasks
+	case user.EdgeFsevents:
+		return m.clearedfsevents
+	case user.EdgeEntities:
+		return m.clearedentities
+	case user.EdgeOauthGrants:
+		return m.clearedoauth_grants
+	case user.EdgeTeams:
+		return m.clearedteams
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *UserMutation) ClearEdge(name string) error {
+	switch name {
+	case user.EdgeGroup:
+		m.ClearGroup()
+		return nil
+	}
+	return fmt.Errorf("unknown User unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *UserMutation) ResetEdge(name string) error {
+	switch name {
+	case user.EdgeGroup:
+		m.ResetGroup()
+		return nil
+	case user.EdgeFiles:
+		m.ResetFiles()
+		return nil
+	case user.EdgeDavAccounts:
+		m.ResetDavAccounts()
+		return nil
+	case user.EdgeShares:
+		m.ResetShares()
+		return nil
+	case user.EdgePasskey:
+		m.ResetPasskey()
+		return nil
+	case user.EdgeTasks:
+		m.ResetTasks()
+		return nil
+	case user.EdgeFsevents:
+		m.ResetFsevents()
+		return nil
+	case user.EdgeEntities:
+		m.ResetEntities()
+		return nil
+	case user.EdgeOauthGrants:
+		m.ResetOauthGrants()
+		return nil
+	case user.EdgeTeams:
+		m.ResetTeams()
+		return nil
+	}
+	return fmt.Errorf("unknown User edge %s", name)
+}
+
+// WebhookMutation represents an operation that mutates the Webhook nodes in the graph.
+type WebhookMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	created_at    *time.Time
+	updated_at    *time.Time
+	deleted_at    *time.Time
+	name          *string
+	url           *string
+	secret        *string
+	events        *[]string
+	appendevents  []string
+	enabled       *bool
+	owner_id      *int
+	addowner_id   *int
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Webhook, error)
+	predicates    []predicate.Webhook
+}
+
+var _ ent.Mutation = (*WebhookMutation)(nil)
+
+// webhookOption allows management of the mutation configuration using functional options.
+type webhookOption func(*WebhookMutation)
+
+// newWebhookMutation creates new mutation for the Webhook entity.
+func newWebhookMutation(c config, op Op, opts ...webhookOption) *WebhookMutation {
+	m := &WebhookMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWebhook,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withWebhookID sets the ID field of the mutation.
+func withWebhookID(id int) webhookOption {
+	return func(m *WebhookMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Webhook
+		)
+		m.oldValue = func(ctx context.Context) (*Webhook, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Webhook.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withWebhook sets the old Webhook of the mutation.
+func withWebhook(node *Webhook) webhookOption {
+	return func(m *WebhookMutation) {
+		m.oldValue = func(context.Context) (*Webhook, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WebhookMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WebhookMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WebhookMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WebhookMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Webhook.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *WebhookMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *WebhookMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *WebhookMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *WebhookMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *WebhookMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *WebhookMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *WebhookMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *WebhookMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *WebhookMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[webhook.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *WebhookMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[webhook.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *WebhookMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, webhook.FieldDeletedAt)
+}
+
+// SetName sets the "name" field.
+func (m *WebhookMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *WebhookMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *WebhookMutation) ResetName() {
+	m.name = nil
+}
+
+// SetURL sets the "url" field.
+func (m *WebhookMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
+func (m *WebhookMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURL returns the old "url" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
+}
+
+// ResetURL resets all changes to the "url" field.
+func (m *WebhookMutation) ResetURL() {
+	m.url = nil
+}
+
+// SetSecret sets the "secret" field.
+func (m *WebhookMutation) SetSecret(s string) {
+	m.secret = &s
+}
+
+// Secret returns the value of the "secret" field in the mutation.
+func (m *WebhookMutation) Secret() (r string, exists bool) {
+	v := m.secret
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSecret returns the old "secret" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldSecret(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSecret requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
+	}
+	return oldValue.Secret, nil
+}
+
+// ResetSecret resets all changes to the "secret" field.
+func (m *WebhookMutation) ResetSecret() {
+	m.secret = nil
+}
+
+// SetEvents sets the "events" field.
+func (m *WebhookMutation) SetEvents(s []string) {
+	m.events = &s
+	m.appendevents = nil
+}
+
+// Events returns the value of the "events" field in the mutation.
+func (m *WebhookMutation) Events() (r []string, exists bool) {
+	v := m.events
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEvents returns the old "events" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldEvents(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEvents is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEvents requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEvents: %w", err)
+	}
+	return oldValue.Events, nil
+}
+
+// AppendEvents adds s to the "events" field.
+func (m *WebhookMutation) AppendEvents(s []string) {
+	m.appendevents = append(m.appendevents, s...)
+}
+
+// AppendedEvents returns the list of values that were appended to the "events" field in this mutation.
+func (m *WebhookMutation) AppendedEvents() ([]string, bool) {
+	if len(m.appendevents) == 0 {
+		return nil, false
+	}
+	return m.appendevents, true
+}
+
+// ClearEvents clears the value of the "events" field.
+func (m *WebhookMutation) ClearEvents() {
+	m.events = nil
+	m.appendevents = nil
+	m.clearedFields[webhook.FieldEvents] = struct{}{}
+}
+
+// EventsCleared returns if the "events" field was cleared in this mutation.
+func (m *WebhookMutation) EventsCleared() bool {
+	_, ok := m.clearedFields[webhook.FieldEvents]
+	return ok
+}
+
+// ResetEvents resets all changes to the "events" field.
+func (m *WebhookMutation) ResetEvents() {
+	m.events = nil
+	m.appendevents = nil
+	delete(m.clearedFields, webhook.FieldEvents)
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *WebhookMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *WebhookMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *WebhookMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (m *WebhookMutation) SetOwnerID(i int) {
+	m.owner_id = &i
+	m.addowner_id = nil
+}
+
+// OwnerID returns the value of the "owner_id" field in the mutation.
+func (m *WebhookMutation) OwnerID() (r int, exists bool) {
+	v := m.owner_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwnerID returns the old "owner_id" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldOwnerID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
+	}
+	return oldValue.OwnerID, nil
+}
+
+// AddOwnerID adds i to the "owner_id" field.
+func (m *WebhookMutation) AddOwnerID(i int) {
+	if m.addowner_id != nil {
+		*m.addowner_id += i
+	} else {
+		m.addowner_id = &i
+	}
+}
+
+// AddedOwnerID returns the value that was added to the "owner_id" field in this mutation.
+func (m *WebhookMutation) AddedOwnerID() (r int, exists bool) {
+	v := m.addowner_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (m *WebhookMutation) ClearOwnerID() {
+	m.owner_id = nil
+	m.addowner_id = nil
+	m.clearedFields[webhook.FieldOwnerID] = struct{}{}
+}
+
+// OwnerIDCleared returns if the "owner_id" field was cleared in this mutation.
+func (m *WebhookMutation) OwnerIDCleared() bool {
+	_, ok := m.clearedFields[webhook.FieldOwnerID]
+	return ok
+}
+
+// ResetOwnerID resets all changes to the "owner_id" field.
+func (m *WebhookMutation) ResetOwnerID() {
+	m.owner_id = nil
+	m.addowner_id = nil
+	delete(m.clearedFields, webhook.FieldOwnerID)
+}
+
+// Where appends a list predicates to the WebhookMutation builder.
+func (m *WebhookMutation) Where(ps ...predicate.Webhook) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the WebhookMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *WebhookMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Webhook, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *WebhookMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *WebhookMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Webhook).
+func (m *WebhookMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *WebhookMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.created_at != nil {
+		fields = append(fields, webhook.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, webhook.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, webhook.FieldDeletedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, webhook.FieldName)
+	}
+	if m.url != nil {
+		fields = append(fields, webhook.FieldURL)
+	}
+	if m.secret != nil {
+		fields = append(fields, webhook.FieldSecret)
+	}
+	if m.events != nil {
+		fields = append(fields, webhook.FieldEvents)
+	}
+	if m.enabled != nil {
+		fields = append(fields, webhook.FieldEnabled)
+	}
+	if m.owner_id != nil {
+		fields = append(fields, webhook.FieldOwnerID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *WebhookMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case webhook.FieldCreatedAt:
+		return m.CreatedAt()
+	case webhook.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case webhook.FieldDeletedAt:
+		return m.DeletedAt()
+	case webhook.FieldName:
+		return m.Name()
+	case webhook.FieldURL:
+		return m.URL()
+	case webhook.FieldSecret:
+		return m.Secret()
+	case webhook.FieldEvents:
+		return m.Events()
+	case webhook.FieldEnabled:
+		return m.Enabled()
+	case webhook.FieldOwnerID:
+		return m.OwnerID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *WebhookMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case webhook.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case webhook.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case webhook.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case webhook.FieldName:
+		return m.OldName(ctx)
+	case webhook.FieldURL:
+		return m.OldURL(ctx)
+	case webhook.FieldSecret:
+		return m.OldSecret(ctx)
+	case webhook.FieldEvents:
+		return m.OldEvents(ctx)
+	case webhook.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case webhook.FieldOwnerID:
+		return m.OldOwnerID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Webhook field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WebhookMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case webhook.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case webhook.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case webhook.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case webhook.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case webhook.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
+	case webhook.FieldSecret:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecret(v)
+		return nil
+	case webhook.FieldEvents:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEvents(v)
+		return nil
+	case webhook.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case webhook.FieldOwnerID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwnerID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Webhook field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *WebhookMutation) AddedFields() []string {
+	var fields []string
+	if m.addowner_id != nil {
+		fields = append(fields, webhook.FieldOwnerID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *WebhookMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case webhook.FieldOwnerID:
+		return m.AddedOwnerID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WebhookMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case webhook.FieldOwnerID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOwnerID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Webhook numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *WebhookMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(webhook.FieldDeletedAt) {
+		fields = append(fields, webhook.FieldDeletedAt)
+	}
+	if m.FieldCleared(webhook.FieldEvents) {
+		fields = append(fields, webhook.FieldEvents)
+	}
+	if m.FieldCleared(webhook.FieldOwnerID) {
+		fields = append(fields, webhook.FieldOwnerID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *WebhookMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *WebhookMutation) ClearField(name string) error {
+	switch name {
+	case webhook.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case webhook.FieldEvents:
+		m.ClearEvents()
+		return nil
+	case webhook.FieldOwnerID:
+		m.ClearOwnerID()
+		return nil
+	}
+	return fmt.Errorf("unknown Webhook nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *WebhookMutation) ResetField(name string) error {
+	switch name {
+	case webhook.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case webhook.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case webhook.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case webhook.FieldName:
+		m.ResetName()
+		return nil
+	case webhook.FieldURL:
+		m.ResetURL()
+		return nil
+	case webhook.FieldSecret:
+		m.ResetSecret()
+		return nil
+	case webhook.FieldEvents:
+		m.ResetEvents()
+		return nil
+	case webhook.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case webhook.FieldOwnerID:
+		m.ResetOwnerID()
+		return nil
+	}
+	return fmt.Errorf("unknown Webhook field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *WebhookMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *WebhookMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *WebhookMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *WebhookMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *WebhookMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *WebhookMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *WebhookMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Webhook unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *WebhookMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Webhook edge %s", name)
+}
+
+// WebhookDeliveryMutation represents an operation that mutates the WebhookDelivery nodes in the graph.
+type WebhookDeliveryMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	created_at     *time.Time
+	updated_at     *time.Time
+	deleted_at     *time.Time
+	webhook_id     *int
+	addwebhook_id  *int
+	event          *string
+	delivery_id    *uuid.UUID
+	payload        *string
+	status_code    *int
+	addstatus_code *int
+	error          *string
+	attempt        *int
+	addattempt     *int
+	duration       *int64
+	addduration    *int64
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*WebhookDelivery, error)
+	predicates     []predicate.WebhookDelivery
+}
+
+var _ ent.Mutation = (*WebhookDeliveryMutation)(nil)
+
+// webhookdeliveryOption allows management of the mutation configuration using functional options.
+type webhookdeliveryOption func(*WebhookDeliveryMutation)
+
+// newWebhookDeliveryMutation creates new mutation for the WebhookDelivery entity.
+func newWebhookDeliveryMutation(c config, op Op, opts ...webhookdeliveryOption) *WebhookDeliveryMutation {
+	m := &WebhookDeliveryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWebhookDelivery,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withWebhookDeliveryID sets the ID field of the mutation.
+func withWebhookDeliveryID(id int) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *WebhookDelivery
+		)
+		m.oldValue = func(ctx context.Context) (*WebhookDelivery, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().WebhookDelivery.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withWebhookDelivery sets the old WebhookDelivery of the mutation.
+func withWebhookDelivery(node *WebhookDelivery) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
+		m.oldValue = func(context.Context) (*WebhookDelivery, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// Op returns the operation name.
-func (m *UserMutation) Op() Op {
-	return m.op
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WebhookDeliveryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// SetOp allows setting the mutation operation.
-func (m *UserMutation) SetOp(op Op) {
-	m.op = op
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WebhookDeliveryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// Type returns the node type of this mutation (User).
-func (m *UserMutation) Type() string {
-	return m.typ
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WebhookDeliveryMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 12)
-	if m.created_at != nil {
-		fields = append(fields, user.FieldCreatedAt)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WebhookDeliveryMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().WebhookDelivery.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, user.FieldUpdatedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *WebhookDeliveryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *WebhookDeliveryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	if m.deleted_at != nil {
-		fields = append(fields, user.FieldDeletedAt)
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *WebhookDeliveryMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *WebhookDeliveryMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *WebhookDeliveryMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *WebhookDeliveryMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *WebhookDeliveryMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *WebhookDeliveryMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *WebhookDeliveryMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[webhookdelivery.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *WebhookDeliveryMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, webhookdelivery.FieldDeletedAt)
+}
+
+// SetWebhookID sets the "webhook_id" field.
+func (m *WebhookDeliveryMutation) SetWebhookID(i int) {
+	m.webhook_id = &i
+	m.addwebhook_id = nil
+}
+
+// WebhookID returns the value of the "webhook_id" field in the mutation.
+func (m *WebhookDeliveryMutation) WebhookID() (r int, exists bool) {
+	v := m.webhook_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWebhookID returns the old "webhook_id" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldWebhookID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWebhookID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWebhookID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWebhookID: %w", err)
+	}
+	return oldValue.WebhookID, nil
+}
+
+// AddWebhookID adds i to the "webhook_id" field.
+func (m *WebhookDeliveryMutation) AddWebhookID(i int) {
+	if m.addwebhook_id != nil {
+		*m.addwebhook_id += i
+	} else {
+		m.addwebhook_id = &i
+	}
+}
+
+// AddedWebhookID returns the value that was added to the "webhook_id" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedWebhookID() (r int, exists bool) {
+	v := m.addwebhook_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetWebhookID resets all changes to the "webhook_id" field.
+func (m *WebhookDeliveryMutation) ResetWebhookID() {
+	m.webhook_id = nil
+	m.addwebhook_id = nil
+}
+
+// SetEvent sets the "event" field.
+func (m *WebhookDeliveryMutation) SetEvent(s string) {
+	m.event = &s
+}
+
+// Event returns the value of the "event" field in the mutation.
+func (m *WebhookDeliveryMutation) Event() (r string, exists bool) {
+	v := m.event
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEvent returns the old "event" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldEvent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEvent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEvent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEvent: %w", err)
+	}
+	return oldValue.Event, nil
+}
+
+// ResetEvent resets all changes to the "event" field.
+func (m *WebhookDeliveryMutation) ResetEvent() {
+	m.event = nil
+}
+
+// SetDeliveryID sets the "delivery_id" field.
+func (m *WebhookDeliveryMutation) SetDeliveryID(u uuid.UUID) {
+	m.delivery_id = &u
+}
+
+// DeliveryID returns the value of the "delivery_id" field in the mutation.
+func (m *WebhookDeliveryMutation) DeliveryID() (r uuid.UUID, exists bool) {
+	v := m.delivery_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeliveryID returns the old "delivery_id" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldDeliveryID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeliveryID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeliveryID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeliveryID: %w", err)
+	}
+	return oldValue.DeliveryID, nil
+}
+
+// ResetDeliveryID resets all changes to the "delivery_id" field.
+func (m *WebhookDeliveryMutation) ResetDeliveryID() {
+	m.delivery_id = nil
+}
+
+// SetPayload sets the "payload" field.
+func (m *WebhookDeliveryMutation) SetPayload(s string) {
+	m.payload = &s
+}
+
+// Payload returns the value of the "payload" field in the mutation.
+func (m *WebhookDeliveryMutation) Payload() (r string, exists bool) {
+	v := m.payload
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPayload returns the old "payload" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldPayload(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+	}
+	return oldValue.Payload, nil
+}
+
+// ResetPayload resets all changes to the "payload" field.
+func (m *WebhookDeliveryMutation) ResetPayload() {
+	m.payload = nil
+}
+
+// SetStatusCode sets the "status_code" field.
+func (m *WebhookDeliveryMutation) SetStatusCode(i int) {
+	m.status_code = &i
+	m.addstatus_code = nil
+}
+
+// StatusCode returns the value of the "status_code" field in the mutation.
+func (m *WebhookDeliveryMutation) StatusCode() (r int, exists bool) {
+	v := m.status_code
+	if v == nil {
+		return
 	}
-	if m.email != nil {
-		fields = append(fields, user.FieldEmail)
+	return *v, true
+}
+
+// OldStatusCode returns the old "status_code" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldStatusCode(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatusCode is only allowed on UpdateOne operations")
 	}
-	if m.nick != nil {
-		fields = append(fields, user.FieldNick)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatusCode requires an ID field in the mutation")
 	}
-	if m.password != nil {
-		fields = append(fields, user.FieldPassword)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatusCode: %w", err)
 	}
-	if m.status != nil {
-		fields = append(fields, user.FieldStatus)
+	return oldValue.StatusCode, nil
+}
+
+// AddStatusCode adds i to the "status_code" field.
+func (m *WebhookDeliveryMutation) AddStatusCode(i int) {
+	if m.addstatus_code != nil {
+		*m.addstatus_code += i
+	} else {
+		m.addstatus_code = &i
 	}
-	if m.storage != nil {
-		fields = append(fields, user.FieldStorage)
+}
+
+// AddedStatusCode returns the value that was added to the "status_code" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedStatusCode() (r int, exists bool) {
+	v := m.addstatus_code
+	if v == nil {
+		return
 	}
-	if m.two_factor_secret != nil {
-		fields = append(fields, user.FieldTwoFactorSecret)
+	return *v, true
+}
+
+// ResetStatusCode resets all changes to the "status_code" field.
+func (m *WebhookDeliveryMutation) ResetStatusCode() {
+	m.status_code = nil
+	m.addstatus_code = nil
+}
+
+// SetError sets the "error" field.
+func (m *WebhookDeliveryMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *WebhookDeliveryMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
 	}
-	if m.avatar != nil {
-		fields = append(fields, user.FieldAvatar)
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
 	}
-	if m.settings != nil {
-		fields = append(fields, user.FieldSettings)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
 	}
-	if m.group != nil {
-		fields = append(fields, user.FieldGroupUsers)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
 	}
-	return fields
+	return oldValue.Error, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *UserMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case user.FieldCreatedAt:
-		return m.CreatedAt()
-	case user.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case user.FieldDeletedAt:
-		return m.DeletedAt()
-	case user.FieldEmail:
-		return m.Email()
-	case user.FieldNick:
-		return m.Nick()
-	case user.FieldPassword:
-		return m.Password()
-	case user.FieldStatus:
-		return m.Status()
-	case user.FieldStorage:
-		return m.Storage()
-	case user.FieldTwoFactorSecret:
-		return m.TwoFactorSecret()
-	case user.FieldAvatar:
-		return m.Avatar()
-	case user.FieldSettings:
-		return m.Settings()
-	case user.FieldGroupUsers:
-		return m.GroupUsers()
-	}
-	return nil, false
+// ClearError clears the value of the "error" field.
+func (m *WebhookDeliveryMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[webhookdelivery.FieldError] = struct{}{}
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case user.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case user.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case user.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
-	case user.FieldEmail:
-		return m.OldEmail(ctx)
-	case user.FieldNick:
-		return m.OldNick(ctx)
-	case user.FieldPassword:
-		return m.OldPassword(ctx)
-	case user.FieldStatus:
-		return m.OldStatus(ctx)
-	case user.FieldStorage:
-		return m.OldStorage(ctx)
-	case user.FieldTwoFactorSecret:
-		return m.OldTwoFactorSecret(ctx)
-	case user.FieldAvatar:
-		return m.OldAvatar(ctx)
-	case user.FieldSettings:
-		return m.OldSettings(ctx)
-	case user.FieldGroupUsers:
-		return m.OldGroupUsers(ctx)
-	}
-	return nil, fmt.Errorf("unknown User field %s", name)
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldError]
+	return ok
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *UserMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case user.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case user.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case user.FieldDeletedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDeletedAt(v)
-		return nil
-	case user.FieldEmail:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEmail(v)
-		return nil
-	case user.FieldNick:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetNick(v)
-		return nil
-	case user.FieldPassword:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPassword(v)
-		return nil
-	case user.FieldStatus:
-		v, ok := value.(user.Status)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case user.FieldStorage:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStorage(v)
-		return nil
-	case user.FieldTwoFactorSecret:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTwoFactorSecret(v)
-		return nil
-	case user.FieldAvatar:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAvatar(v)
-		return nil
-	case user.FieldSettings:
-		v, ok := value.(*types.UserSetting)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSettings(v)
-		return nil
-	case user.FieldGroupUsers:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetGroupUsers(v)
-		return nil
+// ResetError resets all changes to the "error" field.
+func (m *WebhookDeliveryMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, webhookdelivery.FieldError)
+}
+
+// SetAttempt sets the "attempt" field.
+func (m *WebhookDeliveryMutation) SetAttempt(i int) {
+	m.attempt = &i
+	m.addattempt = nil
+}
+
+// Attempt returns the value of the "attempt" field in the mutation.
+func (m *WebhookDeliveryMutation) Attempt() (r int, exists bool) {
+	v := m.attempt
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return *v, true
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *UserMutation) AddedFields() []string {
-	var fields []string
-	if m.addstorage != nil {
-		fields = append(fields, user.FieldStorage)
+// OldAttempt returns the old "attempt" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldAttempt(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempt is only allowed on UpdateOne operations")
 	}
-	return fields
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempt: %w", err)
+	}
+	return oldValue.Attempt, nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case user.FieldStorage:
-		return m.AddedStorage()
+// AddAttempt adds i to the "attempt" field.
+func (m *WebhookDeliveryMutation) AddAttempt(i int) {
+	if m.addattempt != nil {
+		*m.addattempt += i
+	} else {
+		m.addattempt = &i
 	}
-	return nil, false
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *UserMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case user.FieldStorage:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddStorage(v)
-		return nil
+// AddedAttempt returns the value that was added to the "attempt" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedAttempt() (r int, exists bool) {
+	v := m.addattempt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempt resets all changes to the "attempt" field.
+func (m *WebhookDeliveryMutation) ResetAttempt() {
+	m.attempt = nil
+	m.addattempt = nil
+}
+
+// SetDuration sets the "duration" field.
+func (m *WebhookDeliveryMutation) SetDuration(i int64) {
+	m.duration = &i
+	m.addduration = nil
+}
+
+// Duration returns the value of the "duration" field in the mutation.
+func (m *WebhookDeliveryMutation) Duration() (r int64, exists bool) {
+	v := m.duration
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown User numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *UserMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(user.FieldDeletedAt) {
-		fields = append(fields, user.FieldDeletedAt)
+// OldDuration returns the old "duration" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldDuration(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDuration is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(user.FieldPassword) {
-		fields = append(fields, user.FieldPassword)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDuration requires an ID field in the mutation")
 	}
-	if m.FieldCleared(user.FieldTwoFactorSecret) {
-		fields = append(fields, user.FieldTwoFactorSecret)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDuration: %w", err)
 	}
-	if m.FieldCleared(user.FieldAvatar) {
-		fields = append(fields, user.FieldAvatar)
+	return oldValue.Duration, nil
+}
+
+// AddDuration adds i to the "duration" field.
+func (m *WebhookDeliveryMutation) AddDuration(i int64) {
+	if m.addduration != nil {
+		*m.addduration += i
+	} else {
+		m.addduration = &i
 	}
-	if m.FieldCleared(user.FieldSettings) {
-		fields = append(fields, user.FieldSettings)
+}
+
+// AddedDuration returns the value that was added to the "duration" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedDuration() (r int64, exists bool) {
+	v := m.addduration
+	if v == nil {
+		return
 	}
-	return fields
+	return *v, true
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *UserMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// ResetDuration resets all changes to the "duration" field.
+func (m *WebhookDeliveryMutation) ResetDuration() {
+	m.duration = nil
+	m.addduration = nil
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *UserMutation) ClearField(name string) error {
-	switch name {
-	case user.FieldDeletedAt:
-		m.ClearDeletedAt()
-		return nil
-	case user.FieldPassword:
-		m.ClearPassword()
-		return nil
-	case user.FieldTwoFactorSecret:
-		m.ClearTwoFactorSecret()
-		return nil
-	case user.FieldAvatar:
-		m.ClearAvatar()
-		return nil
-	case user.FieldSettings:
-		m.ClearSettings()
-		return nil
-	}
-	return fmt.Errorf("unknown User nullable field %s", name)
+// Where appends a list predicates to the WebhookDeliveryMutation builder.
+func (m *WebhookDeliveryMutation) Where(ps ...predicate.WebhookDelivery) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *UserMutation) ResetField(name string) error {
-	switch name {
-	case user.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case user.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case user.FieldDeletedAt:
-		m.ResetDeletedAt()
-		return nil
-	case user.FieldEmail:
-		m.ResetEmail()
-		return nil
-	case user.FieldNick:
-		m.ResetNick()
-		return nil
-	case user.FieldPassword:
-		m.ResetPassword()
-		return nil
-	case user.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case user.FieldStorage:
-		m.ResetStorage()
-		return nil
-	case user.FieldTwoFactorSecret:
-		m.ResetTwoFactorSecret()
-		return nil
-	case user.FieldAvatar:
-		m.ResetAvatar()
-		return nil
-	case user.FieldSettings:
-		m.ResetSettings()
-		return nil
-	case user.FieldGroupUsers:
-		m.ResetGroupUsers()
-		return nil
+// WhereP appends storage-level predicates to the WebhookDeliveryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *WebhookDeliveryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WebhookDelivery, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	m.Where(p...)
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 9)
-	if m.group != nil {
-		edges = append(edges, user.EdgeGroup)
+// Op returns the operation name.
+func (m *WebhookDeliveryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *WebhookDeliveryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (WebhookDelivery).
+func (m *WebhookDeliveryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *WebhookDeliveryMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.created_at != nil {
+		fields = append(fields, webhookdelivery.FieldCreatedAt)
 	}
-	if m.files != nil {
-		edges = append(edges, user.EdgeFiles)
+	if m.updated_at != nil {
+		fields = append(fields, webhookdelivery.FieldUpdatedAt)
 	}
-	if m.dav_accounts != nil {
-		edges = append(edges, user.EdgeDavAccounts)
+	if m.deleted_at != nil {
+		fields = append(fields, webhookdelivery.FieldDeletedAt)
 	}
-	if m.shares != nil {
-		edges = append(edges, user.EdgeShares)
+	if m.webhook_id != nil {
+		fields = append(fields, webhookdelivery.FieldWebhookID)
 	}
-	if m.passkey != nil {
-		edges = append(edges, user.EdgePasskey)
+	if m.event != nil {
+		fields = append(fields, webhookdelivery.FieldEvent)
 	}
-	if m.tasks != nil {
-		edges = append(edges, user.EdgeTasks)
+	if m.delivery_id != nil {
+		fields = append(fields, webhookdelivery.FieldDeliveryID)
 	}
-	if m.fsevents != nil {
-		edges = append(edges, user.EdgeFsevents)
+	if m.payload != nil {
+		fields = append(fields, webhookdelivery.FieldPayload)
 	}
-	if m.entities != nil {
-		edges = append(edges, user.EdgeEntities)
+	if m.status_code != nil {
+		fields = append(fields, webhookdelivery.FieldStatusCode)
 	}
-	if m.oauth_grants != nil {
-		edges = append(edges, user.EdgeOauthGrants)
+	if m.error != nil {
+		fields = append(fields, webhookdelivery.FieldError)
 	}
-	return edges
+	if m.attempt != nil {
+		fields = append(fields, webhookdelivery.FieldAttempt)
+	}
+	if m.duration != nil {
+		fields = append(fields, webhookdelivery.FieldDuration)
+	}
+	return fields
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *UserMutation) AddedIDs(name string) []ent.Value {
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *WebhookDeliveryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case user.EdgeGroup:
-		if id := m.group; id != nil {
-			return []ent.Value{*id}
+	case webhookdelivery.FieldCreatedAt:
+		return m.CreatedAt()
+	case webhookdelivery.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case webhookdelivery.FieldDeletedAt:
+		return m.DeletedAt()
+	case webhookdelivery.FieldWebhookID:
+		return m.WebhookID()
+	case webhookdelivery.FieldEvent:
+		return m.Event()
+	case webhookdelivery.FieldDeliveryID:
+		return m.DeliveryID()
+	case webhookdelivery.FieldPayload:
+		return m.Payload()
+	case webhookdelivery.FieldStatusCode:
+		return m.StatusCode()
+	case webhookdelivery.FieldError:
+		return m.Error()
+	case webhookdelivery.FieldAttempt:
+		return m.Attempt()
+	case webhookdelivery.FieldDuration:
+		return m.Duration()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *WebhookDeliveryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case webhookdelivery.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case webhookdelivery.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case webhookdelivery.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case webhookdelivery.FieldWebhookID:
+		return m.OldWebhookID(ctx)
+	case webhookdelivery.FieldEvent:
+		return m.OldEvent(ctx)
+	case webhookdelivery.FieldDeliveryID:
+		return m.OldDeliveryID(ctx)
+	case webhookdelivery.FieldPayload:
+		return m.OldPayload(ctx)
+	case webhookdelivery.FieldStatusCode:
+		return m.OldStatusCode(ctx)
+	case webhookdelivery.FieldError:
+		return m.OldError(ctx)
+	case webhookdelivery.FieldAttempt:
+		return m.OldAttempt(ctx)
+	case webhookdelivery.FieldDuration:
+		return m.OldDuration(ctx)
+	}
+	return nil, fmt.Errorf("unknown WebhookDelivery field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WebhookDeliveryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case webhookdelivery.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-	case user.EdgeFiles:
-		ids := make([]ent.Value, 0, len(m.files))
-		for id := range m.files {
-			ids = append(ids, id)
+		m.SetCreatedAt(v)
+		return nil
+	case webhookdelivery.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		return ids
-	case user.EdgeDavAccounts:
-		ids := make([]ent.Value, 0, len(m.dav_accounts))
-		for id := range m.dav_accounts {
-			ids = append(ids, id)
+		m.SetUpdatedAt(v)
+		return nil
+	case webhookdelivery.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		return ids
-	case user.EdgeShares:
-		ids := make([]ent.Value, 0, len(m.shares))
-		for id := range m.shares {
-			ids = append(ids, id)
+		m.SetDeletedAt(v)
+		return nil
+	case webhookdelivery.FieldWebhookID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		return ids
-	case user.EdgePasskey:
-		ids := make([]ent.Value, 0, len(m.passkey))
-		for id := range m.passkey {
-			ids = append(ids, id)
+		m.SetWebhookID(v)
+		return nil
+	case webhookdelivery.FieldEvent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		return ids
-	case user.EdgeTasks:
-		ids := make([]ent.Value, 0, len(m.tasks))
-		for id := range m.tasks {
-			ids = append(ids, id)
+		m.SetEvent(v)
+		return nil
+	case webhookdelivery.FieldDeliveryID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		return ids
-	case user.EdgeFsevents:
-		ids := make([]ent.Value, 0, len(m.fsevents))
-		for id := range m.fsevents {
-			ids = append(ids, id)
+		m.SetDeliveryID(v)
+		return nil
+	case webhookdelivery.FieldPayload:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		return ids
-	case user.EdgeEntities:
-		ids := make([]ent.Value, 0, len(m.entities))
-		for id := range m.entities {
-			ids = append(ids, id)
+		m.SetPayload(v)
+		return nil
+	case webhookdelivery.FieldStatusCode:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatusCode(v)
+		return nil
+	case webhookdelivery.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		return ids
-	case user.EdgeOauthGrants:
-		ids := make([]ent.Value, 0, len(m.oauth_grants))
-		for id := range m.oauth_grants {
-			ids = append(ids, id)
+		m.SetError(v)
+		return nil
+	case webhookdelivery.FieldAttempt:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		return ids
+		m.SetAttempt(v)
+		return nil
+	case webhookdelivery.FieldDuration:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDuration(v)
+		return nil
 	}
-	return nil
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 9)
-	if m.removedfiles != nil {
-		edges = append(edges, user.EdgeFiles)
-	}
-	if m.removeddav_accounts != nil {
-		edges = append(edges, user.EdgeDavAccounts)
-	}
-	if m.removedshares != nil {
-		edges = append(edges, user.EdgeShares)
-	}
-	if m.removedpasskey != nil {
-		edges = append(edges, user.EdgePasskey)
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *WebhookDeliveryMutation) AddedFields() []string {
+	var fields []string
+	if m.addwebhook_id != nil {
+		fields = append(fields, webhookdelivery.FieldWebhookID)
 	}
-	if m.removedtasks != nil {
-		edges = append(edges, user.EdgeTasks)
+	if m.addstatus_code != nil {
+		fields = append(fields, webhookdelivery.FieldStatusCode)
 	}
-	if m.removedfsevents != nil {
-		edges = append(edges, user.EdgeFsevents)
+	if m.addattempt != nil {
+		fields = append(fields, webhookdelivery.FieldAttempt)
 	}
-	if m.removedentities != nil {
-		edges = append(edges, user.EdgeEntities)
+	if m.addduration != nil {
+		fields = append(fields, webhookdelivery.FieldDuration)
 	}
-	if m.removedoauth_grants != nil {
-		edges = append(edges, user.EdgeOauthGrants)
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *WebhookDeliveryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case webhookdelivery.FieldWebhookID:
+		return m.AddedWebhookID()
+	case webhookdelivery.FieldStatusCode:
+		return m.AddedStatusCode()
+	case webhookdelivery.FieldAttempt:
+		return m.AddedAttempt()
+	case webhookdelivery.FieldDuration:
+		return m.AddedDuration()
 	}
-	return edges
+	return nil, false
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WebhookDeliveryMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case user.EdgeFiles:
-		ids := make([]ent.Value, 0, len(m.removedfiles))
-		for id := range m.removedfiles {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeDavAccounts:
-		ids := make([]ent.Value, 0, len(m.removeddav_accounts))
-		for id := range m.removeddav_accounts {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeShares:
-		ids := make([]ent.Value, 0, len(m.removedshares))
-		for id := range m.removedshares {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgePasskey:
-		ids := make([]ent.Value, 0, len(m.removedpasskey))
-		for id := range m.removedpasskey {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeTasks:
-		ids := make([]ent.Value, 0, len(m.removedtasks))
-		for id := range m.removedtasks {
-			ids = append(ids, id)
+	case webhookdelivery.FieldWebhookID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		return ids
-	case user.EdgeFsevents:
-		ids := make([]ent.Value, 0, len(m.removedfsevents))
-		for id := range m.removedfsevents {
-			ids = append(ids, id)
+		m.AddWebhookID(v)
+		return nil
+	case webhookdelivery.FieldStatusCode:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		return ids
-	case user.EdgeEntities:
-		ids := make([]ent.Value, 0, len(m.removedentities))
-		for id := range m.removedentities {
-			ids = append(ids, id)
+		m.AddStatusCode(v)
+		return nil
+	case webhookdelivery.FieldAttempt:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		return ids
-	case user.EdgeOauthGrants:
-		ids := make([]ent.Value, 0, len(m.removedoauth_grants))
-		for id := range m.removedoauth_grants {
-			ids = append(ids, id)
+		m.AddAttempt(v)
+		return nil
+	case webhookdelivery.FieldDuration:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		return ids
+		m.AddDuration(v)
+		return nil
 	}
-	return nil
+	return fmt.Errorf("unknown WebhookDelivery numeric field %s", name)
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 9)
-	if m.clearedgroup {
-		edges = append(edges, user.EdgeGroup)
-	}
-	if m.clearedfiles {
-		edges = append(edges, user.EdgeFiles)
-	}
-	if m.cleareddav_accounts {
-		edges = append(edges, user.EdgeDavAccounts)
-	}
-	if m.clearedshares {
-		edges = append(edges, user.EdgeShares)
-	}
-	if m.clearedpasskey {
-		edges = append(edges, user.EdgePasskey)
-	}
-	if m.clearedtasks {
-		edges = append(edges, user.EdgeTasks)
-	}
-	if m.clearedfsevents {
-		edges = append(edges, user.EdgeFsevents)
-	}
-	if m.clearedentities {
-		edges = append(edges, user.EdgeEntities)
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *WebhookDeliveryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(webhookdelivery.FieldDeletedAt) {
+		fields = append(fields, webhookdelivery.FieldDeletedAt)
 	}
-	if m.clearedoauth_grants {
-		edges = append(edges, user.EdgeOauthGrants)
+	if m.FieldCleared(webhookdelivery.FieldError) {
+		fields = append(fields, webhookdelivery.FieldError)
 	}
-	return edges
+	return fields
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *UserMutation) EdgeCleared(name string) bool {
-	switch name {
-	case user.EdgeGroup:
-		return m.clearedgroup
-	case user.EdgeFiles:
-		return m.clearedfiles
-	case user.EdgeDavAccounts:
-		return m.cleareddav_accounts
-	case user.EdgeShares:
-		return m.clearedshares
-	case user.EdgePasskey:
-		return m.clearedpasskey
-	case user.EdgeTasks:
-		return m.clearedtasks
-	case user.EdgeFsevents:
-		return m.clearedfsevents
-	case user.EdgeEntities:
-		return m.clearedentities
-	case user.EdgeOauthGrants:
-		return m.clearedoauth_grants
-	}
-	return false
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *WebhookDeliveryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *UserMutation) ClearEdge(name string) error {
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *WebhookDeliveryMutation) ClearField(name string) error {
 	switch name {
-	case user.EdgeGroup:
-		m.ClearGroup()
+	case webhookdelivery.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case webhookdelivery.FieldError:
+		m.ClearError()
 		return nil
 	}
-	return fmt.Errorf("unknown User unique edge %s", name)
+	return fmt.Errorf("unknown WebhookDelivery nullable field %s", name)
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *UserMutation) ResetEdge(name string) error {
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *WebhookDeliveryMutation) ResetField(name string) error {
 	switch name {
-	case user.EdgeGroup:
-		m.ResetGroup()
+	case webhookdelivery.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case user.EdgeFiles:
-		m.ResetFiles()
+	case webhookdelivery.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
-	case user.EdgeDavAccounts:
-		m.ResetDavAccounts()
+	case webhookdelivery.FieldDeletedAt:
+		m.ResetDeletedAt()
 		return nil
-	case user.EdgeShares:
-		m.ResetShares()
+	case webhookdelivery.FieldWebhookID:
+		m.ResetWebhookID()
 		return nil
-	case user.EdgePasskey:
-		m.ResetPasskey()
+	case webhookdelivery.FieldEvent:
+		m.ResetEvent()
 		return nil
-	case user.EdgeTasks:
-		m.ResetTasks()
+	case webhookdelivery.FieldDeliveryID:
+		m.ResetDeliveryID()
 		return nil
-	case user.EdgeFsevents:
-		m.ResetFsevents()
+	case webhookdelivery.FieldPayload:
+		m.ResetPayload()
 		return nil
-	case user.EdgeEntities:
-		m.ResetEntities()
+	case webhookdelivery.FieldStatusCode:
+		m.ResetStatusCode()
 		return nil
-	case user.EdgeOauthGrants:
-		m.ResetOauthGrants()
+	case webhookdelivery.FieldError:
+		m.ResetError()
+		return nil
+	case webhookdelivery.FieldAttempt:
+		m.ResetAttempt()
+		return nil
+	case webhookdelivery.FieldDuration:
+		m.ResetDuration()
 		return nil
 	}
-	return fmt.Errorf("unknown User edge %s", name)
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *WebhookDeliveryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *WebhookDeliveryMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *WebhookDeliveryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *WebhookDeliveryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *WebhookDeliveryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *WebhookDeliveryMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *WebhookDeliveryMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown WebhookDelivery unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *WebhookDeliveryMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown WebhookDelivery edge %s", name)
 }
@@ -21,6 +21,9 @@ type File func(*sql.Selector)
 // FsEvent is the predicate function for fsevent builders.
 type FsEvent func(*sql.Selector)
 
+// FsLock is the predicate function for fslock builders.
+type FsLock func(*sql.Selector)
+
 // Group is the predicate function for group builders.
 type Group func(*sql.Selector)
 
@@ -39,17 +42,35 @@ type OAuthGrant func(*sql.Selector)
 // Passkey is the predicate function for passkey builders.
 type Passkey func(*sql.Selector)
 
+// Schedule is the predicate function for schedule builders.
+type Schedule func(*sql.Selector)
+
+// ScheduleRun is the predicate function for schedulerun builders.
+type ScheduleRun func(*sql.Selector)
+
 // Setting is the predicate function for setting builders.
 type Setting func(*sql.Selector)
 
 // Share is the predicate function for share builders.
 type Share func(*sql.Selector)
 
+// ShareAccess is the predicate function for shareaccess builders.
+type ShareAccess func(*sql.Selector)
+
 // StoragePolicy is the predicate function for storagepolicy builders.
 type StoragePolicy func(*sql.Selector)
 
 // Task is the predicate function for task builders.
 type Task func(*sql.Selector)
 
+// Team is the predicate function for team builders.
+type Team func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
+
+// Webhook is the predicate function for webhook builders.
+type Webhook func(*sql.Selector)
+
+// WebhookDelivery is the predicate function for webhookdelivery builders.
+type WebhookDelivery func(*sql.Selector)
@@ -10,18 +10,25 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/ent/entity"
 	"github.com/cloudreve/Cloudreve/v4/ent/file"
 	"github.com/cloudreve/Cloudreve/v4/ent/fsevent"
+	"github.com/cloudreve/Cloudreve/v4/ent/fslock"
 	"github.com/cloudreve/Cloudreve/v4/ent/group"
 	"github.com/cloudreve/Cloudreve/v4/ent/metadata"
 	"github.com/cloudreve/Cloudreve/v4/ent/node"
 	"github.com/cloudreve/Cloudreve/v4/ent/oauthclient"
 	"github.com/cloudreve/Cloudreve/v4/ent/oauthgrant"
 	"github.com/cloudreve/Cloudreve/v4/ent/passkey"
+	"github.com/cloudreve/Cloudreve/v4/ent/schedule"
+	"github.com/cloudreve/Cloudreve/v4/ent/schedulerun"
 	"github.com/cloudreve/Cloudreve/v4/ent/schema"
 	"github.com/cloudreve/Cloudreve/v4/ent/setting"
 	"github.com/cloudreve/Cloudreve/v4/ent/share"
+	"github.com/cloudreve/Cloudreve/v4/ent/shareaccess"
 	"github.com/cloudreve/Cloudreve/v4/ent/storagepolicy"
 	"github.com/cloudreve/Cloudreve/v4/ent/task"
+	"github.com/cloudreve/Cloudreve/v4/ent/team"
 	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/ent/webhook"
+	"github.com/cloudreve/Cloudreve/v4/ent/webhookdelivery"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
 )
 
@@ -129,6 +136,37 @@ func init() {
 	fsevent.DefaultUpdatedAt = fseventDescUpdatedAt.Default.(func() time.Time)
 	// fsevent.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	fsevent.UpdateDefaultUpdatedAt = fseventDescUpdatedAt.UpdateDefault.(func() time.Time)
+	fslockMixin := schema.FsLock{}.Mixin()
+	fslockMixinHooks0 := fslockMixin[0].Hooks()
+	fslock.Hooks[0] = fslockMixinHooks0[0]
+	fslockMixinInters0 := fslockMixin[0].Interceptors()
+	fslock.Interceptors[0] = fslockMixinInters0[0]
+	fslockMixinFields0 := fslockMixin[0].Fields()
+	_ = fslockMixinFields0
+	fslockFields := schema.FsLock{}.Fields()
+	_ = fslockFields
+	// fslockDescCreatedAt is the schema descriptor for created_at field.
+	fslockDescCreatedAt := fslockMixinFields0[0].Descriptor()
+	// fslock.DefaultCreatedAt holds the default value on creation for the created_at field.
+	fslock.DefaultCreatedAt = fslockDescCreatedAt.Default.(func() time.Time)
+	// fslockDescUpdatedAt is the schema descriptor for updated_at field.
+	fslockDescUpdatedAt := fslockMixinFields0[1].Descriptor()
+	// fslock.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	fslock.DefaultUpdatedAt = fslockDescUpdatedAt.Default.(func() time.Time)
+	// fslock.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	fslock.UpdateDefaultUpdatedAt = fslockDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// fslockDescZeroDepth is the schema descriptor for zero_depth field.
+	fslockDescZeroDepth := fslockFields[3].Descriptor()
+	// fslock.DefaultZeroDepth holds the default value on creation for the zero_depth field.
+	fslock.DefaultZeroDepth = fslockDescZeroDepth.Default.(bool)
+	// fslockDescFileType is the schema descriptor for file_type field.
+	fslockDescFileType := fslockFields[4].Descriptor()
+	// fslock.DefaultFileType holds the default value on creation for the file_type field.
+	fslock.DefaultFileType = fslockDescFileType.Default.(int)
+	// fslockDescDuration is the schema descriptor for duration field.
+	fslockDescDuration := fslockFields[6].Descriptor()
+	// fslock.DefaultDuration holds the default value on creation for the duration field.
+	fslock.DefaultDuration = fslockDescDuration.Default.(int64)
 	groupMixin := schema.Group{}.Mixin()
 	groupMixinHooks0 := groupMixin[0].Hooks()
 	group.Hooks[0] = groupMixinHooks0[0]
@@ -295,6 +333,48 @@ func init() {
 	passkey.DefaultUpdatedAt = passkeyDescUpdatedAt.Default.(func() time.Time)
 	// passkey.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	passkey.UpdateDefaultUpdatedAt = passkeyDescUpdatedAt.UpdateDefault.(func() time.Time)
+	scheduleMixin := schema.Schedule{}.Mixin()
+	scheduleMixinHooks0 := scheduleMixin[0].Hooks()
+	schedule.Hooks[0] = scheduleMixinHooks0[0]
+	scheduleMixinInters0 := scheduleMixin[0].Interceptors()
+	schedule.Interceptors[0] = scheduleMixinInters0[0]
+	scheduleMixinFields0 := scheduleMixin[0].Fields()
+	_ = scheduleMixinFields0
+	scheduleFields := schema.Schedule{}.Fields()
+	_ = scheduleFields
+	// scheduleDescCreatedAt is the schema descriptor for created_at field.
+	scheduleDescCreatedAt := scheduleMixinFields0[0].Descriptor()
+	// schedule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	schedule.DefaultCreatedAt = scheduleDescCreatedAt.Default.(func() time.Time)
+	// scheduleDescUpdatedAt is the schema descriptor for updated_at field.
+	scheduleDescUpdatedAt := scheduleMixinFields0[1].Descriptor()
+	// schedule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	schedule.DefaultUpdatedAt = scheduleDescUpdatedAt.Default.(func() time.Time)
+	// schedule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	schedule.UpdateDefaultUpdatedAt = scheduleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// scheduleDescEnabled is the schema descriptor for enabled field.
+	scheduleDescEnabled := scheduleFields[4].Descriptor()
+	// schedule.DefaultEnabled holds the default value on creation for the enabled field.
+	schedule.DefaultEnabled = scheduleDescEnabled.Default.(bool)
+	schedulerunMixin := schema.ScheduleRun{}.Mixin()
+	schedulerunMixinHooks0 := schedulerunMixin[0].Hooks()
+	schedulerun.Hooks[0] = schedulerunMixinHooks0[0]
+	schedulerunMixinInters0 := schedulerunMixin[0].Interceptors()
+	schedulerun.Interceptors[0] = schedulerunMixinInters0[0]
+	schedulerunMixinFields0 := schedulerunMixin[0].Fields()
+	_ = schedulerunMixinFields0
+	schedulerunFields := schema.ScheduleRun{}.Fields()
+	_ = schedulerunFields
+	// schedulerunDescCreatedAt is the schema descriptor for created_at field.
+	schedulerunDescCreatedAt := schedulerunMixinFields0[0].Descriptor()
+	// schedulerun.DefaultCreatedAt holds the default value on creation for the created_at field.
+	schedulerun.DefaultCreatedAt = schedulerunDescCreatedAt.Default.(func() time.Time)
+	// schedulerunDescUpdatedAt is the schema descriptor for updated_at field.
+	schedulerunDescUpdatedAt := schedulerunMixinFields0[1].Descriptor()
+	// schedulerun.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	schedulerun.DefaultUpdatedAt = schedulerunDescUpdatedAt.Default.(func() time.Time)
+	// schedulerun.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	schedulerun.UpdateDefaultUpdatedAt = schedulerunDescUpdatedAt.UpdateDefault.(func() time.Time)
 	settingMixin := schema.Setting{}.Mixin()
 	settingMixinHooks0 := settingMixin[0].Hooks()
 	setting.Hooks[0] = settingMixinHooks0[0]
@@ -341,6 +421,29 @@ func init() {
 	shareDescDownloads := shareFields[2].Descriptor()
 	// share.DefaultDownloads holds the default value on creation for the downloads field.
 	share.DefaultDownloads = shareDescDownloads.Default.(int)
+	// shareDescUploads is the schema descriptor for uploads field.
+	shareDescUploads := shareFields[3].Descriptor()
+	// share.DefaultUploads holds the default value on creation for the uploads field.
+	share.DefaultUploads = shareDescUploads.Default.(int)
+	shareaccessMixin := schema.ShareAccess{}.Mixin()
+	shareaccessMixinHooks0 := shareaccessMixin[0].Hooks()
+	shareaccess.Hooks[0] = shareaccessMixinHooks0[0]
+	shareaccessMixinInters0 := shareaccessMixin[0].Interceptors()
+	shareaccess.Interceptors[0] = shareaccessMixinInters0[0]
+	shareaccessMixinFields0 := shareaccessMixin[0].Fields()
+	_ = shareaccessMixinFields0
+	shareaccessFields := schema.ShareAccess{}.Fields()
+	_ = shareaccessFields
+	// shareaccessDescCreatedAt is the schema descriptor for created_at field.
+	shareaccessDescCreatedAt := shareaccessMixinFields0[0].Descriptor()
+	// shareaccess.DefaultCreatedAt holds the default value on creation for the created_at field.
+	shareaccess.DefaultCreatedAt = shareaccessDescCreatedAt.Default.(func() time.Time)
+	// shareaccessDescUpdatedAt is the schema descriptor for updated_at field.
+	shareaccessDescUpdatedAt := shareaccessMixinFields0[1].Descriptor()
+	// shareaccess.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	shareaccess.DefaultUpdatedAt = shareaccessDescUpdatedAt.Default.(func() time.Time)
+	// shareaccess.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	shareaccess.UpdateDefaultUpdatedAt = shareaccessDescUpdatedAt.UpdateDefault.(func() time.Time)
 	storagepolicyMixin := schema.StoragePolicy{}.Mixin()
 	storagepolicyMixinHooks0 := storagepolicyMixin[0].Hooks()
 	storagepolicy.Hooks[0] = storagepolicyMixinHooks0[0]
@@ -383,6 +486,33 @@ func init() {
 	task.DefaultUpdatedAt = taskDescUpdatedAt.Default.(func() time.Time)
 	// task.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	task.UpdateDefaultUpdatedAt = taskDescUpdatedAt.UpdateDefault.(func() time.Time)
+	teamMixin := schema.Team{}.Mixin()
+	teamMixinHooks0 := teamMixin[0].Hooks()
+	team.Hooks[0] = teamMixinHooks0[0]
+	teamMixinInters0 := teamMixin[0].Interceptors()
+	team.Interceptors[0] = teamMixinInters0[0]
+	teamMixinFields0 := teamMixin[0].Fields()
+	_ = teamMixinFields0
+	teamFields := schema.Team{}.Fields()
+	_ = teamFields
+	// teamDescCreatedAt is the schema descriptor for created_at field.
+	teamDescCreatedAt := teamMixinFields0[0].Descriptor()
+	// team.DefaultCreatedAt holds the default value on creation for the created_at field.
+	team.DefaultCreatedAt = teamDescCreatedAt.Default.(func() time.Time)
+	// teamDescUpdatedAt is the schema descriptor for updated_at field.
+	teamDescUpdatedAt := teamMixinFields0[1].Descriptor()
+	// team.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	team.DefaultUpdatedAt = teamDescUpdatedAt.Default.(func() time.Time)
+	// team.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	team.UpdateDefaultUpdatedAt = teamDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// teamDescName is the schema descriptor for name field.
+	teamDescName := teamFields[0].Descriptor()
+	// team.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	team.NameValidator = teamDescName.Validators[0].(func(string) error)
+	// teamDescSettings is the schema descriptor for settings field.
+	teamDescSettings := teamFields[1].Descriptor()
+	// team.DefaultSettings holds the default value on creation for the settings field.
+	team.DefaultSettings = teamDescSettings.Default.(*types.TeamSetting)
 	userMixin := schema.User{}.Mixin()
 	userMixinHooks0 := userMixin[0].Hooks()
 	user.Hooks[0] = userMixinHooks0[0]
@@ -414,10 +544,68 @@ func init() {
 	userDescStorage := userFields[4].Descriptor()
 	// user.DefaultStorage holds the default value on creation for the storage field.
 	user.DefaultStorage = userDescStorage.Default.(int64)
+	// userDescFileCount is the schema descriptor for file_count field.
+	userDescFileCount := userFields[5].Descriptor()
+	// user.DefaultFileCount holds the default value on creation for the file_count field.
+	user.DefaultFileCount = userDescFileCount.Default.(int)
 	// userDescSettings is the schema descriptor for settings field.
-	userDescSettings := userFields[7].Descriptor()
+	userDescSettings := userFields[8].Descriptor()
 	// user.DefaultSettings holds the default value on creation for the settings field.
 	user.DefaultSettings = userDescSettings.Default.(*types.UserSetting)
+	webhookMixin := schema.Webhook{}.Mixin()
+	webhookMixinHooks0 := webhookMixin[0].Hooks()
+	webhook.Hooks[0] = webhookMixinHooks0[0]
+	webhookMixinInters0 := webhookMixin[0].Interceptors()
+	webhook.Interceptors[0] = webhookMixinInters0[0]
+	webhookMixinFields0 := webhookMixin[0].Fields()
+	_ = webhookMixinFields0
+	webhookFields := schema.Webhook{}.Fields()
+	_ = webhookFields
+	// webhookDescCreatedAt is the schema descriptor for created_at field.
+	webhookDescCreatedAt := webhookMixinFields0[0].Descriptor()
+	// webhook.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhook.DefaultCreatedAt = webhookDescCreatedAt.Default.(func() time.Time)
+	// webhookDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookDescUpdatedAt := webhookMixinFields0[1].Descriptor()
+	// webhook.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhook.DefaultUpdatedAt = webhookDescUpdatedAt.Default.(func() time.Time)
+	// webhook.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhook.UpdateDefaultUpdatedAt = webhookDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// webhookDescEnabled is the schema descriptor for enabled field.
+	webhookDescEnabled := webhookFields[4].Descriptor()
+	// webhook.DefaultEnabled holds the default value on creation for the enabled field.
+	webhook.DefaultEnabled = webhookDescEnabled.Default.(bool)
+	webhookdeliveryMixin := schema.WebhookDelivery{}.Mixin()
+	webhookdeliveryMixinHooks0 := webhookdeliveryMixin[0].Hooks()
+	webhookdelivery.Hooks[0] = webhookdeliveryMixinHooks0[0]
+	webhookdeliveryMixinInters0 := webhookdeliveryMixin[0].Interceptors()
+	webhookdelivery.Interceptors[0] = webhookdeliveryMixinInters0[0]
+	webhookdeliveryMixinFields0 := webhookdeliveryMixin[0].Fields()
+	_ = webhookdeliveryMixinFields0
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	webhookdeliveryDescCreatedAt := webhookdeliveryMixinFields0[0].Descriptor()
+	// webhookdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookdelivery.DefaultCreatedAt = webhookdeliveryDescCreatedAt.Default.(func() time.Time)
+	// webhookdeliveryDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookdeliveryDescUpdatedAt := webhookdeliveryMixinFields0[1].Descriptor()
+	// webhookdelivery.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhookdelivery.DefaultUpdatedAt = webhookdeliveryDescUpdatedAt.Default.(func() time.Time)
+	// webhookdelivery.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhookdelivery.UpdateDefaultUpdatedAt = webhookdeliveryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// webhookdeliveryDescStatusCode is the schema descriptor for status_code field.
+	webhookdeliveryDescStatusCode := webhookdeliveryFields[4].Descriptor()
+	// webhookdelivery.DefaultStatusCode holds the default value on creation for the status_code field.
+	webhookdelivery.DefaultStatusCode = webhookdeliveryDescStatusCode.Default.(int)
+	// webhookdeliveryDescAttempt is the schema descriptor for attempt field.
+	webhookdeliveryDescAttempt := webhookdeliveryFields[6].Descriptor()
+	// webhookdelivery.DefaultAttempt holds the default value on creation for the attempt field.
+	webhookdelivery.DefaultAttempt = webhookdeliveryDescAttempt.Default.(int)
+	// webhookdeliveryDescDuration is the schema descriptor for duration field.
+	webhookdeliveryDescDuration := webhookdeliveryFields[7].Descriptor()
+	// webhookdelivery.DefaultDuration holds the default value on creation for the duration field.
+	webhookdelivery.DefaultDuration = webhookdeliveryDescDuration.Default.(int64)
 }
 
 const (
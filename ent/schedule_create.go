@@ -0,0 +1,1121 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/schedule"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+)
+
+// ScheduleCreate is the builder for creating a Schedule entity.
+type ScheduleCreate struct {
+	config
+	mutation *ScheduleMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sc *ScheduleCreate) SetCreatedAt(t time.Time) *ScheduleCreate {
+	sc.mutation.SetCreatedAt(t)
+	return sc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sc *ScheduleCreate) SetNillableCreatedAt(t *time.Time) *ScheduleCreate {
+	if t != nil {
+		sc.SetCreatedAt(*t)
+	}
+	return sc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sc *ScheduleCreate) SetUpdatedAt(t time.Time) *ScheduleCreate {
+	sc.mutation.SetUpdatedAt(t)
+	return sc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (sc *ScheduleCreate) SetNillableUpdatedAt(t *time.Time) *ScheduleCreate {
+	if t != nil {
+		sc.SetUpdatedAt(*t)
+	}
+	return sc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (sc *ScheduleCreate) SetDeletedAt(t time.Time) *ScheduleCreate {
+	sc.mutation.SetDeletedAt(t)
+	return sc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (sc *ScheduleCreate) SetNillableDeletedAt(t *time.Time) *ScheduleCreate {
+	if t != nil {
+		sc.SetDeletedAt(*t)
+	}
+	return sc
+}
+
+// SetName sets the "name" field.
+func (sc *ScheduleCreate) SetName(s string) *ScheduleCreate {
+	sc.mutation.SetName(s)
+	return sc
+}
+
+// SetType sets the "type" field.
+func (sc *ScheduleCreate) SetType(s schedule.Type) *ScheduleCreate {
+	sc.mutation.SetType(s)
+	return sc
+}
+
+// SetSpec sets the "spec" field.
+func (sc *ScheduleCreate) SetSpec(s string) *ScheduleCreate {
+	sc.mutation.SetSpec(s)
+	return sc
+}
+
+// SetProps sets the "props" field.
+func (sc *ScheduleCreate) SetProps(tp *types.ScheduleProps) *ScheduleCreate {
+	sc.mutation.SetProps(tp)
+	return sc
+}
+
+// SetEnabled sets the "enabled" field.
+func (sc *ScheduleCreate) SetEnabled(b bool) *ScheduleCreate {
+	sc.mutation.SetEnabled(b)
+	return sc
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (sc *ScheduleCreate) SetNillableEnabled(b *bool) *ScheduleCreate {
+	if b != nil {
+		sc.SetEnabled(*b)
+	}
+	return sc
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (sc *ScheduleCreate) SetOwnerID(i int) *ScheduleCreate {
+	sc.mutation.SetOwnerID(i)
+	return sc
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (sc *ScheduleCreate) SetNextRunAt(t time.Time) *ScheduleCreate {
+	sc.mutation.SetNextRunAt(t)
+	return sc
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (sc *ScheduleCreate) SetNillableNextRunAt(t *time.Time) *ScheduleCreate {
+	if t != nil {
+		sc.SetNextRunAt(*t)
+	}
+	return sc
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (sc *ScheduleCreate) SetLastRunAt(t time.Time) *ScheduleCreate {
+	sc.mutation.SetLastRunAt(t)
+	return sc
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (sc *ScheduleCreate) SetNillableLastRunAt(t *time.Time) *ScheduleCreate {
+	if t != nil {
+		sc.SetLastRunAt(*t)
+	}
+	return sc
+}
+
+// Mutation returns the ScheduleMutation object of the builder.
+func (sc *ScheduleCreate) Mutation() *ScheduleMutation {
+	return sc.mutation
+}
+
+// Save creates the Schedule in the database.
+func (sc *ScheduleCreate) Save(ctx context.Context) (*Schedule, error) {
+	if err := sc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, sc.sqlSave, sc.mutation, sc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sc *ScheduleCreate) SaveX(ctx context.Context) *Schedule {
+	v, err := sc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sc *ScheduleCreate) Exec(ctx context.Context) error {
+	_, err := sc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sc *ScheduleCreate) ExecX(ctx context.Context) {
+	if err := sc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sc *ScheduleCreate) defaults() error {
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		if schedule.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized schedule.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := schedule.DefaultCreatedAt()
+		sc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sc.mutation.UpdatedAt(); !ok {
+		if schedule.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized schedule.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := schedule.DefaultUpdatedAt()
+		sc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := sc.mutation.Enabled(); !ok {
+		v := schedule.DefaultEnabled
+		sc.mutation.SetEnabled(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sc *ScheduleCreate) check() error {
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Schedule.created_at"`)}
+	}
+	if _, ok := sc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Schedule.updated_at"`)}
+	}
+	if _, ok := sc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Schedule.name"`)}
+	}
+	if _, ok := sc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "Schedule.type"`)}
+	}
+	if v, ok := sc.mutation.GetType(); ok {
+		if err := schedule.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Schedule.type": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.Spec(); !ok {
+		return &ValidationError{Name: "spec", err: errors.New(`ent: missing required field "Schedule.spec"`)}
+	}
+	if _, ok := sc.mutation.Props(); !ok {
+		return &ValidationError{Name: "props", err: errors.New(`ent: missing required field "Schedule.props"`)}
+	}
+	if _, ok := sc.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "Schedule.enabled"`)}
+	}
+	if _, ok := sc.mutation.OwnerID(); !ok {
+		return &ValidationError{Name: "owner_id", err: errors.New(`ent: missing required field "Schedule.owner_id"`)}
+	}
+	return nil
+}
+
+func (sc *ScheduleCreate) sqlSave(ctx context.Context) (*Schedule, error) {
+	if err := sc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	sc.mutation.id = &_node.ID
+	sc.mutation.done = true
+	return _node, nil
+}
+
+func (sc *ScheduleCreate) createSpec() (*Schedule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Schedule{config: sc.config}
+		_spec = sqlgraph.NewCreateSpec(schedule.Table, sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeInt))
+	)
+
+	if id, ok := sc.mutation.ID(); ok {
+		_node.ID = id
+		id64 := int64(id)
+		_spec.ID.Value = id64
+	}
+
+	_spec.OnConflict = sc.conflict
+	if value, ok := sc.mutation.CreatedAt(); ok {
+		_spec.SetField(schedule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := sc.mutation.UpdatedAt(); ok {
+		_spec.SetField(schedule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := sc.mutation.DeletedAt(); ok {
+		_spec.SetField(schedule.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := sc.mutation.Name(); ok {
+		_spec.SetField(schedule.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := sc.mutation.GetType(); ok {
+		_spec.SetField(schedule.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := sc.mutation.Spec(); ok {
+		_spec.SetField(schedule.FieldSpec, field.TypeString, value)
+		_node.Spec = value
+	}
+	if value, ok := sc.mutation.Props(); ok {
+		_spec.SetField(schedule.FieldProps, field.TypeJSON, value)
+		_node.Props = value
+	}
+	if value, ok := sc.mutation.Enabled(); ok {
+		_spec.SetField(schedule.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := sc.mutation.OwnerID(); ok {
+		_spec.SetField(schedule.FieldOwnerID, field.TypeInt, value)
+		_node.OwnerID = value
+	}
+	if value, ok := sc.mutation.NextRunAt(); ok {
+		_spec.SetField(schedule.FieldNextRunAt, field.TypeTime, value)
+		_node.NextRunAt = &value
+	}
+	if value, ok := sc.mutation.LastRunAt(); ok {
+		_spec.SetField(schedule.FieldLastRunAt, field.TypeTime, value)
+		_node.LastRunAt = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Schedule.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ScheduleUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (sc *ScheduleCreate) OnConflict(opts ...sql.ConflictOption) *ScheduleUpsertOne {
+	sc.conflict = opts
+	return &ScheduleUpsertOne{
+		create: sc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Schedule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (sc *ScheduleCreate) OnConflictColumns(columns ...string) *ScheduleUpsertOne {
+	sc.conflict = append(sc.conflict, sql.ConflictColumns(columns...))
+	return &ScheduleUpsertOne{
+		create: sc,
+	}
+}
+
+type (
+	// ScheduleUpsertOne is the builder for "upsert"-ing
+	//  one Schedule node.
+	ScheduleUpsertOne struct {
+		create *ScheduleCreate
+	}
+
+	// ScheduleUpsert is the "OnConflict" setter.
+	ScheduleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ScheduleUpsert) SetUpdatedAt(v time.Time) *ScheduleUpsert {
+	u.Set(schedule.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ScheduleUpsert) UpdateUpdatedAt() *ScheduleUpsert {
+	u.SetExcluded(schedule.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ScheduleUpsert) SetDeletedAt(v time.Time) *ScheduleUpsert {
+	u.Set(schedule.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ScheduleUpsert) UpdateDeletedAt() *ScheduleUpsert {
+	u.SetExcluded(schedule.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ScheduleUpsert) ClearDeletedAt() *ScheduleUpsert {
+	u.SetNull(schedule.FieldDeletedAt)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *ScheduleUpsert) SetName(v string) *ScheduleUpsert {
+	u.Set(schedule.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ScheduleUpsert) UpdateName() *ScheduleUpsert {
+	u.SetExcluded(schedule.FieldName)
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *ScheduleUpsert) SetType(v schedule.Type) *ScheduleUpsert {
+	u.Set(schedule.FieldType, v)
+	return u
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *ScheduleUpsert) UpdateType() *ScheduleUpsert {
+	u.SetExcluded(schedule.FieldType)
+	return u
+}
+
+// SetSpec sets the "spec" field.
+func (u *ScheduleUpsert) SetSpec(v string) *ScheduleUpsert {
+	u.Set(schedule.FieldSpec, v)
+	return u
+}
+
+// UpdateSpec sets the "spec" field to the value that was provided on create.
+func (u *ScheduleUpsert) UpdateSpec() *ScheduleUpsert {
+	u.SetExcluded(schedule.FieldSpec)
+	return u
+}
+
+// SetProps sets the "props" field.
+func (u *ScheduleUpsert) SetProps(v *types.ScheduleProps) *ScheduleUpsert {
+	u.Set(schedule.FieldProps, v)
+	return u
+}
+
+// UpdateProps sets the "props" field to the value that was provided on create.
+func (u *ScheduleUpsert) UpdateProps() *ScheduleUpsert {
+	u.SetExcluded(schedule.FieldProps)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *ScheduleUpsert) SetEnabled(v bool) *ScheduleUpsert {
+	u.Set(schedule.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *ScheduleUpsert) UpdateEnabled() *ScheduleUpsert {
+	u.SetExcluded(schedule.FieldEnabled)
+	return u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (u *ScheduleUpsert) SetOwnerID(v int) *ScheduleUpsert {
+	u.Set(schedule.FieldOwnerID, v)
+	return u
+}
+
+// UpdateOwnerID sets the "owner_id" field to the value that was provided on create.
+func (u *ScheduleUpsert) UpdateOwnerID() *ScheduleUpsert {
+	u.SetExcluded(schedule.FieldOwnerID)
+	return u
+}
+
+// AddOwnerID adds v to the "owner_id" field.
+func (u *ScheduleUpsert) AddOwnerID(v int) *ScheduleUpsert {
+	u.Add(schedule.FieldOwnerID, v)
+	return u
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (u *ScheduleUpsert) SetNextRunAt(v time.Time) *ScheduleUpsert {
+	u.Set(schedule.FieldNextRunAt, v)
+	return u
+}
+
+// UpdateNextRunAt sets the "next_run_at" field to the value that was provided on create.
+func (u *ScheduleUpsert) UpdateNextRunAt() *ScheduleUpsert {
+	u.SetExcluded(schedule.FieldNextRunAt)
+	return u
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (u *ScheduleUpsert) ClearNextRunAt() *ScheduleUpsert {
+	u.SetNull(schedule.FieldNextRunAt)
+	return u
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (u *ScheduleUpsert) SetLastRunAt(v time.Time) *ScheduleUpsert {
+	u.Set(schedule.FieldLastRunAt, v)
+	return u
+}
+
+// UpdateLastRunAt sets the "last_run_at" field to the value that was provided on create.
+func (u *ScheduleUpsert) UpdateLastRunAt() *ScheduleUpsert {
+	u.SetExcluded(schedule.FieldLastRunAt)
+	return u
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (u *ScheduleUpsert) ClearLastRunAt() *ScheduleUpsert {
+	u.SetNull(schedule.FieldLastRunAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Schedule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ScheduleUpsertOne) UpdateNewValues() *ScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(schedule.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Schedule.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ScheduleUpsertOne) Ignore() *ScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ScheduleUpsertOne) DoNothing() *ScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ScheduleCreate.OnConflict
+// documentation for more info.
+func (u *ScheduleUpsertOne) Update(set func(*ScheduleUpsert)) *ScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ScheduleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ScheduleUpsertOne) SetUpdatedAt(v time.Time) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ScheduleUpsertOne) UpdateUpdatedAt() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ScheduleUpsertOne) SetDeletedAt(v time.Time) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ScheduleUpsertOne) UpdateDeletedAt() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ScheduleUpsertOne) ClearDeletedAt() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *ScheduleUpsertOne) SetName(v string) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ScheduleUpsertOne) UpdateName() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *ScheduleUpsertOne) SetType(v schedule.Type) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *ScheduleUpsertOne) UpdateType() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetSpec sets the "spec" field.
+func (u *ScheduleUpsertOne) SetSpec(v string) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetSpec(v)
+	})
+}
+
+// UpdateSpec sets the "spec" field to the value that was provided on create.
+func (u *ScheduleUpsertOne) UpdateSpec() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateSpec()
+	})
+}
+
+// SetProps sets the "props" field.
+func (u *ScheduleUpsertOne) SetProps(v *types.ScheduleProps) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetProps(v)
+	})
+}
+
+// UpdateProps sets the "props" field to the value that was provided on create.
+func (u *ScheduleUpsertOne) UpdateProps() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateProps()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *ScheduleUpsertOne) SetEnabled(v bool) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *ScheduleUpsertOne) UpdateEnabled() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (u *ScheduleUpsertOne) SetOwnerID(v int) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetOwnerID(v)
+	})
+}
+
+// AddOwnerID adds v to the "owner_id" field.
+func (u *ScheduleUpsertOne) AddOwnerID(v int) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.AddOwnerID(v)
+	})
+}
+
+// UpdateOwnerID sets the "owner_id" field to the value that was provided on create.
+func (u *ScheduleUpsertOne) UpdateOwnerID() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateOwnerID()
+	})
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (u *ScheduleUpsertOne) SetNextRunAt(v time.Time) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetNextRunAt(v)
+	})
+}
+
+// UpdateNextRunAt sets the "next_run_at" field to the value that was provided on create.
+func (u *ScheduleUpsertOne) UpdateNextRunAt() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateNextRunAt()
+	})
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (u *ScheduleUpsertOne) ClearNextRunAt() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.ClearNextRunAt()
+	})
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (u *ScheduleUpsertOne) SetLastRunAt(v time.Time) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetLastRunAt(v)
+	})
+}
+
+// UpdateLastRunAt sets the "last_run_at" field to the value that was provided on create.
+func (u *ScheduleUpsertOne) UpdateLastRunAt() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateLastRunAt()
+	})
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (u *ScheduleUpsertOne) ClearLastRunAt() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.ClearLastRunAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ScheduleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ScheduleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ScheduleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ScheduleUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ScheduleUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+func (m *ScheduleCreate) SetRawID(t int) *ScheduleCreate {
+	m.mutation.SetRawID(t)
+	return m
+}
+
+// ScheduleCreateBulk is the builder for creating many Schedule entities in bulk.
+type ScheduleCreateBulk struct {
+	config
+	err      error
+	builders []*ScheduleCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Schedule entities in the database.
+func (scb *ScheduleCreateBulk) Save(ctx context.Context) ([]*Schedule, error) {
+	if scb.err != nil {
+		return nil, scb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(scb.builders))
+	nodes := make([]*Schedule, len(scb.builders))
+	mutators := make([]Mutator, len(scb.builders))
+	for i := range scb.builders {
+		func(i int, root context.Context) {
+			builder := scb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ScheduleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, scb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = scb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, scb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, scb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scb *ScheduleCreateBulk) SaveX(ctx context.Context) []*Schedule {
+	v, err := scb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scb *ScheduleCreateBulk) Exec(ctx context.Context) error {
+	_, err := scb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scb *ScheduleCreateBulk) ExecX(ctx context.Context) {
+	if err := scb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Schedule.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ScheduleUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (scb *ScheduleCreateBulk) OnConflict(opts ...sql.ConflictOption) *ScheduleUpsertBulk {
+	scb.conflict = opts
+	return &ScheduleUpsertBulk{
+		create: scb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Schedule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (scb *ScheduleCreateBulk) OnConflictColumns(columns ...string) *ScheduleUpsertBulk {
+	scb.conflict = append(scb.conflict, sql.ConflictColumns(columns...))
+	return &ScheduleUpsertBulk{
+		create: scb,
+	}
+}
+
+// ScheduleUpsertBulk is the builder for "upsert"-ing
+// a bulk of Schedule nodes.
+type ScheduleUpsertBulk struct {
+	create *ScheduleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Schedule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ScheduleUpsertBulk) UpdateNewValues() *ScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(schedule.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Schedule.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ScheduleUpsertBulk) Ignore() *ScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ScheduleUpsertBulk) DoNothing() *ScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ScheduleCreateBulk.OnConflict
+// documentation for more info.
+func (u *ScheduleUpsertBulk) Update(set func(*ScheduleUpsert)) *ScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ScheduleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ScheduleUpsertBulk) SetUpdatedAt(v time.Time) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ScheduleUpsertBulk) UpdateUpdatedAt() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ScheduleUpsertBulk) SetDeletedAt(v time.Time) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ScheduleUpsertBulk) UpdateDeletedAt() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ScheduleUpsertBulk) ClearDeletedAt() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *ScheduleUpsertBulk) SetName(v string) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ScheduleUpsertBulk) UpdateName() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *ScheduleUpsertBulk) SetType(v schedule.Type) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *ScheduleUpsertBulk) UpdateType() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetSpec sets the "spec" field.
+func (u *ScheduleUpsertBulk) SetSpec(v string) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetSpec(v)
+	})
+}
+
+// UpdateSpec sets the "spec" field to the value that was provided on create.
+func (u *ScheduleUpsertBulk) UpdateSpec() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateSpec()
+	})
+}
+
+// SetProps sets the "props" field.
+func (u *ScheduleUpsertBulk) SetProps(v *types.ScheduleProps) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetProps(v)
+	})
+}
+
+// UpdateProps sets the "props" field to the value that was provided on create.
+func (u *ScheduleUpsertBulk) UpdateProps() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateProps()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *ScheduleUpsertBulk) SetEnabled(v bool) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *ScheduleUpsertBulk) UpdateEnabled() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (u *ScheduleUpsertBulk) SetOwnerID(v int) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetOwnerID(v)
+	})
+}
+
+// AddOwnerID adds v to the "owner_id" field.
+func (u *ScheduleUpsertBulk) AddOwnerID(v int) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.AddOwnerID(v)
+	})
+}
+
+// UpdateOwnerID sets the "owner_id" field to the value that was provided on create.
+func (u *ScheduleUpsertBulk) UpdateOwnerID() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateOwnerID()
+	})
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (u *ScheduleUpsertBulk) SetNextRunAt(v time.Time) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetNextRunAt(v)
+	})
+}
+
+// UpdateNextRunAt sets the "next_run_at" field to the value that was provided on create.
+func (u *ScheduleUpsertBulk) UpdateNextRunAt() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateNextRunAt()
+	})
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (u *ScheduleUpsertBulk) ClearNextRunAt() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.ClearNextRunAt()
+	})
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (u *ScheduleUpsertBulk) SetLastRunAt(v time.Time) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetLastRunAt(v)
+	})
+}
+
+// UpdateLastRunAt sets the "last_run_at" field to the value that was provided on create.
+func (u *ScheduleUpsertBulk) UpdateLastRunAt() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateLastRunAt()
+	})
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (u *ScheduleUpsertBulk) ClearLastRunAt() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.ClearLastRunAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ScheduleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ScheduleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ScheduleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ScheduleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
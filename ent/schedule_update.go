@@ -0,0 +1,615 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+	"github.com/cloudreve/Cloudreve/v4/ent/schedule"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+)
+
+// ScheduleUpdate is the builder for updating Schedule entities.
+type ScheduleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ScheduleMutation
+}
+
+// Where appends a list predicates to the ScheduleUpdate builder.
+func (su *ScheduleUpdate) Where(ps ...predicate.Schedule) *ScheduleUpdate {
+	su.mutation.Where(ps...)
+	return su
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (su *ScheduleUpdate) SetUpdatedAt(t time.Time) *ScheduleUpdate {
+	su.mutation.SetUpdatedAt(t)
+	return su
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (su *ScheduleUpdate) SetDeletedAt(t time.Time) *ScheduleUpdate {
+	su.mutation.SetDeletedAt(t)
+	return su
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (su *ScheduleUpdate) SetNillableDeletedAt(t *time.Time) *ScheduleUpdate {
+	if t != nil {
+		su.SetDeletedAt(*t)
+	}
+	return su
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (su *ScheduleUpdate) ClearDeletedAt() *ScheduleUpdate {
+	su.mutation.ClearDeletedAt()
+	return su
+}
+
+// SetName sets the "name" field.
+func (su *ScheduleUpdate) SetName(s string) *ScheduleUpdate {
+	su.mutation.SetName(s)
+	return su
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (su *ScheduleUpdate) SetNillableName(s *string) *ScheduleUpdate {
+	if s != nil {
+		su.SetName(*s)
+	}
+	return su
+}
+
+// SetType sets the "type" field.
+func (su *ScheduleUpdate) SetType(s schedule.Type) *ScheduleUpdate {
+	su.mutation.SetType(s)
+	return su
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (su *ScheduleUpdate) SetNillableType(s *schedule.Type) *ScheduleUpdate {
+	if s != nil {
+		su.SetType(*s)
+	}
+	return su
+}
+
+// SetSpec sets the "spec" field.
+func (su *ScheduleUpdate) SetSpec(s string) *ScheduleUpdate {
+	su.mutation.SetSpec(s)
+	return su
+}
+
+// SetNillableSpec sets the "spec" field if the given value is not nil.
+func (su *ScheduleUpdate) SetNillableSpec(s *string) *ScheduleUpdate {
+	if s != nil {
+		su.SetSpec(*s)
+	}
+	return su
+}
+
+// SetProps sets the "props" field.
+func (su *ScheduleUpdate) SetProps(tp *types.ScheduleProps) *ScheduleUpdate {
+	su.mutation.SetProps(tp)
+	return su
+}
+
+// SetEnabled sets the "enabled" field.
+func (su *ScheduleUpdate) SetEnabled(b bool) *ScheduleUpdate {
+	su.mutation.SetEnabled(b)
+	return su
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (su *ScheduleUpdate) SetNillableEnabled(b *bool) *ScheduleUpdate {
+	if b != nil {
+		su.SetEnabled(*b)
+	}
+	return su
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (su *ScheduleUpdate) SetOwnerID(i int) *ScheduleUpdate {
+	su.mutation.ResetOwnerID()
+	su.mutation.SetOwnerID(i)
+	return su
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (su *ScheduleUpdate) SetNillableOwnerID(i *int) *ScheduleUpdate {
+	if i != nil {
+		su.SetOwnerID(*i)
+	}
+	return su
+}
+
+// AddOwnerID adds i to the "owner_id" field.
+func (su *ScheduleUpdate) AddOwnerID(i int) *ScheduleUpdate {
+	su.mutation.AddOwnerID(i)
+	return su
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (su *ScheduleUpdate) SetNextRunAt(t time.Time) *ScheduleUpdate {
+	su.mutation.SetNextRunAt(t)
+	return su
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (su *ScheduleUpdate) SetNillableNextRunAt(t *time.Time) *ScheduleUpdate {
+	if t != nil {
+		su.SetNextRunAt(*t)
+	}
+	return su
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (su *ScheduleUpdate) ClearNextRunAt() *ScheduleUpdate {
+	su.mutation.ClearNextRunAt()
+	return su
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (su *ScheduleUpdate) SetLastRunAt(t time.Time) *ScheduleUpdate {
+	su.mutation.SetLastRunAt(t)
+	return su
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (su *ScheduleUpdate) SetNillableLastRunAt(t *time.Time) *ScheduleUpdate {
+	if t != nil {
+		su.SetLastRunAt(*t)
+	}
+	return su
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (su *ScheduleUpdate) ClearLastRunAt() *ScheduleUpdate {
+	su.mutation.ClearLastRunAt()
+	return su
+}
+
+// Mutation returns the ScheduleMutation object of the builder.
+func (su *ScheduleUpdate) Mutation() *ScheduleMutation {
+	return su.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (su *ScheduleUpdate) Save(ctx context.Context) (int, error) {
+	if err := su.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, su.sqlSave, su.mutation, su.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (su *ScheduleUpdate) SaveX(ctx context.Context) int {
+	affected, err := su.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (su *ScheduleUpdate) Exec(ctx context.Context) error {
+	_, err := su.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (su *ScheduleUpdate) ExecX(ctx context.Context) {
+	if err := su.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (su *ScheduleUpdate) defaults() error {
+	if _, ok := su.mutation.UpdatedAt(); !ok {
+		if schedule.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized schedule.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := schedule.UpdateDefaultUpdatedAt()
+		su.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (su *ScheduleUpdate) check() error {
+	if v, ok := su.mutation.GetType(); ok {
+		if err := schedule.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Schedule.type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (su *ScheduleUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := su.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(schedule.Table, schedule.Columns, sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeInt))
+	if ps := su.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := su.mutation.UpdatedAt(); ok {
+		_spec.SetField(schedule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := su.mutation.DeletedAt(); ok {
+		_spec.SetField(schedule.FieldDeletedAt, field.TypeTime, value)
+	}
+	if su.mutation.DeletedAtCleared() {
+		_spec.ClearField(schedule.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := su.mutation.Name(); ok {
+		_spec.SetField(schedule.FieldName, field.TypeString, value)
+	}
+	if value, ok := su.mutation.GetType(); ok {
+		_spec.SetField(schedule.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := su.mutation.Spec(); ok {
+		_spec.SetField(schedule.FieldSpec, field.TypeString, value)
+	}
+	if value, ok := su.mutation.Props(); ok {
+		_spec.SetField(schedule.FieldProps, field.TypeJSON, value)
+	}
+	if value, ok := su.mutation.Enabled(); ok {
+		_spec.SetField(schedule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := su.mutation.OwnerID(); ok {
+		_spec.SetField(schedule.FieldOwnerID, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.AddedOwnerID(); ok {
+		_spec.AddField(schedule.FieldOwnerID, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.NextRunAt(); ok {
+		_spec.SetField(schedule.FieldNextRunAt, field.TypeTime, value)
+	}
+	if su.mutation.NextRunAtCleared() {
+		_spec.ClearField(schedule.FieldNextRunAt, field.TypeTime)
+	}
+	if value, ok := su.mutation.LastRunAt(); ok {
+		_spec.SetField(schedule.FieldLastRunAt, field.TypeTime, value)
+	}
+	if su.mutation.LastRunAtCleared() {
+		_spec.ClearField(schedule.FieldLastRunAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{schedule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	su.mutation.done = true
+	return n, nil
+}
+
+// ScheduleUpdateOne is the builder for updating a single Schedule entity.
+type ScheduleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ScheduleMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (suo *ScheduleUpdateOne) SetUpdatedAt(t time.Time) *ScheduleUpdateOne {
+	suo.mutation.SetUpdatedAt(t)
+	return suo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (suo *ScheduleUpdateOne) SetDeletedAt(t time.Time) *ScheduleUpdateOne {
+	suo.mutation.SetDeletedAt(t)
+	return suo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (suo *ScheduleUpdateOne) SetNillableDeletedAt(t *time.Time) *ScheduleUpdateOne {
+	if t != nil {
+		suo.SetDeletedAt(*t)
+	}
+	return suo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (suo *ScheduleUpdateOne) ClearDeletedAt() *ScheduleUpdateOne {
+	suo.mutation.ClearDeletedAt()
+	return suo
+}
+
+// SetName sets the "name" field.
+func (suo *ScheduleUpdateOne) SetName(s string) *ScheduleUpdateOne {
+	suo.mutation.SetName(s)
+	return suo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (suo *ScheduleUpdateOne) SetNillableName(s *string) *ScheduleUpdateOne {
+	if s != nil {
+		suo.SetName(*s)
+	}
+	return suo
+}
+
+// SetType sets the "type" field.
+func (suo *ScheduleUpdateOne) SetType(s schedule.Type) *ScheduleUpdateOne {
+	suo.mutation.SetType(s)
+	return suo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (suo *ScheduleUpdateOne) SetNillableType(s *schedule.Type) *ScheduleUpdateOne {
+	if s != nil {
+		suo.SetType(*s)
+	}
+	return suo
+}
+
+// SetSpec sets the "spec" field.
+func (suo *ScheduleUpdateOne) SetSpec(s string) *ScheduleUpdateOne {
+	suo.mutation.SetSpec(s)
+	return suo
+}
+
+// SetNillableSpec sets the "spec" field if the given value is not nil.
+func (suo *ScheduleUpdateOne) SetNillableSpec(s *string) *ScheduleUpdateOne {
+	if s != nil {
+		suo.SetSpec(*s)
+	}
+	return suo
+}
+
+// SetProps sets the "props" field.
+func (suo *ScheduleUpdateOne) SetProps(tp *types.ScheduleProps) *ScheduleUpdateOne {
+	suo.mutation.SetProps(tp)
+	return suo
+}
+
+// SetEnabled sets the "enabled" field.
+func (suo *ScheduleUpdateOne) SetEnabled(b bool) *ScheduleUpdateOne {
+	suo.mutation.SetEnabled(b)
+	return suo
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (suo *ScheduleUpdateOne) SetNillableEnabled(b *bool) *ScheduleUpdateOne {
+	if b != nil {
+		suo.SetEnabled(*b)
+	}
+	return suo
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (suo *ScheduleUpdateOne) SetOwnerID(i int) *ScheduleUpdateOne {
+	suo.mutation.ResetOwnerID()
+	suo.mutation.SetOwnerID(i)
+	return suo
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (suo *ScheduleUpdateOne) SetNillableOwnerID(i *int) *ScheduleUpdateOne {
+	if i != nil {
+		suo.SetOwnerID(*i)
+	}
+	return suo
+}
+
+// AddOwnerID adds i to the "owner_id" field.
+func (suo *ScheduleUpdateOne) AddOwnerID(i int) *ScheduleUpdateOne {
+	suo.mutation.AddOwnerID(i)
+	return suo
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (suo *ScheduleUpdateOne) SetNextRunAt(t time.Time) *ScheduleUpdateOne {
+	suo.mutation.SetNextRunAt(t)
+	return suo
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (suo *ScheduleUpdateOne) SetNillableNextRunAt(t *time.Time) *ScheduleUpdateOne {
+	if t != nil {
+		suo.SetNextRunAt(*t)
+	}
+	return suo
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (suo *ScheduleUpdateOne) ClearNextRunAt() *ScheduleUpdateOne {
+	suo.mutation.ClearNextRunAt()
+	return suo
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (suo *ScheduleUpdateOne) SetLastRunAt(t time.Time) *ScheduleUpdateOne {
+	suo.mutation.SetLastRunAt(t)
+	return suo
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (suo *ScheduleUpdateOne) SetNillableLastRunAt(t *time.Time) *ScheduleUpdateOne {
+	if t != nil {
+		suo.SetLastRunAt(*t)
+	}
+	return suo
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (suo *ScheduleUpdateOne) ClearLastRunAt() *ScheduleUpdateOne {
+	suo.mutation.ClearLastRunAt()
+	return suo
+}
+
+// Mutation returns the ScheduleMutation object of the builder.
+func (suo *ScheduleUpdateOne) Mutation() *ScheduleMutation {
+	return suo.mutation
+}
+
+// Where appends a list predicates to the ScheduleUpdate builder.
+func (suo *ScheduleUpdateOne) Where(ps ...predicate.Schedule) *ScheduleUpdateOne {
+	suo.mutation.Where(ps...)
+	return suo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (suo *ScheduleUpdateOne) Select(field string, fields ...string) *ScheduleUpdateOne {
+	suo.fields = append([]string{field}, fields...)
+	return suo
+}
+
+// Save executes the query and returns the updated Schedule entity.
+func (suo *ScheduleUpdateOne) Save(ctx context.Context) (*Schedule, error) {
+	if err := suo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, suo.sqlSave, suo.mutation, suo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (suo *ScheduleUpdateOne) SaveX(ctx context.Context) *Schedule {
+	node, err := suo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (suo *ScheduleUpdateOne) Exec(ctx context.Context) error {
+	_, err := suo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (suo *ScheduleUpdateOne) ExecX(ctx context.Context) {
+	if err := suo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (suo *ScheduleUpdateOne) defaults() error {
+	if _, ok := suo.mutation.UpdatedAt(); !ok {
+		if schedule.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized schedule.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := schedule.UpdateDefaultUpdatedAt()
+		suo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (suo *ScheduleUpdateOne) check() error {
+	if v, ok := suo.mutation.GetType(); ok {
+		if err := schedule.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Schedule.type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (suo *ScheduleUpdateOne) sqlSave(ctx context.Context) (_node *Schedule, err error) {
+	if err := suo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(schedule.Table, schedule.Columns, sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeInt))
+	id, ok := suo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Schedule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := suo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, schedule.FieldID)
+		for _, f := range fields {
+			if !schedule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != schedule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := suo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := suo.mutation.UpdatedAt(); ok {
+		_spec.SetField(schedule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := suo.mutation.DeletedAt(); ok {
+		_spec.SetField(schedule.FieldDeletedAt, field.TypeTime, value)
+	}
+	if suo.mutation.DeletedAtCleared() {
+		_spec.ClearField(schedule.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := suo.mutation.Name(); ok {
+		_spec.SetField(schedule.FieldName, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.GetType(); ok {
+		_spec.SetField(schedule.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := suo.mutation.Spec(); ok {
+		_spec.SetField(schedule.FieldSpec, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.Props(); ok {
+		_spec.SetField(schedule.FieldProps, field.TypeJSON, value)
+	}
+	if value, ok := suo.mutation.Enabled(); ok {
+		_spec.SetField(schedule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := suo.mutation.OwnerID(); ok {
+		_spec.SetField(schedule.FieldOwnerID, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedOwnerID(); ok {
+		_spec.AddField(schedule.FieldOwnerID, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.NextRunAt(); ok {
+		_spec.SetField(schedule.FieldNextRunAt, field.TypeTime, value)
+	}
+	if suo.mutation.NextRunAtCleared() {
+		_spec.ClearField(schedule.FieldNextRunAt, field.TypeTime)
+	}
+	if value, ok := suo.mutation.LastRunAt(); ok {
+		_spec.SetField(schedule.FieldLastRunAt, field.TypeTime, value)
+	}
+	if suo.mutation.LastRunAtCleared() {
+		_spec.ClearField(schedule.FieldLastRunAt, field.TypeTime)
+	}
+	_node = &Schedule{config: suo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, suo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{schedule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	suo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,167 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/ent/schedulerun"
+)
+
+// ScheduleRun is the model entity for the ScheduleRun schema.
+type ScheduleRun struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// ScheduleID holds the value of the "schedule_id" field.
+	ScheduleID int `json:"schedule_id,omitempty"`
+	// TaskID holds the value of the "task_id" field.
+	TaskID *int `json:"task_id,omitempty"`
+	// Error holds the value of the "error" field.
+	Error        string `json:"error,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ScheduleRun) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case schedulerun.FieldID, schedulerun.FieldScheduleID, schedulerun.FieldTaskID:
+			values[i] = new(sql.NullInt64)
+		case schedulerun.FieldError:
+			values[i] = new(sql.NullString)
+		case schedulerun.FieldCreatedAt, schedulerun.FieldUpdatedAt, schedulerun.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ScheduleRun fields.
+func (sr *ScheduleRun) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case schedulerun.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			sr.ID = int(value.Int64)
+		case schedulerun.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sr.CreatedAt = value.Time
+			}
+		case schedulerun.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				sr.UpdatedAt = value.Time
+			}
+		case schedulerun.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				sr.DeletedAt = new(time.Time)
+				*sr.DeletedAt = value.Time
+			}
+		case schedulerun.FieldScheduleID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field schedule_id", values[i])
+			} else if value.Valid {
+				sr.ScheduleID = int(value.Int64)
+			}
+		case schedulerun.FieldTaskID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field task_id", values[i])
+			} else if value.Valid {
+				sr.TaskID = new(int)
+				*sr.TaskID = int(value.Int64)
+			}
+		case schedulerun.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				sr.Error = value.String
+			}
+		default:
+			sr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ScheduleRun.
+// This includes values selected through modifiers, order, etc.
+func (sr *ScheduleRun) Value(name string) (ent.Value, error) {
+	return sr.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ScheduleRun.
+// Note that you need to call ScheduleRun.Unwrap() before calling this method if this ScheduleRun
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sr *ScheduleRun) Update() *ScheduleRunUpdateOne {
+	return NewScheduleRunClient(sr.config).UpdateOne(sr)
+}
+
+// Unwrap unwraps the ScheduleRun entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sr *ScheduleRun) Unwrap() *ScheduleRun {
+	_tx, ok := sr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ScheduleRun is not a transactional entity")
+	}
+	sr.config.driver = _tx.drv
+	return sr
+}
+
+// String implements the fmt.Stringer.
+func (sr *ScheduleRun) String() string {
+	var builder strings.Builder
+	builder.WriteString("ScheduleRun(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sr.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(sr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(sr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := sr.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("schedule_id=")
+	builder.WriteString(fmt.Sprintf("%v", sr.ScheduleID))
+	builder.WriteString(", ")
+	if v := sr.TaskID; v != nil {
+		builder.WriteString("task_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(sr.Error)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ScheduleRuns is a parsable slice of ScheduleRun.
+type ScheduleRuns []*ScheduleRun
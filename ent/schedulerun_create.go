@@ -0,0 +1,858 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/schedulerun"
+)
+
+// ScheduleRunCreate is the builder for creating a ScheduleRun entity.
+type ScheduleRunCreate struct {
+	config
+	mutation *ScheduleRunMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (src *ScheduleRunCreate) SetCreatedAt(t time.Time) *ScheduleRunCreate {
+	src.mutation.SetCreatedAt(t)
+	return src
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (src *ScheduleRunCreate) SetNillableCreatedAt(t *time.Time) *ScheduleRunCreate {
+	if t != nil {
+		src.SetCreatedAt(*t)
+	}
+	return src
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (src *ScheduleRunCreate) SetUpdatedAt(t time.Time) *ScheduleRunCreate {
+	src.mutation.SetUpdatedAt(t)
+	return src
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (src *ScheduleRunCreate) SetNillableUpdatedAt(t *time.Time) *ScheduleRunCreate {
+	if t != nil {
+		src.SetUpdatedAt(*t)
+	}
+	return src
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (src *ScheduleRunCreate) SetDeletedAt(t time.Time) *ScheduleRunCreate {
+	src.mutation.SetDeletedAt(t)
+	return src
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (src *ScheduleRunCreate) SetNillableDeletedAt(t *time.Time) *ScheduleRunCreate {
+	if t != nil {
+		src.SetDeletedAt(*t)
+	}
+	return src
+}
+
+// SetScheduleID sets the "schedule_id" field.
+func (src *ScheduleRunCreate) SetScheduleID(i int) *ScheduleRunCreate {
+	src.mutation.SetScheduleID(i)
+	return src
+}
+
+// SetTaskID sets the "task_id" field.
+func (src *ScheduleRunCreate) SetTaskID(i int) *ScheduleRunCreate {
+	src.mutation.SetTaskID(i)
+	return src
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (src *ScheduleRunCreate) SetNillableTaskID(i *int) *ScheduleRunCreate {
+	if i != nil {
+		src.SetTaskID(*i)
+	}
+	return src
+}
+
+// SetError sets the "error" field.
+func (src *ScheduleRunCreate) SetError(s string) *ScheduleRunCreate {
+	src.mutation.SetError(s)
+	return src
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (src *ScheduleRunCreate) SetNillableError(s *string) *ScheduleRunCreate {
+	if s != nil {
+		src.SetError(*s)
+	}
+	return src
+}
+
+// Mutation returns the ScheduleRunMutation object of the builder.
+func (src *ScheduleRunCreate) Mutation() *ScheduleRunMutation {
+	return src.mutation
+}
+
+// Save creates the ScheduleRun in the database.
+func (src *ScheduleRunCreate) Save(ctx context.Context) (*ScheduleRun, error) {
+	if err := src.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, src.sqlSave, src.mutation, src.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (src *ScheduleRunCreate) SaveX(ctx context.Context) *ScheduleRun {
+	v, err := src.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (src *ScheduleRunCreate) Exec(ctx context.Context) error {
+	_, err := src.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (src *ScheduleRunCreate) ExecX(ctx context.Context) {
+	if err := src.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (src *ScheduleRunCreate) defaults() error {
+	if _, ok := src.mutation.CreatedAt(); !ok {
+		if schedulerun.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized schedulerun.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := schedulerun.DefaultCreatedAt()
+		src.mutation.SetCreatedAt(v)
+	}
+	if _, ok := src.mutation.UpdatedAt(); !ok {
+		if schedulerun.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized schedulerun.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := schedulerun.DefaultUpdatedAt()
+		src.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (src *ScheduleRunCreate) check() error {
+	if _, ok := src.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ScheduleRun.created_at"`)}
+	}
+	if _, ok := src.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ScheduleRun.updated_at"`)}
+	}
+	if _, ok := src.mutation.ScheduleID(); !ok {
+		return &ValidationError{Name: "schedule_id", err: errors.New(`ent: missing required field "ScheduleRun.schedule_id"`)}
+	}
+	return nil
+}
+
+func (src *ScheduleRunCreate) sqlSave(ctx context.Context) (*ScheduleRun, error) {
+	if err := src.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := src.createSpec()
+	if err := sqlgraph.CreateNode(ctx, src.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	src.mutation.id = &_node.ID
+	src.mutation.done = true
+	return _node, nil
+}
+
+func (src *ScheduleRunCreate) createSpec() (*ScheduleRun, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ScheduleRun{config: src.config}
+		_spec = sqlgraph.NewCreateSpec(schedulerun.Table, sqlgraph.NewFieldSpec(schedulerun.FieldID, field.TypeInt))
+	)
+
+	if id, ok := src.mutation.ID(); ok {
+		_node.ID = id
+		id64 := int64(id)
+		_spec.ID.Value = id64
+	}
+
+	_spec.OnConflict = src.conflict
+	if value, ok := src.mutation.CreatedAt(); ok {
+		_spec.SetField(schedulerun.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := src.mutation.UpdatedAt(); ok {
+		_spec.SetField(schedulerun.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := src.mutation.DeletedAt(); ok {
+		_spec.SetField(schedulerun.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := src.mutation.ScheduleID(); ok {
+		_spec.SetField(schedulerun.FieldScheduleID, field.TypeInt, value)
+		_node.ScheduleID = value
+	}
+	if value, ok := src.mutation.TaskID(); ok {
+		_spec.SetField(schedulerun.FieldTaskID, field.TypeInt, value)
+		_node.TaskID = &value
+	}
+	if value, ok := src.mutation.Error(); ok {
+		_spec.SetField(schedulerun.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ScheduleRun.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ScheduleRunUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (src *ScheduleRunCreate) OnConflict(opts ...sql.ConflictOption) *ScheduleRunUpsertOne {
+	src.conflict = opts
+	return &ScheduleRunUpsertOne{
+		create: src,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ScheduleRun.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (src *ScheduleRunCreate) OnConflictColumns(columns ...string) *ScheduleRunUpsertOne {
+	src.conflict = append(src.conflict, sql.ConflictColumns(columns...))
+	return &ScheduleRunUpsertOne{
+		create: src,
+	}
+}
+
+type (
+	// ScheduleRunUpsertOne is the builder for "upsert"-ing
+	//  one ScheduleRun node.
+	ScheduleRunUpsertOne struct {
+		create *ScheduleRunCreate
+	}
+
+	// ScheduleRunUpsert is the "OnConflict" setter.
+	ScheduleRunUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ScheduleRunUpsert) SetUpdatedAt(v time.Time) *ScheduleRunUpsert {
+	u.Set(schedulerun.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ScheduleRunUpsert) UpdateUpdatedAt() *ScheduleRunUpsert {
+	u.SetExcluded(schedulerun.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ScheduleRunUpsert) SetDeletedAt(v time.Time) *ScheduleRunUpsert {
+	u.Set(schedulerun.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ScheduleRunUpsert) UpdateDeletedAt() *ScheduleRunUpsert {
+	u.SetExcluded(schedulerun.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ScheduleRunUpsert) ClearDeletedAt() *ScheduleRunUpsert {
+	u.SetNull(schedulerun.FieldDeletedAt)
+	return u
+}
+
+// SetScheduleID sets the "schedule_id" field.
+func (u *ScheduleRunUpsert) SetScheduleID(v int) *ScheduleRunUpsert {
+	u.Set(schedulerun.FieldScheduleID, v)
+	return u
+}
+
+// UpdateScheduleID sets the "schedule_id" field to the value that was provided on create.
+func (u *ScheduleRunUpsert) UpdateScheduleID() *ScheduleRunUpsert {
+	u.SetExcluded(schedulerun.FieldScheduleID)
+	return u
+}
+
+// AddScheduleID adds v to the "schedule_id" field.
+func (u *ScheduleRunUpsert) AddScheduleID(v int) *ScheduleRunUpsert {
+	u.Add(schedulerun.FieldScheduleID, v)
+	return u
+}
+
+// SetTaskID sets the "task_id" field.
+func (u *ScheduleRunUpsert) SetTaskID(v int) *ScheduleRunUpsert {
+	u.Set(schedulerun.FieldTaskID, v)
+	return u
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *ScheduleRunUpsert) UpdateTaskID() *ScheduleRunUpsert {
+	u.SetExcluded(schedulerun.FieldTaskID)
+	return u
+}
+
+// AddTaskID adds v to the "task_id" field.
+func (u *ScheduleRunUpsert) AddTaskID(v int) *ScheduleRunUpsert {
+	u.Add(schedulerun.FieldTaskID, v)
+	return u
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (u *ScheduleRunUpsert) ClearTaskID() *ScheduleRunUpsert {
+	u.SetNull(schedulerun.FieldTaskID)
+	return u
+}
+
+// SetError sets the "error" field.
+func (u *ScheduleRunUpsert) SetError(v string) *ScheduleRunUpsert {
+	u.Set(schedulerun.FieldError, v)
+	return u
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *ScheduleRunUpsert) UpdateError() *ScheduleRunUpsert {
+	u.SetExcluded(schedulerun.FieldError)
+	return u
+}
+
+// ClearError clears the value of the "error" field.
+func (u *ScheduleRunUpsert) ClearError() *ScheduleRunUpsert {
+	u.SetNull(schedulerun.FieldError)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.ScheduleRun.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ScheduleRunUpsertOne) UpdateNewValues() *ScheduleRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(schedulerun.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ScheduleRun.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ScheduleRunUpsertOne) Ignore() *ScheduleRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ScheduleRunUpsertOne) DoNothing() *ScheduleRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ScheduleRunCreate.OnConflict
+// documentation for more info.
+func (u *ScheduleRunUpsertOne) Update(set func(*ScheduleRunUpsert)) *ScheduleRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ScheduleRunUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ScheduleRunUpsertOne) SetUpdatedAt(v time.Time) *ScheduleRunUpsertOne {
+	return u.Update(func(s *ScheduleRunUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ScheduleRunUpsertOne) UpdateUpdatedAt() *ScheduleRunUpsertOne {
+	return u.Update(func(s *ScheduleRunUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ScheduleRunUpsertOne) SetDeletedAt(v time.Time) *ScheduleRunUpsertOne {
+	return u.Update(func(s *ScheduleRunUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ScheduleRunUpsertOne) UpdateDeletedAt() *ScheduleRunUpsertOne {
+	return u.Update(func(s *ScheduleRunUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ScheduleRunUpsertOne) ClearDeletedAt() *ScheduleRunUpsertOne {
+	return u.Update(func(s *ScheduleRunUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetScheduleID sets the "schedule_id" field.
+func (u *ScheduleRunUpsertOne) SetScheduleID(v int) *ScheduleRunUpsertOne {
+	return u.Update(func(s *ScheduleRunUpsert) {
+		s.SetScheduleID(v)
+	})
+}
+
+// AddScheduleID adds v to the "schedule_id" field.
+func (u *ScheduleRunUpsertOne) AddScheduleID(v int) *ScheduleRunUpsertOne {
+	return u.Update(func(s *ScheduleRunUpsert) {
+		s.AddScheduleID(v)
+	})
+}
+
+// UpdateScheduleID sets the "schedule_id" field to the value that was provided on create.
+func (u *ScheduleRunUpsertOne) UpdateScheduleID() *ScheduleRunUpsertOne {
+	return u.Update(func(s *ScheduleRunUpsert) {
+		s.UpdateScheduleID()
+	})
+}
+
+// SetTaskID sets the "task_id" field.
+func (u *ScheduleRunUpsertOne) SetTaskID(v int) *ScheduleRunUpsertOne {
+	return u.Update(func(s *ScheduleRunUpsert) {
+		s.SetTaskID(v)
+	})
+}
+
+// AddTaskID adds v to the "task_id" field.
+func (u *ScheduleRunUpsertOne) AddTaskID(v int) *ScheduleRunUpsertOne {
+	return u.Update(func(s *ScheduleRunUpsert) {
+		s.AddTaskID(v)
+	})
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *ScheduleRunUpsertOne) UpdateTaskID() *ScheduleRunUpsertOne {
+	return u.Update(func(s *ScheduleRunUpsert) {
+		s.UpdateTaskID()
+	})
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (u *ScheduleRunUpsertOne) ClearTaskID() *ScheduleRunUpsertOne {
+	return u.Update(func(s *ScheduleRunUpsert) {
+		s.ClearTaskID()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *ScheduleRunUpsertOne) SetError(v string) *ScheduleRunUpsertOne {
+	return u.Update(func(s *ScheduleRunUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *ScheduleRunUpsertOne) UpdateError() *ScheduleRunUpsertOne {
+	return u.Update(func(s *ScheduleRunUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *ScheduleRunUpsertOne) ClearError() *ScheduleRunUpsertOne {
+	return u.Update(func(s *ScheduleRunUpsert) {
+		s.ClearError()
+	})
+}
+
+// Exec executes the query.
+func (u *ScheduleRunUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ScheduleRunCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ScheduleRunUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ScheduleRunUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ScheduleRunUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+func (m *ScheduleRunCreate) SetRawID(t int) *ScheduleRunCreate {
+	m.mutation.SetRawID(t)
+	return m
+}
+
+// ScheduleRunCreateBulk is the builder for creating many ScheduleRun entities in bulk.
+type ScheduleRunCreateBulk struct {
+	config
+	err      error
+	builders []*ScheduleRunCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ScheduleRun entities in the database.
+func (srcb *ScheduleRunCreateBulk) Save(ctx context.Context) ([]*ScheduleRun, error) {
+	if srcb.err != nil {
+		return nil, srcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(srcb.builders))
+	nodes := make([]*ScheduleRun, len(srcb.builders))
+	mutators := make([]Mutator, len(srcb.builders))
+	for i := range srcb.builders {
+		func(i int, root context.Context) {
+			builder := srcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ScheduleRunMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, srcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = srcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, srcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, srcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (srcb *ScheduleRunCreateBulk) SaveX(ctx context.Context) []*ScheduleRun {
+	v, err := srcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (srcb *ScheduleRunCreateBulk) Exec(ctx context.Context) error {
+	_, err := srcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (srcb *ScheduleRunCreateBulk) ExecX(ctx context.Context) {
+	if err := srcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ScheduleRun.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ScheduleRunUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (srcb *ScheduleRunCreateBulk) OnConflict(opts ...sql.ConflictOption) *ScheduleRunUpsertBulk {
+	srcb.conflict = opts
+	return &ScheduleRunUpsertBulk{
+		create: srcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ScheduleRun.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (srcb *ScheduleRunCreateBulk) OnConflictColumns(columns ...string) *ScheduleRunUpsertBulk {
+	srcb.conflict = append(srcb.conflict, sql.ConflictColumns(columns...))
+	return &ScheduleRunUpsertBulk{
+		create: srcb,
+	}
+}
+
+// ScheduleRunUpsertBulk is the builder for "upsert"-ing
+// a bulk of ScheduleRun nodes.
+type ScheduleRunUpsertBulk struct {
+	create *ScheduleRunCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ScheduleRun.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ScheduleRunUpsertBulk) UpdateNewValues() *ScheduleRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(schedulerun.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ScheduleRun.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ScheduleRunUpsertBulk) Ignore() *ScheduleRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ScheduleRunUpsertBulk) DoNothing() *ScheduleRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ScheduleRunCreateBulk.OnConflict
+// documentation for more info.
+func (u *ScheduleRunUpsertBulk) Update(set func(*ScheduleRunUpsert)) *ScheduleRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ScheduleRunUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ScheduleRunUpsertBulk) SetUpdatedAt(v time.Time) *ScheduleRunUpsertBulk {
+	return u.Update(func(s *ScheduleRunUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ScheduleRunUpsertBulk) UpdateUpdatedAt() *ScheduleRunUpsertBulk {
+	return u.Update(func(s *ScheduleRunUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ScheduleRunUpsertBulk) SetDeletedAt(v time.Time) *ScheduleRunUpsertBulk {
+	return u.Update(func(s *ScheduleRunUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ScheduleRunUpsertBulk) UpdateDeletedAt() *ScheduleRunUpsertBulk {
+	return u.Update(func(s *ScheduleRunUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ScheduleRunUpsertBulk) ClearDeletedAt() *ScheduleRunUpsertBulk {
+	return u.Update(func(s *ScheduleRunUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetScheduleID sets the "schedule_id" field.
+func (u *ScheduleRunUpsertBulk) SetScheduleID(v int) *ScheduleRunUpsertBulk {
+	return u.Update(func(s *ScheduleRunUpsert) {
+		s.SetScheduleID(v)
+	})
+}
+
+// AddScheduleID adds v to the "schedule_id" field.
+func (u *ScheduleRunUpsertBulk) AddScheduleID(v int) *ScheduleRunUpsertBulk {
+	return u.Update(func(s *ScheduleRunUpsert) {
+		s.AddScheduleID(v)
+	})
+}
+
+// UpdateScheduleID sets the "schedule_id" field to the value that was provided on create.
+func (u *ScheduleRunUpsertBulk) UpdateScheduleID() *ScheduleRunUpsertBulk {
+	return u.Update(func(s *ScheduleRunUpsert) {
+		s.UpdateScheduleID()
+	})
+}
+
+// SetTaskID sets the "task_id" field.
+func (u *ScheduleRunUpsertBulk) SetTaskID(v int) *ScheduleRunUpsertBulk {
+	return u.Update(func(s *ScheduleRunUpsert) {
+		s.SetTaskID(v)
+	})
+}
+
+// AddTaskID adds v to the "task_id" field.
+func (u *ScheduleRunUpsertBulk) AddTaskID(v int) *ScheduleRunUpsertBulk {
+	return u.Update(func(s *ScheduleRunUpsert) {
+		s.AddTaskID(v)
+	})
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *ScheduleRunUpsertBulk) UpdateTaskID() *ScheduleRunUpsertBulk {
+	return u.Update(func(s *ScheduleRunUpsert) {
+		s.UpdateTaskID()
+	})
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (u *ScheduleRunUpsertBulk) ClearTaskID() *ScheduleRunUpsertBulk {
+	return u.Update(func(s *ScheduleRunUpsert) {
+		s.ClearTaskID()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *ScheduleRunUpsertBulk) SetError(v string) *ScheduleRunUpsertBulk {
+	return u.Update(func(s *ScheduleRunUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *ScheduleRunUpsertBulk) UpdateError() *ScheduleRunUpsertBulk {
+	return u.Update(func(s *ScheduleRunUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *ScheduleRunUpsertBulk) ClearError() *ScheduleRunUpsertBulk {
+	return u.Update(func(s *ScheduleRunUpsert) {
+		s.ClearError()
+	})
+}
+
+// Exec executes the query.
+func (u *ScheduleRunUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ScheduleRunCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ScheduleRunCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ScheduleRunUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
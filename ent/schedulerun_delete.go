@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+	"github.com/cloudreve/Cloudreve/v4/ent/schedulerun"
+)
+
+// ScheduleRunDelete is the builder for deleting a ScheduleRun entity.
+type ScheduleRunDelete struct {
+	config
+	hooks    []Hook
+	mutation *ScheduleRunMutation
+}
+
+// Where appends a list predicates to the ScheduleRunDelete builder.
+func (srd *ScheduleRunDelete) Where(ps ...predicate.ScheduleRun) *ScheduleRunDelete {
+	srd.mutation.Where(ps...)
+	return srd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (srd *ScheduleRunDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, srd.sqlExec, srd.mutation, srd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (srd *ScheduleRunDelete) ExecX(ctx context.Context) int {
+	n, err := srd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (srd *ScheduleRunDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(schedulerun.Table, sqlgraph.NewFieldSpec(schedulerun.FieldID, field.TypeInt))
+	if ps := srd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, srd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	srd.mutation.done = true
+	return affected, err
+}
+
+// ScheduleRunDeleteOne is the builder for deleting a single ScheduleRun entity.
+type ScheduleRunDeleteOne struct {
+	srd *ScheduleRunDelete
+}
+
+// Where appends a list predicates to the ScheduleRunDelete builder.
+func (srdo *ScheduleRunDeleteOne) Where(ps ...predicate.ScheduleRun) *ScheduleRunDeleteOne {
+	srdo.srd.mutation.Where(ps...)
+	return srdo
+}
+
+// Exec executes the deletion query.
+func (srdo *ScheduleRunDeleteOne) Exec(ctx context.Context) error {
+	n, err := srdo.srd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{schedulerun.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (srdo *ScheduleRunDeleteOne) ExecX(ctx context.Context) {
+	if err := srdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
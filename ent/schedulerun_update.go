@@ -0,0 +1,454 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+	"github.com/cloudreve/Cloudreve/v4/ent/schedulerun"
+)
+
+// ScheduleRunUpdate is the builder for updating ScheduleRun entities.
+type ScheduleRunUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ScheduleRunMutation
+}
+
+// Where appends a list predicates to the ScheduleRunUpdate builder.
+func (sru *ScheduleRunUpdate) Where(ps ...predicate.ScheduleRun) *ScheduleRunUpdate {
+	sru.mutation.Where(ps...)
+	return sru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sru *ScheduleRunUpdate) SetUpdatedAt(t time.Time) *ScheduleRunUpdate {
+	sru.mutation.SetUpdatedAt(t)
+	return sru
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (sru *ScheduleRunUpdate) SetDeletedAt(t time.Time) *ScheduleRunUpdate {
+	sru.mutation.SetDeletedAt(t)
+	return sru
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (sru *ScheduleRunUpdate) SetNillableDeletedAt(t *time.Time) *ScheduleRunUpdate {
+	if t != nil {
+		sru.SetDeletedAt(*t)
+	}
+	return sru
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (sru *ScheduleRunUpdate) ClearDeletedAt() *ScheduleRunUpdate {
+	sru.mutation.ClearDeletedAt()
+	return sru
+}
+
+// SetScheduleID sets the "schedule_id" field.
+func (sru *ScheduleRunUpdate) SetScheduleID(i int) *ScheduleRunUpdate {
+	sru.mutation.ResetScheduleID()
+	sru.mutation.SetScheduleID(i)
+	return sru
+}
+
+// SetNillableScheduleID sets the "schedule_id" field if the given value is not nil.
+func (sru *ScheduleRunUpdate) SetNillableScheduleID(i *int) *ScheduleRunUpdate {
+	if i != nil {
+		sru.SetScheduleID(*i)
+	}
+	return sru
+}
+
+// AddScheduleID adds i to the "schedule_id" field.
+func (sru *ScheduleRunUpdate) AddScheduleID(i int) *ScheduleRunUpdate {
+	sru.mutation.AddScheduleID(i)
+	return sru
+}
+
+// SetTaskID sets the "task_id" field.
+func (sru *ScheduleRunUpdate) SetTaskID(i int) *ScheduleRunUpdate {
+	sru.mutation.ResetTaskID()
+	sru.mutation.SetTaskID(i)
+	return sru
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (sru *ScheduleRunUpdate) SetNillableTaskID(i *int) *ScheduleRunUpdate {
+	if i != nil {
+		sru.SetTaskID(*i)
+	}
+	return sru
+}
+
+// AddTaskID adds i to the "task_id" field.
+func (sru *ScheduleRunUpdate) AddTaskID(i int) *ScheduleRunUpdate {
+	sru.mutation.AddTaskID(i)
+	return sru
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (sru *ScheduleRunUpdate) ClearTaskID() *ScheduleRunUpdate {
+	sru.mutation.ClearTaskID()
+	return sru
+}
+
+// SetError sets the "error" field.
+func (sru *ScheduleRunUpdate) SetError(s string) *ScheduleRunUpdate {
+	sru.mutation.SetError(s)
+	return sru
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (sru *ScheduleRunUpdate) SetNillableError(s *string) *ScheduleRunUpdate {
+	if s != nil {
+		sru.SetError(*s)
+	}
+	return sru
+}
+
+// ClearError clears the value of the "error" field.
+func (sru *ScheduleRunUpdate) ClearError() *ScheduleRunUpdate {
+	sru.mutation.ClearError()
+	return sru
+}
+
+// Mutation returns the ScheduleRunMutation object of the builder.
+func (sru *ScheduleRunUpdate) Mutation() *ScheduleRunMutation {
+	return sru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sru *ScheduleRunUpdate) Save(ctx context.Context) (int, error) {
+	if err := sru.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, sru.sqlSave, sru.mutation, sru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sru *ScheduleRunUpdate) SaveX(ctx context.Context) int {
+	affected, err := sru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sru *ScheduleRunUpdate) Exec(ctx context.Context) error {
+	_, err := sru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sru *ScheduleRunUpdate) ExecX(ctx context.Context) {
+	if err := sru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sru *ScheduleRunUpdate) defaults() error {
+	if _, ok := sru.mutation.UpdatedAt(); !ok {
+		if schedulerun.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized schedulerun.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := schedulerun.UpdateDefaultUpdatedAt()
+		sru.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+func (sru *ScheduleRunUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(schedulerun.Table, schedulerun.Columns, sqlgraph.NewFieldSpec(schedulerun.FieldID, field.TypeInt))
+	if ps := sru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sru.mutation.UpdatedAt(); ok {
+		_spec.SetField(schedulerun.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := sru.mutation.DeletedAt(); ok {
+		_spec.SetField(schedulerun.FieldDeletedAt, field.TypeTime, value)
+	}
+	if sru.mutation.DeletedAtCleared() {
+		_spec.ClearField(schedulerun.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := sru.mutation.ScheduleID(); ok {
+		_spec.SetField(schedulerun.FieldScheduleID, field.TypeInt, value)
+	}
+	if value, ok := sru.mutation.AddedScheduleID(); ok {
+		_spec.AddField(schedulerun.FieldScheduleID, field.TypeInt, value)
+	}
+	if value, ok := sru.mutation.TaskID(); ok {
+		_spec.SetField(schedulerun.FieldTaskID, field.TypeInt, value)
+	}
+	if value, ok := sru.mutation.AddedTaskID(); ok {
+		_spec.AddField(schedulerun.FieldTaskID, field.TypeInt, value)
+	}
+	if sru.mutation.TaskIDCleared() {
+		_spec.ClearField(schedulerun.FieldTaskID, field.TypeInt)
+	}
+	if value, ok := sru.mutation.Error(); ok {
+		_spec.SetField(schedulerun.FieldError, field.TypeString, value)
+	}
+	if sru.mutation.ErrorCleared() {
+		_spec.ClearField(schedulerun.FieldError, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, sru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{schedulerun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	sru.mutation.done = true
+	return n, nil
+}
+
+// ScheduleRunUpdateOne is the builder for updating a single ScheduleRun entity.
+type ScheduleRunUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ScheduleRunMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sruo *ScheduleRunUpdateOne) SetUpdatedAt(t time.Time) *ScheduleRunUpdateOne {
+	sruo.mutation.SetUpdatedAt(t)
+	return sruo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (sruo *ScheduleRunUpdateOne) SetDeletedAt(t time.Time) *ScheduleRunUpdateOne {
+	sruo.mutation.SetDeletedAt(t)
+	return sruo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (sruo *ScheduleRunUpdateOne) SetNillableDeletedAt(t *time.Time) *ScheduleRunUpdateOne {
+	if t != nil {
+		sruo.SetDeletedAt(*t)
+	}
+	return sruo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (sruo *ScheduleRunUpdateOne) ClearDeletedAt() *ScheduleRunUpdateOne {
+	sruo.mutation.ClearDeletedAt()
+	return sruo
+}
+
+// SetScheduleID sets the "schedule_id" field.
+func (sruo *ScheduleRunUpdateOne) SetScheduleID(i int) *ScheduleRunUpdateOne {
+	sruo.mutation.ResetScheduleID()
+	sruo.mutation.SetScheduleID(i)
+	return sruo
+}
+
+// SetNillableScheduleID sets the "schedule_id" field if the given value is not nil.
+func (sruo *ScheduleRunUpdateOne) SetNillableScheduleID(i *int) *ScheduleRunUpdateOne {
+	if i != nil {
+		sruo.SetScheduleID(*i)
+	}
+	return sruo
+}
+
+// AddScheduleID adds i to the "schedule_id" field.
+func (sruo *ScheduleRunUpdateOne) AddScheduleID(i int) *ScheduleRunUpdateOne {
+	sruo.mutation.AddScheduleID(i)
+	return sruo
+}
+
+// SetTaskID sets the "task_id" field.
+func (sruo *ScheduleRunUpdateOne) SetTaskID(i int) *ScheduleRunUpdateOne {
+	sruo.mutation.ResetTaskID()
+	sruo.mutation.SetTaskID(i)
+	return sruo
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (sruo *ScheduleRunUpdateOne) SetNillableTaskID(i *int) *ScheduleRunUpdateOne {
+	if i != nil {
+		sruo.SetTaskID(*i)
+	}
+	return sruo
+}
+
+// AddTaskID adds i to the "task_id" field.
+func (sruo *ScheduleRunUpdateOne) AddTaskID(i int) *ScheduleRunUpdateOne {
+	sruo.mutation.AddTaskID(i)
+	return sruo
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (sruo *ScheduleRunUpdateOne) ClearTaskID() *ScheduleRunUpdateOne {
+	sruo.mutation.ClearTaskID()
+	return sruo
+}
+
+// SetError sets the "error" field.
+func (sruo *ScheduleRunUpdateOne) SetError(s string) *ScheduleRunUpdateOne {
+	sruo.mutation.SetError(s)
+	return sruo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (sruo *ScheduleRunUpdateOne) SetNillableError(s *string) *ScheduleRunUpdateOne {
+	if s != nil {
+		sruo.SetError(*s)
+	}
+	return sruo
+}
+
+// ClearError clears the value of the "error" field.
+func (sruo *ScheduleRunUpdateOne) ClearError() *ScheduleRunUpdateOne {
+	sruo.mutation.ClearError()
+	return sruo
+}
+
+// Mutation returns the ScheduleRunMutation object of the builder.
+func (sruo *ScheduleRunUpdateOne) Mutation() *ScheduleRunMutation {
+	return sruo.mutation
+}
+
+// Where appends a list predicates to the ScheduleRunUpdate builder.
+func (sruo *ScheduleRunUpdateOne) Where(ps ...predicate.ScheduleRun) *ScheduleRunUpdateOne {
+	sruo.mutation.Where(ps...)
+	return sruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (sruo *ScheduleRunUpdateOne) Select(field string, fields ...string) *ScheduleRunUpdateOne {
+	sruo.fields = append([]string{field}, fields...)
+	return sruo
+}
+
+// Save executes the query and returns the updated ScheduleRun entity.
+func (sruo *ScheduleRunUpdateOne) Save(ctx context.Context) (*ScheduleRun, error) {
+	if err := sruo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, sruo.sqlSave, sruo.mutation, sruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sruo *ScheduleRunUpdateOne) SaveX(ctx context.Context) *ScheduleRun {
+	node, err := sruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (sruo *ScheduleRunUpdateOne) Exec(ctx context.Context) error {
+	_, err := sruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sruo *ScheduleRunUpdateOne) ExecX(ctx context.Context) {
+	if err := sruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sruo *ScheduleRunUpdateOne) defaults() error {
+	if _, ok := sruo.mutation.UpdatedAt(); !ok {
+		if schedulerun.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized schedulerun.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := schedulerun.UpdateDefaultUpdatedAt()
+		sruo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+func (sruo *ScheduleRunUpdateOne) sqlSave(ctx context.Context) (_node *ScheduleRun, err error) {
+	_spec := sqlgraph.NewUpdateSpec(schedulerun.Table, schedulerun.Columns, sqlgraph.NewFieldSpec(schedulerun.FieldID, field.TypeInt))
+	id, ok := sruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ScheduleRun.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := sruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, schedulerun.FieldID)
+		for _, f := range fields {
+			if !schedulerun.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != schedulerun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := sruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(schedulerun.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := sruo.mutation.DeletedAt(); ok {
+		_spec.SetField(schedulerun.FieldDeletedAt, field.TypeTime, value)
+	}
+	if sruo.mutation.DeletedAtCleared() {
+		_spec.ClearField(schedulerun.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := sruo.mutation.ScheduleID(); ok {
+		_spec.SetField(schedulerun.FieldScheduleID, field.TypeInt, value)
+	}
+	if value, ok := sruo.mutation.AddedScheduleID(); ok {
+		_spec.AddField(schedulerun.FieldScheduleID, field.TypeInt, value)
+	}
+	if value, ok := sruo.mutation.TaskID(); ok {
+		_spec.SetField(schedulerun.FieldTaskID, field.TypeInt, value)
+	}
+	if value, ok := sruo.mutation.AddedTaskID(); ok {
+		_spec.AddField(schedulerun.FieldTaskID, field.TypeInt, value)
+	}
+	if sruo.mutation.TaskIDCleared() {
+		_spec.ClearField(schedulerun.FieldTaskID, field.TypeInt)
+	}
+	if value, ok := sruo.mutation.Error(); ok {
+		_spec.SetField(schedulerun.FieldError, field.TypeString, value)
+	}
+	if sruo.mutation.ErrorCleared() {
+		_spec.ClearField(schedulerun.FieldError, field.TypeString)
+	}
+	_node = &ScheduleRun{config: sruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, sruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{schedulerun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	sruo.mutation.done = true
+	return _node, nil
+}
@@ -4,6 +4,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
 	"github.com/gofrs/uuid"
 )
@@ -28,6 +29,8 @@ func (Entity) Fields() []ent.Field {
 		field.JSON("props", &types.EntityProps{}).
 			Optional().
 			StorageKey("recycle_options"),
+		field.String("checksum").
+			Optional(),
 	}
 }
 
@@ -48,6 +51,13 @@ func (Entity) Edges() []ent.Edge {
 	}
 }
 
+// Indexes of the Entity.
+func (Entity) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("storage_policy_entities", "checksum"),
+	}
+}
+
 func (Entity) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		CommonMixin{},
@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// FsLock holds the schema definition for the FsLock entity, a WebDAV or WOPI lock
+// persisted so that it survives restart and is shared by all master replicas.
+type FsLock struct {
+	ent.Schema
+}
+
+// Fields of the FsLock.
+func (FsLock) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("token").
+			Unique(),
+		field.String("ns"),
+		field.Text("root"),
+		field.Bool("zero_depth").
+			Default(false),
+		field.Int("file_type").
+			Default(0),
+		field.Text("owner").
+			Optional(),
+		// Duration in nanoseconds, negative means infinite.
+		field.Int64("duration").
+			Default(-1),
+		field.Time("expire_at").
+			Optional().
+			Nillable(),
+		// HeldUntil is set while the lock is held by an ongoing operation.
+		field.Time("held_until").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the FsLock.
+func (FsLock) Edges() []ent.Edge {
+	return nil
+}
+
+func (FsLock) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("ns"),
+		index.Fields("expire_at"),
+	}
+}
+
+func (FsLock) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CommonMixin{},
+	}
+}
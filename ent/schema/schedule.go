@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+)
+
+// Schedule holds the schema definition for the Schedule entity, a workflow that a user
+// wants to run periodically.
+type Schedule struct {
+	ent.Schema
+}
+
+// Fields of the Schedule.
+func (Schedule) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name"),
+		field.Enum("type").
+			Values("archive", "extract", "download"),
+		// Cron expression in standard 5 fields format, or descriptors like @daily.
+		field.String("spec"),
+		field.JSON("props", &types.ScheduleProps{}),
+		field.Bool("enabled").
+			Default(true),
+		field.Int("owner_id"),
+		field.Time("next_run_at").
+			Optional().
+			Nillable(),
+		field.Time("last_run_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the Schedule.
+func (Schedule) Edges() []ent.Edge {
+	return nil
+}
+
+func (Schedule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("owner_id"),
+		index.Fields("enabled", "next_run_at"),
+	}
+}
+
+func (Schedule) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CommonMixin{},
+	}
+}
@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ScheduleRun holds the schema definition for the ScheduleRun entity, a record of a single
+// execution of a schedule.
+type ScheduleRun struct {
+	ent.Schema
+}
+
+// Fields of the ScheduleRun.
+func (ScheduleRun) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("schedule_id"),
+		// ID of the task created by this run, empty if the run failed before a task is queued.
+		field.Int("task_id").
+			Optional().
+			Nillable(),
+		field.Text("error").
+			Optional(),
+	}
+}
+
+// Edges of the ScheduleRun.
+func (ScheduleRun) Edges() []ent.Edge {
+	return nil
+}
+
+func (ScheduleRun) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("schedule_id", "created_at"),
+	}
+}
+
+func (ScheduleRun) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CommonMixin{},
+	}
+}
@@ -22,6 +22,8 @@ func (Share) Fields() []ent.Field {
 			Default(0),
 		field.Int("downloads").
 			Default(0),
+		field.Int("uploads").
+			Default(0),
 		field.Time("expires").
 			Nillable().
 			Optional().
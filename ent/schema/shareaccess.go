@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ShareAccess holds the schema definition for the ShareAccess entity, a record of
+// a single visit to a share link.
+type ShareAccess struct {
+	ent.Schema
+}
+
+// Fields of the ShareAccess.
+func (ShareAccess) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("share_id"),
+		field.Int("user_id").
+			Optional().
+			Nillable(),
+		field.Enum("action").
+			Values("view", "download", "archive"),
+		field.String("ip").
+			Optional(),
+		field.Text("user_agent").
+			Optional(),
+		field.Text("path").
+			Optional(),
+	}
+}
+
+// Edges of the ShareAccess.
+func (ShareAccess) Edges() []ent.Edge {
+	return nil
+}
+
+func (ShareAccess) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("share_id", "created_at"),
+		index.Fields("created_at"),
+	}
+}
+
+func (ShareAccess) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CommonMixin{},
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
 	"github.com/gofrs/uuid"
 )
@@ -26,6 +27,12 @@ func (Task) Fields() []ent.Field {
 			Optional().
 			Immutable(),
 		field.Int("user_tasks").Optional(),
+		// Instance ID of the master currently executing the task, and until when it holds the claim.
+		field.String("lease_owner").
+			Optional(),
+		field.Time("lease_expire_at").
+			Optional().
+			Nillable(),
 	}
 }
 
@@ -39,6 +46,12 @@ func (Task) Edges() []ent.Edge {
 	}
 }
 
+func (Task) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "lease_expire_at"),
+	}
+}
+
 func (Task) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		CommonMixin{},
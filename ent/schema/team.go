@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+)
+
+// Team holds the schema definition for the Team entity. Files in a team folder are owned by
+// a dedicated team account, so that they are charged to the team and survive member departures.
+type Team struct {
+	ent.Schema
+}
+
+// Fields of the Team.
+func (Team) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			MaxLen(255),
+		field.JSON("settings", &types.TeamSetting{}).
+			Default(&types.TeamSetting{}).
+			Optional(),
+		field.Int("owner_id"),
+	}
+}
+
+// Edges of the Team.
+func (Team) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).
+			Ref("teams").
+			Field("owner_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (Team) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CommonMixin{},
+	}
+}
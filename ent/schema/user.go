@@ -27,6 +27,8 @@ func (User) Fields() []ent.Field {
 			Default("active"),
 		field.Int64("storage").
 			Default(0),
+		field.Int("file_count").
+			Default(0),
 		field.String("two_factor_secret").
 			Sensitive().
 			Optional(),
@@ -54,6 +56,7 @@ func (User) Edges() []ent.Edge {
 		edge.To("fsevents", FsEvent.Type),
 		edge.To("entities", Entity.Type),
 		edge.To("oauth_grants", OAuthGrant.Type),
+		edge.To("teams", Team.Type),
 	}
 }
 
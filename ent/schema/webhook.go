@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Webhook holds the schema definition for the Webhook entity.
+type Webhook struct {
+	ent.Schema
+}
+
+// Fields of the Webhook.
+func (Webhook) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name"),
+		field.Text("url"),
+		field.String("secret").
+			Sensitive(),
+		field.Strings("events").
+			Optional(),
+		field.Bool("enabled").
+			Default(true),
+		// Webhooks without owner are configured by admin and receive events of all users.
+		field.Int("owner_id").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the Webhook.
+func (Webhook) Edges() []ent.Edge {
+	return nil
+}
+
+func (Webhook) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("owner_id"),
+	}
+}
+
+func (Webhook) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CommonMixin{},
+	}
+}
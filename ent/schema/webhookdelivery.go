@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/gofrs/uuid"
+)
+
+// WebhookDelivery holds the schema definition for the WebhookDelivery entity, a record of
+// a single delivery attempt of a webhook event.
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+// Fields of the WebhookDelivery.
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("webhook_id"),
+		field.String("event"),
+		field.UUID("delivery_id", uuid.UUID{}),
+		field.Text("payload"),
+		field.Int("status_code").
+			Default(0),
+		field.Text("error").
+			Optional(),
+		field.Int("attempt").
+			Default(1),
+		field.Int64("duration").
+			Default(0),
+	}
+}
+
+// Edges of the WebhookDelivery.
+func (WebhookDelivery) Edges() []ent.Edge {
+	return nil
+}
+
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("webhook_id", "created_at"),
+	}
+}
+
+func (WebhookDelivery) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CommonMixin{},
+	}
+}
@@ -33,6 +33,8 @@ type Share struct {
 	Views int `json:"views,omitempty"`
 	// Downloads holds the value of the "downloads" field.
 	Downloads int `json:"downloads,omitempty"`
+	// Uploads holds the value of the "uploads" field.
+	Uploads int `json:"uploads,omitempty"`
 	// Expires holds the value of the "expires" field.
 	Expires *time.Time `json:"expires,omitempty"`
 	// RemainDownloads holds the value of the "remain_downloads" field.
@@ -91,7 +93,7 @@ func (*Share) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case share.FieldProps:
 			values[i] = new([]byte)
-		case share.FieldID, share.FieldViews, share.FieldDownloads, share.FieldRemainDownloads:
+		case share.FieldID, share.FieldViews, share.FieldDownloads, share.FieldUploads, share.FieldRemainDownloads:
 			values[i] = new(sql.NullInt64)
 		case share.FieldPassword:
 			values[i] = new(sql.NullString)
@@ -159,6 +161,12 @@ func (s *Share) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				s.Downloads = int(value.Int64)
 			}
+		case share.FieldUploads:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field uploads", values[i])
+			} else if value.Valid {
+				s.Uploads = int(value.Int64)
+			}
 		case share.FieldExpires:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field expires", values[i])
@@ -261,6 +269,9 @@ func (s *Share) String() string {
 	builder.WriteString("downloads=")
 	builder.WriteString(fmt.Sprintf("%v", s.Downloads))
 	builder.WriteString(", ")
+	builder.WriteString("uploads=")
+	builder.WriteString(fmt.Sprintf("%v", s.Uploads))
+	builder.WriteString(", ")
 	if v := s.Expires; v != nil {
 		builder.WriteString("expires=")
 		builder.WriteString(v.Format(time.ANSIC))
@@ -27,6 +27,8 @@ const (
 	FieldViews = "views"
 	// FieldDownloads holds the string denoting the downloads field in the database.
 	FieldDownloads = "downloads"
+	// FieldUploads holds the string denoting the uploads field in the database.
+	FieldUploads = "uploads"
 	// FieldExpires holds the string denoting the expires field in the database.
 	FieldExpires = "expires"
 	// FieldRemainDownloads holds the string denoting the remain_downloads field in the database.
@@ -64,6 +66,7 @@ var Columns = []string{
 	FieldPassword,
 	FieldViews,
 	FieldDownloads,
+	FieldUploads,
 	FieldExpires,
 	FieldRemainDownloads,
 	FieldProps,
@@ -109,6 +112,8 @@ var (
 	DefaultViews int
 	// DefaultDownloads holds the default value on creation for the "downloads" field.
 	DefaultDownloads int
+	// DefaultUploads holds the default value on creation for the "uploads" field.
+	DefaultUploads int
 )
 
 // OrderOption defines the ordering options for the Share queries.
@@ -149,6 +154,11 @@ func ByDownloads(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDownloads, opts...).ToFunc()
 }
 
+// ByUploads orders the results by the uploads field.
+func ByUploads(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUploads, opts...).ToFunc()
+}
+
 // ByExpires orders the results by the expires field.
 func ByExpires(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldExpires, opts...).ToFunc()
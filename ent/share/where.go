@@ -85,6 +85,11 @@ func Downloads(v int) predicate.Share {
 	return predicate.Share(sql.FieldEQ(FieldDownloads, v))
 }
 
+// Uploads applies equality check predicate on the "uploads" field. It's identical to UploadsEQ.
+func Uploads(v int) predicate.Share {
+	return predicate.Share(sql.FieldEQ(FieldUploads, v))
+}
+
 // Expires applies equality check predicate on the "expires" field. It's identical to ExpiresEQ.
 func Expires(v time.Time) predicate.Share {
 	return predicate.Share(sql.FieldEQ(FieldExpires, v))
@@ -380,6 +385,46 @@ func DownloadsLTE(v int) predicate.Share {
 	return predicate.Share(sql.FieldLTE(FieldDownloads, v))
 }
 
+// UploadsEQ applies the EQ predicate on the "uploads" field.
+func UploadsEQ(v int) predicate.Share {
+	return predicate.Share(sql.FieldEQ(FieldUploads, v))
+}
+
+// UploadsNEQ applies the NEQ predicate on the "uploads" field.
+func UploadsNEQ(v int) predicate.Share {
+	return predicate.Share(sql.FieldNEQ(FieldUploads, v))
+}
+
+// UploadsIn applies the In predicate on the "uploads" field.
+func UploadsIn(vs ...int) predicate.Share {
+	return predicate.Share(sql.FieldIn(FieldUploads, vs...))
+}
+
+// UploadsNotIn applies the NotIn predicate on the "uploads" field.
+func UploadsNotIn(vs ...int) predicate.Share {
+	return predicate.Share(sql.FieldNotIn(FieldUploads, vs...))
+}
+
+// UploadsGT applies the GT predicate on the "uploads" field.
+func UploadsGT(v int) predicate.Share {
+	return predicate.Share(sql.FieldGT(FieldUploads, v))
+}
+
+// UploadsGTE applies the GTE predicate on the "uploads" field.
+func UploadsGTE(v int) predicate.Share {
+	return predicate.Share(sql.FieldGTE(FieldUploads, v))
+}
+
+// UploadsLT applies the LT predicate on the "uploads" field.
+func UploadsLT(v int) predicate.Share {
+	return predicate.Share(sql.FieldLT(FieldUploads, v))
+}
+
+// UploadsLTE applies the LTE predicate on the "uploads" field.
+func UploadsLTE(v int) predicate.Share {
+	return predicate.Share(sql.FieldLTE(FieldUploads, v))
+}
+
 // ExpiresEQ applies the EQ predicate on the "expires" field.
 func ExpiresEQ(v time.Time) predicate.Share {
 	return predicate.Share(sql.FieldEQ(FieldExpires, v))
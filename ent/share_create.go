@@ -109,6 +109,20 @@ func (sc *ShareCreate) SetNillableDownloads(i *int) *ShareCreate {
 	return sc
 }
 
+// SetUploads sets the "uploads" field.
+func (sc *ShareCreate) SetUploads(i int) *ShareCreate {
+	sc.mutation.SetUploads(i)
+	return sc
+}
+
+// SetNillableUploads sets the "uploads" field if the given value is not nil.
+func (sc *ShareCreate) SetNillableUploads(i *int) *ShareCreate {
+	if i != nil {
+		sc.SetUploads(*i)
+	}
+	return sc
+}
+
 // SetExpires sets the "expires" field.
 func (sc *ShareCreate) SetExpires(t time.Time) *ShareCreate {
 	sc.mutation.SetExpires(t)
@@ -240,6 +254,10 @@ func (sc *ShareCreate) defaults() error {
 		v := share.DefaultDownloads
 		sc.mutation.SetDownloads(v)
 	}
+	if _, ok := sc.mutation.Uploads(); !ok {
+		v := share.DefaultUploads
+		sc.mutation.SetUploads(v)
+	}
 	return nil
 }
 
@@ -257,6 +275,9 @@ func (sc *ShareCreate) check() error {
 	if _, ok := sc.mutation.Downloads(); !ok {
 		return &ValidationError{Name: "downloads", err: errors.New(`ent: missing required field "Share.downloads"`)}
 	}
+	if _, ok := sc.mutation.Uploads(); !ok {
+		return &ValidationError{Name: "uploads", err: errors.New(`ent: missing required field "Share.uploads"`)}
+	}
 	return nil
 }
 
@@ -315,6 +336,10 @@ func (sc *ShareCreate) createSpec() (*Share, *sqlgraph.CreateSpec) {
 		_spec.SetField(share.FieldDownloads, field.TypeInt, value)
 		_node.Downloads = value
 	}
+	if value, ok := sc.mutation.Uploads(); ok {
+		_spec.SetField(share.FieldUploads, field.TypeInt, value)
+		_node.Uploads = value
+	}
 	if value, ok := sc.mutation.Expires(); ok {
 		_spec.SetField(share.FieldExpires, field.TypeTime, value)
 		_node.Expires = &value
@@ -497,6 +522,24 @@ func (u *ShareUpsert) AddDownloads(v int) *ShareUpsert {
 	return u
 }
 
+// SetUploads sets the "uploads" field.
+func (u *ShareUpsert) SetUploads(v int) *ShareUpsert {
+	u.Set(share.FieldUploads, v)
+	return u
+}
+
+// UpdateUploads sets the "uploads" field to the value that was provided on create.
+func (u *ShareUpsert) UpdateUploads() *ShareUpsert {
+	u.SetExcluded(share.FieldUploads)
+	return u
+}
+
+// AddUploads adds v to the "uploads" field.
+func (u *ShareUpsert) AddUploads(v int) *ShareUpsert {
+	u.Add(share.FieldUploads, v)
+	return u
+}
+
 // SetExpires sets the "expires" field.
 func (u *ShareUpsert) SetExpires(v time.Time) *ShareUpsert {
 	u.Set(share.FieldExpires, v)
@@ -700,6 +743,27 @@ func (u *ShareUpsertOne) UpdateDownloads() *ShareUpsertOne {
 	})
 }
 
+// SetUploads sets the "uploads" field.
+func (u *ShareUpsertOne) SetUploads(v int) *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.SetUploads(v)
+	})
+}
+
+// AddUploads adds v to the "uploads" field.
+func (u *ShareUpsertOne) AddUploads(v int) *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.AddUploads(v)
+	})
+}
+
+// UpdateUploads sets the "uploads" field to the value that was provided on create.
+func (u *ShareUpsertOne) UpdateUploads() *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.UpdateUploads()
+	})
+}
+
 // SetExpires sets the "expires" field.
 func (u *ShareUpsertOne) SetExpires(v time.Time) *ShareUpsertOne {
 	return u.Update(func(s *ShareUpsert) {
@@ -1084,6 +1148,27 @@ func (u *ShareUpsertBulk) UpdateDownloads() *ShareUpsertBulk {
 	})
 }
 
+// SetUploads sets the "uploads" field.
+func (u *ShareUpsertBulk) SetUploads(v int) *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.SetUploads(v)
+	})
+}
+
+// AddUploads adds v to the "uploads" field.
+func (u *ShareUpsertBulk) AddUploads(v int) *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.AddUploads(v)
+	})
+}
+
+// UpdateUploads sets the "uploads" field to the value that was provided on create.
+func (u *ShareUpsertBulk) UpdateUploads() *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.UpdateUploads()
+	})
+}
+
 // SetExpires sets the "expires" field.
 func (u *ShareUpsertBulk) SetExpires(v time.Time) *ShareUpsertBulk {
 	return u.Update(func(s *ShareUpsert) {
@@ -119,6 +119,27 @@ func (su *ShareUpdate) AddDownloads(i int) *ShareUpdate {
 	return su
 }
 
+// SetUploads sets the "uploads" field.
+func (su *ShareUpdate) SetUploads(i int) *ShareUpdate {
+	su.mutation.ResetUploads()
+	su.mutation.SetUploads(i)
+	return su
+}
+
+// SetNillableUploads sets the "uploads" field if the given value is not nil.
+func (su *ShareUpdate) SetNillableUploads(i *int) *ShareUpdate {
+	if i != nil {
+		su.SetUploads(*i)
+	}
+	return su
+}
+
+// AddUploads adds i to the "uploads" field.
+func (su *ShareUpdate) AddUploads(i int) *ShareUpdate {
+	su.mutation.AddUploads(i)
+	return su
+}
+
 // SetExpires sets the "expires" field.
 func (su *ShareUpdate) SetExpires(t time.Time) *ShareUpdate {
 	su.mutation.SetExpires(t)
@@ -311,6 +332,12 @@ func (su *ShareUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := su.mutation.AddedDownloads(); ok {
 		_spec.AddField(share.FieldDownloads, field.TypeInt, value)
 	}
+	if value, ok := su.mutation.Uploads(); ok {
+		_spec.SetField(share.FieldUploads, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.AddedUploads(); ok {
+		_spec.AddField(share.FieldUploads, field.TypeInt, value)
+	}
 	if value, ok := su.mutation.Expires(); ok {
 		_spec.SetField(share.FieldExpires, field.TypeTime, value)
 	}
@@ -498,6 +525,27 @@ func (suo *ShareUpdateOne) AddDownloads(i int) *ShareUpdateOne {
 	return suo
 }
 
+// SetUploads sets the "uploads" field.
+func (suo *ShareUpdateOne) SetUploads(i int) *ShareUpdateOne {
+	suo.mutation.ResetUploads()
+	suo.mutation.SetUploads(i)
+	return suo
+}
+
+// SetNillableUploads sets the "uploads" field if the given value is not nil.
+func (suo *ShareUpdateOne) SetNillableUploads(i *int) *ShareUpdateOne {
+	if i != nil {
+		suo.SetUploads(*i)
+	}
+	return suo
+}
+
+// AddUploads adds i to the "uploads" field.
+func (suo *ShareUpdateOne) AddUploads(i int) *ShareUpdateOne {
+	suo.mutation.AddUploads(i)
+	return suo
+}
+
 // SetExpires sets the "expires" field.
 func (suo *ShareUpdateOne) SetExpires(t time.Time) *ShareUpdateOne {
 	suo.mutation.SetExpires(t)
@@ -720,6 +768,12 @@ func (suo *ShareUpdateOne) sqlSave(ctx context.Context) (_node *Share, err error
 	if value, ok := suo.mutation.AddedDownloads(); ok {
 		_spec.AddField(share.FieldDownloads, field.TypeInt, value)
 	}
+	if value, ok := suo.mutation.Uploads(); ok {
+		_spec.SetField(share.FieldUploads, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedUploads(); ok {
+		_spec.AddField(share.FieldUploads, field.TypeInt, value)
+	}
 	if value, ok := suo.mutation.Expires(); ok {
 		_spec.SetField(share.FieldExpires, field.TypeTime, value)
 	}
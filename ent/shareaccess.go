@@ -0,0 +1,200 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/ent/shareaccess"
+)
+
+// ShareAccess is the model entity for the ShareAccess schema.
+type ShareAccess struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// ShareID holds the value of the "share_id" field.
+	ShareID int `json:"share_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID *int `json:"user_id,omitempty"`
+	// Action holds the value of the "action" field.
+	Action shareaccess.Action `json:"action,omitempty"`
+	// IP holds the value of the "ip" field.
+	IP string `json:"ip,omitempty"`
+	// UserAgent holds the value of the "user_agent" field.
+	UserAgent string `json:"user_agent,omitempty"`
+	// Path holds the value of the "path" field.
+	Path         string `json:"path,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ShareAccess) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case shareaccess.FieldID, shareaccess.FieldShareID, shareaccess.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case shareaccess.FieldAction, shareaccess.FieldIP, shareaccess.FieldUserAgent, shareaccess.FieldPath:
+			values[i] = new(sql.NullString)
+		case shareaccess.FieldCreatedAt, shareaccess.FieldUpdatedAt, shareaccess.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ShareAccess fields.
+func (sa *ShareAccess) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case shareaccess.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			sa.ID = int(value.Int64)
+		case shareaccess.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sa.CreatedAt = value.Time
+			}
+		case shareaccess.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				sa.UpdatedAt = value.Time
+			}
+		case shareaccess.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				sa.DeletedAt = new(time.Time)
+				*sa.DeletedAt = value.Time
+			}
+		case shareaccess.FieldShareID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field share_id", values[i])
+			} else if value.Valid {
+				sa.ShareID = int(value.Int64)
+			}
+		case shareaccess.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				sa.UserID = new(int)
+				*sa.UserID = int(value.Int64)
+			}
+		case shareaccess.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				sa.Action = shareaccess.Action(value.String)
+			}
+		case shareaccess.FieldIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip", values[i])
+			} else if value.Valid {
+				sa.IP = value.String
+			}
+		case shareaccess.FieldUserAgent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_agent", values[i])
+			} else if value.Valid {
+				sa.UserAgent = value.String
+			}
+		case shareaccess.FieldPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field path", values[i])
+			} else if value.Valid {
+				sa.Path = value.String
+			}
+		default:
+			sa.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ShareAccess.
+// This includes values selected through modifiers, order, etc.
+func (sa *ShareAccess) Value(name string) (ent.Value, error) {
+	return sa.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ShareAccess.
+// Note that you need to call ShareAccess.Unwrap() before calling this method if this ShareAccess
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sa *ShareAccess) Update() *ShareAccessUpdateOne {
+	return NewShareAccessClient(sa.config).UpdateOne(sa)
+}
+
+// Unwrap unwraps the ShareAccess entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sa *ShareAccess) Unwrap() *ShareAccess {
+	_tx, ok := sa.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ShareAccess is not a transactional entity")
+	}
+	sa.config.driver = _tx.drv
+	return sa
+}
+
+// String implements the fmt.Stringer.
+func (sa *ShareAccess) String() string {
+	var builder strings.Builder
+	builder.WriteString("ShareAccess(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sa.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(sa.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(sa.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := sa.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("share_id=")
+	builder.WriteString(fmt.Sprintf("%v", sa.ShareID))
+	builder.WriteString(", ")
+	if v := sa.UserID; v != nil {
+		builder.WriteString("user_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(fmt.Sprintf("%v", sa.Action))
+	builder.WriteString(", ")
+	builder.WriteString("ip=")
+	builder.WriteString(sa.IP)
+	builder.WriteString(", ")
+	builder.WriteString("user_agent=")
+	builder.WriteString(sa.UserAgent)
+	builder.WriteString(", ")
+	builder.WriteString("path=")
+	builder.WriteString(sa.Path)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ShareAccesses is a parsable slice of ShareAccess.
+type ShareAccesses []*ShareAccess
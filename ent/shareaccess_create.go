@@ -0,0 +1,1072 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/shareaccess"
+)
+
+// ShareAccessCreate is the builder for creating a ShareAccess entity.
+type ShareAccessCreate struct {
+	config
+	mutation *ShareAccessMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sac *ShareAccessCreate) SetCreatedAt(t time.Time) *ShareAccessCreate {
+	sac.mutation.SetCreatedAt(t)
+	return sac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sac *ShareAccessCreate) SetNillableCreatedAt(t *time.Time) *ShareAccessCreate {
+	if t != nil {
+		sac.SetCreatedAt(*t)
+	}
+	return sac
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sac *ShareAccessCreate) SetUpdatedAt(t time.Time) *ShareAccessCreate {
+	sac.mutation.SetUpdatedAt(t)
+	return sac
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (sac *ShareAccessCreate) SetNillableUpdatedAt(t *time.Time) *ShareAccessCreate {
+	if t != nil {
+		sac.SetUpdatedAt(*t)
+	}
+	return sac
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (sac *ShareAccessCreate) SetDeletedAt(t time.Time) *ShareAccessCreate {
+	sac.mutation.SetDeletedAt(t)
+	return sac
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (sac *ShareAccessCreate) SetNillableDeletedAt(t *time.Time) *ShareAccessCreate {
+	if t != nil {
+		sac.SetDeletedAt(*t)
+	}
+	return sac
+}
+
+// SetShareID sets the "share_id" field.
+func (sac *ShareAccessCreate) SetShareID(i int) *ShareAccessCreate {
+	sac.mutation.SetShareID(i)
+	return sac
+}
+
+// SetUserID sets the "user_id" field.
+func (sac *ShareAccessCreate) SetUserID(i int) *ShareAccessCreate {
+	sac.mutation.SetUserID(i)
+	return sac
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (sac *ShareAccessCreate) SetNillableUserID(i *int) *ShareAccessCreate {
+	if i != nil {
+		sac.SetUserID(*i)
+	}
+	return sac
+}
+
+// SetAction sets the "action" field.
+func (sac *ShareAccessCreate) SetAction(s shareaccess.Action) *ShareAccessCreate {
+	sac.mutation.SetAction(s)
+	return sac
+}
+
+// SetIP sets the "ip" field.
+func (sac *ShareAccessCreate) SetIP(s string) *ShareAccessCreate {
+	sac.mutation.SetIP(s)
+	return sac
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (sac *ShareAccessCreate) SetNillableIP(s *string) *ShareAccessCreate {
+	if s != nil {
+		sac.SetIP(*s)
+	}
+	return sac
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (sac *ShareAccessCreate) SetUserAgent(s string) *ShareAccessCreate {
+	sac.mutation.SetUserAgent(s)
+	return sac
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (sac *ShareAccessCreate) SetNillableUserAgent(s *string) *ShareAccessCreate {
+	if s != nil {
+		sac.SetUserAgent(*s)
+	}
+	return sac
+}
+
+// SetPath sets the "path" field.
+func (sac *ShareAccessCreate) SetPath(s string) *ShareAccessCreate {
+	sac.mutation.SetPath(s)
+	return sac
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (sac *ShareAccessCreate) SetNillablePath(s *string) *ShareAccessCreate {
+	if s != nil {
+		sac.SetPath(*s)
+	}
+	return sac
+}
+
+// Mutation returns the ShareAccessMutation object of the builder.
+func (sac *ShareAccessCreate) Mutation() *ShareAccessMutation {
+	return sac.mutation
+}
+
+// Save creates the ShareAccess in the database.
+func (sac *ShareAccessCreate) Save(ctx context.Context) (*ShareAccess, error) {
+	if err := sac.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, sac.sqlSave, sac.mutation, sac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sac *ShareAccessCreate) SaveX(ctx context.Context) *ShareAccess {
+	v, err := sac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sac *ShareAccessCreate) Exec(ctx context.Context) error {
+	_, err := sac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sac *ShareAccessCreate) ExecX(ctx context.Context) {
+	if err := sac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sac *ShareAccessCreate) defaults() error {
+	if _, ok := sac.mutation.CreatedAt(); !ok {
+		if shareaccess.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized shareaccess.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := shareaccess.DefaultCreatedAt()
+		sac.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sac.mutation.UpdatedAt(); !ok {
+		if shareaccess.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized shareaccess.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := shareaccess.DefaultUpdatedAt()
+		sac.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sac *ShareAccessCreate) check() error {
+	if _, ok := sac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ShareAccess.created_at"`)}
+	}
+	if _, ok := sac.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ShareAccess.updated_at"`)}
+	}
+	if _, ok := sac.mutation.ShareID(); !ok {
+		return &ValidationError{Name: "share_id", err: errors.New(`ent: missing required field "ShareAccess.share_id"`)}
+	}
+	if _, ok := sac.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "ShareAccess.action"`)}
+	}
+	if v, ok := sac.mutation.Action(); ok {
+		if err := shareaccess.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "ShareAccess.action": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (sac *ShareAccessCreate) sqlSave(ctx context.Context) (*ShareAccess, error) {
+	if err := sac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	sac.mutation.id = &_node.ID
+	sac.mutation.done = true
+	return _node, nil
+}
+
+func (sac *ShareAccessCreate) createSpec() (*ShareAccess, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ShareAccess{config: sac.config}
+		_spec = sqlgraph.NewCreateSpec(shareaccess.Table, sqlgraph.NewFieldSpec(shareaccess.FieldID, field.TypeInt))
+	)
+
+	if id, ok := sac.mutation.ID(); ok {
+		_node.ID = id
+		id64 := int64(id)
+		_spec.ID.Value = id64
+	}
+
+	_spec.OnConflict = sac.conflict
+	if value, ok := sac.mutation.CreatedAt(); ok {
+		_spec.SetField(shareaccess.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := sac.mutation.UpdatedAt(); ok {
+		_spec.SetField(shareaccess.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := sac.mutation.DeletedAt(); ok {
+		_spec.SetField(shareaccess.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := sac.mutation.ShareID(); ok {
+		_spec.SetField(shareaccess.FieldShareID, field.TypeInt, value)
+		_node.ShareID = value
+	}
+	if value, ok := sac.mutation.UserID(); ok {
+		_spec.SetField(shareaccess.FieldUserID, field.TypeInt, value)
+		_node.UserID = &value
+	}
+	if value, ok := sac.mutation.Action(); ok {
+		_spec.SetField(shareaccess.FieldAction, field.TypeEnum, value)
+		_node.Action = value
+	}
+	if value, ok := sac.mutation.IP(); ok {
+		_spec.SetField(shareaccess.FieldIP, field.TypeString, value)
+		_node.IP = value
+	}
+	if value, ok := sac.mutation.UserAgent(); ok {
+		_spec.SetField(shareaccess.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = value
+	}
+	if value, ok := sac.mutation.Path(); ok {
+		_spec.SetField(shareaccess.FieldPath, field.TypeString, value)
+		_node.Path = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ShareAccess.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ShareAccessUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (sac *ShareAccessCreate) OnConflict(opts ...sql.ConflictOption) *ShareAccessUpsertOne {
+	sac.conflict = opts
+	return &ShareAccessUpsertOne{
+		create: sac,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ShareAccess.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (sac *ShareAccessCreate) OnConflictColumns(columns ...string) *ShareAccessUpsertOne {
+	sac.conflict = append(sac.conflict, sql.ConflictColumns(columns...))
+	return &ShareAccessUpsertOne{
+		create: sac,
+	}
+}
+
+type (
+	// ShareAccessUpsertOne is the builder for "upsert"-ing
+	//  one ShareAccess node.
+	ShareAccessUpsertOne struct {
+		create *ShareAccessCreate
+	}
+
+	// ShareAccessUpsert is the "OnConflict" setter.
+	ShareAccessUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ShareAccessUpsert) SetUpdatedAt(v time.Time) *ShareAccessUpsert {
+	u.Set(shareaccess.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ShareAccessUpsert) UpdateUpdatedAt() *ShareAccessUpsert {
+	u.SetExcluded(shareaccess.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ShareAccessUpsert) SetDeletedAt(v time.Time) *ShareAccessUpsert {
+	u.Set(shareaccess.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ShareAccessUpsert) UpdateDeletedAt() *ShareAccessUpsert {
+	u.SetExcluded(shareaccess.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ShareAccessUpsert) ClearDeletedAt() *ShareAccessUpsert {
+	u.SetNull(shareaccess.FieldDeletedAt)
+	return u
+}
+
+// SetShareID sets the "share_id" field.
+func (u *ShareAccessUpsert) SetShareID(v int) *ShareAccessUpsert {
+	u.Set(shareaccess.FieldShareID, v)
+	return u
+}
+
+// UpdateShareID sets the "share_id" field to the value that was provided on create.
+func (u *ShareAccessUpsert) UpdateShareID() *ShareAccessUpsert {
+	u.SetExcluded(shareaccess.FieldShareID)
+	return u
+}
+
+// AddShareID adds v to the "share_id" field.
+func (u *ShareAccessUpsert) AddShareID(v int) *ShareAccessUpsert {
+	u.Add(shareaccess.FieldShareID, v)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ShareAccessUpsert) SetUserID(v int) *ShareAccessUpsert {
+	u.Set(shareaccess.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ShareAccessUpsert) UpdateUserID() *ShareAccessUpsert {
+	u.SetExcluded(shareaccess.FieldUserID)
+	return u
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *ShareAccessUpsert) AddUserID(v int) *ShareAccessUpsert {
+	u.Add(shareaccess.FieldUserID, v)
+	return u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *ShareAccessUpsert) ClearUserID() *ShareAccessUpsert {
+	u.SetNull(shareaccess.FieldUserID)
+	return u
+}
+
+// SetAction sets the "action" field.
+func (u *ShareAccessUpsert) SetAction(v shareaccess.Action) *ShareAccessUpsert {
+	u.Set(shareaccess.FieldAction, v)
+	return u
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *ShareAccessUpsert) UpdateAction() *ShareAccessUpsert {
+	u.SetExcluded(shareaccess.FieldAction)
+	return u
+}
+
+// SetIP sets the "ip" field.
+func (u *ShareAccessUpsert) SetIP(v string) *ShareAccessUpsert {
+	u.Set(shareaccess.FieldIP, v)
+	return u
+}
+
+// UpdateIP sets the "ip" field to the value that was provided on create.
+func (u *ShareAccessUpsert) UpdateIP() *ShareAccessUpsert {
+	u.SetExcluded(shareaccess.FieldIP)
+	return u
+}
+
+// ClearIP clears the value of the "ip" field.
+func (u *ShareAccessUpsert) ClearIP() *ShareAccessUpsert {
+	u.SetNull(shareaccess.FieldIP)
+	return u
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (u *ShareAccessUpsert) SetUserAgent(v string) *ShareAccessUpsert {
+	u.Set(shareaccess.FieldUserAgent, v)
+	return u
+}
+
+// UpdateUserAgent sets the "user_agent" field to the value that was provided on create.
+func (u *ShareAccessUpsert) UpdateUserAgent() *ShareAccessUpsert {
+	u.SetExcluded(shareaccess.FieldUserAgent)
+	return u
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (u *ShareAccessUpsert) ClearUserAgent() *ShareAccessUpsert {
+	u.SetNull(shareaccess.FieldUserAgent)
+	return u
+}
+
+// SetPath sets the "path" field.
+func (u *ShareAccessUpsert) SetPath(v string) *ShareAccessUpsert {
+	u.Set(shareaccess.FieldPath, v)
+	return u
+}
+
+// UpdatePath sets the "path" field to the value that was provided on create.
+func (u *ShareAccessUpsert) UpdatePath() *ShareAccessUpsert {
+	u.SetExcluded(shareaccess.FieldPath)
+	return u
+}
+
+// ClearPath clears the value of the "path" field.
+func (u *ShareAccessUpsert) ClearPath() *ShareAccessUpsert {
+	u.SetNull(shareaccess.FieldPath)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.ShareAccess.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ShareAccessUpsertOne) UpdateNewValues() *ShareAccessUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(shareaccess.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ShareAccess.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ShareAccessUpsertOne) Ignore() *ShareAccessUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ShareAccessUpsertOne) DoNothing() *ShareAccessUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ShareAccessCreate.OnConflict
+// documentation for more info.
+func (u *ShareAccessUpsertOne) Update(set func(*ShareAccessUpsert)) *ShareAccessUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ShareAccessUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ShareAccessUpsertOne) SetUpdatedAt(v time.Time) *ShareAccessUpsertOne {
+	return u.Update(func(s *ShareAccessUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ShareAccessUpsertOne) UpdateUpdatedAt() *ShareAccessUpsertOne {
+	return u.Update(func(s *ShareAccessUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ShareAccessUpsertOne) SetDeletedAt(v time.Time) *ShareAccessUpsertOne {
+	return u.Update(func(s *ShareAccessUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ShareAccessUpsertOne) UpdateDeletedAt() *ShareAccessUpsertOne {
+	return u.Update(func(s *ShareAccessUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ShareAccessUpsertOne) ClearDeletedAt() *ShareAccessUpsertOne {
+	return u.Update(func(s *ShareAccessUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetShareID sets the "share_id" field.
+func (u *ShareAccessUpsertOne) SetShareID(v int) *ShareAccessUpsertOne {
+	return u.Update(func(s *ShareAccessUpsert) {
+		s.SetShareID(v)
+	})
+}
+
+// AddShareID adds v to the "share_id" field.
+func (u *ShareAccessUpsertOne) AddShareID(v int) *ShareAccessUpsertOne {
+	return u.Update(func(s *ShareAccessUpsert) {
+		s.AddShareID(v)
+	})
+}
+
+// UpdateShareID sets the "share_id" field to the value that was provided on create.
+func (u *ShareAccessUpsertOne) UpdateShareID() *ShareAccessUpsertOne {
+	return u.Update(func(s *ShareAccessUpsert) {
+		s.UpdateShareID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ShareAccessUpsertOne) SetUserID(v int) *ShareAccessUpsertOne {
+	return u.Update(func(s *ShareAccessUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *ShareAccessUpsertOne) AddUserID(v int) *ShareAccessUpsertOne {
+	return u.Update(func(s *ShareAccessUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ShareAccessUpsertOne) UpdateUserID() *ShareAccessUpsertOne {
+	return u.Update(func(s *ShareAccessUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *ShareAccessUpsertOne) ClearUserID() *ShareAccessUpsertOne {
+	return u.Update(func(s *ShareAccessUpsert) {
+		s.ClearUserID()
+	})
+}
+
+// SetAction sets the "action" field.
+func (u *ShareAccessUpsertOne) SetAction(v shareaccess.Action) *ShareAccessUpsertOne {
+	return u.Update(func(s *ShareAccessUpsert) {
+		s.SetAction(v)
+	})
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *ShareAccessUpsertOne) UpdateAction() *ShareAccessUpsertOne {
+	return u.Update(func(s *ShareAccessUpsert) {
+		s.UpdateAction()
+	})
+}
+
+// SetIP sets the "ip" field.
+func (u *ShareAccessUpsertOne) SetIP(v string) *ShareAccessUpsertOne {
+	return u.Update(func(s *ShareAccessUpsert) {
+		s.SetIP(v)
+	})
+}
+
+// UpdateIP sets the "ip" field to the value that was provided on create.
+func (u *ShareAccessUpsertOne) UpdateIP() *ShareAccessUpsertOne {
+	return u.Update(func(s *ShareAccessUpsert) {
+		s.UpdateIP()
+	})
+}
+
+// ClearIP clears the value of the "ip" field.
+func (u *ShareAccessUpsertOne) ClearIP() *ShareAccessUpsertOne {
+	return u.Update(func(s *ShareAccessUpsert) {
+		s.ClearIP()
+	})
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (u *ShareAccessUpsertOne) SetUserAgent(v string) *ShareAccessUpsertOne {
+	return u.Update(func(s *ShareAccessUpsert) {
+		s.SetUserAgent(v)
+	})
+}
+
+// UpdateUserAgent sets the "user_agent" field to the value that was provided on create.
+func (u *ShareAccessUpsertOne) UpdateUserAgent() *ShareAccessUpsertOne {
+	return u.Update(func(s *ShareAccessUpsert) {
+		s.UpdateUserAgent()
+	})
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (u *ShareAccessUpsertOne) ClearUserAgent() *ShareAccessUpsertOne {
+	return u.Update(func(s *ShareAccessUpsert) {
+		s.ClearUserAgent()
+	})
+}
+
+// SetPath sets the "path" field.
+func (u *ShareAccessUpsertOne) SetPath(v string) *ShareAccessUpsertOne {
+	return u.Update(func(s *ShareAccessUpsert) {
+		s.SetPath(v)
+	})
+}
+
+// UpdatePath sets the "path" field to the value that was provided on create.
+func (u *ShareAccessUpsertOne) UpdatePath() *ShareAccessUpsertOne {
+	return u.Update(func(s *ShareAccessUpsert) {
+		s.UpdatePath()
+	})
+}
+
+// ClearPath clears the value of the "path" field.
+func (u *ShareAccessUpsertOne) ClearPath() *ShareAccessUpsertOne {
+	return u.Update(func(s *ShareAccessUpsert) {
+		s.ClearPath()
+	})
+}
+
+// Exec executes the query.
+func (u *ShareAccessUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ShareAccessCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ShareAccessUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ShareAccessUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ShareAccessUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+func (m *ShareAccessCreate) SetRawID(t int) *ShareAccessCreate {
+	m.mutation.SetRawID(t)
+	return m
+}
+
+// ShareAccessCreateBulk is the builder for creating many ShareAccess entities in bulk.
+type ShareAccessCreateBulk struct {
+	config
+	err      error
+	builders []*ShareAccessCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ShareAccess entities in the database.
+func (sacb *ShareAccessCreateBulk) Save(ctx context.Context) ([]*ShareAccess, error) {
+	if sacb.err != nil {
+		return nil, sacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(sacb.builders))
+	nodes := make([]*ShareAccess, len(sacb.builders))
+	mutators := make([]Mutator, len(sacb.builders))
+	for i := range sacb.builders {
+		func(i int, root context.Context) {
+			builder := sacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ShareAccessMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = sacb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sacb *ShareAccessCreateBulk) SaveX(ctx context.Context) []*ShareAccess {
+	v, err := sacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sacb *ShareAccessCreateBulk) Exec(ctx context.Context) error {
+	_, err := sacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sacb *ShareAccessCreateBulk) ExecX(ctx context.Context) {
+	if err := sacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ShareAccess.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ShareAccessUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (sacb *ShareAccessCreateBulk) OnConflict(opts ...sql.ConflictOption) *ShareAccessUpsertBulk {
+	sacb.conflict = opts
+	return &ShareAccessUpsertBulk{
+		create: sacb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ShareAccess.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (sacb *ShareAccessCreateBulk) OnConflictColumns(columns ...string) *ShareAccessUpsertBulk {
+	sacb.conflict = append(sacb.conflict, sql.ConflictColumns(columns...))
+	return &ShareAccessUpsertBulk{
+		create: sacb,
+	}
+}
+
+// ShareAccessUpsertBulk is the builder for "upsert"-ing
+// a bulk of ShareAccess nodes.
+type ShareAccessUpsertBulk struct {
+	create *ShareAccessCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ShareAccess.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ShareAccessUpsertBulk) UpdateNewValues() *ShareAccessUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(shareaccess.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ShareAccess.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ShareAccessUpsertBulk) Ignore() *ShareAccessUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ShareAccessUpsertBulk) DoNothing() *ShareAccessUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ShareAccessCreateBulk.OnConflict
+// documentation for more info.
+func (u *ShareAccessUpsertBulk) Update(set func(*ShareAccessUpsert)) *ShareAccessUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ShareAccessUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ShareAccessUpsertBulk) SetUpdatedAt(v time.Time) *ShareAccessUpsertBulk {
+	return u.Update(func(s *ShareAccessUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ShareAccessUpsertBulk) UpdateUpdatedAt() *ShareAccessUpsertBulk {
+	return u.Update(func(s *ShareAccessUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ShareAccessUpsertBulk) SetDeletedAt(v time.Time) *ShareAccessUpsertBulk {
+	return u.Update(func(s *ShareAccessUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ShareAccessUpsertBulk) UpdateDeletedAt() *ShareAccessUpsertBulk {
+	return u.Update(func(s *ShareAccessUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ShareAccessUpsertBulk) ClearDeletedAt() *ShareAccessUpsertBulk {
+	return u.Update(func(s *ShareAccessUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetShareID sets the "share_id" field.
+func (u *ShareAccessUpsertBulk) SetShareID(v int) *ShareAccessUpsertBulk {
+	return u.Update(func(s *ShareAccessUpsert) {
+		s.SetShareID(v)
+	})
+}
+
+// AddShareID adds v to the "share_id" field.
+func (u *ShareAccessUpsertBulk) AddShareID(v int) *ShareAccessUpsertBulk {
+	return u.Update(func(s *ShareAccessUpsert) {
+		s.AddShareID(v)
+	})
+}
+
+// UpdateShareID sets the "share_id" field to the value that was provided on create.
+func (u *ShareAccessUpsertBulk) UpdateShareID() *ShareAccessUpsertBulk {
+	return u.Update(func(s *ShareAccessUpsert) {
+		s.UpdateShareID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ShareAccessUpsertBulk) SetUserID(v int) *ShareAccessUpsertBulk {
+	return u.Update(func(s *ShareAccessUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *ShareAccessUpsertBulk) AddUserID(v int) *ShareAccessUpsertBulk {
+	return u.Update(func(s *ShareAccessUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ShareAccessUpsertBulk) UpdateUserID() *ShareAccessUpsertBulk {
+	return u.Update(func(s *ShareAccessUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *ShareAccessUpsertBulk) ClearUserID() *ShareAccessUpsertBulk {
+	return u.Update(func(s *ShareAccessUpsert) {
+		s.ClearUserID()
+	})
+}
+
+// SetAction sets the "action" field.
+func (u *ShareAccessUpsertBulk) SetAction(v shareaccess.Action) *ShareAccessUpsertBulk {
+	return u.Update(func(s *ShareAccessUpsert) {
+		s.SetAction(v)
+	})
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *ShareAccessUpsertBulk) UpdateAction() *ShareAccessUpsertBulk {
+	return u.Update(func(s *ShareAccessUpsert) {
+		s.UpdateAction()
+	})
+}
+
+// SetIP sets the "ip" field.
+func (u *ShareAccessUpsertBulk) SetIP(v string) *ShareAccessUpsertBulk {
+	return u.Update(func(s *ShareAccessUpsert) {
+		s.SetIP(v)
+	})
+}
+
+// UpdateIP sets the "ip" field to the value that was provided on create.
+func (u *ShareAccessUpsertBulk) UpdateIP() *ShareAccessUpsertBulk {
+	return u.Update(func(s *ShareAccessUpsert) {
+		s.UpdateIP()
+	})
+}
+
+// ClearIP clears the value of the "ip" field.
+func (u *ShareAccessUpsertBulk) ClearIP() *ShareAccessUpsertBulk {
+	return u.Update(func(s *ShareAccessUpsert) {
+		s.ClearIP()
+	})
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (u *ShareAccessUpsertBulk) SetUserAgent(v string) *ShareAccessUpsertBulk {
+	return u.Update(func(s *ShareAccessUpsert) {
+		s.SetUserAgent(v)
+	})
+}
+
+// UpdateUserAgent sets the "user_agent" field to the value that was provided on create.
+func (u *ShareAccessUpsertBulk) UpdateUserAgent() *ShareAccessUpsertBulk {
+	return u.Update(func(s *ShareAccessUpsert) {
+		s.UpdateUserAgent()
+	})
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (u *ShareAccessUpsertBulk) ClearUserAgent() *ShareAccessUpsertBulk {
+	return u.Update(func(s *ShareAccessUpsert) {
+		s.ClearUserAgent()
+	})
+}
+
+// SetPath sets the "path" field.
+func (u *ShareAccessUpsertBulk) SetPath(v string) *ShareAccessUpsertBulk {
+	return u.Update(func(s *ShareAccessUpsert) {
+		s.SetPath(v)
+	})
+}
+
+// UpdatePath sets the "path" field to the value that was provided on create.
+func (u *ShareAccessUpsertBulk) UpdatePath() *ShareAccessUpsertBulk {
+	return u.Update(func(s *ShareAccessUpsert) {
+		s.UpdatePath()
+	})
+}
+
+// ClearPath clears the value of the "path" field.
+func (u *ShareAccessUpsertBulk) ClearPath() *ShareAccessUpsertBulk {
+	return u.Update(func(s *ShareAccessUpsert) {
+		s.ClearPath()
+	})
+}
+
+// Exec executes the query.
+func (u *ShareAccessUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ShareAccessCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ShareAccessCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ShareAccessUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
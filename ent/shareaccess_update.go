@@ -0,0 +1,618 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+	"github.com/cloudreve/Cloudreve/v4/ent/shareaccess"
+)
+
+// ShareAccessUpdate is the builder for updating ShareAccess entities.
+type ShareAccessUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ShareAccessMutation
+}
+
+// Where appends a list predicates to the ShareAccessUpdate builder.
+func (sau *ShareAccessUpdate) Where(ps ...predicate.ShareAccess) *ShareAccessUpdate {
+	sau.mutation.Where(ps...)
+	return sau
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sau *ShareAccessUpdate) SetUpdatedAt(t time.Time) *ShareAccessUpdate {
+	sau.mutation.SetUpdatedAt(t)
+	return sau
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (sau *ShareAccessUpdate) SetDeletedAt(t time.Time) *ShareAccessUpdate {
+	sau.mutation.SetDeletedAt(t)
+	return sau
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (sau *ShareAccessUpdate) SetNillableDeletedAt(t *time.Time) *ShareAccessUpdate {
+	if t != nil {
+		sau.SetDeletedAt(*t)
+	}
+	return sau
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (sau *ShareAccessUpdate) ClearDeletedAt() *ShareAccessUpdate {
+	sau.mutation.ClearDeletedAt()
+	return sau
+}
+
+// SetShareID sets the "share_id" field.
+func (sau *ShareAccessUpdate) SetShareID(i int) *ShareAccessUpdate {
+	sau.mutation.ResetShareID()
+	sau.mutation.SetShareID(i)
+	return sau
+}
+
+// SetNillableShareID sets the "share_id" field if the given value is not nil.
+func (sau *ShareAccessUpdate) SetNillableShareID(i *int) *ShareAccessUpdate {
+	if i != nil {
+		sau.SetShareID(*i)
+	}
+	return sau
+}
+
+// AddShareID adds i to the "share_id" field.
+func (sau *ShareAccessUpdate) AddShareID(i int) *ShareAccessUpdate {
+	sau.mutation.AddShareID(i)
+	return sau
+}
+
+// SetUserID sets the "user_id" field.
+func (sau *ShareAccessUpdate) SetUserID(i int) *ShareAccessUpdate {
+	sau.mutation.ResetUserID()
+	sau.mutation.SetUserID(i)
+	return sau
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (sau *ShareAccessUpdate) SetNillableUserID(i *int) *ShareAccessUpdate {
+	if i != nil {
+		sau.SetUserID(*i)
+	}
+	return sau
+}
+
+// AddUserID adds i to the "user_id" field.
+func (sau *ShareAccessUpdate) AddUserID(i int) *ShareAccessUpdate {
+	sau.mutation.AddUserID(i)
+	return sau
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (sau *ShareAccessUpdate) ClearUserID() *ShareAccessUpdate {
+	sau.mutation.ClearUserID()
+	return sau
+}
+
+// SetAction sets the "action" field.
+func (sau *ShareAccessUpdate) SetAction(s shareaccess.Action) *ShareAccessUpdate {
+	sau.mutation.SetAction(s)
+	return sau
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (sau *ShareAccessUpdate) SetNillableAction(s *shareaccess.Action) *ShareAccessUpdate {
+	if s != nil {
+		sau.SetAction(*s)
+	}
+	return sau
+}
+
+// SetIP sets the "ip" field.
+func (sau *ShareAccessUpdate) SetIP(s string) *ShareAccessUpdate {
+	sau.mutation.SetIP(s)
+	return sau
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (sau *ShareAccessUpdate) SetNillableIP(s *string) *ShareAccessUpdate {
+	if s != nil {
+		sau.SetIP(*s)
+	}
+	return sau
+}
+
+// ClearIP clears the value of the "ip" field.
+func (sau *ShareAccessUpdate) ClearIP() *ShareAccessUpdate {
+	sau.mutation.ClearIP()
+	return sau
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (sau *ShareAccessUpdate) SetUserAgent(s string) *ShareAccessUpdate {
+	sau.mutation.SetUserAgent(s)
+	return sau
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (sau *ShareAccessUpdate) SetNillableUserAgent(s *string) *ShareAccessUpdate {
+	if s != nil {
+		sau.SetUserAgent(*s)
+	}
+	return sau
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (sau *ShareAccessUpdate) ClearUserAgent() *ShareAccessUpdate {
+	sau.mutation.ClearUserAgent()
+	return sau
+}
+
+// SetPath sets the "path" field.
+func (sau *ShareAccessUpdate) SetPath(s string) *ShareAccessUpdate {
+	sau.mutation.SetPath(s)
+	return sau
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (sau *ShareAccessUpdate) SetNillablePath(s *string) *ShareAccessUpdate {
+	if s != nil {
+		sau.SetPath(*s)
+	}
+	return sau
+}
+
+// ClearPath clears the value of the "path" field.
+func (sau *ShareAccessUpdate) ClearPath() *ShareAccessUpdate {
+	sau.mutation.ClearPath()
+	return sau
+}
+
+// Mutation returns the ShareAccessMutation object of the builder.
+func (sau *ShareAccessUpdate) Mutation() *ShareAccessMutation {
+	return sau.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sau *ShareAccessUpdate) Save(ctx context.Context) (int, error) {
+	if err := sau.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, sau.sqlSave, sau.mutation, sau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sau *ShareAccessUpdate) SaveX(ctx context.Context) int {
+	affected, err := sau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sau *ShareAccessUpdate) Exec(ctx context.Context) error {
+	_, err := sau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sau *ShareAccessUpdate) ExecX(ctx context.Context) {
+	if err := sau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sau *ShareAccessUpdate) defaults() error {
+	if _, ok := sau.mutation.UpdatedAt(); !ok {
+		if shareaccess.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized shareaccess.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := shareaccess.UpdateDefaultUpdatedAt()
+		sau.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sau *ShareAccessUpdate) check() error {
+	if v, ok := sau.mutation.Action(); ok {
+		if err := shareaccess.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "ShareAccess.action": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (sau *ShareAccessUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := sau.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(shareaccess.Table, shareaccess.Columns, sqlgraph.NewFieldSpec(shareaccess.FieldID, field.TypeInt))
+	if ps := sau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sau.mutation.UpdatedAt(); ok {
+		_spec.SetField(shareaccess.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := sau.mutation.DeletedAt(); ok {
+		_spec.SetField(shareaccess.FieldDeletedAt, field.TypeTime, value)
+	}
+	if sau.mutation.DeletedAtCleared() {
+		_spec.ClearField(shareaccess.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := sau.mutation.ShareID(); ok {
+		_spec.SetField(shareaccess.FieldShareID, field.TypeInt, value)
+	}
+	if value, ok := sau.mutation.AddedShareID(); ok {
+		_spec.AddField(shareaccess.FieldShareID, field.TypeInt, value)
+	}
+	if value, ok := sau.mutation.UserID(); ok {
+		_spec.SetField(shareaccess.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := sau.mutation.AddedUserID(); ok {
+		_spec.AddField(shareaccess.FieldUserID, field.TypeInt, value)
+	}
+	if sau.mutation.UserIDCleared() {
+		_spec.ClearField(shareaccess.FieldUserID, field.TypeInt)
+	}
+	if value, ok := sau.mutation.Action(); ok {
+		_spec.SetField(shareaccess.FieldAction, field.TypeEnum, value)
+	}
+	if value, ok := sau.mutation.IP(); ok {
+		_spec.SetField(shareaccess.FieldIP, field.TypeString, value)
+	}
+	if sau.mutation.IPCleared() {
+		_spec.ClearField(shareaccess.FieldIP, field.TypeString)
+	}
+	if value, ok := sau.mutation.UserAgent(); ok {
+		_spec.SetField(shareaccess.FieldUserAgent, field.TypeString, value)
+	}
+	if sau.mutation.UserAgentCleared() {
+		_spec.ClearField(shareaccess.FieldUserAgent, field.TypeString)
+	}
+	if value, ok := sau.mutation.Path(); ok {
+		_spec.SetField(shareaccess.FieldPath, field.TypeString, value)
+	}
+	if sau.mutation.PathCleared() {
+		_spec.ClearField(shareaccess.FieldPath, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, sau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{shareaccess.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	sau.mutation.done = true
+	return n, nil
+}
+
+// ShareAccessUpdateOne is the builder for updating a single ShareAccess entity.
+type ShareAccessUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ShareAccessMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sauo *ShareAccessUpdateOne) SetUpdatedAt(t time.Time) *ShareAccessUpdateOne {
+	sauo.mutation.SetUpdatedAt(t)
+	return sauo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (sauo *ShareAccessUpdateOne) SetDeletedAt(t time.Time) *ShareAccessUpdateOne {
+	sauo.mutation.SetDeletedAt(t)
+	return sauo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (sauo *ShareAccessUpdateOne) SetNillableDeletedAt(t *time.Time) *ShareAccessUpdateOne {
+	if t != nil {
+		sauo.SetDeletedAt(*t)
+	}
+	return sauo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (sauo *ShareAccessUpdateOne) ClearDeletedAt() *ShareAccessUpdateOne {
+	sauo.mutation.ClearDeletedAt()
+	return sauo
+}
+
+// SetShareID sets the "share_id" field.
+func (sauo *ShareAccessUpdateOne) SetShareID(i int) *ShareAccessUpdateOne {
+	sauo.mutation.ResetShareID()
+	sauo.mutation.SetShareID(i)
+	return sauo
+}
+
+// SetNillableShareID sets the "share_id" field if the given value is not nil.
+func (sauo *ShareAccessUpdateOne) SetNillableShareID(i *int) *ShareAccessUpdateOne {
+	if i != nil {
+		sauo.SetShareID(*i)
+	}
+	return sauo
+}
+
+// AddShareID adds i to the "share_id" field.
+func (sauo *ShareAccessUpdateOne) AddShareID(i int) *ShareAccessUpdateOne {
+	sauo.mutation.AddShareID(i)
+	return sauo
+}
+
+// SetUserID sets the "user_id" field.
+func (sauo *ShareAccessUpdateOne) SetUserID(i int) *ShareAccessUpdateOne {
+	sauo.mutation.ResetUserID()
+	sauo.mutation.SetUserID(i)
+	return sauo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (sauo *ShareAccessUpdateOne) SetNillableUserID(i *int) *ShareAccessUpdateOne {
+	if i != nil {
+		sauo.SetUserID(*i)
+	}
+	return sauo
+}
+
+// AddUserID adds i to the "user_id" field.
+func (sauo *ShareAccessUpdateOne) AddUserID(i int) *ShareAccessUpdateOne {
+	sauo.mutation.AddUserID(i)
+	return sauo
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (sauo *ShareAccessUpdateOne) ClearUserID() *ShareAccessUpdateOne {
+	sauo.mutation.ClearUserID()
+	return sauo
+}
+
+// SetAction sets the "action" field.
+func (sauo *ShareAccessUpdateOne) SetAction(s shareaccess.Action) *ShareAccessUpdateOne {
+	sauo.mutation.SetAction(s)
+	return sauo
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (sauo *ShareAccessUpdateOne) SetNillableAction(s *shareaccess.Action) *ShareAccessUpdateOne {
+	if s != nil {
+		sauo.SetAction(*s)
+	}
+	return sauo
+}
+
+// SetIP sets the "ip" field.
+func (sauo *ShareAccessUpdateOne) SetIP(s string) *ShareAccessUpdateOne {
+	sauo.mutation.SetIP(s)
+	return sauo
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (sauo *ShareAccessUpdateOne) SetNillableIP(s *string) *ShareAccessUpdateOne {
+	if s != nil {
+		sauo.SetIP(*s)
+	}
+	return sauo
+}
+
+// ClearIP clears the value of the "ip" field.
+func (sauo *ShareAccessUpdateOne) ClearIP() *ShareAccessUpdateOne {
+	sauo.mutation.ClearIP()
+	return sauo
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (sauo *ShareAccessUpdateOne) SetUserAgent(s string) *ShareAccessUpdateOne {
+	sauo.mutation.SetUserAgent(s)
+	return sauo
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (sauo *ShareAccessUpdateOne) SetNillableUserAgent(s *string) *ShareAccessUpdateOne {
+	if s != nil {
+		sauo.SetUserAgent(*s)
+	}
+	return sauo
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (sauo *ShareAccessUpdateOne) ClearUserAgent() *ShareAccessUpdateOne {
+	sauo.mutation.ClearUserAgent()
+	return sauo
+}
+
+// SetPath sets the "path" field.
+func (sauo *ShareAccessUpdateOne) SetPath(s string) *ShareAccessUpdateOne {
+	sauo.mutation.SetPath(s)
+	return sauo
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (sauo *ShareAccessUpdateOne) SetNillablePath(s *string) *ShareAccessUpdateOne {
+	if s != nil {
+		sauo.SetPath(*s)
+	}
+	return sauo
+}
+
+// ClearPath clears the value of the "path" field.
+func (sauo *ShareAccessUpdateOne) ClearPath() *ShareAccessUpdateOne {
+	sauo.mutation.ClearPath()
+	return sauo
+}
+
+// Mutation returns the ShareAccessMutation object of the builder.
+func (sauo *ShareAccessUpdateOne) Mutation() *ShareAccessMutation {
+	return sauo.mutation
+}
+
+// Where appends a list predicates to the ShareAccessUpdate builder.
+func (sauo *ShareAccessUpdateOne) Where(ps ...predicate.ShareAccess) *ShareAccessUpdateOne {
+	sauo.mutation.Where(ps...)
+	return sauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (sauo *ShareAccessUpdateOne) Select(field string, fields ...string) *ShareAccessUpdateOne {
+	sauo.fields = append([]string{field}, fields...)
+	return sauo
+}
+
+// Save executes the query and returns the updated ShareAccess entity.
+func (sauo *ShareAccessUpdateOne) Save(ctx context.Context) (*ShareAccess, error) {
+	if err := sauo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, sauo.sqlSave, sauo.mutation, sauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sauo *ShareAccessUpdateOne) SaveX(ctx context.Context) *ShareAccess {
+	node, err := sauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (sauo *ShareAccessUpdateOne) Exec(ctx context.Context) error {
+	_, err := sauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sauo *ShareAccessUpdateOne) ExecX(ctx context.Context) {
+	if err := sauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sauo *ShareAccessUpdateOne) defaults() error {
+	if _, ok := sauo.mutation.UpdatedAt(); !ok {
+		if shareaccess.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized shareaccess.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := shareaccess.UpdateDefaultUpdatedAt()
+		sauo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sauo *ShareAccessUpdateOne) check() error {
+	if v, ok := sauo.mutation.Action(); ok {
+		if err := shareaccess.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "ShareAccess.action": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (sauo *ShareAccessUpdateOne) sqlSave(ctx context.Context) (_node *ShareAccess, err error) {
+	if err := sauo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(shareaccess.Table, shareaccess.Columns, sqlgraph.NewFieldSpec(shareaccess.FieldID, field.TypeInt))
+	id, ok := sauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ShareAccess.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := sauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, shareaccess.FieldID)
+		for _, f := range fields {
+			if !shareaccess.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != shareaccess.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := sauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sauo.mutation.UpdatedAt(); ok {
+		_spec.SetField(shareaccess.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := sauo.mutation.DeletedAt(); ok {
+		_spec.SetField(shareaccess.FieldDeletedAt, field.TypeTime, value)
+	}
+	if sauo.mutation.DeletedAtCleared() {
+		_spec.ClearField(shareaccess.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := sauo.mutation.ShareID(); ok {
+		_spec.SetField(shareaccess.FieldShareID, field.TypeInt, value)
+	}
+	if value, ok := sauo.mutation.AddedShareID(); ok {
+		_spec.AddField(shareaccess.FieldShareID, field.TypeInt, value)
+	}
+	if value, ok := sauo.mutation.UserID(); ok {
+		_spec.SetField(shareaccess.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := sauo.mutation.AddedUserID(); ok {
+		_spec.AddField(shareaccess.FieldUserID, field.TypeInt, value)
+	}
+	if sauo.mutation.UserIDCleared() {
+		_spec.ClearField(shareaccess.FieldUserID, field.TypeInt)
+	}
+	if value, ok := sauo.mutation.Action(); ok {
+		_spec.SetField(shareaccess.FieldAction, field.TypeEnum, value)
+	}
+	if value, ok := sauo.mutation.IP(); ok {
+		_spec.SetField(shareaccess.FieldIP, field.TypeString, value)
+	}
+	if sauo.mutation.IPCleared() {
+		_spec.ClearField(shareaccess.FieldIP, field.TypeString)
+	}
+	if value, ok := sauo.mutation.UserAgent(); ok {
+		_spec.SetField(shareaccess.FieldUserAgent, field.TypeString, value)
+	}
+	if sauo.mutation.UserAgentCleared() {
+		_spec.ClearField(shareaccess.FieldUserAgent, field.TypeString)
+	}
+	if value, ok := sauo.mutation.Path(); ok {
+		_spec.SetField(shareaccess.FieldPath, field.TypeString, value)
+	}
+	if sauo.mutation.PathCleared() {
+		_spec.ClearField(shareaccess.FieldPath, field.TypeString)
+	}
+	_node = &ShareAccess{config: sauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, sauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{shareaccess.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	sauo.mutation.done = true
+	return _node, nil
+}
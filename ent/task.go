@@ -39,6 +39,10 @@ type Task struct {
 	CorrelationID uuid.UUID `json:"correlation_id,omitempty"`
 	// UserTasks holds the value of the "user_tasks" field.
 	UserTasks int `json:"user_tasks,omitempty"`
+	// LeaseOwner holds the value of the "lease_owner" field.
+	LeaseOwner string `json:"lease_owner,omitempty"`
+	// LeaseExpireAt holds the value of the "lease_expire_at" field.
+	LeaseExpireAt *time.Time `json:"lease_expire_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TaskQuery when eager-loading is set.
 	Edges        TaskEdges `json:"edges"`
@@ -76,9 +80,9 @@ func (*Task) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case task.FieldID, task.FieldUserTasks:
 			values[i] = new(sql.NullInt64)
-		case task.FieldType, task.FieldStatus, task.FieldPrivateState:
+		case task.FieldType, task.FieldStatus, task.FieldPrivateState, task.FieldLeaseOwner:
 			values[i] = new(sql.NullString)
-		case task.FieldCreatedAt, task.FieldUpdatedAt, task.FieldDeletedAt:
+		case task.FieldCreatedAt, task.FieldUpdatedAt, task.FieldDeletedAt, task.FieldLeaseExpireAt:
 			values[i] = new(sql.NullTime)
 		case task.FieldCorrelationID:
 			values[i] = new(uuid.UUID)
@@ -160,6 +164,19 @@ func (t *Task) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				t.UserTasks = int(value.Int64)
 			}
+		case task.FieldLeaseOwner:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field lease_owner", values[i])
+			} else if value.Valid {
+				t.LeaseOwner = value.String
+			}
+		case task.FieldLeaseExpireAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field lease_expire_at", values[i])
+			} else if value.Valid {
+				t.LeaseExpireAt = new(time.Time)
+				*t.LeaseExpireAt = value.Time
+			}
 		default:
 			t.selectValues.Set(columns[i], values[i])
 		}
@@ -229,6 +246,14 @@ func (t *Task) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("user_tasks=")
 	builder.WriteString(fmt.Sprintf("%v", t.UserTasks))
+	builder.WriteString(", ")
+	builder.WriteString("lease_owner=")
+	builder.WriteString(t.LeaseOwner)
+	builder.WriteString(", ")
+	if v := t.LeaseExpireAt; v != nil {
+		builder.WriteString("lease_expire_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
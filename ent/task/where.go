@@ -91,6 +91,16 @@ func UserTasks(v int) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldUserTasks, v))
 }
 
+// LeaseOwner applies equality check predicate on the "lease_owner" field. It's identical to LeaseOwnerEQ.
+func LeaseOwner(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldLeaseOwner, v))
+}
+
+// LeaseExpireAt applies equality check predicate on the "lease_expire_at" field. It's identical to LeaseExpireAtEQ.
+func LeaseExpireAt(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldLeaseExpireAt, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldCreatedAt, v))
@@ -461,6 +471,131 @@ func UserTasksNotNil() predicate.Task {
 	return predicate.Task(sql.FieldNotNull(FieldUserTasks))
 }
 
+// LeaseOwnerEQ applies the EQ predicate on the "lease_owner" field.
+func LeaseOwnerEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldLeaseOwner, v))
+}
+
+// LeaseOwnerNEQ applies the NEQ predicate on the "lease_owner" field.
+func LeaseOwnerNEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldLeaseOwner, v))
+}
+
+// LeaseOwnerIn applies the In predicate on the "lease_owner" field.
+func LeaseOwnerIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldLeaseOwner, vs...))
+}
+
+// LeaseOwnerNotIn applies the NotIn predicate on the "lease_owner" field.
+func LeaseOwnerNotIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldLeaseOwner, vs...))
+}
+
+// LeaseOwnerGT applies the GT predicate on the "lease_owner" field.
+func LeaseOwnerGT(v string) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldLeaseOwner, v))
+}
+
+// LeaseOwnerGTE applies the GTE predicate on the "lease_owner" field.
+func LeaseOwnerGTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldLeaseOwner, v))
+}
+
+// LeaseOwnerLT applies the LT predicate on the "lease_owner" field.
+func LeaseOwnerLT(v string) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldLeaseOwner, v))
+}
+
+// LeaseOwnerLTE applies the LTE predicate on the "lease_owner" field.
+func LeaseOwnerLTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldLeaseOwner, v))
+}
+
+// LeaseOwnerContains applies the Contains predicate on the "lease_owner" field.
+func LeaseOwnerContains(v string) predicate.Task {
+	return predicate.Task(sql.FieldContains(FieldLeaseOwner, v))
+}
+
+// LeaseOwnerHasPrefix applies the HasPrefix predicate on the "lease_owner" field.
+func LeaseOwnerHasPrefix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasPrefix(FieldLeaseOwner, v))
+}
+
+// LeaseOwnerHasSuffix applies the HasSuffix predicate on the "lease_owner" field.
+func LeaseOwnerHasSuffix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasSuffix(FieldLeaseOwner, v))
+}
+
+// LeaseOwnerIsNil applies the IsNil predicate on the "lease_owner" field.
+func LeaseOwnerIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldLeaseOwner))
+}
+
+// LeaseOwnerNotNil applies the NotNil predicate on the "lease_owner" field.
+func LeaseOwnerNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldLeaseOwner))
+}
+
+// LeaseOwnerEqualFold applies the EqualFold predicate on the "lease_owner" field.
+func LeaseOwnerEqualFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldEqualFold(FieldLeaseOwner, v))
+}
+
+// LeaseOwnerContainsFold applies the ContainsFold predicate on the "lease_owner" field.
+func LeaseOwnerContainsFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldContainsFold(FieldLeaseOwner, v))
+}
+
+// LeaseExpireAtEQ applies the EQ predicate on the "lease_expire_at" field.
+func LeaseExpireAtEQ(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldLeaseExpireAt, v))
+}
+
+// LeaseExpireAtNEQ applies the NEQ predicate on the "lease_expire_at" field.
+func LeaseExpireAtNEQ(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldLeaseExpireAt, v))
+}
+
+// LeaseExpireAtIn applies the In predicate on the "lease_expire_at" field.
+func LeaseExpireAtIn(vs ...time.Time) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldLeaseExpireAt, vs...))
+}
+
+// LeaseExpireAtNotIn applies the NotIn predicate on the "lease_expire_at" field.
+func LeaseExpireAtNotIn(vs ...time.Time) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldLeaseExpireAt, vs...))
+}
+
+// LeaseExpireAtGT applies the GT predicate on the "lease_expire_at" field.
+func LeaseExpireAtGT(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldLeaseExpireAt, v))
+}
+
+// LeaseExpireAtGTE applies the GTE predicate on the "lease_expire_at" field.
+func LeaseExpireAtGTE(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldLeaseExpireAt, v))
+}
+
+// LeaseExpireAtLT applies the LT predicate on the "lease_expire_at" field.
+func LeaseExpireAtLT(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldLeaseExpireAt, v))
+}
+
+// LeaseExpireAtLTE applies the LTE predicate on the "lease_expire_at" field.
+func LeaseExpireAtLTE(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldLeaseExpireAt, v))
+}
+
+// LeaseExpireAtIsNil applies the IsNil predicate on the "lease_expire_at" field.
+func LeaseExpireAtIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldLeaseExpireAt))
+}
+
+// LeaseExpireAtNotNil applies the NotNil predicate on the "lease_expire_at" field.
+func LeaseExpireAtNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldLeaseExpireAt))
+}
+
 // HasUser applies the HasEdge predicate on the "user" edge.
 func HasUser() predicate.Task {
 	return predicate.Task(func(s *sql.Selector) {
@@ -135,6 +135,34 @@ func (tc *TaskCreate) SetNillableUserTasks(i *int) *TaskCreate {
 	return tc
 }
 
+// SetLeaseOwner sets the "lease_owner" field.
+func (tc *TaskCreate) SetLeaseOwner(s string) *TaskCreate {
+	tc.mutation.SetLeaseOwner(s)
+	return tc
+}
+
+// SetNillableLeaseOwner sets the "lease_owner" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableLeaseOwner(s *string) *TaskCreate {
+	if s != nil {
+		tc.SetLeaseOwner(*s)
+	}
+	return tc
+}
+
+// SetLeaseExpireAt sets the "lease_expire_at" field.
+func (tc *TaskCreate) SetLeaseExpireAt(t time.Time) *TaskCreate {
+	tc.mutation.SetLeaseExpireAt(t)
+	return tc
+}
+
+// SetNillableLeaseExpireAt sets the "lease_expire_at" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableLeaseExpireAt(t *time.Time) *TaskCreate {
+	if t != nil {
+		tc.SetLeaseExpireAt(*t)
+	}
+	return tc
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (tc *TaskCreate) SetUserID(id int) *TaskCreate {
 	tc.mutation.SetUserID(id)
@@ -300,6 +328,14 @@ func (tc *TaskCreate) createSpec() (*Task, *sqlgraph.CreateSpec) {
 		_spec.SetField(task.FieldCorrelationID, field.TypeUUID, value)
 		_node.CorrelationID = value
 	}
+	if value, ok := tc.mutation.LeaseOwner(); ok {
+		_spec.SetField(task.FieldLeaseOwner, field.TypeString, value)
+		_node.LeaseOwner = value
+	}
+	if value, ok := tc.mutation.LeaseExpireAt(); ok {
+		_spec.SetField(task.FieldLeaseExpireAt, field.TypeTime, value)
+		_node.LeaseExpireAt = &value
+	}
 	if nodes := tc.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -471,6 +507,42 @@ func (u *TaskUpsert) ClearUserTasks() *TaskUpsert {
 	return u
 }
 
+// SetLeaseOwner sets the "lease_owner" field.
+func (u *TaskUpsert) SetLeaseOwner(v string) *TaskUpsert {
+	u.Set(task.FieldLeaseOwner, v)
+	return u
+}
+
+// UpdateLeaseOwner sets the "lease_owner" field to the value that was provided on create.
+func (u *TaskUpsert) UpdateLeaseOwner() *TaskUpsert {
+	u.SetExcluded(task.FieldLeaseOwner)
+	return u
+}
+
+// ClearLeaseOwner clears the value of the "lease_owner" field.
+func (u *TaskUpsert) ClearLeaseOwner() *TaskUpsert {
+	u.SetNull(task.FieldLeaseOwner)
+	return u
+}
+
+// SetLeaseExpireAt sets the "lease_expire_at" field.
+func (u *TaskUpsert) SetLeaseExpireAt(v time.Time) *TaskUpsert {
+	u.Set(task.FieldLeaseExpireAt, v)
+	return u
+}
+
+// UpdateLeaseExpireAt sets the "lease_expire_at" field to the value that was provided on create.
+func (u *TaskUpsert) UpdateLeaseExpireAt() *TaskUpsert {
+	u.SetExcluded(task.FieldLeaseExpireAt)
+	return u
+}
+
+// ClearLeaseExpireAt clears the value of the "lease_expire_at" field.
+func (u *TaskUpsert) ClearLeaseExpireAt() *TaskUpsert {
+	u.SetNull(task.FieldLeaseExpireAt)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -638,6 +710,48 @@ func (u *TaskUpsertOne) ClearUserTasks() *TaskUpsertOne {
 	})
 }
 
+// SetLeaseOwner sets the "lease_owner" field.
+func (u *TaskUpsertOne) SetLeaseOwner(v string) *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetLeaseOwner(v)
+	})
+}
+
+// UpdateLeaseOwner sets the "lease_owner" field to the value that was provided on create.
+func (u *TaskUpsertOne) UpdateLeaseOwner() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdateLeaseOwner()
+	})
+}
+
+// ClearLeaseOwner clears the value of the "lease_owner" field.
+func (u *TaskUpsertOne) ClearLeaseOwner() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.ClearLeaseOwner()
+	})
+}
+
+// SetLeaseExpireAt sets the "lease_expire_at" field.
+func (u *TaskUpsertOne) SetLeaseExpireAt(v time.Time) *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetLeaseExpireAt(v)
+	})
+}
+
+// UpdateLeaseExpireAt sets the "lease_expire_at" field to the value that was provided on create.
+func (u *TaskUpsertOne) UpdateLeaseExpireAt() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdateLeaseExpireAt()
+	})
+}
+
+// ClearLeaseExpireAt clears the value of the "lease_expire_at" field.
+func (u *TaskUpsertOne) ClearLeaseExpireAt() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.ClearLeaseExpireAt()
+	})
+}
+
 // Exec executes the query.
 func (u *TaskUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -976,6 +1090,48 @@ func (u *TaskUpsertBulk) ClearUserTasks() *TaskUpsertBulk {
 	})
 }
 
+// SetLeaseOwner sets the "lease_owner" field.
+func (u *TaskUpsertBulk) SetLeaseOwner(v string) *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetLeaseOwner(v)
+	})
+}
+
+// UpdateLeaseOwner sets the "lease_owner" field to the value that was provided on create.
+func (u *TaskUpsertBulk) UpdateLeaseOwner() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdateLeaseOwner()
+	})
+}
+
+// ClearLeaseOwner clears the value of the "lease_owner" field.
+func (u *TaskUpsertBulk) ClearLeaseOwner() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.ClearLeaseOwner()
+	})
+}
+
+// SetLeaseExpireAt sets the "lease_expire_at" field.
+func (u *TaskUpsertBulk) SetLeaseExpireAt(v time.Time) *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetLeaseExpireAt(v)
+	})
+}
+
+// UpdateLeaseExpireAt sets the "lease_expire_at" field to the value that was provided on create.
+func (u *TaskUpsertBulk) UpdateLeaseExpireAt() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdateLeaseExpireAt()
+	})
+}
+
+// ClearLeaseExpireAt clears the value of the "lease_expire_at" field.
+func (u *TaskUpsertBulk) ClearLeaseExpireAt() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.ClearLeaseExpireAt()
+	})
+}
+
 // Exec executes the query.
 func (u *TaskUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -130,6 +130,46 @@ func (tu *TaskUpdate) ClearUserTasks() *TaskUpdate {
 	return tu
 }
 
+// SetLeaseOwner sets the "lease_owner" field.
+func (tu *TaskUpdate) SetLeaseOwner(s string) *TaskUpdate {
+	tu.mutation.SetLeaseOwner(s)
+	return tu
+}
+
+// SetNillableLeaseOwner sets the "lease_owner" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableLeaseOwner(s *string) *TaskUpdate {
+	if s != nil {
+		tu.SetLeaseOwner(*s)
+	}
+	return tu
+}
+
+// ClearLeaseOwner clears the value of the "lease_owner" field.
+func (tu *TaskUpdate) ClearLeaseOwner() *TaskUpdate {
+	tu.mutation.ClearLeaseOwner()
+	return tu
+}
+
+// SetLeaseExpireAt sets the "lease_expire_at" field.
+func (tu *TaskUpdate) SetLeaseExpireAt(t time.Time) *TaskUpdate {
+	tu.mutation.SetLeaseExpireAt(t)
+	return tu
+}
+
+// SetNillableLeaseExpireAt sets the "lease_expire_at" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableLeaseExpireAt(t *time.Time) *TaskUpdate {
+	if t != nil {
+		tu.SetLeaseExpireAt(*t)
+	}
+	return tu
+}
+
+// ClearLeaseExpireAt clears the value of the "lease_expire_at" field.
+func (tu *TaskUpdate) ClearLeaseExpireAt() *TaskUpdate {
+	tu.mutation.ClearLeaseExpireAt()
+	return tu
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (tu *TaskUpdate) SetUserID(id int) *TaskUpdate {
 	tu.mutation.SetUserID(id)
@@ -251,6 +291,18 @@ func (tu *TaskUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if tu.mutation.CorrelationIDCleared() {
 		_spec.ClearField(task.FieldCorrelationID, field.TypeUUID)
 	}
+	if value, ok := tu.mutation.LeaseOwner(); ok {
+		_spec.SetField(task.FieldLeaseOwner, field.TypeString, value)
+	}
+	if tu.mutation.LeaseOwnerCleared() {
+		_spec.ClearField(task.FieldLeaseOwner, field.TypeString)
+	}
+	if value, ok := tu.mutation.LeaseExpireAt(); ok {
+		_spec.SetField(task.FieldLeaseExpireAt, field.TypeTime, value)
+	}
+	if tu.mutation.LeaseExpireAtCleared() {
+		_spec.ClearField(task.FieldLeaseExpireAt, field.TypeTime)
+	}
 	if tu.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -400,6 +452,46 @@ func (tuo *TaskUpdateOne) ClearUserTasks() *TaskUpdateOne {
 	return tuo
 }
 
+// SetLeaseOwner sets the "lease_owner" field.
+func (tuo *TaskUpdateOne) SetLeaseOwner(s string) *TaskUpdateOne {
+	tuo.mutation.SetLeaseOwner(s)
+	return tuo
+}
+
+// SetNillableLeaseOwner sets the "lease_owner" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableLeaseOwner(s *string) *TaskUpdateOne {
+	if s != nil {
+		tuo.SetLeaseOwner(*s)
+	}
+	return tuo
+}
+
+// ClearLeaseOwner clears the value of the "lease_owner" field.
+func (tuo *TaskUpdateOne) ClearLeaseOwner() *TaskUpdateOne {
+	tuo.mutation.ClearLeaseOwner()
+	return tuo
+}
+
+// SetLeaseExpireAt sets the "lease_expire_at" field.
+func (tuo *TaskUpdateOne) SetLeaseExpireAt(t time.Time) *TaskUpdateOne {
+	tuo.mutation.SetLeaseExpireAt(t)
+	return tuo
+}
+
+// SetNillableLeaseExpireAt sets the "lease_expire_at" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableLeaseExpireAt(t *time.Time) *TaskUpdateOne {
+	if t != nil {
+		tuo.SetLeaseExpireAt(*t)
+	}
+	return tuo
+}
+
+// ClearLeaseExpireAt clears the value of the "lease_expire_at" field.
+func (tuo *TaskUpdateOne) ClearLeaseExpireAt() *TaskUpdateOne {
+	tuo.mutation.ClearLeaseExpireAt()
+	return tuo
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (tuo *TaskUpdateOne) SetUserID(id int) *TaskUpdateOne {
 	tuo.mutation.SetUserID(id)
@@ -551,6 +643,18 @@ func (tuo *TaskUpdateOne) sqlSave(ctx context.Context) (_node *Task, err error)
 	if tuo.mutation.CorrelationIDCleared() {
 		_spec.ClearField(task.FieldCorrelationID, field.TypeUUID)
 	}
+	if value, ok := tuo.mutation.LeaseOwner(); ok {
+		_spec.SetField(task.FieldLeaseOwner, field.TypeString, value)
+	}
+	if tuo.mutation.LeaseOwnerCleared() {
+		_spec.ClearField(task.FieldLeaseOwner, field.TypeString)
+	}
+	if value, ok := tuo.mutation.LeaseExpireAt(); ok {
+		_spec.SetField(task.FieldLeaseExpireAt, field.TypeTime, value)
+	}
+	if tuo.mutation.LeaseExpireAtCleared() {
+		_spec.ClearField(task.FieldLeaseExpireAt, field.TypeTime)
+	}
 	if tuo.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -20,6 +20,7 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/ent/passkey"
 	"github.com/cloudreve/Cloudreve/v4/ent/share"
 	"github.com/cloudreve/Cloudreve/v4/ent/task"
+	"github.com/cloudreve/Cloudreve/v4/ent/team"
 	"github.com/cloudreve/Cloudreve/v4/ent/user"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
 )
@@ -128,6 +129,20 @@ func (uc *UserCreate) SetNillableStorage(i *int64) *UserCreate {
 	return uc
 }
 
+// SetFileCount sets the "file_count" field.
+func (uc *UserCreate) SetFileCount(i int) *UserCreate {
+	uc.mutation.SetFileCount(i)
+	return uc
+}
+
+// SetNillableFileCount sets the "file_count" field if the given value is not nil.
+func (uc *UserCreate) SetNillableFileCount(i *int) *UserCreate {
+	if i != nil {
+		uc.SetFileCount(*i)
+	}
+	return uc
+}
+
 // SetTwoFactorSecret sets the "two_factor_secret" field.
 func (uc *UserCreate) SetTwoFactorSecret(s string) *UserCreate {
 	uc.mutation.SetTwoFactorSecret(s)
@@ -299,6 +314,21 @@ func (uc *UserCreate) AddOauthGrants(o ...*OAuthGrant) *UserCreate {
 	return uc.AddOauthGrantIDs(ids...)
 }
 
+// AddTeamIDs adds the "teams" edge to the Team entity by IDs.
+func (uc *UserCreate) AddTeamIDs(ids ...int) *UserCreate {
+	uc.mutation.AddTeamIDs(ids...)
+	return uc
+}
+
+// AddTeams adds the "teams" edges to the Team entity.
+func (uc *UserCreate) AddTeams(t ...*Team) *UserCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return uc.AddTeamIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uc *UserCreate) Mutation() *UserMutation {
 	return uc.mutation
@@ -358,6 +388,10 @@ func (uc *UserCreate) defaults() error {
 		v := user.DefaultStorage
 		uc.mutation.SetStorage(v)
 	}
+	if _, ok := uc.mutation.FileCount(); !ok {
+		v := user.DefaultFileCount
+		uc.mutation.SetFileCount(v)
+	}
 	if _, ok := uc.mutation.Settings(); !ok {
 		v := user.DefaultSettings
 		uc.mutation.SetSettings(v)
@@ -400,6 +434,9 @@ func (uc *UserCreate) check() error {
 	if _, ok := uc.mutation.Storage(); !ok {
 		return &ValidationError{Name: "storage", err: errors.New(`ent: missing required field "User.storage"`)}
 	}
+	if _, ok := uc.mutation.FileCount(); !ok {
+		return &ValidationError{Name: "file_count", err: errors.New(`ent: missing required field "User.file_count"`)}
+	}
 	if _, ok := uc.mutation.GroupUsers(); !ok {
 		return &ValidationError{Name: "group_users", err: errors.New(`ent: missing required field "User.group_users"`)}
 	}
@@ -472,6 +509,10 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldStorage, field.TypeInt64, value)
 		_node.Storage = value
 	}
+	if value, ok := uc.mutation.FileCount(); ok {
+		_spec.SetField(user.FieldFileCount, field.TypeInt, value)
+		_node.FileCount = value
+	}
 	if value, ok := uc.mutation.TwoFactorSecret(); ok {
 		_spec.SetField(user.FieldTwoFactorSecret, field.TypeString, value)
 		_node.TwoFactorSecret = value
@@ -629,6 +670,22 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := uc.mutation.TeamsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TeamsTable,
+			Columns: []string{user.TeamsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -783,6 +840,24 @@ func (u *UserUpsert) AddStorage(v int64) *UserUpsert {
 	return u
 }
 
+// SetFileCount sets the "file_count" field.
+func (u *UserUpsert) SetFileCount(v int) *UserUpsert {
+	u.Set(user.FieldFileCount, v)
+	return u
+}
+
+// UpdateFileCount sets the "file_count" field to the value that was provided on create.
+func (u *UserUpsert) UpdateFileCount() *UserUpsert {
+	u.SetExcluded(user.FieldFileCount)
+	return u
+}
+
+// AddFileCount adds v to the "file_count" field.
+func (u *UserUpsert) AddFileCount(v int) *UserUpsert {
+	u.Add(user.FieldFileCount, v)
+	return u
+}
+
 // SetTwoFactorSecret sets the "two_factor_secret" field.
 func (u *UserUpsert) SetTwoFactorSecret(v string) *UserUpsert {
 	u.Set(user.FieldTwoFactorSecret, v)
@@ -1013,6 +1088,27 @@ func (u *UserUpsertOne) UpdateStorage() *UserUpsertOne {
 	})
 }
 
+// SetFileCount sets the "file_count" field.
+func (u *UserUpsertOne) SetFileCount(v int) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetFileCount(v)
+	})
+}
+
+// AddFileCount adds v to the "file_count" field.
+func (u *UserUpsertOne) AddFileCount(v int) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.AddFileCount(v)
+	})
+}
+
+// UpdateFileCount sets the "file_count" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateFileCount() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateFileCount()
+	})
+}
+
 // SetTwoFactorSecret sets the "two_factor_secret" field.
 func (u *UserUpsertOne) SetTwoFactorSecret(v string) *UserUpsertOne {
 	return u.Update(func(s *UserUpsert) {
@@ -1425,6 +1521,27 @@ func (u *UserUpsertBulk) UpdateStorage() *UserUpsertBulk {
 	})
 }
 
+// SetFileCount sets the "file_count" field.
+func (u *UserUpsertBulk) SetFileCount(v int) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetFileCount(v)
+	})
+}
+
+// AddFileCount adds v to the "file_count" field.
+func (u *UserUpsertBulk) AddFileCount(v int) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.AddFileCount(v)
+	})
+}
+
+// UpdateFileCount sets the "file_count" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateFileCount() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateFileCount()
+	})
+}
+
 // SetTwoFactorSecret sets the "two_factor_secret" field.
 func (u *UserUpsertBulk) SetTwoFactorSecret(v string) *UserUpsertBulk {
 	return u.Update(func(s *UserUpsert) {
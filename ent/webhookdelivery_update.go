@@ -0,0 +1,647 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+	"github.com/cloudreve/Cloudreve/v4/ent/webhookdelivery"
+	"github.com/gofrs/uuid"
+)
+
+// WebhookDeliveryUpdate is the builder for updating WebhookDelivery entities.
+type WebhookDeliveryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WebhookDeliveryMutation
+}
+
+// Where appends a list predicates to the WebhookDeliveryUpdate builder.
+func (wdu *WebhookDeliveryUpdate) Where(ps ...predicate.WebhookDelivery) *WebhookDeliveryUpdate {
+	wdu.mutation.Where(ps...)
+	return wdu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wdu *WebhookDeliveryUpdate) SetUpdatedAt(t time.Time) *WebhookDeliveryUpdate {
+	wdu.mutation.SetUpdatedAt(t)
+	return wdu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (wdu *WebhookDeliveryUpdate) SetDeletedAt(t time.Time) *WebhookDeliveryUpdate {
+	wdu.mutation.SetDeletedAt(t)
+	return wdu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableDeletedAt(t *time.Time) *WebhookDeliveryUpdate {
+	if t != nil {
+		wdu.SetDeletedAt(*t)
+	}
+	return wdu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (wdu *WebhookDeliveryUpdate) ClearDeletedAt() *WebhookDeliveryUpdate {
+	wdu.mutation.ClearDeletedAt()
+	return wdu
+}
+
+// SetWebhookID sets the "webhook_id" field.
+func (wdu *WebhookDeliveryUpdate) SetWebhookID(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.ResetWebhookID()
+	wdu.mutation.SetWebhookID(i)
+	return wdu
+}
+
+// SetNillableWebhookID sets the "webhook_id" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableWebhookID(i *int) *WebhookDeliveryUpdate {
+	if i != nil {
+		wdu.SetWebhookID(*i)
+	}
+	return wdu
+}
+
+// AddWebhookID adds i to the "webhook_id" field.
+func (wdu *WebhookDeliveryUpdate) AddWebhookID(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.AddWebhookID(i)
+	return wdu
+}
+
+// SetEvent sets the "event" field.
+func (wdu *WebhookDeliveryUpdate) SetEvent(s string) *WebhookDeliveryUpdate {
+	wdu.mutation.SetEvent(s)
+	return wdu
+}
+
+// SetNillableEvent sets the "event" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableEvent(s *string) *WebhookDeliveryUpdate {
+	if s != nil {
+		wdu.SetEvent(*s)
+	}
+	return wdu
+}
+
+// SetDeliveryID sets the "delivery_id" field.
+func (wdu *WebhookDeliveryUpdate) SetDeliveryID(u uuid.UUID) *WebhookDeliveryUpdate {
+	wdu.mutation.SetDeliveryID(u)
+	return wdu
+}
+
+// SetNillableDeliveryID sets the "delivery_id" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableDeliveryID(u *uuid.UUID) *WebhookDeliveryUpdate {
+	if u != nil {
+		wdu.SetDeliveryID(*u)
+	}
+	return wdu
+}
+
+// SetPayload sets the "payload" field.
+func (wdu *WebhookDeliveryUpdate) SetPayload(s string) *WebhookDeliveryUpdate {
+	wdu.mutation.SetPayload(s)
+	return wdu
+}
+
+// SetNillablePayload sets the "payload" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillablePayload(s *string) *WebhookDeliveryUpdate {
+	if s != nil {
+		wdu.SetPayload(*s)
+	}
+	return wdu
+}
+
+// SetStatusCode sets the "status_code" field.
+func (wdu *WebhookDeliveryUpdate) SetStatusCode(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.ResetStatusCode()
+	wdu.mutation.SetStatusCode(i)
+	return wdu
+}
+
+// SetNillableStatusCode sets the "status_code" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableStatusCode(i *int) *WebhookDeliveryUpdate {
+	if i != nil {
+		wdu.SetStatusCode(*i)
+	}
+	return wdu
+}
+
+// AddStatusCode adds i to the "status_code" field.
+func (wdu *WebhookDeliveryUpdate) AddStatusCode(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.AddStatusCode(i)
+	return wdu
+}
+
+// SetError sets the "error" field.
+func (wdu *WebhookDeliveryUpdate) SetError(s string) *WebhookDeliveryUpdate {
+	wdu.mutation.SetError(s)
+	return wdu
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableError(s *string) *WebhookDeliveryUpdate {
+	if s != nil {
+		wdu.SetError(*s)
+	}
+	return wdu
+}
+
+// ClearError clears the value of the "error" field.
+func (wdu *WebhookDeliveryUpdate) ClearError() *WebhookDeliveryUpdate {
+	wdu.mutation.ClearError()
+	return wdu
+}
+
+// SetAttempt sets the "attempt" field.
+func (wdu *WebhookDeliveryUpdate) SetAttempt(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.ResetAttempt()
+	wdu.mutation.SetAttempt(i)
+	return wdu
+}
+
+// SetNillableAttempt sets the "attempt" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableAttempt(i *int) *WebhookDeliveryUpdate {
+	if i != nil {
+		wdu.SetAttempt(*i)
+	}
+	return wdu
+}
+
+// AddAttempt adds i to the "attempt" field.
+func (wdu *WebhookDeliveryUpdate) AddAttempt(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.AddAttempt(i)
+	return wdu
+}
+
+// SetDuration sets the "duration" field.
+func (wdu *WebhookDeliveryUpdate) SetDuration(i int64) *WebhookDeliveryUpdate {
+	wdu.mutation.ResetDuration()
+	wdu.mutation.SetDuration(i)
+	return wdu
+}
+
+// SetNillableDuration sets the "duration" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableDuration(i *int64) *WebhookDeliveryUpdate {
+	if i != nil {
+		wdu.SetDuration(*i)
+	}
+	return wdu
+}
+
+// AddDuration adds i to the "duration" field.
+func (wdu *WebhookDeliveryUpdate) AddDuration(i int64) *WebhookDeliveryUpdate {
+	wdu.mutation.AddDuration(i)
+	return wdu
+}
+
+// Mutation returns the WebhookDeliveryMutation object of the builder.
+func (wdu *WebhookDeliveryUpdate) Mutation() *WebhookDeliveryMutation {
+	return wdu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wdu *WebhookDeliveryUpdate) Save(ctx context.Context) (int, error) {
+	if err := wdu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, wdu.sqlSave, wdu.mutation, wdu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wdu *WebhookDeliveryUpdate) SaveX(ctx context.Context) int {
+	affected, err := wdu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wdu *WebhookDeliveryUpdate) Exec(ctx context.Context) error {
+	_, err := wdu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdu *WebhookDeliveryUpdate) ExecX(ctx context.Context) {
+	if err := wdu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wdu *WebhookDeliveryUpdate) defaults() error {
+	if _, ok := wdu.mutation.UpdatedAt(); !ok {
+		if webhookdelivery.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized webhookdelivery.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := webhookdelivery.UpdateDefaultUpdatedAt()
+		wdu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+func (wdu *WebhookDeliveryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(webhookdelivery.Table, webhookdelivery.Columns, sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt))
+	if ps := wdu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wdu.mutation.UpdatedAt(); ok {
+		_spec.SetField(webhookdelivery.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := wdu.mutation.DeletedAt(); ok {
+		_spec.SetField(webhookdelivery.FieldDeletedAt, field.TypeTime, value)
+	}
+	if wdu.mutation.DeletedAtCleared() {
+		_spec.ClearField(webhookdelivery.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := wdu.mutation.WebhookID(); ok {
+		_spec.SetField(webhookdelivery.FieldWebhookID, field.TypeInt, value)
+	}
+	if value, ok := wdu.mutation.AddedWebhookID(); ok {
+		_spec.AddField(webhookdelivery.FieldWebhookID, field.TypeInt, value)
+	}
+	if value, ok := wdu.mutation.Event(); ok {
+		_spec.SetField(webhookdelivery.FieldEvent, field.TypeString, value)
+	}
+	if value, ok := wdu.mutation.DeliveryID(); ok {
+		_spec.SetField(webhookdelivery.FieldDeliveryID, field.TypeUUID, value)
+	}
+	if value, ok := wdu.mutation.Payload(); ok {
+		_spec.SetField(webhookdelivery.FieldPayload, field.TypeString, value)
+	}
+	if value, ok := wdu.mutation.StatusCode(); ok {
+		_spec.SetField(webhookdelivery.FieldStatusCode, field.TypeInt, value)
+	}
+	if value, ok := wdu.mutation.AddedStatusCode(); ok {
+		_spec.AddField(webhookdelivery.FieldStatusCode, field.TypeInt, value)
+	}
+	if value, ok := wdu.mutation.Error(); ok {
+		_spec.SetField(webhookdelivery.FieldError, field.TypeString, value)
+	}
+	if wdu.mutation.ErrorCleared() {
+		_spec.ClearField(webhookdelivery.FieldError, field.TypeString)
+	}
+	if value, ok := wdu.mutation.Attempt(); ok {
+		_spec.SetField(webhookdelivery.FieldAttempt, field.TypeInt, value)
+	}
+	if value, ok := wdu.mutation.AddedAttempt(); ok {
+		_spec.AddField(webhookdelivery.FieldAttempt, field.TypeInt, value)
+	}
+	if value, ok := wdu.mutation.Duration(); ok {
+		_spec.SetField(webhookdelivery.FieldDuration, field.TypeInt64, value)
+	}
+	if value, ok := wdu.mutation.AddedDuration(); ok {
+		_spec.AddField(webhookdelivery.FieldDuration, field.TypeInt64, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wdu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wdu.mutation.done = true
+	return n, nil
+}
+
+// WebhookDeliveryUpdateOne is the builder for updating a single WebhookDelivery entity.
+type WebhookDeliveryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WebhookDeliveryMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wduo *WebhookDeliveryUpdateOne) SetUpdatedAt(t time.Time) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetUpdatedAt(t)
+	return wduo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (wduo *WebhookDeliveryUpdateOne) SetDeletedAt(t time.Time) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetDeletedAt(t)
+	return wduo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableDeletedAt(t *time.Time) *WebhookDeliveryUpdateOne {
+	if t != nil {
+		wduo.SetDeletedAt(*t)
+	}
+	return wduo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (wduo *WebhookDeliveryUpdateOne) ClearDeletedAt() *WebhookDeliveryUpdateOne {
+	wduo.mutation.ClearDeletedAt()
+	return wduo
+}
+
+// SetWebhookID sets the "webhook_id" field.
+func (wduo *WebhookDeliveryUpdateOne) SetWebhookID(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.ResetWebhookID()
+	wduo.mutation.SetWebhookID(i)
+	return wduo
+}
+
+// SetNillableWebhookID sets the "webhook_id" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableWebhookID(i *int) *WebhookDeliveryUpdateOne {
+	if i != nil {
+		wduo.SetWebhookID(*i)
+	}
+	return wduo
+}
+
+// AddWebhookID adds i to the "webhook_id" field.
+func (wduo *WebhookDeliveryUpdateOne) AddWebhookID(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.AddWebhookID(i)
+	return wduo
+}
+
+// SetEvent sets the "event" field.
+func (wduo *WebhookDeliveryUpdateOne) SetEvent(s string) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetEvent(s)
+	return wduo
+}
+
+// SetNillableEvent sets the "event" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableEvent(s *string) *WebhookDeliveryUpdateOne {
+	if s != nil {
+		wduo.SetEvent(*s)
+	}
+	return wduo
+}
+
+// SetDeliveryID sets the "delivery_id" field.
+func (wduo *WebhookDeliveryUpdateOne) SetDeliveryID(u uuid.UUID) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetDeliveryID(u)
+	return wduo
+}
+
+// SetNillableDeliveryID sets the "delivery_id" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableDeliveryID(u *uuid.UUID) *WebhookDeliveryUpdateOne {
+	if u != nil {
+		wduo.SetDeliveryID(*u)
+	}
+	return wduo
+}
+
+// SetPayload sets the "payload" field.
+func (wduo *WebhookDeliveryUpdateOne) SetPayload(s string) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetPayload(s)
+	return wduo
+}
+
+// SetNillablePayload sets the "payload" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillablePayload(s *string) *WebhookDeliveryUpdateOne {
+	if s != nil {
+		wduo.SetPayload(*s)
+	}
+	return wduo
+}
+
+// SetStatusCode sets the "status_code" field.
+func (wduo *WebhookDeliveryUpdateOne) SetStatusCode(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.ResetStatusCode()
+	wduo.mutation.SetStatusCode(i)
+	return wduo
+}
+
+// SetNillableStatusCode sets the "status_code" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableStatusCode(i *int) *WebhookDeliveryUpdateOne {
+	if i != nil {
+		wduo.SetStatusCode(*i)
+	}
+	return wduo
+}
+
+// AddStatusCode adds i to the "status_code" field.
+func (wduo *WebhookDeliveryUpdateOne) AddStatusCode(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.AddStatusCode(i)
+	return wduo
+}
+
+// SetError sets the "error" field.
+func (wduo *WebhookDeliveryUpdateOne) SetError(s string) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetError(s)
+	return wduo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableError(s *string) *WebhookDeliveryUpdateOne {
+	if s != nil {
+		wduo.SetError(*s)
+	}
+	return wduo
+}
+
+// ClearError clears the value of the "error" field.
+func (wduo *WebhookDeliveryUpdateOne) ClearError() *WebhookDeliveryUpdateOne {
+	wduo.mutation.ClearError()
+	return wduo
+}
+
+// SetAttempt sets the "attempt" field.
+func (wduo *WebhookDeliveryUpdateOne) SetAttempt(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.ResetAttempt()
+	wduo.mutation.SetAttempt(i)
+	return wduo
+}
+
+// SetNillableAttempt sets the "attempt" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableAttempt(i *int) *WebhookDeliveryUpdateOne {
+	if i != nil {
+		wduo.SetAttempt(*i)
+	}
+	return wduo
+}
+
+// AddAttempt adds i to the "attempt" field.
+func (wduo *WebhookDeliveryUpdateOne) AddAttempt(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.AddAttempt(i)
+	return wduo
+}
+
+// SetDuration sets the "duration" field.
+func (wduo *WebhookDeliveryUpdateOne) SetDuration(i int64) *WebhookDeliveryUpdateOne {
+	wduo.mutation.ResetDuration()
+	wduo.mutation.SetDuration(i)
+	return wduo
+}
+
+// SetNillableDuration sets the "duration" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableDuration(i *int64) *WebhookDeliveryUpdateOne {
+	if i != nil {
+		wduo.SetDuration(*i)
+	}
+	return wduo
+}
+
+// AddDuration adds i to the "duration" field.
+func (wduo *WebhookDeliveryUpdateOne) AddDuration(i int64) *WebhookDeliveryUpdateOne {
+	wduo.mutation.AddDuration(i)
+	return wduo
+}
+
+// Mutation returns the WebhookDeliveryMutation object of the builder.
+func (wduo *WebhookDeliveryUpdateOne) Mutation() *WebhookDeliveryMutation {
+	return wduo.mutation
+}
+
+// Where appends a list predicates to the WebhookDeliveryUpdate builder.
+func (wduo *WebhookDeliveryUpdateOne) Where(ps ...predicate.WebhookDelivery) *WebhookDeliveryUpdateOne {
+	wduo.mutation.Where(ps...)
+	return wduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wduo *WebhookDeliveryUpdateOne) Select(field string, fields ...string) *WebhookDeliveryUpdateOne {
+	wduo.fields = append([]string{field}, fields...)
+	return wduo
+}
+
+// Save executes the query and returns the updated WebhookDelivery entity.
+func (wduo *WebhookDeliveryUpdateOne) Save(ctx context.Context) (*WebhookDelivery, error) {
+	if err := wduo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, wduo.sqlSave, wduo.mutation, wduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wduo *WebhookDeliveryUpdateOne) SaveX(ctx context.Context) *WebhookDelivery {
+	node, err := wduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wduo *WebhookDeliveryUpdateOne) Exec(ctx context.Context) error {
+	_, err := wduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wduo *WebhookDeliveryUpdateOne) ExecX(ctx context.Context) {
+	if err := wduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wduo *WebhookDeliveryUpdateOne) defaults() error {
+	if _, ok := wduo.mutation.UpdatedAt(); !ok {
+		if webhookdelivery.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized webhookdelivery.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := webhookdelivery.UpdateDefaultUpdatedAt()
+		wduo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+func (wduo *WebhookDeliveryUpdateOne) sqlSave(ctx context.Context) (_node *WebhookDelivery, err error) {
+	_spec := sqlgraph.NewUpdateSpec(webhookdelivery.Table, webhookdelivery.Columns, sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt))
+	id, ok := wduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WebhookDelivery.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webhookdelivery.FieldID)
+		for _, f := range fields {
+			if !webhookdelivery.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != webhookdelivery.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wduo.mutation.UpdatedAt(); ok {
+		_spec.SetField(webhookdelivery.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := wduo.mutation.DeletedAt(); ok {
+		_spec.SetField(webhookdelivery.FieldDeletedAt, field.TypeTime, value)
+	}
+	if wduo.mutation.DeletedAtCleared() {
+		_spec.ClearField(webhookdelivery.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := wduo.mutation.WebhookID(); ok {
+		_spec.SetField(webhookdelivery.FieldWebhookID, field.TypeInt, value)
+	}
+	if value, ok := wduo.mutation.AddedWebhookID(); ok {
+		_spec.AddField(webhookdelivery.FieldWebhookID, field.TypeInt, value)
+	}
+	if value, ok := wduo.mutation.Event(); ok {
+		_spec.SetField(webhookdelivery.FieldEvent, field.TypeString, value)
+	}
+	if value, ok := wduo.mutation.DeliveryID(); ok {
+		_spec.SetField(webhookdelivery.FieldDeliveryID, field.TypeUUID, value)
+	}
+	if value, ok := wduo.mutation.Payload(); ok {
+		_spec.SetField(webhookdelivery.FieldPayload, field.TypeString, value)
+	}
+	if value, ok := wduo.mutation.StatusCode(); ok {
+		_spec.SetField(webhookdelivery.FieldStatusCode, field.TypeInt, value)
+	}
+	if value, ok := wduo.mutation.AddedStatusCode(); ok {
+		_spec.AddField(webhookdelivery.FieldStatusCode, field.TypeInt, value)
+	}
+	if value, ok := wduo.mutation.Error(); ok {
+		_spec.SetField(webhookdelivery.FieldError, field.TypeString, value)
+	}
+	if wduo.mutation.ErrorCleared() {
+		_spec.ClearField(webhookdelivery.FieldError, field.TypeString)
+	}
+	if value, ok := wduo.mutation.Attempt(); ok {
+		_spec.SetField(webhookdelivery.FieldAttempt, field.TypeInt, value)
+	}
+	if value, ok := wduo.mutation.AddedAttempt(); ok {
+		_spec.AddField(webhookdelivery.FieldAttempt, field.TypeInt, value)
+	}
+	if value, ok := wduo.mutation.Duration(); ok {
+		_spec.SetField(webhookdelivery.FieldDuration, field.TypeInt64, value)
+	}
+	if value, ok := wduo.mutation.AddedDuration(); ok {
+		_spec.AddField(webhookdelivery.FieldDuration, field.TypeInt64, value)
+	}
+	_node = &WebhookDelivery{config: wduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wduo.mutation.done = true
+	return _node, nil
+}
@@ -2,7 +2,10 @@ package inventory
 
 import (
 	"context"
+	stdsql "database/sql"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -14,6 +17,7 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
 	"github.com/cloudreve/Cloudreve/v4/ent/schema"
 	"github.com/cloudreve/Cloudreve/v4/ent/share"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
 	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
 	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
@@ -28,6 +32,11 @@ const (
 	MaxMetadataLen = 65535
 )
 
+var (
+	ErrEntityNotMergeable = fmt.Errorf("target entity is not mergeable")
+	ErrEntityChanged      = fmt.Errorf("entity is changed or deleted during operation")
+)
+
 type (
 	// Ctx keys for eager loading options.
 	LoadFileEntity          struct{}
@@ -40,6 +49,14 @@ type (
 	LoadEntityStoragePolicy struct{}
 	LoadEntityFile          struct{}
 
+	// FileCountLimitCtx is the ctx key of *FileCountLimit enforced when new files are created for its user.
+	FileCountLimitCtx struct{}
+	FileCountLimit    struct {
+		UserID int
+		// Max number of files the user can own, 0 indicates no limit.
+		Max int
+	}
+
 	// Parameters for file list
 	ListFileParameters struct {
 		*PaginationArgs
@@ -92,6 +109,15 @@ type (
 		StoragePolicyID int
 	}
 
+	// PolicyEntityFilter narrows down entities listed by ListPolicyEntities.
+	PolicyEntityFilter struct {
+		CreatedBefore *time.Time `json:"created_before,omitempty"`
+		MinSize       int64      `json:"min_size,omitempty"`
+		MaxSize       int64      `json:"max_size,omitempty"`
+		// Exts matches entities referenced by at least one file with given extensions.
+		Exts []string `json:"exts,omitempty"`
+	}
+
 	ListEntityResult struct {
 		Entities []*ent.Entity
 		*PaginationResults
@@ -133,6 +159,13 @@ type (
 		EncryptMetadata *types.EncryptMetadata
 	}
 
+	// PolicyEntityStat is the number and total size of entities in a storage policy.
+	PolicyEntityStat struct {
+		StoragePolicyID int   `json:"storage_policy_entities"`
+		Sum             int64 `json:"sum"`
+		Count           int   `json:"count"`
+	}
+
 	RelocateEntityParameter struct {
 		Entity                   *ent.Entity
 		NewSource                string
@@ -220,6 +253,10 @@ type FileClient interface {
 	CountEntityByTimeRange(ctx context.Context, start, end *time.Time) (int, error)
 	// CountEntityByStoragePolicyID counts entities by storage policy ID
 	CountEntityByStoragePolicyID(ctx context.Context, storagePolicyID int) (int, int, error)
+	// CountEntityGroupByStoragePolicy counts entities and their total size of each storage policy.
+	CountEntityGroupByStoragePolicy(ctx context.Context) ([]PolicyEntityStat, error)
+	// CountUploadingEntities counts entities with an upload session in progress.
+	CountUploadingEntities(ctx context.Context) (int, error)
 	// IsStoragePolicyUsedByEntities checks if a storage policy is used by entities
 	IsStoragePolicyUsedByEntities(ctx context.Context, policyID int) (bool, error)
 	// DeleteByUser deletes all files by a given user
@@ -236,6 +273,35 @@ type FileClient interface {
 	UpdateModifiedAt(ctx context.Context, file *ent.File, modifiedAt time.Time) error
 	// DeleteAllMetadataByName deletes all metadata by a given name
 	DeleteAllMetadataByName(ctx context.Context, name string) error
+	// SetEntityChecksum sets the content checksum of a given entity
+	SetEntityChecksum(ctx context.Context, entityID int, checksum string) error
+	// FindEntityByChecksum returns the oldest alive version entity with the same checksum and size within
+	// given storage policy, excluding entity with ID `excluded`.
+	FindEntityByChecksum(ctx context.Context, policyID int, size int64, checksum string, excluded int) (*ent.Entity, error)
+	// MergeEntity relinks all files referencing `src` to `dst`, leaving `src` as a stale entity.
+	// Returns ErrEntityNotMergeable if `dst` is no longer referenced by any file.
+	MergeEntity(ctx context.Context, src, dst *ent.Entity) (StorageDiff, error)
+	// FindOwnedEntityByChecksum is similar to FindEntityByChecksum, but only returns entity referenced by
+	// files owned by given user.
+	FindOwnedEntityByChecksum(ctx context.Context, ownerID, policyID int, size int64, checksum string) (*ent.Entity, error)
+	// LinkEntity links an existing entity to a file, returns storage diff for file owner.
+	// Returns ErrEntityNotMergeable if entity is no longer referenced by any file.
+	LinkEntity(ctx context.Context, file *ent.File, e *ent.Entity) (StorageDiff, error)
+	// CountPolicyEntities counts alive entities in given storage policy, filter is optional.
+	CountPolicyEntities(ctx context.Context, policyID int, filter *PolicyEntityFilter) (int, error)
+	// ListPolicyEntities lists alive entities in given storage policy, returning up to limit entities
+	// with ID strictly greater than afterID. Use afterID=0 to start from the beginning. filter is optional.
+	ListPolicyEntities(ctx context.Context, policyID, afterID, limit int, filter *PolicyEntityFilter) ([]*ent.Entity, error)
+	// ListFoldersByPath returns folders located at given path elements relative to root folder, of all users
+	// owning files stored in given storage policy.
+	ListFoldersByPath(ctx context.Context, policyID int, elements []string) ([]*ent.File, error)
+	// UpdateEntityProps updates props of an entity
+	UpdateEntityProps(ctx context.Context, entityID int, props *types.EntityProps) error
+	// MigrateEntity points an entity to a new storage policy and blob source, also updates storage policy of files
+	// using it as primary entity. Returns ErrEntityChanged if entity is no longer in its original policy.
+	MigrateEntity(ctx context.Context, e *ent.Entity, policyID int, source string) error
+	// IsEntitySourceShared returns true if any other entity in the same storage policy points to the same blob.
+	IsEntitySourceShared(ctx context.Context, e *ent.Entity) (bool, error)
 }
 
 func NewFileClient(client *ent.Client, dbType conf.DBType, hasher hashid.Encoder) FileClient {
@@ -377,6 +443,21 @@ func (f *fileClient) CountEntityByStoragePolicyID(ctx context.Context, storagePo
 	return v[0].Count, v[0].Sum, nil
 }
 
+func (f *fileClient) CountEntityGroupByStoragePolicy(ctx context.Context) ([]PolicyEntityStat, error) {
+	var v []PolicyEntityStat
+	err := f.client.Entity.Query().
+		GroupBy(entity.FieldStoragePolicyEntities).
+		Aggregate(
+			ent.Sum(entity.FieldSize),
+			ent.Count(),
+		).Scan(ctx, &v)
+	return v, err
+}
+
+func (f *fileClient) CountUploadingEntities(ctx context.Context) (int, error) {
+	return f.client.Entity.Query().Where(entity.UploadSessionIDNotNil()).Count(ctx)
+}
+
 func (f *fileClient) CreateDirectLink(ctx context.Context, file int, name string, speed int, reuse bool) (*ent.DirectLink, error) {
 	if reuse {
 		// Find existed
@@ -519,7 +600,10 @@ func (f *fileClient) Delete(ctx context.Context, files []*ent.File, options *typ
 	entities := make(map[int]int)
 	// storageReduced stores the relation between owner ID and storage reduced.
 	storageReduced := make(map[int]int64)
+	// fileReduced stores the relation between owner ID and number of files deleted.
+	fileReduced := make(map[int]int)
 	for _, fi := range files {
+		fileReduced[fi.OwnerID]--
 		fileEntities, err := fi.Edges.EntitiesOrErr()
 		if err != nil {
 			return nil, nil, err
@@ -602,6 +686,11 @@ func (f *fileClient) Delete(ctx context.Context, files []*ent.File, options *typ
 		}
 	}
 
+	// 6. Update file count of owners.
+	if err := f.addFileCount(ctx, fileReduced); err != nil {
+		return nil, nil, err
+	}
+
 	return toBeRecycled, storageReduced, nil
 }
 
@@ -697,7 +786,12 @@ func (f *fileClient) Copy(ctx context.Context, args *CopyParameter) (map[int][]*
 		}
 	}
 
-	return newDstMap, map[int]int64{dstMap[files[0].FileChildren][0].OwnerID: sizeDiff}, nil
+	dstOwner := dstMap[files[0].FileChildren][0].OwnerID
+	if err := f.addFileCount(ctx, map[int]int{dstOwner: len(files)}); err != nil {
+		return nil, nil, err
+	}
+
+	return newDstMap, map[int]int64{dstOwner: sizeDiff}, nil
 }
 
 func (f *fileClient) UpdateModifiedAt(ctx context.Context, file *ent.File, modifiedAt time.Time) error {
@@ -815,6 +909,10 @@ func (f *fileClient) CreateFile(ctx context.Context, root *ent.File, args *Creat
 		return nil, nil, nil, fmt.Errorf("failed to create file: %v", err)
 	}
 
+	if err := f.addFileCount(ctx, map[int]int{root.OwnerID: 1}); err != nil {
+		return nil, nil, nil, err
+	}
+
 	// Create default primary file entity if needed
 	var storageDiff StorageDiff
 	if args.EntityParameters != nil {
@@ -981,6 +1079,280 @@ func (f *fileClient) CreateEntity(ctx context.Context, file *ent.File, args *Ent
 	return created, diff, nil
 }
 
+func (f *fileClient) SetEntityChecksum(ctx context.Context, entityID int, checksum string) error {
+	if err := f.client.Entity.UpdateOneID(entityID).SetChecksum(checksum).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to set entity checksum: %w", err)
+	}
+
+	return nil
+}
+
+func (f *fileClient) FindEntityByChecksum(ctx context.Context, policyID int, size int64, checksum string, excluded int) (*ent.Entity, error) {
+	return f.entityByChecksumQuery(policyID, size, checksum).
+		Where(entity.IDNEQ(excluded)).
+		First(ctx)
+}
+
+func (f *fileClient) FindOwnedEntityByChecksum(ctx context.Context, ownerID, policyID int, size int64, checksum string) (*ent.Entity, error) {
+	return f.entityByChecksumQuery(policyID, size, checksum).
+		Where(entity.HasFileWith(file.OwnerID(ownerID))).
+		First(ctx)
+}
+
+func (f *fileClient) entityByChecksumQuery(policyID int, size int64, checksum string) *ent.EntityQuery {
+	return f.client.Entity.Query().
+		Where(
+			entity.StoragePolicyEntities(policyID),
+			entity.Checksum(checksum),
+			entity.Size(size),
+			entity.Type(int(types.EntityTypeVersion)),
+			entity.ReferenceCountGT(0),
+			entity.UploadSessionIDIsNil(),
+		).
+		Order(ent.Asc(entity.FieldID))
+}
+
+func (f *fileClient) LinkEntity(ctx context.Context, file *ent.File, e *ent.Entity) (StorageDiff, error) {
+	affected, err := f.client.Entity.Update().
+		Where(entity.ID(e.ID), entity.ReferenceCountGT(0)).
+		AddReferenceCount(1).
+		AddFile(file).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to link file to entity %d: %w", e.ID, err)
+	}
+
+	if affected == 0 {
+		return nil, ErrEntityNotMergeable
+	}
+
+	return map[int]int64{file.OwnerID: e.Size}, nil
+}
+
+func (f *fileClient) MergeEntity(ctx context.Context, src, dst *ent.Entity) (StorageDiff, error) {
+	files, err := f.client.Entity.QueryFile(src).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query files of entity %d: %w", src.ID, err)
+	}
+
+	diff := make(StorageDiff)
+	if len(files) == 0 {
+		return diff, nil
+	}
+
+	// Files that already link to dst (e.g. same content uploaded as another version) only need to drop src.
+	linked, err := f.client.Entity.QueryFile(dst).
+		Where(file.IDIn(lo.Map(files, func(item *ent.File, index int) int {
+			return item.ID
+		})...)).
+		IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query files of entity %d: %w", dst.ID, err)
+	}
+
+	toBeLinked := lo.Filter(files, func(item *ent.File, index int) bool {
+		if lo.Contains(linked, item.ID) {
+			diff[item.OwnerID] -= src.Size
+			return false
+		}
+
+		return true
+	})
+
+	if len(toBeLinked) > 0 {
+		// Make sure dst is still alive before linking new files to it.
+		affected, err := f.client.Entity.Update().
+			Where(entity.ID(dst.ID), entity.ReferenceCountGT(0)).
+			AddReferenceCount(len(toBeLinked)).
+			AddFile(toBeLinked...).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to link files to entity %d: %w", dst.ID, err)
+		}
+
+		if affected == 0 {
+			return nil, ErrEntityNotMergeable
+		}
+	}
+
+	stm := f.client.Entity.UpdateOne(src).
+		ClearFile().
+		AddReferenceCount(-1 * len(files))
+	if src.Source == dst.Source {
+		// Both entities point to the same physical object, recycling src must not delete it.
+		props := &types.EntityProps{}
+		if src.Props != nil {
+			*props = *src.Props
+		}
+		props.UnlinkOnly = true
+		stm.SetProps(props)
+	}
+
+	if err := stm.Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to unlink files from entity %d: %w", src.ID, err)
+	}
+
+	if err := f.client.File.Update().
+		Where(file.PrimaryEntity(src.ID)).
+		SetPrimaryEntity(dst.ID).
+		Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to update primary entity of files: %w", err)
+	}
+
+	return diff, nil
+}
+
+func (f *fileClient) CountPolicyEntities(ctx context.Context, policyID int, filter *PolicyEntityFilter) (int, error) {
+	return f.policyEntitiesQuery(policyID, filter).Count(ctx)
+}
+
+func (f *fileClient) ListPolicyEntities(ctx context.Context, policyID, afterID, limit int, filter *PolicyEntityFilter) ([]*ent.Entity, error) {
+	q := f.policyEntitiesQuery(policyID, filter)
+	if afterID > 0 {
+		q = q.Where(entity.IDGT(afterID))
+	}
+	return q.Limit(limit).All(ctx)
+}
+
+func (f *fileClient) policyEntitiesQuery(policyID int, filter *PolicyEntityFilter) *ent.EntityQuery {
+	q := f.client.Entity.Query().Where(
+		entity.StoragePolicyEntities(policyID),
+		entity.ReferenceCountGT(0),
+		entity.UploadSessionIDIsNil(),
+	).Order(entity.ByID())
+
+	if filter == nil {
+		return q
+	}
+
+	if filter.CreatedBefore != nil {
+		q = q.Where(entity.CreatedAtLT(*filter.CreatedBefore))
+	}
+
+	if filter.MinSize > 0 {
+		q = q.Where(entity.SizeGTE(filter.MinSize))
+	}
+
+	if filter.MaxSize > 0 {
+		q = q.Where(entity.SizeLTE(filter.MaxSize))
+	}
+
+	if len(filter.Exts) > 0 {
+		q = q.Where(entity.HasFileWith(file.Or(lo.Map(filter.Exts, func(ext string, index int) predicate.File {
+			pattern := "%." + strings.ToLower(ext)
+			return func(s *sql.Selector) {
+				s.Where(sql.Like(sql.Lower(s.C(file.FieldName)), pattern))
+			}
+		})...)))
+	}
+
+	return q
+}
+
+// addFileCount adds file count diff to given users.
+func (f *fileClient) addFileCount(ctx context.Context, diff map[int]int) error {
+	for uid, count := range diff {
+		if count == 0 {
+			continue
+		}
+
+		stm := f.client.User.Update().Where(user.ID(uid)).AddFileCount(count)
+		if count > 0 {
+			limit, ok := ctx.Value(FileCountLimitCtx{}).(*FileCountLimit)
+			if !ok || limit.UserID != uid || limit.Max <= 0 {
+				if err := stm.Exec(ctx); err != nil {
+					return fmt.Errorf("failed to update file count of user %d: %w", uid, err)
+				}
+				continue
+			}
+
+			// file_count + count <= max  <=>  file_count <= max - count.
+			affected, err := stm.Where(user.FileCountLTE(limit.Max - count)).Save(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to update file count of user %d: %w", uid, err)
+			}
+			if affected == 0 {
+				return ErrInsufficientFileCount
+			}
+			continue
+		}
+
+		// Count might be drifted or not calibrated yet, it should never go below 0.
+		affected, err := stm.Where(user.FileCountGTE(-count)).Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to update file count of user %d: %w", uid, err)
+		}
+
+		if affected == 0 {
+			if err := f.client.User.Update().Where(user.ID(uid)).SetFileCount(0).Exec(ctx); err != nil {
+				return fmt.Errorf("failed to reset file count of user %d: %w", uid, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (f *fileClient) ListFoldersByPath(ctx context.Context, policyID int, elements []string) ([]*ent.File, error) {
+	q := f.client.File.Query().Where(
+		file.Not(file.HasParent()),
+		file.Name(RootFolderName),
+		file.HasOwnerWith(user.HasFilesWith(file.HasEntitiesWith(entity.StoragePolicyEntities(policyID)))),
+	)
+	for _, elem := range elements {
+		q = q.QueryChildren().Where(file.Name(elem), file.Type(int(types.FileTypeFolder)))
+	}
+
+	return q.All(ctx)
+}
+
+func (f *fileClient) UpdateEntityProps(ctx context.Context, entityID int, props *types.EntityProps) error {
+	if err := f.client.Entity.UpdateOneID(entityID).SetProps(props).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to update entity props: %w", err)
+	}
+
+	return nil
+}
+
+func (f *fileClient) MigrateEntity(ctx context.Context, e *ent.Entity, policyID int, source string) error {
+	affected, err := f.client.Entity.Update().
+		Where(
+			entity.ID(e.ID),
+			entity.StoragePolicyEntities(e.StoragePolicyEntities),
+			entity.Source(e.Source),
+			entity.ReferenceCountGT(0),
+		).
+		SetStoragePolicyEntities(policyID).
+		SetSource(source).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to migrate entity %d: %w", e.ID, err)
+	}
+
+	if affected == 0 {
+		return ErrEntityChanged
+	}
+
+	if err := f.client.File.Update().
+		Where(file.PrimaryEntity(e.ID), file.StoragePolicyFiles(e.StoragePolicyEntities)).
+		SetStoragePolicyFiles(policyID).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("failed to update storage policy of files: %w", err)
+	}
+
+	return nil
+}
+
+func (f *fileClient) IsEntitySourceShared(ctx context.Context, e *ent.Entity) (bool, error) {
+	return f.client.Entity.Query().
+		Where(
+			entity.IDNEQ(e.ID),
+			entity.StoragePolicyEntities(e.StoragePolicyEntities),
+			entity.Source(e.Source),
+		).
+		Exist(ctx)
+}
+
 func (f *fileClient) SetParent(ctx context.Context, files []*ent.File, parent *ent.File) error {
 	groups, _ := f.batchInCondition(intsets.MaxInt, 10, 1, lo.Map(files, func(file *ent.File, index int) int {
 		return file.ID
@@ -1062,24 +1434,59 @@ func (f *fileClient) Root(ctx context.Context, user *ent.User) (*ent.File, error
 }
 
 func (f *fileClient) CreateFolder(ctx context.Context, root *ent.File, args *CreateFolderParameters) (*ent.File, error) {
-	stm := f.client.File.
-		Create().
-		SetOwnerID(args.Owner).
-		SetType(int(types.FileTypeFolder)).
-		SetIsSymbolic(args.IsSymbolic).
-		SetName(args.Name)
-	if root != nil {
-		stm.SetParent(root).SetType(int(types.FileTypeFolder))
-	}
+	var (
+		newFolder *ent.File
+		inserted  = true
+		err       error
+	)
+	if root == nil {
+		newFolder, err = f.client.File.
+			Create().
+			SetOwnerID(args.Owner).
+			SetType(int(types.FileTypeFolder)).
+			SetIsSymbolic(args.IsSymbolic).
+			SetName(args.Name).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create folder: %w", err)
+		}
+	} else {
+		// A folder with the same name might be created by concurrent requests, it is only inserted and counted once.
+		// MySQL returns ID of the existing row instead of an error on conflict, so existence is checked first.
+		inserted, err = f.client.File.Query().Where(file.FileChildren(root.ID), file.Name(args.Name)).Exist(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check existing folder: %w", err)
+		}
 
-	fid, err := stm.OnConflict(sql.ConflictColumns(file.FieldFileChildren, file.FieldName)).Ignore().ID(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create folder: %w", err)
+		inserted = !inserted
+		if inserted {
+			err = f.client.File.
+				Create().
+				SetOwnerID(args.Owner).
+				SetType(int(types.FileTypeFolder)).
+				SetIsSymbolic(args.IsSymbolic).
+				SetName(args.Name).
+				SetFileChildren(root.ID).
+				OnConflict(sql.ConflictColumns(file.FieldFileChildren, file.FieldName), sql.DoNothing()).
+				Exec(ctx)
+			if errors.Is(err, stdsql.ErrNoRows) {
+				inserted, err = false, nil
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to create folder: %w", err)
+			}
+		}
+
+		newFolder, err = f.client.File.Query().Where(file.FileChildren(root.ID), file.Name(args.Name)).First(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get folder: %w", err)
+		}
 	}
 
-	newFolder, err := f.client.File.Get(ctx, fid)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get folder: %w", err)
+	if inserted {
+		if err := f.addFileCount(ctx, map[int]int{args.Owner: 1}); err != nil {
+			return nil, err
+		}
 	}
 
 	if len(args.Metadata) > 0 {
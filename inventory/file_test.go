@@ -0,0 +1,183 @@
+package inventory_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/application/dependency/deptest"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newEntity(t *testing.T, dep dependency.Dep, source string, size int64, files ...*ent.File) *ent.Entity {
+	e, err := dep.DBClient().Entity.Create().
+		SetType(int(types.EntityTypeVersion)).
+		SetSource(source).
+		SetSize(size).
+		SetStoragePolicyEntities(1).
+		SetReferenceCount(len(files)).
+		AddFile(files...).
+		Save(context.Background())
+	require.NoError(t, err)
+	return e
+}
+
+func newFile(t *testing.T, dep dependency.Dep, owner *ent.User, name string) *ent.File {
+	f, err := dep.DBClient().File.Create().
+		SetType(int(types.FileTypeFile)).
+		SetName(name).
+		SetOwnerID(owner.ID).
+		Save(context.Background())
+	require.NoError(t, err)
+	return f
+}
+
+func setPrimaryEntity(t *testing.T, dep dependency.Dep, f *ent.File, e *ent.Entity) {
+	require.NoError(t, dep.DBClient().File.UpdateOne(f).SetPrimaryEntity(e.ID).Exec(context.Background()))
+}
+
+func TestMergeEntity(t *testing.T) {
+	a := assert.New(t)
+	dep := deptest.New(t)
+	ctx := context.Background()
+	u1 := deptest.NewUser(t, dep, "u1@cloudreve.org", 2)
+	u2 := deptest.NewUser(t, dep, "u2@cloudreve.org", 2)
+
+	// f1 -> dst; f2 -> src; f3 has both src and dst as versions, with src as current version.
+	f1, f2, f3 := newFile(t, dep, u1, "f1"), newFile(t, dep, u2, "f2"), newFile(t, dep, u2, "f3")
+	dst := newEntity(t, dep, "dst", 10, f1, f3)
+	src := newEntity(t, dep, "src", 10, f2, f3)
+	setPrimaryEntity(t, dep, f1, dst)
+	setPrimaryEntity(t, dep, f2, src)
+	setPrimaryEntity(t, dep, f3, src)
+
+	diff, err := dep.FileClient().MergeEntity(ctx, src, dst)
+	require.NoError(t, err)
+	a.Equal(inventory.StorageDiff{u2.ID: -10}, diff, "f3 no longer holds two copies of the same content")
+
+	dst = dep.DBClient().Entity.GetX(ctx, dst.ID)
+	a.Equal(3, dst.ReferenceCount)
+	a.ElementsMatch([]int{f1.ID, f2.ID, f3.ID}, dep.DBClient().Entity.QueryFile(dst).IDsX(ctx))
+
+	src = dep.DBClient().Entity.GetX(ctx, src.ID)
+	a.Equal(0, src.ReferenceCount)
+	a.Empty(dep.DBClient().Entity.QueryFile(src).IDsX(ctx))
+	a.True(src.Props == nil || !src.Props.UnlinkOnly, "src blob is stored separately and should be deleted on recycle")
+
+	a.Equal(dst.ID, dep.DBClient().File.GetX(ctx, f2.ID).PrimaryEntity)
+	a.Equal(dst.ID, dep.DBClient().File.GetX(ctx, f3.ID).PrimaryEntity)
+}
+
+func TestMergeEntitySameSource(t *testing.T) {
+	a := assert.New(t)
+	dep := deptest.New(t)
+	ctx := context.Background()
+	u := deptest.NewUser(t, dep, "u@cloudreve.org", 2)
+
+	f1, f2 := newFile(t, dep, u, "f1"), newFile(t, dep, u, "f2")
+	dst := newEntity(t, dep, "blob", 10, f1)
+	src := newEntity(t, dep, "blob", 10, f2)
+
+	diff, err := dep.FileClient().MergeEntity(ctx, src, dst)
+	require.NoError(t, err)
+	a.Empty(diff)
+
+	src = dep.DBClient().Entity.GetX(ctx, src.ID)
+	require.NotNil(t, src.Props)
+	a.True(src.Props.UnlinkOnly, "recycling src must not delete blob shared with dst")
+}
+
+func TestMergeEntityNotMergeable(t *testing.T) {
+	a := assert.New(t)
+	dep := deptest.New(t)
+	ctx := context.Background()
+	u := deptest.NewUser(t, dep, "u@cloudreve.org", 2)
+
+	f := newFile(t, dep, u, "f")
+	dst := newEntity(t, dep, "dst", 10)
+	src := newEntity(t, dep, "src", 10, f)
+
+	_, err := dep.FileClient().MergeEntity(ctx, src, dst)
+	a.ErrorIs(err, inventory.ErrEntityNotMergeable)
+	a.Equal(1, dep.DBClient().Entity.GetX(ctx, src.ID).ReferenceCount)
+}
+
+func TestMigrateEntity(t *testing.T) {
+	a := assert.New(t)
+	dep := deptest.New(t)
+	ctx := context.Background()
+	u := deptest.NewUser(t, dep, "u@cloudreve.org", 2)
+	dstPolicy, err := dep.DBClient().StoragePolicy.Create().
+		SetName("dst").
+		SetType(types.PolicyTypeLocal).
+		Save(ctx)
+	require.NoError(t, err)
+
+	f1, f2 := newFile(t, dep, u, "f1"), newFile(t, dep, u, "f2")
+	require.NoError(t, dep.DBClient().File.Update().SetStoragePolicyFiles(1).Exec(ctx))
+	e1 := newEntity(t, dep, "uploads/shared", 10, f1)
+	e2 := newEntity(t, dep, "uploads/shared", 10, f2)
+	setPrimaryEntity(t, dep, f1, e1)
+
+	shared, err := dep.FileClient().IsEntitySourceShared(ctx, e1)
+	require.NoError(t, err)
+	a.True(shared)
+
+	require.NoError(t, dep.FileClient().MigrateEntity(ctx, e1, dstPolicy.ID, "data/shared"))
+	migrated := dep.DBClient().Entity.GetX(ctx, e1.ID)
+	a.Equal(dstPolicy.ID, migrated.StoragePolicyEntities)
+	a.Equal("data/shared", migrated.Source)
+	a.Equal(dstPolicy.ID, dep.DBClient().File.GetX(ctx, f1.ID).StoragePolicyFiles)
+	a.Equal(1, dep.DBClient().File.GetX(ctx, f2.ID).StoragePolicyFiles)
+
+	a.ErrorIs(dep.FileClient().MigrateEntity(ctx, e1, dstPolicy.ID, "data/shared"), inventory.ErrEntityChanged)
+
+	shared, err = dep.FileClient().IsEntitySourceShared(ctx, e2)
+	require.NoError(t, err)
+	a.False(shared, "e1 no longer uses the blob in source policy")
+}
+
+func TestCreateFolderCountedOnce(t *testing.T) {
+	a := assert.New(t)
+	dep := deptest.New(t)
+	ctx := context.Background()
+	u := deptest.NewUser(t, dep, "u@cloudreve.org", 2)
+	root, err := dep.FileClient().CreateFolder(ctx, nil, &inventory.CreateFolderParameters{Owner: u.ID, Name: inventory.RootFolderName})
+	require.NoError(t, err)
+	a.Equal(1, dep.DBClient().User.GetX(ctx, u.ID).FileCount)
+
+	args := &inventory.CreateFolderParameters{Owner: u.ID, Name: "dir"}
+	first, err := dep.FileClient().CreateFolder(ctx, root, args)
+	require.NoError(t, err)
+	second, err := dep.FileClient().CreateFolder(ctx, root, args)
+	require.NoError(t, err)
+
+	a.Equal(first.ID, second.ID)
+	a.Equal(2, dep.DBClient().User.GetX(ctx, u.ID).FileCount)
+}
+
+func TestCreateFolderFileCountLimit(t *testing.T) {
+	a := assert.New(t)
+	dep := deptest.New(t)
+	ctx := context.Background()
+	u := deptest.NewUser(t, dep, "u@cloudreve.org", 2)
+	root, err := dep.FileClient().CreateFolder(ctx, nil, &inventory.CreateFolderParameters{Owner: u.ID, Name: inventory.RootFolderName})
+	require.NoError(t, err)
+
+	limited := context.WithValue(ctx, inventory.FileCountLimitCtx{}, &inventory.FileCountLimit{UserID: u.ID, Max: 2})
+	_, err = dep.FileClient().CreateFolder(limited, root, &inventory.CreateFolderParameters{Owner: u.ID, Name: "a"})
+	require.NoError(t, err)
+	_, err = dep.FileClient().CreateFolder(limited, root, &inventory.CreateFolderParameters{Owner: u.ID, Name: "b"})
+	a.ErrorIs(err, inventory.ErrInsufficientFileCount)
+	a.Equal(2, dep.DBClient().User.GetX(ctx, u.ID).FileCount)
+
+	// Limit of other users is not applied.
+	other := context.WithValue(ctx, inventory.FileCountLimitCtx{}, &inventory.FileCountLimit{UserID: u.ID + 1, Max: 2})
+	_, err = dep.FileClient().CreateFolder(other, root, &inventory.CreateFolderParameters{Owner: u.ID, Name: "c"})
+	require.NoError(t, err)
+	a.Equal(3, dep.DBClient().User.GetX(ctx, u.ID).FileCount)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/Masterminds/semver/v3"
 	"github.com/cloudreve/Cloudreve/v4/application/constants"
 	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/file"
 	"github.com/cloudreve/Cloudreve/v4/ent/group"
 	"github.com/cloudreve/Cloudreve/v4/ent/node"
 	"github.com/cloudreve/Cloudreve/v4/ent/oauthclient"
@@ -577,6 +578,31 @@ var patches = []Patch{
 				return fmt.Errorf("failed to update secret_key setting: %w", err)
 			}
 
+			return nil
+		},
+	},
+	{
+		Name:       "calibrate_user_file_count",
+		EndVersion: "4.15.0",
+		Func: func(l logging.Logger, client *ent.Client, ctx context.Context) error {
+			// File count is not tracked for users created in previous versions.
+			var counts []struct {
+				OwnerID int `json:"owner_id"`
+				Count   int `json:"count"`
+			}
+			if err := client.File.Query().
+				GroupBy(file.FieldOwnerID).
+				Aggregate(ent.Count()).
+				Scan(ctx, &counts); err != nil {
+				return fmt.Errorf("failed to count files of users: %w", err)
+			}
+
+			for _, c := range counts {
+				if err := client.User.UpdateOneID(c.OwnerID).SetFileCount(c.Count).Exec(ctx); err != nil {
+					return fmt.Errorf("failed to update file count of user %d: %w", c.OwnerID, err)
+				}
+			}
+
 			return nil
 		},
 	},
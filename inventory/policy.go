@@ -125,7 +125,8 @@ func (c *storagePolicyClient) Upsert(ctx context.Context, policy *ent.StoragePol
 		SetSettings(policy.Settings).
 		SetNillableNodeID(nodeId)
 
-	if policy.Type != types.PolicyTypeOd {
+	// Refresh token of OAuth based policies is only updated through OAuth flow
+	if policy.Type != types.PolicyTypeOd && policy.Type != types.PolicyTypeGoogleDrive {
 		updateQuery.SetAccessKey(policy.AccessKey)
 	}
 
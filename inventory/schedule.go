@@ -0,0 +1,225 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/schedule"
+	"github.com/cloudreve/Cloudreve/v4/ent/schedulerun"
+	"github.com/cloudreve/Cloudreve/v4/ent/schema"
+	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
+)
+
+type (
+	ScheduleClient interface {
+		TxOperator
+		// GetByID returns the schedule with given id.
+		GetByID(ctx context.Context, id int) (*ent.Schedule, error)
+		// List lists schedules owned by given user.
+		List(ctx context.Context, ownerID int) ([]*ent.Schedule, error)
+		// Count returns number of schedules owned by given user.
+		Count(ctx context.Context, ownerID int) (int, error)
+		// ListDue lists enabled schedules whose next run time is before given time.
+		ListDue(ctx context.Context, now time.Time, limit int) ([]*ent.Schedule, error)
+		// Upsert creates or updates a schedule.
+		Upsert(ctx context.Context, s *ent.Schedule) (*ent.Schedule, error)
+		// Claim moves next run time of the schedule from its loaded value to `next`, returns false if
+		// the schedule is already claimed by another instance.
+		Claim(ctx context.Context, s *ent.Schedule, now, next time.Time) (bool, error)
+		// Delete deletes schedule by id, along with its run history.
+		Delete(ctx context.Context, id int) error
+		// DeleteByOwner deletes all schedules owned by given user.
+		DeleteByOwner(ctx context.Context, ownerID int) error
+		// LogRun records a run of the schedule and keeps at most `retention` latest records.
+		LogRun(ctx context.Context, args *ScheduleRunArgs, retention int) error
+		// ListRuns lists run history of a schedule, newest first.
+		ListRuns(ctx context.Context, args *ListScheduleRunArgs) (*ListScheduleRunResult, error)
+	}
+
+	ScheduleRunArgs struct {
+		ScheduleID int
+		TaskID     int
+		Error      string
+	}
+
+	ListScheduleRunArgs struct {
+		*PaginationArgs
+		ScheduleID int
+	}
+	ListScheduleRunResult struct {
+		*PaginationResults
+		Runs []*ent.ScheduleRun
+	}
+)
+
+func NewScheduleClient(client *ent.Client, dbType conf.DBType) ScheduleClient {
+	return &scheduleClient{client: client, maxSQlParam: sqlParamLimit(dbType)}
+}
+
+type scheduleClient struct {
+	maxSQlParam int
+	client      *ent.Client
+}
+
+func (c *scheduleClient) SetClient(newClient *ent.Client) TxOperator {
+	return &scheduleClient{client: newClient, maxSQlParam: c.maxSQlParam}
+}
+
+func (c *scheduleClient) GetClient() *ent.Client {
+	return c.client
+}
+
+func (c *scheduleClient) GetByID(ctx context.Context, id int) (*ent.Schedule, error) {
+	return c.client.Schedule.Get(ctx, id)
+}
+
+func (c *scheduleClient) List(ctx context.Context, ownerID int) ([]*ent.Schedule, error) {
+	return c.client.Schedule.Query().
+		Where(schedule.OwnerID(ownerID)).
+		Order(ent.Asc(schedule.FieldID)).
+		All(ctx)
+}
+
+func (c *scheduleClient) Count(ctx context.Context, ownerID int) (int, error) {
+	return c.client.Schedule.Query().Where(schedule.OwnerID(ownerID)).Count(ctx)
+}
+
+func (c *scheduleClient) ListDue(ctx context.Context, now time.Time, limit int) ([]*ent.Schedule, error) {
+	return c.client.Schedule.Query().
+		Where(schedule.Enabled(true), schedule.NextRunAtLTE(now)).
+		Order(ent.Asc(schedule.FieldNextRunAt)).
+		Limit(capPageSize(c.maxSQlParam, limit, 10)).
+		All(ctx)
+}
+
+func (c *scheduleClient) Upsert(ctx context.Context, s *ent.Schedule) (*ent.Schedule, error) {
+	if s.ID == 0 {
+		stm := c.client.Schedule.Create().
+			SetName(s.Name).
+			SetType(s.Type).
+			SetSpec(s.Spec).
+			SetProps(s.Props).
+			SetEnabled(s.Enabled).
+			SetOwnerID(s.OwnerID)
+		if s.NextRunAt != nil {
+			stm.SetNextRunAt(*s.NextRunAt)
+		}
+
+		return stm.Save(ctx)
+	}
+
+	stm := c.client.Schedule.UpdateOneID(s.ID).
+		SetName(s.Name).
+		SetType(s.Type).
+		SetSpec(s.Spec).
+		SetProps(s.Props).
+		SetEnabled(s.Enabled)
+	if s.NextRunAt != nil {
+		stm.SetNextRunAt(*s.NextRunAt)
+	} else {
+		stm.ClearNextRunAt()
+	}
+
+	return stm.Save(ctx)
+}
+
+func (c *scheduleClient) Claim(ctx context.Context, s *ent.Schedule, now, next time.Time) (bool, error) {
+	if s.NextRunAt == nil {
+		return false, nil
+	}
+
+	affected, err := c.client.Schedule.Update().
+		Where(schedule.ID(s.ID), schedule.Enabled(true), schedule.NextRunAt(*s.NextRunAt)).
+		SetNextRunAt(next).
+		SetLastRunAt(now).
+		Save(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to claim schedule: %w", err)
+	}
+
+	return affected > 0, nil
+}
+
+func (c *scheduleClient) Delete(ctx context.Context, id int) error {
+	ctx = schema.SkipSoftDelete(ctx)
+	if _, err := c.client.ScheduleRun.Delete().Where(schedulerun.ScheduleID(id)).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete run history: %w", err)
+	}
+
+	return c.client.Schedule.DeleteOneID(id).Exec(ctx)
+}
+
+func (c *scheduleClient) DeleteByOwner(ctx context.Context, ownerID int) error {
+	schedules, err := c.client.Schedule.Query().Where(schedule.OwnerID(ownerID)).IDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range schedules {
+		if err := c.Delete(ctx, id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *scheduleClient) LogRun(ctx context.Context, args *ScheduleRunArgs, retention int) error {
+	stm := c.client.ScheduleRun.Create().
+		SetScheduleID(args.ScheduleID).
+		SetError(args.Error)
+	if args.TaskID > 0 {
+		stm.SetTaskID(args.TaskID)
+	}
+
+	if err := stm.Exec(ctx); err != nil {
+		return err
+	}
+
+	if retention <= 0 {
+		return nil
+	}
+
+	// Remove records beyond retention.
+	expired, err := c.client.ScheduleRun.Query().
+		Where(schedulerun.ScheduleID(args.ScheduleID)).
+		Order(ent.Desc(schedulerun.FieldID)).
+		Offset(retention).
+		Limit(c.maxSQlParam).
+		IDs(ctx)
+	if err != nil || len(expired) == 0 {
+		return err
+	}
+
+	_, err = c.client.ScheduleRun.Delete().Where(schedulerun.IDIn(expired...)).Exec(schema.SkipSoftDelete(ctx))
+	return err
+}
+
+func (c *scheduleClient) ListRuns(ctx context.Context, args *ListScheduleRunArgs) (*ListScheduleRunResult, error) {
+	query := c.client.ScheduleRun.Query().Where(schedulerun.ScheduleID(args.ScheduleID))
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count run history: %w", err)
+	}
+
+	pageSize := capPageSize(c.maxSQlParam, args.PageSize, 10)
+	runs, err := query.
+		Order(ent.Desc(schedulerun.FieldCreatedAt), ent.Desc(schedulerun.FieldID)).
+		Limit(pageSize).
+		Offset(args.Page * pageSize).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list run history: %w", err)
+	}
+
+	return &ListScheduleRunResult{
+		Runs: runs,
+		PaginationResults: &PaginationResults{
+			PageSize:   pageSize,
+			TotalItems: total,
+			Page:       args.Page,
+		},
+	}, nil
+}
@@ -0,0 +1,89 @@
+package inventory_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency/deptest"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/schedule"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduleClaim(t *testing.T) {
+	a := assert.New(t)
+	dep := deptest.New(t)
+	ctx := context.Background()
+	owner := deptest.NewUser(t, dep, "owner@cloudreve.org", 1)
+	sc := dep.ScheduleClient()
+
+	// Run times are computed by cron expressions, in whole seconds.
+	now := time.Now().Truncate(time.Second)
+	newSchedule := func(name string, enabled bool, next time.Time) *ent.Schedule {
+		s, err := sc.Upsert(ctx, &ent.Schedule{
+			Name:      name,
+			Type:      schedule.TypeArchive,
+			Spec:      "0 * * * *",
+			Props:     &types.ScheduleProps{},
+			Enabled:   enabled,
+			OwnerID:   owner.ID,
+			NextRunAt: &next,
+		})
+		require.NoError(t, err)
+		return s
+	}
+	due := newSchedule("due", true, now.Add(-time.Minute))
+	newSchedule("later", true, now.Add(time.Hour))
+	newSchedule("disabled", false, now.Add(-time.Minute))
+
+	// Two instances loading due schedules at the same time.
+	first, err := sc.ListDue(ctx, now, 10)
+	require.NoError(t, err)
+	second, err := sc.ListDue(ctx, now, 10)
+	require.NoError(t, err)
+	a.Equal([]int{due.ID}, lo.Map(first, func(s *ent.Schedule, _ int) int { return s.ID }))
+
+	next := now.Add(time.Hour)
+	claimed, err := sc.Claim(ctx, first[0], now, next)
+	require.NoError(t, err)
+	a.True(claimed)
+	claimed, err = sc.Claim(ctx, second[0], now, next)
+	require.NoError(t, err)
+	a.False(claimed, "schedule is only claimed once")
+
+	reloaded, err := sc.GetByID(ctx, due.ID)
+	require.NoError(t, err)
+	a.WithinDuration(next, *reloaded.NextRunAt, time.Second)
+	a.WithinDuration(now, *reloaded.LastRunAt, time.Second)
+	remaining, err := sc.ListDue(ctx, now, 10)
+	require.NoError(t, err)
+	a.Empty(remaining)
+}
+
+func TestScheduleLogRun(t *testing.T) {
+	a := assert.New(t)
+	dep := deptest.New(t)
+	ctx := context.Background()
+	owner := deptest.NewUser(t, dep, "owner@cloudreve.org", 1)
+	sc := dep.ScheduleClient()
+
+	s, err := sc.Upsert(ctx, &ent.Schedule{Name: "s", Type: schedule.TypeArchive, Spec: "0 * * * *", OwnerID: owner.ID})
+	require.NoError(t, err)
+	for _, msg := range []string{"1", "2", "3"} {
+		require.NoError(t, sc.LogRun(ctx, &inventory.ScheduleRunArgs{ScheduleID: s.ID, Error: msg}, 2))
+	}
+
+	// Only the latest runs within retention are kept.
+	res, err := sc.ListRuns(ctx, &inventory.ListScheduleRunArgs{PaginationArgs: &inventory.PaginationArgs{PageSize: 10}, ScheduleID: s.ID})
+	require.NoError(t, err)
+	a.Equal(2, res.TotalItems)
+	a.Equal([]string{"3", "2"}, lo.Map(res.Runs, func(r *ent.ScheduleRun, _ int) string { return r.Error }))
+
+	require.NoError(t, sc.Delete(ctx, s.ID))
+	a.Zero(dep.DBClient().ScheduleRun.Query().CountX(ctx), "run history is deleted along with schedule")
+}
@@ -512,6 +512,10 @@ var DefaultSettings = map[string]string{
 	"archive_timeout":                            `600`,
 	"upload_session_timeout":                     `86400`,
 	"slave_api_timeout":                          `60`,
+	"node_health_check_interval":                 `30`,
+	"node_health_check_timeout":                  `10`,
+	"node_health_check_max_failures":             `3`,
+	"node_failover_grace_period":                 `300`,
 	"folder_props_timeout":                       `300`,
 	"chunk_retries":                              `5`,
 	"use_temp_chunk_buffer":                      `1`,
@@ -532,6 +536,13 @@ var DefaultSettings = map[string]string{
 	"cron_entity_collect":                        "@every 15m",
 	"cron_trash_bin_collect":                     "@every 33m",
 	"cron_oauth_cred_refresh":                    "@every 230h",
+	"cron_lifecycle":                             "@daily",
+	"cron_share_access_log_collect":              "@daily",
+	"share_access_log_retention":                 "90",
+	"cron_user_schedule":                         "@every 1m",
+	"schedule_min_interval":                      "3600",
+	"schedule_max_per_user":                      "20",
+	"schedule_run_retention":                     "50",
 	"authn_enabled":                              "1",
 	"captcha_type":                               "normal",
 	"captcha_height":                             "60",
@@ -619,12 +630,20 @@ var DefaultSettings = map[string]string{
 	"queue_io_intense_backoff_max_duration":      "600",
 	"queue_io_intense_max_retry":                 "5",
 	"queue_io_intense_retry_delay":               "0",
+	"queue_io_intense_fair_scheduling":           "1",
 	"queue_remote_download_worker_num":           "5",
 	"queue_remote_download_max_execution":        "864000",
 	"queue_remote_download_backoff_factor":       "2",
 	"queue_remote_download_backoff_max_duration": "600",
 	"queue_remote_download_max_retry":            "5",
 	"queue_remote_download_retry_delay":          "0",
+	"queue_remote_download_fair_scheduling":      "1",
+	"queue_webhook_worker_num":                   "10",
+	"queue_webhook_max_execution":                "60",
+	"queue_webhook_backoff_factor":               "4",
+	"queue_webhook_backoff_max_duration":         "3600",
+	"queue_webhook_max_retry":                    "8",
+	"queue_webhook_retry_delay":                  "0",
 	"entity_url_default_ttl":                     "3600",
 	"entity_url_cache_margin":                    "600",
 	"media_meta":                                 "1",
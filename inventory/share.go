@@ -28,6 +28,7 @@ var (
 	ErrShareLinkExpired  = fmt.Errorf("share link expired")
 	ErrOwnerInactive     = fmt.Errorf("owner is inactive")
 	ErrSourceFileInvalid = fmt.Errorf("source file is deleted")
+	ErrShareUploadsFull  = fmt.Errorf("share upload limit reached")
 )
 
 type (
@@ -47,6 +48,11 @@ type (
 		Viewed(ctx context.Context, share *ent.Share) error
 		// Downloaded increase the download count of the share.
 		Downloaded(ctx context.Context, share *ent.Share) error
+		// ReserveUpload increases the upload count of the share only if it is less than max (0 for no
+		// limit), returns ErrShareUploadsFull otherwise.
+		ReserveUpload(ctx context.Context, share *ent.Share, max int) error
+		// ReleaseUpload decreases the upload count of the share, used to undo ReserveUpload.
+		ReleaseUpload(ctx context.Context, share *ent.Share) error
 		// Delete deletes the share.
 		Delete(ctx context.Context, shareId int) error
 		// DeleteBatch deletes the shares with the given ids.
@@ -57,6 +63,12 @@ type (
 		List(ctx context.Context, args *ListShareArgs) (*ListShareResult, error)
 		// CountByTimeRange counts the number of shares created in the given time range.
 		CountByTimeRange(ctx context.Context, start, end *time.Time) (int, error)
+		// LogAccess records a visit to the share.
+		LogAccess(ctx context.Context, args *ShareAccessArgs) error
+		// ListAccesses returns access records with the given args, newest first.
+		ListAccesses(ctx context.Context, args *ListShareAccessArgs) (*ListShareAccessResult, error)
+		// DeleteAccessesBefore deletes access records created before given time.
+		DeleteAccessesBefore(ctx context.Context, before time.Time) (int, error)
 	}
 
 	CreateShareParams struct {
@@ -224,6 +236,28 @@ func (c *shareClient) Downloaded(ctx context.Context, share *ent.Share) error {
 	return err
 }
 
+func (c *shareClient) ReserveUpload(ctx context.Context, s *ent.Share, max int) error {
+	q := c.client.Share.Update().Where(share.ID(s.ID))
+	if max > 0 {
+		q = q.Where(share.UploadsLT(max))
+	}
+
+	affected, err := q.AddUploads(1).Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return ErrShareUploadsFull
+	}
+
+	return nil
+}
+
+func (c *shareClient) ReleaseUpload(ctx context.Context, s *ent.Share) error {
+	return c.client.Share.Update().Where(share.ID(s.ID), share.UploadsGT(0)).AddUploads(-1).Exec(ctx)
+}
+
 func IsValidShare(share *ent.Share) error {
 	// Check if share is expired
 	if err := IsShareExpired(share); err != nil {
@@ -0,0 +1,109 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/schema"
+	"github.com/cloudreve/Cloudreve/v4/ent/shareaccess"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth/requestinfo"
+)
+
+type (
+	ShareAccessArgs struct {
+		ShareID int
+		// UserID is the visitor, 0 for anonymous visitors.
+		UserID    int
+		Action    shareaccess.Action
+		IP        string
+		UserAgent string
+		// Path is the accessed path relative to the share root.
+		Path string
+	}
+
+	ListShareAccessArgs struct {
+		*PaginationArgs
+		ShareID int
+		UserID  int
+	}
+	ListShareAccessResult struct {
+		*PaginationResults
+		Accesses []*ent.ShareAccess
+	}
+)
+
+// NewShareAccessArgs builds the access record of given share for current request.
+func NewShareAccessArgs(ctx context.Context, shareID int, u *ent.User, action shareaccess.Action, path string) *ShareAccessArgs {
+	args := &ShareAccessArgs{
+		ShareID: shareID,
+		Action:  action,
+		Path:    path,
+	}
+
+	if u != nil && !IsAnonymousUser(u) {
+		args.UserID = u.ID
+	}
+
+	if info := requestinfo.RequestInfoFromContext(ctx); info != nil {
+		args.IP = info.IP
+		args.UserAgent = info.UserAgent
+	}
+
+	return args
+}
+
+func (c *shareClient) LogAccess(ctx context.Context, args *ShareAccessArgs) error {
+	stm := c.client.ShareAccess.Create().
+		SetShareID(args.ShareID).
+		SetAction(args.Action).
+		SetIP(args.IP).
+		SetUserAgent(args.UserAgent).
+		SetPath(args.Path)
+	if args.UserID > 0 {
+		stm.SetUserID(args.UserID)
+	}
+
+	return stm.Exec(ctx)
+}
+
+func (c *shareClient) ListAccesses(ctx context.Context, args *ListShareAccessArgs) (*ListShareAccessResult, error) {
+	query := c.client.ShareAccess.Query()
+	if args.ShareID > 0 {
+		query.Where(shareaccess.ShareID(args.ShareID))
+	}
+
+	if args.UserID > 0 {
+		query.Where(shareaccess.UserID(args.UserID))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count access records: %w", err)
+	}
+
+	pageSize := capPageSize(c.maxSQlParam, args.PageSize, 10)
+	accesses, err := query.
+		Order(ent.Desc(shareaccess.FieldCreatedAt), ent.Desc(shareaccess.FieldID)).
+		Limit(pageSize).
+		Offset(args.Page * pageSize).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list access records: %w", err)
+	}
+
+	return &ListShareAccessResult{
+		Accesses: accesses,
+		PaginationResults: &PaginationResults{
+			PageSize:   pageSize,
+			TotalItems: total,
+			Page:       args.Page,
+		},
+	}, nil
+}
+
+func (c *shareClient) DeleteAccessesBefore(ctx context.Context, before time.Time) (int, error) {
+	ctx = schema.SkipSoftDelete(ctx)
+	return c.client.ShareAccess.Delete().Where(shareaccess.CreatedAtLT(before)).Exec(ctx)
+}
@@ -7,6 +7,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
 	"github.com/cloudreve/Cloudreve/v4/ent/task"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
 	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
@@ -26,6 +27,9 @@ type (
 		PrivateState  string
 		OwnerID       int
 		CorrelationID uuid.UUID
+		// LeaseOwner claims the new task for given instance until LeaseExpireAt.
+		LeaseOwner    string
+		LeaseExpireAt time.Time
 	}
 )
 
@@ -37,6 +41,15 @@ type TaskClient interface {
 	Update(ctx context.Context, task *ent.Task, args *TaskArgs) (*ent.Task, error)
 	// GetPendingTasks returns all pending tasks of given type.
 	GetPendingTasks(ctx context.Context, taskType ...string) ([]*ent.Task, error)
+	// GetClaimableTasks returns pending tasks of given type that are not leased, leased by given
+	// owner, or whose lease has expired.
+	GetClaimableTasks(ctx context.Context, owner string, now time.Time, taskType ...string) ([]*ent.Task, error)
+	// GetLapsedTaskIDs returns IDs of pending tasks of given type that are not leased or whose lease has expired.
+	GetLapsedTaskIDs(ctx context.Context, now time.Time, taskType ...string) ([]int, error)
+	// ClaimTask leases the task to given owner if it is claimable, returns false if someone else holds it.
+	ClaimTask(ctx context.Context, taskID int, owner string, now, until time.Time) (bool, error)
+	// RenewLeases extends leases of given tasks held by owner, returns IDs of tasks no longer leased by owner.
+	RenewLeases(ctx context.Context, owner string, until time.Time, taskIDs ...int) ([]int, error)
 	// GetTaskByID returns the task with the given ID.
 	GetTaskByID(ctx context.Context, taskID int) (*ent.Task, error)
 	// SetCompleteByID sets the task with the given ID to complete.
@@ -109,6 +122,10 @@ func (c *taskClient) New(ctx context.Context, task *TaskArgs) (*ent.Task, error)
 		stm.SetCorrelationID(task.CorrelationID)
 	}
 
+	if task.LeaseOwner != "" {
+		stm.SetLeaseOwner(task.LeaseOwner).SetLeaseExpireAt(task.LeaseExpireAt)
+	}
+
 	newTask, err := stm.Save(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create task: %w", err)
@@ -163,10 +180,74 @@ func (c *taskClient) Update(ctx context.Context, task *ent.Task, args *TaskArgs)
 }
 
 func (c *taskClient) GetPendingTasks(ctx context.Context, taskType ...string) ([]*ent.Task, error) {
-	tasks, err := withTaskEagerLoading(ctx, c.client.Task.Query()).
+	return c.getPendingTasks(ctx, withTaskEagerLoading(ctx, c.client.Task.Query()).
+		Where(task.StatusIn(task.StatusProcessing, task.StatusQueued, task.StatusSuspending)).
+		Where(task.TypeIn(taskType...)))
+}
+
+func (c *taskClient) GetClaimableTasks(ctx context.Context, owner string, now time.Time, taskType ...string) ([]*ent.Task, error) {
+	return c.getPendingTasks(ctx, withTaskEagerLoading(ctx, c.client.Task.Query()).
 		Where(task.StatusIn(task.StatusProcessing, task.StatusQueued, task.StatusSuspending)).
 		Where(task.TypeIn(taskType...)).
-		All(ctx)
+		Where(claimable(owner, now)))
+}
+
+func (c *taskClient) GetLapsedTaskIDs(ctx context.Context, now time.Time, taskType ...string) ([]int, error) {
+	return c.client.Task.Query().
+		Where(task.StatusIn(task.StatusProcessing, task.StatusQueued, task.StatusSuspending)).
+		Where(task.TypeIn(taskType...)).
+		Where(leaseLapsed(now)).
+		IDs(ctx)
+}
+
+func (c *taskClient) ClaimTask(ctx context.Context, taskID int, owner string, now, until time.Time) (bool, error) {
+	affected, err := c.client.Task.Update().
+		Where(task.ID(taskID), claimable(owner, now)).
+		SetLeaseOwner(owner).
+		SetLeaseExpireAt(until).
+		Save(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to claim task: %w", err)
+	}
+
+	return affected > 0, nil
+}
+
+func (c *taskClient) RenewLeases(ctx context.Context, owner string, until time.Time, taskIDs ...int) ([]int, error) {
+	if len(taskIDs) == 0 {
+		return nil, nil
+	}
+
+	renewed := make([]int, 0, len(taskIDs))
+	for _, chunk := range lo.Chunk(taskIDs, c.maxSQlParam) {
+		affected, err := c.client.Task.Update().
+			Where(task.IDIn(chunk...), task.LeaseOwner(owner)).
+			SetLeaseExpireAt(until).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to renew leases: %w", err)
+		}
+
+		if affected == len(chunk) {
+			renewed = append(renewed, chunk...)
+			continue
+		}
+
+		owned, err := c.client.Task.Query().
+			Where(task.IDIn(chunk...), task.LeaseOwner(owner)).
+			IDs(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query leased tasks: %w", err)
+		}
+		renewed = append(renewed, owned...)
+	}
+
+	lost, _ := lo.Difference(taskIDs, renewed)
+	return lost, nil
+}
+
+func (c *taskClient) getPendingTasks(ctx context.Context, query *ent.TaskQuery) ([]*ent.Task, error) {
+	tasks, err := query.All(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -338,3 +419,18 @@ func withTaskEagerLoading(ctx context.Context, q *ent.TaskQuery) *ent.TaskQuery
 
 	return q
 }
+
+// claimable matches tasks that are not leased, leased by owner, or whose lease has expired.
+func claimable(owner string, now time.Time) predicate.Task {
+	return task.Or(task.LeaseOwner(owner), leaseLapsed(now))
+}
+
+// leaseLapsed matches tasks that are not leased, or whose lease has expired.
+func leaseLapsed(now time.Time) predicate.Task {
+	return task.Or(
+		task.LeaseOwnerIsNil(),
+		task.LeaseOwner(""),
+		task.LeaseExpireAtIsNil(),
+		task.LeaseExpireAtLT(now),
+	)
+}
@@ -0,0 +1,75 @@
+package inventory_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency/deptest"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/task"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/gofrs/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskLease(t *testing.T) {
+	a := assert.New(t)
+	dep := deptest.New(t)
+	ctx := context.Background()
+	client := dep.TaskClient()
+	now := time.Now()
+	owner := deptest.NewUser(t, dep, "owner@cloudreve.org", 1)
+
+	newTask := func(status task.Status, leaseOwner string, expireAt time.Time) int {
+		created, err := client.New(ctx, &inventory.TaskArgs{
+			Status:        status,
+			Type:          "lease_test",
+			PublicState:   &types.TaskPublicState{},
+			OwnerID:       owner.ID,
+			CorrelationID: uuid.Must(uuid.NewV4()),
+			LeaseOwner:    leaseOwner,
+			LeaseExpireAt: expireAt,
+		})
+		require.NoError(t, err)
+		return created.ID
+	}
+
+	unleased := newTask(task.StatusQueued, "", time.Time{})
+	mine := newTask(task.StatusSuspending, "a", now.Add(time.Minute))
+	others := newTask(task.StatusProcessing, "b", now.Add(time.Minute))
+	expired := newTask(task.StatusQueued, "b", now.Add(-time.Second))
+	newTask(task.StatusCompleted, "", time.Time{})
+
+	claimable, err := client.GetClaimableTasks(ctx, "a", now, "lease_test")
+	require.NoError(t, err)
+	a.ElementsMatch([]int{unleased, mine, expired}, taskIDs(claimable))
+
+	lapsed, err := client.GetLapsedTaskIDs(ctx, now, "lease_test")
+	require.NoError(t, err)
+	a.ElementsMatch([]int{unleased, expired}, lapsed, "alive leases are excluded regardless of owner")
+
+	claimed, err := client.ClaimTask(ctx, others, "a", now, now.Add(time.Minute))
+	require.NoError(t, err)
+	a.False(claimed, "lease held by another instance")
+	claimed, err = client.ClaimTask(ctx, expired, "a", now, now.Add(time.Minute))
+	require.NoError(t, err)
+	a.True(claimed)
+	claimed, err = client.ClaimTask(ctx, expired, "b", now, now.Add(time.Minute))
+	require.NoError(t, err)
+	a.False(claimed, "claimed by a just now")
+
+	lost, err := client.RenewLeases(ctx, "a", now.Add(2*time.Minute), mine, expired, others)
+	require.NoError(t, err)
+	a.Equal([]int{others}, lost)
+	renewed, err := client.GetTaskByID(ctx, mine)
+	require.NoError(t, err)
+	a.WithinDuration(now.Add(2*time.Minute), *renewed.LeaseExpireAt, time.Second)
+}
+
+func taskIDs(tasks []*ent.Task) []int {
+	return lo.Map(tasks, func(item *ent.Task, _ int) int { return item.ID })
+}
@@ -0,0 +1,163 @@
+package inventory
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/schema"
+	"github.com/cloudreve/Cloudreve/v4/ent/team"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/samber/lo"
+)
+
+type (
+	TeamClient interface {
+		TxOperator
+		// GetByID get team by id.
+		GetByID(ctx context.Context, id int) (*ent.Team, error)
+		// List lists all teams.
+		List(ctx context.Context) ([]*ent.Team, error)
+		// ListByMember lists teams that given user is a member of.
+		ListByMember(ctx context.Context, u *ent.User) ([]*ent.Team, error)
+		// Upsert creates or updates a team.
+		Upsert(ctx context.Context, t *ent.Team) (*ent.Team, error)
+		// Delete deletes team by id, the team account is not deleted.
+		Delete(ctx context.Context, id int) error
+	}
+	// LoadTeamOwner loads the team account with its group.
+	LoadTeamOwner struct{}
+)
+
+func NewTeamClient(client *ent.Client, dbType conf.DBType, hasher hashid.Encoder) TeamClient {
+	return &teamClient{
+		client:      client,
+		hasher:      hasher,
+		maxSQlParam: sqlParamLimit(dbType),
+	}
+}
+
+type teamClient struct {
+	maxSQlParam int
+	client      *ent.Client
+	hasher      hashid.Encoder
+}
+
+func (c *teamClient) SetClient(newClient *ent.Client) TxOperator {
+	return &teamClient{client: newClient, hasher: c.hasher, maxSQlParam: c.maxSQlParam}
+}
+
+func (c *teamClient) GetClient() *ent.Client {
+	return c.client
+}
+
+func (c *teamClient) GetByID(ctx context.Context, id int) (*ent.Team, error) {
+	return withTeamEagerLoading(ctx, c.client.Team.Query().Where(team.ID(id))).First(ctx)
+}
+
+func (c *teamClient) List(ctx context.Context) ([]*ent.Team, error) {
+	return withTeamEagerLoading(ctx, c.client.Team.Query()).Order(ent.Asc(team.FieldID)).All(ctx)
+}
+
+func (c *teamClient) ListByMember(ctx context.Context, u *ent.User) ([]*ent.Team, error) {
+	if u == nil || IsAnonymousUser(u) {
+		return nil, nil
+	}
+
+	teams, err := withTeamEagerLoading(ctx, c.client.Team.Query().Where(func(s *sql.Selector) {
+		settings := s.C(team.FieldSettings)
+		preds := []*sql.Predicate{hasTeamMember(settings, u.ID)}
+		if u.GroupUsers > 0 {
+			preds = append(preds, sqljson.ValueEQ(settings, u.GroupUsers, sqljson.Path("group_id")))
+		}
+		s.Where(sql.Or(preds...))
+	})).Order(ent.Asc(team.FieldID)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Explicit member role takes precedence, which might revoke the role granted by group.
+	return lo.Filter(teams, func(t *ent.Team, _ int) bool {
+		return TeamRoleOf(t, u) != types.TeamRoleNone
+	}), nil
+}
+
+// hasTeamMember returns a predicate matching teams with given user in its members list.
+func hasTeamMember(column string, uid int) *sql.Predicate {
+	return sql.P(func(b *sql.Builder) {
+		if b.Dialect() == dialect.SQLite {
+			// JSON_EACH yields array elements as JSON objects, compare their user_id instead.
+			b.WriteString("EXISTS").Wrap(func(b *sql.Builder) {
+				b.WriteString("SELECT * FROM JSON_EACH").Wrap(func(b *sql.Builder) {
+					b.Ident(column).Comma().WriteString("'$.members'")
+				})
+				b.WriteString(" WHERE JSON_EXTRACT(").Ident("value").WriteString(", '$.user_id')").
+					WriteOp(sql.OpEQ).Arg(uid)
+			})
+			return
+		}
+
+		b.Join(sqljson.ValueContains(column, []map[string]int{{"user_id": uid}}, sqljson.Path("members")))
+	})
+}
+
+func (c *teamClient) Upsert(ctx context.Context, t *ent.Team) (*ent.Team, error) {
+	settings := t.Settings
+	if settings == nil {
+		settings = &types.TeamSetting{}
+	}
+
+	if t.ID == 0 {
+		return c.client.Team.Create().
+			SetName(t.Name).
+			SetSettings(settings).
+			SetOwnerID(t.OwnerID).
+			Save(ctx)
+	}
+
+	return c.client.Team.UpdateOneID(t.ID).
+		SetName(t.Name).
+		SetSettings(settings).
+		Save(ctx)
+}
+
+func (c *teamClient) Delete(ctx context.Context, id int) error {
+	ctx = schema.SkipSoftDelete(ctx)
+	_, err := c.client.Team.Delete().Where(team.ID(id)).Exec(ctx)
+	return err
+}
+
+// TeamRoleOf returns the role of given user in the team. Explicit member role takes precedence
+// over the role granted by group.
+func TeamRoleOf(t *ent.Team, u *ent.User) types.TeamRole {
+	if t.Settings == nil || u == nil || IsAnonymousUser(u) {
+		return types.TeamRoleNone
+	}
+
+	for _, m := range t.Settings.Members {
+		if m.UserID == u.ID {
+			return m.Role
+		}
+	}
+
+	if t.Settings.GroupID > 0 && t.Settings.GroupID == u.GroupUsers {
+		return t.Settings.GroupRole
+	}
+
+	return types.TeamRoleNone
+}
+
+func withTeamEagerLoading(ctx context.Context, q *ent.TeamQuery) *ent.TeamQuery {
+	if v, ok := ctx.Value(LoadTeamOwner{}).(bool); ok && v {
+		q.WithOwner(func(uq *ent.UserQuery) {
+			uq.WithGroup(func(gq *ent.GroupQuery) {
+				withGroupEagerLoading(ctx, gq)
+			})
+		})
+	}
+	return q
+}
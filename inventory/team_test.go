@@ -0,0 +1,55 @@
+package inventory_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency/deptest"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListTeamsByMember(t *testing.T) {
+	a := assert.New(t)
+	dep := deptest.New(t)
+	ctx := context.Background()
+	account := deptest.NewUser(t, dep, "team@cloudreve.org", 2)
+	member := deptest.NewUser(t, dep, "member@cloudreve.org", 2)
+	groupMember := deptest.NewUser(t, dep, "group@cloudreve.org", 3)
+	revoked := deptest.NewUser(t, dep, "revoked@cloudreve.org", 3)
+	outsider := deptest.NewUser(t, dep, "outsider@cloudreve.org", 2)
+
+	newTeam := func(name string, settings *types.TeamSetting) *ent.Team {
+		team, err := dep.TeamClient().Upsert(ctx, &ent.Team{Name: name, OwnerID: account.ID, Settings: settings})
+		require.NoError(t, err)
+		return team
+	}
+	byMember := newTeam("members", &types.TeamSetting{Members: []types.TeamMember{
+		{UserID: outsider.ID + 100, Role: types.TeamRoleWriter},
+		{UserID: member.ID, Role: types.TeamRoleReader},
+	}})
+	byGroup := newTeam("group", &types.TeamSetting{
+		GroupID:   3,
+		GroupRole: types.TeamRoleReader,
+		// Explicit member without role revokes access granted by group.
+		Members: []types.TeamMember{{UserID: revoked.ID, Role: types.TeamRoleNone}},
+	})
+	newTeam("empty", nil)
+
+	for _, tc := range []struct {
+		u        *ent.User
+		expected []int
+	}{
+		{member, []int{byMember.ID}},
+		{groupMember, []int{byGroup.ID}},
+		{revoked, []int{}},
+		{outsider, []int{}},
+	} {
+		teams, err := dep.TeamClient().ListByMember(ctx, tc.u)
+		require.NoError(t, err)
+		a.Equal(tc.expected, lo.Map(teams, func(t *ent.Team, _ int) int { return t.ID }), tc.u.Email)
+	}
+}
@@ -76,6 +76,21 @@ func (t *Tx) AppendStorageDiff(diff StorageDiff) {
 	}
 }
 
+// AfterCommit registers f to be called once the underlying transaction is committed. f is
+// never called if the transaction is rolled back.
+func (t *Tx) AfterCommit(f func()) {
+	t.tx.OnCommit(func(next ent.Committer) ent.Committer {
+		return ent.CommitFunc(func(ctx context.Context, tx *ent.Tx) error {
+			if err := next.Commit(ctx, tx); err != nil {
+				return err
+			}
+
+			f()
+			return nil
+		})
+	})
+}
+
 // WithTx wraps the given inventory client with a transaction.
 func WithTx[T TxOperator](ctx context.Context, c T) (T, *Tx, context.Context, error) {
 	var txClient *ent.Client
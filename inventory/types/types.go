@@ -36,6 +36,12 @@ type (
 		MaxWalkedFiles        int                    `json:"max_walked_files,omitempty"`
 		TrashRetention        int                    `json:"trash_retention,omitempty"`
 		RedirectedSource      bool                   `json:"redirected_source,omitempty"`
+		// MaxFileCount max number of files and folders a user can own, 0 indicates no limit.
+		MaxFileCount int `json:"max_file_count,omitempty"`
+		// MaxRunningTasks max number of tasks of users in this group running at the same time
+		// in one queue with fair scheduling, counted separately by each Cloudreve instance.
+		// 0 indicates no limit.
+		MaxRunningTasks int `json:"max_running_tasks,omitempty"`
 	}
 
 	// PolicySetting 非公有的存储策略属性
@@ -105,6 +111,49 @@ type (
 		ChunkConcurrency int `json:"chunk_concurrency,omitempty"`
 		// Whether to enable file encryption.
 		Encryption bool `json:"encryption,omitempty"`
+		// Deduplication whether to merge entities with identical content digest in this policy.
+		Deduplication bool `json:"deduplication,omitempty"`
+		// RapidUpload whether to allow clients to skip uploading content that already exists in this policy.
+		RapidUpload bool `json:"rapid_upload,omitempty"`
+		// LifecycleRules rules to tier or expire data in this policy, evaluated periodically.
+		LifecycleRules []LifecycleRule `json:"lifecycle_rules,omitempty"`
+		// HostKey SHA256 fingerprint of the SFTP server's host key, e.g. "SHA256:...". Required by
+		// SFTP policy, connections to servers presenting other host keys are refused.
+		HostKey string `json:"host_key,omitempty"`
+		// GoogleDriveID ID of the shared drive to store files in, user's My Drive is used if empty.
+		GoogleDriveID string `json:"gd_drive_id,omitempty"`
+		// PluginAddress address of the storage plugin, either `unix:///path/to/socket` or an HTTP URL.
+		PluginAddress string `json:"plugin_address,omitempty"`
+		// PluginConfig opaque config passed to the storage plugin as is.
+		PluginConfig string `json:"plugin_config,omitempty"`
+	}
+
+	LifecycleAction string
+	// LifecycleRule matches data older than AgeDays in a storage policy, optionally narrowed down
+	// by size and file extensions.
+	LifecycleRule struct {
+		Action  LifecycleAction `json:"action"`
+		AgeDays int             `json:"age_days"`
+		MinSize int64           `json:"min_size,omitempty"`
+		MaxSize int64           `json:"max_size,omitempty"`
+		Exts    []string        `json:"exts,omitempty"`
+		// TargetPolicyID destination policy of transition action.
+		TargetPolicyID int `json:"target_policy_id,omitempty"`
+		// PathPrefix folder path relative to the root of users' `my` file system, required by expire action.
+		PathPrefix string `json:"path_prefix,omitempty"`
+	}
+
+	// ScheduleProps arguments of the workflow created by a schedule. Dst may contain magic
+	// variables like {date}, replaced when the schedule runs.
+	ScheduleProps struct {
+		Src      []string `json:"src,omitempty"`
+		SrcFile  string   `json:"src_file,omitempty"`
+		Dst      string   `json:"dst"`
+		Encoding string   `json:"encoding,omitempty"`
+		Password string   `json:"password,omitempty"`
+		FileMask []string `json:"file_mask,omitempty"`
+		// Then is the follow-up steps attached to created tasks.
+		Then []TaskChainStep `json:"then,omitempty"`
 	}
 
 	FileType         int
@@ -115,9 +164,12 @@ type (
 	NodeCapability   int
 
 	NodeSetting struct {
-		Provider            DownloaderProvider `json:"provider,omitempty"`
-		*QBittorrentSetting `json:"qbittorrent,omitempty"`
-		*Aria2Setting       `json:"aria2,omitempty"`
+		Provider                 DownloaderProvider `json:"provider,omitempty"`
+		*QBittorrentSetting      `json:"qbittorrent,omitempty"`
+		*Aria2Setting            `json:"aria2,omitempty"`
+		*NativeDownloaderSetting `json:"native,omitempty"`
+		*TransmissionSetting     `json:"transmission,omitempty"`
+		*RTorrentSetting         `json:"rtorrent,omitempty"`
 		// 下载监控间隔
 		Interval       int  `json:"interval,omitempty"`
 		WaitForSeeding bool `json:"wait_for_seeding,omitempty"`
@@ -160,6 +212,31 @@ type (
 		TempPath string         `json:"temp_path,omitempty"`
 	}
 
+	TransmissionSetting struct {
+		// Server is the RPC URL, "/transmission/rpc" is used if path is omitted.
+		Server   string         `json:"server,omitempty"`
+		User     string         `json:"user,omitempty"`
+		Password string         `json:"password,omitempty"`
+		Options  map[string]any `json:"options,omitempty"`
+		TempPath string         `json:"temp_path,omitempty"`
+	}
+
+	RTorrentSetting struct {
+		// Server is the XML-RPC endpoint exposed over HTTP, e.g. "http://127.0.0.1/RPC2".
+		Server   string         `json:"server,omitempty"`
+		User     string         `json:"user,omitempty"`
+		Password string         `json:"password,omitempty"`
+		Options  map[string]any `json:"options,omitempty"`
+		TempPath string         `json:"temp_path,omitempty"`
+	}
+
+	NativeDownloaderSetting struct {
+		TempPath string `json:"temp_path,omitempty"`
+		// Connections is the max number of parallel ranged requests per download.
+		Connections int            `json:"connections,omitempty"`
+		Options     map[string]any `json:"options,omitempty"`
+	}
+
 	TaskPublicState struct {
 		Error            string          `json:"error,omitempty"`
 		ErrorHistory     []string        `json:"error_history,omitempty"`
@@ -167,6 +244,28 @@ type (
 		RetryCount       int             `json:"retry_count,omitempty"`
 		ResumeTime       int64           `json:"resume_time,omitempty"`
 		SlaveTaskProps   *SlaveTaskProps `json:"slave_task_props,omitempty"`
+		// Chain follow-up steps run after the task completes.
+		Chain []TaskChainStep `json:"chain,omitempty"`
+	}
+
+	TaskChainAction string
+	// TaskChainStep is a follow-up action of a completed task. Src and Dst may reference results of
+	// the previous step: {input} and {output} is the first input and output URI, {output_dir} and
+	// {output_name} is the parent folder and name of the first output. A Src entry of {inputs} or
+	// {outputs} expands to all input or output URIs.
+	TaskChainStep struct {
+		Action   TaskChainAction `json:"action"`
+		Src      []string        `json:"src,omitempty"`
+		Dst      string          `json:"dst,omitempty"`
+		Encoding string          `json:"encoding,omitempty"`
+		Password string          `json:"password,omitempty"`
+		FileMask []string        `json:"file_mask,omitempty"`
+	}
+
+	// TaskChainVars results of a task that can be referenced by its follow-up steps.
+	TaskChainVars struct {
+		Inputs  []string
+		Outputs []string
 	}
 
 	SlaveTaskProps struct {
@@ -179,6 +278,8 @@ type (
 	EntityProps struct {
 		UnlinkOnly      bool             `json:"unlink_only,omitempty"`
 		EncryptMetadata *EncryptMetadata `json:"encrypt_metadata,omitempty"`
+		// Broken is set by scrub task if the blob is missing or corrupted.
+		Broken bool `json:"broken,omitempty"`
 	}
 
 	Cipher string
@@ -225,7 +326,14 @@ type (
 		ShareView bool `json:"share_view,omitempty"`
 		// Whether to automatically show readme file in share view
 		ShowReadMe bool `json:"show_read_me,omitempty"`
+		// Mode of the share, empty for read-only share.
+		Mode ShareMode `json:"mode,omitempty"`
+		// Max size of a single file uploaded through the share, 0 for unlimited.
+		MaxUploadSize int64 `json:"max_upload_size,omitempty"`
+		// Max number of files uploaded through the share, 0 for unlimited.
+		MaxUploads int `json:"max_uploads,omitempty"`
 	}
+	ShareMode string
 
 	OAuthClientProps struct {
 		Description     string `json:"description,omitempty"`
@@ -233,6 +341,20 @@ type (
 		RefreshTokenTTL int64  `json:"refresh_token_ttl,omitempty"` // in seconds, 0 means default
 	}
 
+	TeamRole string
+	// TeamSetting defines members of a team folder and their roles.
+	TeamSetting struct {
+		// GroupID all users in this group are members of the team, 0 indicates no group.
+		GroupID int `json:"group_id,omitempty"`
+		// GroupRole role of members granted by GroupID.
+		GroupRole TeamRole     `json:"group_role,omitempty"`
+		Members   []TeamMember `json:"members,omitempty"`
+	}
+	TeamMember struct {
+		UserID int      `json:"user_id"`
+		Role   TeamRole `json:"role"`
+	}
+
 	FileTypeIconSetting struct {
 		Exts      []string `json:"exts"`
 		Icon      string   `json:"icon,omitempty"`
@@ -261,6 +383,7 @@ const (
 	GroupPermissionSetExplicitUser_placeholder
 	GroupPermissionIgnoreFileOwnership // not used
 	GroupPermissionUniqueRedirectDirectLink
+	GroupPermissionWebhook
 )
 
 const (
@@ -299,21 +422,59 @@ const (
 )
 
 const (
-	PolicyTypeLocal  = "local"
-	PolicyTypeQiniu  = "qiniu"
-	PolicyTypeUpyun  = "upyun"
-	PolicyTypeOss    = "oss"
-	PolicyTypeCos    = "cos"
-	PolicyTypeS3     = "s3"
-	PolicyTypeKs3    = "ks3"
-	PolicyTypeOd     = "onedrive"
-	PolicyTypeRemote = "remote"
-	PolicyTypeObs    = "obs"
+	PolicyTypeLocal       = "local"
+	PolicyTypeQiniu       = "qiniu"
+	PolicyTypeUpyun       = "upyun"
+	PolicyTypeOss         = "oss"
+	PolicyTypeCos         = "cos"
+	PolicyTypeS3          = "s3"
+	PolicyTypeKs3         = "ks3"
+	PolicyTypeOd          = "onedrive"
+	PolicyTypeRemote      = "remote"
+	PolicyTypeObs         = "obs"
+	PolicyTypeSftp        = "sftp"
+	PolicyTypeWebdav      = "webdav"
+	PolicyTypeAzblob      = "azblob"
+	PolicyTypeGoogleDrive = "googledrive"
+	PolicyTypePlugin      = "plugin"
+)
+
+const (
+	// ShareModeRead allows visitors to view and download shared files.
+	ShareModeRead = ShareMode("")
+	// ShareModeWrite additionally allows visitors to upload, create, rename and delete files.
+	ShareModeWrite = ShareMode("write")
+	// ShareModeFileDrop only allows visitors to upload files without seeing folder contents.
+	ShareModeFileDrop = ShareMode("file_drop")
+)
+
+const (
+	TeamRoleNone   = TeamRole("")
+	TeamRoleReader = TeamRole("reader")
+	TeamRoleWriter = TeamRole("writer")
+)
+
+const (
+	// LifecycleActionTransition moves matched entities into another storage policy.
+	LifecycleActionTransition = LifecycleAction("transition")
+	// LifecycleActionExpire permanently deletes matched files.
+	LifecycleActionExpire = LifecycleAction("expire")
+)
+
+const (
+	TaskChainActionExtract = TaskChainAction("extract")
+	TaskChainActionArchive = TaskChainAction("archive")
+	// TaskChainActionDelete deletes files in Src, e.g. the archive after it is extracted.
+	TaskChainActionDelete = TaskChainAction("delete")
 )
 
 const (
 	DownloaderProviderAria2       = DownloaderProvider("aria2")
 	DownloaderProviderQBittorrent = DownloaderProvider("qbittorrent")
+	// DownloaderProviderNative downloads HTTP(S) and FTP links in process, without external daemon.
+	DownloaderProviderNative       = DownloaderProvider("native")
+	DownloaderProviderTransmission = DownloaderProvider("transmission")
+	DownloaderProviderRTorrent     = DownloaderProvider("rtorrent")
 )
 
 type (
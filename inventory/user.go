@@ -46,6 +46,9 @@ var (
 	// UPDATE would push the user's storage past their quota. The FS layer
 	// translates this into fs.ErrInsufficientCapacity for API consumers.
 	ErrInsufficientCapacity = errors.New("insufficient storage capacity")
+	// ErrInsufficientFileCount is returned when creating files would push the user's file count past
+	// the FileCountLimit given in context.
+	ErrInsufficientFileCount = errors.New("insufficient file count quota")
 )
 
 type (
@@ -108,6 +111,8 @@ type (
 		Delete(ctx context.Context, uid int) error
 		// CalculateStorage calculate user's storage from scratch and update user's storage.
 		CalculateStorage(ctx context.Context, uid int) (int64, error)
+		// CalculateFileCount counts files and folders owned by user from scratch and update user's file count.
+		CalculateFileCount(ctx context.Context, uid int) (int, error)
 	}
 	ListUserParameters struct {
 		*PaginationArgs
@@ -360,6 +365,19 @@ func (c *userClient) CalculateStorage(ctx context.Context, uid int) (int64, erro
 	return sum, nil
 }
 
+func (c *userClient) CalculateFileCount(ctx context.Context, uid int) (int, error) {
+	count, err := c.client.File.Query().Where(file.OwnerID(uid)).Count(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count user files: %w", err)
+	}
+
+	if _, err := c.client.User.UpdateOneID(uid).SetFileCount(count).Save(ctx); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
 func (c *userClient) SetStatus(ctx context.Context, u *ent.User, status user.Status) (*ent.User, error) {
 	return c.client.User.UpdateOne(u).SetStatus(status).Save(ctx)
 }
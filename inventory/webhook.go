@@ -0,0 +1,245 @@
+package inventory
+
+import (
+	"context"
+	"encoding/gob"
+	"fmt"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/schema"
+	"github.com/cloudreve/Cloudreve/v4/ent/webhook"
+	"github.com/cloudreve/Cloudreve/v4/ent/webhookdelivery"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cache"
+	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
+	"github.com/gofrs/uuid"
+	"github.com/samber/lo"
+)
+
+const (
+	// WebhookSubscriptionCacheKey is the cache key of all enabled webhooks' subscriptions.
+	WebhookSubscriptionCacheKey = "webhook_subscriptions"
+	// webhookSubscriptionCacheTTL bounds staleness of the cache when the KV is not shared
+	// between master replicas.
+	webhookSubscriptionCacheTTL = 60
+)
+
+func init() {
+	gob.Register([]WebhookSubscription{})
+}
+
+type (
+	WebhookClient interface {
+		TxOperator
+		// GetByID returns the webhook with given id.
+		GetByID(ctx context.Context, id int) (*ent.Webhook, error)
+		// List lists webhooks owned by given user, or global webhooks if ownerID is 0.
+		List(ctx context.Context, ownerID int) ([]*ent.Webhook, error)
+		// ListSubscribed lists enabled webhooks that should receive given event of the user,
+		// including global webhooks. Subscriptions are cached, so that events of users without
+		// webhooks do not hit the DB.
+		ListSubscribed(ctx context.Context, uid int, event string) ([]WebhookSubscription, error)
+		// Upsert creates or updates a webhook.
+		Upsert(ctx context.Context, w *ent.Webhook) (*ent.Webhook, error)
+		// Delete deletes webhook by id, along with its delivery logs.
+		Delete(ctx context.Context, id int) error
+		// DeleteByOwner deletes all webhooks owned by given user.
+		DeleteByOwner(ctx context.Context, ownerID int) error
+		// LogDelivery records a delivery attempt.
+		LogDelivery(ctx context.Context, args *WebhookDeliveryArgs) error
+		// ListDeliveries lists delivery logs of a webhook, newest first.
+		ListDeliveries(ctx context.Context, args *ListWebhookDeliveryArgs) (*ListWebhookDeliveryResult, error)
+	}
+
+	// WebhookSubscription is the cached subscription of an enabled webhook.
+	WebhookSubscription struct {
+		ID int
+		// OwnerID is 0 for global webhooks.
+		OwnerID int
+		Events  []string
+	}
+
+	WebhookDeliveryArgs struct {
+		WebhookID  int
+		Event      string
+		DeliveryID uuid.UUID
+		Payload    string
+		StatusCode int
+		Error      string
+		Attempt    int
+		Duration   time.Duration
+	}
+
+	ListWebhookDeliveryArgs struct {
+		*PaginationArgs
+		WebhookID int
+	}
+	ListWebhookDeliveryResult struct {
+		*PaginationResults
+		Deliveries []*ent.WebhookDelivery
+	}
+)
+
+func NewWebhookClient(client *ent.Client, dbType conf.DBType, cache cache.Driver) WebhookClient {
+	return &webhookClient{client: client, maxSQlParam: sqlParamLimit(dbType), cache: cache}
+}
+
+type webhookClient struct {
+	maxSQlParam int
+	client      *ent.Client
+	cache       cache.Driver
+}
+
+func (c *webhookClient) SetClient(newClient *ent.Client) TxOperator {
+	return &webhookClient{client: newClient, maxSQlParam: c.maxSQlParam, cache: c.cache}
+}
+
+func (c *webhookClient) GetClient() *ent.Client {
+	return c.client
+}
+
+func (c *webhookClient) GetByID(ctx context.Context, id int) (*ent.Webhook, error) {
+	return c.client.Webhook.Get(ctx, id)
+}
+
+func (c *webhookClient) List(ctx context.Context, ownerID int) ([]*ent.Webhook, error) {
+	query := c.client.Webhook.Query()
+	if ownerID > 0 {
+		query.Where(webhook.OwnerID(ownerID))
+	} else {
+		query.Where(webhook.OwnerIDIsNil())
+	}
+
+	return query.Order(ent.Asc(webhook.FieldID)).All(ctx)
+}
+
+func (c *webhookClient) ListSubscribed(ctx context.Context, uid int, event string) ([]WebhookSubscription, error) {
+	subscriptions, err := c.subscriptions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Webhooks without events specified receive all events.
+	return lo.Filter(subscriptions, func(w WebhookSubscription, _ int) bool {
+		return (w.OwnerID == 0 || (uid > 0 && w.OwnerID == uid)) &&
+			(len(w.Events) == 0 || lo.Contains(w.Events, event))
+	}), nil
+}
+
+func (c *webhookClient) subscriptions(ctx context.Context) ([]WebhookSubscription, error) {
+	if res, ok := c.cache.Get(WebhookSubscriptionCacheKey); ok {
+		return res.([]WebhookSubscription), nil
+	}
+
+	hooks, err := c.client.Webhook.Query().Where(webhook.Enabled(true)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query webhooks: %w", err)
+	}
+
+	subscriptions := lo.Map(hooks, func(w *ent.Webhook, _ int) WebhookSubscription {
+		return WebhookSubscription{ID: w.ID, OwnerID: lo.FromPtr(w.OwnerID), Events: w.Events}
+	})
+	_ = c.cache.Set(WebhookSubscriptionCacheKey, subscriptions, webhookSubscriptionCacheTTL)
+	return subscriptions, nil
+}
+
+func (c *webhookClient) Upsert(ctx context.Context, w *ent.Webhook) (*ent.Webhook, error) {
+	defer c.clearCache()
+	if w.ID == 0 {
+		stm := c.client.Webhook.Create().
+			SetName(w.Name).
+			SetURL(w.URL).
+			SetSecret(w.Secret).
+			SetEvents(w.Events).
+			SetEnabled(w.Enabled)
+		if w.OwnerID != nil {
+			stm.SetOwnerID(*w.OwnerID)
+		}
+
+		return stm.Save(ctx)
+	}
+
+	stm := c.client.Webhook.UpdateOneID(w.ID).
+		SetName(w.Name).
+		SetURL(w.URL).
+		SetEvents(w.Events).
+		SetEnabled(w.Enabled)
+	if w.Secret != "" {
+		stm.SetSecret(w.Secret)
+	}
+
+	return stm.Save(ctx)
+}
+
+func (c *webhookClient) Delete(ctx context.Context, id int) error {
+	ctx = schema.SkipSoftDelete(ctx)
+	if _, err := c.client.WebhookDelivery.Delete().Where(webhookdelivery.WebhookID(id)).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete delivery logs: %w", err)
+	}
+
+	if err := c.client.Webhook.DeleteOneID(id).Exec(ctx); err != nil {
+		return err
+	}
+
+	c.clearCache()
+	return nil
+}
+
+func (c *webhookClient) clearCache() {
+	_ = c.cache.Delete("", WebhookSubscriptionCacheKey)
+}
+
+func (c *webhookClient) DeleteByOwner(ctx context.Context, ownerID int) error {
+	hooks, err := c.client.Webhook.Query().Where(webhook.OwnerID(ownerID)).IDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range hooks {
+		if err := c.Delete(ctx, id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *webhookClient) LogDelivery(ctx context.Context, args *WebhookDeliveryArgs) error {
+	return c.client.WebhookDelivery.Create().
+		SetWebhookID(args.WebhookID).
+		SetEvent(args.Event).
+		SetDeliveryID(args.DeliveryID).
+		SetPayload(args.Payload).
+		SetStatusCode(args.StatusCode).
+		SetError(args.Error).
+		SetAttempt(args.Attempt).
+		SetDuration(args.Duration.Milliseconds()).
+		Exec(ctx)
+}
+
+func (c *webhookClient) ListDeliveries(ctx context.Context, args *ListWebhookDeliveryArgs) (*ListWebhookDeliveryResult, error) {
+	query := c.client.WebhookDelivery.Query().Where(webhookdelivery.WebhookID(args.WebhookID))
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count delivery logs: %w", err)
+	}
+
+	pageSize := capPageSize(c.maxSQlParam, args.PageSize, 10)
+	deliveries, err := query.
+		Order(ent.Desc(webhookdelivery.FieldCreatedAt), ent.Desc(webhookdelivery.FieldID)).
+		Limit(pageSize).
+		Offset(args.Page * pageSize).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list delivery logs: %w", err)
+	}
+
+	return &ListWebhookDeliveryResult{
+		Deliveries: deliveries,
+		PaginationResults: &PaginationResults{
+			PageSize:   pageSize,
+			TotalItems: total,
+			Page:       args.Page,
+		},
+	}, nil
+}
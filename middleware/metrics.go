@@ -0,0 +1,58 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/constants"
+	"github.com/cloudreve/Cloudreve/v4/pkg/metrics"
+	"github.com/gin-gonic/gin"
+)
+
+// RequestMetrics records latency of requests grouped by the first path segment of matched route.
+func RequestMetrics(h *metrics.HistogramVec) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		h.Observe(
+			time.Since(start).Seconds(),
+			routeGroup(c.FullPath()),
+			c.Request.Method,
+			strconv.Itoa(c.Writer.Status()/100)+"xx",
+		)
+	}
+}
+
+// routeGroup returns the group of a route, e.g. "file" for "/api/v4/file/upload/:sessionId".
+// Route templates are used instead of raw paths to keep label cardinality bounded.
+func routeGroup(route string) string {
+	if route == "" {
+		return "unmatched"
+	}
+
+	rest, ok := strings.CutPrefix(route, constants.APIPrefix+"/")
+	if !ok {
+		return "static"
+	}
+
+	group, _, _ := strings.Cut(rest, "/")
+	return group
+}
+
+// MetricsTokenRequired checks bearer token of metrics scrape requests. Requests are passed
+// without check if token is empty, which is only allowed on the separate metrics listener.
+func MetricsTokenRequired(token string) gin.HandlerFunc {
+	expected := []byte("Bearer " + token)
+	return func(c *gin.Context) {
+		if token != "" && subtle.ConstantTimeCompare([]byte(c.GetHeader("Authorization")), expected) != 1 {
+			c.AbortWithStatus(http.StatusUnauthorized)
+			return
+		}
+
+		c.Next()
+	}
+}
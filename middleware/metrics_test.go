@@ -0,0 +1,63 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/metrics"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetricsTokenRequired(t *testing.T) {
+	a := assert.New(t)
+	gin.SetMode(gin.TestMode)
+	serve := func(token, authorization string) int {
+		r := gin.New()
+		r.GET("/metrics", MetricsTokenRequired(token), func(c *gin.Context) {
+			c.Status(http.StatusOK)
+		})
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		r.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	a.Equal(http.StatusOK, serve("secret", "Bearer secret"))
+	a.Equal(http.StatusUnauthorized, serve("secret", ""))
+	a.Equal(http.StatusUnauthorized, serve("secret", "Bearer wrong"))
+	a.Equal(http.StatusUnauthorized, serve("secret", "secret"))
+	// Empty token disables authentication of the separate metrics listener.
+	a.Equal(http.StatusOK, serve("", ""))
+	a.Equal(http.StatusOK, serve("", "Bearer anything"))
+}
+
+func TestRequestMetrics(t *testing.T) {
+	a := assert.New(t)
+	gin.SetMode(gin.TestMode)
+	h := metrics.NewHistogramVec("request_duration_seconds", "", metrics.DefBuckets, "group", "method", "code")
+	r := gin.New()
+	r.Use(RequestMetrics(h))
+	r.GET("/api/v4/file/:id", func(c *gin.Context) {
+		c.Status(http.StatusNotFound)
+	})
+
+	for _, path := range []string{"/api/v4/file/1", "/api/v4/file/2", "/unknown"} {
+		r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	counts := make(map[string]float64)
+	for _, s := range h.Collect(context.Background())[0].Samples {
+		if s.Suffix == "_count" {
+			counts[s.Labels[1]+" "+s.Labels[3]+" "+s.Labels[5]] = s.Value
+		}
+	}
+	a.Equal(map[string]float64{"file GET 4xx": 2, "unmatched GET 4xx": 1}, counts)
+	a.Equal("static", routeGroup("/s/:id"))
+}
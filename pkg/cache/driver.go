@@ -2,6 +2,7 @@ package cache
 
 import (
 	"encoding/gob"
+	"sync/atomic"
 )
 
 func init() {
@@ -33,4 +34,28 @@ type Driver interface {
 
 	// Remove all entries
 	DeleteAll() error
+
+	// Stats returns hit and miss counts of Get and Gets since started.
+	Stats() Stats
+}
+
+// Stats is the hit and miss counts of a cache driver.
+type Stats struct {
+	Hits   uint64
+	Misses uint64
+}
+
+// hitCounter counts cache hits and misses.
+type hitCounter struct {
+	hits   atomic.Uint64
+	misses atomic.Uint64
+}
+
+func (c *hitCounter) record(hits, misses int) {
+	c.hits.Add(uint64(hits))
+	c.misses.Add(uint64(misses))
+}
+
+func (c *hitCounter) Stats() Stats {
+	return Stats{Hits: c.hits.Load(), Misses: c.misses.Load()}
 }
@@ -15,6 +15,7 @@ import (
 // MemoStore 内存存储驱动
 type MemoStore struct {
 	Store *sync.Map
+	hitCounter
 }
 
 // item 存储的对象
@@ -91,7 +92,13 @@ func (store *MemoStore) Set(key string, value any, ttl int) error {
 
 // Get 取值
 func (store *MemoStore) Get(key string) (any, bool) {
-	return getValue(store.Store.Load(key))
+	v, ok := getValue(store.Store.Load(key))
+	if ok {
+		store.record(1, 0)
+	} else {
+		store.record(0, 1)
+	}
+	return v, ok
 }
 
 // Gets 批量取值
@@ -107,6 +114,7 @@ func (store *MemoStore) Gets(keys []string, prefix string) (map[string]any, []st
 		}
 	}
 
+	store.record(len(res), len(notFound))
 	return res, notFound
 }
 
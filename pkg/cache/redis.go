@@ -15,6 +15,7 @@ import (
 // RedisStore redis存储驱动
 type RedisStore struct {
 	pool *redis.Pool
+	hitCounter
 }
 
 type item struct {
@@ -116,14 +117,17 @@ func (store *RedisStore) Get(key string) (any, bool) {
 
 	v, err := redis.Bytes(rc.Do("GET", key))
 	if err != nil || v == nil {
+		store.record(0, 1)
 		return nil, false
 	}
 
 	finalValue, err := deserializer(v)
 	if err != nil {
+		store.record(0, 1)
 		return nil, false
 	}
 
+	store.record(1, 0)
 	return finalValue, true
 
 }
@@ -143,6 +147,7 @@ func (store *RedisStore) Gets(keys []string, prefix string) (map[string]any, []s
 
 	v, err := redis.ByteSlices(rc.Do("MGET", redis.Args{}.AddFlat(queryKeys)...))
 	if err != nil {
+		store.record(0, len(keys))
 		return nil, keys
 	}
 
@@ -157,6 +162,8 @@ func (store *RedisStore) Gets(keys []string, prefix string) (map[string]any, []s
 			res[keys[key]] = decoded
 		}
 	}
+
+	store.record(len(res), len(missed))
 	// 解码所得值
 	return res, missed
 }
@@ -16,8 +16,11 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
 	"github.com/cloudreve/Cloudreve/v4/pkg/downloader"
 	"github.com/cloudreve/Cloudreve/v4/pkg/downloader/aria2"
+	"github.com/cloudreve/Cloudreve/v4/pkg/downloader/native"
 	"github.com/cloudreve/Cloudreve/v4/pkg/downloader/qbittorrent"
+	"github.com/cloudreve/Cloudreve/v4/pkg/downloader/rtorrent"
 	"github.com/cloudreve/Cloudreve/v4/pkg/downloader/slave"
+	"github.com/cloudreve/Cloudreve/v4/pkg/downloader/transmission"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
 	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
 	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
@@ -25,6 +28,8 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
 	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
 	"strconv"
+	"strings"
+	"time"
 )
 
 type (
@@ -42,9 +47,18 @@ type (
 		// AuthInstance returns the auth instance for the node.
 		AuthInstance() auth.Auth
 		// CreateDownloader creates a downloader instance from the node for remote download tasks.
-		CreateDownloader(ctx context.Context, c request.Client, settings setting.Provider) (downloader.Downloader, error)
+		// If provider is not empty, it overrides the downloader provider configured in node settings.
+		CreateDownloader(ctx context.Context, c request.Client, settings setting.Provider, provider types.DownloaderProvider) (downloader.Downloader, error)
 		// Settings returns the settings of the node.
 		Settings(ctx context.Context) *types.NodeSetting
+		// Ping sends a heartbeat to the node and returns its status. It does not have effect on master node.
+		Ping(ctx context.Context, timeout time.Duration) (*NodeStatus, error)
+	}
+
+	// NodeStatus is the load status reported by slave node on heartbeat.
+	NodeStatus struct {
+		BusyWorkers int `json:"busy_workers"`
+		Workers     int `json:"workers"`
 	}
 
 	// Request body for creating tasks on slave node
@@ -213,8 +227,8 @@ func (b *masterNode) CreateFile(ctx context.Context, args *fs.StatelessCreateFil
 
 }
 
-func (b *masterNode) CreateDownloader(ctx context.Context, c request.Client, settings setting.Provider) (downloader.Downloader, error) {
-	return NewDownloader(ctx, c, settings, b.Settings(ctx))
+func (b *masterNode) CreateDownloader(ctx context.Context, c request.Client, settings setting.Provider, provider types.DownloaderProvider) (downloader.Downloader, error) {
+	return NewDownloader(ctx, c, settings, withProvider(b.Settings(ctx), provider))
 }
 
 // NewDownloader creates a new downloader instance from the node for remote download tasks.
@@ -223,6 +237,12 @@ func NewDownloader(ctx context.Context, c request.Client, settings setting.Provi
 		return qbittorrent.NewClient(logging.FromContext(ctx), c, settings, options.QBittorrentSetting)
 	} else if options.Provider == types.DownloaderProviderAria2 {
 		return aria2.New(logging.FromContext(ctx), settings, options.Aria2Setting), nil
+	} else if options.Provider == types.DownloaderProviderTransmission {
+		return transmission.NewClient(logging.FromContext(ctx), c, settings, options.TransmissionSetting)
+	} else if options.Provider == types.DownloaderProviderRTorrent {
+		return rtorrent.NewClient(logging.FromContext(ctx), c, settings, options.RTorrentSetting)
+	} else if options.Provider == types.DownloaderProviderNative {
+		return native.New(logging.FromContext(ctx), settings, options.NativeDownloaderSetting, SSRFOptions(ctx, settings, options)), nil
 	} else if options.Provider == "" {
 		return nil, errors.New("downloader not configured for this node")
 	} else {
@@ -230,6 +250,24 @@ func NewDownloader(ctx context.Context, c request.Client, settings setting.Provi
 	}
 }
 
+// SSRFOptions composes the SSRF policy for a download: the assigned
+// node's URLValidation settings, plus the operator-configured site URL hosts
+// (always allowlisted so users can fetch files served by Cloudreve itself).
+func SSRFOptions(ctx context.Context, settings setting.Provider, node *types.NodeSetting) request.SSRFOptions {
+	opt := request.SSRFOptions{}
+	if node != nil && node.URLValidation != nil {
+		opt.Disabled = node.URLValidation.Disabled
+		opt.AllowedHosts = append(opt.AllowedHosts, node.URLValidation.AllowedHosts...)
+		opt.AllowedCIDRs = append(opt.AllowedCIDRs, node.URLValidation.AllowedCIDRs...)
+	}
+	for _, u := range settings.AllSiteURLs(ctx) {
+		if h := u.Hostname(); h != "" {
+			opt.AllowedHosts = append(opt.AllowedHosts, h)
+		}
+	}
+	return opt
+}
+
 type slaveNode struct {
 	nodeBase
 	client request.Client
@@ -334,8 +372,33 @@ func (b *slaveNode) CleanupFolders(ctx context.Context, folders ...string) error
 	return nil
 }
 
-func (b *slaveNode) CreateDownloader(ctx context.Context, c request.Client, settings setting.Provider) (downloader.Downloader, error) {
-	return slave.NewSlaveDownloader(b.client, b.Settings(ctx)), nil
+func (n *slaveNode) Ping(ctx context.Context, timeout time.Duration) (*NodeStatus, error) {
+	resp, err := n.client.Request(
+		"POST",
+		constants.APIPrefixSlave+"/ping",
+		strings.NewReader("{}"),
+		request.WithContext(ctx),
+		request.WithTimeout(timeout),
+		request.WithLogger(logging.FromContext(ctx)),
+	).CheckHTTPResponse(200).DecodeResponse()
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Code != 0 {
+		return nil, serializer.NewErrorFromResponse(resp)
+	}
+
+	status := &NodeStatus{}
+	if data, ok := resp.Data.(string); ok && data != "" {
+		resp.GobDecode(status)
+	}
+
+	return status, nil
+}
+
+func (b *slaveNode) CreateDownloader(ctx context.Context, c request.Client, settings setting.Provider, provider types.DownloaderProvider) (downloader.Downloader, error) {
+	return slave.NewSlaveDownloader(b.client, withProvider(b.Settings(ctx), provider)), nil
 }
 
 type nodeBase struct {
@@ -386,7 +449,11 @@ func (b *nodeBase) CreateFile(ctx context.Context, args *fs.StatelessCreateFileS
 	return errors.New("not implemented")
 }
 
-func (b *nodeBase) CreateDownloader(ctx context.Context, c request.Client, settings setting.Provider) (downloader.Downloader, error) {
+func (b *nodeBase) CreateDownloader(ctx context.Context, c request.Client, settings setting.Provider, provider types.DownloaderProvider) (downloader.Downloader, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (b *nodeBase) Ping(ctx context.Context, timeout time.Duration) (*NodeStatus, error) {
 	return nil, errors.New("not implemented")
 }
 
@@ -411,3 +478,14 @@ func MasterSiteUrlFromContext(ctx context.Context) string {
 
 	return ""
 }
+
+// withProvider returns a copy of node settings with downloader provider replaced, if given.
+func withProvider(settings *types.NodeSetting, provider types.DownloaderProvider) *types.NodeSetting {
+	if provider == "" || settings == nil {
+		return settings
+	}
+
+	res := *settings
+	res.Provider = provider
+	return &res
+}
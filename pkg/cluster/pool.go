@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/cloudreve/Cloudreve/v4/ent"
 	"github.com/cloudreve/Cloudreve/v4/ent/node"
@@ -18,19 +19,31 @@ import (
 type NodePool interface {
 	// Upsert updates or inserts a node into the pool.
 	Upsert(ctx context.Context, node *ent.Node)
-	// Get returns a node with the given capability and preferred node id. `allowed` is a list of allowed node ids.
-	// If `allowed` is empty, all nodes with the capability are considered.
+	// Get returns a healthy node with the given capability and preferred node id. If the preferred node is
+	// unhealthy or not found, another healthy node with the capability is selected.
 	Get(ctx context.Context, capability types.NodeCapability, preferred int) (Node, error)
+	// GetByID returns the node with given id regardless of its health state.
+	GetByID(ctx context.Context, id int) (Node, error)
+	// Health returns the health state of the node with given id, nil if the node is not in the pool.
+	Health(id int) *NodeHealth
+	// Nodes returns all active nodes in the pool.
+	Nodes() []Node
+	// Shutdown stops heartbeats of the pool.
+	Shutdown()
 }
 
 type (
 	weightedNodePool struct {
 		lock sync.RWMutex
 
+		l        logging.Logger
 		conf     conf.ConfigProvider
 		settings setting.Provider
+		cancel   context.CancelFunc
 
 		nodes map[types.NodeCapability][]*nodeItem
+		// health is the health state of slave nodes indexed by node id.
+		health map[int]*NodeHealth
 	}
 
 	nodeItem struct {
@@ -38,10 +51,24 @@ type (
 		weight  int
 		current int
 	}
+
+	// NodeHealth is the health state of a slave node collected by heartbeats.
+	NodeHealth struct {
+		Healthy bool `json:"healthy"`
+		// Failures is the number of consecutive failed heartbeats.
+		Failures int `json:"failures"`
+		// UnhealthySince is the time the node is marked as unhealthy.
+		UnhealthySince time.Time `json:"unhealthy_since,omitempty"`
+		LastSeen       time.Time `json:"last_seen,omitempty"`
+		LastError      string    `json:"last_error,omitempty"`
+		BusyWorkers    int       `json:"busy_workers"`
+		Workers        int       `json:"workers"`
+	}
 )
 
 var (
 	ErrNoAvailableNode = fmt.Errorf("no available node found")
+	ErrNoHealthyNode   = fmt.Errorf("no healthy node found")
 
 	supportedCapabilities = []types.NodeCapability{
 		types.NodeCapabilityNone,
@@ -60,6 +87,8 @@ func NewNodePool(ctx context.Context, l logging.Logger, config conf.ConfigProvid
 
 	pool := &weightedNodePool{
 		nodes:    make(map[types.NodeCapability][]*nodeItem),
+		health:   make(map[int]*NodeHealth),
+		l:        l,
 		conf:     config,
 		settings: settings,
 	}
@@ -82,6 +111,10 @@ func NewNodePool(ctx context.Context, l logging.Logger, config conf.ConfigProvid
 		}
 	}
 
+	heartbeatCtx, cancel := context.WithCancel(context.WithValue(context.Background(), logging.LoggerCtx{}, l))
+	pool.cancel = cancel
+	go pool.heartbeat(heartbeatCtx)
+
 	return pool, nil
 }
 
@@ -95,11 +128,18 @@ func (p *weightedNodePool) Get(ctx context.Context, capability types.NodeCapabil
 		return nil, fmt.Errorf("no node found with capability %d: %w", capability, ErrNoAvailableNode)
 	}
 
+	healthy := lo.Filter(nodes, func(item *nodeItem, _ int) bool {
+		return p.isHealthy(item.node)
+	})
+	if len(healthy) == 0 {
+		return nil, fmt.Errorf("all nodes with capability %d are unhealthy: %w", capability, ErrNoHealthyNode)
+	}
+
 	var selected *nodeItem
 
 	if preferred > 0 {
 		// First try to find the preferred node.
-		for _, n := range nodes {
+		for _, n := range healthy {
 			if n.node.ID() == preferred {
 				selected = n
 				break
@@ -107,12 +147,19 @@ func (p *weightedNodePool) Get(ctx context.Context, capability types.NodeCapabil
 		}
 
 		if selected == nil {
-			l.Debug("Preferred node %d not found, fallback to select a node with the least current weight", preferred)
+			l.Debug("Preferred node %d not found or unhealthy, fallback to select a node with the least current weight", preferred)
 		}
 	}
 
 	if selected == nil {
 		// If no preferred one, or the preferred one is not available, select a node with the least current weight.
+		// Nodes with all workers busy are only considered when there's no other choice.
+		candidates := lo.Filter(healthy, func(item *nodeItem, _ int) bool {
+			return !p.isSaturated(item.node)
+		})
+		if len(candidates) == 0 {
+			candidates = healthy
+		}
 
 		// Total weight of all items.
 		var total int
@@ -120,7 +167,7 @@ func (p *weightedNodePool) Get(ctx context.Context, capability types.NodeCapabil
 		// Loop through the list of items and add the item's weight to the current weight.
 		// Also increment the total weight counter.
 		var maxNode *nodeItem
-		for _, item := range nodes {
+		for _, item := range candidates {
 			item.current += max(1, item.weight)
 			total += max(1, item.weight)
 
@@ -145,10 +192,55 @@ func (p *weightedNodePool) Get(ctx context.Context, capability types.NodeCapabil
 	return selected.node, nil
 }
 
+func (p *weightedNodePool) GetByID(ctx context.Context, id int) (Node, error) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	item, found := lo.Find(p.nodes[types.NodeCapabilityNone], func(i *nodeItem) bool {
+		return i.node.ID() == id
+	})
+	if !found {
+		return nil, fmt.Errorf("node %d not found: %w", id, ErrNoAvailableNode)
+	}
+
+	return item.node, nil
+}
+
+func (p *weightedNodePool) Health(id int) *NodeHealth {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	if h, ok := p.health[id]; ok {
+		res := *h
+		return &res
+	}
+
+	return nil
+}
+
+func (p *weightedNodePool) Nodes() []Node {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	return lo.Map(p.nodes[types.NodeCapabilityNone], func(item *nodeItem, _ int) Node {
+		return item.node
+	})
+}
+
+func (p *weightedNodePool) Shutdown() {
+	if p.cancel != nil {
+		p.cancel()
+	}
+}
+
 func (p *weightedNodePool) Upsert(ctx context.Context, n *ent.Node) {
 	p.lock.Lock()
 	defer p.lock.Unlock()
 
+	if n.Status != node.StatusActive {
+		delete(p.health, n.ID)
+	}
+
 	for _, capability := range supportedCapabilities {
 		_, index, found := lo.FindIndexOf(p.nodes[capability], func(i *nodeItem) bool {
 			return i.node.ID() == n.ID
@@ -177,6 +269,91 @@ func (p *weightedNodePool) Upsert(ctx context.Context, n *ent.Node) {
 	}
 }
 
+// isHealthy returns true if the node is considered healthy. Master node is always healthy, slave nodes
+// without heartbeat results yet are optimistically considered healthy.
+func (p *weightedNodePool) isHealthy(n Node) bool {
+	if n.IsMaster() {
+		return true
+	}
+
+	h, ok := p.health[n.ID()]
+	return !ok || h.Healthy
+}
+
+// isSaturated returns true if all workers of the node are busy according to the last heartbeat.
+func (p *weightedNodePool) isSaturated(n Node) bool {
+	h, ok := p.health[n.ID()]
+	return ok && h.Workers > 0 && h.BusyWorkers >= h.Workers
+}
+
+// heartbeat periodically pings all slave nodes until ctx is canceled.
+func (p *weightedNodePool) heartbeat(ctx context.Context) {
+	for {
+		hc := p.settings.NodeHealthCheck(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(max(hc.Interval, time.Second)):
+		}
+
+		p.checkNodes(ctx, hc)
+	}
+}
+
+func (p *weightedNodePool) checkNodes(ctx context.Context, hc *setting.NodeHealthCheck) {
+	p.lock.RLock()
+	slaves := lo.FilterMap(p.nodes[types.NodeCapabilityNone], func(item *nodeItem, _ int) (Node, bool) {
+		return item.node, !item.node.IsMaster()
+	})
+	p.lock.RUnlock()
+
+	wg := sync.WaitGroup{}
+	for _, n := range slaves {
+		wg.Add(1)
+		go func(n Node) {
+			defer wg.Done()
+			status, err := n.Ping(ctx, hc.Timeout)
+			p.updateHealth(n, status, err, hc.MaxFailures)
+		}(n)
+	}
+
+	wg.Wait()
+}
+
+func (p *weightedNodePool) updateHealth(n Node, status *NodeStatus, err error, maxFailures int) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	h, ok := p.health[n.ID()]
+	if !ok {
+		h = &NodeHealth{Healthy: true}
+		p.health[n.ID()] = h
+	}
+
+	if err != nil {
+		h.Failures++
+		h.LastError = err.Error()
+		if h.Healthy && h.Failures >= max(1, maxFailures) {
+			h.Healthy = false
+			h.UnhealthySince = time.Now()
+			p.l.Warning("Node %q is marked as unhealthy after %d failed heartbeats: %s", n.Name(), h.Failures, err)
+		}
+		return
+	}
+
+	if !h.Healthy {
+		p.l.Info("Node %q is back online.", n.Name())
+	}
+
+	h.Healthy = true
+	h.UnhealthySince = time.Time{}
+	h.Failures = 0
+	h.LastError = ""
+	h.LastSeen = time.Now()
+	h.BusyWorkers = status.BusyWorkers
+	h.Workers = status.Workers
+}
+
 type slaveDummyNodePool struct {
 	conf       conf.ConfigProvider
 	settings   setting.Provider
@@ -201,3 +378,18 @@ func (s *slaveDummyNodePool) Upsert(ctx context.Context, node *ent.Node) {
 func (s *slaveDummyNodePool) Get(ctx context.Context, capability types.NodeCapability, preferred int) (Node, error) {
 	return s.masterNode, nil
 }
+
+func (s *slaveDummyNodePool) GetByID(ctx context.Context, id int) (Node, error) {
+	return s.masterNode, nil
+}
+
+func (s *slaveDummyNodePool) Health(id int) *NodeHealth {
+	return nil
+}
+
+func (s *slaveDummyNodePool) Nodes() []Node {
+	return []Node{s.masterNode}
+}
+
+func (s *slaveDummyNodePool) Shutdown() {
+}
@@ -0,0 +1,169 @@
+package cluster
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/node"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSlave is a slave node whose heartbeat result is controlled by the test.
+type fakeSlave struct {
+	nodeBase
+
+	mu     sync.Mutex
+	err    error
+	status *NodeStatus
+}
+
+func newFakeSlave(id int) *fakeSlave {
+	return &fakeSlave{
+		nodeBase: nodeBase{model: &ent.Node{ID: id, Name: "slave", Type: node.TypeSlave, Status: node.StatusActive}},
+		status:   &NodeStatus{},
+	}
+}
+
+func (n *fakeSlave) Ping(ctx context.Context, timeout time.Duration) (*NodeStatus, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.status, n.err
+}
+
+func (n *fakeSlave) respond(status *NodeStatus, err error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.status, n.err = status, err
+}
+
+// newTestPool creates a pool with given nodes in remote download capability slot, heartbeats are
+// triggered by tests.
+func newTestPool(nodes ...Node) *weightedNodePool {
+	p := &weightedNodePool{
+		l:      logging.NewConsoleLogger(logging.LevelError),
+		nodes:  make(map[types.NodeCapability][]*nodeItem),
+		health: make(map[int]*NodeHealth),
+	}
+	for _, n := range nodes {
+		for _, capability := range []types.NodeCapability{types.NodeCapabilityNone, types.NodeCapabilityRemoteDownload} {
+			p.nodes[capability] = append(p.nodes[capability], &nodeItem{node: n, weight: 1})
+		}
+	}
+
+	return p
+}
+
+// selected returns IDs of nodes selected by n calls of Get.
+func selected(t *testing.T, p *weightedNodePool, preferred, n int) []int {
+	t.Helper()
+
+	res := make([]int, 0, n)
+	for i := 0; i < n; i++ {
+		node, err := p.Get(context.Background(), types.NodeCapabilityRemoteDownload, preferred)
+		require.NoError(t, err)
+		res = append(res, node.ID())
+	}
+	return res
+}
+
+func TestPoolSkipsUnhealthyNodes(t *testing.T) {
+	a := assert.New(t)
+	s1, s2 := newFakeSlave(2), newFakeSlave(3)
+	p := newTestPool(s1, s2)
+	hc := &setting.NodeHealthCheck{Timeout: time.Second, MaxFailures: 2}
+
+	// Nodes without heartbeat results are optimistically selected.
+	a.Nil(p.Health(s1.ID()))
+	a.ElementsMatch([]int{2, 3}, selected(t, p, 0, 2))
+
+	// Node is only marked unhealthy after consecutive failures.
+	s1.respond(nil, errors.New("connection refused"))
+	p.checkNodes(context.Background(), hc)
+	h := p.Health(s1.ID())
+	require.NotNil(t, h)
+	a.True(h.Healthy)
+	a.Equal(1, h.Failures)
+	a.Equal([]int{2}, selected(t, p, 2, 1))
+
+	p.checkNodes(context.Background(), hc)
+	h = p.Health(s1.ID())
+	a.False(h.Healthy)
+	a.Equal(2, h.Failures)
+	a.Equal("connection refused", h.LastError)
+	a.WithinDuration(time.Now(), h.UnhealthySince, time.Second)
+	a.True(p.Health(s2.ID()).Healthy)
+
+	// Unhealthy nodes are skipped, even if preferred.
+	a.Equal([]int{3, 3, 3}, selected(t, p, 0, 3))
+	a.Equal([]int{3}, selected(t, p, s1.ID(), 1))
+
+	s2.respond(nil, errors.New("timeout"))
+	p.checkNodes(context.Background(), hc)
+	p.checkNodes(context.Background(), hc)
+	_, err := p.Get(context.Background(), types.NodeCapabilityRemoteDownload, 0)
+	a.ErrorIs(err, ErrNoHealthyNode)
+	_, err = p.Get(context.Background(), types.NodeCapabilityExtractArchive, 0)
+	a.ErrorIs(err, ErrNoAvailableNode)
+
+	// Unhealthy nodes can still be found by ID, e.g. for cleanup.
+	found, err := p.GetByID(context.Background(), s1.ID())
+	require.NoError(t, err)
+	a.Equal(s1.ID(), found.ID())
+}
+
+func TestPoolNodeRecovery(t *testing.T) {
+	a := assert.New(t)
+	s1, s2 := newFakeSlave(2), newFakeSlave(3)
+	p := newTestPool(s1, s2)
+	hc := &setting.NodeHealthCheck{Timeout: time.Second, MaxFailures: 1}
+
+	s1.respond(nil, errors.New("connection refused"))
+	p.checkNodes(context.Background(), hc)
+	a.False(p.Health(s1.ID()).Healthy)
+	a.Equal([]int{3, 3}, selected(t, p, 0, 2))
+
+	// Recovered node is put back into rotation.
+	s1.respond(&NodeStatus{Workers: 4, BusyWorkers: 1}, nil)
+	p.checkNodes(context.Background(), hc)
+	h := p.Health(s1.ID())
+	a.True(h.Healthy)
+	a.Zero(h.Failures)
+	a.Empty(h.LastError)
+	a.True(h.UnhealthySince.IsZero())
+	a.WithinDuration(time.Now(), h.LastSeen, time.Second)
+	a.Equal(4, h.Workers)
+	a.Equal([]int{2}, selected(t, p, s1.ID(), 1))
+	a.ElementsMatch([]int{2, 3, 2, 3}, selected(t, p, 0, 4))
+
+	// Deactivated node is removed along with its health state.
+	deactivated := *s1.model
+	deactivated.Status = node.StatusSuspended
+	p.Upsert(context.Background(), &deactivated)
+	a.Nil(p.Health(s1.ID()))
+	a.Equal([]int{3, 3}, selected(t, p, 0, 2))
+}
+
+func TestPoolSaturatedNodes(t *testing.T) {
+	a := assert.New(t)
+	s1, s2 := newFakeSlave(2), newFakeSlave(3)
+	p := newTestPool(s1, s2)
+	hc := &setting.NodeHealthCheck{Timeout: time.Second, MaxFailures: 1}
+
+	// Nodes with all workers busy are only selected when there's no other choice.
+	s1.respond(&NodeStatus{Workers: 2, BusyWorkers: 2}, nil)
+	s2.respond(&NodeStatus{Workers: 2, BusyWorkers: 1}, nil)
+	p.checkNodes(context.Background(), hc)
+	a.Equal([]int{3, 3, 3}, selected(t, p, 0, 3))
+
+	s2.respond(&NodeStatus{Workers: 2, BusyWorkers: 2}, nil)
+	p.checkNodes(context.Background(), hc)
+	a.ElementsMatch([]int{2, 3}, selected(t, p, 0, 2))
+}
@@ -100,6 +100,15 @@ func MasterArchiveDownloadUrl(base *url.URL, sessionID string) *url.URL {
 	return base.ResolveReference(routes)
 }
 
+// MasterGoogleDriveOAuthCallback returns the redirect URL of Google Drive OAuth flow.
+func MasterGoogleDriveOAuthCallback(base *url.URL) *url.URL {
+	routes, err := url.Parse(constants.APIPrefix + "/callback/googledrive/auth")
+	if err != nil {
+		return nil
+	}
+	return base.ResolveReference(routes)
+}
+
 func MasterPolicyOAuthCallback(base *url.URL) *url.URL {
 	if base.Scheme != "https" {
 		base.Scheme = "https"
@@ -38,6 +38,13 @@ var (
 	SlaveMode  SysMode = "slave"
 )
 
+type LockSystemType string
+
+var (
+	LockSystemMemory   LockSystemType = "memory"
+	LockSystemDatabase LockSystemType = "database"
+)
+
 // System 系统通用配置
 type System struct {
 	Mode          SysMode `validate:"eq=master|eq=slave"`
@@ -49,6 +56,12 @@ type System struct {
 	ProxyHeader   string
 	LogLevel      string `validate:"oneof=debug info warning error"`
 	Pprof         string // Address to listen for pprof, e.g. "localhost:6060". Empty to disable.
+	// LockSystem is where WebDAV/WOPI locks are kept, "memory" or "database". Use "database"
+	// if multiple master replicas are running behind a load balancer.
+	LockSystem LockSystemType `validate:"omitempty,oneof=memory database"`
+	// InstanceID identifies this master among replicas sharing one database. Persisted tasks are
+	// leased to replicas only if it is set, it must be unique for each replica and stable across restarts.
+	InstanceID string
 }
 
 type SSL struct {
@@ -62,6 +75,17 @@ type Unix struct {
 	Perm   uint32
 }
 
+// Metrics configures the Prometheus scrape endpoint, it is disabled if both fields are empty.
+type Metrics struct {
+	// Listen is a separate address to serve /metrics on, e.g. "localhost:9100".
+	Listen string
+	// Token is the bearer token required by the endpoint. If Listen is empty, metrics are served
+	// at /api/v4/metrics on main listener and Token is mandatory. If Listen is set and Token is
+	// empty, the endpoint is served WITHOUT authentication, Listen should then only be reachable
+	// by trusted scrapers, e.g. bound to localhost or a private network.
+	Token string
+}
+
 // Slave 作为slave存储端配置
 type Slave struct {
 	Secret          string `validate:"omitempty,gte=64"`
@@ -117,6 +141,7 @@ var SystemConfig = &System{
 	Listen:      ":5212",
 	ProxyHeader: "",
 	LogLevel:    "info",
+	LockSystem:  LockSystemMemory,
 }
 
 // CORSConfig 跨域配置
@@ -142,6 +167,8 @@ var SSLConfig = &SSL{
 	KeyPath:  "",
 }
 
+var MetricsConfig = &Metrics{}
+
 var UnixConfig = &Unix{
 	Listen: "",
 }
@@ -0,0 +1,198 @@
+package native
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync/atomic"
+)
+
+type sourceKind string
+
+const (
+	sourceKindDirect sourceKind = ""
+	sourceKindShare  sourceKind = "share"
+	sourceKindWebDAV sourceKind = "webdav"
+
+	// maxFolderFiles limits number of files pulled from one folder source.
+	maxFolderFiles = 10000
+)
+
+var (
+	// shareLinkPath matches share page path of a Cloudreve v4 site, e.g. /s/abc or /s/abc/password.
+	shareLinkPath = regexp.MustCompile(`^(.*)/s/([^/]+)(?:/([^/]+))?/?$`)
+)
+
+// folderSource is a remote source that may contain multiple files.
+type folderSource interface {
+	// list returns name of the source root and all files under it, file names are
+	// relative to the download folder.
+	list(ctx context.Context) (string, []*remoteFile, error)
+	// request builds the request to download given file.
+	request(ctx context.Context, f *remoteFile) (*http.Request, error)
+}
+
+// IsListingSource returns whether given URL points to a remote folder the built-in downloader
+// can list and pull recursively, i.e. a share link of another Cloudreve site or a WebDAV URL.
+func IsListingSource(raw string) bool {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return false
+	}
+
+	return detectKind(u) != sourceKindDirect
+}
+
+func detectKind(u *url.URL) sourceKind {
+	switch strings.ToLower(u.Scheme) {
+	case "webdav", "webdavs":
+		return sourceKindWebDAV
+	case "cloudreve":
+		return sourceKindShare
+	case "http", "https":
+		if u.RawQuery == "" && shareLinkPath.MatchString(u.Path) {
+			return sourceKindShare
+		}
+	}
+
+	return sourceKindDirect
+}
+
+// downloadFolder lists the source once, then downloads listed files one by one.
+func (j *job) downloadFolder(ctx context.Context, src folderSource) error {
+	if !j.meta.Listed {
+		var (
+			name  string
+			files []*remoteFile
+		)
+		if err := j.withRetry(ctx, func() error {
+			var err error
+			name, files, err = src.list(ctx)
+			return err
+		}); err != nil {
+			return fmt.Errorf("failed to list files: %w", err)
+		}
+
+		if len(files) > maxFolderFiles {
+			return fmt.Errorf("too many files in source, max %d", maxFolderFiles)
+		}
+
+		total := int64(0)
+		for _, f := range files {
+			if f.Size < 0 {
+				total = -1
+				break
+			}
+			total += f.Size
+		}
+
+		j.mu.Lock()
+		j.meta.Name = sanitizeFileName(name)
+		j.meta.Files = files
+		j.meta.Total = total
+		j.meta.Listed = true
+		j.mu.Unlock()
+		if err := j.persist(); err != nil {
+			return fmt.Errorf("failed to save task meta: %w", err)
+		}
+	}
+
+	for _, f := range j.meta.Files {
+		j.mu.Lock()
+		skip := f.Skip
+		j.mu.Unlock()
+		if skip || (f.Size > 0 && atomic.LoadInt64(&f.Done) >= f.Size) {
+			continue
+		}
+
+		if err := j.withRetry(ctx, func() error {
+			return j.fetchFile(ctx, src, f)
+		}); err != nil {
+			return fmt.Errorf("failed to download %q: %w", f.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// fetchFile downloads one listed file, resuming from recorded progress if server supports it.
+func (j *job) fetchFile(ctx context.Context, src folderSource, rf *remoteFile) error {
+	dst := filepath.Join(j.dir, filepath.FromSlash(rf.Name))
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return fmt.Errorf("failed to create folder: %w", err)
+	}
+
+	if _, err := os.Stat(dst); os.IsNotExist(err) {
+		atomic.StoreInt64(&rf.Done, 0)
+	}
+
+	f, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open file: %w", err)
+	}
+	defer f.Close()
+
+	req, err := src.request(ctx, rf)
+	if err != nil {
+		return err
+	}
+
+	start := atomic.LoadInt64(&rf.Done)
+	if start > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", start))
+	}
+
+	resp, err := j.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to request %q: %w", req.URL.Redacted(), err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusPartialContent && start > 0:
+	case resp.StatusCode == http.StatusOK:
+		// Range not honored, start over.
+		start = 0
+		atomic.StoreInt64(&rf.Done, 0)
+		if err := f.Truncate(0); err != nil {
+			return fmt.Errorf("failed to truncate file: %w", err)
+		}
+	default:
+		return fmt.Errorf("unexpected status code %d from %q", resp.StatusCode, req.URL.Redacted())
+	}
+
+	if _, err := io.Copy(&progressWriter{f: f, offset: start, done: &rf.Done}, resp.Body); err != nil {
+		return err
+	}
+
+	done := atomic.LoadInt64(&rf.Done)
+	if rf.Size >= 0 && done < rf.Size {
+		return io.ErrUnexpectedEOF
+	}
+
+	j.mu.Lock()
+	rf.Size = done
+	j.mu.Unlock()
+	return nil
+}
+
+// sanitizeRelativePath cleans every element of a slash separated relative path.
+func sanitizeRelativePath(p string) string {
+	elements := strings.Split(strings.Trim(strings.ReplaceAll(p, "\\", "/"), "/"), "/")
+	res := make([]string, 0, len(elements))
+	for _, e := range elements {
+		if e == "" {
+			continue
+		}
+		res = append(res, sanitizeFileName(e))
+	}
+
+	return path.Join(res...)
+}
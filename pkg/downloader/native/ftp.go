@@ -0,0 +1,260 @@
+package native
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/textproto"
+	"net/url"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"sync/atomic"
+)
+
+const defaultFTPPort = "21"
+
+// ftpConn is a minimal passive mode FTP client, only supports what is needed to
+// retrieve one file with resume.
+type ftpConn struct {
+	host string
+	dial func(ctx context.Context, network, addr string) (net.Conn, error)
+	raw  net.Conn
+	text *textproto.Conn
+	data net.Conn
+}
+
+func dialFTP(ctx context.Context, u *url.URL, dial func(ctx context.Context, network, addr string) (net.Conn, error),
+	username, password string) (*ftpConn, error) {
+	port := u.Port()
+	if port == "" {
+		port = defaultFTPPort
+	}
+
+	raw, err := dial(ctx, "tcp", net.JoinHostPort(u.Hostname(), port))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to ftp server: %w", err)
+	}
+
+	c := &ftpConn{
+		host: u.Hostname(),
+		dial: dial,
+		raw:  raw,
+		text: textproto.NewConn(raw),
+	}
+
+	if _, _, err := c.text.ReadResponse(220); err != nil {
+		c.Close()
+		return nil, fmt.Errorf("unexpected ftp greeting: %w", err)
+	}
+
+	if username == "" && u.User != nil {
+		username = u.User.Username()
+		password, _ = u.User.Password()
+	}
+	if username == "" {
+		username, password = "anonymous", "anonymous@"
+	}
+
+	code, msg, err := c.cmd(0, "USER %s", username)
+	if err == nil && code == 331 {
+		code, msg, err = c.cmd(0, "PASS %s", password)
+	}
+	if err == nil && code != 230 {
+		err = fmt.Errorf("%d %s", code, msg)
+	}
+	if err != nil {
+		c.Close()
+		return nil, fmt.Errorf("ftp login failed: %w", err)
+	}
+
+	if _, _, err := c.cmd(200, "TYPE I"); err != nil {
+		c.Close()
+		return nil, fmt.Errorf("failed to switch to binary mode: %w", err)
+	}
+
+	return c, nil
+}
+
+func (c *ftpConn) cmd(expect int, format string, args ...interface{}) (int, string, error) {
+	if err := c.text.PrintfLine(format, args...); err != nil {
+		return 0, "", err
+	}
+
+	return c.text.ReadResponse(expect)
+}
+
+// size returns size of given file, -1 if server does not support SIZE.
+func (c *ftpConn) size(p string) int64 {
+	_, msg, err := c.cmd(213, "SIZE %s", p)
+	if err != nil {
+		return -1
+	}
+
+	size, err := strconv.ParseInt(strings.TrimSpace(msg), 10, 64)
+	if err != nil {
+		return -1
+	}
+
+	return size
+}
+
+// openData opens a passive data connection. The address announced by server is ignored
+// except for the port, data is always fetched from the control host so that it is covered
+// by the same SSRF check.
+func (c *ftpConn) openData(ctx context.Context) (net.Conn, error) {
+	if c.data != nil {
+		c.data.Close()
+		c.data = nil
+	}
+
+	port := ""
+	if _, msg, err := c.cmd(229, "EPSV"); err == nil {
+		// Entering Extended Passive Mode (|||6446|)
+		start, end := strings.Index(msg, "("), strings.LastIndex(msg, ")")
+		if start >= 0 && end > start {
+			if fields := strings.Split(msg[start+1:end], "|"); len(fields) == 5 {
+				port = fields[3]
+			}
+		}
+	}
+
+	if port == "" {
+		_, msg, err := c.cmd(227, "PASV")
+		if err != nil {
+			return nil, fmt.Errorf("failed to enter passive mode: %w", err)
+		}
+
+		// Entering Passive Mode (h1,h2,h3,h4,p1,p2)
+		start, end := strings.Index(msg, "("), strings.LastIndex(msg, ")")
+		if start < 0 || end <= start {
+			return nil, fmt.Errorf("invalid PASV response %q", msg)
+		}
+
+		fields := strings.Split(msg[start+1:end], ",")
+		if len(fields) != 6 {
+			return nil, fmt.Errorf("invalid PASV response %q", msg)
+		}
+
+		p1, err1 := strconv.Atoi(strings.TrimSpace(fields[4]))
+		p2, err2 := strconv.Atoi(strings.TrimSpace(fields[5]))
+		if err1 != nil || err2 != nil {
+			return nil, fmt.Errorf("invalid PASV response %q", msg)
+		}
+		port = strconv.Itoa(p1<<8 | p2)
+	}
+
+	conn, err := c.dial(ctx, "tcp", net.JoinHostPort(c.host, port))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open data connection: %w", err)
+	}
+
+	c.data = conn
+	return conn, nil
+}
+
+// retrieve starts transferring given file from offset.
+func (c *ftpConn) retrieve(ctx context.Context, p string, offset int64) (io.Reader, error) {
+	data, err := c.openData(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if offset > 0 {
+		if _, _, err := c.cmd(350, "REST %d", offset); err != nil {
+			return nil, fmt.Errorf("server does not support resume: %w", err)
+		}
+	}
+
+	if _, _, err := c.cmd(1, "RETR %s", p); err != nil {
+		return nil, fmt.Errorf("failed to retrieve file: %w", err)
+	}
+
+	return data, nil
+}
+
+// finish closes the data connection and reads the transfer result.
+func (c *ftpConn) finish() error {
+	if c.data != nil {
+		c.data.Close()
+		c.data = nil
+	}
+
+	_, _, err := c.text.ReadResponse(2)
+	return err
+}
+
+func (c *ftpConn) Close() error {
+	if c.data != nil {
+		c.data.Close()
+	}
+
+	return c.raw.Close()
+}
+
+func (j *job) downloadFTP(ctx context.Context, u *url.URL) error {
+	p := u.Path
+	if p == "" || strings.HasSuffix(p, "/") {
+		return fmt.Errorf("ftp url %q does not point to a file", u.Redacted())
+	}
+
+	return j.withRetry(ctx, func() error {
+		c, err := dialFTP(ctx, u, j.dial, j.meta.Options.Username, j.meta.Options.Password)
+		if err != nil {
+			return err
+		}
+		defer c.Close()
+		stop := context.AfterFunc(ctx, func() { c.Close() })
+		defer stop()
+
+		if j.meta.Segments == nil {
+			total := c.size(p)
+			j.mu.Lock()
+			j.meta.Name = sanitizeFileName(path.Base(p))
+			j.meta.Total = total
+			j.meta.Segments = planSegments(total, false, 1)
+			j.mu.Unlock()
+		}
+
+		j.resetIfMissing()
+		s := j.meta.Segments[0]
+		offset := atomic.LoadInt64(&s.Done)
+		body, err := c.retrieve(ctx, p, offset)
+		if err != nil && offset > 0 {
+			// Start over if server refuses to resume.
+			j.l.Debug("Native download task %q cannot resume from %d: %s", j.id, offset, err)
+			offset = 0
+			atomic.StoreInt64(&s.Done, 0)
+			body, err = c.retrieve(ctx, p, 0)
+		}
+		if err != nil {
+			return err
+		}
+
+		f, err := os.OpenFile(j.filePath(), os.O_CREATE|os.O_WRONLY, 0644)
+		if err != nil {
+			return fmt.Errorf("failed to open file: %w", err)
+		}
+		defer f.Close()
+
+		if err := f.Truncate(offset); err != nil {
+			return fmt.Errorf("failed to truncate file: %w", err)
+		}
+
+		if _, err := io.Copy(&progressWriter{f: f, offset: offset, done: &s.Done}, body); err != nil {
+			return err
+		}
+
+		if err := c.finish(); err != nil {
+			return fmt.Errorf("ftp transfer failed: %w", err)
+		}
+
+		if s.End >= 0 && atomic.LoadInt64(&s.Done) <= s.End {
+			return io.ErrUnexpectedEOF
+		}
+
+		return nil
+	})
+}
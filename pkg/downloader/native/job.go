@@ -0,0 +1,578 @@
+package native
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/downloader"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+)
+
+const (
+	// minSegmentSize is the smallest range assigned to one connection.
+	minSegmentSize  = 4 << 20
+	maxRedirects    = 10
+	segmentMaxRetry = 5
+	persistInterval = time.Second
+	defaultFileName = "download"
+)
+
+type (
+	jobOptions struct {
+		Header      map[string]string `json:"header,omitempty"`
+		Username    string            `json:"username,omitempty"`
+		Password    string            `json:"password,omitempty"`
+		Connections int               `json:"connections,omitempty"`
+	}
+
+	// segment is a byte range of the target file fetched by one connection.
+	segment struct {
+		Start int64 `json:"start"`
+		// End is the inclusive end offset, -1 if size of the file is unknown.
+		End  int64 `json:"end"`
+		Done int64 `json:"done"`
+	}
+
+	// remoteFile is a file found under a folder source, e.g. a Cloudreve share or WebDAV folder.
+	remoteFile struct {
+		// Name is the slash separated path relative to the download folder.
+		Name string `json:"name"`
+		// Ref locates the file in the source, e.g. its WebDAV URL or Cloudreve URI.
+		Ref  string `json:"ref"`
+		Size int64  `json:"size"`
+		Done int64  `json:"done"`
+		Skip bool   `json:"skip,omitempty"`
+	}
+
+	// jobMeta is persisted next to the download folder so that tasks can be resumed after restart.
+	jobMeta struct {
+		URL     string     `json:"url"`
+		Kind    sourceKind `json:"kind,omitempty"`
+		Options jobOptions `json:"options"`
+		Name    string     `json:"name,omitempty"`
+		// Total is the size of the file, -1 if unknown.
+		Total    int64             `json:"total"`
+		Ranged   bool              `json:"ranged,omitempty"`
+		Segments []*segment        `json:"segments,omitempty"`
+		Listed   bool              `json:"listed,omitempty"`
+		Files    []*remoteFile     `json:"files,omitempty"`
+		State    downloader.Status `json:"state"`
+		Error    string            `json:"error,omitempty"`
+	}
+
+	job struct {
+		l        logging.Logger
+		id       string
+		dir      string
+		metaPath string
+		ssrf     request.SSRFOptions
+		dial     func(ctx context.Context, network, addr string) (net.Conn, error)
+		client   *http.Client
+
+		mu     sync.Mutex
+		meta   *jobMeta
+		speed  int64
+		cancel context.CancelFunc
+		done   chan struct{}
+	}
+)
+
+func newJob(l logging.Logger, id, base string, meta *jobMeta, ssrf request.SSRFOptions) *job {
+	j := &job{
+		l:        l,
+		id:       id,
+		dir:      filepath.Join(base, id),
+		metaPath: filepath.Join(base, id+metaFileExt),
+		ssrf:     ssrf,
+		meta:     meta,
+		done:     make(chan struct{}),
+		dial: request.SafeDialContext(ssrf, &net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}),
+	}
+
+	transport := &http.Transport{
+		DialContext:           j.dial,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConnsPerHost:   maxConnections,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ResponseHeaderTimeout: time.Minute,
+	}
+	if ssrf.Disabled {
+		// Proxies would hide the real target from SSRF check, only use them when it is disabled.
+		transport.Proxy = http.ProxyFromEnvironment
+	}
+
+	j.client = &http.Client{
+		Transport: transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= maxRedirects {
+				return fmt.Errorf("stopped after %d redirects", maxRedirects)
+			}
+
+			if scheme := strings.ToLower(req.URL.Scheme); scheme != "http" && scheme != "https" {
+				return fmt.Errorf("redirect to unsupported scheme %q", req.URL.Scheme)
+			}
+
+			return request.ValidateExternalURL(req.Context(), req.URL.String(), ssrf)
+		},
+	}
+
+	return j
+}
+
+func (j *job) start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	j.cancel = cancel
+	go j.run(ctx)
+}
+
+// stop cancels the running download and waits for it to exit.
+func (j *job) stop() {
+	if j.cancel == nil {
+		return
+	}
+
+	j.cancel()
+	<-j.done
+}
+
+func (j *job) run(ctx context.Context) {
+	defer close(j.done)
+
+	stopMonitor := make(chan struct{})
+	monitorDone := make(chan struct{})
+	go j.monitor(stopMonitor, monitorDone)
+
+	u, err := url.Parse(j.meta.URL)
+	if err == nil {
+		switch j.meta.Kind {
+		case sourceKindShare:
+			err = j.downloadFolder(ctx, newShareSource(j, u))
+		case sourceKindWebDAV:
+			err = j.downloadFolder(ctx, newWebDAVSource(j, u))
+		default:
+			if strings.ToLower(u.Scheme) == "ftp" {
+				err = j.downloadFTP(ctx, u)
+			} else {
+				err = j.downloadHTTP(ctx, u)
+			}
+		}
+	}
+
+	close(stopMonitor)
+	<-monitorDone
+	if ctx.Err() != nil {
+		// Canceled, files will be removed by caller.
+		return
+	}
+
+	j.mu.Lock()
+	if err != nil {
+		j.l.Warning("Native download task %q failed: %s", j.id, err)
+		j.meta.State = downloader.StatusError
+		j.meta.Error = err.Error()
+	} else {
+		j.l.Info("Native download task %q completed.", j.id)
+		j.meta.State = downloader.StatusCompleted
+		if j.meta.Total < 0 {
+			j.meta.Total = j.downloaded()
+		}
+	}
+	j.mu.Unlock()
+
+	atomic.StoreInt64(&j.speed, 0)
+	if err := j.persist(); err != nil {
+		j.l.Warning("Failed to save native download task %q: %s", j.id, err)
+	}
+}
+
+// monitor samples download speed and periodically persists progress.
+func (j *job) monitor(stop <-chan struct{}, done chan<- struct{}) {
+	defer close(done)
+	ticker := time.NewTicker(persistInterval)
+	defer ticker.Stop()
+
+	last := j.downloaded()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			current := j.downloaded()
+			atomic.StoreInt64(&j.speed, int64(float64(current-last)/persistInterval.Seconds()))
+			last = current
+			if err := j.persist(); err != nil {
+				j.l.Warning("Failed to save native download task %q: %s", j.id, err)
+			}
+		}
+	}
+}
+
+func (j *job) downloaded() int64 {
+	j.mu.Lock()
+	segments, files := j.meta.Segments, j.meta.Files
+	j.mu.Unlock()
+
+	var res int64
+	for _, s := range segments {
+		res += atomic.LoadInt64(&s.Done)
+	}
+	for _, f := range files {
+		res += atomic.LoadInt64(&f.Done)
+	}
+	return res
+}
+
+func (j *job) status() *downloader.TaskStatus {
+	downloaded := j.downloaded()
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	res := &downloader.TaskStatus{
+		SavePath:      filepath.ToSlash(j.dir),
+		Name:          j.meta.Name,
+		State:         j.meta.State,
+		Downloaded:    downloaded,
+		DownloadSpeed: atomic.LoadInt64(&j.speed),
+		ErrorMessage:  j.meta.Error,
+	}
+
+	// Size is reported once known, so that capacity is only validated against the final size.
+	if j.meta.Total > 0 {
+		res.Total = j.meta.Total
+	}
+
+	if j.meta.Listed {
+		// Only selected files are counted, unknown size of any file leaves total unknown.
+		res.Total = 0
+		for _, f := range j.meta.Files {
+			if f.Skip {
+				continue
+			}
+			if f.Size < 0 {
+				res.Total = 0
+				break
+			}
+			res.Total += f.Size
+		}
+
+		res.Files = make([]downloader.TaskFile, 0, len(j.meta.Files))
+		for i, f := range j.meta.Files {
+			progress := 0.0
+			if f.Size > 0 {
+				progress = float64(atomic.LoadInt64(&f.Done)) / float64(f.Size)
+			}
+			res.Files = append(res.Files, downloader.TaskFile{
+				Index:    i,
+				Name:     f.Name,
+				Size:     f.Size,
+				Progress: progress,
+				Selected: !f.Skip,
+			})
+		}
+	} else if j.meta.Name != "" {
+		progress := 0.0
+		if res.Total > 0 {
+			progress = float64(downloaded) / float64(res.Total)
+		}
+		res.Files = []downloader.TaskFile{{
+			Index:    0,
+			Name:     j.meta.Name,
+			Size:     res.Total,
+			Progress: progress,
+			Selected: true,
+		}}
+	}
+
+	return res
+}
+
+func (j *job) persist() error {
+	j.mu.Lock()
+	snapshot := *j.meta
+	snapshot.Segments = make([]*segment, len(j.meta.Segments))
+	for i, s := range j.meta.Segments {
+		snapshot.Segments[i] = &segment{Start: s.Start, End: s.End, Done: atomic.LoadInt64(&s.Done)}
+	}
+	snapshot.Files = make([]*remoteFile, len(j.meta.Files))
+	for i, f := range j.meta.Files {
+		snapshot.Files[i] = &remoteFile{Name: f.Name, Ref: f.Ref, Size: f.Size, Done: atomic.LoadInt64(&f.Done), Skip: f.Skip}
+	}
+	j.mu.Unlock()
+
+	content, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+
+	tmp := j.metaPath + ".tmp"
+	if err := os.WriteFile(tmp, content, 0600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, j.metaPath)
+}
+
+func (j *job) filePath() string {
+	return filepath.Join(j.dir, j.meta.Name)
+}
+
+func (j *job) downloadHTTP(ctx context.Context, u *url.URL) error {
+	if j.meta.Segments == nil {
+		if err := j.probe(ctx, u); err != nil {
+			return err
+		}
+	}
+
+	j.resetIfMissing()
+	f, err := os.OpenFile(j.filePath(), os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open file: %w", err)
+	}
+	defer f.Close()
+
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	for _, s := range j.meta.Segments {
+		if s.End >= 0 && s.Start+atomic.LoadInt64(&s.Done) > s.End {
+			continue
+		}
+
+		wg.Add(1)
+		go func(s *segment) {
+			defer wg.Done()
+			if err := j.withRetry(ctx, func() error {
+				return j.fetchSegment(ctx, u, f, s)
+			}); err != nil {
+				errOnce.Do(func() {
+					firstErr = err
+					cancel()
+				})
+			}
+		}(s)
+	}
+
+	wg.Wait()
+	return firstErr
+}
+
+// probe sends a single byte ranged request to detect file name, size and range support,
+// then splits the file into segments.
+func (j *job) probe(ctx context.Context, u *url.URL) error {
+	req, err := j.newRequest(ctx, u)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Range", "bytes=0-0")
+
+	resp, err := j.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to request %q: %w", u.Redacted(), err)
+	}
+	defer resp.Body.Close()
+
+	total := int64(-1)
+	ranged := false
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+		// Content-Range: bytes 0-0/1234
+		if _, size, ok := strings.Cut(resp.Header.Get("Content-Range"), "/"); ok {
+			if parsed, err := strconv.ParseInt(strings.TrimSpace(size), 10, 64); err == nil {
+				total = parsed
+				ranged = true
+			}
+		}
+	case http.StatusOK:
+		total = resp.ContentLength
+	default:
+		return fmt.Errorf("unexpected status code %d from %q", resp.StatusCode, u.Redacted())
+	}
+
+	name := ""
+	if _, params, err := mime.ParseMediaType(resp.Header.Get("Content-Disposition")); err == nil {
+		name = params["filename"]
+	}
+	if name == "" {
+		name = path.Base(resp.Request.URL.Path)
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.meta.Name = sanitizeFileName(name)
+	j.meta.Total = total
+	j.meta.Ranged = ranged
+	j.meta.Segments = planSegments(total, ranged, j.meta.Options.Connections)
+	return nil
+}
+
+func (j *job) fetchSegment(ctx context.Context, u *url.URL, f *os.File, s *segment) error {
+	if !j.meta.Ranged {
+		// Servers without range support can only be downloaded from start.
+		atomic.StoreInt64(&s.Done, 0)
+		if err := f.Truncate(0); err != nil {
+			return fmt.Errorf("failed to truncate file: %w", err)
+		}
+	}
+
+	req, err := j.newRequest(ctx, u)
+	if err != nil {
+		return err
+	}
+
+	start := s.Start + atomic.LoadInt64(&s.Done)
+	if j.meta.Ranged {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", start, s.End))
+	}
+
+	resp, err := j.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to request %q: %w", u.Redacted(), err)
+	}
+	defer resp.Body.Close()
+
+	expected := http.StatusOK
+	if j.meta.Ranged {
+		expected = http.StatusPartialContent
+	}
+	if resp.StatusCode != expected {
+		return fmt.Errorf("unexpected status code %d from %q", resp.StatusCode, u.Redacted())
+	}
+
+	var body io.Reader = resp.Body
+	if s.End >= 0 {
+		body = io.LimitReader(resp.Body, s.End-start+1)
+	}
+
+	if _, err := io.Copy(&progressWriter{f: f, offset: start, done: &s.Done}, body); err != nil {
+		return err
+	}
+
+	if s.End >= 0 && s.Start+atomic.LoadInt64(&s.Done) <= s.End {
+		return io.ErrUnexpectedEOF
+	}
+
+	return nil
+}
+
+// resetIfMissing discards recorded progress if the partially downloaded file is gone.
+func (j *job) resetIfMissing() {
+	if _, err := os.Stat(j.filePath()); os.IsNotExist(err) {
+		for _, s := range j.meta.Segments {
+			atomic.StoreInt64(&s.Done, 0)
+		}
+	}
+}
+
+func (j *job) newRequest(ctx context.Context, u *url.URL) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	for k, v := range j.meta.Options.Header {
+		req.Header.Set(k, v)
+	}
+
+	if j.meta.Options.Username != "" {
+		req.SetBasicAuth(j.meta.Options.Username, j.meta.Options.Password)
+	}
+
+	return req, nil
+}
+
+// withRetry calls f until it succeeds, the job is canceled or retry limit is reached.
+func (j *job) withRetry(ctx context.Context, f func() error) error {
+	for attempt := 1; ; attempt++ {
+		err := f()
+		if err == nil || ctx.Err() != nil {
+			return err
+		}
+
+		if attempt >= segmentMaxRetry {
+			return fmt.Errorf("failed after %d attempts: %w", attempt, err)
+		}
+
+		j.l.Debug("Native download task %q attempt %d failed: %s, retrying...", j.id, attempt, err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Duration(attempt) * time.Second):
+		}
+	}
+}
+
+// planSegments splits a file into at most `connections` segments, no smaller than minSegmentSize.
+func planSegments(total int64, ranged bool, connections int) []*segment {
+	if !ranged || total <= 0 {
+		end := total - 1
+		if total < 0 {
+			end = -1
+		}
+		return []*segment{{Start: 0, End: end}}
+	}
+
+	n := int64(max(connections, 1))
+	if maxN := (total + minSegmentSize - 1) / minSegmentSize; n > maxN {
+		n = maxN
+	}
+
+	size := total / n
+	res := make([]*segment, 0, n)
+	for i := int64(0); i < n; i++ {
+		end := (i+1)*size - 1
+		if i == n-1 {
+			end = total - 1
+		}
+		res = append(res, &segment{Start: i * size, End: end})
+	}
+
+	return res
+}
+
+func sanitizeFileName(name string) string {
+	name = path.Base(strings.ReplaceAll(name, "\\", "/"))
+	if name == "." || name == "/" || name == ".." || name == "" {
+		return defaultFileName
+	}
+
+	return name
+}
+
+// progressWriter writes into the file from given offset and records written bytes.
+type progressWriter struct {
+	f      *os.File
+	offset int64
+	done   *int64
+}
+
+func (w *progressWriter) Write(p []byte) (int, error) {
+	n, err := w.f.WriteAt(p, w.offset)
+	w.offset += int64(n)
+	atomic.AddInt64(w.done, int64(n))
+	return n, err
+}
@@ -0,0 +1,274 @@
+package native
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/downloader"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/gofrs/uuid"
+)
+
+const (
+	NativeTempFolder = "native"
+	metaFileExt      = ".json"
+
+	defaultConnections = 4
+	maxConnections     = 16
+)
+
+var (
+	// jobs holds downloads running or finished in this process, keyed by task handle ID.
+	// Downloader instances are created per request, so the registry must be global.
+	jobs   = make(map[string]*job)
+	jobsMu sync.Mutex
+)
+
+type nativeClient struct {
+	l        logging.Logger
+	settings setting.Provider
+	options  *types.NativeDownloaderSetting
+	ssrf     request.SSRFOptions
+}
+
+// New creates a built-in downloader for HTTP(S) and FTP links, Cloudreve share links and
+// WebDAV folders. Every connection it makes, including redirects and FTP data channels,
+// is checked against given SSRF policy.
+func New(l logging.Logger, settings setting.Provider, options *types.NativeDownloaderSetting, ssrf request.SSRFOptions) downloader.Downloader {
+	if options == nil {
+		options = &types.NativeDownloaderSetting{}
+	}
+
+	return &nativeClient{
+		l:        l,
+		settings: settings,
+		options:  options,
+		ssrf:     ssrf,
+	}
+}
+
+func (c *nativeClient) CreateTask(ctx context.Context, rawUrl string, options map[string]interface{}) (*downloader.TaskHandle, error) {
+	u, err := url.Parse(strings.TrimSpace(rawUrl))
+	if err != nil {
+		return nil, fmt.Errorf("invalid url: %w", err)
+	}
+
+	switch strings.ToLower(u.Scheme) {
+	case "http", "https", "ftp", "webdav", "webdavs", "cloudreve":
+	default:
+		return nil, fmt.Errorf("unsupported scheme %q", u.Scheme)
+	}
+
+	if err := request.ValidateExternalURL(ctx, u.String(), c.ssrf); err != nil {
+		return nil, err
+	}
+
+	// Node options are overwritten by task options
+	merged := make(map[string]interface{})
+	for k, v := range c.options.Options {
+		merged[k] = v
+	}
+	for k, v := range options {
+		merged[k] = v
+	}
+
+	opts := parseOptions(merged)
+	if opts.Connections <= 0 {
+		opts.Connections = c.options.Connections
+	}
+	if opts.Connections <= 0 {
+		opts.Connections = defaultConnections
+	}
+	opts.Connections = min(opts.Connections, maxConnections)
+
+	guid, _ := uuid.NewV4()
+	id := guid.String()
+	base := c.tempPath(ctx)
+	if err := os.MkdirAll(filepath.Join(base, id), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create temp folder: %w", err)
+	}
+
+	c.l.Info("Creating native download task with url %q saving to %q...", u.Redacted(), filepath.Join(base, id))
+	j := newJob(c.l, id, base, &jobMeta{
+		URL:     u.String(),
+		Kind:    detectKind(u),
+		Options: opts,
+		State:   downloader.StatusDownloading,
+	}, c.ssrf)
+	if err := j.persist(); err != nil {
+		_ = os.RemoveAll(j.dir)
+		return nil, fmt.Errorf("failed to save task meta: %w", err)
+	}
+
+	jobsMu.Lock()
+	jobs[id] = j
+	jobsMu.Unlock()
+	j.start()
+
+	return &downloader.TaskHandle{
+		ID: id,
+	}, nil
+}
+
+func (c *nativeClient) Info(ctx context.Context, handle *downloader.TaskHandle) (*downloader.TaskStatus, error) {
+	j, err := c.load(ctx, handle, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return j.status(), nil
+}
+
+func (c *nativeClient) Cancel(ctx context.Context, handle *downloader.TaskHandle) error {
+	j, err := c.load(ctx, handle, false)
+	if err != nil {
+		return err
+	}
+
+	j.stop()
+	jobsMu.Lock()
+	delete(jobs, handle.ID)
+	jobsMu.Unlock()
+
+	if err := os.RemoveAll(j.dir); err != nil {
+		c.l.Warning("Failed to delete temp download folder: %q: %s", j.dir, err)
+	}
+	if err := os.Remove(j.metaPath); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to delete task meta: %w", err)
+	}
+
+	return nil
+}
+
+func (c *nativeClient) SetFilesToDownload(ctx context.Context, handle *downloader.TaskHandle, args ...*downloader.SetFileToDownloadArgs) error {
+	j, err := c.load(ctx, handle, false)
+	if err != nil {
+		return err
+	}
+
+	// Only folder sources contain multiple files.
+	j.mu.Lock()
+	for _, arg := range args {
+		if arg.Index >= 0 && arg.Index < len(j.meta.Files) {
+			j.meta.Files[arg.Index].Skip = !arg.Download
+		}
+	}
+	j.mu.Unlock()
+
+	return j.persist()
+}
+
+func (c *nativeClient) Test(ctx context.Context) (string, error) {
+	base := c.tempPath(ctx)
+	if err := os.MkdirAll(base, 0755); err != nil {
+		return "", fmt.Errorf("temp path %q is not writable: %w", base, err)
+	}
+
+	return "built-in", nil
+}
+
+// load returns the job of given handle from registry, or restores it from the meta file
+// persisted in temp folder. Unfinished jobs restored from disk are resumed if `resume` is true.
+func (c *nativeClient) load(ctx context.Context, handle *downloader.TaskHandle, resume bool) (*job, error) {
+	if _, err := uuid.FromString(handle.ID); err != nil {
+		return nil, fmt.Errorf("invalid task id %q: %w", handle.ID, downloader.ErrTaskNotFount)
+	}
+
+	jobsMu.Lock()
+	defer jobsMu.Unlock()
+	if j, ok := jobs[handle.ID]; ok {
+		return j, nil
+	}
+
+	base := c.tempPath(ctx)
+	content, err := os.ReadFile(filepath.Join(base, handle.ID+metaFileExt))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, downloader.ErrTaskNotFount
+		}
+		return nil, fmt.Errorf("failed to read task meta: %w", err)
+	}
+
+	meta := &jobMeta{}
+	if err := json.Unmarshal(content, meta); err != nil {
+		return nil, fmt.Errorf("failed to parse task meta: %w", err)
+	}
+
+	j := newJob(c.l, handle.ID, base, meta, c.ssrf)
+	if resume && meta.State == downloader.StatusDownloading {
+		c.l.Info("Resuming native download task %q...", handle.ID)
+		jobs[handle.ID] = j
+		j.start()
+	}
+
+	return j, nil
+}
+
+func (c *nativeClient) tempPath(ctx context.Context) string {
+	base := util.RelativePath(c.options.TempPath)
+	if c.options.TempPath == "" {
+		base = util.DataPath(c.settings.TempPath(ctx))
+	}
+
+	return filepath.Join(base, NativeTempFolder)
+}
+
+// parseOptions reads supported task options:
+//   - header: extra request headers, either "Key: Value" lines, a list of them or a map;
+//   - cookie: value of Cookie header;
+//   - username/password: credential for HTTP basic auth or FTP login;
+//   - connections: max parallel ranged requests.
+func parseOptions(options map[string]interface{}) jobOptions {
+	res := jobOptions{Header: make(map[string]string)}
+	addHeaderLine := func(line string) {
+		if k, v, ok := strings.Cut(line, ":"); ok && strings.TrimSpace(k) != "" {
+			res.Header[strings.TrimSpace(k)] = strings.TrimSpace(v)
+		}
+	}
+
+	switch v := options["header"].(type) {
+	case string:
+		for _, line := range strings.Split(v, "\n") {
+			addHeaderLine(line)
+		}
+	case []string:
+		for _, line := range v {
+			addHeaderLine(line)
+		}
+	case []interface{}:
+		for _, line := range v {
+			addHeaderLine(fmt.Sprint(line))
+		}
+	case map[string]interface{}:
+		for k, val := range v {
+			res.Header[k] = fmt.Sprint(val)
+		}
+	}
+
+	if cookie, ok := options["cookie"]; ok && fmt.Sprint(cookie) != "" {
+		res.Header["Cookie"] = fmt.Sprint(cookie)
+	}
+	if username, ok := options["username"]; ok {
+		res.Username = fmt.Sprint(username)
+	}
+	if password, ok := options["password"]; ok {
+		res.Password = fmt.Sprint(password)
+	}
+	if connections, ok := options["connections"]; ok {
+		res.Connections, _ = strconv.Atoi(fmt.Sprint(connections))
+	}
+
+	return res
+}
@@ -0,0 +1,190 @@
+package native
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/downloader"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fileServer serves content at /file.bin with range support and records Range headers of
+// requests, /redirect redirects to given location.
+type fileServer struct {
+	*httptest.Server
+	mu     sync.Mutex
+	ranges []string
+}
+
+func newFileServer(t *testing.T, content []byte, redirect string) *fileServer {
+	s := &fileServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/file.bin":
+			s.mu.Lock()
+			s.ranges = append(s.ranges, r.Header.Get("Range"))
+			s.mu.Unlock()
+			http.ServeContent(w, r, "file.bin", time.Time{}, bytes.NewReader(content))
+		case "/redirect":
+			http.Redirect(w, r, redirect, http.StatusFound)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *fileServer) requestedRanges() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.ranges...)
+}
+
+func newTestClient(t *testing.T, connections int) (*nativeClient, string) {
+	tempPath := t.TempDir()
+	c := New(logging.NewConsoleLogger(logging.LevelError), nil, &types.NativeDownloaderSetting{
+		TempPath:    tempPath,
+		Connections: connections,
+	}, request.SSRFOptions{AllowedCIDRs: []string{"127.0.0.1/32"}})
+	return c.(*nativeClient), filepath.Join(tempPath, NativeTempFolder)
+}
+
+func randomContent(size int) []byte {
+	content := make([]byte, size)
+	rand.New(rand.NewSource(1)).Read(content)
+	return content
+}
+
+// waitFinished polls the task until it leaves downloading state.
+func waitFinished(t *testing.T, c *nativeClient, handle *downloader.TaskHandle) *downloader.TaskStatus {
+	t.Helper()
+
+	var status *downloader.TaskStatus
+	require.Eventually(t, func() bool {
+		var err error
+		status, err = c.Info(t.Context(), handle)
+		require.NoError(t, err)
+		return status.State != downloader.StatusDownloading
+	}, 30*time.Second, 20*time.Millisecond)
+	t.Cleanup(func() {
+		_ = c.Cancel(t.Context(), handle)
+	})
+
+	return status
+}
+
+func TestPlanSegments(t *testing.T) {
+	a := assert.New(t)
+
+	a.Equal([]*segment{{Start: 0, End: -1}}, planSegments(-1, false, 4))
+	a.Equal([]*segment{{Start: 0, End: 99}}, planSegments(100, false, 4))
+	a.Equal([]*segment{{Start: 0, End: 99}}, planSegments(100, true, 4), "small file is not split")
+
+	segments := planSegments(9<<20, true, 4)
+	a.Len(segments, 3, "segments are no smaller than minSegmentSize")
+	a.Equal(int64(0), segments[0].Start)
+	for i := 1; i < len(segments); i++ {
+		a.Equal(segments[i-1].End+1, segments[i].Start)
+	}
+	a.Equal(int64(9<<20-1), segments[2].End)
+}
+
+func TestDownloadHTTPRanged(t *testing.T) {
+	a := assert.New(t)
+	content := randomContent(9 << 20)
+	srv := newFileServer(t, content, "")
+	c, base := newTestClient(t, 4)
+
+	handle, err := c.CreateTask(t.Context(), srv.URL+"/file.bin", nil)
+	require.NoError(t, err)
+	status := waitFinished(t, c, handle)
+	require.Equal(t, downloader.StatusCompleted, status.State, status.ErrorMessage)
+	a.Equal("file.bin", status.Name)
+	a.Equal(int64(len(content)), status.Total)
+
+	downloaded, err := os.ReadFile(filepath.Join(base, handle.ID, "file.bin"))
+	require.NoError(t, err)
+	a.True(bytes.Equal(content, downloaded))
+	a.ElementsMatch([]string{
+		"bytes=0-0",
+		"bytes=0-3145727",
+		"bytes=3145728-6291455",
+		"bytes=6291456-9437183",
+	}, srv.requestedRanges())
+}
+
+func TestDownloadHTTPResume(t *testing.T) {
+	a := assert.New(t)
+	content := randomContent(9 << 20)
+	srv := newFileServer(t, content, "")
+	c, base := newTestClient(t, 4)
+
+	// Persist a task interrupted with the first segment partially and the last one fully downloaded.
+	guid, _ := uuid.NewV4()
+	id := guid.String()
+	segments := planSegments(int64(len(content)), true, 4)
+	segments[0].Done = 1 << 20
+	segments[2].Done = segments[2].End - segments[2].Start + 1
+	meta := &jobMeta{
+		URL:      srv.URL + "/file.bin",
+		Kind:     sourceKindDirect,
+		Options:  jobOptions{Connections: 4},
+		Name:     "file.bin",
+		Total:    int64(len(content)),
+		Ranged:   true,
+		Segments: segments,
+		State:    downloader.StatusDownloading,
+	}
+	metaContent, err := json.Marshal(meta)
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Join(base, id), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(base, id+metaFileExt), metaContent, 0600))
+
+	partial := make([]byte, len(content))
+	copy(partial[:1<<20], content)
+	copy(partial[segments[2].Start:], content[segments[2].Start:])
+	require.NoError(t, os.WriteFile(filepath.Join(base, id, "file.bin"), partial, 0644))
+
+	status := waitFinished(t, c, &downloader.TaskHandle{ID: id})
+	require.Equal(t, downloader.StatusCompleted, status.State, status.ErrorMessage)
+
+	downloaded, err := os.ReadFile(filepath.Join(base, id, "file.bin"))
+	require.NoError(t, err)
+	a.True(bytes.Equal(content, downloaded))
+	a.ElementsMatch([]string{
+		"bytes=1048576-3145727",
+		"bytes=3145728-6291455",
+	}, srv.requestedRanges(), "only missing ranges are requested")
+}
+
+func TestDownloadHTTPRedirectToPrivateAddress(t *testing.T) {
+	a := assert.New(t)
+	c, _ := newTestClient(t, 1)
+
+	for _, target := range []string{"http://10.0.0.1/file.bin", "http://169.254.169.254/latest/meta-data"} {
+		srv := newFileServer(t, nil, target)
+		handle, err := c.CreateTask(t.Context(), srv.URL+"/redirect", nil)
+		require.NoError(t, err)
+
+		status := waitFinished(t, c, handle)
+		a.Equal(downloader.StatusError, status.State, target)
+		a.Contains(status.ErrorMessage, request.ErrUnsafeURL.Error(), target)
+	}
+
+	_, err := c.CreateTask(t.Context(), "http://10.0.0.1/file.bin", nil)
+	a.ErrorIs(err, request.ErrUnsafeURL, "private address is rejected on creation")
+}
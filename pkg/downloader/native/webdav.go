@@ -0,0 +1,187 @@
+package native
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+)
+
+const propfindBody = `<?xml version="1.0" encoding="utf-8"?><d:propfind xmlns:d="DAV:"><d:prop><d:resourcetype/><d:getcontentlength/></d:prop></d:propfind>`
+
+type (
+	// webdavSource lists and downloads files under a WebDAV collection. webdav:// and
+	// webdavs:// URLs are requested over http and https respectively.
+	webdavSource struct {
+		j    *job
+		root *url.URL
+	}
+
+	multiStatus struct {
+		Responses []davResponse `xml:"response"`
+	}
+
+	davResponse struct {
+		Href     string `xml:"href"`
+		Propstat []struct {
+			Prop struct {
+				ResourceType struct {
+					Collection *struct{} `xml:"collection"`
+				} `xml:"resourcetype"`
+				ContentLength string `xml:"getcontentlength"`
+			} `xml:"prop"`
+			Status string `xml:"status"`
+		} `xml:"propstat"`
+	}
+
+	davEntry struct {
+		url        *url.URL
+		collection bool
+		size       int64
+	}
+)
+
+func newWebDAVSource(j *job, u *url.URL) *webdavSource {
+	root := *u
+	root.Scheme = "http"
+	if strings.ToLower(u.Scheme) == "webdavs" {
+		root.Scheme = "https"
+	}
+
+	// Credential in URL is moved into options so that it is sent as basic auth.
+	if root.User != nil {
+		if j.meta.Options.Username == "" {
+			j.meta.Options.Username = root.User.Username()
+			j.meta.Options.Password, _ = root.User.Password()
+		}
+		root.User = nil
+	}
+
+	return &webdavSource{j: j, root: &root}
+}
+
+func (s *webdavSource) list(ctx context.Context) (string, []*remoteFile, error) {
+	res := make([]*remoteFile, 0)
+	rootPath := s.root.Path
+	name := path.Base(strings.TrimSuffix(rootPath, "/"))
+	if name == "." || name == "/" || name == "" {
+		name = s.root.Hostname()
+	}
+
+	prefix := strings.TrimSuffix(rootPath, "/") + "/"
+	visited := map[string]bool{}
+	pending := []*url.URL{s.root}
+	for len(pending) > 0 {
+		dir := pending[0]
+		pending = pending[1:]
+		if visited[dir.Path] {
+			continue
+		}
+		visited[dir.Path] = true
+
+		entries, err := s.propfind(ctx, dir)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to list %q: %w", dir.Redacted(), err)
+		}
+
+		for _, e := range entries {
+			if strings.TrimSuffix(e.url.Path, "/") == strings.TrimSuffix(dir.Path, "/") {
+				if dir == s.root && !e.collection {
+					// Root itself is a file.
+					return name, []*remoteFile{{Name: sanitizeFileName(name), Ref: e.url.String(), Size: e.size}}, nil
+				}
+				continue
+			}
+
+			if !strings.HasPrefix(e.url.Path, prefix) {
+				// Not under the root, ignore.
+				continue
+			}
+
+			if e.collection {
+				pending = append(pending, e.url)
+				continue
+			}
+
+			if len(res) >= maxFolderFiles {
+				return "", nil, fmt.Errorf("too many files in source, max %d", maxFolderFiles)
+			}
+			res = append(res, &remoteFile{
+				Name: path.Join(sanitizeFileName(name), sanitizeRelativePath(strings.TrimPrefix(e.url.Path, prefix))),
+				Ref:  e.url.String(),
+				Size: e.size,
+			})
+		}
+	}
+
+	return name, res, nil
+}
+
+func (s *webdavSource) request(ctx context.Context, f *remoteFile) (*http.Request, error) {
+	u, err := url.Parse(f.Ref)
+	if err != nil {
+		return nil, fmt.Errorf("invalid file url: %w", err)
+	}
+
+	return s.j.newRequest(ctx, u)
+}
+
+// propfind lists direct children of given collection, including itself.
+func (s *webdavSource) propfind(ctx context.Context, dir *url.URL) ([]davEntry, error) {
+	req, err := s.j.newRequest(ctx, dir)
+	if err != nil {
+		return nil, err
+	}
+	req.Method = "PROPFIND"
+	req.Body = io.NopCloser(bytes.NewReader([]byte(propfindBody)))
+	req.ContentLength = int64(len(propfindBody))
+	req.Header.Set("Depth", "1")
+	req.Header.Set("Content-Type", "application/xml; charset=utf-8")
+
+	resp, err := s.j.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusMultiStatus {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	ms := &multiStatus{}
+	if err := xml.NewDecoder(io.LimitReader(resp.Body, maxResponseSize)).Decode(ms); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	res := make([]davEntry, 0, len(ms.Responses))
+	for _, r := range ms.Responses {
+		href, err := resp.Request.URL.Parse(strings.TrimSpace(r.Href))
+		if err != nil || href.Host != s.root.Host {
+			continue
+		}
+
+		entry := davEntry{url: href, size: -1}
+		for _, ps := range r.Propstat {
+			if ps.Status != "" && !strings.Contains(ps.Status, " 200") {
+				continue
+			}
+			if ps.Prop.ResourceType.Collection != nil {
+				entry.collection = true
+			}
+			if ps.Prop.ContentLength != "" {
+				if size, err := strconv.ParseInt(strings.TrimSpace(ps.Prop.ContentLength), 10, 64); err == nil {
+					entry.size = size
+				}
+			}
+		}
+		res = append(res, entry)
+	}
+
+	return res, nil
+}
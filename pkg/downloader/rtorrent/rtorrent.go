@@ -0,0 +1,289 @@
+package rtorrent
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/downloader"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/gofrs/uuid"
+)
+
+const (
+	crTagPrefix = "cr-"
+
+	filePrioritySkip   = 0
+	filePriorityNormal = 1
+)
+
+var (
+	// downloadFields are fields of d.multicall2, values in each row are in the same order.
+	downloadFields = []any{
+		"d.hash=", "d.custom1=", "d.name=", "d.size_bytes=", "d.completed_bytes=", "d.down.rate=",
+		"d.up.total=", "d.up.rate=", "d.directory=", "d.is_multi_file=", "d.state=", "d.complete=",
+		"d.is_active=", "d.message=", "d.size_chunks=", "d.bitfield=", "d.hashing=",
+	}
+	fileFields = []any{
+		"f.path=", "f.size_bytes=", "f.completed_chunks=", "f.size_chunks=", "f.priority=",
+	}
+)
+
+const (
+	fieldHash = iota
+	fieldCustom1
+	fieldName
+	fieldSize
+	fieldCompleted
+	fieldDownRate
+	fieldUpTotal
+	fieldUpRate
+	fieldDirectory
+	fieldMultiFile
+	fieldState
+	fieldComplete
+	fieldActive
+	fieldMessage
+	fieldChunks
+	fieldBitfield
+	fieldHashing
+)
+
+type rtorrentClient struct {
+	c        request.Client
+	settings setting.Provider
+	l        logging.Logger
+	options  *types.RTorrentSetting
+}
+
+func NewClient(l logging.Logger, c request.Client, setting setting.Provider, options *types.RTorrentSetting) (downloader.Downloader, error) {
+	if _, err := url.Parse(options.Server); err != nil {
+		return nil, fmt.Errorf("invalid rtorrent server URL: %w", err)
+	}
+
+	c.Apply(request.WithLogger(l))
+	return &rtorrentClient{c: c, options: options, l: l, settings: setting}, nil
+}
+
+func (c *rtorrentClient) CreateTask(ctx context.Context, url string, options map[string]interface{}) (*downloader.TaskHandle, error) {
+	guid, _ := uuid.NewV4()
+
+	// Generate a unique path for the task
+	base := util.RelativePath(c.options.TempPath)
+	if c.options.TempPath == "" {
+		base = util.DataPath(c.settings.TempPath(ctx))
+	}
+	path := filepath.Join(
+		base,
+		"rtorrent",
+		guid.String(),
+	)
+	c.l.Info("Creating rTorrent task with url %q saving to %q...", url, path)
+
+	// Options are passed as post-load commands, e.g. {"d.priority.set": 2}
+	params := []any{"", url, fmt.Sprintf("d.directory.set=%q", path), "d.custom1.set=" + crTagPrefix + guid.String()}
+	for k, v := range c.options.Options {
+		params = append(params, fmt.Sprintf("%s=%v", k, v))
+	}
+	for k, v := range options {
+		params = append(params, fmt.Sprintf("%s=%v", k, v))
+	}
+
+	if _, err := c.call(ctx, "load.start", params...); err != nil {
+		return nil, fmt.Errorf("create task rtorrent failed: %w", err)
+	}
+
+	return &downloader.TaskHandle{
+		ID: guid.String(),
+	}, nil
+}
+
+func (c *rtorrentClient) Info(ctx context.Context, handle *downloader.TaskHandle) (*downloader.TaskStatus, error) {
+	row, err := c.find(ctx, handle)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := asString(row[fieldHash])
+	filesRes, err := c.call(ctx, "f.multicall", append([]any{hash, ""}, fileFields...)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get files of torrent %q: %w", hash, err)
+	}
+
+	state := downloader.StatusDownloading
+	switch {
+	case asInt(row[fieldHashing]) != 0:
+		state = downloader.StatusDownloading
+	case asInt(row[fieldComplete]) == 1 && asInt(row[fieldState]) == 1 && asInt(row[fieldActive]) == 1:
+		state = downloader.StatusSeeding
+	case asInt(row[fieldComplete]) == 1:
+		state = downloader.StatusCompleted
+	case asInt(row[fieldState]) == 0 && asString(row[fieldMessage]) != "":
+		state = downloader.StatusError
+	}
+
+	// For multi-file torrents, d.directory is the torrent folder under the directory we set.
+	savePath := filepath.ToSlash(asString(row[fieldDirectory]))
+	prefix := ""
+	if asInt(row[fieldMultiFile]) == 1 {
+		prefix = path.Base(savePath)
+		savePath = path.Dir(savePath)
+	}
+
+	status := &downloader.TaskStatus{
+		Name:          asString(row[fieldName]),
+		Total:         asInt(row[fieldSize]),
+		Downloaded:    asInt(row[fieldCompleted]),
+		DownloadSpeed: asInt(row[fieldDownRate]),
+		Uploaded:      asInt(row[fieldUpTotal]),
+		UploadSpeed:   asInt(row[fieldUpRate]),
+		SavePath:      savePath,
+		State:         state,
+		Hash:          hash,
+		NumPieces:     int(asInt(row[fieldChunks])),
+		ErrorMessage:  asString(row[fieldMessage]),
+	}
+
+	for i, f := range asSlice(filesRes) {
+		fields := asSlice(f)
+		if len(fields) < len(fileFields) {
+			continue
+		}
+
+		progress := 0.0
+		if chunks := asInt(fields[3]); chunks > 0 {
+			progress = float64(asInt(fields[2])) / float64(chunks)
+		}
+		status.Files = append(status.Files, downloader.TaskFile{
+			Index:    i,
+			Name:     path.Join(prefix, filepath.ToSlash(asString(fields[0]))),
+			Size:     asInt(fields[1]),
+			Progress: progress,
+			Selected: asInt(fields[4]) > filePrioritySkip,
+		})
+	}
+
+	// Bitfield is a hex string, the highest bit corresponds to the piece at index 0.
+	if bitfield := asString(row[fieldBitfield]); bitfield != "" {
+		status.Pieces = make([]byte, len(bitfield)/2)
+		for i := 0; i+1 < len(bitfield); i += 2 {
+			b, _ := strconv.ParseUint(bitfield[i:i+2], 16, 8)
+			status.Pieces[i/2] = byte(b)
+		}
+	}
+
+	if handle.Hash != hash {
+		handle.Hash = hash
+		status.FollowedBy = handle
+	}
+
+	return status, nil
+}
+
+func (c *rtorrentClient) Cancel(ctx context.Context, handle *downloader.TaskHandle) error {
+	status, err := c.Info(ctx, handle)
+	if err != nil {
+		return fmt.Errorf("cannot get task: %w", err)
+	}
+
+	if _, err := c.call(ctx, "d.erase", status.Hash); err != nil {
+		return fmt.Errorf("failed to cancel task with hash %q: %w", status.Hash, err)
+	}
+
+	// rTorrent never deletes downloaded data, remove the task folder if it is on the same host.
+	if path.Base(status.SavePath) == handle.ID {
+		if err := os.RemoveAll(status.SavePath); err != nil {
+			c.l.Warning("Failed to delete temp download folder: %q: %s", status.SavePath, err)
+		}
+	}
+
+	return nil
+}
+
+func (c *rtorrentClient) SetFilesToDownload(ctx context.Context, handle *downloader.TaskHandle, args ...*downloader.SetFileToDownloadArgs) error {
+	row, err := c.find(ctx, handle)
+	if err != nil {
+		return fmt.Errorf("cannot get task: %w", err)
+	}
+
+	hash := asString(row[fieldHash])
+	for _, arg := range args {
+		priority := filePrioritySkip
+		if arg.Download {
+			priority = filePriorityNormal
+		}
+
+		if _, err := c.call(ctx, "f.priority.set", fmt.Sprintf("%s:f%d", hash, arg.Index), priority); err != nil {
+			return fmt.Errorf("failed to set priority of file %d: %w", arg.Index, err)
+		}
+	}
+
+	if _, err := c.call(ctx, "d.update_priorities", hash); err != nil {
+		return fmt.Errorf("failed to update priorities: %w", err)
+	}
+
+	return nil
+}
+
+func (c *rtorrentClient) Test(ctx context.Context) (string, error) {
+	res, err := c.call(ctx, "system.client_version")
+	if err != nil {
+		return "", fmt.Errorf("test rtorrent failed: %w", err)
+	}
+
+	return asString(res), nil
+}
+
+// find returns the d.multicall2 row of given handle, matched by hash or by the tag stored in custom1.
+func (c *rtorrentClient) find(ctx context.Context, handle *downloader.TaskHandle) ([]any, error) {
+	res, err := c.call(ctx, "d.multicall2", append([]any{"", "main"}, downloadFields...)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list torrents: %w", err)
+	}
+
+	for _, item := range asSlice(res) {
+		row := asSlice(item)
+		if len(row) < len(downloadFields) {
+			continue
+		}
+
+		if (handle.Hash != "" && asString(row[fieldHash]) == handle.Hash) || asString(row[fieldCustom1]) == crTagPrefix+handle.ID {
+			return row, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no torrent with tag %q: %w", crTagPrefix+handle.ID, downloader.ErrTaskNotFount)
+}
+
+func (c *rtorrentClient) call(ctx context.Context, method string, params ...any) (any, error) {
+	body, err := encodeCall(method, params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	headers := http.Header{"Content-Type": []string{"text/xml"}}
+	if c.options.User != "" {
+		headers.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(c.options.User+":"+c.options.Password)))
+	}
+
+	content, err := c.c.Request(http.MethodPost, c.options.Server, bytes.NewReader(body),
+		request.WithContext(ctx),
+		request.WithHeader(headers),
+	).CheckHTTPResponse(http.StatusOK).GetResponse()
+	if err != nil {
+		return nil, fmt.Errorf("send request failed: %w", err)
+	}
+
+	return decodeResponse([]byte(content))
+}
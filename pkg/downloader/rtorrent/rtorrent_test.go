@@ -0,0 +1,136 @@
+package rtorrent
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
+	"github.com/cloudreve/Cloudreve/v4/pkg/downloader"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestXmlRpcRoundTrip(t *testing.T) {
+	a := assert.New(t)
+	body, err := encodeCall("load.start", "", "http://a/b?c=1&d=<2>", 3, true, []any{"x", int64(4)})
+	require.NoError(t, err)
+	a.Contains(string(body), "<methodName>load.start</methodName>")
+	a.Contains(string(body), "http://a/b?c=1&amp;d=&lt;2&gt;")
+
+	res, err := decodeResponse([]byte(`<?xml version="1.0"?><methodResponse><params><param><value><array><data>
+<value><array><data><value><string>HASH</string></value><value><i8>42</i8></value><value>raw</value><value><boolean>1</boolean></value></data></array></value>
+</data></array></value></param></params></methodResponse>`))
+	require.NoError(t, err)
+	a.Equal([]any{[]any{"HASH", int64(42), "raw", true}}, res)
+
+	_, err = decodeResponse([]byte(`<?xml version="1.0"?><methodResponse><fault><value><struct>
+<member><name>faultCode</name><value><i4>-501</i4></value></member>
+<member><name>faultString</name><value><string>Could not find info-hash.</string></value></member>
+</struct></value></fault></methodResponse>`))
+	a.ErrorContains(err, "Could not find info-hash.")
+}
+
+type methodCall struct {
+	Method string `xml:"methodName"`
+	Params []struct {
+		Value xmlValue `xml:"value"`
+	} `xml:"params>param"`
+}
+
+// stubServer emulates rTorrent XML-RPC with one multi-file torrent tagged by the first load.start call.
+func stubServer(t *testing.T, calls map[string][]any) *httptest.Server {
+	tag := ""
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		content, _ := io.ReadAll(r.Body)
+		call := &methodCall{}
+		require.NoError(t, xml.Unmarshal(content, call))
+		params := make([]any, 0, len(call.Params))
+		for _, p := range call.Params {
+			params = append(params, p.Value.decode())
+		}
+		calls[call.Method] = params
+
+		result := "<string>0</string>"
+		switch call.Method {
+		case "system.client_version":
+			result = "<string>0.9.8</string>"
+		case "load.start":
+			for _, p := range params {
+				if s, ok := p.(string); ok && strings.HasPrefix(s, "d.custom1.set=") {
+					tag = strings.TrimPrefix(s, "d.custom1.set=")
+				}
+			}
+		case "d.multicall2":
+			result = fmt.Sprintf(`<array><data><value><array><data>
+<value><string>HASH</string></value><value><string>%s</string></value><value><string>folder</string></value>
+<value><i8>100</i8></value><value><i8>100</i8></value><value><i8>0</i8></value><value><i8>10</i8></value>
+<value><i8>5</i8></value><value><string>/downloads/task/folder</string></value><value><i8>1</i8></value>
+<value><i8>1</i8></value><value><i8>1</i8></value><value><i8>1</i8></value><value><string></string></value>
+<value><i8>2</i8></value><value><string>C0</string></value><value><i8>0</i8></value>
+</data></array></value></data></array>`, tag)
+		case "f.multicall":
+			result = `<array><data>
+<value><array><data><value><string>a.txt</string></value><value><i8>60</i8></value><value><i8>1</i8></value><value><i8>1</i8></value><value><i8>1</i8></value></data></array></value>
+<value><array><data><value><string>sub/b.txt</string></value><value><i8>40</i8></value><value><i8>0</i8></value><value><i8>1</i8></value><value><i8>0</i8></value></data></array></value>
+</data></array>`
+		}
+
+		fmt.Fprintf(w, `<?xml version="1.0"?><methodResponse><params><param><value>%s</value></param></params></methodResponse>`, result)
+	}))
+}
+
+func TestRTorrentClient(t *testing.T) {
+	a := assert.New(t)
+	calls := make(map[string][]any)
+	srv := stubServer(t, calls)
+	defer srv.Close()
+
+	l := logging.NewConsoleLogger(logging.LevelError)
+	config, err := conf.NewIniConfigProvider(filepath.Join(t.TempDir(), "conf.ini"), l)
+	require.NoError(t, err)
+	c, err := NewClient(l, request.NewClient(config), nil, &types.RTorrentSetting{
+		Server:   srv.URL + "/RPC2",
+		TempPath: t.TempDir(),
+	})
+	require.NoError(t, err)
+	ctx := t.Context()
+
+	version, err := c.Test(ctx)
+	a.NoError(err)
+	a.Equal("0.9.8", version)
+
+	handle, err := c.CreateTask(ctx, "magnet:?xt=urn:btih:hash", map[string]any{"d.priority.set": 2})
+	require.NoError(t, err)
+	a.Contains(calls["load.start"], "d.priority.set=2")
+
+	status, err := c.Info(ctx, handle)
+	require.NoError(t, err)
+	a.Equal(downloader.StatusSeeding, status.State)
+	a.Equal("/downloads/task", status.SavePath)
+	a.Equal([]byte{0xC0}, status.Pieces)
+	a.Equal("HASH", status.FollowedBy.Hash)
+	require.Len(t, status.Files, 2)
+	a.Equal("folder/a.txt", status.Files[0].Name)
+	a.Equal(1.0, status.Files[0].Progress)
+	a.Equal("folder/sub/b.txt", status.Files[1].Name)
+	a.False(status.Files[1].Selected)
+
+	a.NoError(c.SetFilesToDownload(ctx, handle, &downloader.SetFileToDownloadArgs{Index: 1, Download: true}))
+	a.Equal([]any{"HASH:f1", int64(1)}, calls["f.priority.set"])
+	a.Equal([]any{"HASH"}, calls["d.update_priorities"])
+
+	a.NoError(c.Cancel(ctx, handle))
+	a.Equal([]any{"HASH"}, calls["d.erase"])
+
+	_, err = c.Info(ctx, &downloader.TaskHandle{ID: "other"})
+	a.ErrorIs(err, downloader.ErrTaskNotFount)
+}
@@ -0,0 +1,202 @@
+package rtorrent
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// encodeCall encodes an XML-RPC method call. Supported param types are string, integers,
+// bool, float64, slices of them and map[string]any.
+func encodeCall(method string, params ...any) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	buf.WriteString(`<?xml version="1.0"?><methodCall><methodName>`)
+	if err := xml.EscapeText(buf, []byte(method)); err != nil {
+		return nil, err
+	}
+	buf.WriteString(`</methodName><params>`)
+	for _, p := range params {
+		buf.WriteString(`<param>`)
+		if err := encodeValue(buf, p); err != nil {
+			return nil, err
+		}
+		buf.WriteString(`</param>`)
+	}
+	buf.WriteString(`</params></methodCall>`)
+	return buf.Bytes(), nil
+}
+
+func encodeValue(buf *bytes.Buffer, v any) error {
+	buf.WriteString(`<value>`)
+	switch val := v.(type) {
+	case string:
+		buf.WriteString(`<string>`)
+		if err := xml.EscapeText(buf, []byte(val)); err != nil {
+			return err
+		}
+		buf.WriteString(`</string>`)
+	case int:
+		fmt.Fprintf(buf, `<i8>%d</i8>`, val)
+	case int64:
+		fmt.Fprintf(buf, `<i8>%d</i8>`, val)
+	case bool:
+		if val {
+			buf.WriteString(`<boolean>1</boolean>`)
+		} else {
+			buf.WriteString(`<boolean>0</boolean>`)
+		}
+	case float64:
+		fmt.Fprintf(buf, `<double>%s</double>`, strconv.FormatFloat(val, 'f', -1, 64))
+	case []string:
+		buf.WriteString(`<array><data>`)
+		for _, item := range val {
+			if err := encodeValue(buf, item); err != nil {
+				return err
+			}
+		}
+		buf.WriteString(`</data></array>`)
+	case []any:
+		buf.WriteString(`<array><data>`)
+		for _, item := range val {
+			if err := encodeValue(buf, item); err != nil {
+				return err
+			}
+		}
+		buf.WriteString(`</data></array>`)
+	case map[string]any:
+		buf.WriteString(`<struct>`)
+		for k, item := range val {
+			buf.WriteString(`<member><name>`)
+			if err := xml.EscapeText(buf, []byte(k)); err != nil {
+				return err
+			}
+			buf.WriteString(`</name>`)
+			if err := encodeValue(buf, item); err != nil {
+				return err
+			}
+			buf.WriteString(`</member>`)
+		}
+		buf.WriteString(`</struct>`)
+	default:
+		return fmt.Errorf("unsupported xmlrpc param type %T", v)
+	}
+	buf.WriteString(`</value>`)
+	return nil
+}
+
+type (
+	xmlValue struct {
+		String *string    `xml:"string"`
+		Int    *string    `xml:"int"`
+		I4     *string    `xml:"i4"`
+		I8     *string    `xml:"i8"`
+		Bool   *string    `xml:"boolean"`
+		Double *string    `xml:"double"`
+		Array  *xmlArray  `xml:"array"`
+		Struct *xmlStruct `xml:"struct"`
+		// Raw is the content of value without type tag, which defaults to string.
+		Raw string `xml:",chardata"`
+	}
+	xmlArray struct {
+		Values []xmlValue `xml:"data>value"`
+	}
+	xmlStruct struct {
+		Members []xmlMember `xml:"member"`
+	}
+	xmlMember struct {
+		Name  string   `xml:"name"`
+		Value xmlValue `xml:"value"`
+	}
+	methodResponse struct {
+		Params []xmlValue `xml:"params>param>value"`
+		Fault  *xmlValue  `xml:"fault>value"`
+	}
+)
+
+// decodeResponse decodes an XML-RPC method response into string, int64, bool, float64,
+// []any or map[string]any. Fault response is returned as error.
+func decodeResponse(content []byte) (any, error) {
+	res := &methodResponse{}
+	if err := xml.Unmarshal(content, res); err != nil {
+		return nil, fmt.Errorf("failed to parse xmlrpc response: %w", err)
+	}
+
+	if res.Fault != nil {
+		fault, _ := res.Fault.decode().(map[string]any)
+		return nil, fmt.Errorf("xmlrpc fault %v: %v", fault["faultCode"], fault["faultString"])
+	}
+
+	if len(res.Params) == 0 {
+		return nil, nil
+	}
+
+	return res.Params[0].decode(), nil
+}
+
+func (v *xmlValue) decode() any {
+	parseInt := func(s string) int64 {
+		i, _ := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+		return i
+	}
+
+	switch {
+	case v.String != nil:
+		return *v.String
+	case v.I8 != nil:
+		return parseInt(*v.I8)
+	case v.I4 != nil:
+		return parseInt(*v.I4)
+	case v.Int != nil:
+		return parseInt(*v.Int)
+	case v.Bool != nil:
+		return strings.TrimSpace(*v.Bool) == "1"
+	case v.Double != nil:
+		f, _ := strconv.ParseFloat(strings.TrimSpace(*v.Double), 64)
+		return f
+	case v.Array != nil:
+		res := make([]any, 0, len(v.Array.Values))
+		for i := range v.Array.Values {
+			res = append(res, v.Array.Values[i].decode())
+		}
+		return res
+	case v.Struct != nil:
+		res := make(map[string]any, len(v.Struct.Members))
+		for i := range v.Struct.Members {
+			res[v.Struct.Members[i].Name] = v.Struct.Members[i].Value.decode()
+		}
+		return res
+	default:
+		return v.Raw
+	}
+}
+
+func asString(v any) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+	return ""
+}
+
+func asInt(v any) int64 {
+	switch val := v.(type) {
+	case int64:
+		return val
+	case string:
+		i, _ := strconv.ParseInt(val, 10, 64)
+		return i
+	case bool:
+		if val {
+			return 1
+		}
+	}
+	return 0
+}
+
+func asSlice(v any) []any {
+	if s, ok := v.([]any); ok {
+		return s
+	}
+	return nil
+}
@@ -0,0 +1,320 @@
+package transmission
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"path/filepath"
+	"sync"
+
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/downloader"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/gofrs/uuid"
+	"github.com/samber/lo"
+)
+
+const (
+	defaultRpcPath  = "/transmission/rpc"
+	sessionIdHeader = "X-Transmission-Session-Id"
+	successResult   = "success"
+	crLabelPrefix   = "cr-"
+)
+
+var (
+	// downloadOptions are torrent-add arguments that can be overwritten by node or group options.
+	downloadOptions = map[string]bool{
+		"cookies":            true,
+		"paused":             true,
+		"peer-limit":         true,
+		"bandwidthPriority":  true,
+		"sequentialDownload": true,
+	}
+)
+
+type transmissionClient struct {
+	c        request.Client
+	settings setting.Provider
+	l        logging.Logger
+	options  *types.TransmissionSetting
+	server   string
+
+	mu        sync.Mutex
+	sessionId string
+}
+
+func NewClient(l logging.Logger, c request.Client, setting setting.Provider, options *types.TransmissionSetting) (downloader.Downloader, error) {
+	server, err := url.Parse(options.Server)
+	if err != nil {
+		return nil, fmt.Errorf("invalid transmission server URL: %w", err)
+	}
+
+	if server.Path == "" || server.Path == "/" {
+		server.Path = defaultRpcPath
+	}
+
+	c.Apply(request.WithLogger(l))
+	return &transmissionClient{c: c, options: options, l: l, settings: setting, server: server.String()}, nil
+}
+
+func (c *transmissionClient) CreateTask(ctx context.Context, url string, options map[string]interface{}) (*downloader.TaskHandle, error) {
+	guid, _ := uuid.NewV4()
+
+	// Generate a unique path for the task
+	base := util.RelativePath(c.options.TempPath)
+	if c.options.TempPath == "" {
+		base = util.DataPath(c.settings.TempPath(ctx))
+	}
+	path := filepath.Join(
+		base,
+		"transmission",
+		guid.String(),
+	)
+	c.l.Info("Creating Transmission task with url %q saving to %q...", url, path)
+
+	args := map[string]any{}
+	for k, v := range c.options.Options {
+		if downloadOptions[k] {
+			args[k] = v
+		}
+	}
+	for k, v := range options {
+		if downloadOptions[k] {
+			args[k] = v
+		}
+	}
+	args["filename"] = url
+	args["download-dir"] = path
+	args["labels"] = []string{crLabelPrefix + guid.String()}
+
+	res := &rpcResponse[addTorrentResult]{}
+	if err := c.call(ctx, "torrent-add", args, res); err != nil {
+		return nil, fmt.Errorf("create task transmission failed: %w", err)
+	}
+
+	added := res.Arguments.TorrentAdded
+	if added == nil {
+		if res.Arguments.TorrentDuplicate != nil {
+			return nil, fmt.Errorf("torrent %q already exists in transmission", res.Arguments.TorrentDuplicate.HashString)
+		}
+		return nil, fmt.Errorf("create task transmission failed: empty response")
+	}
+
+	return &downloader.TaskHandle{
+		ID:   guid.String(),
+		Hash: added.HashString,
+	}, nil
+}
+
+func (c *transmissionClient) Info(ctx context.Context, handle *downloader.TaskHandle) (*downloader.TaskStatus, error) {
+	t, err := c.get(ctx, handle)
+	if err != nil {
+		return nil, err
+	}
+
+	state := downloader.StatusDownloading
+	switch {
+	case t.Error == errorLocal:
+		state = downloader.StatusError
+	case t.Status == statusSeed || t.Status == statusSeedWait:
+		state = downloader.StatusSeeding
+	case t.Status == statusStopped && (t.IsFinished || (t.MetadataPct >= 1 && t.LeftUntilDone == 0)):
+		state = downloader.StatusCompleted
+	case t.Status == statusStopped, t.Status == statusCheckWait, t.Status == statusCheck,
+		t.Status == statusDownloadWait, t.Status == statusDownload:
+		state = downloader.StatusDownloading
+	default:
+		state = downloader.StatusUnknown
+	}
+
+	status := &downloader.TaskStatus{
+		Name:          t.Name,
+		Total:         t.SizeWhenDone,
+		Downloaded:    t.SizeWhenDone - t.LeftUntilDone,
+		DownloadSpeed: t.RateDownload,
+		Uploaded:      t.UploadedEver,
+		UploadSpeed:   t.RateUpload,
+		SavePath:      filepath.ToSlash(t.DownloadDir),
+		State:         state,
+		Hash:          t.HashString,
+		NumPieces:     t.PieceCount,
+		ErrorMessage:  t.ErrorString,
+		Files: lo.Map(t.Files, func(item File, index int) downloader.TaskFile {
+			progress := 0.0
+			if item.Length > 0 {
+				progress = float64(item.BytesCompleted) / float64(item.Length)
+			}
+			return downloader.TaskFile{
+				Index:    index,
+				Name:     path.Clean(filepath.ToSlash(item.Name)),
+				Size:     item.Length,
+				Progress: progress,
+				Selected: index >= len(t.FileStats) || t.FileStats[index].Wanted,
+			}
+		}),
+	}
+
+	// Pieces are base64 encoded bitfield, the highest bit corresponds to the piece at index 0.
+	if t.Pieces != "" {
+		if pieces, err := base64.StdEncoding.DecodeString(t.Pieces); err == nil {
+			status.Pieces = pieces
+		}
+	}
+
+	if handle.Hash != t.HashString {
+		handle.Hash = t.HashString
+		status.FollowedBy = handle
+	}
+
+	return status, nil
+}
+
+func (c *transmissionClient) Cancel(ctx context.Context, handle *downloader.TaskHandle) error {
+	t, err := c.get(ctx, handle)
+	if err != nil {
+		return fmt.Errorf("failed to get task %q: %w", handle.ID, err)
+	}
+
+	if err := c.call(ctx, "torrent-remove", map[string]any{
+		"ids":               []int{t.ID},
+		"delete-local-data": true,
+	}, &rpcResponse[any]{}); err != nil {
+		return fmt.Errorf("failed to cancel task with hash %q: %w", t.HashString, err)
+	}
+
+	return nil
+}
+
+func (c *transmissionClient) SetFilesToDownload(ctx context.Context, handle *downloader.TaskHandle, args ...*downloader.SetFileToDownloadArgs) error {
+	t, err := c.get(ctx, handle)
+	if err != nil {
+		return fmt.Errorf("failed to get task %q: %w", handle.ID, err)
+	}
+
+	wanted := make([]int, 0, len(args))
+	unwanted := make([]int, 0, len(args))
+	for _, arg := range args {
+		if arg.Download {
+			wanted = append(wanted, arg.Index)
+		} else {
+			unwanted = append(unwanted, arg.Index)
+		}
+	}
+
+	setArgs := map[string]any{"ids": []int{t.ID}}
+	if len(wanted) > 0 {
+		setArgs["files-wanted"] = wanted
+	}
+	if len(unwanted) > 0 {
+		setArgs["files-unwanted"] = unwanted
+	}
+
+	if err := c.call(ctx, "torrent-set", setArgs, &rpcResponse[any]{}); err != nil {
+		return fmt.Errorf("failed to set files to download: %w", err)
+	}
+
+	return nil
+}
+
+func (c *transmissionClient) Test(ctx context.Context) (string, error) {
+	res := &rpcResponse[sessionResult]{}
+	if err := c.call(ctx, "session-get", map[string]any{"fields": []string{"version"}}, res); err != nil {
+		return "", fmt.Errorf("test transmission failed: %w", err)
+	}
+
+	return res.Arguments.Version, nil
+}
+
+// get finds the torrent of given handle by hash, or by label if hash is not known yet.
+func (c *transmissionClient) get(ctx context.Context, handle *downloader.TaskHandle) (*Torrent, error) {
+	args := map[string]any{"fields": torrentFields}
+	if handle.Hash != "" {
+		args["ids"] = []string{handle.Hash}
+	}
+
+	res := &rpcResponse[getTorrentResult]{}
+	if err := c.call(ctx, "torrent-get", args, res); err != nil {
+		return nil, fmt.Errorf("failed to get torrent info: %w", err)
+	}
+
+	for i := range res.Arguments.Torrents {
+		t := &res.Arguments.Torrents[i]
+		if t.HashString == handle.Hash || lo.Contains(t.Labels, crLabelPrefix+handle.ID) {
+			return t, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no torrent with hash %q: %w", handle.Hash, downloader.ErrTaskNotFount)
+}
+
+// call sends an RPC request, session ID is refreshed and request is retried once on 409 response.
+func (c *transmissionClient) call(ctx context.Context, method string, args map[string]any, res any) error {
+	body, err := json.Marshal(&rpcRequest{Method: method, Arguments: args})
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	for retry := 0; ; retry++ {
+		c.mu.Lock()
+		headers := http.Header{
+			"Content-Type":  []string{"application/json"},
+			sessionIdHeader: []string{c.sessionId},
+		}
+		c.mu.Unlock()
+		if c.options.User != "" {
+			headers.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(c.options.User+":"+c.options.Password)))
+		}
+
+		resp := c.c.Request(http.MethodPost, c.server, bytes.NewReader(body),
+			request.WithContext(ctx),
+			request.WithHeader(headers),
+		)
+		if resp.Err != nil {
+			return fmt.Errorf("send request failed: %w", resp.Err)
+		}
+
+		switch resp.Response.StatusCode {
+		case http.StatusConflict:
+			resp.Response.Body.Close()
+			if retry > 0 {
+				return fmt.Errorf("failed to negotiate session ID")
+			}
+
+			c.mu.Lock()
+			c.sessionId = resp.Response.Header.Get(sessionIdHeader)
+			c.mu.Unlock()
+			continue
+		case http.StatusUnauthorized:
+			resp.Response.Body.Close()
+			return fmt.Errorf("unauthorized, possibly incorrect credential is provided")
+		case http.StatusOK:
+		default:
+			content, _ := resp.GetResponse()
+			return fmt.Errorf("unexpected status code: %d, content: %s", resp.Response.StatusCode, content)
+		}
+
+		content, err := resp.GetResponse()
+		if err != nil {
+			return fmt.Errorf("failed reading response: %w", err)
+		}
+
+		result := &rpcResponse[json.RawMessage]{}
+		if err := json.Unmarshal([]byte(content), result); err != nil {
+			return fmt.Errorf("failed to unmarshal response: %w", err)
+		}
+
+		if result.Result != successResult {
+			return fmt.Errorf("transmission rpc error: %s", result.Result)
+		}
+
+		return json.Unmarshal([]byte(content), res)
+	}
+}
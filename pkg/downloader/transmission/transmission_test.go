@@ -0,0 +1,124 @@
+package transmission
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
+	"github.com/cloudreve/Cloudreve/v4/pkg/downloader"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubServer emulates Transmission RPC with session ID handshake and one torrent.
+func stubServer(t *testing.T, torrent *Torrent, calls map[string]map[string]any) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != defaultRpcPath {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		if r.Header.Get(sessionIdHeader) != "session" {
+			w.Header().Set(sessionIdHeader, "session")
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+
+		req := &rpcRequest{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(req))
+		calls[req.Method] = req.Arguments
+
+		var args any
+		switch req.Method {
+		case "torrent-add":
+			args = addTorrentResult{TorrentAdded: &addedTorrent{ID: torrent.ID, HashString: torrent.HashString}}
+		case "torrent-get":
+			args = getTorrentResult{Torrents: []Torrent{*torrent}}
+		case "session-get":
+			args = sessionResult{Version: "4.0.5"}
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]any{"result": successResult, "arguments": args})
+	}))
+}
+
+func newTestClient(t *testing.T, server string) downloader.Downloader {
+	l := logging.NewConsoleLogger(logging.LevelError)
+	config, err := conf.NewIniConfigProvider(filepath.Join(t.TempDir(), "conf.ini"), l)
+	require.NoError(t, err)
+
+	c, err := NewClient(l, request.NewClient(config), nil, &types.TransmissionSetting{
+		Server:   server,
+		TempPath: t.TempDir(),
+		Options:  map[string]any{"paused": true, "unknown": 1},
+	})
+	require.NoError(t, err)
+	return c
+}
+
+func TestTransmissionClient(t *testing.T) {
+	a := assert.New(t)
+	torrent := &Torrent{
+		ID:            1,
+		HashString:    "abc",
+		Name:          "folder",
+		Status:        statusSeed,
+		DownloadDir:   "/downloads/task",
+		SizeWhenDone:  100,
+		LeftUntilDone: 0,
+		Pieces:        "gA==",
+		PieceCount:    1,
+		Files: []File{
+			{Name: "folder/a.txt", Length: 60, BytesCompleted: 60},
+			{Name: "folder/b.txt", Length: 40, BytesCompleted: 40},
+		},
+		FileStats: []FileStat{{Wanted: true}, {Wanted: false}},
+	}
+	calls := make(map[string]map[string]any)
+	srv := stubServer(t, torrent, calls)
+	defer srv.Close()
+
+	c := newTestClient(t, srv.URL)
+	ctx := t.Context()
+
+	version, err := c.Test(ctx)
+	a.NoError(err)
+	a.Equal("4.0.5", version)
+
+	handle, err := c.CreateTask(ctx, "magnet:?xt=urn:btih:abc", nil)
+	require.NoError(t, err)
+	a.Equal("abc", handle.Hash)
+	a.Equal(true, calls["torrent-add"]["paused"])
+	a.NotContains(calls["torrent-add"], "unknown")
+
+	status, err := c.Info(ctx, handle)
+	require.NoError(t, err)
+	a.Equal(downloader.StatusSeeding, status.State)
+	a.Equal("/downloads/task", status.SavePath)
+	a.Equal([]byte{0x80}, status.Pieces)
+	a.Len(status.Files, 2)
+	a.True(status.Files[0].Selected)
+	a.False(status.Files[1].Selected)
+	a.Nil(status.FollowedBy)
+
+	torrent.Status = statusStopped
+	torrent.IsFinished = true
+	status, err = c.Info(ctx, handle)
+	require.NoError(t, err)
+	a.Equal(downloader.StatusCompleted, status.State)
+
+	a.NoError(c.SetFilesToDownload(ctx, handle, &downloader.SetFileToDownloadArgs{Index: 1, Download: true}))
+	a.Equal([]any{float64(1)}, calls["torrent-set"]["files-wanted"])
+
+	a.NoError(c.Cancel(ctx, handle))
+	a.Equal(true, calls["torrent-remove"]["delete-local-data"])
+
+	_, err = c.Info(ctx, &downloader.TaskHandle{ID: "other", Hash: "def"})
+	a.ErrorIs(err, downloader.ErrTaskNotFount)
+}
@@ -0,0 +1,85 @@
+package transmission
+
+type rpcRequest struct {
+	Method    string         `json:"method"`
+	Arguments map[string]any `json:"arguments,omitempty"`
+}
+
+type rpcResponse[T any] struct {
+	Result    string `json:"result"`
+	Arguments T      `json:"arguments"`
+}
+
+type addedTorrent struct {
+	ID         int    `json:"id"`
+	HashString string `json:"hashString"`
+	Name       string `json:"name"`
+}
+
+type addTorrentResult struct {
+	TorrentAdded     *addedTorrent `json:"torrent-added"`
+	TorrentDuplicate *addedTorrent `json:"torrent-duplicate"`
+}
+
+type getTorrentResult struct {
+	Torrents []Torrent `json:"torrents"`
+}
+
+type sessionResult struct {
+	Version string `json:"version"`
+}
+
+// Torrent status codes of Transmission RPC.
+const (
+	statusStopped      = 0
+	statusCheckWait    = 1
+	statusCheck        = 2
+	statusDownloadWait = 3
+	statusDownload     = 4
+	statusSeedWait     = 5
+	statusSeed         = 6
+)
+
+// errorLocal means the torrent is stopped by a local error, e.g. disk full.
+const errorLocal = 3
+
+type Torrent struct {
+	ID             int        `json:"id"`
+	HashString     string     `json:"hashString"`
+	Name           string     `json:"name"`
+	Status         int        `json:"status"`
+	Error          int        `json:"error"`
+	ErrorString    string     `json:"errorString"`
+	DownloadDir    string     `json:"downloadDir"`
+	SizeWhenDone   int64      `json:"sizeWhenDone"`
+	LeftUntilDone  int64      `json:"leftUntilDone"`
+	RateDownload   int64      `json:"rateDownload"`
+	RateUpload     int64      `json:"rateUpload"`
+	UploadedEver   int64      `json:"uploadedEver"`
+	IsFinished     bool       `json:"isFinished"`
+	Pieces         string     `json:"pieces"`
+	PieceCount     int        `json:"pieceCount"`
+	Files          []File     `json:"files"`
+	FileStats      []FileStat `json:"fileStats"`
+	MetadataPct    float64    `json:"metadataPercentComplete"`
+	Labels         []string   `json:"labels"`
+	PercentDone    float64    `json:"percentDone"`
+	DownloadedEver int64      `json:"downloadedEver"`
+}
+
+type File struct {
+	Name           string `json:"name"`
+	Length         int64  `json:"length"`
+	BytesCompleted int64  `json:"bytesCompleted"`
+}
+
+type FileStat struct {
+	Wanted         bool  `json:"wanted"`
+	BytesCompleted int64 `json:"bytesCompleted"`
+}
+
+var torrentFields = []string{
+	"id", "hashString", "name", "status", "error", "errorString", "downloadDir", "sizeWhenDone",
+	"leftUntilDone", "rateDownload", "rateUpload", "uploadedEver", "isFinished", "pieces", "pieceCount",
+	"files", "fileStats", "metadataPercentComplete", "labels", "percentDone", "downloadedEver",
+}
@@ -0,0 +1,423 @@
+package azblob
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blockblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/sas"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/service"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/boolset"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cluster/routes"
+	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/chunk"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/chunk/backoff"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/mime"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+)
+
+// Driver Azure Blob Storage driver. Policy.Server is the blob service endpoint, Policy.BucketName
+// is the container name, Policy.AccessKey and Policy.SecretKey are the account name and key.
+type Driver struct {
+	policy    *ent.StoragePolicy
+	chunkSize int64
+
+	settings setting.Provider
+	l        logging.Logger
+	config   conf.ConfigProvider
+	mime     mime.MimeDetector
+
+	cred      *azblob.SharedKeyCredential
+	client    *azblob.Client
+	container *container.Client
+}
+
+// MetaData 文件信息
+type MetaData struct {
+	Size int64
+	Etag string
+}
+
+var (
+	features = &boolset.BooleanSet{}
+)
+
+func init() {
+	boolset.Sets(map[driver.HandlerCapability]bool{
+		driver.HandlerCapabilityUploadSentinelRequired: true,
+	}, features)
+}
+
+func New(ctx context.Context, policy *ent.StoragePolicy, settings setting.Provider,
+	config conf.ConfigProvider, l logging.Logger, mime mime.MimeDetector) (*Driver, error) {
+	chunkSize := policy.Settings.ChunkSize
+	if policy.Settings.ChunkSize == 0 {
+		chunkSize = 25 << 20 // 25 MB
+	}
+
+	cred, err := azblob.NewSharedKeyCredential(policy.AccessKey, policy.SecretKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid account credential: %w", err)
+	}
+
+	client, err := azblob.NewClientWithSharedKeyCredential(policy.Server, cred, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create azure blob client: %w", err)
+	}
+
+	return &Driver{
+		policy:    policy,
+		chunkSize: chunkSize,
+		settings:  settings,
+		config:    config,
+		l:         l,
+		mime:      mime,
+		cred:      cred,
+		client:    client,
+		container: client.ServiceClient().NewContainerClient(policy.BucketName),
+	}, nil
+}
+
+// List 列出给定路径下的文件
+func (handler *Driver) List(ctx context.Context, base string, onProgress driver.ListProgressFunc, recursive bool) ([]fs.PhysicalObject, error) {
+	base = strings.TrimPrefix(base, "/")
+	if base != "" {
+		base += "/"
+	}
+
+	var (
+		blobs    []*container.BlobItem
+		prefixes []*container.BlobPrefix
+	)
+
+	if recursive {
+		pager := handler.container.NewListBlobsFlatPager(&container.ListBlobsFlatOptions{Prefix: &base})
+		for pager.More() {
+			page, err := pager.NextPage(ctx)
+			if err != nil {
+				return nil, err
+			}
+			blobs = append(blobs, page.Segment.BlobItems...)
+		}
+	} else {
+		pager := handler.container.NewListBlobsHierarchyPager("/", &container.ListBlobsHierarchyOptions{Prefix: &base})
+		for pager.More() {
+			page, err := pager.NextPage(ctx)
+			if err != nil {
+				return nil, err
+			}
+			blobs = append(blobs, page.Segment.BlobItems...)
+			prefixes = append(prefixes, page.Segment.BlobPrefixes...)
+		}
+	}
+
+	res := make([]fs.PhysicalObject, 0, len(blobs)+len(prefixes))
+
+	// 处理目录
+	for _, prefix := range prefixes {
+		name := strings.TrimSuffix(*prefix.Name, "/")
+		res = append(res, fs.PhysicalObject{
+			Name:         path.Base(name),
+			RelativePath: strings.TrimPrefix(name, base),
+			Size:         0,
+			IsDir:        true,
+			LastModify:   time.Now(),
+		})
+	}
+	onProgress(len(prefixes))
+
+	// 处理文件
+	for _, item := range blobs {
+		object := fs.PhysicalObject{
+			Name:         path.Base(*item.Name),
+			Source:       *item.Name,
+			RelativePath: strings.TrimPrefix(*item.Name, base),
+			LastModify:   time.Now(),
+		}
+		if item.Properties != nil && item.Properties.ContentLength != nil {
+			object.Size = *item.Properties.ContentLength
+		}
+		if item.Properties != nil && item.Properties.LastModified != nil {
+			object.LastModify = *item.Properties.LastModified
+		}
+		res = append(res, object)
+	}
+	onProgress(len(blobs))
+
+	return res, nil
+}
+
+// Open 打开文件
+func (handler *Driver) Open(ctx context.Context, path string) (*os.File, error) {
+	return nil, errors.New("not implemented")
+}
+
+// Put 将文件流保存到指定目录
+func (handler *Driver) Put(ctx context.Context, file *fs.UploadRequest) error {
+	defer file.Close()
+
+	// 是否允许覆盖
+	overwrite := file.Mode&fs.ModeOverwrite == fs.ModeOverwrite
+	if !overwrite {
+		// Check for duplicated file
+		if _, err := handler.Meta(ctx, file.Props.SavePath); err == nil {
+			return fs.ErrFileExisted
+		}
+	}
+
+	mimeType := file.Props.MimeType
+	if mimeType == "" {
+		mimeType = handler.mime.TypeByName(file.Props.Uri.Name())
+	}
+
+	_, err := handler.container.NewBlockBlobClient(file.Props.SavePath).UploadStream(ctx, io.LimitReader(file, file.Props.Size),
+		&blockblob.UploadStreamOptions{
+			BlockSize:   handler.chunkSize,
+			HTTPHeaders: &blob.HTTPHeaders{BlobContentType: &mimeType},
+		})
+	return err
+}
+
+// Delete 删除一个或多个文件，
+// 返回未删除的文件，及遇到的最后一个错误
+func (handler *Driver) Delete(ctx context.Context, files ...string) ([]string, error) {
+	failed := make([]string, 0, len(files))
+	var lastErr error
+
+	for _, file := range files {
+		if _, err := handler.container.NewBlobClient(file).Delete(ctx, nil); err != nil {
+			if bloberror.HasCode(err, bloberror.BlobNotFound) {
+				continue
+			}
+
+			handler.l.Debug("Failed to delete file %q: %s", file, err)
+			failed = append(failed, file)
+			lastErr = err
+		}
+	}
+
+	return failed, lastErr
+}
+
+// Thumb 获取文件缩略图
+func (handler *Driver) Thumb(ctx context.Context, expire *time.Time, ext string, e fs.Entity) (string, error) {
+	return "", errors.New("not implemented")
+}
+
+// Source 获取外链URL
+func (handler *Driver) Source(ctx context.Context, e fs.Entity, args *driver.GetSourceArgs) (string, error) {
+	blobURL := handler.container.NewBlobClient(e.Source()).URL()
+
+	// 公有容器直接返回对象地址，下载时仍需签名以指定 Content-Disposition
+	if !handler.policy.IsPrivate && !args.IsDownload {
+		return blobURL, nil
+	}
+
+	ttl := time.Duration(604800) * time.Second // 7 days
+	if args.Expire != nil {
+		ttl = time.Until(*args.Expire)
+	}
+
+	values := sas.BlobSignatureValues{
+		Protocol:      sas.ProtocolHTTPSandHTTP,
+		ExpiryTime:    time.Now().Add(ttl).UTC(),
+		Permissions:   (&sas.BlobPermissions{Read: true}).String(),
+		ContainerName: handler.policy.BucketName,
+		BlobName:      e.Source(),
+	}
+	if args.IsDownload {
+		encodedFilename := url.PathEscape(args.DisplayName)
+		values.ContentDisposition = fmt.Sprintf(`attachment; filename="%s"; filename*=UTF-8''%s`,
+			encodedFilename, encodedFilename)
+	}
+
+	return handler.signURL(blobURL, values, "")
+}
+
+// Token 获取上传策略和认证Token
+func (handler *Driver) Token(ctx context.Context, uploadSession *fs.UploadSession, file *fs.UploadRequest) (*fs.UploadCredential, error) {
+	// Check for duplicated file
+	if _, err := handler.Meta(ctx, file.Props.SavePath); err == nil {
+		return nil, fs.ErrFileExisted
+	}
+
+	// 生成回调地址
+	siteURL := handler.settings.SiteURL(setting.UseFirstSiteUrl(ctx))
+	uploadSession.ChunkSize = handler.chunkSize
+	uploadSession.Callback = routes.MasterSlaveCallbackUrl(siteURL, types.PolicyTypeAzblob, uploadSession.Props.UploadSessionID, uploadSession.CallbackSecret).String()
+
+	mimeType := file.Props.MimeType
+	if mimeType == "" {
+		mimeType = handler.mime.TypeByName(file.Props.Uri.Name())
+	}
+
+	// SAS token is only valid for the blob being uploaded
+	blobURL := handler.container.NewBlobClient(uploadSession.Props.SavePath).URL()
+	values := sas.BlobSignatureValues{
+		Protocol:      sas.ProtocolHTTPSandHTTP,
+		ExpiryTime:    uploadSession.Props.ExpireAt.UTC(),
+		Permissions:   (&sas.BlobPermissions{Create: true, Write: true}).String(),
+		ContainerName: handler.policy.BucketName,
+		BlobName:      uploadSession.Props.SavePath,
+	}
+
+	// 为每个分片签名上传 URL
+	chunks := chunk.NewChunkGroup(file, handler.chunkSize, &backoff.ConstantBackoff{}, false, handler.l, "")
+	urls := make([]string, chunks.Num())
+	for chunks.Next() {
+		err := chunks.Process(func(c *chunk.ChunkGroup, chunk io.Reader) error {
+			signedURL, err := handler.signURL(blobURL, values, "comp=block&blockid="+url.QueryEscape(BlockID(c.Index())))
+			if err != nil {
+				return err
+			}
+
+			urls[c.Index()] = signedURL
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// 签名提交分片列表的请求URL
+	completeURL, err := handler.signURL(blobURL, values, "comp=blocklist")
+	if err != nil {
+		return nil, err
+	}
+
+	// 生成上传凭证
+	return &fs.UploadCredential{
+		UploadURLs:  urls,
+		CompleteURL: completeURL,
+		SessionID:   uploadSession.Props.UploadSessionID,
+		ChunkSize:   handler.chunkSize,
+		MimeType:    mimeType,
+	}, nil
+}
+
+// BlockID returns the base64 encoded block ID of given chunk index. IDs of all blocks in a blob
+// must have the same length.
+func BlockID(index int) string {
+	return base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%08d", index)))
+}
+
+// signURL appends signed SAS token and extra query to given blob URL.
+func (handler *Driver) signURL(blobURL string, values sas.BlobSignatureValues, query string) (string, error) {
+	qps, err := values.SignWithSharedKey(handler.cred)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign SAS token: %w", err)
+	}
+
+	res := blobURL + "?" + qps.Encode()
+	if query != "" {
+		res += "&" + query
+	}
+
+	return res, nil
+}
+
+// Meta 获取文件信息
+func (handler *Driver) Meta(ctx context.Context, path string) (*MetaData, error) {
+	res, err := handler.container.NewBlobClient(path).GetProperties(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.ContentLength == nil {
+		return nil, errors.New("invalid response from Azure: missing ContentLength")
+	}
+
+	etag := ""
+	if res.ETag != nil {
+		etag = string(*res.ETag)
+	}
+
+	return &MetaData{
+		Size: *res.ContentLength,
+		Etag: etag,
+	}, nil
+}
+
+// CORS 创建跨域策略
+func (handler *Driver) CORS() error {
+	_, err := handler.client.ServiceClient().SetProperties(context.Background(), &service.SetPropertiesOptions{
+		CORS: []*service.CORSRule{
+			{
+				AllowedMethods:  to.Ptr("GET,POST,PUT,DELETE,HEAD"),
+				AllowedOrigins:  to.Ptr("*"),
+				AllowedHeaders:  to.Ptr("*"),
+				ExposedHeaders:  to.Ptr("ETag"),
+				MaxAgeInSeconds: to.Ptr(int32(3600)),
+			},
+		},
+	})
+
+	return err
+}
+
+// 取消上传凭证
+func (handler *Driver) CancelToken(ctx context.Context, uploadSession *fs.UploadSession) error {
+	// Uncommitted blocks are garbage collected by Azure, only committed blob needs to be deleted.
+	_, err := handler.container.NewBlobClient(uploadSession.Props.SavePath).Delete(ctx, nil)
+	if err != nil && !bloberror.HasCode(err, bloberror.BlobNotFound) {
+		return err
+	}
+
+	return nil
+}
+
+func (handler *Driver) Capabilities() *driver.Capabilities {
+	return &driver.Capabilities{
+		StaticFeatures: features,
+		MediaMetaProxy: handler.policy.Settings.MediaMetaGeneratorProxy,
+		ThumbProxy:     handler.policy.Settings.ThumbGeneratorProxy,
+	}
+}
+
+func (handler *Driver) MediaMeta(ctx context.Context, path, ext, language string) ([]driver.MediaMeta, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (handler *Driver) LocalPath(ctx context.Context, path string) string {
+	return ""
+}
+
+func (handler *Driver) CompleteUpload(ctx context.Context, session *fs.UploadSession) error {
+	if session.SentinelTaskID == 0 {
+		return nil
+	}
+
+	// Make sure uploaded file size is correct
+	res, err := handler.Meta(ctx, session.Props.SavePath)
+	if err != nil {
+		return fmt.Errorf("failed to get uploaded file size: %w", err)
+	}
+
+	if res.Size != session.Props.Size {
+		return serializer.NewError(
+			serializer.CodeMetaMismatch,
+			fmt.Sprintf("File size not match, expected: %d, actual: %d", session.Props.Size, res.Size),
+			nil,
+		)
+	}
+	return nil
+}
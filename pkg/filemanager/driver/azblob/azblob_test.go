@@ -0,0 +1,413 @@
+package azblob_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency/deptest"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver"
+	azblobdriver "github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver/azblob"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// Well-known development account of Azurite.
+	testAccount    = "devstoreaccount1"
+	testAccountKey = "Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCz4I6tq/K1SZFPTOtr/KBHBeksoGMGw=="
+	testContainer  = "cloudreve"
+)
+
+type (
+	// blobStub is an Azurite-like in-memory Blob service of one container, supporting the subset of
+	// API used by the driver. Requests must carry a shared key authorization or a SAS signature.
+	blobStub struct {
+		*httptest.Server
+
+		mu     sync.Mutex
+		blobs  map[string]*stubBlob
+		staged map[string][]byte
+	}
+
+	stubBlob struct {
+		data        []byte
+		contentType string
+		modified    time.Time
+	}
+
+	enumerationResults struct {
+		XMLName   xml.Name `xml:"EnumerationResults"`
+		Prefix    string   `xml:"Prefix"`
+		Delimiter string   `xml:"Delimiter,omitempty"`
+		Blobs     struct {
+			Blob       []listedBlob `xml:"Blob"`
+			BlobPrefix []listedBlob `xml:"BlobPrefix"`
+		} `xml:"Blobs"`
+		NextMarker string `xml:"NextMarker"`
+	}
+
+	listedBlob struct {
+		Name       string          `xml:"Name"`
+		Properties *blobProperties `xml:"Properties,omitempty"`
+	}
+
+	blobProperties struct {
+		LastModified  string `xml:"Last-Modified"`
+		ContentLength int64  `xml:"Content-Length"`
+		ContentType   string `xml:"Content-Type"`
+		BlobType      string `xml:"BlobType"`
+	}
+
+	blockList struct {
+		Latest []string `xml:"Latest"`
+	}
+)
+
+func newBlobStub(t *testing.T) *blobStub {
+	s := &blobStub{
+		blobs:  make(map[string]*stubBlob),
+		staged: make(map[string][]byte),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *blobStub) endpoint() string {
+	return s.URL + "/" + testAccount
+}
+
+func (s *blobStub) content(name string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, ok := s.blobs[name]
+	if !ok {
+		return nil, false
+	}
+	return b.data, true
+}
+
+func (s *blobStub) serve(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "SharedKey "+testAccount+":") && query.Get("sig") == "" {
+		stubError(w, http.StatusForbidden, "AuthenticationFailed")
+		return
+	}
+
+	// Path-style URL: /{account}/{container}/{blob}
+	parts := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 3)
+	if len(parts) < 2 || parts[0] != testAccount || parts[1] != testContainer {
+		stubError(w, http.StatusNotFound, "ContainerNotFound")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(parts) == 2 {
+		if r.Method == http.MethodGet && query.Get("restype") == "container" && query.Get("comp") == "list" {
+			s.list(w, query.Get("prefix"), query.Get("delimiter"))
+			return
+		}
+
+		stubError(w, http.StatusBadRequest, "UnsupportedQueryParameter")
+		return
+	}
+
+	name := parts[2]
+	switch {
+	case r.Method == http.MethodPut && query.Get("comp") == "block":
+		body, _ := io.ReadAll(r.Body)
+		s.staged[name+"/"+query.Get("blockid")] = body
+		w.WriteHeader(http.StatusCreated)
+	case r.Method == http.MethodPut && query.Get("comp") == "blocklist":
+		var list blockList
+		if err := xml.NewDecoder(r.Body).Decode(&list); err != nil {
+			stubError(w, http.StatusBadRequest, "InvalidXmlDocument")
+			return
+		}
+
+		var data []byte
+		for _, id := range list.Latest {
+			block, ok := s.staged[name+"/"+id]
+			if !ok {
+				stubError(w, http.StatusBadRequest, "InvalidBlockList")
+				return
+			}
+			data = append(data, block...)
+		}
+		s.put(w, name, data, r.Header.Get("x-ms-blob-content-type"))
+	case r.Method == http.MethodPut:
+		data, _ := io.ReadAll(r.Body)
+		s.put(w, name, data, r.Header.Get("x-ms-blob-content-type"))
+	case r.Method == http.MethodHead || r.Method == http.MethodGet:
+		b, ok := s.blobs[name]
+		if !ok {
+			stubError(w, http.StatusNotFound, "BlobNotFound")
+			return
+		}
+
+		w.Header().Set("Content-Length", strconv.Itoa(len(b.data)))
+		w.Header().Set("Content-Type", b.contentType)
+		w.Header().Set("Last-Modified", b.modified.Format(http.TimeFormat))
+		w.Header().Set("ETag", stubETag(b))
+		w.Header().Set("x-ms-blob-type", "BlockBlob")
+		if disposition := query.Get("rscd"); disposition != "" {
+			w.Header().Set("Content-Disposition", disposition)
+		}
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodGet {
+			w.Write(b.data)
+		}
+	case r.Method == http.MethodDelete:
+		if _, ok := s.blobs[name]; !ok {
+			stubError(w, http.StatusNotFound, "BlobNotFound")
+			return
+		}
+
+		delete(s.blobs, name)
+		w.WriteHeader(http.StatusAccepted)
+	default:
+		stubError(w, http.StatusMethodNotAllowed, "UnsupportedHttpVerb")
+	}
+}
+
+func (s *blobStub) put(w http.ResponseWriter, name string, data []byte, contentType string) {
+	b := &stubBlob{data: data, contentType: contentType, modified: time.Now().UTC()}
+	s.blobs[name] = b
+	w.Header().Set("ETag", stubETag(b))
+	w.Header().Set("Last-Modified", b.modified.Format(http.TimeFormat))
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (s *blobStub) list(w http.ResponseWriter, prefix, delimiter string) {
+	res := enumerationResults{Prefix: prefix, Delimiter: delimiter}
+	names := lo.Keys(s.blobs)
+	sort.Strings(names)
+	prefixes := make(map[string]bool)
+	for _, name := range names {
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+
+		if delimiter != "" {
+			if i := strings.Index(name[len(prefix):], delimiter); i >= 0 {
+				dir := name[:len(prefix)+i+len(delimiter)]
+				if !prefixes[dir] {
+					prefixes[dir] = true
+					res.Blobs.BlobPrefix = append(res.Blobs.BlobPrefix, listedBlob{Name: dir})
+				}
+				continue
+			}
+		}
+
+		b := s.blobs[name]
+		res.Blobs.Blob = append(res.Blobs.Blob, listedBlob{Name: name, Properties: &blobProperties{
+			LastModified:  b.modified.Format(http.TimeFormat),
+			ContentLength: int64(len(b.data)),
+			ContentType:   b.contentType,
+			BlobType:      "BlockBlob",
+		}})
+	}
+
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(http.StatusOK)
+	io.WriteString(w, xml.Header)
+	xml.NewEncoder(w).Encode(res)
+}
+
+func stubETag(b *stubBlob) string {
+	return fmt.Sprintf(`"0x%X"`, b.modified.UnixNano())
+}
+
+func stubError(w http.ResponseWriter, status int, code string) {
+	w.Header().Set("x-ms-error-code", code)
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	fmt.Fprintf(w, "%s<Error><Code>%s</Code><Message>%s</Message></Error>", xml.Header, code, code)
+}
+
+func newUploadRequest(t *testing.T, savePath string, content []byte, mode fs.WriteMode) *fs.UploadRequest {
+	uri, err := fs.NewUriFromString("cloudreve://my/" + savePath)
+	require.NoError(t, err)
+
+	reader := bytes.NewReader(content)
+	return &fs.UploadRequest{
+		Props:  &fs.UploadProps{Uri: uri, SavePath: savePath, Size: int64(len(content))},
+		Mode:   mode,
+		File:   io.NopCloser(reader),
+		Seeker: reader,
+	}
+}
+
+func newTestDriver(t *testing.T, stub *blobStub, private bool) *azblobdriver.Driver {
+	dep := deptest.New(t)
+	handler, err := azblobdriver.New(context.Background(), &ent.StoragePolicy{
+		ID:         1,
+		Type:       types.PolicyTypeAzblob,
+		Server:     stub.endpoint(),
+		BucketName: testContainer,
+		AccessKey:  testAccount,
+		SecretKey:  testAccountKey,
+		IsPrivate:  private,
+		Settings:   &types.PolicySetting{ChunkSize: 8},
+	}, dep.SettingProvider(), dep.ConfigProvider(), dep.Logger(), dep.MimeDetector(context.Background()))
+	require.NoError(t, err)
+	return handler
+}
+
+func TestDriver_RoundTrip(t *testing.T) {
+	a := assert.New(t)
+	stub := newBlobStub(t)
+	handler := newTestDriver(t, stub, true)
+	ctx := context.Background()
+
+	// Content larger than chunk size is staged in blocks and committed.
+	content := []byte("hello azure blob policy")
+	require.NoError(t, handler.Put(ctx, newUploadRequest(t, "uploads/sub/a.txt", content, fs.ModeNone)))
+	require.NoError(t, handler.Put(ctx, newUploadRequest(t, "uploads/b.txt", []byte("old"), fs.ModeNone)))
+	a.ErrorIs(handler.Put(ctx, newUploadRequest(t, "uploads/b.txt", []byte("new"), fs.ModeNone)), fs.ErrFileExisted)
+	require.NoError(t, handler.Put(ctx, newUploadRequest(t, "uploads/b.txt", []byte("new"), fs.ModeOverwrite)))
+
+	stored, ok := stub.content("uploads/sub/a.txt")
+	require.True(t, ok)
+	a.Equal(content, stored)
+	stored, _ = stub.content("uploads/b.txt")
+	a.Equal([]byte("new"), stored)
+
+	meta, err := handler.Meta(ctx, "uploads/sub/a.txt")
+	require.NoError(t, err)
+	a.Equal(int64(len(content)), meta.Size)
+	a.NotEmpty(meta.Etag)
+
+	// List
+	objects, err := handler.List(ctx, "uploads", func(int) {}, false)
+	require.NoError(t, err)
+	a.ElementsMatch([]string{"b.txt", "sub"}, lo.Map(objects, func(o fs.PhysicalObject, _ int) string { return o.RelativePath }))
+	for _, o := range objects {
+		a.Equal(o.RelativePath == "sub", o.IsDir, o.RelativePath)
+	}
+
+	listed := 0
+	objects, err = handler.List(ctx, "/uploads", func(i int) { listed += i }, true)
+	require.NoError(t, err)
+	a.ElementsMatch([]string{"b.txt", "sub/a.txt"}, lo.Map(objects, func(o fs.PhysicalObject, _ int) string { return o.RelativePath }))
+	a.Equal(2, listed)
+	for _, o := range objects {
+		if o.RelativePath == "sub/a.txt" {
+			a.Equal("uploads/sub/a.txt", o.Source)
+			a.Equal(int64(len(content)), o.Size)
+		}
+	}
+
+	// Source of private container is signed.
+	entity := fs.NewEntity(&ent.Entity{Source: "uploads/sub/a.txt", Size: int64(len(content))})
+	source, err := handler.Source(ctx, entity, &driver.GetSourceArgs{IsDownload: true, DisplayName: "a b.txt"})
+	require.NoError(t, err)
+	signed, err := url.Parse(source)
+	require.NoError(t, err)
+	a.Equal("/"+testAccount+"/"+testContainer+"/uploads/sub/a.txt", signed.Path)
+	a.Equal("r", signed.Query().Get("sp"))
+	resp, err := http.Get(source)
+	require.NoError(t, err)
+	downloaded, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	a.Equal(http.StatusOK, resp.StatusCode)
+	a.Equal(content, downloaded)
+	a.Contains(resp.Header.Get("Content-Disposition"), "attachment")
+
+	// Delete, missing blobs are ignored.
+	failed, err := handler.Delete(ctx, "uploads/sub/a.txt", "uploads/missing.txt")
+	a.NoError(err)
+	a.Empty(failed)
+	_, ok = stub.content("uploads/sub/a.txt")
+	a.False(ok)
+	_, err = handler.Meta(ctx, "uploads/sub/a.txt")
+	a.Error(err)
+}
+
+func TestDriver_PublicSource(t *testing.T) {
+	a := assert.New(t)
+	stub := newBlobStub(t)
+	handler := newTestDriver(t, stub, false)
+
+	entity := fs.NewEntity(&ent.Entity{Source: "uploads/a.txt"})
+	source, err := handler.Source(context.Background(), entity, &driver.GetSourceArgs{})
+	require.NoError(t, err)
+	parsed, err := url.Parse(source)
+	require.NoError(t, err)
+	a.Equal("/"+testAccount+"/"+testContainer+"/uploads/a.txt", parsed.Path)
+	a.Empty(parsed.RawQuery, "public blobs are not signed")
+}
+
+func TestDriver_DirectUpload(t *testing.T) {
+	a := assert.New(t)
+	stub := newBlobStub(t)
+	handler := newTestDriver(t, stub, true)
+	ctx := context.Background()
+
+	content := []byte("uploaded from client directly")
+	file := newUploadRequest(t, "uploads/direct.txt", content, fs.ModeNone)
+	expire := time.Now().Add(time.Hour)
+	file.Props.ExpireAt = expire
+	session := &fs.UploadSession{Props: file.Props, CallbackSecret: "secret", SentinelTaskID: 1}
+	credential, err := handler.Token(ctx, session, file)
+	require.NoError(t, err)
+	require.Len(t, credential.UploadURLs, 4, "one signed URL per 8 bytes chunk")
+
+	// Client uploads blocks and commits the block list with signed URLs.
+	ids := make([]string, 0, len(credential.UploadURLs))
+	for i, u := range credential.UploadURLs {
+		end := min(len(content), (i+1)*8)
+		req, err := http.NewRequest(http.MethodPut, u, bytes.NewReader(content[i*8:end]))
+		require.NoError(t, err)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		ids = append(ids, azblobdriver.BlockID(i))
+	}
+
+	blocks, err := xml.Marshal(struct {
+		XMLName xml.Name `xml:"BlockList"`
+		blockList
+	}{blockList: blockList{Latest: ids}})
+	require.NoError(t, err)
+	req, err := http.NewRequest(http.MethodPut, credential.CompleteURL, bytes.NewReader(blocks))
+	require.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	require.NoError(t, handler.CompleteUpload(ctx, session))
+	stored, _ := stub.content("uploads/direct.txt")
+	a.Equal(content, stored)
+
+	_, err = handler.Token(ctx, session, file)
+	a.ErrorIs(err, fs.ErrFileExisted)
+
+	session.Props.Size++
+	a.Error(handler.CompleteUpload(ctx, session), "size mismatch")
+
+	require.NoError(t, handler.CancelToken(ctx, session))
+	_, ok := stub.content("uploads/direct.txt")
+	a.False(ok, "committed blob is deleted on cancel")
+}
@@ -0,0 +1,407 @@
+package googledrive
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/chunk"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/chunk/backoff"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+)
+
+const (
+	chunkRetrySleep = time.Second * 5
+	listPageSize    = 1000
+)
+
+// apiURL returns the URL of given Drive API, shared drives are always supported.
+func (client *client) apiURL(api string, query url.Values) string {
+	if query == nil {
+		query = url.Values{}
+	}
+	query.Set("supportsAllDrives", "true")
+
+	u := client.endpoint.JoinPath(api)
+	u.RawQuery = query.Encode()
+	return u.String()
+}
+
+// ListChildren lists all files in given folder.
+func (client *client) ListChildren(ctx context.Context, p string) ([]FileInfo, error) {
+	id, err := client.resolve(ctx, p, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.listFiles(ctx, fmt.Sprintf("'%s' in parents and trashed = false", escapeQuery(id)), 0)
+}
+
+// Meta gets file metadata of given path.
+func (client *client) Meta(ctx context.Context, p string) (*FileInfo, error) {
+	parentID, err := client.resolve(ctx, path.Dir(p), false)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.findChild(ctx, parentID, path.Base(p))
+}
+
+// Download opens file content of given path from offset.
+func (client *client) Download(ctx context.Context, p string, offset int64) (io.ReadCloser, error) {
+	info, err := client.Meta(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	h := http.Header{}
+	if offset > 0 {
+		h.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+
+	resp, err := client.request(ctx, http.MethodGet, client.apiURL("drive/v3/files/"+info.ID, url.Values{"alt": {"media"}}), nil,
+		request.WithHeader(h))
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Body, nil
+}
+
+// Upload uploads file stream with a resumable upload session. Existing file with the same name
+// is updated in place if overwrite is enabled.
+func (client *client) Upload(ctx context.Context, file *fs.UploadRequest) error {
+	dst := file.Props.SavePath
+	parentID, err := client.resolve(ctx, path.Dir(dst), true)
+	if err != nil {
+		return err
+	}
+
+	var (
+		method   = http.MethodPost
+		api      = "upload/drive/v3/files"
+		metadata = fileMetadata{Name: path.Base(dst), Parents: []string{parentID}}
+	)
+	existing, err := client.findChild(ctx, parentID, path.Base(dst))
+	if err == nil {
+		if file.Mode&fs.ModeOverwrite != fs.ModeOverwrite {
+			return errors.New("file with the same name existed or unavailable")
+		}
+
+		method = http.MethodPatch
+		api += "/" + existing.ID
+		metadata = fileMetadata{}
+	} else if !errors.Is(err, ErrObjectNotFound) {
+		return err
+	}
+
+	sessionURL, err := client.createUploadSession(ctx, method, api, &metadata, file.Props.Size)
+	if err != nil {
+		return err
+	}
+
+	chunks := chunk.NewChunkGroup(file, client.chunkSize, &backoff.ConstantBackoff{
+		Max:   client.settings.ChunkRetryLimit(ctx),
+		Sleep: chunkRetrySleep,
+	}, client.settings.UseChunkBuffer(ctx), client.l, client.settings.TempPath(ctx))
+
+	uploadFunc := func(current *chunk.ChunkGroup, content io.Reader) error {
+		return client.uploadChunk(ctx, sessionURL, content, current)
+	}
+
+	for chunks.Next() {
+		if err := chunks.Process(uploadFunc); err != nil {
+			client.cancelUploadSession(ctx, sessionURL)
+			return fmt.Errorf("failed to upload chunk #%d: %w", chunks.Index(), err)
+		}
+	}
+
+	return nil
+}
+
+// Delete deletes file at given path permanently, missing file is ignored.
+func (client *client) Delete(ctx context.Context, p string) error {
+	info, err := client.Meta(ctx, p)
+	if err != nil {
+		if errors.Is(err, ErrObjectNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	resp, err := client.request(ctx, http.MethodDelete, client.apiURL("drive/v3/files/"+info.ID, nil), nil)
+	if err != nil {
+		var apiErr *RespError
+		if errors.As(err, &apiErr) && apiErr.NotFound() {
+			return nil
+		}
+		return err
+	}
+
+	return resp.Body.Close()
+}
+
+func (client *client) createUploadSession(ctx context.Context, method, api string, metadata *fileMetadata, size int64) (string, error) {
+	body, err := json.Marshal(metadata)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := client.request(ctx, method, client.apiURL(api, url.Values{"uploadType": {"resumable"}}), bytes.NewReader(body),
+		request.WithContentLength(int64(len(body))),
+		request.WithHeader(http.Header{
+			"Content-Type":            {"application/json; charset=UTF-8"},
+			"X-Upload-Content-Length": {strconv.FormatInt(size, 10)},
+		}),
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to create upload session: %w", err)
+	}
+	resp.Body.Close()
+
+	sessionURL := resp.Header.Get("Location")
+	if sessionURL == "" {
+		return "", errors.New("upload session URL is empty")
+	}
+
+	return sessionURL, nil
+}
+
+// uploadChunk uploads one chunk to upload session, 308 indicates more chunks are expected.
+func (client *client) uploadChunk(ctx context.Context, sessionURL string, content io.Reader, current *chunk.ChunkGroup) error {
+	contentRange := current.RangeHeader()
+	if current.Total() == 0 {
+		contentRange = "bytes */0"
+	}
+
+	resp, err := client.request(ctx, http.MethodPut, sessionURL, content,
+		request.WithContentLength(current.Length()),
+		request.WithHeader(http.Header{"Content-Range": {contentRange}}),
+	)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode == http.StatusPermanentRedirect && current.IsLast() {
+		return fmt.Errorf("upload session is not completed after last chunk, range received: %q", resp.Header.Get("Range"))
+	}
+
+	return nil
+}
+
+// cancelUploadSession cancels given upload session, errors are only logged.
+func (client *client) cancelUploadSession(ctx context.Context, sessionURL string) {
+	resp := client.httpClient.Request(http.MethodDelete, sessionURL, nil, request.WithContext(ctx))
+	if resp.Err != nil {
+		client.l.Warning("Failed to cancel upload session: %s", resp.Err)
+		return
+	}
+	resp.Response.Body.Close()
+}
+
+// resolve returns ID of folder at given path, missing folders are created if create is true.
+func (client *client) resolve(ctx context.Context, p string, create bool) (string, error) {
+	p = strings.Trim(path.Clean("/"+p), "/")
+
+	client.mu.Lock()
+	id, ok := client.folders[p]
+	client.mu.Unlock()
+	if ok {
+		return id, nil
+	}
+
+	parent := path.Dir(p)
+	if parent == "." {
+		parent = ""
+	}
+	parentID, err := client.resolve(ctx, parent, create)
+	if err != nil {
+		return "", err
+	}
+
+	info, err := client.findChild(ctx, parentID, path.Base(p))
+	if errors.Is(err, ErrObjectNotFound) && create {
+		info, err = client.createFolder(ctx, parentID, path.Base(p))
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve folder %q: %w", p, err)
+	}
+
+	if !info.IsFolder() {
+		return "", fmt.Errorf("%q is not a folder", p)
+	}
+
+	client.mu.Lock()
+	client.folders[p] = info.ID
+	client.mu.Unlock()
+	return info.ID, nil
+}
+
+// findChild finds file with given name in folder. Drive allows duplicated names, the most
+// recently modified one is returned.
+func (client *client) findChild(ctx context.Context, parentID, name string) (*FileInfo, error) {
+	q := fmt.Sprintf("name = '%s' and '%s' in parents and trashed = false", escapeQuery(name), escapeQuery(parentID))
+	files, err := client.listFiles(ctx, q, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(files) == 0 {
+		return nil, ErrObjectNotFound
+	}
+
+	return &files[0], nil
+}
+
+func (client *client) createFolder(ctx context.Context, parentID, name string) (*FileInfo, error) {
+	info := &FileInfo{}
+	err := client.requestJSON(ctx, http.MethodPost, client.apiURL("drive/v3/files", url.Values{"fields": {fileFields}}),
+		&fileMetadata{Name: name, MimeType: folderMimeType, Parents: []string{parentID}}, info)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create folder %q: %w", name, err)
+	}
+
+	return info, nil
+}
+
+// listFiles lists files matching query q, limit 0 lists all pages.
+func (client *client) listFiles(ctx context.Context, q string, limit int) ([]FileInfo, error) {
+	query := url.Values{
+		"q":                         {q},
+		"fields":                    {"nextPageToken,files(" + fileFields + ")"},
+		"orderBy":                   {"modifiedTime desc"},
+		"pageSize":                  {strconv.Itoa(listPageSize)},
+		"includeItemsFromAllDrives": {"true"},
+	}
+	if limit > 0 {
+		query.Set("pageSize", strconv.Itoa(limit))
+	}
+	if client.policy.Settings != nil && client.policy.Settings.GoogleDriveID != "" {
+		query.Set("corpora", "drive")
+		query.Set("driveId", client.policy.Settings.GoogleDriveID)
+	}
+
+	var res []FileInfo
+	for {
+		var page ListResponse
+		if err := client.requestJSON(ctx, http.MethodGet, client.apiURL("drive/v3/files", query), nil, &page); err != nil {
+			return nil, err
+		}
+
+		res = append(res, page.Files...)
+		if page.NextPageToken == "" || (limit > 0 && len(res) >= limit) {
+			break
+		}
+
+		query.Set("pageToken", page.NextPageToken)
+	}
+
+	return res, nil
+}
+
+// requestJSON sends request with JSON body and decodes JSON response into out.
+func (client *client) requestJSON(ctx context.Context, method, u string, body, out any) error {
+	var (
+		reader io.Reader
+		opts   []request.Option
+	)
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+
+		reader = bytes.NewReader(b)
+		opts = append(opts,
+			request.WithContentLength(int64(len(b))),
+			request.WithHeader(http.Header{"Content-Type": {"application/json; charset=UTF-8"}}),
+		)
+	}
+
+	resp, err := client.request(ctx, method, u, reader, opts...)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return nil
+}
+
+// request sends an authorized request to Drive API, error responses are decoded as RespError.
+func (client *client) request(ctx context.Context, method, u string, body io.Reader, opts ...request.Option) (*http.Response, error) {
+	token, err := client.accessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	opts = append([]request.Option{
+		request.WithHeader(http.Header{"Authorization": {"Bearer " + token}}),
+		request.WithContext(ctx),
+		request.WithTPSLimit(
+			fmt.Sprintf("policy_%d", client.policy.ID),
+			client.policy.Settings.TPSLimit,
+			client.policy.Settings.TPSLimitBurst,
+		),
+	}, opts...)
+
+	res := client.httpClient.Request(method, u, body, opts...)
+	if res.Err != nil {
+		return nil, res.Err
+	}
+
+	if res.Response.StatusCode < 400 {
+		return res.Response, nil
+	}
+
+	respBody, err := res.GetResponse()
+	if err != nil {
+		return nil, err
+	}
+
+	errResp := &RespError{}
+	if err := json.Unmarshal([]byte(respBody), errResp); err != nil || errResp.APIError.Code == 0 {
+		client.l.Debug("Google Drive returns unknown response: %s", respBody)
+		return nil, fmt.Errorf("unexpected status code %d", res.Response.StatusCode)
+	}
+
+	if isRateLimited(errResp) {
+		client.l.Warning("Google Drive request is throttled.")
+		return nil, backoff.NewRetryableErrorFromHeader(errResp, res.Response.Header)
+	}
+
+	return nil, errResp
+}
+
+func isRateLimited(err *RespError) bool {
+	if err.APIError.Code == http.StatusTooManyRequests {
+		return true
+	}
+
+	for _, e := range err.APIError.Errors {
+		if e.Reason == "rateLimitExceeded" || e.Reason == "userRateLimitExceeded" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// escapeQuery escapes string literal in Drive search query.
+func escapeQuery(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(s)
+}
@@ -0,0 +1,87 @@
+package googledrive
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/url"
+	"sync"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/pkg/credmanager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+)
+
+const (
+	defaultEndpoint = "https://www.googleapis.com"
+	rootFolderID    = "root"
+)
+
+var (
+	// ErrInvalidRefreshToken no valid refresh token in the policy
+	ErrInvalidRefreshToken = errors.New("no valid refresh token in this policy")
+	// ErrThumbNotAvailable Drive cannot generate thumbnail for the file
+	ErrThumbNotAvailable = errors.New("thumbnail not available")
+	// ErrObjectNotFound file or folder at given path does not exist
+	ErrObjectNotFound = errors.New("object not found")
+)
+
+type Client interface {
+	ListChildren(ctx context.Context, path string) ([]FileInfo, error)
+	Meta(ctx context.Context, path string) (*FileInfo, error)
+	Download(ctx context.Context, path string, offset int64) (io.ReadCloser, error)
+	Upload(ctx context.Context, file *fs.UploadRequest) error
+	Delete(ctx context.Context, path string) error
+	OAuthURL(ctx context.Context, state string) string
+	ObtainToken(ctx context.Context, code, refreshToken string) (*Credential, error)
+}
+
+// client Google Drive API client
+type client struct {
+	policy   *ent.StoragePolicy
+	endpoint *url.URL
+
+	httpClient request.Client
+	cred       credmanager.CredManager
+	l          logging.Logger
+	settings   setting.Provider
+
+	chunkSize int64
+
+	// folders caches resolved IDs of folder paths
+	mu      sync.Mutex
+	folders map[string]string
+}
+
+// NewClient creates a new client from given storage policy. Policy.Server overrides the API
+// endpoint if set.
+func NewClient(policy *ent.StoragePolicy, httpClient request.Client, cred credmanager.CredManager,
+	l logging.Logger, settings setting.Provider, chunkSize int64) Client {
+	endpoint, err := url.Parse(policy.Server)
+	if err != nil || endpoint.Host == "" {
+		endpoint, _ = url.Parse(defaultEndpoint)
+	}
+
+	return &client{
+		policy:     policy,
+		endpoint:   endpoint,
+		httpClient: httpClient,
+		cred:       cred,
+		l:          l,
+		settings:   settings,
+		chunkSize:  chunkSize,
+		folders:    map[string]string{"": rootID(policy)},
+	}
+}
+
+// rootID returns the ID of root folder, which is the ID of shared drive if it's configured.
+func rootID(policy *ent.StoragePolicy) string {
+	if policy.Settings != nil && policy.Settings.GoogleDriveID != "" {
+		return policy.Settings.GoogleDriveID
+	}
+
+	return rootFolderID
+}
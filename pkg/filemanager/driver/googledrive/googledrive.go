@@ -0,0 +1,198 @@
+package googledrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/pkg/boolset"
+	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
+	"github.com/cloudreve/Cloudreve/v4/pkg/credmanager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+)
+
+const (
+	defaultChunkSize = 50 << 20 // 50MB
+	// Chunk size of resumable upload must be a multiple of 256 KiB
+	chunkSizeUnit = 256 << 10
+)
+
+var (
+	features = &boolset.BooleanSet{}
+
+	thumbSizeSuffix = regexp.MustCompile(`=s\d+$`)
+)
+
+func init() {
+	boolset.Sets(map[driver.HandlerCapability]bool{
+		driver.HandlerCapabilityProxyRequired: true,
+	}, features)
+}
+
+// Driver stores files in Google Drive. Policy.BucketName and Policy.SecretKey are the OAuth
+// client ID and secret, Policy.AccessKey is the refresh token. Files are stored in a shared
+// drive if Settings.GoogleDriveID is set.
+type Driver struct {
+	policy   *ent.StoragePolicy
+	client   Client
+	settings setting.Provider
+	l        logging.Logger
+}
+
+func New(ctx context.Context, policy *ent.StoragePolicy, settings setting.Provider,
+	config conf.ConfigProvider, l logging.Logger, cred credmanager.CredManager) (*Driver, error) {
+	chunkSize := policy.Settings.ChunkSize
+	if chunkSize == 0 {
+		chunkSize = defaultChunkSize
+	}
+	chunkSize = max(chunkSize/chunkSizeUnit, 1) * chunkSizeUnit
+
+	return &Driver{
+		policy:   policy,
+		client:   NewClient(policy, request.NewClient(config, request.WithLogger(l)), cred, l, settings, chunkSize),
+		settings: settings,
+		l:        l,
+	}, nil
+}
+
+func (handler *Driver) List(ctx context.Context, base string, onProgress driver.ListProgressFunc, recursive bool) ([]fs.PhysicalObject, error) {
+	base = strings.Trim(base, "/")
+
+	var res []fs.PhysicalObject
+	queue := []string{base}
+	for len(queue) > 0 {
+		dir := queue[0]
+		queue = queue[1:]
+
+		objects, err := handler.client.ListChildren(ctx, dir)
+		if err != nil {
+			if dir == base {
+				return nil, err
+			}
+
+			handler.l.Warning("Failed to walk folder %q: %s", dir, err)
+			continue
+		}
+
+		for _, object := range objects {
+			// Workspace documents cannot be downloaded as is
+			if !object.IsFolder() && strings.HasPrefix(object.MimeType, workspaceMimeTypePrefix) {
+				continue
+			}
+
+			source := path.Join(dir, object.Name)
+			res = append(res, fs.PhysicalObject{
+				Name:         object.Name,
+				RelativePath: strings.TrimPrefix(strings.TrimPrefix(source, base), "/"),
+				Source:       source,
+				Size:         object.Size,
+				IsDir:        object.IsFolder(),
+				LastModify:   object.ModifiedTime,
+			})
+			onProgress(1)
+
+			if recursive && object.IsFolder() {
+				queue = append(queue, source)
+			}
+		}
+	}
+
+	return res, nil
+}
+
+func (handler *Driver) Open(ctx context.Context, path string) (*os.File, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (handler *Driver) LocalPath(ctx context.Context, path string) string {
+	return ""
+}
+
+// OpenStream reads file content from given offset, Drive download URLs require authorization.
+func (handler *Driver) OpenStream(ctx context.Context, path string, offset int64) (io.ReadCloser, error) {
+	return handler.client.Download(ctx, path, offset)
+}
+
+func (handler *Driver) Put(ctx context.Context, file *fs.UploadRequest) error {
+	defer file.Close()
+
+	if file.Offset > 0 {
+		return errors.New("google drive policy does not support chunked relay upload")
+	}
+
+	return handler.client.Upload(ctx, file)
+}
+
+// Delete deletes files permanently, returns paths failed to delete and last error.
+func (handler *Driver) Delete(ctx context.Context, files ...string) ([]string, error) {
+	failed := make([]string, 0, len(files))
+	var retErr error
+	for _, file := range files {
+		if err := handler.client.Delete(ctx, file); err != nil {
+			handler.l.Warning("Failed to delete file %q: %s", file, err)
+			failed = append(failed, file)
+			retErr = err
+		}
+	}
+
+	return failed, retErr
+}
+
+// Thumb returns the thumbnail link generated by Drive, resized to configured thumbnail size.
+func (handler *Driver) Thumb(ctx context.Context, expire *time.Time, ext string, e fs.Entity) (string, error) {
+	info, err := handler.client.Meta(ctx, e.Source())
+	if err != nil {
+		return "", err
+	}
+
+	if info.ThumbnailLink == "" {
+		return "", fmt.Errorf("thumb not supported in Google Drive: %w", ErrThumbNotAvailable)
+	}
+
+	w, h := handler.settings.ThumbSize(ctx)
+	return thumbSizeSuffix.ReplaceAllString(info.ThumbnailLink, fmt.Sprintf("=s%d", max(w, h))), nil
+}
+
+// Source is not implemented, file content is always served by Cloudreve's proxy.
+func (handler *Driver) Source(ctx context.Context, e fs.Entity, args *driver.GetSourceArgs) (string, error) {
+	return "", errors.New("not implemented")
+}
+
+// Token is not supported, Google Drive policy only accepts relayed upload.
+func (handler *Driver) Token(ctx context.Context, uploadSession *fs.UploadSession, file *fs.UploadRequest) (*fs.UploadCredential, error) {
+	return nil, errors.New("google drive policy only supports relayed upload")
+}
+
+func (handler *Driver) CancelToken(ctx context.Context, uploadSession *fs.UploadSession) error {
+	return nil
+}
+
+func (handler *Driver) CompleteUpload(ctx context.Context, session *fs.UploadSession) error {
+	return nil
+}
+
+func (handler *Driver) Capabilities() *driver.Capabilities {
+	return &driver.Capabilities{
+		StaticFeatures:      features,
+		ThumbSupportedExts:  handler.policy.Settings.ThumbExts,
+		ThumbSupportAllExts: handler.policy.Settings.ThumbSupportAllExts,
+		ThumbMaxSize:        handler.policy.Settings.ThumbMaxSize,
+		ThumbProxy:          handler.policy.Settings.ThumbGeneratorProxy,
+		MediaMetaProxy:      handler.policy.Settings.MediaMetaGeneratorProxy,
+	}
+}
+
+func (handler *Driver) MediaMeta(ctx context.Context, path, ext, language string) ([]driver.MediaMeta, error) {
+	return nil, errors.New("not implemented")
+}
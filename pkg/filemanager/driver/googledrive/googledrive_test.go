@@ -0,0 +1,439 @@
+package googledrive_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency/deptest"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cache"
+	"github.com/cloudreve/Cloudreve/v4/pkg/credmanager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver/googledrive"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testPolicyID    = 1
+	testAccessToken = "test-access-token"
+	folderMimeType  = "application/vnd.google-apps.folder"
+)
+
+var (
+	listQuery  = regexp.MustCompile(`^'((?:[^'\\]|\\.)*)' in parents and trashed = false$`)
+	childQuery = regexp.MustCompile(`^name = '((?:[^'\\]|\\.)*)' and '((?:[^'\\]|\\.)*)' in parents and trashed = false$`)
+	unescape   = strings.NewReplacer(`\'`, `'`, `\\`, `\`)
+)
+
+type (
+	// driveStub is a local stand-in of Drive API v3 keeping files in memory, supporting the
+	// subset of API used by the driver. Requests must carry the test access token.
+	driveStub struct {
+		*httptest.Server
+
+		mu       sync.Mutex
+		seq      int
+		files    map[string]*stubFile
+		sessions map[string]*uploadSession
+	}
+
+	stubFile struct {
+		googledrive.FileInfo
+		parent string
+		data   []byte
+	}
+
+	uploadSession struct {
+		file  *stubFile
+		size  int64
+		data  []byte
+		isNew bool
+	}
+)
+
+func newDriveStub(t *testing.T) *driveStub {
+	s := &driveStub{
+		files:    make(map[string]*stubFile),
+		sessions: make(map[string]*uploadSession),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// add puts a file into the stub directly and returns its ID.
+func (s *driveStub) add(parent, name, mimeType string, data []byte) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.create(parent, name, mimeType, data).ID
+}
+
+func (s *driveStub) create(parent, name, mimeType string, data []byte) *stubFile {
+	s.seq++
+	f := &stubFile{
+		FileInfo: googledrive.FileInfo{
+			ID:           fmt.Sprintf("file-%d", s.seq),
+			Name:         name,
+			MimeType:     mimeType,
+			Size:         int64(len(data)),
+			ModifiedTime: time.Now().UTC().Add(time.Duration(s.seq) * time.Millisecond),
+		},
+		parent: parent,
+		data:   data,
+	}
+	s.files[f.ID] = f
+	return f
+}
+
+// find returns the file at given path under root, nil if not exists.
+func (s *driveStub) find(p string) *stubFile {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	parent := "root"
+	var found *stubFile
+	for _, name := range strings.Split(p, "/") {
+		found = nil
+		for _, f := range s.files {
+			if f.parent == parent && f.Name == name {
+				found = f
+				break
+			}
+		}
+		if found == nil {
+			return nil
+		}
+		parent = found.ID
+	}
+
+	return found
+}
+
+func (s *driveStub) serve(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "Bearer "+testAccessToken {
+		stubError(w, http.StatusUnauthorized, "authError")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch p := r.URL.Path; {
+	case p == "/drive/v3/files" && r.Method == http.MethodGet:
+		s.list(w, r.URL.Query().Get("q"))
+	case p == "/drive/v3/files" && r.Method == http.MethodPost:
+		var meta struct {
+			Name     string   `json:"name"`
+			MimeType string   `json:"mimeType"`
+			Parents  []string `json:"parents"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&meta); err != nil || len(meta.Parents) != 1 {
+			stubError(w, http.StatusBadRequest, "badRequest")
+			return
+		}
+		writeJSON(w, http.StatusOK, s.create(meta.Parents[0], meta.Name, meta.MimeType, nil).FileInfo)
+	case strings.HasPrefix(p, "/drive/v3/files/"):
+		f, ok := s.files[strings.TrimPrefix(p, "/drive/v3/files/")]
+		if !ok {
+			stubError(w, http.StatusNotFound, "notFound")
+			return
+		}
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Query().Get("alt") == "media":
+			http.ServeContent(w, r, f.Name, f.ModifiedTime, bytes.NewReader(f.data))
+		case r.Method == http.MethodDelete:
+			delete(s.files, f.ID)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			stubError(w, http.StatusBadRequest, "badRequest")
+		}
+	case strings.HasPrefix(p, "/upload/drive/v3/files") && r.URL.Query().Get("uploadType") == "resumable":
+		s.createSession(w, r)
+	case strings.HasPrefix(p, "/upload/session/"):
+		s.uploadChunk(w, r, strings.TrimPrefix(p, "/upload/session/"))
+	default:
+		stubError(w, http.StatusNotFound, "notFound")
+	}
+}
+
+func (s *driveStub) list(w http.ResponseWriter, q string) {
+	var (
+		parent, name string
+		byName       bool
+	)
+	if m := childQuery.FindStringSubmatch(q); m != nil {
+		name, parent, byName = unescape.Replace(m[1]), unescape.Replace(m[2]), true
+	} else if m := listQuery.FindStringSubmatch(q); m != nil {
+		parent = unescape.Replace(m[1])
+	} else {
+		stubError(w, http.StatusBadRequest, "invalidQuery")
+		return
+	}
+
+	files := make([]googledrive.FileInfo, 0)
+	for _, f := range s.files {
+		if f.parent == parent && (!byName || f.Name == name) {
+			files = append(files, f.FileInfo)
+		}
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].ModifiedTime.After(files[j].ModifiedTime) })
+
+	writeJSON(w, http.StatusOK, googledrive.ListResponse{Files: files})
+}
+
+func (s *driveStub) createSession(w http.ResponseWriter, r *http.Request) {
+	size, err := strconv.ParseInt(r.Header.Get("X-Upload-Content-Length"), 10, 64)
+	if err != nil {
+		stubError(w, http.StatusBadRequest, "badContentLength")
+		return
+	}
+
+	var meta struct {
+		Name    string   `json:"name"`
+		Parents []string `json:"parents"`
+	}
+	json.NewDecoder(r.Body).Decode(&meta)
+
+	session := &uploadSession{size: size}
+	if id := strings.TrimPrefix(r.URL.Path, "/upload/drive/v3/files/"); r.Method == http.MethodPatch {
+		f, ok := s.files[id]
+		if !ok {
+			stubError(w, http.StatusNotFound, "notFound")
+			return
+		}
+		session.file = f
+	} else if len(meta.Parents) == 1 {
+		session.file = &stubFile{FileInfo: googledrive.FileInfo{Name: meta.Name}, parent: meta.Parents[0]}
+		session.isNew = true
+	} else {
+		stubError(w, http.StatusBadRequest, "badRequest")
+		return
+	}
+
+	s.seq++
+	id := strconv.Itoa(s.seq)
+	s.sessions[id] = session
+	w.Header().Set("Location", s.URL+"/upload/session/"+id)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *driveStub) uploadChunk(w http.ResponseWriter, r *http.Request, id string) {
+	session, ok := s.sessions[id]
+	if !ok {
+		stubError(w, http.StatusNotFound, "notFound")
+		return
+	}
+
+	if r.Method == http.MethodDelete {
+		delete(s.sessions, id)
+		w.WriteHeader(499)
+		return
+	}
+
+	var start, end, total int64
+	contentRange := r.Header.Get("Content-Range")
+	if contentRange != "bytes */0" {
+		if _, err := fmt.Sscanf(contentRange, "bytes %d-%d/%d", &start, &end, &total); err != nil ||
+			start != int64(len(session.data)) || total != session.size {
+			stubError(w, http.StatusBadRequest, "badContentRange")
+			return
+		}
+	}
+
+	data, _ := io.ReadAll(r.Body)
+	session.data = append(session.data, data...)
+	if int64(len(session.data)) < session.size {
+		w.Header().Set("Range", fmt.Sprintf("bytes=0-%d", len(session.data)-1))
+		w.WriteHeader(http.StatusPermanentRedirect)
+		return
+	}
+
+	delete(s.sessions, id)
+	f := session.file
+	if session.isNew {
+		f = s.create(f.parent, f.Name, "application/octet-stream", nil)
+	}
+	f.data = session.data
+	f.Size = int64(len(f.data))
+	f.ModifiedTime = time.Now().UTC()
+	writeJSON(w, http.StatusOK, f.FileInfo)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func stubError(w http.ResponseWriter, status int, reason string) {
+	writeJSON(w, status, map[string]any{"error": map[string]any{
+		"code":    status,
+		"message": reason,
+		"errors":  []map[string]string{{"reason": reason}},
+	}})
+}
+
+func newTestDriver(t *testing.T, stub *driveStub) (*googledrive.Driver, context.Context) {
+	dep := deptest.New(t)
+	ctx := deptest.Context(dep, nil)
+
+	cred := credmanager.New(cache.NewMemoStore("", dep.Logger()))
+	require.NoError(t, cred.Upsert(ctx, googledrive.Credential{
+		PolicyID:    testPolicyID,
+		AccessToken: testAccessToken,
+		ExpiresIn:   time.Now().Add(time.Hour).Unix(),
+	}))
+
+	handler, err := googledrive.New(ctx, &ent.StoragePolicy{
+		ID:       testPolicyID,
+		Type:     types.PolicyTypeGoogleDrive,
+		Server:   stub.URL,
+		Settings: &types.PolicySetting{ChunkSize: 1},
+	}, dep.SettingProvider(), dep.ConfigProvider(), dep.Logger(), cred)
+	require.NoError(t, err)
+	return handler, ctx
+}
+
+func newUploadRequest(savePath string, content []byte, mode fs.WriteMode) *fs.UploadRequest {
+	reader := bytes.NewReader(content)
+	return &fs.UploadRequest{
+		Props:  &fs.UploadProps{SavePath: savePath, Size: int64(len(content))},
+		Mode:   mode,
+		File:   io.NopCloser(reader),
+		Seeker: reader,
+	}
+}
+
+func readAll(t *testing.T, handler *googledrive.Driver, ctx context.Context, p string, offset int64) []byte {
+	stream, err := handler.OpenStream(ctx, p, offset)
+	require.NoError(t, err)
+	defer stream.Close()
+
+	content, err := io.ReadAll(stream)
+	require.NoError(t, err)
+	return content
+}
+
+func TestDriver_RoundTrip(t *testing.T) {
+	a := assert.New(t)
+	stub := newDriveStub(t)
+	handler, ctx := newTestDriver(t, stub)
+
+	// Content larger than 256 KiB chunk is uploaded in multiple requests of one session,
+	// missing folders are created.
+	content := make([]byte, 600<<10)
+	rand.New(rand.NewSource(1)).Read(content)
+	require.NoError(t, handler.Put(ctx, newUploadRequest("uploads/sub/a.bin", content, fs.ModeNone)))
+	require.NoError(t, handler.Put(ctx, newUploadRequest("uploads/b.txt", []byte("old"), fs.ModeNone)))
+	a.Error(handler.Put(ctx, newUploadRequest("uploads/b.txt", []byte("new"), fs.ModeNone)))
+	require.NoError(t, handler.Put(ctx, newUploadRequest("uploads/b.txt", []byte("new"), fs.ModeOverwrite)))
+
+	uploaded := stub.find("uploads/sub/a.bin")
+	require.NotNil(t, uploaded)
+	a.Equal(content, uploaded.data)
+	a.Equal(folderMimeType, stub.find("uploads/sub").MimeType)
+	b := stub.find("uploads/b.txt")
+	require.NotNil(t, b)
+	a.Equal([]byte("new"), b.data, "file is updated in place on overwrite")
+	stub.mu.Lock()
+	a.Len(lo.Filter(lo.Values(stub.files), func(f *stubFile, _ int) bool { return f.Name == "b.txt" }), 1)
+	stub.mu.Unlock()
+
+	// Workspace documents are not listed.
+	stub.add(stub.find("uploads").ID, "doc", "application/vnd.google-apps.document", nil)
+
+	// List
+	listed := 0
+	objects, err := handler.List(ctx, "/uploads", func(i int) { listed += i }, false)
+	require.NoError(t, err)
+	a.ElementsMatch([]string{"b.txt", "sub"}, lo.Map(objects, func(o fs.PhysicalObject, _ int) string { return o.RelativePath }))
+	a.Equal(2, listed)
+
+	objects, err = handler.List(ctx, "uploads", func(int) {}, true)
+	require.NoError(t, err)
+	a.ElementsMatch([]string{"b.txt", "sub", "sub/a.bin"}, lo.Map(objects, func(o fs.PhysicalObject, _ int) string { return o.RelativePath }))
+	for _, o := range objects {
+		switch o.RelativePath {
+		case "sub/a.bin":
+			a.Equal("uploads/sub/a.bin", o.Source)
+			a.Equal(int64(len(content)), o.Size)
+			a.False(o.IsDir)
+		case "sub":
+			a.True(o.IsDir)
+		}
+	}
+
+	// Download, range read
+	a.Equal(content, readAll(t, handler, ctx, "uploads/sub/a.bin", 0))
+	a.Equal(content[1000:], readAll(t, handler, ctx, "uploads/sub/a.bin", 1000))
+
+	// Source is served by proxy.
+	_, err = handler.Source(ctx, fs.NewEntity(&ent.Entity{Source: "uploads/b.txt"}), &driver.GetSourceArgs{})
+	a.Error(err)
+
+	// Delete, missing files are ignored.
+	failed, err := handler.Delete(ctx, "uploads/sub/a.bin", "uploads/missing.txt", "missing/a.txt")
+	a.NoError(err)
+	a.Empty(failed)
+	a.Nil(stub.find("uploads/sub/a.bin"))
+	_, err = handler.OpenStream(ctx, "uploads/sub/a.bin", 0)
+	a.ErrorIs(err, googledrive.ErrObjectNotFound)
+}
+
+func TestDriver_EmptyFile(t *testing.T) {
+	a := assert.New(t)
+	stub := newDriveStub(t)
+	handler, ctx := newTestDriver(t, stub)
+
+	require.NoError(t, handler.Put(ctx, newUploadRequest("empty.txt", nil, fs.ModeNone)))
+	f := stub.find("empty.txt")
+	require.NotNil(t, f)
+	a.Empty(f.data)
+	stub.mu.Lock()
+	a.Empty(stub.sessions, "session is completed")
+	stub.mu.Unlock()
+}
+
+func TestDriver_Unauthorized(t *testing.T) {
+	a := assert.New(t)
+	stub := newDriveStub(t)
+	dep := deptest.New(t)
+	ctx := deptest.Context(dep, nil)
+
+	cred := credmanager.New(cache.NewMemoStore("", dep.Logger()))
+	require.NoError(t, cred.Upsert(ctx, googledrive.Credential{
+		PolicyID:    testPolicyID,
+		AccessToken: "revoked",
+		ExpiresIn:   time.Now().Add(time.Hour).Unix(),
+	}))
+	handler, err := googledrive.New(ctx, &ent.StoragePolicy{
+		ID:       testPolicyID,
+		Type:     types.PolicyTypeGoogleDrive,
+		Server:   stub.URL,
+		Settings: &types.PolicySetting{},
+	}, dep.SettingProvider(), dep.ConfigProvider(), dep.Logger(), cred)
+	require.NoError(t, err)
+
+	_, err = handler.List(ctx, "", func(int) {}, false)
+	var respErr *googledrive.RespError
+	a.ErrorAs(err, &respErr)
+	a.Equal(http.StatusUnauthorized, respErr.APIError.Code)
+}
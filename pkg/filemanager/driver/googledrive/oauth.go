@@ -0,0 +1,240 @@
+package googledrive
+
+import (
+	"context"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/credmanager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/samber/lo"
+)
+
+const (
+	AccessTokenExpiryMargin = 600 // 10 minutes
+
+	// OAuthStateKeyPrefix KV prefix of pending OAuth state, value is the storage policy ID.
+	OAuthStateKeyPrefix = "googledrive_oauth_state_"
+	// OAuthStateTTL TTL of pending OAuth state in seconds.
+	OAuthStateTTL = 600
+)
+
+var (
+	// RequiredScope scopes required by the driver
+	RequiredScope = []string{
+		"https://www.googleapis.com/auth/drive",
+	}
+
+	authorizeEndpoint = "https://accounts.google.com/o/oauth2/v2/auth"
+	tokenEndpoint     = "https://oauth2.googleapis.com/token"
+)
+
+// Credential token obtained from Google OAuth endpoint
+type Credential struct {
+	ExpiresIn       int64  `json:"expires_in"`
+	AccessToken     string `json:"access_token"`
+	RefreshToken    string `json:"refresh_token"`
+	Scope           string `json:"scope"`
+	RefreshedAtUnix int64  `json:"refreshed_at"`
+
+	PolicyID int `json:"policy_id"`
+}
+
+func init() {
+	gob.Register(Credential{})
+}
+
+func (c Credential) Refresh(ctx context.Context) (credmanager.Credential, error) {
+	if c.RefreshToken == "" {
+		return nil, ErrInvalidRefreshToken
+	}
+
+	dep := dependency.FromContext(ctx)
+	storagePolicyClient := dep.StoragePolicyClient()
+	policy, err := storagePolicyClient.GetPolicyByID(ctx, c.PolicyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get storage policy: %w", err)
+	}
+
+	newCredential, err := obtainToken(ctx, &obtainTokenArgs{
+		clientId:     policy.BucketName,
+		secret:       policy.SecretKey,
+		refreshToken: c.RefreshToken,
+		client:       dep.RequestClient(request.WithLogger(dep.Logger())),
+		policyID:     c.PolicyID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c.AccessToken = newCredential.AccessToken
+	c.ExpiresIn = newCredential.ExpiresIn
+	c.RefreshedAtUnix = time.Now().Unix()
+
+	// Google only rotates refresh token occasionally
+	if newCredential.RefreshToken != "" && newCredential.RefreshToken != c.RefreshToken {
+		c.RefreshToken = newCredential.RefreshToken
+		if err := storagePolicyClient.UpdateAccessKey(ctx, policy, newCredential.RefreshToken); err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
+
+func (c Credential) Key() string {
+	return CredentialKey(c.PolicyID)
+}
+
+func (c Credential) Expiry() time.Time {
+	return time.Unix(c.ExpiresIn-AccessTokenExpiryMargin, 0)
+}
+
+func (c Credential) String() string {
+	return c.AccessToken
+}
+
+func (c Credential) RefreshedAt() *time.Time {
+	if c.RefreshedAtUnix == 0 {
+		return nil
+	}
+	refreshedAt := time.Unix(c.RefreshedAtUnix, 0)
+	return &refreshedAt
+}
+
+// OAuthURL returns the URL of Google consent page. Offline access and consent prompt are
+// required so that a refresh token is always issued.
+func (client *client) OAuthURL(ctx context.Context, state string) string {
+	query := url.Values{
+		"client_id":     {client.policy.BucketName},
+		"scope":         {strings.Join(RequiredScope, " ")},
+		"response_type": {"code"},
+		"redirect_uri":  {client.policy.Settings.OauthRedirect},
+		"access_type":   {"offline"},
+		"prompt":        {"consent"},
+		"state":         {state},
+	}
+
+	return authorizeEndpoint + "?" + query.Encode()
+}
+
+// ObtainToken exchanges code or refresh token for a new credential.
+func (client *client) ObtainToken(ctx context.Context, code, refreshToken string) (*Credential, error) {
+	return obtainToken(ctx, &obtainTokenArgs{
+		clientId:     client.policy.BucketName,
+		redirect:     client.policy.Settings.OauthRedirect,
+		secret:       client.policy.SecretKey,
+		code:         code,
+		refreshToken: refreshToken,
+		client:       client.httpClient,
+		policyID:     client.policy.ID,
+	})
+}
+
+type obtainTokenArgs struct {
+	clientId     string
+	redirect     string
+	secret       string
+	code         string
+	refreshToken string
+	client       request.Client
+	policyID     int
+}
+
+func obtainToken(ctx context.Context, args *obtainTokenArgs) (*Credential, error) {
+	body := url.Values{
+		"client_id":     {args.clientId},
+		"client_secret": {args.secret},
+	}
+	if args.code != "" {
+		body.Add("grant_type", "authorization_code")
+		body.Add("code", args.code)
+		body.Add("redirect_uri", args.redirect)
+	} else {
+		body.Add("grant_type", "refresh_token")
+		body.Add("refresh_token", args.refreshToken)
+	}
+	strBody := body.Encode()
+
+	res := args.client.Request(
+		http.MethodPost,
+		tokenEndpoint,
+		io.NopCloser(strings.NewReader(strBody)),
+		request.WithHeader(http.Header{
+			"Content-Type": {"application/x-www-form-urlencoded"}},
+		),
+		request.WithContentLength(int64(len(strBody))),
+		request.WithContext(ctx),
+	)
+	respBody, err := res.GetResponse()
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Response.StatusCode != http.StatusOK {
+		var errResp OAuthError
+		if err := json.Unmarshal([]byte(respBody), &errResp); err != nil || errResp.ErrorType == "" {
+			return nil, fmt.Errorf("unexpected token response with status code %d", res.Response.StatusCode)
+		}
+
+		return nil, errResp
+	}
+
+	var credential Credential
+	if err := json.Unmarshal([]byte(respBody), &credential); err != nil {
+		return nil, err
+	}
+
+	credential.PolicyID = args.policyID
+	credential.ExpiresIn = time.Now().Unix() + credential.ExpiresIn
+	if args.code != "" {
+		// Force a refresh on first use to verify the refresh token
+		credential.ExpiresIn = time.Now().Unix() - 10
+	}
+	return &credential, nil
+}
+
+// accessToken obtains latest access token from CredManager.
+func (client *client) accessToken(ctx context.Context) (string, error) {
+	cred, err := client.cred.Obtain(ctx, CredentialKey(client.policy.ID))
+	if err != nil {
+		return "", fmt.Errorf("failed to obtain token from CredManager: %w", err)
+	}
+
+	return cred.String(), nil
+}
+
+// RetrieveGoogleDriveCredentials retrieves Google Drive credentials from DB inventory
+func RetrieveGoogleDriveCredentials(ctx context.Context, storagePolicyClient inventory.StoragePolicyClient) ([]credmanager.Credential, error) {
+	policies, err := storagePolicyClient.ListPolicyByType(ctx, types.PolicyTypeGoogleDrive)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list Google Drive policies: %w", err)
+	}
+
+	return lo.Map(policies, func(item *ent.StoragePolicy, index int) credmanager.Credential {
+		return &Credential{
+			PolicyID:     item.ID,
+			ExpiresIn:    0,
+			RefreshToken: item.AccessKey,
+		}
+	}), nil
+}
+
+func CredentialKey(policyId int) string {
+	return fmt.Sprintf("cred_gd_%d", policyId)
+}
+
+// OAuthStateKey returns the KV key of given OAuth state.
+func OAuthStateKey(state string) string {
+	return OAuthStateKeyPrefix + state
+}
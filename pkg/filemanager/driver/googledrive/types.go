@@ -0,0 +1,78 @@
+package googledrive
+
+import (
+	"net/http"
+	"time"
+)
+
+const (
+	folderMimeType = "application/vnd.google-apps.folder"
+	// Google Workspace documents are prefixed with this mime type, they have no binary content.
+	workspaceMimeTypePrefix = "application/vnd.google-apps."
+
+	fileFields = "id,name,mimeType,size,modifiedTime,thumbnailLink"
+)
+
+// RespError is the error response of Drive API.
+type RespError struct {
+	APIError APIError `json:"error"`
+}
+
+// APIError is the content of error response.
+type APIError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Errors  []struct {
+		Reason string `json:"reason"`
+	} `json:"errors"`
+}
+
+func (err *RespError) Error() string {
+	return err.APIError.Message
+}
+
+// NotFound returns true if the resource requested does not exist.
+func (err *RespError) NotFound() bool {
+	return err.APIError.Code == http.StatusNotFound
+}
+
+// FileInfo is the file resource of Drive API.
+type FileInfo struct {
+	ID            string    `json:"id"`
+	Name          string    `json:"name"`
+	MimeType      string    `json:"mimeType"`
+	Size          int64     `json:"size,string"`
+	ModifiedTime  time.Time `json:"modifiedTime"`
+	ThumbnailLink string    `json:"thumbnailLink"`
+}
+
+// IsFolder returns true if the file is a folder.
+func (info *FileInfo) IsFolder() bool {
+	return info.MimeType == folderMimeType
+}
+
+// ListResponse is the response of listing files.
+type ListResponse struct {
+	Files         []FileInfo `json:"files"`
+	NextPageToken string     `json:"nextPageToken"`
+}
+
+// fileMetadata is the request body to create or update a file.
+type fileMetadata struct {
+	Name     string   `json:"name,omitempty"`
+	MimeType string   `json:"mimeType,omitempty"`
+	Parents  []string `json:"parents,omitempty"`
+}
+
+// OAuthError is the error response of OAuth token endpoint.
+type OAuthError struct {
+	ErrorType        string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+func (err OAuthError) Error() string {
+	if err.ErrorDescription != "" {
+		return err.ErrorDescription
+	}
+	return err.ErrorType
+}
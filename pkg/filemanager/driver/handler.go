@@ -3,6 +3,7 @@ package driver
 import (
 	"context"
 	"encoding/gob"
+	"io"
 	"os"
 	"time"
 
@@ -86,6 +87,13 @@ type (
 		MediaMeta(ctx context.Context, path, ext, language string) ([]MediaMeta, error)
 	}
 
+	// StreamReader is implemented by handlers that read file content directly instead of through
+	// a source URL, usually along with HandlerCapabilityProxyRequired.
+	StreamReader interface {
+		// OpenStream opens the file at given path for reading, starting from offset.
+		OpenStream(ctx context.Context, path string, offset int64) (io.ReadCloser, error)
+	}
+
 	Capabilities struct {
 		StaticFeatures *boolset.BooleanSet
 		// MaxSourceExpire indicates the maximum allowed expiration duration of a source URL
@@ -82,3 +82,7 @@ func (l *localFileEntity) Props() *types.EntityProps {
 func (l *localFileEntity) Encrypted() bool {
 	return false
 }
+
+func (l *localFileEntity) Checksum() string {
+	return ""
+}
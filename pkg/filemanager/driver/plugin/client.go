@@ -0,0 +1,171 @@
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+)
+
+const (
+	// Host used in request URL when calling plugins over unix socket
+	unixSocketHost = "http://plugin"
+	dialTimeout    = 10 * time.Second
+)
+
+var (
+	// ErrNotImplemented the plugin does not implement called method
+	ErrNotImplemented = errors.New("not implemented by plugin")
+
+	// transports shares connections to the same plugin address between driver instances.
+	transports sync.Map
+)
+
+type client struct {
+	endpoint   string
+	httpClient request.Client
+	l          logging.Logger
+}
+
+// newClient creates a client calling plugin at given address, either `unix:///path/to/socket`
+// or an HTTP(S) URL.
+func newClient(address string, config conf.ConfigProvider, l logging.Logger) (*client, error) {
+	u, err := url.Parse(address)
+	if err != nil || address == "" {
+		return nil, fmt.Errorf("invalid plugin address %q: %w", address, err)
+	}
+
+	endpoint := strings.TrimSuffix(address, "/")
+	switch u.Scheme {
+	case "unix":
+		endpoint = unixSocketHost
+	case "http", "https":
+	default:
+		return nil, fmt.Errorf("unsupported plugin address scheme %q", u.Scheme)
+	}
+
+	return &client{
+		endpoint:   endpoint,
+		httpClient: request.NewClient(config, request.WithLogger(l), request.WithTransport(transport(u))),
+		l:          l,
+	}, nil
+}
+
+func transport(u *url.URL) *http.Transport {
+	if t, ok := transports.Load(u.String()); ok {
+		return t.(*http.Transport)
+	}
+
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	if u.Scheme == "unix" {
+		socket := u.Path
+		if socket == "" {
+			socket = u.Opaque
+		}
+
+		dialer := &net.Dialer{Timeout: dialTimeout}
+		t.Proxy = nil
+		t.DialContext = func(ctx context.Context, _, _ string) (net.Conn, error) {
+			return dialer.DialContext(ctx, "unix", socket)
+		}
+	}
+
+	actual, _ := transports.LoadOrStore(u.String(), t)
+	return actual.(*http.Transport)
+}
+
+// call sends JSON request to given method and decodes JSON response into res if it's not nil.
+func (c *client) call(ctx context.Context, method string, req, res any) error {
+	resp, err := c.sendJSON(ctx, method, req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if res == nil {
+		return nil
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(res); err != nil {
+		return fmt.Errorf("failed to decode %s response: %w", method, err)
+	}
+
+	return nil
+}
+
+// sendJSON sends JSON request to given method, caller is responsible to close response body.
+func (c *client) sendJSON(ctx context.Context, method string, req any) (*http.Response, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s request: %w", method, err)
+	}
+
+	return c.send(ctx, method, bytes.NewReader(body),
+		request.WithContentLength(int64(len(body))),
+		request.WithHeader(http.Header{"Content-Type": {"application/json"}}),
+	)
+}
+
+// callStream sends request encoded in RequestHeader with content as request body.
+func (c *client) callStream(ctx context.Context, method string, req any, content io.Reader, size int64) error {
+	encoded, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s request: %w", method, err)
+	}
+
+	resp, err := c.send(ctx, method, content,
+		request.WithContentLength(size),
+		request.WithHeader(http.Header{
+			RequestHeader:  {base64.StdEncoding.EncodeToString(encoded)},
+			"Content-Type": {"application/octet-stream"},
+		}),
+	)
+	if err != nil {
+		return err
+	}
+
+	return resp.Body.Close()
+}
+
+func (c *client) send(ctx context.Context, method string, body io.Reader, opts ...request.Option) (*http.Response, error) {
+	opts = append([]request.Option{
+		request.WithContext(ctx),
+		request.WithHeader(http.Header{VersionHeader: {strconv.Itoa(ProtocolVersion)}}),
+	}, opts...)
+
+	res := c.httpClient.Request(http.MethodPost, c.endpoint+"/v1/"+method, body, opts...)
+	if res.Err != nil {
+		return nil, fmt.Errorf("failed to call plugin method %q: %w", method, res.Err)
+	}
+
+	if res.Response.StatusCode == http.StatusOK {
+		return res.Response, nil
+	}
+
+	respBody, _ := res.GetResponse()
+	if res.Response.StatusCode == http.StatusNotFound || res.Response.StatusCode == http.StatusNotImplemented {
+		return nil, fmt.Errorf("plugin method %q: %w", method, ErrNotImplemented)
+	}
+
+	var errResp ErrorResponse
+	if err := json.Unmarshal([]byte(respBody), &errResp); err != nil || errResp.Error == "" {
+		c.l.Debug("Plugin returns unknown response: %s", respBody)
+		return nil, fmt.Errorf("plugin method %q returns unexpected status code %d", method, res.Response.StatusCode)
+	}
+
+	return nil, fmt.Errorf("plugin method %q: %s", method, errResp.Error)
+}
@@ -0,0 +1,323 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/boolset"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cluster/routes"
+	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/chunk"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/chunk/backoff"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+)
+
+const (
+	chunkRetrySleep = time.Duration(5) * time.Second
+	// capabilitiesTTL how long declared capabilities of a plugin are cached
+	capabilitiesTTL = time.Minute
+)
+
+// capabilitiesCache caches capabilities declared by plugins, since drivers are created per request.
+var capabilitiesCache sync.Map
+
+type cachedCapabilities struct {
+	res      *CapabilitiesResponse
+	expireAt time.Time
+}
+
+// Driver forwards storage operations to an out-of-process plugin speaking the protocol
+// described in protocol.go. Settings.PluginAddress is the address of plugin and
+// Settings.PluginConfig is passed to plugin as is.
+type Driver struct {
+	policy   *ent.StoragePolicy
+	client   *client
+	settings setting.Provider
+	l        logging.Logger
+	caps     *CapabilitiesResponse
+}
+
+// streamDriver is used for plugins declaring ProxyRequired, file content is read from plugin
+// and served by Cloudreve.
+type streamDriver struct {
+	*Driver
+}
+
+// New creates a driver for given plugin policy, capabilities of the plugin are fetched if
+// not cached.
+func New(ctx context.Context, policy *ent.StoragePolicy, settings setting.Provider,
+	config conf.ConfigProvider, l logging.Logger) (driver.Handler, error) {
+	c, err := newClient(policy.Settings.PluginAddress, config, l)
+	if err != nil {
+		return nil, err
+	}
+
+	handler := &Driver{
+		policy:   policy,
+		client:   c,
+		settings: settings,
+		l:        l,
+	}
+
+	handler.caps, err = handler.capabilities(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if handler.caps.ProxyRequired {
+		return &streamDriver{handler}, nil
+	}
+
+	return handler, nil
+}
+
+func (handler *Driver) capabilities(ctx context.Context) (*CapabilitiesResponse, error) {
+	key := fmt.Sprintf("%d|%s|%s", handler.policy.ID, handler.policy.Settings.PluginAddress, handler.policy.Settings.PluginConfig)
+	if cached, ok := capabilitiesCache.Load(key); ok && time.Now().Before(cached.(cachedCapabilities).expireAt) {
+		return cached.(cachedCapabilities).res, nil
+	}
+
+	res := &CapabilitiesResponse{}
+	if err := handler.client.call(ctx, MethodCapabilities, handler.request(), res); err != nil {
+		return nil, fmt.Errorf("failed to get plugin capabilities: %w", err)
+	}
+
+	if res.ProtocolVersion != ProtocolVersion {
+		return nil, fmt.Errorf("unsupported plugin protocol version %d, expected %d", res.ProtocolVersion, ProtocolVersion)
+	}
+
+	capabilitiesCache.Store(key, cachedCapabilities{res: res, expireAt: time.Now().Add(capabilitiesTTL)})
+	return res, nil
+}
+
+func (handler *Driver) request() Request {
+	return Request{Policy: PolicyInfo{
+		ID:         handler.policy.ID,
+		Name:       handler.policy.Name,
+		Server:     handler.policy.Server,
+		BucketName: handler.policy.BucketName,
+		IsPrivate:  handler.policy.IsPrivate,
+		AccessKey:  handler.policy.AccessKey,
+		SecretKey:  handler.policy.SecretKey,
+		Config:     handler.policy.Settings.PluginConfig,
+	}}
+}
+
+func (handler *Driver) sessionRequest(session *fs.UploadSession) *UploadSessionRequest {
+	return &UploadSessionRequest{
+		Request: handler.request(),
+		Session: UploadSessionInfo{
+			ID:       session.Props.UploadSessionID,
+			Path:     session.Props.SavePath,
+			Size:     session.Props.Size,
+			MimeType: session.Props.MimeType,
+			ExpireAt: session.Props.ExpireAt,
+			Callback: session.Callback,
+			UploadID: session.UploadID,
+		},
+	}
+}
+
+func (handler *Driver) List(ctx context.Context, base string, onProgress driver.ListProgressFunc, recursive bool) ([]fs.PhysicalObject, error) {
+	res := &ListResponse{}
+	if err := handler.client.call(ctx, MethodList, &ListRequest{
+		Request:   handler.request(),
+		Base:      base,
+		Recursive: recursive,
+	}, res); err != nil {
+		return nil, err
+	}
+
+	onProgress(len(res.Objects))
+	return res.Objects, nil
+}
+
+func (handler *Driver) Open(ctx context.Context, path string) (*os.File, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (handler *Driver) LocalPath(ctx context.Context, path string) string {
+	return ""
+}
+
+// OpenStream reads file content from plugin starting from offset.
+func (handler *streamDriver) OpenStream(ctx context.Context, path string, offset int64) (io.ReadCloser, error) {
+	resp, err := handler.client.sendJSON(ctx, MethodOpen, &OpenRequest{
+		Request: handler.request(),
+		Path:    path,
+		Offset:  offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Body, nil
+}
+
+// Put sends the file stream to plugin in one request, the request is retried if the stream is
+// seekable or chunk buffer is enabled.
+func (handler *Driver) Put(ctx context.Context, file *fs.UploadRequest) error {
+	defer file.Close()
+
+	req := &PutRequest{
+		Request:   handler.request(),
+		Path:      file.Props.SavePath,
+		Size:      file.Props.Size,
+		Offset:    file.Offset,
+		Overwrite: file.Mode&fs.ModeOverwrite == fs.ModeOverwrite,
+		MimeType:  file.Props.MimeType,
+	}
+
+	chunks := chunk.NewChunkGroup(file, 0, &backoff.ConstantBackoff{
+		Max:   handler.settings.ChunkRetryLimit(ctx),
+		Sleep: chunkRetrySleep,
+	}, handler.settings.UseChunkBuffer(ctx), handler.l, handler.settings.TempPath(ctx))
+
+	uploadFunc := func(current *chunk.ChunkGroup, content io.Reader) error {
+		return handler.client.callStream(ctx, MethodPut, req, content, current.Length())
+	}
+
+	for chunks.Next() {
+		if err := chunks.Process(uploadFunc); err != nil {
+			return fmt.Errorf("failed to upload file: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (handler *Driver) Delete(ctx context.Context, files ...string) ([]string, error) {
+	res := &DeleteResponse{}
+	if err := handler.client.call(ctx, MethodDelete, &DeleteRequest{
+		Request: handler.request(),
+		Files:   files,
+	}, res); err != nil {
+		return files, err
+	}
+
+	if len(res.Failed) > 0 {
+		return res.Failed, fmt.Errorf("plugin failed to delete files: %s", res.Error)
+	}
+
+	return []string{}, nil
+}
+
+func (handler *Driver) Thumb(ctx context.Context, expire *time.Time, ext string, e fs.Entity) (string, error) {
+	res := &URLResponse{}
+	if err := handler.client.call(ctx, MethodThumb, &ThumbRequest{
+		Request: handler.request(),
+		Entity:  EntityInfo{Source: e.Source(), Size: e.Size()},
+		Ext:     ext,
+		Expire:  expire,
+	}, res); err != nil {
+		return "", err
+	}
+
+	return res.URL, nil
+}
+
+func (handler *Driver) Source(ctx context.Context, e fs.Entity, args *driver.GetSourceArgs) (string, error) {
+	res := &URLResponse{}
+	if err := handler.client.call(ctx, MethodSource, &SourceRequest{
+		Request:     handler.request(),
+		Entity:      EntityInfo{Source: e.Source(), Size: e.Size()},
+		Expire:      args.Expire,
+		IsDownload:  args.IsDownload,
+		Speed:       args.Speed,
+		DisplayName: args.DisplayName,
+	}, res); err != nil {
+		return "", err
+	}
+
+	return res.URL, nil
+}
+
+// Token creates upload session in plugin, plugin or the storage behind it should call
+// session callback once upload is finished.
+func (handler *Driver) Token(ctx context.Context, uploadSession *fs.UploadSession, file *fs.UploadRequest) (*fs.UploadCredential, error) {
+	siteURL := handler.settings.SiteURL(setting.UseFirstSiteUrl(ctx))
+	uploadSession.Callback = routes.MasterSlaveCallbackUrl(siteURL, types.PolicyTypePlugin, uploadSession.Props.UploadSessionID, uploadSession.CallbackSecret).String()
+
+	req := handler.sessionRequest(uploadSession)
+	req.Session.Overwrite = file.Mode&fs.ModeOverwrite == fs.ModeOverwrite
+
+	res := &TokenResponse{}
+	if err := handler.client.call(ctx, MethodToken, req, res); err != nil {
+		return nil, err
+	}
+
+	uploadSession.UploadID = res.UploadID
+	uploadSession.ChunkSize = res.ChunkSize
+	return &fs.UploadCredential{
+		SessionID:   uploadSession.Props.UploadSessionID,
+		ChunkSize:   res.ChunkSize,
+		UploadURLs:  res.UploadURLs,
+		Credential:  res.Credential,
+		UploadID:    res.UploadID,
+		CompleteURL: res.CompleteURL,
+		Callback:    uploadSession.Callback,
+	}, nil
+}
+
+func (handler *Driver) CancelToken(ctx context.Context, uploadSession *fs.UploadSession) error {
+	err := handler.client.call(ctx, MethodCancelToken, handler.sessionRequest(uploadSession), nil)
+	if errors.Is(err, ErrNotImplemented) {
+		return nil
+	}
+
+	return err
+}
+
+func (handler *Driver) CompleteUpload(ctx context.Context, session *fs.UploadSession) error {
+	err := handler.client.call(ctx, MethodCompleteUpload, handler.sessionRequest(session), nil)
+	if errors.Is(err, ErrNotImplemented) {
+		return nil
+	}
+
+	return err
+}
+
+func (handler *Driver) Capabilities() *driver.Capabilities {
+	features := &boolset.BooleanSet{}
+	boolset.Sets(map[driver.HandlerCapability]bool{
+		driver.HandlerCapabilityProxyRequired:          handler.caps.ProxyRequired,
+		driver.HandlerCapabilityUploadSentinelRequired: handler.caps.UploadSentinelRequired,
+	}, features)
+
+	return &driver.Capabilities{
+		StaticFeatures:         features,
+		MaxSourceExpire:        time.Duration(handler.caps.MaxSourceExpire) * time.Second,
+		MinSourceExpire:        time.Duration(handler.caps.MinSourceExpire) * time.Second,
+		MediaMetaSupportedExts: handler.caps.MediaMetaSupportedExts,
+		MediaMetaProxy:         handler.policy.Settings.MediaMetaGeneratorProxy,
+		ThumbSupportedExts:     handler.caps.ThumbSupportedExts,
+		ThumbSupportAllExts:    handler.caps.ThumbSupportAllExts,
+		ThumbMaxSize:           handler.caps.ThumbMaxSize,
+		ThumbProxy:             handler.policy.Settings.ThumbGeneratorProxy,
+		BrowserRelayedDownload: handler.caps.BrowserRelayedDownload,
+	}
+}
+
+func (handler *Driver) MediaMeta(ctx context.Context, path, ext, language string) ([]driver.MediaMeta, error) {
+	res := &MediaMetaResponse{}
+	if err := handler.client.call(ctx, MethodMediaMeta, &MediaMetaRequest{
+		Request:  handler.request(),
+		Path:     path,
+		Ext:      ext,
+		Language: language,
+	}, res); err != nil {
+		return nil, err
+	}
+
+	return res.Metas, nil
+}
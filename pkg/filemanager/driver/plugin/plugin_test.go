@@ -0,0 +1,312 @@
+package plugin_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency/deptest"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver/plugin"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testPluginConfig = `{"bucket":"test"}`
+	// Files under this folder cannot be deleted by the stub plugin.
+	lockedFolder = "locked/"
+)
+
+// pluginStub is a storage plugin keeping files in memory, implementing methods used by round
+// trips. Other methods respond 501 as unimplemented.
+type pluginStub struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	version int
+	proxy   bool
+	files   map[string][]byte
+	configs []string
+}
+
+func newPluginStub(t *testing.T, proxy bool, version int, unixSocket string) *pluginStub {
+	s := &pluginStub{version: version, proxy: proxy, files: make(map[string][]byte)}
+	s.Server = httptest.NewUnstartedServer(http.HandlerFunc(s.serve))
+	if unixSocket != "" {
+		listener, err := net.Listen("unix", unixSocket)
+		require.NoError(t, err)
+		s.Listener.Close()
+		s.Listener = listener
+	}
+	s.Start()
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *pluginStub) file(name string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	content, ok := s.files[name]
+	return content, ok
+}
+
+func (s *pluginStub) serve(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost || r.Header.Get(plugin.VersionHeader) != strconv.Itoa(plugin.ProtocolVersion) {
+		writeJSON(w, http.StatusBadRequest, plugin.ErrorResponse{Error: "bad request"})
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch strings.TrimPrefix(r.URL.Path, "/v1/") {
+	case plugin.MethodCapabilities:
+		writeJSON(w, http.StatusOK, plugin.CapabilitiesResponse{ProtocolVersion: s.version, ProxyRequired: s.proxy})
+	case plugin.MethodPut:
+		encoded, _ := base64.StdEncoding.DecodeString(r.Header.Get(plugin.RequestHeader))
+		var req plugin.PutRequest
+		if err := json.Unmarshal(encoded, &req); err != nil {
+			writeJSON(w, http.StatusBadRequest, plugin.ErrorResponse{Error: "invalid request header"})
+			return
+		}
+		s.configs = append(s.configs, req.Policy.Config)
+
+		existing, exist := s.files[req.Path]
+		if exist && !req.Overwrite {
+			writeJSON(w, http.StatusConflict, plugin.ErrorResponse{Error: "file already exists"})
+			return
+		}
+
+		content, _ := io.ReadAll(r.Body)
+		if req.Offset > int64(len(existing)) {
+			writeJSON(w, http.StatusBadRequest, plugin.ErrorResponse{Error: "offset out of range"})
+			return
+		}
+		s.files[req.Path] = append(existing[:req.Offset:req.Offset], content...)
+		writeJSON(w, http.StatusOK, struct{}{})
+	case plugin.MethodOpen:
+		var req plugin.OpenRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		content, ok := s.files[req.Path]
+		if !ok || req.Offset > int64(len(content)) {
+			writeJSON(w, http.StatusBadRequest, plugin.ErrorResponse{Error: "file not found"})
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write(content[req.Offset:])
+	case plugin.MethodList:
+		var req plugin.ListRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		writeJSON(w, http.StatusOK, plugin.ListResponse{Objects: s.list(req.Base, req.Recursive)})
+	case plugin.MethodDelete:
+		var req plugin.DeleteRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		res := plugin.DeleteResponse{}
+		for _, f := range req.Files {
+			if strings.HasPrefix(f, lockedFolder) {
+				res.Failed = append(res.Failed, f)
+				res.Error = "file is locked"
+				continue
+			}
+			delete(s.files, f)
+		}
+		writeJSON(w, http.StatusOK, res)
+	case plugin.MethodSource:
+		var req plugin.SourceRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		if _, ok := s.files[req.Entity.Source]; !ok {
+			writeJSON(w, http.StatusBadRequest, plugin.ErrorResponse{Error: "file not found"})
+			return
+		}
+		u := "https://cdn.example.com/" + req.Entity.Source
+		if req.IsDownload {
+			u += "?download=" + req.DisplayName
+		}
+		writeJSON(w, http.StatusOK, plugin.URLResponse{URL: u})
+	default:
+		w.WriteHeader(http.StatusNotImplemented)
+	}
+}
+
+func (s *pluginStub) list(base string, recursive bool) []fs.PhysicalObject {
+	prefix := strings.Trim(base, "/")
+	if prefix != "" {
+		prefix += "/"
+	}
+
+	names := lo.Keys(s.files)
+	sort.Strings(names)
+	dirs := make(map[string]bool)
+	objects := make([]fs.PhysicalObject, 0)
+	for _, name := range names {
+		rel, ok := strings.CutPrefix(name, prefix)
+		if !ok {
+			continue
+		}
+
+		if dir, _, nested := strings.Cut(rel, "/"); nested && !recursive {
+			if !dirs[dir] {
+				dirs[dir] = true
+				objects = append(objects, fs.PhysicalObject{Name: dir, RelativePath: dir, Source: prefix + dir, IsDir: true})
+			}
+			continue
+		}
+
+		objects = append(objects, fs.PhysicalObject{
+			Name:         filepath.Base(rel),
+			RelativePath: rel,
+			Source:       name,
+			Size:         int64(len(s.files[name])),
+		})
+	}
+
+	return objects
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func newUploadRequest(savePath string, content []byte, mode fs.WriteMode) *fs.UploadRequest {
+	reader := bytes.NewReader(content)
+	return &fs.UploadRequest{
+		Props:  &fs.UploadProps{SavePath: savePath, Size: int64(len(content))},
+		Mode:   mode,
+		File:   io.NopCloser(reader),
+		Seeker: reader,
+	}
+}
+
+func newTestDriver(t *testing.T, id int, address string) (driver.Handler, error) {
+	dep := deptest.New(t)
+	return plugin.New(context.Background(), &ent.StoragePolicy{
+		ID:   id,
+		Type: types.PolicyTypePlugin,
+		Settings: &types.PolicySetting{
+			PluginAddress: address,
+			PluginConfig:  testPluginConfig,
+		},
+	}, dep.SettingProvider(), dep.ConfigProvider(), dep.Logger())
+}
+
+func TestDriver_RoundTrip(t *testing.T) {
+	a := assert.New(t)
+	stub := newPluginStub(t, false, plugin.ProtocolVersion, "")
+	ctx := context.Background()
+
+	handler, err := newTestDriver(t, 1, stub.URL)
+	require.NoError(t, err)
+	_, isStream := handler.(driver.StreamReader)
+	a.False(isStream, "content is served by plugin")
+	a.False(handler.Capabilities().StaticFeatures.Enabled(int(driver.HandlerCapabilityProxyRequired)))
+
+	// Put
+	content := []byte("hello plugin policy")
+	require.NoError(t, handler.Put(ctx, newUploadRequest("uploads/sub/a.txt", content, fs.ModeNone)))
+	require.NoError(t, handler.Put(ctx, newUploadRequest("uploads/b.txt", []byte("old"), fs.ModeNone)))
+	err = handler.Put(ctx, newUploadRequest("uploads/b.txt", []byte("new"), fs.ModeNone))
+	a.ErrorContains(err, "file already exists", "error message of plugin is surfaced")
+	require.NoError(t, handler.Put(ctx, newUploadRequest("uploads/b.txt", []byte("new"), fs.ModeOverwrite)))
+
+	resumed := newUploadRequest("uploads/b.txt", []byte("er"), fs.ModeOverwrite)
+	resumed.Offset = 3
+	require.NoError(t, handler.Put(ctx, resumed))
+
+	stored, ok := stub.file("uploads/sub/a.txt")
+	require.True(t, ok)
+	a.Equal(content, stored)
+	stored, _ = stub.file("uploads/b.txt")
+	a.Equal([]byte("newer"), stored)
+	stub.mu.Lock()
+	a.Equal(testPluginConfig, stub.configs[0], "plugin config is passed as is")
+	stub.mu.Unlock()
+
+	// List
+	listed := 0
+	objects, err := handler.List(ctx, "uploads", func(i int) { listed += i }, false)
+	require.NoError(t, err)
+	a.ElementsMatch([]string{"b.txt", "sub"}, lo.Map(objects, func(o fs.PhysicalObject, _ int) string { return o.RelativePath }))
+	a.Equal(2, listed)
+
+	objects, err = handler.List(ctx, "uploads", func(int) {}, true)
+	require.NoError(t, err)
+	a.ElementsMatch([]string{"b.txt", "sub/a.txt"}, lo.Map(objects, func(o fs.PhysicalObject, _ int) string { return o.RelativePath }))
+
+	// Source
+	entity := fs.NewEntity(&ent.Entity{Source: "uploads/sub/a.txt", Size: int64(len(content))})
+	source, err := handler.Source(ctx, entity, &driver.GetSourceArgs{IsDownload: true, DisplayName: "a.txt"})
+	require.NoError(t, err)
+	a.Equal("https://cdn.example.com/uploads/sub/a.txt?download=a.txt", source)
+	_, err = handler.Source(ctx, fs.NewEntity(&ent.Entity{Source: "missing.txt"}), &driver.GetSourceArgs{})
+	a.ErrorContains(err, "file not found")
+
+	// Unimplemented methods
+	_, err = handler.Thumb(ctx, nil, "jpg", entity)
+	a.ErrorIs(err, plugin.ErrNotImplemented)
+	a.NoError(handler.CancelToken(ctx, &fs.UploadSession{Props: &fs.UploadProps{}}), "cancel_token is optional")
+
+	// Delete
+	require.NoError(t, handler.Put(ctx, newUploadRequest(lockedFolder+"c.txt", content, fs.ModeNone)))
+	failed, err := handler.Delete(ctx, "uploads/sub/a.txt", "uploads/missing.txt", lockedFolder+"c.txt")
+	a.ErrorContains(err, "file is locked")
+	a.Equal([]string{lockedFolder + "c.txt"}, failed)
+	_, ok = stub.file("uploads/sub/a.txt")
+	a.False(ok)
+
+	failed, err = handler.Delete(ctx, "uploads/b.txt")
+	a.NoError(err)
+	a.Empty(failed)
+}
+
+func TestDriver_StreamOverUnixSocket(t *testing.T) {
+	a := assert.New(t)
+	socket := filepath.Join(t.TempDir(), "plugin.sock")
+	stub := newPluginStub(t, true, plugin.ProtocolVersion, socket)
+	ctx := context.Background()
+
+	handler, err := newTestDriver(t, 2, "unix://"+socket)
+	require.NoError(t, err)
+	a.True(handler.Capabilities().StaticFeatures.Enabled(int(driver.HandlerCapabilityProxyRequired)))
+	reader, ok := handler.(driver.StreamReader)
+	require.True(t, ok, "plugin requires proxy")
+
+	content := []byte("served by cloudreve")
+	require.NoError(t, handler.Put(ctx, newUploadRequest("a.txt", content, fs.ModeNone)))
+	stored, _ := stub.file("a.txt")
+	a.Equal(content, stored)
+
+	stream, err := reader.OpenStream(ctx, "a.txt", 10)
+	require.NoError(t, err)
+	read, err := io.ReadAll(stream)
+	stream.Close()
+	require.NoError(t, err)
+	a.Equal(content[10:], read)
+
+	_, err = reader.OpenStream(ctx, "missing.txt", 0)
+	a.ErrorContains(err, "file not found")
+}
+
+func TestDriver_ProtocolVersion(t *testing.T) {
+	stub := newPluginStub(t, false, plugin.ProtocolVersion+1, "")
+
+	_, err := newTestDriver(t, 3, stub.URL)
+	assert.ErrorContains(t, err, "unsupported plugin protocol version")
+}
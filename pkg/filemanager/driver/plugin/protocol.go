@@ -0,0 +1,187 @@
+package plugin
+
+import (
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+)
+
+// Plugin protocol, version 1.
+//
+// Cloudreve calls the plugin over HTTP, either on a unix socket (`unix:///path/to/plugin.sock`)
+// or a TCP address (`http://127.0.0.1:9000`) configured in PolicySetting.PluginAddress. Every
+// call is a POST to `/v1/<method>` with a JSON body embedding Request. Plugins respond 200
+// with a JSON body of the documented response type, or any other status code with an
+// ErrorResponse body. Plugins respond 404 or 501 for methods they do not implement.
+//
+// Methods carrying file content do not use JSON body:
+//   - put: request is encoded as base64 JSON in RequestHeader, request body is the file content.
+//   - open: response body is the file content starting from requested offset, only called if
+//     the plugin declares ProxyRequired.
+const (
+	ProtocolVersion = 1
+
+	// RequestHeader carries the base64 encoded JSON request for methods whose body is file content.
+	RequestHeader = "X-Cloudreve-Plugin-Request"
+	// VersionHeader carries ProtocolVersion in every call.
+	VersionHeader = "X-Cloudreve-Plugin-Version"
+
+	MethodCapabilities   = "capabilities"
+	MethodPut            = "put"
+	MethodOpen           = "open"
+	MethodDelete         = "delete"
+	MethodList           = "list"
+	MethodSource         = "source"
+	MethodThumb          = "thumb"
+	MethodToken          = "token"
+	MethodCancelToken    = "cancel_token"
+	MethodCompleteUpload = "complete_upload"
+	MethodMediaMeta      = "media_meta"
+)
+
+type (
+	// PolicyInfo is the storage policy the call is made for. Config is the opaque
+	// PolicySetting.PluginConfig set by admin.
+	PolicyInfo struct {
+		ID         int    `json:"id"`
+		Name       string `json:"name"`
+		Server     string `json:"server,omitempty"`
+		BucketName string `json:"bucket_name,omitempty"`
+		IsPrivate  bool   `json:"is_private"`
+		AccessKey  string `json:"access_key,omitempty"`
+		SecretKey  string `json:"secret_key,omitempty"`
+		Config     string `json:"config,omitempty"`
+	}
+
+	// Request is embedded in all requests.
+	Request struct {
+		Policy PolicyInfo `json:"policy"`
+	}
+
+	ErrorResponse struct {
+		Error string `json:"error"`
+	}
+
+	// CapabilitiesResponse response of capabilities.
+	CapabilitiesResponse struct {
+		ProtocolVersion        int      `json:"protocol_version"`
+		ProxyRequired          bool     `json:"proxy_required,omitempty"`
+		UploadSentinelRequired bool     `json:"upload_sentinel_required,omitempty"`
+		MaxSourceExpire        int64    `json:"max_source_expire,omitempty"` // seconds
+		MinSourceExpire        int64    `json:"min_source_expire,omitempty"` // seconds
+		MediaMetaSupportedExts []string `json:"media_meta_supported_exts,omitempty"`
+		ThumbSupportedExts     []string `json:"thumb_supported_exts,omitempty"`
+		ThumbSupportAllExts    bool     `json:"thumb_support_all_exts,omitempty"`
+		ThumbMaxSize           int64    `json:"thumb_max_size,omitempty"`
+		BrowserRelayedDownload bool     `json:"browser_relayed_download,omitempty"`
+	}
+
+	// PutRequest request of put, file should be created or appended from Offset.
+	PutRequest struct {
+		Request
+		Path      string `json:"path"`
+		Size      int64  `json:"size"`
+		Offset    int64  `json:"offset,omitempty"`
+		Overwrite bool   `json:"overwrite"`
+		MimeType  string `json:"mime_type,omitempty"`
+	}
+
+	// OpenRequest request of open.
+	OpenRequest struct {
+		Request
+		Path   string `json:"path"`
+		Offset int64  `json:"offset,omitempty"`
+	}
+
+	// DeleteRequest request of delete, missing files should not be reported as failed.
+	DeleteRequest struct {
+		Request
+		Files []string `json:"files"`
+	}
+
+	DeleteResponse struct {
+		Failed []string `json:"failed,omitempty"`
+		Error  string   `json:"error,omitempty"`
+	}
+
+	// ListRequest request of list, objects are relative to Base.
+	ListRequest struct {
+		Request
+		Base      string `json:"base"`
+		Recursive bool   `json:"recursive"`
+	}
+
+	ListResponse struct {
+		Objects []fs.PhysicalObject `json:"objects"`
+	}
+
+	// EntityInfo is the file entity the call is made for.
+	EntityInfo struct {
+		Source string `json:"source"`
+		Size   int64  `json:"size"`
+	}
+
+	// SourceRequest request of source.
+	SourceRequest struct {
+		Request
+		Entity      EntityInfo `json:"entity"`
+		Expire      *time.Time `json:"expire,omitempty"`
+		IsDownload  bool       `json:"is_download"`
+		Speed       int64      `json:"speed,omitempty"`
+		DisplayName string     `json:"display_name,omitempty"`
+	}
+
+	// ThumbRequest request of thumb.
+	ThumbRequest struct {
+		Request
+		Entity EntityInfo `json:"entity"`
+		Ext    string     `json:"ext"`
+		Expire *time.Time `json:"expire,omitempty"`
+	}
+
+	// URLResponse response of source and thumb.
+	URLResponse struct {
+		URL string `json:"url"`
+	}
+
+	// UploadSessionInfo is the upload session the call is made for. Plugins, or the storage
+	// behind it, POST to Callback once client finishes uploading to complete the session.
+	UploadSessionInfo struct {
+		ID        string    `json:"id"`
+		Path      string    `json:"path"`
+		Size      int64     `json:"size"`
+		MimeType  string    `json:"mime_type,omitempty"`
+		Overwrite bool      `json:"overwrite"`
+		ExpireAt  time.Time `json:"expire_at"`
+		Callback  string    `json:"callback"`
+		UploadID  string    `json:"upload_id,omitempty"`
+	}
+
+	// UploadSessionRequest request of token, cancel_token and complete_upload.
+	UploadSessionRequest struct {
+		Request
+		Session UploadSessionInfo `json:"session"`
+	}
+
+	// TokenResponse response of token, UploadID is passed back in later calls of the session.
+	TokenResponse struct {
+		UploadID    string   `json:"upload_id,omitempty"`
+		ChunkSize   int64    `json:"chunk_size,omitempty"`
+		UploadURLs  []string `json:"upload_urls,omitempty"`
+		Credential  string   `json:"credential,omitempty"`
+		CompleteURL string   `json:"complete_url,omitempty"`
+	}
+
+	// MediaMetaRequest request of media_meta.
+	MediaMetaRequest struct {
+		Request
+		Path     string `json:"path"`
+		Ext      string `json:"ext"`
+		Language string `json:"language"`
+	}
+
+	MediaMetaResponse struct {
+		Metas []driver.MediaMeta `json:"metas"`
+	}
+)
@@ -0,0 +1,155 @@
+package sftp
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	defaultPort = "22"
+	dialTimeout = 30 * time.Second
+)
+
+// ErrHostKeyRequired is returned if host key fingerprint of SFTP server is not configured.
+var ErrHostKeyRequired = errors.New("sftp host key fingerprint is required")
+
+// defaultPool shares SFTP connections between driver instances of the same policy, since drivers
+// are created per request.
+var defaultPool = &pool{conns: make(map[string]*conn)}
+
+type (
+	pool struct {
+		mu    sync.Mutex
+		conns map[string]*conn
+	}
+
+	conn struct {
+		ssh  *ssh.Client
+		sftp *sftp.Client
+	}
+)
+
+// get returns a connected client for given policy, a new connection is made if the previous one
+// is closed or policy credentials changed.
+func (p *pool) get(policy *ent.StoragePolicy, l logging.Logger) (*sftp.Client, error) {
+	key := connKey(policy)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if c, ok := p.conns[key]; ok {
+		return c.sftp, nil
+	}
+
+	c, err := dial(policy)
+	if err != nil {
+		return nil, err
+	}
+
+	p.conns[key] = c
+	go func() {
+		err := c.ssh.Wait()
+		l.Debug("SFTP connection to %q closed: %v", policy.Server, err)
+		c.sftp.Close()
+
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		if p.conns[key] == c {
+			delete(p.conns, key)
+		}
+	}()
+
+	return c.sftp, nil
+}
+
+func dial(policy *ent.StoragePolicy) (*conn, error) {
+	auth, err := authMethod(policy.SecretKey)
+	if err != nil {
+		return nil, err
+	}
+
+	hostKeyCallback, err := fixedHostKey(policy)
+	if err != nil {
+		return nil, err
+	}
+
+	addr := policy.Server
+	if _, _, err := net.SplitHostPort(addr); err != nil {
+		addr = net.JoinHostPort(addr, defaultPort)
+	}
+
+	client, err := ssh.Dial("tcp", addr, &ssh.ClientConfig{
+		User:            policy.AccessKey,
+		Auth:            []ssh.AuthMethod{auth},
+		HostKeyCallback: hostKeyCallback,
+		Timeout:         dialTimeout,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to ssh server: %w", err)
+	}
+
+	sftpClient, err := sftp.NewClient(client)
+	if err != nil {
+		client.Close()
+		return nil, fmt.Errorf("failed to start sftp session: %w", err)
+	}
+
+	return &conn{ssh: client, sftp: sftpClient}, nil
+}
+
+// fixedHostKey accepts only the host key matching fingerprint configured in policy.
+func fixedHostKey(policy *ent.StoragePolicy) (ssh.HostKeyCallback, error) {
+	if policy.Settings == nil || policy.Settings.HostKey == "" {
+		return nil, ErrHostKeyRequired
+	}
+
+	expected := policy.Settings.HostKey
+	return func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+		if actual := ssh.FingerprintSHA256(key); actual != expected {
+			return fmt.Errorf("host key mismatch, got %q", actual)
+		}
+		return nil
+	}, nil
+}
+
+// authMethod uses secret as private key if it is PEM encoded, otherwise as password.
+func authMethod(secret string) (ssh.AuthMethod, error) {
+	if secret == "" {
+		return nil, errors.New("password or private key is required")
+	}
+
+	if !strings.HasPrefix(strings.TrimSpace(secret), "-----BEGIN") {
+		return ssh.Password(secret), nil
+	}
+
+	signer, err := ssh.ParsePrivateKey([]byte(secret))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %w", err)
+	}
+
+	return ssh.PublicKeys(signer), nil
+}
+
+func connKey(policy *ent.StoragePolicy) string {
+	hostKey := ""
+	if policy.Settings != nil {
+		hostKey = policy.Settings.HostKey
+	}
+
+	h := sha256.Sum256([]byte(strings.Join([]string{
+		strconv.Itoa(policy.ID), policy.Server, policy.AccessKey, policy.SecretKey, hostKey,
+	}, "\x00")))
+	return hex.EncodeToString(h[:])
+}
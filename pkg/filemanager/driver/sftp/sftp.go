@@ -0,0 +1,266 @@
+package sftp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/pkg/boolset"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/chunk"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/chunk/backoff"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/pkg/sftp"
+)
+
+const (
+	chunkRetrySleep  = time.Duration(5) * time.Second
+	defaultChunkSize = 25 << 20
+)
+
+var (
+	capabilities = &driver.Capabilities{
+		StaticFeatures: &boolset.BooleanSet{},
+		MediaMetaProxy: true,
+		ThumbProxy:     true,
+	}
+)
+
+func init() {
+	boolset.Sets(map[driver.HandlerCapability]bool{
+		driver.HandlerCapabilityProxyRequired: true,
+	}, capabilities.StaticFeatures)
+}
+
+// Driver stores files on a remote host over SFTP. Policy.Server is the address of SSH server,
+// Policy.AccessKey is the user name and Policy.SecretKey is either the password or a PEM
+// encoded private key. Policy.Settings.HostKey must be the fingerprint of server's host key.
+type Driver struct {
+	policy   *ent.StoragePolicy
+	settings setting.Provider
+	l        logging.Logger
+}
+
+// New constructs a new SFTP driver, connection is established on first use.
+func New(ctx context.Context, policy *ent.StoragePolicy, settings setting.Provider, l logging.Logger) (*Driver, error) {
+	if policy.Server == "" || policy.AccessKey == "" {
+		return nil, errors.New("sftp server and user name are required")
+	}
+
+	if policy.Settings == nil || policy.Settings.HostKey == "" {
+		return nil, ErrHostKeyRequired
+	}
+
+	return &Driver{
+		policy:   policy,
+		settings: settings,
+		l:        l,
+	}, nil
+}
+
+func (handler *Driver) List(ctx context.Context, base string, onProgress driver.ListProgressFunc, recursive bool) ([]fs.PhysicalObject, error) {
+	c, err := handler.client()
+	if err != nil {
+		return nil, err
+	}
+
+	var res []fs.PhysicalObject
+	root := path.Clean(base)
+	walker := c.Walk(root)
+	for walker.Step() {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+
+		// Skip root directory
+		if walker.Path() == root {
+			if err := walker.Err(); err != nil {
+				return nil, fmt.Errorf("failed to walk %q: %w", root, err)
+			}
+			continue
+		}
+
+		if err := walker.Err(); err != nil {
+			handler.l.Warning("Failed to walk folder %q: %s", walker.Path(), err)
+			walker.SkipDir()
+			continue
+		}
+
+		info := walker.Stat()
+		rel := strings.TrimPrefix(strings.TrimPrefix(walker.Path(), root), "/")
+		res = append(res, fs.PhysicalObject{
+			Name:         info.Name(),
+			RelativePath: rel,
+			Source:       walker.Path(),
+			Size:         info.Size(),
+			IsDir:        info.IsDir(),
+			LastModify:   info.ModTime(),
+		})
+		onProgress(1)
+
+		// If not recursive, do not enter directory
+		if !recursive && info.IsDir() {
+			walker.SkipDir()
+		}
+	}
+
+	return res, nil
+}
+
+func (handler *Driver) Open(ctx context.Context, path string) (*os.File, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (handler *Driver) LocalPath(ctx context.Context, path string) string {
+	return ""
+}
+
+// OpenStream opens remote file for reading from given offset.
+func (handler *Driver) OpenStream(ctx context.Context, path string, offset int64) (io.ReadCloser, error) {
+	c, err := handler.client()
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := c.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open remote file: %w", err)
+	}
+
+	if offset > 0 {
+		if _, err := f.Seek(offset, io.SeekStart); err != nil {
+			f.Close()
+			return nil, fmt.Errorf("failed to seek remote file: %w", err)
+		}
+	}
+
+	return f, nil
+}
+
+// Put writes the file stream to remote host in chunks, failed chunks are retried if the stream
+// is seekable or chunk buffer is enabled.
+func (handler *Driver) Put(ctx context.Context, file *fs.UploadRequest) error {
+	defer file.Close()
+
+	c, err := handler.client()
+	if err != nil {
+		return err
+	}
+
+	dst := file.Props.SavePath
+	if file.Mode&fs.ModeOverwrite != fs.ModeOverwrite {
+		if _, err := c.Stat(dst); err == nil {
+			return errors.New("file with the same name existed or unavailable")
+		}
+	}
+
+	if err := c.MkdirAll(path.Dir(dst)); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	openMode := os.O_CREATE | os.O_WRONLY
+	if file.Offset == 0 {
+		openMode |= os.O_TRUNC
+	}
+
+	out, err := c.OpenFile(dst, openMode)
+	if err != nil {
+		return fmt.Errorf("failed to open or create remote file: %w", err)
+	}
+	defer out.Close()
+
+	chunkSize := handler.policy.Settings.ChunkSize
+	if chunkSize == 0 {
+		chunkSize = defaultChunkSize
+	}
+
+	chunks := chunk.NewChunkGroup(file, chunkSize, &backoff.ConstantBackoff{
+		Max:   handler.settings.ChunkRetryLimit(ctx),
+		Sleep: chunkRetrySleep,
+	}, handler.settings.UseChunkBuffer(ctx), handler.l, handler.settings.TempPath(ctx))
+
+	uploadFunc := func(current *chunk.ChunkGroup, content io.Reader) error {
+		n, err := io.Copy(io.NewOffsetWriter(out, file.Offset+current.Start()), content)
+		if err != nil {
+			return err
+		}
+
+		if n != current.Length() {
+			return io.ErrUnexpectedEOF
+		}
+
+		return nil
+	}
+
+	for chunks.Next() {
+		if err := chunks.Process(uploadFunc); err != nil {
+			return fmt.Errorf("failed to upload chunk #%d: %w", chunks.Index(), err)
+		}
+	}
+
+	return nil
+}
+
+// Delete deletes files from remote host, returns paths failed to delete and last error.
+func (handler *Driver) Delete(ctx context.Context, files ...string) ([]string, error) {
+	c, err := handler.client()
+	if err != nil {
+		return files, err
+	}
+
+	failed := make([]string, 0, len(files))
+	var retErr error
+	for _, file := range files {
+		if err := c.Remove(file); err != nil && !errors.Is(err, os.ErrNotExist) {
+			handler.l.Warning("Failed to delete remote file %q: %s", file, err)
+			failed = append(failed, file)
+			retErr = err
+		}
+	}
+
+	return failed, retErr
+}
+
+func (handler *Driver) Thumb(ctx context.Context, expire *time.Time, ext string, e fs.Entity) (string, error) {
+	return "", errors.New("not implemented")
+}
+
+// Source is not implemented, file content is always served by Cloudreve's proxy.
+func (handler *Driver) Source(ctx context.Context, e fs.Entity, args *driver.GetSourceArgs) (string, error) {
+	return "", errors.New("not implemented")
+}
+
+// Token is not supported, SFTP policy only accepts relayed upload.
+func (handler *Driver) Token(ctx context.Context, uploadSession *fs.UploadSession, file *fs.UploadRequest) (*fs.UploadCredential, error) {
+	return nil, errors.New("sftp policy only supports relayed upload")
+}
+
+func (handler *Driver) CancelToken(ctx context.Context, uploadSession *fs.UploadSession) error {
+	return nil
+}
+
+func (handler *Driver) CompleteUpload(ctx context.Context, session *fs.UploadSession) error {
+	return nil
+}
+
+func (handler *Driver) Capabilities() *driver.Capabilities {
+	return capabilities
+}
+
+func (handler *Driver) MediaMeta(ctx context.Context, path, ext, language string) ([]driver.MediaMeta, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (handler *Driver) client() (*sftp.Client, error) {
+	return defaultPool.get(handler.policy, handler.l)
+}
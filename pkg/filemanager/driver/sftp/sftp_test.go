@@ -0,0 +1,239 @@
+package sftp_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency/deptest"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	sftpdriver "github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver/sftp"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/pkg/sftp"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	testUser     = "cloudreve"
+	testPassword = "secret"
+)
+
+// testServer is an in-process SSH server serving SFTP subsystem rooted at a temp directory.
+type testServer struct {
+	addr        string
+	fingerprint string
+	root        string
+}
+
+func newTestServer(t *testing.T) *testServer {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	signer, err := ssh.NewSignerFromKey(key)
+	require.NoError(t, err)
+
+	config := &ssh.ServerConfig{
+		PasswordCallback: func(c ssh.ConnMetadata, password []byte) (*ssh.Permissions, error) {
+			if c.User() == testUser && string(password) == testPassword {
+				return nil, nil
+			}
+			return nil, errors.New("access denied")
+		},
+	}
+	config.AddHostKey(signer)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	s := &testServer{
+		addr:        listener.Addr().String(),
+		fingerprint: ssh.FingerprintSHA256(signer.PublicKey()),
+		root:        t.TempDir(),
+	}
+
+	var (
+		mu    sync.Mutex
+		conns []net.Conn
+	)
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			mu.Lock()
+			conns = append(conns, conn)
+			mu.Unlock()
+			go s.serve(conn, config)
+		}
+	}()
+	t.Cleanup(func() {
+		listener.Close()
+		mu.Lock()
+		defer mu.Unlock()
+		for _, conn := range conns {
+			conn.Close()
+		}
+	})
+
+	return s
+}
+
+func (s *testServer) serve(conn net.Conn, config *ssh.ServerConfig) {
+	_, chans, reqs, err := ssh.NewServerConn(conn, config)
+	if err != nil {
+		return
+	}
+	go ssh.DiscardRequests(reqs)
+
+	for newChannel := range chans {
+		if newChannel.ChannelType() != "session" {
+			newChannel.Reject(ssh.UnknownChannelType, "unknown channel type")
+			continue
+		}
+
+		channel, requests, err := newChannel.Accept()
+		if err != nil {
+			return
+		}
+
+		go func() {
+			for req := range requests {
+				// Payload of subsystem request is a length prefixed subsystem name.
+				ok := req.Type == "subsystem" && len(req.Payload) > 4 && string(req.Payload[4:]) == "sftp"
+				req.Reply(ok, nil)
+				if !ok {
+					continue
+				}
+
+				server, err := sftp.NewServer(channel, sftp.WithServerWorkingDirectory(s.root))
+				if err != nil {
+					channel.Close()
+					return
+				}
+				go func() {
+					server.Serve()
+					server.Close()
+				}()
+			}
+		}()
+	}
+}
+
+func (s *testServer) policy(id int) *ent.StoragePolicy {
+	return &ent.StoragePolicy{
+		ID:        id,
+		Type:      types.PolicyTypeSftp,
+		Server:    s.addr,
+		AccessKey: testUser,
+		SecretKey: testPassword,
+		Settings:  &types.PolicySetting{HostKey: s.fingerprint},
+	}
+}
+
+func newUploadRequest(savePath string, content []byte, mode fs.WriteMode) *fs.UploadRequest {
+	reader := bytes.NewReader(content)
+	return &fs.UploadRequest{
+		Props:  &fs.UploadProps{SavePath: savePath, Size: int64(len(content))},
+		Mode:   mode,
+		File:   io.NopCloser(reader),
+		Seeker: reader,
+	}
+}
+
+func TestDriver_HostKey(t *testing.T) {
+	a := assert.New(t)
+	dep := deptest.New(t)
+	s := newTestServer(t)
+	ctx := context.Background()
+
+	policy := s.policy(1)
+	policy.Settings.HostKey = ""
+	_, err := sftpdriver.New(ctx, policy, dep.SettingProvider(), dep.Logger())
+	a.ErrorIs(err, sftpdriver.ErrHostKeyRequired)
+
+	policy = s.policy(2)
+	policy.Settings.HostKey = "SHA256:mismatch"
+	handler, err := sftpdriver.New(ctx, policy, dep.SettingProvider(), dep.Logger())
+	require.NoError(t, err)
+	_, err = handler.List(ctx, ".", func(int) {}, false)
+	a.ErrorContains(err, "host key mismatch")
+	a.ErrorContains(err, s.fingerprint)
+}
+
+func TestDriver_RoundTrip(t *testing.T) {
+	a := assert.New(t)
+	dep := deptest.New(t)
+	s := newTestServer(t)
+	ctx := context.Background()
+
+	handler, err := sftpdriver.New(ctx, s.policy(3), dep.SettingProvider(), dep.Logger())
+	require.NoError(t, err)
+
+	// Put creates missing directories, existing files are kept unless overwriting.
+	content := []byte("hello sftp policy")
+	require.NoError(t, handler.Put(ctx, newUploadRequest("uploads/sub/a.txt", content, fs.ModeNone)))
+	require.NoError(t, handler.Put(ctx, newUploadRequest("uploads/b.txt", []byte("old"), fs.ModeNone)))
+	a.Error(handler.Put(ctx, newUploadRequest("uploads/b.txt", []byte("new"), fs.ModeNone)))
+	require.NoError(t, handler.Put(ctx, newUploadRequest("uploads/b.txt", []byte("new"), fs.ModeOverwrite)))
+
+	stored, err := os.ReadFile(filepath.Join(s.root, "uploads", "sub", "a.txt"))
+	require.NoError(t, err)
+	a.Equal(content, stored)
+	stored, err = os.ReadFile(filepath.Join(s.root, "uploads", "b.txt"))
+	require.NoError(t, err)
+	a.Equal([]byte("new"), stored, "file is truncated on overwrite")
+
+	// Resumed upload writes from the offset.
+	resumed := newUploadRequest("uploads/b.txt", []byte("er"), fs.ModeOverwrite)
+	resumed.Offset = 3
+	require.NoError(t, handler.Put(ctx, resumed))
+	stored, err = os.ReadFile(filepath.Join(s.root, "uploads", "b.txt"))
+	require.NoError(t, err)
+	a.Equal([]byte("newer"), stored)
+
+	// List
+	listed := 0
+	objects, err := handler.List(ctx, "uploads", func(i int) { listed += i }, false)
+	require.NoError(t, err)
+	a.ElementsMatch([]string{"b.txt", "sub"}, lo.Map(objects, func(o fs.PhysicalObject, _ int) string { return o.RelativePath }))
+	a.Equal(2, listed)
+
+	objects, err = handler.List(ctx, "uploads", func(int) {}, true)
+	require.NoError(t, err)
+	a.ElementsMatch([]string{"b.txt", "sub", "sub/a.txt"}, lo.Map(objects, func(o fs.PhysicalObject, _ int) string { return o.RelativePath }))
+	for _, o := range objects {
+		if o.RelativePath == "sub/a.txt" {
+			a.Equal(int64(len(content)), o.Size)
+			a.Equal("uploads/sub/a.txt", o.Source)
+			a.False(o.IsDir)
+		}
+	}
+
+	// Range read
+	stream, err := handler.OpenStream(ctx, "uploads/sub/a.txt", 6)
+	require.NoError(t, err)
+	read, err := io.ReadAll(stream)
+	stream.Close()
+	require.NoError(t, err)
+	a.Equal(content[6:], read)
+
+	// Delete, missing files are ignored.
+	failed, err := handler.Delete(ctx, "uploads/sub/a.txt", "uploads/missing.txt")
+	a.NoError(err)
+	a.Empty(failed)
+	_, err = os.Stat(filepath.Join(s.root, "uploads", "sub", "a.txt"))
+	a.ErrorIs(err, os.ErrNotExist)
+}
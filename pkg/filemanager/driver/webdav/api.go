@@ -0,0 +1,168 @@
+package webdav
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+)
+
+const propfindBody = `<?xml version="1.0" encoding="utf-8"?>
+<d:propfind xmlns:d="DAV:">
+  <d:prop>
+    <d:resourcetype/>
+    <d:getcontentlength/>
+    <d:getlastmodified/>
+  </d:prop>
+</d:propfind>`
+
+type (
+	multistatus struct {
+		Responses []davResponse `xml:"DAV: response"`
+	}
+
+	davResponse struct {
+		Href     string     `xml:"DAV: href"`
+		Propstat []propstat `xml:"DAV: propstat"`
+	}
+
+	propstat struct {
+		Status string `xml:"DAV: status"`
+		Prop   struct {
+			ContentLength int64  `xml:"DAV: getcontentlength"`
+			LastModified  string `xml:"DAV: getlastmodified"`
+			ResourceType  struct {
+				Collection *struct{} `xml:"DAV: collection"`
+			} `xml:"DAV: resourcetype"`
+		} `xml:"DAV: prop"`
+	}
+
+	// davObject is a resource listed by PROPFIND, Path is relative to the server root URL.
+	davObject struct {
+		Path       string
+		Size       int64
+		IsDir      bool
+		LastModify time.Time
+	}
+)
+
+// url returns the URL of given path relative to the server root URL.
+func (handler *Driver) url(p string) string {
+	return handler.root.JoinPath(p).String()
+}
+
+func (handler *Driver) request(ctx context.Context, method, p string, body io.Reader, opts ...request.Option) *request.Response {
+	opts = append([]request.Option{
+		request.WithContext(ctx),
+		request.WithHeader(http.Header{"Authorization": {handler.authorization}}),
+		request.WithTPSLimit(
+			fmt.Sprintf("policy_%d", handler.policy.ID),
+			handler.policy.Settings.TPSLimit,
+			handler.policy.Settings.TPSLimitBurst,
+		),
+	}, opts...)
+	return handler.httpClient.Request(method, handler.url(p), body, opts...)
+}
+
+// propfind lists the resource at given path and its direct children.
+func (handler *Driver) propfind(ctx context.Context, p string) ([]davObject, error) {
+	resp := handler.request(ctx, "PROPFIND", p, strings.NewReader(propfindBody),
+		request.WithHeader(http.Header{"Depth": {"1"}, "Content-Type": {"application/xml; charset=utf-8"}}),
+	).CheckHTTPResponse(http.StatusMultiStatus)
+	if resp.Err != nil {
+		return nil, fmt.Errorf("failed to list %q: %w", p, resp.Err)
+	}
+	defer resp.Response.Body.Close()
+
+	var ms multistatus
+	if err := xml.NewDecoder(resp.Response.Body).Decode(&ms); err != nil {
+		return nil, fmt.Errorf("failed to parse PROPFIND response: %w", err)
+	}
+
+	res := make([]davObject, 0, len(ms.Responses))
+	for _, r := range ms.Responses {
+		href, err := url.Parse(r.Href)
+		if err != nil {
+			return nil, fmt.Errorf("invalid href %q: %w", r.Href, err)
+		}
+
+		rel := strings.Trim(strings.TrimPrefix(href.Path, handler.root.Path), "/")
+		for _, ps := range r.Propstat {
+			if !strings.Contains(ps.Status, " 200 ") {
+				continue
+			}
+
+			obj := davObject{
+				Path:  rel,
+				Size:  ps.Prop.ContentLength,
+				IsDir: ps.Prop.ResourceType.Collection != nil,
+			}
+			if t, err := http.ParseTime(ps.Prop.LastModified); err == nil {
+				obj.LastModify = t
+			}
+			res = append(res, obj)
+			break
+		}
+	}
+
+	return res, nil
+}
+
+// exist checks whether the resource at given path exists.
+func (handler *Driver) exist(ctx context.Context, p string) (bool, error) {
+	resp := handler.request(ctx, http.MethodHead, p, nil)
+	if resp.Err != nil {
+		return false, resp.Err
+	}
+	resp.Response.Body.Close()
+
+	switch resp.Response.StatusCode {
+	case http.StatusNotFound:
+		return false, nil
+	case http.StatusOK, http.StatusNoContent:
+		return true, nil
+	default:
+		return false, fmt.Errorf("unexpected status code: %d", resp.Response.StatusCode)
+	}
+}
+
+// mkcol creates given directory, missing parent directories are created as needed.
+func (handler *Driver) mkcol(ctx context.Context, dir string) error {
+	dir = strings.Trim(path.Clean(dir), "/")
+	if dir == "" || dir == "." {
+		return nil
+	}
+
+	resp := handler.request(ctx, "MKCOL", dir+"/", nil)
+	if resp.Err != nil {
+		return resp.Err
+	}
+	resp.Response.Body.Close()
+
+	switch resp.Response.StatusCode {
+	case http.StatusCreated, http.StatusMethodNotAllowed:
+		// 405 indicates the directory already exists
+		return nil
+	case http.StatusConflict:
+		// Parent directory does not exist
+		if err := handler.mkcol(ctx, path.Dir(dir)); err != nil {
+			return err
+		}
+
+		resp = handler.request(ctx, "MKCOL", dir+"/", nil).CheckHTTPResponse(http.StatusCreated, http.StatusMethodNotAllowed)
+		if resp.Err != nil {
+			return fmt.Errorf("failed to create directory %q: %w", dir, resp.Err)
+		}
+		resp.Response.Body.Close()
+		return nil
+	default:
+		return fmt.Errorf("failed to create directory %q: unexpected status code %d", dir, resp.Response.StatusCode)
+	}
+}
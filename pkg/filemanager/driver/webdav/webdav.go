@@ -0,0 +1,250 @@
+package webdav
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/pkg/boolset"
+	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/chunk"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/chunk/backoff"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+)
+
+const (
+	chunkRetrySleep = time.Duration(5) * time.Second
+)
+
+var (
+	capabilities = &driver.Capabilities{
+		StaticFeatures: &boolset.BooleanSet{},
+		MediaMetaProxy: true,
+		ThumbProxy:     true,
+	}
+)
+
+func init() {
+	boolset.Sets(map[driver.HandlerCapability]bool{
+		driver.HandlerCapabilityProxyRequired: true,
+	}, capabilities.StaticFeatures)
+}
+
+// Driver stores files on an upstream WebDAV server. Policy.Server is the root URL of WebDAV
+// server, Policy.AccessKey and Policy.SecretKey are the user name and password for basic auth.
+type Driver struct {
+	policy        *ent.StoragePolicy
+	root          *url.URL
+	authorization string
+
+	settings   setting.Provider
+	l          logging.Logger
+	httpClient request.Client
+}
+
+func New(ctx context.Context, policy *ent.StoragePolicy, settings setting.Provider,
+	config conf.ConfigProvider, l logging.Logger) (*Driver, error) {
+	root, err := url.Parse(policy.Server)
+	if err != nil || root.Host == "" {
+		return nil, fmt.Errorf("invalid webdav server %q: %w", policy.Server, err)
+	}
+
+	return &Driver{
+		policy:        policy,
+		root:          root,
+		authorization: "Basic " + base64.StdEncoding.EncodeToString([]byte(policy.AccessKey+":"+policy.SecretKey)),
+		settings:      settings,
+		l:             l,
+		httpClient:    request.NewClient(config, request.WithLogger(l)),
+	}, nil
+}
+
+func (handler *Driver) List(ctx context.Context, base string, onProgress driver.ListProgressFunc, recursive bool) ([]fs.PhysicalObject, error) {
+	base = strings.Trim(base, "/")
+
+	var res []fs.PhysicalObject
+	queue := []string{base}
+	for len(queue) > 0 {
+		dir := queue[0]
+		queue = queue[1:]
+
+		objects, err := handler.propfind(ctx, dir+"/")
+		if err != nil {
+			if dir == base {
+				return nil, err
+			}
+
+			handler.l.Warning("Failed to walk folder %q: %s", dir, err)
+			continue
+		}
+
+		for _, object := range objects {
+			// Skip the folder itself
+			if object.Path == dir {
+				continue
+			}
+
+			res = append(res, fs.PhysicalObject{
+				Name:         path.Base(object.Path),
+				RelativePath: strings.TrimPrefix(strings.TrimPrefix(object.Path, base), "/"),
+				Source:       object.Path,
+				Size:         object.Size,
+				IsDir:        object.IsDir,
+				LastModify:   object.LastModify,
+			})
+			onProgress(1)
+
+			if recursive && object.IsDir {
+				queue = append(queue, object.Path)
+			}
+		}
+	}
+
+	return res, nil
+}
+
+func (handler *Driver) Open(ctx context.Context, path string) (*os.File, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (handler *Driver) LocalPath(ctx context.Context, path string) string {
+	return ""
+}
+
+// OpenStream reads the remote file from given offset with a range request.
+func (handler *Driver) OpenStream(ctx context.Context, path string, offset int64) (io.ReadCloser, error) {
+	h := http.Header{}
+	if offset > 0 {
+		h.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+
+	resp := handler.request(ctx, http.MethodGet, path, nil, request.WithHeader(h)).
+		CheckHTTPResponse(http.StatusOK, http.StatusPartialContent)
+	if resp.Err != nil {
+		return nil, fmt.Errorf("failed to get remote file: %w", resp.Err)
+	}
+
+	// Range is ignored by server, skip to the offset manually
+	if offset > 0 && resp.Response.StatusCode == http.StatusOK {
+		if _, err := io.CopyN(io.Discard, resp.Response.Body, offset); err != nil {
+			resp.Response.Body.Close()
+			return nil, fmt.Errorf("failed to skip to offset %d: %w", offset, err)
+		}
+	}
+
+	return resp.Response.Body, nil
+}
+
+// Put uploads the file stream with a single PUT request, the request is retried if the stream is
+// seekable or chunk buffer is enabled.
+func (handler *Driver) Put(ctx context.Context, file *fs.UploadRequest) error {
+	defer file.Close()
+
+	if file.Offset > 0 {
+		return errors.New("webdav policy does not support chunked upload")
+	}
+
+	dst := file.Props.SavePath
+	if file.Mode&fs.ModeOverwrite != fs.ModeOverwrite {
+		exist, err := handler.exist(ctx, dst)
+		if err != nil {
+			return fmt.Errorf("failed to check existing file: %w", err)
+		}
+
+		if exist {
+			return errors.New("file with the same name existed or unavailable")
+		}
+	}
+
+	if err := handler.mkcol(ctx, path.Dir(dst)); err != nil {
+		return err
+	}
+
+	// Chunk size 0 puts the whole file in one chunk, WebDAV has no standard to append data.
+	chunks := chunk.NewChunkGroup(file, 0, &backoff.ConstantBackoff{
+		Max:   handler.settings.ChunkRetryLimit(ctx),
+		Sleep: chunkRetrySleep,
+	}, handler.settings.UseChunkBuffer(ctx), handler.l, handler.settings.TempPath(ctx))
+
+	uploadFunc := func(current *chunk.ChunkGroup, content io.Reader) error {
+		resp := handler.request(ctx, http.MethodPut, dst, content,
+			request.WithContentLength(current.Length()),
+		).CheckHTTPResponse(http.StatusOK, http.StatusCreated, http.StatusNoContent)
+		if resp.Err != nil {
+			return resp.Err
+		}
+
+		return resp.Response.Body.Close()
+	}
+
+	for chunks.Next() {
+		if err := chunks.Process(uploadFunc); err != nil {
+			return fmt.Errorf("failed to upload file: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Delete deletes files from upstream server, returns paths failed to delete and last error.
+func (handler *Driver) Delete(ctx context.Context, files ...string) ([]string, error) {
+	failed := make([]string, 0, len(files))
+	var retErr error
+	for _, file := range files {
+		resp := handler.request(ctx, http.MethodDelete, file, nil).
+			CheckHTTPResponse(http.StatusOK, http.StatusNoContent, http.StatusNotFound)
+		if resp.Err != nil {
+			handler.l.Warning("Failed to delete remote file %q: %s", file, resp.Err)
+			failed = append(failed, file)
+			retErr = resp.Err
+			continue
+		}
+
+		resp.Response.Body.Close()
+	}
+
+	return failed, retErr
+}
+
+func (handler *Driver) Thumb(ctx context.Context, expire *time.Time, ext string, e fs.Entity) (string, error) {
+	return "", errors.New("not implemented")
+}
+
+// Source is not implemented, file content is always served by Cloudreve's proxy.
+func (handler *Driver) Source(ctx context.Context, e fs.Entity, args *driver.GetSourceArgs) (string, error) {
+	return "", errors.New("not implemented")
+}
+
+// Token is not supported, WebDAV policy only accepts relayed upload.
+func (handler *Driver) Token(ctx context.Context, uploadSession *fs.UploadSession, file *fs.UploadRequest) (*fs.UploadCredential, error) {
+	return nil, errors.New("webdav policy only supports relayed upload")
+}
+
+func (handler *Driver) CancelToken(ctx context.Context, uploadSession *fs.UploadSession) error {
+	return nil
+}
+
+func (handler *Driver) CompleteUpload(ctx context.Context, session *fs.UploadSession) error {
+	return nil
+}
+
+func (handler *Driver) Capabilities() *driver.Capabilities {
+	return capabilities
+}
+
+func (handler *Driver) MediaMeta(ctx context.Context, path, ext, language string) ([]driver.MediaMeta, error) {
+	return nil, errors.New("not implemented")
+}
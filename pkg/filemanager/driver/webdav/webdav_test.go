@@ -0,0 +1,159 @@
+package webdav_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency/deptest"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver"
+	webdavdriver "github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver/webdav"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/webdav"
+)
+
+const (
+	testUser     = "cloudreve"
+	testPassword = "secret"
+)
+
+// newTestServer starts an in-process WebDAV server mounted at /dav/ serving a temp directory,
+// requests without valid basic auth are rejected.
+func newTestServer(t *testing.T) (*httptest.Server, string) {
+	root := t.TempDir()
+	dav := &webdav.Handler{
+		Prefix:     "/dav",
+		FileSystem: webdav.Dir(root),
+		LockSystem: webdav.NewMemLS(),
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user, password, ok := r.BasicAuth(); !ok || user != testUser || password != testPassword {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		dav.ServeHTTP(w, r)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, root
+}
+
+func newUploadRequest(savePath string, content []byte, mode fs.WriteMode) *fs.UploadRequest {
+	reader := bytes.NewReader(content)
+	return &fs.UploadRequest{
+		Props:  &fs.UploadProps{SavePath: savePath, Size: int64(len(content))},
+		Mode:   mode,
+		File:   io.NopCloser(reader),
+		Seeker: reader,
+	}
+}
+
+func TestDriver_RoundTrip(t *testing.T) {
+	a := assert.New(t)
+	dep := deptest.New(t)
+	srv, root := newTestServer(t)
+	ctx := context.Background()
+
+	handler, err := webdavdriver.New(ctx, &ent.StoragePolicy{
+		ID:        1,
+		Type:      types.PolicyTypeWebdav,
+		Server:    srv.URL + "/dav/",
+		AccessKey: testUser,
+		SecretKey: testPassword,
+		Settings:  &types.PolicySetting{},
+	}, dep.SettingProvider(), dep.ConfigProvider(), dep.Logger())
+	require.NoError(t, err)
+
+	// Put creates missing directories, existing files are kept unless overwriting.
+	content := []byte("hello webdav policy")
+	require.NoError(t, handler.Put(ctx, newUploadRequest("uploads/sub/a.txt", content, fs.ModeNone)))
+	require.NoError(t, handler.Put(ctx, newUploadRequest("uploads/b.txt", []byte("old"), fs.ModeNone)))
+	a.Error(handler.Put(ctx, newUploadRequest("uploads/b.txt", []byte("new"), fs.ModeNone)))
+	require.NoError(t, handler.Put(ctx, newUploadRequest("uploads/b.txt", []byte("new"), fs.ModeOverwrite)))
+
+	stored, err := os.ReadFile(filepath.Join(root, "uploads", "sub", "a.txt"))
+	require.NoError(t, err)
+	a.Equal(content, stored)
+	stored, err = os.ReadFile(filepath.Join(root, "uploads", "b.txt"))
+	require.NoError(t, err)
+	a.Equal([]byte("new"), stored)
+
+	resumed := newUploadRequest("uploads/b.txt", []byte("er"), fs.ModeOverwrite)
+	resumed.Offset = 3
+	a.Error(handler.Put(ctx, resumed), "chunked upload is not supported")
+
+	// List, paths are relative to the server root URL.
+	listed := 0
+	objects, err := handler.List(ctx, "uploads", func(i int) { listed += i }, false)
+	require.NoError(t, err)
+	a.ElementsMatch([]string{"b.txt", "sub"}, lo.Map(objects, func(o fs.PhysicalObject, _ int) string { return o.RelativePath }))
+	a.Equal(2, listed)
+
+	objects, err = handler.List(ctx, "uploads", func(int) {}, true)
+	require.NoError(t, err)
+	a.ElementsMatch([]string{"b.txt", "sub", "sub/a.txt"}, lo.Map(objects, func(o fs.PhysicalObject, _ int) string { return o.RelativePath }))
+	for _, o := range objects {
+		switch o.RelativePath {
+		case "sub/a.txt":
+			a.Equal(int64(len(content)), o.Size)
+			a.Equal("uploads/sub/a.txt", o.Source)
+			a.Equal("a.txt", o.Name)
+			a.False(o.IsDir)
+			a.False(o.LastModify.IsZero())
+		case "sub":
+			a.True(o.IsDir)
+		}
+	}
+
+	// Range read
+	stream, err := handler.OpenStream(ctx, "uploads/sub/a.txt", 6)
+	require.NoError(t, err)
+	read, err := io.ReadAll(stream)
+	stream.Close()
+	require.NoError(t, err)
+	a.Equal(content[6:], read)
+
+	// Source is served by proxy.
+	_, err = handler.Source(ctx, nil, &driver.GetSourceArgs{})
+	a.Error(err)
+
+	// Delete, missing files are ignored.
+	failed, err := handler.Delete(ctx, "uploads/sub/a.txt", "uploads/missing.txt")
+	a.NoError(err)
+	a.Empty(failed)
+	_, err = os.Stat(filepath.Join(root, "uploads", "sub", "a.txt"))
+	a.ErrorIs(err, os.ErrNotExist)
+}
+
+func TestDriver_Unauthorized(t *testing.T) {
+	a := assert.New(t)
+	dep := deptest.New(t)
+	srv, _ := newTestServer(t)
+	ctx := context.Background()
+
+	handler, err := webdavdriver.New(ctx, &ent.StoragePolicy{
+		ID:        2,
+		Type:      types.PolicyTypeWebdav,
+		Server:    srv.URL + "/dav/",
+		AccessKey: testUser,
+		SecretKey: "wrong",
+		Settings:  &types.PolicySetting{},
+	}, dep.SettingProvider(), dep.ConfigProvider(), dep.Logger())
+	require.NoError(t, err)
+
+	_, err = handler.List(ctx, "", func(int) {}, false)
+	a.Error(err)
+	failed, err := handler.Delete(ctx, "a.txt")
+	a.Error(err)
+	a.Equal([]string{"a.txt"}, failed)
+}
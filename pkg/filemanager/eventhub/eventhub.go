@@ -22,6 +22,8 @@ type (
 		Unsubscribe(ctx context.Context, topic int, id string)
 		// Get subscribers of a topic.
 		GetSubscribers(ctx context.Context, topic int) []Subscriber
+		// SubscriberCount returns number of online and offline subscribers of all topics.
+		SubscriberCount() (online, offline int)
 		// Close shuts down the event hub and disconnects all subscribers.
 		Close()
 	}
@@ -112,6 +114,22 @@ func (e *eventHub) GetSubscribers(ctx context.Context, topic int) []Subscriber {
 	return subs
 }
 
+func (e *eventHub) SubscriberCount() (online, offline int) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	for _, subs := range e.topics {
+		for _, sub := range subs {
+			if sub.Online() {
+				online++
+			} else {
+				offline++
+			}
+		}
+	}
+	return online, offline
+}
+
 func (e *eventHub) Subscribe(ctx context.Context, topic int, id string) (chan *Event, bool, error) {
 	l := logging.FromContext(ctx)
 	l.Info("Subscribing to event hub for topic %d with id %s", topic, id)
@@ -45,7 +45,8 @@ type (
 func NewDatabaseFS(u *ent.User, fileClient inventory.FileClient, shareClient inventory.ShareClient,
 	l logging.Logger, ls lock.LockSystem, settingClient setting.Provider,
 	storagePolicyClient inventory.StoragePolicyClient, hasher hashid.Encoder, userClient inventory.UserClient,
-	cache, stateKv cache.Driver, directLinkClient inventory.DirectLinkClient, encryptorFactory encrypt.CryptorFactory, eventHub eventhub.EventHub) fs.FileSystem {
+	cache, stateKv cache.Driver, directLinkClient inventory.DirectLinkClient, teamClient inventory.TeamClient,
+	encryptorFactory encrypt.CryptorFactory, eventHub eventhub.EventHub) fs.FileSystem {
 	return &DBFS{
 		user:                u,
 		navigators:          make(map[string]Navigator),
@@ -60,6 +61,7 @@ func NewDatabaseFS(u *ent.User, fileClient inventory.FileClient, shareClient inv
 		cache:               cache,
 		stateKv:             stateKv,
 		directLinkClient:    directLinkClient,
+		teamClient:          teamClient,
 		encryptorFactory:    encryptorFactory,
 		eventHub:            eventHub,
 	}
@@ -73,6 +75,7 @@ type DBFS struct {
 	storagePolicyClient inventory.StoragePolicyClient
 	shareClient         inventory.ShareClient
 	directLinkClient    inventory.DirectLinkClient
+	teamClient          inventory.TeamClient
 	l                   logging.Logger
 	ls                  lock.LockSystem
 	settingClient       setting.Provider
@@ -236,8 +239,13 @@ func (f *DBFS) Capacity(ctx context.Context, u *ent.User) (*fs.Capacity, error)
 		return nil, serializer.NewError(serializer.CodeDBError, "Failed to get user's group", err)
 	}
 
-	res.Used = f.user.Storage
+	res.Used = u.Storage
 	res.Total = requesterGroup.MaxStorage
+	res.FileCount = u.FileCount
+
+	if requesterGroup.Settings != nil {
+		res.MaxFileCount = requesterGroup.Settings.MaxFileCount
+	}
 	return res, nil
 }
 
@@ -451,7 +459,7 @@ func (f *DBFS) Get(ctx context.Context, path *fs.URI, opts ...fs.Option) (fs.Fil
 
 	// Calculate folder summary if requested
 	if o.loadFolderSummary && target != nil && target.Type() == types.FileTypeFolder {
-		if _, ok := ctx.Value(ByPassOwnerCheckCtxKey{}).(bool); !ok && target.OwnerID() != f.user.ID {
+		if _, ok := ctx.Value(ByPassOwnerCheckCtxKey{}).(bool); !ok && !target.ReadableBy(f.user) {
 			return nil, fs.ErrOwnerOnly
 		}
 
@@ -547,7 +555,7 @@ func (f *DBFS) Walk(ctx context.Context, path *fs.URI, depth int, walk fs.WalkFu
 	}
 
 	// Require Read permission
-	if _, ok := ctx.Value(ByPassOwnerCheckCtxKey{}).(bool); !ok && target.OwnerID() != f.user.ID {
+	if _, ok := ctx.Value(ByPassOwnerCheckCtxKey{}).(bool); !ok && !target.ReadableBy(f.user) {
 		return fs.ErrOwnerOnly
 	}
 
@@ -633,6 +641,9 @@ func (f *DBFS) createFile(ctx context.Context, parent *File, name string, fileTy
 	file, entity, storageDiff, err := fc.CreateFile(ctx, parent.Model, createFileArgs)
 	if err != nil {
 		_ = inventory.Rollback(tx)
+		if errors.Is(err, inventory.ErrInsufficientFileCount) {
+			return nil, fs.ErrInsufficientFileQuota
+		}
 		if ent.IsConstraintError(err) {
 			return nil, fs.ErrFileExisted.WithError(err)
 		}
@@ -737,6 +748,12 @@ func (f *DBFS) getNavigator(ctx context.Context, path *fs.URI, requiredCapabilit
 			n = NewTrashNavigator(f.user, f.fileClient, f.l, config, f.hasher)
 		case constants.FileSystemSharedWithMe:
 			n = NewSharedWithMeNavigator(f.user, f.fileClient, f.l, config, f.hasher)
+		case constants.FileSystemTeam:
+			var err error
+			n, err = NewTeamNavigator(ctx, path, f.user, f.fileClient, f.userClient, f.teamClient, f.l, config, f.hasher)
+			if err != nil {
+				return nil, err
+			}
 		default:
 			return nil, fmt.Errorf("unknown file system %q", pathFs)
 		}
@@ -745,7 +762,7 @@ func (f *DBFS) getNavigator(ctx context.Context, path *fs.URI, requiredCapabilit
 		if stateID, ok := ctx.Value(ContextHintCtxKey{}).(uuid.UUID); ok && stateID != uuid.Nil {
 			cacheKey := NavigatorStateCachePrefix + stateID.String() + "_" + navigatorId
 			if stateRaw, ok := f.stateKv.Get(cacheKey); ok {
-				if err := n.RestoreState(stateRaw.(State)); err != nil {
+				if err := n.RestoreState(ctx, stateRaw.(State)); err != nil {
 					f.l.Warning("Failed to restore state for navigator %q: %s", navigatorId, err)
 				} else {
 					f.l.Info("Navigator %q restored state (%q) successfully", navigatorId, stateID)
@@ -760,6 +777,13 @@ func (f *DBFS) getNavigator(ctx context.Context, path *fs.URI, requiredCapabilit
 		res = n
 	}
 
+	// Capabilities of share navigator depend on the share mode, resolve the share first.
+	if sn, ok := res.(*shareNavigator); ok && sn.share == nil {
+		if _, err := sn.Root(ctx, path); err != nil {
+			return nil, err
+		}
+	}
+
 	// Check fs capabilities
 	capabilities := res.Capabilities(false).Capability
 	for _, capability := range requiredCapabilities {
@@ -804,14 +828,17 @@ func generateSavePath(policy *ent.StoragePolicy, req *fs.UploadRequest, user *en
 
 func canMoveOrCopyTo(src, dst *fs.URI, isCopy bool) bool {
 	if isCopy {
-		return src.FileSystem() == dst.FileSystem() && src.FileSystem() == constants.FileSystemMy
+		// Copied files are owned by the destination owner, so they can be copied across my and team fs.
+		return (src.FileSystem() == constants.FileSystemMy || src.FileSystem() == constants.FileSystemTeam) &&
+			(dst.FileSystem() == constants.FileSystemMy || dst.FileSystem() == constants.FileSystemTeam)
 	} else {
 		switch src.FileSystem() {
 		case constants.FileSystemMy:
 			return dst.FileSystem() == constants.FileSystemMy || dst.FileSystem() == constants.FileSystemTrash
 		case constants.FileSystemTrash:
 			return dst.FileSystem() == constants.FileSystemMy
-
+		case constants.FileSystemTeam:
+			return dst.FileSystem() == constants.FileSystemTeam && src.ID("") == dst.ID("")
 		}
 	}
 
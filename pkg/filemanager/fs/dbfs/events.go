@@ -9,6 +9,7 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/eventhub"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
 	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/webhook"
 	"github.com/samber/lo"
 )
 
@@ -21,6 +22,8 @@ func (f *DBFS) emitFileCreated(ctx context.Context, file *File) {
 			From:   subscriber.relativePath(file),
 		})
 	}
+
+	f.dispatchFileWebhook(ctx, webhook.EventFileCreated, file, "")
 }
 
 func (f *DBFS) emitFileModified(ctx context.Context, file *File) {
@@ -32,6 +35,8 @@ func (f *DBFS) emitFileModified(ctx context.Context, file *File) {
 			From:   subscriber.relativePath(file),
 		})
 	}
+
+	f.dispatchFileWebhook(ctx, webhook.EventFileModified, file, "")
 }
 
 func (f *DBFS) emitFileRenamed(ctx context.Context, file *File, newName string) {
@@ -46,6 +51,8 @@ func (f *DBFS) emitFileRenamed(ctx context.Context, file *File, newName string)
 			To:     to,
 		})
 	}
+
+	f.dispatchFileWebhook(ctx, webhook.EventFileRenamed, file, file.Uri(false).DirUri().Join(newName).String())
 }
 
 func (f *DBFS) emitFileDeleted(ctx context.Context, files ...*File) {
@@ -58,6 +65,8 @@ func (f *DBFS) emitFileDeleted(ctx context.Context, files ...*File) {
 				From:   subscriber.relativePath(file),
 			})
 		}
+
+		f.dispatchFileWebhook(ctx, webhook.EventFileDeleted, file, "")
 	}
 }
 
@@ -99,6 +108,16 @@ func (f *DBFS) emitFileMoved(ctx context.Context, src, dst *File) {
 		})
 	}
 
+	f.dispatchFileWebhook(ctx, webhook.EventFileRenamed, src, dst.Uri(false).Join(src.Name()).String())
+}
+
+// dispatchFileWebhook dispatches file event to webhooks subscribed by the file owner.
+func (f *DBFS) dispatchFileWebhook(ctx context.Context, event webhook.EventType, file *File, to string) {
+	webhook.Dispatch(ctx, file.Owner(), event, &webhook.FileEventData{
+		FileID: hashid.EncodeFileID(f.hasher, file.Model.ID),
+		Uri:    file.Uri(false).String(),
+		To:     to,
+	})
 }
 
 func (f *DBFS) getEligibleSubscriber(ctx context.Context, file *File, checkParentPerm bool) []foundSubscriber {
@@ -48,6 +48,7 @@ type (
 		Path              [2]*fs.URI
 		OwnerModel        *ent.User
 		IsUserRoot        bool
+		Delegation        Delegation
 		CapabilitiesBs    *boolset.BooleanSet
 		FileExtendedInfo  *fs.FileExtendedInfo
 		FileFolderSummary *fs.FolderSummary
@@ -55,6 +56,9 @@ type (
 		disableView bool
 		mu          *sync.Mutex
 	}
+
+	// Delegation is the access to files of OwnerModel granted to the requester, set on user root.
+	Delegation int
 )
 
 const (
@@ -75,6 +79,12 @@ const (
 	pathIndexUser = 1
 )
 
+const (
+	DelegationNone Delegation = iota
+	DelegationRead
+	DelegationWrite
+)
+
 func (f *File) Name() string {
 	return f.Model.Name
 }
@@ -158,6 +168,26 @@ func (f *File) OwnerID() int {
 	return f.Model.OwnerID
 }
 
+// OwnedBy returns true if the file is owned by given user, or write access is delegated to the user.
+func (f *File) OwnedBy(u *ent.User) bool {
+	return f.OwnerID() == u.ID || f.delegated(u, DelegationWrite)
+}
+
+// ReadableBy returns true if the file is owned by given user, or read access is delegated to the user.
+func (f *File) ReadableBy(u *ent.User) bool {
+	return f.OwnerID() == u.ID || f.delegated(u, DelegationRead)
+}
+
+func (f *File) delegated(u *ent.User, required Delegation) bool {
+	root := f.UserRoot()
+	if root == nil || root.Delegation < required {
+		return false
+	}
+
+	owner := root.Owner()
+	return owner != nil && owner.ID == f.OwnerID()
+}
+
 func (f *File) Shared() bool {
 	return len(f.Model.Edges.Shares) > 0
 }
@@ -372,6 +402,7 @@ func (f *File) Recycle() {
 	f.Parent = nil
 	f.OwnerModel = nil
 	f.IsUserRoot = false
+	f.Delegation = DelegationNone
 	f.CapabilitiesBs = nil
 	f.FileExtendedInfo = nil
 	f.FileFolderSummary = nil
@@ -2,6 +2,7 @@ package dbfs
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"path/filepath"
 	"strconv"
@@ -42,6 +43,12 @@ func (f *DBFS) Create(ctx context.Context, path *fs.URI, fileType types.FileType
 		}
 	}
 
+	if v, ok := navigator.(createValidator); ok {
+		if err := v.validateCreate(ctx, path, fileType, o.UploadRequest); err != nil {
+			return nil, err
+		}
+	}
+
 	if ancestor.Uri(false).IsSame(path, hashid.EncodeUserID(f.hasher, f.user.ID)) {
 		if ancestor.Type() == fileType {
 			if o.errOnConflict {
@@ -57,7 +64,7 @@ func (f *DBFS) Create(ctx context.Context, path *fs.URI, fileType types.FileType
 			WithError(fmt.Errorf("object with the same name but different type %q already exist", ancestor.Type()))
 	}
 
-	if _, ok := ctx.Value(ByPassOwnerCheckCtxKey{}).(bool); !ok && ancestor.Owner().ID != f.user.ID {
+	if _, ok := ctx.Value(ByPassOwnerCheckCtxKey{}).(bool); !ok && !ancestor.OwnedBy(f.user) {
 		return nil, fs.ErrOwnerOnly
 	}
 
@@ -77,6 +84,16 @@ func (f *DBFS) Create(ctx context.Context, path *fs.URI, fileType types.FileType
 		return nil, fs.ErrPathNotExist
 	}
 
+	capacity, err := f.Capacity(ctx, ancestor.Owner())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user capacity: %w", err)
+	}
+
+	ctx, err = f.withFileCountLimit(ctx, len(desired)-len(existedElements), ancestor.Owner(), capacity)
+	if err != nil {
+		return nil, err
+	}
+
 	for i := len(existedElements); i < len(desired); i++ {
 		// Make sure parent is a folder
 		if !ancestor.CanHaveChildren() {
@@ -111,6 +128,9 @@ func (f *DBFS) Create(ctx context.Context, path *fs.URI, fileType types.FileType
 			newFolder, err := fc.CreateFolder(ctx, ancestor.Model, args)
 			if err != nil {
 				_ = inventory.Rollback(tx)
+				if errors.Is(err, inventory.ErrInsufficientFileCount) {
+					return nil, fs.ErrInsufficientFileQuota
+				}
 				return nil, fmt.Errorf("failed to create folder %q: %w", desired[i], err)
 			}
 
@@ -162,7 +182,7 @@ func (f *DBFS) Rename(ctx context.Context, path *fs.URI, newName string) (fs.Fil
 	}
 	oldName := target.Name()
 
-	if _, ok := ctx.Value(ByPassOwnerCheckCtxKey{}).(bool); !ok && target.Owner().ID != f.user.ID {
+	if _, ok := ctx.Value(ByPassOwnerCheckCtxKey{}).(bool); !ok && !target.OwnedBy(f.user) {
 		return nil, nil, fs.ErrOwnerOnly
 	}
 
@@ -265,7 +285,7 @@ func (f *DBFS) SoftDelete(ctx context.Context, path ...*fs.URI) error {
 			continue
 		}
 
-		if _, ok := ctx.Value(ByPassOwnerCheckCtxKey{}).(bool); !ok && target.Owner().ID != f.user.ID {
+		if _, ok := ctx.Value(ByPassOwnerCheckCtxKey{}).(bool); !ok && !target.OwnedBy(f.user) {
 			ae.Add(p.String(), fs.ErrOwnerOnly.WithError(fmt.Errorf("only file owner can delete file without trash bin")))
 			continue
 		}
@@ -359,7 +379,7 @@ func (f *DBFS) Delete(ctx context.Context, path []*fs.URI, opts ...fs.Option) ([
 			continue
 		}
 
-		if _, ok := ctx.Value(ByPassOwnerCheckCtxKey{}).(bool); !o.SysSkipSoftDelete && !ok && target.Owner().ID != f.user.ID {
+		if _, ok := ctx.Value(ByPassOwnerCheckCtxKey{}).(bool); !o.SysSkipSoftDelete && !ok && !target.OwnedBy(f.user) {
 			ae.Add(p.String(), fs.ErrOwnerOnly)
 			continue
 		}
@@ -429,7 +449,7 @@ func (f *DBFS) VersionControl(ctx context.Context, path *fs.URI, versionId int,
 		return nil, fmt.Errorf("failed to get target file: %w", err)
 	}
 
-	if _, ok := ctx.Value(ByPassOwnerCheckCtxKey{}).(bool); !ok && target.Owner().ID != f.user.ID {
+	if _, ok := ctx.Value(ByPassOwnerCheckCtxKey{}).(bool); !ok && !target.OwnedBy(f.user) {
 		return nil, fs.ErrOwnerOnly
 	}
 
@@ -546,7 +566,7 @@ func (f *DBFS) MoveOrCopy(ctx context.Context, path []*fs.URI, dst *fs.URI, isCo
 		return nil, fmt.Errorf("faield to get destination folder: %w", err)
 	}
 
-	if _, ok := ctx.Value(ByPassOwnerCheckCtxKey{}).(bool); !ok && destination.Owner().ID != f.user.ID {
+	if _, ok := ctx.Value(ByPassOwnerCheckCtxKey{}).(bool); !ok && !destination.OwnedBy(f.user) {
 		return nil, fs.ErrOwnerOnly
 	}
 
@@ -561,9 +581,15 @@ func (f *DBFS) MoveOrCopy(ctx context.Context, path []*fs.URI, dst *fs.URI, isCo
 	ctx = context.WithValue(ctx, inventory.LoadFileEntity{}, true)
 	ctx = context.WithValue(ctx, inventory.LoadFileMetadata{}, true)
 
+	// Source files are only locked when moving, copying them only requires read access.
+	srcCapability := NavigatorCapabilityLockFile
+	if isCopy {
+		srcCapability = NavigatorCapabilityDownloadFile
+	}
+
 	for _, p := range path {
 		// Get navigator
-		navigator, err := f.getNavigator(ctx, p, NavigatorCapabilityLockFile)
+		navigator, err := f.getNavigator(ctx, p, srcCapability)
 		if err != nil {
 			ae.Add(p.String(), err)
 			continue
@@ -582,7 +608,9 @@ func (f *DBFS) MoveOrCopy(ctx context.Context, path []*fs.URI, dst *fs.URI, isCo
 			continue
 		}
 
-		if _, ok := ctx.Value(ByPassOwnerCheckCtxKey{}).(bool); !ok && target.Owner().ID != f.user.ID {
+		// Copy only requires read access of source files
+		if _, ok := ctx.Value(ByPassOwnerCheckCtxKey{}).(bool); !ok &&
+			((isCopy && !target.ReadableBy(f.user)) || (!isCopy && !target.OwnedBy(f.user))) {
 			ae.Add(p.String(), fs.ErrOwnerOnly)
 			continue
 		}
@@ -873,6 +901,10 @@ func (f *DBFS) copyFiles(ctx context.Context, targets map[Navigator][]*File, des
 	if err != nil {
 		return nil, nil, nil, fmt.Errorf("copy files: failed to destination owner capacity: %w", err)
 	}
+	ctx = context.WithValue(ctx, inventory.FileCountLimitCtx{}, &inventory.FileCountLimit{
+		UserID: destination.OwnerID(),
+		Max:    capacity.MaxFileCount,
+	})
 
 	dstAncestors := lo.Map(destination.AncestorsChain(), func(item *File, index int) *ent.File {
 		return item.Model
@@ -909,6 +941,10 @@ func (f *DBFS) copyFiles(ctx context.Context, targets map[Navigator][]*File, des
 				return fs.ErrInsufficientCapacity
 			}
 
+			if err := f.validateUserFileCountRaw(ctx, len(targets), capacity); err != nil {
+				return err
+			}
+
 			limit -= len(targets)
 			initialDstMap, diff, err = fc.Copy(ctx, &inventory.CopyParameter{
 				Files: lo.Map(targets, func(item *File, index int) *ent.File {
@@ -921,6 +957,9 @@ func (f *DBFS) copyFiles(ctx context.Context, targets map[Navigator][]*File, des
 				if ent.IsConstraintError(err) {
 					return fs.ErrFileExisted.WithError(err)
 				}
+				if errors.Is(err, inventory.ErrInsufficientFileCount) {
+					return fs.ErrInsufficientFileQuota
+				}
 
 				return serializer.NewError(serializer.CodeDBError, "Failed to copy files", err)
 			}
@@ -946,6 +985,7 @@ func (f *DBFS) copyFiles(ctx context.Context, targets map[Navigator][]*File, des
 			}
 
 			capacity.Used += sizeTotal
+			capacity.FileCount += len(targets)
 			firstLayer = false
 
 			return nil
@@ -61,7 +61,7 @@ func (n *myNavigator) PersistState(kv cache.Driver, key string) {
 	}
 }
 
-func (n *myNavigator) RestoreState(s State) error {
+func (n *myNavigator) RestoreState(ctx context.Context, s State) error {
 	n.disableRecycle = true
 	if state, ok := s.(*File); ok {
 		n.root = state
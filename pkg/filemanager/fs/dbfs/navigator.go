@@ -48,7 +48,7 @@ type (
 		// PersistState tells navigator to persist the state of the navigator before recycle.
 		PersistState(kv cache.Driver, key string)
 		// RestoreState restores the state of the navigator.
-		RestoreState(s State) error
+		RestoreState(ctx context.Context, s State) error
 		// FollowTx let the navigator inherit the transaction. Return a function to reset back to previous DB client.
 		FollowTx(ctx context.Context) (func(), error)
 		// ExecuteHook performs custom operations before or after certain actions.
@@ -57,6 +57,21 @@ type (
 		GetView(ctx context.Context, file *File) *types.ExplorerView
 	}
 
+	// uploadValidator is implemented by navigators with extra restrictions on new uploads.
+	uploadValidator interface {
+		validateUpload(ctx context.Context, req *fs.UploadRequest, fileExisted bool) error
+		// releaseUpload gives back what is taken by validateUpload if the upload is not accepted eventually.
+		releaseUpload(ctx context.Context)
+		// renameOnConflict returns true if upload conflicting with an existing file should be saved
+		// under another name instead of being rejected, so that existence of files is not revealed.
+		renameOnConflict() bool
+	}
+
+	// createValidator is implemented by navigators with extra restrictions on creating new files.
+	createValidator interface {
+		validateCreate(ctx context.Context, path *fs.URI, fileType types.FileType, req *fs.UploadRequest) error
+	}
+
 	State interface{}
 
 	NavigatorCapability int
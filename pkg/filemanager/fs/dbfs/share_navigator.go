@@ -2,10 +2,12 @@ package dbfs
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/cloudreve/Cloudreve/v4/application/constants"
 	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/shareaccess"
 	"github.com/cloudreve/Cloudreve/v4/inventory"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
 	"github.com/cloudreve/Cloudreve/v4/pkg/boolset"
@@ -15,18 +17,49 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
 	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/cloudreve/Cloudreve/v4/pkg/webhook"
 )
 
 var (
-	ErrShareNotFound = serializer.NewError(serializer.CodeNotFound, "Shared file does not exist", nil)
-	ErrNotPurchased  = serializer.NewError(serializer.CodePurchaseRequired, "You need to purchased this share", nil)
+	ErrShareNotFound      = serializer.NewError(serializer.CodeNotFound, "Shared file does not exist", nil)
+	ErrNotPurchased       = serializer.NewError(serializer.CodePurchaseRequired, "You need to purchased this share", nil)
+	ErrShareUploadLimited = serializer.NewError(serializer.CodeFileTooLarge, "Upload limit of the share reached", nil)
 )
 
 const (
 	PurchaseTicketHeader = constants.CrHeaderPrefix + "Purchase-Ticket"
 )
 
-var shareNavigatorCapability = &boolset.BooleanSet{}
+var (
+	shareNavigatorCapability         = &boolset.BooleanSet{}
+	shareWriteNavigatorCapability    = &boolset.BooleanSet{}
+	shareFileDropNavigatorCapability = &boolset.BooleanSet{}
+)
+
+func init() {
+	boolset.Sets(map[NavigatorCapability]bool{
+		NavigatorCapabilityCreateFile:     true,
+		NavigatorCapabilityRenameFile:     true,
+		NavigatorCapabilityUploadFile:     true,
+		NavigatorCapabilityDownloadFile:   true,
+		NavigatorCapabilityUpdateMetadata: true,
+		NavigatorCapabilityListChildren:   true,
+		NavigatorCapabilityGenerateThumb:  true,
+		NavigatorCapabilityDeleteFile:     true,
+		NavigatorCapabilityLockFile:       true,
+		NavigatorCapabilityInfo:           true,
+		NavigatorCapabilityVersionControl: true,
+		NavigatorCapabilityEnterFolder:    true,
+		NavigatorCapabilityModifyProps:    true,
+		NavigatorCapabilitySoftDelete:     true,
+	}, shareWriteNavigatorCapability)
+	// Visitors can only create files in the drop folder through uploads, see validateCreate.
+	boolset.Sets(map[NavigatorCapability]bool{
+		NavigatorCapabilityCreateFile: true,
+		NavigatorCapabilityUploadFile: true,
+		NavigatorCapabilityLockFile:   true,
+	}, shareFileDropNavigatorCapability)
+}
 
 // NewShareNavigator creates a navigator for user's "shared" file system.
 func NewShareNavigator(u *ent.User, fileClient inventory.FileClient, shareClient inventory.ShareClient,
@@ -82,18 +115,38 @@ func (n *shareNavigator) PersistState(kv cache.Driver, key string) {
 	}
 }
 
-func (n *shareNavigator) RestoreState(s State) error {
-	n.disableRecycle = true
-	if state, ok := s.(shareNavigatorState); ok {
-		n.shareRoot = state.ShareRoot
-		n.ownerRoot = state.OwnerRoot
-		n.singleFileShare = state.SingleFileShare
-		n.share = state.Share
-		n.owner = state.Owner
-		return nil
+func (n *shareNavigator) RestoreState(ctx context.Context, s State) error {
+	state, ok := s.(shareNavigatorState)
+	if !ok {
+		return fmt.Errorf("invalid state type: %T", s)
 	}
 
-	return fmt.Errorf("invalid state type: %T", s)
+	// Mode of the share might be changed since state persisted, permissions are derived from the latest share.
+	sc, _ := inventory.InheritTx(ctx, n.shareClient)
+	share, err := sc.GetByID(ctx, state.Share.ID)
+	if err != nil {
+		return fmt.Errorf("failed to reload share: %w", err)
+	}
+
+	if err := inventory.IsValidShare(share); err != nil {
+		return err
+	}
+
+	share.Edges = state.Share.Edges
+	mode := shareMode(share)
+	state.ShareRoot.CapabilitiesBs = shareCapability(mode)
+	state.ShareRoot.Delegation = DelegationNone
+	if mode != types.ShareModeRead {
+		state.ShareRoot.Delegation = DelegationWrite
+	}
+
+	n.disableRecycle = true
+	n.shareRoot = state.ShareRoot
+	n.ownerRoot = state.OwnerRoot
+	n.singleFileShare = state.SingleFileShare
+	n.share = share
+	n.owner = state.Owner
+	return nil
 }
 
 func (n *shareNavigator) Recycle() {
@@ -125,6 +178,7 @@ func (n *shareNavigator) Root(ctx context.Context, path *fs.URI) (*File, error)
 	}
 
 	n.owner = share.Edges.User
+	mode := shareMode(share)
 
 	// Check password
 	if share.Password != "" && share.Password != path.Password() {
@@ -149,14 +203,20 @@ func (n *shareNavigator) Root(ctx context.Context, path *fs.URI) (*File, error)
 	n.shareRoot.OwnerModel = n.owner
 	n.shareRoot.IsUserRoot = true
 	n.shareRoot.disableView = (share.Props == nil || !share.Props.ShareView) && n.user.ID != n.owner.ID
-	n.shareRoot.CapabilitiesBs = n.Capabilities(false).Capability
+	n.shareRoot.CapabilitiesBs = shareCapability(mode)
+	if mode != types.ShareModeRead {
+		// Visitors of writable share act on behalf of the owner.
+		n.shareRoot.Delegation = DelegationWrite
+	}
 
 	// Check if any ancestors is deleted
 	if ownerRoot.Name() != inventory.RootFolderName {
 		return nil, ErrShareNotFound
 	}
 
-	if n.user.ID != n.owner.ID && !n.user.Edges.Group.Permissions.Enabled(int(types.GroupPermissionShareDownload)) {
+	// File drop visitors cannot download anything, download permission is not required.
+	if n.user.ID != n.owner.ID && mode != types.ShareModeFileDrop &&
+		!n.user.Edges.Group.Permissions.Enabled(int(types.GroupPermissionShareDownload)) {
 		if inventory.IsAnonymousUser(n.user) {
 			return nil, serializer.NewError(
 				serializer.CodeAnonymouseAccessDenied,
@@ -261,7 +321,7 @@ func (n *shareNavigator) latestSharedSingleFile(ctx context.Context) (*File, err
 
 func (n *shareNavigator) Capabilities(isSearching bool) *fs.NavigatorProps {
 	res := &fs.NavigatorProps{
-		Capability:            shareNavigatorCapability,
+		Capability:            shareCapability(shareMode(n.share)),
 		OrderDirectionOptions: fullOrderDirectionOption,
 		OrderByOptions:        fullOrderByOption,
 		MaxPageSize:           n.config.MaxPageSize,
@@ -302,11 +362,83 @@ func (n *shareNavigator) FollowTx(ctx context.Context) (func(), error) {
 func (n *shareNavigator) ExecuteHook(ctx context.Context, hookType fs.HookType, file *File) error {
 	switch hookType {
 	case fs.HookTypeBeforeDownload:
+		n.logAccess(ctx, shareaccess.ActionDownload, file)
 		return n.shareClient.Downloaded(ctx, n.share)
+	case fs.HookTypeBeforeArchive:
+		n.logAccess(ctx, shareaccess.ActionArchive, file)
 	}
 	return nil
 }
 
+func (n *shareNavigator) logAccess(ctx context.Context, action shareaccess.Action, file *File) {
+	args := inventory.NewShareAccessArgs(ctx, n.share.ID, n.user, action, file.Uri(false).Path())
+	if err := n.shareClient.LogAccess(ctx, args); err != nil {
+		n.l.Warning("Failed to log access of share %d: %s", n.share.ID, err)
+	}
+
+	webhook.DispatchShareAccessed(ctx, n.owner, args)
+}
+
+// validateUpload applies upload limits of writable shares. A slot of the share's upload count is taken
+// for each accepted upload from visitors.
+func (n *shareNavigator) validateUpload(ctx context.Context, req *fs.UploadRequest, fileExisted bool) error {
+	mode := shareMode(n.share)
+	if !n.countUpload() {
+		return nil
+	}
+
+	// File drop visitors can only upload new files into the drop folder.
+	if mode == types.ShareModeFileDrop && (fileExisted || len(req.Props.Uri.Elements()) != 1) {
+		return fs.ErrNotSupportedAction.WithError(fmt.Errorf("file drop share only accepts new files in the shared folder"))
+	}
+
+	props := n.share.Props
+	if props.MaxUploadSize > 0 && req.Props.Size > props.MaxUploadSize {
+		return ErrShareUploadLimited.WithError(fmt.Errorf("file size %d exceeds share limit %d", req.Props.Size, props.MaxUploadSize))
+	}
+
+	if err := n.shareClient.ReserveUpload(ctx, n.share, props.MaxUploads); err != nil {
+		if errors.Is(err, inventory.ErrShareUploadsFull) {
+			return ErrShareUploadLimited.WithError(fmt.Errorf("share already received %d files", props.MaxUploads))
+		}
+		return serializer.NewError(serializer.CodeDBError, "Failed to update share upload count", err)
+	}
+
+	return nil
+}
+
+func (n *shareNavigator) releaseUpload(ctx context.Context) {
+	if !n.countUpload() {
+		return
+	}
+
+	if err := n.shareClient.ReleaseUpload(ctx, n.share); err != nil {
+		n.l.Warning("Failed to release upload count of share %d: %s", n.share.ID, err)
+	}
+}
+
+// countUpload returns true if uploads through this navigator are counted against the share's upload limit.
+func (n *shareNavigator) countUpload() bool {
+	return shareMode(n.share) != types.ShareModeRead && n.user.ID != n.owner.ID
+}
+
+func (n *shareNavigator) renameOnConflict() bool {
+	return shareMode(n.share) == types.ShareModeFileDrop && n.user.ID != n.owner.ID
+}
+
+// validateCreate limits file drop visitors to create files in the drop folder through uploads.
+func (n *shareNavigator) validateCreate(ctx context.Context, path *fs.URI, fileType types.FileType, req *fs.UploadRequest) error {
+	if shareMode(n.share) != types.ShareModeFileDrop || n.user.ID == n.owner.ID {
+		return nil
+	}
+
+	if req == nil || fileType != types.FileTypeFile || len(path.Elements()) != 1 {
+		return fs.ErrNotSupportedAction.WithError(fmt.Errorf("file drop share only accepts new files in the shared folder"))
+	}
+
+	return nil
+}
+
 func (n *shareNavigator) Walk(ctx context.Context, levelFiles []*File, limit, depth int, f WalkFunc) error {
 	return n.baseNavigator.walk(ctx, levelFiles, limit, depth, f)
 }
@@ -314,3 +446,22 @@ func (n *shareNavigator) Walk(ctx context.Context, levelFiles []*File, limit, de
 func (n *shareNavigator) GetView(ctx context.Context, file *File) *types.ExplorerView {
 	return file.View()
 }
+
+func shareMode(share *ent.Share) types.ShareMode {
+	if share == nil || share.Props == nil {
+		return types.ShareModeRead
+	}
+
+	return share.Props.Mode
+}
+
+func shareCapability(mode types.ShareMode) *boolset.BooleanSet {
+	switch mode {
+	case types.ShareModeWrite:
+		return shareWriteNavigatorCapability
+	case types.ShareModeFileDrop:
+		return shareFileDropNavigatorCapability
+	default:
+		return shareNavigatorCapability
+	}
+}
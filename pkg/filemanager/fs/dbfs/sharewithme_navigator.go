@@ -51,7 +51,7 @@ func (t *sharedWithMeNavigator) Recycle() {
 func (n *sharedWithMeNavigator) PersistState(kv cache.Driver, key string) {
 }
 
-func (n *sharedWithMeNavigator) RestoreState(s State) error {
+func (n *sharedWithMeNavigator) RestoreState(ctx context.Context, s State) error {
 	return nil
 }
 
@@ -0,0 +1,239 @@
+package dbfs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/boolset"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cache"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+)
+
+var (
+	ErrTeamNotFound = serializer.NewError(serializer.CodeNotFound, "Team does not exist", nil)
+
+	teamWriterNavigatorCapability = &boolset.BooleanSet{}
+	teamReaderNavigatorCapability = &boolset.BooleanSet{}
+)
+
+func init() {
+	boolset.Sets(map[NavigatorCapability]bool{
+		NavigatorCapabilityCreateFile:     true,
+		NavigatorCapabilityRenameFile:     true,
+		NavigatorCapabilityUploadFile:     true,
+		NavigatorCapabilityDownloadFile:   true,
+		NavigatorCapabilityUpdateMetadata: true,
+		NavigatorCapabilityListChildren:   true,
+		NavigatorCapabilityGenerateThumb:  true,
+		NavigatorCapabilityDeleteFile:     true,
+		NavigatorCapabilitySoftDelete:     true,
+		NavigatorCapabilityLockFile:       true,
+		NavigatorCapabilityInfo:           true,
+		NavigatorCapabilityVersionControl: true,
+		NavigatorCapabilityEnterFolder:    true,
+		NavigatorCapabilityModifyProps:    true,
+	}, teamWriterNavigatorCapability)
+	boolset.Sets(map[NavigatorCapability]bool{
+		NavigatorCapabilityDownloadFile:  true,
+		NavigatorCapabilityListChildren:  true,
+		NavigatorCapabilityGenerateThumb: true,
+		NavigatorCapabilityInfo:          true,
+		NavigatorCapabilityEnterFolder:   true,
+	}, teamReaderNavigatorCapability)
+}
+
+// NewTeamNavigator creates a navigator for the "team" file system. Files in it are owned by the team account,
+// requester's access is determined by its role in the team.
+func NewTeamNavigator(ctx context.Context, path *fs.URI, u *ent.User, fileClient inventory.FileClient, userClient inventory.UserClient,
+	teamClient inventory.TeamClient, l logging.Logger, config *setting.DBFS, hasher hashid.Encoder) (Navigator, error) {
+	if inventory.IsAnonymousUser(u) {
+		return nil, ErrLoginRequired
+	}
+
+	teamID, err := hasher.Decode(path.ID(""), hashid.TeamID)
+	if err != nil {
+		return nil, ErrTeamNotFound.WithError(err)
+	}
+
+	team, err := teamClient.GetByID(ctx, teamID)
+	if err != nil {
+		return nil, ErrTeamNotFound.WithError(err)
+	}
+
+	role := inventory.TeamRoleOf(team, u)
+	if role == types.TeamRoleNone {
+		return nil, ErrPermissionDenied
+	}
+
+	return &teamNavigator{
+		user:          u,
+		l:             l,
+		fileClient:    fileClient,
+		userClient:    userClient,
+		config:        config,
+		team:          team,
+		role:          role,
+		baseNavigator: newBaseNavigator(fileClient, defaultFilter, u, hasher, config),
+	}, nil
+}
+
+type teamNavigator struct {
+	l          logging.Logger
+	user       *ent.User
+	fileClient inventory.FileClient
+	userClient inventory.UserClient
+	team       *ent.Team
+	role       types.TeamRole
+
+	config *setting.DBFS
+	*baseNavigator
+	root           *File
+	disableRecycle bool
+	persist        func()
+}
+
+func (n *teamNavigator) Recycle() {
+	if n.persist != nil {
+		n.persist()
+		n.persist = nil
+	}
+	if n.root != nil && !n.disableRecycle {
+		n.root.Recycle()
+	}
+}
+
+func (n *teamNavigator) PersistState(kv cache.Driver, key string) {
+	n.disableRecycle = true
+	n.persist = func() {
+		kv.Set(key, n.root, ContextHintTTL)
+	}
+}
+
+func (n *teamNavigator) RestoreState(ctx context.Context, s State) error {
+	n.disableRecycle = true
+	if state, ok := s.(*File); ok {
+		n.root = state
+		// Role might be changed since state persisted.
+		n.root.Delegation = n.delegation()
+		n.root.CapabilitiesBs = n.Capabilities(false).Capability
+		return nil
+	}
+
+	return fmt.Errorf("invalid state type: %T", s)
+}
+
+func (n *teamNavigator) To(ctx context.Context, path *fs.URI) (*File, error) {
+	if n.root == nil {
+		ctx = context.WithValue(ctx, inventory.LoadUserGroup{}, true)
+		teamAccount, err := n.userClient.GetByID(ctx, n.team.OwnerID)
+		if err != nil {
+			return nil, fs.ErrPathNotExist.WithError(fmt.Errorf("team account not found: %w", err))
+		}
+
+		rootFile, err := n.fileClient.Root(ctx, teamAccount)
+		if err != nil {
+			return nil, fs.ErrPathNotExist.WithError(fmt.Errorf("team root folder not found: %w", err))
+		}
+
+		n.root = newFile(nil, rootFile)
+		rootPath := path.Root()
+		n.root.Path[pathIndexRoot], n.root.Path[pathIndexUser] = rootPath, rootPath
+		n.root.OwnerModel = teamAccount
+		n.root.IsUserRoot = true
+		n.root.Delegation = n.delegation()
+		n.root.CapabilitiesBs = n.Capabilities(false).Capability
+	}
+
+	current, lastAncestor := n.root, n.root
+	elements := path.Elements()
+	var err error
+	for index, element := range elements {
+		lastAncestor = current
+		current, err = n.walkNext(ctx, current, element, index == len(elements)-1)
+		if err != nil {
+			return lastAncestor, fmt.Errorf("failed to walk into %q: %w", element, err)
+		}
+	}
+
+	return current, nil
+}
+
+func (n *teamNavigator) Children(ctx context.Context, parent *File, args *ListArgs) (*ListResult, error) {
+	return n.baseNavigator.children(ctx, parent, args)
+}
+
+func (n *teamNavigator) walkNext(ctx context.Context, root *File, next string, isLeaf bool) (*File, error) {
+	return n.baseNavigator.walkNext(ctx, root, next, isLeaf)
+}
+
+func (n *teamNavigator) Capabilities(isSearching bool) *fs.NavigatorProps {
+	res := &fs.NavigatorProps{
+		Capability:            teamReaderNavigatorCapability,
+		OrderDirectionOptions: fullOrderDirectionOption,
+		OrderByOptions:        fullOrderByOption,
+		MaxPageSize:           n.config.MaxPageSize,
+	}
+	if n.role == types.TeamRoleWriter {
+		res.Capability = teamWriterNavigatorCapability
+	}
+	if isSearching {
+		res.OrderByOptions = nil
+		res.OrderDirectionOptions = nil
+	}
+
+	return res
+}
+
+func (n *teamNavigator) Walk(ctx context.Context, levelFiles []*File, limit, depth int, f WalkFunc) error {
+	return n.baseNavigator.walk(ctx, levelFiles, limit, depth, f)
+}
+
+func (n *teamNavigator) FollowTx(ctx context.Context) (func(), error) {
+	if _, ok := ctx.Value(inventory.TxCtx{}).(*inventory.Tx); !ok {
+		return nil, fmt.Errorf("navigator: no inherited transaction found in context")
+	}
+	newFileClient, _, _, err := inventory.WithTx(ctx, n.fileClient)
+	if err != nil {
+		return nil, err
+	}
+
+	newUserClient, _, _, err := inventory.WithTx(ctx, n.userClient)
+	if err != nil {
+		return nil, err
+	}
+
+	oldFileClient, oldUserClient := n.fileClient, n.userClient
+	revert := func() {
+		n.fileClient = oldFileClient
+		n.userClient = oldUserClient
+		n.baseNavigator.fileClient = oldFileClient
+	}
+
+	n.fileClient = newFileClient
+	n.userClient = newUserClient
+	n.baseNavigator.fileClient = newFileClient
+	return revert, nil
+}
+
+func (n *teamNavigator) ExecuteHook(ctx context.Context, hookType fs.HookType, file *File) error {
+	return nil
+}
+
+func (n *teamNavigator) GetView(ctx context.Context, file *File) *types.ExplorerView {
+	return file.View()
+}
+
+func (n *teamNavigator) delegation() Delegation {
+	if n.role == types.TeamRoleWriter {
+		return DelegationWrite
+	}
+
+	return DelegationRead
+}
@@ -65,7 +65,7 @@ func (t *trashNavigator) Recycle() {
 func (n *trashNavigator) PersistState(kv cache.Driver, key string) {
 }
 
-func (n *trashNavigator) RestoreState(s State) error {
+func (n *trashNavigator) RestoreState(ctx context.Context, s State) error {
 	return nil
 }
 
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"math"
 	"path"
+	"strings"
 	"time"
 
 	"github.com/cloudreve/Cloudreve/v4/ent"
@@ -15,8 +16,12 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
 	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/cloudreve/Cloudreve/v4/pkg/webhook"
 )
 
+// renameUploadAttempts is the max number of random names tried for an upload conflicting with existing file.
+const renameUploadAttempts = 5
+
 func (f *DBFS) PreValidateUpload(ctx context.Context, dst *fs.URI, files ...fs.PreValidateFile) error {
 	// Get navigator
 	navigator, err := f.getNavigator(ctx, dst, NavigatorCapabilityUploadFile, NavigatorCapabilityLockFile)
@@ -34,7 +39,7 @@ func (f *DBFS) PreValidateUpload(ctx context.Context, dst *fs.URI, files ...fs.P
 	}
 
 	// check ownership
-	if f.user.ID != dstFile.OwnerID() {
+	if !dstFile.OwnedBy(f.user) {
 		return fmt.Errorf("failed to evaluate permission: %w", err)
 	}
 
@@ -97,6 +102,14 @@ func (f *DBFS) PrepareUpload(ctx context.Context, req *fs.UploadRequest, opts ..
 		fileExisted = true
 	}
 
+	if v, ok := navigator.(uploadValidator); ok && fileExisted && v.renameOnConflict() {
+		ancestor, err = f.renameUpload(ctx, navigator, req)
+		if err != nil {
+			return nil, err
+		}
+		fileExisted = false
+	}
+
 	// If file already exist, and update operation is suspended or existing file is not a file
 	if fileExisted && (req.Props.EntityType == nil || ancestor.Type() != types.FileTypeFile) {
 		return nil, fs.ErrFileExisted
@@ -107,10 +120,26 @@ func (f *DBFS) PrepareUpload(ctx context.Context, req *fs.UploadRequest, opts ..
 		return nil, fs.ErrPathNotExist
 	}
 
-	if _, ok := ctx.Value(ByPassOwnerCheckCtxKey{}).(bool); !ok && ancestor.OwnerID() != f.user.ID {
+	if _, ok := ctx.Value(ByPassOwnerCheckCtxKey{}).(bool); !ok && !ancestor.OwnedBy(f.user) {
 		return nil, fs.ErrOwnerOnly
 	}
 
+	var releaseUpload func()
+	if v, ok := navigator.(uploadValidator); ok {
+		if err := v.validateUpload(ctx, req, fileExisted); err != nil {
+			return nil, err
+		}
+
+		releaseCtx := ctx
+		releaseUpload = func() { v.releaseUpload(releaseCtx) }
+		defer func() {
+			// Upload session is not created.
+			if releaseUpload != nil {
+				releaseUpload()
+			}
+		}()
+	}
+
 	// Lock target
 	lockedPath := ancestor.RootUri().JoinRaw(req.Props.Uri.PathTrimmed())
 	lr := &LockByPath{lockedPath, ancestor, types.FileTypeFile, ""}
@@ -162,6 +191,22 @@ func (f *DBFS) PrepareUpload(ctx context.Context, req *fs.UploadRequest, opts ..
 		return nil, err
 	}
 
+	if !fileExisted {
+		// Missing parent folders will also be created along with the file.
+		if err := f.validateUserFileCountRaw(ctx, len(req.Props.Uri.Elements())-len(ancestor.Uri(false).Elements()), capacity); err != nil {
+			return nil, err
+		}
+	}
+
+	// Look for existing entity with the same content that user can read.
+	var rapidUploadEntity *ent.Entity
+	if req.Props.Checksum != "" && policy.Settings.RapidUpload && !fileExisted && req.ImportFrom == nil && encryptMetadata == nil {
+		rapidUploadEntity, err = f.fileClient.FindOwnedEntityByChecksum(ctx, f.user.ID, policy.ID, req.Props.Size, req.Props.Checksum)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, serializer.NewError(serializer.CodeDBError, "Failed to find entity by checksum", err)
+		}
+	}
+
 	// Generate save path by storage policy
 	isThumbnailAndPolicyNotAvailable := policy.ID != ancestor.Model.StoragePolicyFiles &&
 		(req.Props.EntityType != nil && *req.Props.EntityType == types.EntityTypeThumbnail) &&
@@ -193,6 +238,14 @@ func (f *DBFS) PrepareUpload(ctx context.Context, req *fs.UploadRequest, opts ..
 		return nil, serializer.NewError(serializer.CodeDBError, "Failed to reserve storage capacity", err)
 	}
 
+	if rapidUploadEntity != nil {
+		session, err := f.completeRapidUpload(ctx, fc, dbTx, ancestor, policy, req, rapidUploadEntity)
+		if err == nil {
+			releaseUpload = nil
+		}
+		return session, err
+	}
+
 	if fileExisted {
 		entityType := types.EntityTypeVersion
 		if req.Props.EntityType != nil {
@@ -273,9 +326,76 @@ func (f *DBFS) PrepareUpload(ctx context.Context, req *fs.UploadRequest, opts ..
 	}
 
 	// TODO: frontend should create new upload session if resumed session does not exist.
+	releaseUpload = nil
 	return session, nil
 }
 
+// completeRapidUpload creates the target file linked to an existing entity with the same content
+// in the given transaction, and commits it. Returned session is already completed.
+func (f *DBFS) completeRapidUpload(ctx context.Context, fc inventory.FileClient, dbTx *inventory.Tx, ancestor *File,
+	policy *ent.StoragePolicy, req *fs.UploadRequest, entity *ent.Entity) (*fs.UploadSession, error) {
+	newFile, err := f.Create(ctx, req.Props.Uri, types.FileTypeFile,
+		WithPreferredStoragePolicy(policy),
+		WithErrorOnConflict(),
+		WithAncestor(ancestor),
+	)
+	if err != nil {
+		_ = inventory.Rollback(dbTx)
+		return nil, fmt.Errorf("failed to create file: %w", err)
+	}
+
+	targetFile := newFile.(*File).Model
+	diff, err := fc.LinkEntity(ctx, targetFile, entity)
+	if err != nil {
+		_ = inventory.Rollback(dbTx)
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to link existing entity", err)
+	}
+	dbTx.AppendStorageDiff(diff)
+
+	if err := fc.SetPrimaryEntity(ctx, targetFile, entity); err != nil {
+		_ = inventory.Rollback(dbTx)
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to set primary entity", err)
+	}
+
+	if req.Props.LastModified != nil {
+		if err := fc.UpdateModifiedAt(ctx, targetFile, *req.Props.LastModified); err != nil {
+			_ = inventory.Rollback(dbTx)
+			return nil, serializer.NewError(serializer.CodeDBError, "Failed to update modified time", err)
+		}
+	}
+
+	if len(req.Props.Metadata) > 0 {
+		if err := fc.UpsertMetadata(ctx, targetFile, req.Props.Metadata, nil); err != nil {
+			_ = inventory.Rollback(dbTx)
+			return nil, serializer.NewError(serializer.CodeDBError, "Failed to upsert file metadata", err)
+		}
+	}
+
+	if err := inventory.CommitWithStorageDiff(ctx, dbTx, f.l, f.userClient); err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to commit rapid upload", err)
+	}
+
+	return &fs.UploadSession{
+		Props: &fs.UploadProps{
+			Uri:             req.Props.Uri,
+			Size:            req.Props.Size,
+			SavePath:        entity.Source,
+			LastModified:    req.Props.LastModified,
+			UploadSessionID: req.Props.UploadSessionID,
+			ExpireAt:        req.Props.ExpireAt,
+			EntityType:      req.Props.EntityType,
+			Metadata:        req.Props.Metadata,
+			Checksum:        req.Props.Checksum,
+		},
+		FileID:         targetFile.ID,
+		NewFileCreated: true,
+		EntityID:       entity.ID,
+		UID:            f.user.ID,
+		Policy:         policy,
+		RapidUploaded:  true,
+	}, nil
+}
+
 func (f *DBFS) CompleteUpload(ctx context.Context, session *fs.UploadSession) (fs.File, error) {
 	// Get placeholder file
 	file, err := f.Get(ctx, session.Props.Uri, WithFileEntities(), WithNotRoot())
@@ -373,12 +493,22 @@ func (f *DBFS) CompleteUpload(ctx context.Context, session *fs.UploadSession) (f
 	}
 
 	f.emitFileModified(ctx, filePrivate)
+	webhook.Dispatch(ctx, filePrivate.Owner(), webhook.EventUploadCompleted, &webhook.FileEventData{
+		FileID: hashid.EncodeFileID(f.hasher, filePrivate.Model.ID),
+		Uri:    filePrivate.Uri(false).String(),
+	})
 
 	file, err = f.Get(ctx, session.Props.Uri, WithFileEntities(), WithNotRoot())
 	if err != nil {
 		return nil, fmt.Errorf("failed to get updated file: %w", err)
 	}
 
+	if navigator, err := f.getNavigator(ctx, session.Props.Uri); err == nil {
+		if err := navigator.ExecuteHook(ctx, fs.HookTypeAfterUpload, file.(*File)); err != nil {
+			f.l.Warning("Failed to execute after upload hook for %q: %s", session.Props.Uri, err)
+		}
+	}
+
 	return file, nil
 }
 
@@ -409,7 +539,7 @@ func (f *DBFS) CancelUploadSession(ctx context.Context, path *fs.URI, sessionID
 		}
 	}
 
-	if _, ok := ctx.Value(ByPassOwnerCheckCtxKey{}).(bool); !ok && filePrivate.OwnerID() != f.user.ID {
+	if _, ok := ctx.Value(ByPassOwnerCheckCtxKey{}).(bool); !ok && !filePrivate.OwnedBy(f.user) {
 		return nil, nil, fs.ErrOwnerOnly
 	}
 
@@ -441,6 +571,12 @@ func (f *DBFS) CancelUploadSession(ctx context.Context, path *fs.URI, sessionID
 		return nil, nil, nil
 	}
 
+	if navigator, err := f.getNavigator(ctx, path); err == nil {
+		if v, ok := navigator.(uploadValidator); ok {
+			v.releaseUpload(ctx)
+		}
+	}
+
 	if session != nil && session.LockToken != "" {
 		defer func() {
 			if err := f.ls.Unlock(time.Now(), session.LockToken); err != nil {
@@ -467,3 +603,25 @@ func (f *DBFS) CancelUploadSession(ctx context.Context, path *fs.URI, sessionID
 
 	return nil, nil, nil
 }
+
+// renameUpload saves the upload request conflicting with existing file under a random available name,
+// returns the most recent ancestor of the new path.
+func (f *DBFS) renameUpload(ctx context.Context, navigator Navigator, req *fs.UploadRequest) (*File, error) {
+	name := req.Props.Uri.Name()
+	ext := path.Ext(name)
+	dir := req.Props.Uri.DirUri()
+	for i := 0; i < renameUploadAttempts; i++ {
+		candidate := dir.Join(fmt.Sprintf("%s_%s%s", strings.TrimSuffix(name, ext), util.RandStringRunes(6), ext))
+		ancestor, err := f.getFileByPath(ctx, navigator, candidate)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to get ancestor: %w", err)
+		}
+
+		if !ancestor.Uri(false).IsSame(candidate, hashid.EncodeUserID(f.hasher, f.user.ID)) {
+			req.Props.Uri = candidate
+			return ancestor, nil
+		}
+	}
+
+	return nil, fs.ErrFileExisted
+}
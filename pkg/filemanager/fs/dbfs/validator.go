@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
 	"github.com/cloudreve/Cloudreve/v4/pkg/util"
 )
@@ -109,3 +110,24 @@ func (f *DBFS) validateUserCapacityRaw(ctx context.Context, size int64, capacity
 	}
 	return nil
 }
+
+// withFileCountLimit validates whether the owner of given capacity can own `count` more files, and returns a
+// context in which file count of the owner is updated only if the quota is not exceeded.
+func (f *DBFS) withFileCountLimit(ctx context.Context, count int, owner *ent.User, capacity *fs.Capacity) (context.Context, error) {
+	if err := f.validateUserFileCountRaw(ctx, count, capacity); err != nil {
+		return ctx, err
+	}
+
+	return context.WithValue(ctx, inventory.FileCountLimitCtx{}, &inventory.FileCountLimit{
+		UserID: owner.ID,
+		Max:    capacity.MaxFileCount,
+	}), nil
+}
+
+// validateUserFileCountRaw validates whether user can own `count` more files, but does not fetch the capacity.
+func (f *DBFS) validateUserFileCountRaw(ctx context.Context, count int, capacity *fs.Capacity) error {
+	if capacity.MaxFileCount > 0 && capacity.FileCount+count > capacity.MaxFileCount {
+		return fs.ErrInsufficientFileQuota
+	}
+	return nil
+}
@@ -26,22 +26,23 @@ const (
 )
 
 var (
-	ErrDirectLinkInvalid    = serializer.NewError(serializer.CodeNotFound, "Direct link invalid", nil)
-	ErrUnknownPolicyType    = serializer.NewError(serializer.CodeInternalSetting, "Unknown policy type", nil)
-	ErrPathNotExist         = serializer.NewError(serializer.CodeParentNotExist, "Path not exist", nil)
-	ErrFileDeleted          = serializer.NewError(serializer.CodeFileDeleted, "File deleted", nil)
-	ErrEntityNotExist       = serializer.NewError(serializer.CodeEntityNotExist, "Entity not exist", nil)
-	ErrFileExisted          = serializer.NewError(serializer.CodeObjectExist, "Object existed", nil)
-	ErrNotSupportedAction   = serializer.NewError(serializer.CodeNoPermissionErr, "Not supported action", nil)
-	ErrLockConflict         = serializer.NewError(serializer.CodeLockConflict, "Lock conflict", nil)
-	ErrLockExpired          = serializer.NewError(serializer.CodeLockConflict, "Lock expired", nil)
-	ErrModified             = serializer.NewError(serializer.CodeConflict, "Object conflict", nil)
-	ErrIllegalObjectName    = serializer.NewError(serializer.CodeIllegalObjectName, "Invalid object name", nil)
-	ErrFileSizeTooBig       = serializer.NewError(serializer.CodeFileTooLarge, "File is too large", nil)
-	ErrInsufficientCapacity = serializer.NewError(serializer.CodeInsufficientCapacity, "Insufficient capacity", nil)
-	ErrStaleVersion         = serializer.NewError(serializer.CodeStaleVersion, "File is updated during your edit", nil)
-	ErrOwnerOnly            = serializer.NewError(serializer.CodeOwnerOnly, "Only owner or administrator can perform this action", nil)
-	ErrArchiveSrcSizeTooBig = ErrFileSizeTooBig.WithError(fmt.Errorf("total size of to-be compressed file exceed group limit (%w)", queue.CriticalErr))
+	ErrDirectLinkInvalid     = serializer.NewError(serializer.CodeNotFound, "Direct link invalid", nil)
+	ErrUnknownPolicyType     = serializer.NewError(serializer.CodeInternalSetting, "Unknown policy type", nil)
+	ErrPathNotExist          = serializer.NewError(serializer.CodeParentNotExist, "Path not exist", nil)
+	ErrFileDeleted           = serializer.NewError(serializer.CodeFileDeleted, "File deleted", nil)
+	ErrEntityNotExist        = serializer.NewError(serializer.CodeEntityNotExist, "Entity not exist", nil)
+	ErrFileExisted           = serializer.NewError(serializer.CodeObjectExist, "Object existed", nil)
+	ErrNotSupportedAction    = serializer.NewError(serializer.CodeNoPermissionErr, "Not supported action", nil)
+	ErrLockConflict          = serializer.NewError(serializer.CodeLockConflict, "Lock conflict", nil)
+	ErrLockExpired           = serializer.NewError(serializer.CodeLockConflict, "Lock expired", nil)
+	ErrModified              = serializer.NewError(serializer.CodeConflict, "Object conflict", nil)
+	ErrIllegalObjectName     = serializer.NewError(serializer.CodeIllegalObjectName, "Invalid object name", nil)
+	ErrFileSizeTooBig        = serializer.NewError(serializer.CodeFileTooLarge, "File is too large", nil)
+	ErrInsufficientCapacity  = serializer.NewError(serializer.CodeInsufficientCapacity, "Insufficient capacity", nil)
+	ErrInsufficientFileQuota = serializer.NewError(serializer.CodeInsufficientFileQuota, "File count quota exceeded", nil)
+	ErrStaleVersion          = serializer.NewError(serializer.CodeStaleVersion, "File is updated during your edit", nil)
+	ErrOwnerOnly             = serializer.NewError(serializer.CodeOwnerOnly, "Only owner or administrator can perform this action", nil)
+	ErrArchiveSrcSizeTooBig  = ErrFileSizeTooBig.WithError(fmt.Errorf("total size of to-be compressed file exceed group limit (%w)", queue.CriticalErr))
 )
 
 type (
@@ -186,6 +187,8 @@ type (
 		Model() *ent.Entity
 		Props() *types.EntityProps
 		Encrypted() bool
+		// Checksum returns the hex encoded SHA-256 digest of the entity content, empty if not calculated yet.
+		Checksum() string
 	}
 
 	FileExtendedInfo struct {
@@ -257,6 +260,7 @@ type (
 		MimeType        string                 `json:"mime_type,omitempty"`     // Expected mimetype
 		UploadPolicy    string                 `json:"upload_policy,omitempty"` // Upyun upload policy
 		EncryptMetadata *types.EncryptMetadata `json:"encrypt_metadata,omitempty"`
+		Completed       bool                   `json:"completed,omitempty"` // Content already exists, no need to upload
 	}
 
 	// UploadSession stores the information of an upload session, used in server side.
@@ -274,6 +278,7 @@ type (
 		SentinelTaskID  int
 		NewFileCreated  bool // If new file is created for this session
 		Importing       bool // If the upload is importing from another file
+		RapidUploaded   bool // If the file is linked to an existing entity with the same content
 		EncryptMetadata *types.EncryptMetadata
 
 		LockToken string // Token of the locked placeholder file
@@ -301,6 +306,8 @@ type (
 		ExpireAt            time.Time
 		EncryptionSupported []types.Cipher
 		ClientSideEncrypted bool // Whether the file stream is already encrypted by client side.
+		// Checksum is the hex encoded SHA-256 digest of the content declared by client, used for rapid upload.
+		Checksum string
 	}
 
 	// FsOption options for underlying file system.
@@ -347,6 +354,10 @@ type (
 	Capacity struct {
 		Total int64 `json:"total"`
 		Used  int64 `json:"used"`
+		// FileCount number of files and folders owned by user.
+		FileCount int `json:"file_count"`
+		// MaxFileCount max allowed FileCount, 0 indicates no limit.
+		MaxFileCount int `json:"max_file_count,omitempty"`
 	}
 
 	FileCapacity int
@@ -422,6 +433,8 @@ const (
 
 const (
 	HookTypeBeforeDownload = HookType(iota)
+	HookTypeAfterUpload
+	HookTypeBeforeArchive
 )
 
 func (p *UploadProps) Copy() *UploadProps {
@@ -801,6 +814,10 @@ func (e *DbEntity) Encrypted() bool {
 	return e.model.Props != nil && e.model.Props.EncryptMetadata != nil
 }
 
+func (e *DbEntity) Checksum() string {
+	return e.model.Checksum
+}
+
 func NewEmptyEntity(u *ent.User) Entity {
 	return &DbEntity{
 		model: &ent.Entity{
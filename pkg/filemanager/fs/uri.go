@@ -368,6 +368,10 @@ func NewMyUri(id string) string {
 	return fmt.Sprintf("%s://%s@%s", constants.CloudreveScheme, id, constants.FileSystemMy)
 }
 
+func NewTeamUri(id string) string {
+	return fmt.Sprintf("%s://%s@%s", constants.CloudreveScheme, id, constants.FileSystemTeam)
+}
+
 // PathEscape is same as url.PathEscape, with modifications to incoporate with JS encodeURIComponent:
 // encodeURI() escapes all characters except:
 //
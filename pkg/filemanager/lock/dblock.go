@@ -0,0 +1,374 @@
+package lock
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/fslock"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+	"github.com/cloudreve/Cloudreve/v4/ent/schema"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/gofrs/uuid"
+	"github.com/samber/lo"
+)
+
+const (
+	// maxHoldDuration bounds how long a lock confirmed by an operation stays held, so that
+	// locks held by a crashed replica are released eventually.
+	maxHoldDuration = time.Hour
+	// expiredCollectInterval is the minimal interval between two cleanups of expired locks.
+	expiredCollectInterval = time.Minute
+)
+
+type dbLS struct {
+	l      logging.Logger
+	hasher hashid.Encoder
+	client *ent.Client
+
+	// mu serializes lock creation within this process, creation from other replicas
+	// is handled by the conflict check after insert.
+	mu            sync.Mutex
+	lastCollected time.Time
+}
+
+// NewDBLS returns a LockSystem persisted in database, locks are shared by all replicas
+// using the same database and survive restart.
+func NewDBLS(client *ent.Client, hasher hashid.Encoder, l logging.Logger) LockSystem {
+	return &dbLS{
+		client: client,
+		hasher: hasher,
+		l:      l,
+	}
+}
+
+func (m *dbLS) Confirm(now time.Time, request LockInfo) (func(), string, error) {
+	ctx := context.Background()
+	m.l.Debug("DBlock confirm: NS:%s, Root: %s, Token: %v", request.Ns, request.Root, request.Token)
+	if len(request.Token) == 0 {
+		return nil, "", ErrConfirmationFailed
+	}
+
+	locks, err := m.client.FsLock.Query().
+		Where(fslock.TokenIn(request.Token...), fslock.Ns(request.Ns), alive(now)).
+		All(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to query locks: %w", err)
+	}
+
+	byToken := lo.SliceToMap(locks, func(item *ent.FsLock) (string, *ent.FsLock) {
+		return item.Token, item
+	})
+	for _, token := range request.Token {
+		n, ok := byToken[token]
+		if !ok || !covers(n, request.Root) {
+			continue
+		}
+
+		// Hold the lock only if no one else is holding it.
+		affected, err := m.client.FsLock.Update().
+			Where(fslock.ID(n.ID), notHeld(now)).
+			SetHeldUntil(now.Add(maxHoldDuration)).
+			Save(ctx)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to hold lock: %w", err)
+		}
+		if affected == 0 {
+			continue
+		}
+
+		return func() {
+			if err := m.client.FsLock.UpdateOneID(n.ID).ClearHeldUntil().Exec(context.Background()); err != nil && !ent.IsNotFound(err) {
+				m.l.Warning("Failed to release lock %q: %s", n.Token, err)
+			}
+		}, n.Token, nil
+	}
+
+	return nil, "", ErrConfirmationFailed
+}
+
+func (m *dbLS) Refresh(now time.Time, duration time.Duration, token string) (LockDetails, error) {
+	ctx := context.Background()
+	m.l.Debug("DBlock refresh: Token: %s, Duration: %v", token, duration)
+	n, err := m.client.FsLock.Query().Where(fslock.Token(token), alive(now)).First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return LockDetails{}, ErrNoSuchLock
+		}
+		return LockDetails{}, fmt.Errorf("failed to query lock: %w", err)
+	}
+
+	if isHeld(n, now) {
+		return LockDetails{}, ErrLocked
+	}
+
+	stm := m.client.FsLock.UpdateOneID(n.ID).SetDuration(int64(duration))
+	if duration >= 0 {
+		stm.SetExpireAt(now.Add(duration))
+	} else {
+		stm.ClearExpireAt()
+	}
+
+	n, err = stm.Save(ctx)
+	if err != nil {
+		return LockDetails{}, fmt.Errorf("failed to refresh lock: %w", err)
+	}
+
+	return toLockDetails(n), nil
+}
+
+func (m *dbLS) Create(now time.Time, details ...LockDetails) ([]string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	ctx := context.Background()
+	m.collectExpired(ctx, now)
+
+	created := make([]*ent.FsLock, 0, len(details))
+	rollback := func() {
+		if len(created) == 0 {
+			return
+		}
+
+		ids := lo.Map(created, func(item *ent.FsLock, index int) int { return item.ID })
+		if _, err := m.client.FsLock.Delete().Where(fslock.IDIn(ids...)).Exec(schema.SkipSoftDelete(ctx)); err != nil {
+			m.l.Warning("Failed to delete locks %v: %s", ids, err)
+		}
+	}
+
+	for i, detail := range details {
+		detail.Root = util.SlashClean(detail.Root)
+		m.l.Debug("DBlock create: NS:%s, Root: %s, Duration: %v, ZeroDepth: %v", detail.Ns, detail.Root, detail.Duration, detail.ZeroDepth)
+
+		conflicts, err := m.conflicts(ctx, now, i, detail.Ns, detail.Root, detail.ZeroDepth, 0)
+		if err != nil {
+			rollback()
+			return nil, err
+		}
+		if len(conflicts) > 0 {
+			rollback()
+			return nil, ConflictError(conflicts)
+		}
+
+		n, err := m.insert(ctx, now, detail)
+		if err != nil {
+			rollback()
+			return nil, err
+		}
+		created = append(created, n)
+	}
+
+	// Other replicas might create conflicting locks concurrently, check again after our locks are
+	// visible to them. In the worst case all parties back off, but they never win together.
+	for i, n := range created {
+		conflicts, err := m.conflicts(ctx, now, i, n.Ns, n.Root, n.ZeroDepth, n.ID)
+		if err != nil {
+			rollback()
+			return nil, err
+		}
+
+		// Conflicts with locks created in the same batch have been checked before.
+		conflicts = lo.Filter(conflicts, func(item *ConflictDetail, index int) bool {
+			return !lo.ContainsBy(created, func(c *ent.FsLock) bool { return c.Token == item.Token })
+		})
+		if len(conflicts) > 0 {
+			rollback()
+			return nil, ConflictError(conflicts)
+		}
+	}
+
+	return lo.Map(created, func(item *ent.FsLock, index int) string {
+		return item.Token
+	}), nil
+}
+
+func (m *dbLS) Unlock(now time.Time, tokens ...string) error {
+	ctx := context.Background()
+	if len(tokens) == 0 {
+		return nil
+	}
+
+	locks, err := m.client.FsLock.Query().Where(fslock.TokenIn(tokens...), alive(now)).All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query locks: %w", err)
+	}
+
+	byToken := lo.SliceToMap(locks, func(item *ent.FsLock) (string, *ent.FsLock) {
+		return item.Token, item
+	})
+	conflicts := make([]*ConflictDetail, 0)
+	for i, token := range tokens {
+		n, ok := byToken[token]
+		if !ok {
+			return ErrNoSuchLock
+		}
+		if isHeld(n, now) {
+			conflicts = append(conflicts, m.toConflictDetail(n, i))
+		}
+	}
+
+	if len(conflicts) > 0 {
+		return ConflictError(conflicts)
+	}
+
+	if _, err := m.client.FsLock.Delete().
+		Where(fslock.TokenIn(tokens...), notHeld(now)).
+		Exec(schema.SkipSoftDelete(ctx)); err != nil {
+		return fmt.Errorf("failed to delete locks: %w", err)
+	}
+
+	return nil
+}
+
+// conflicts finds alive locks preventing a new lock on `root`, lock with ID `self` is excluded.
+func (m *dbLS) conflicts(ctx context.Context, now time.Time, index int, ns, root string, zeroDepth bool, self int) ([]*ConflictDetail, error) {
+	// Ancestors locked with infinite depth, or the target itself is locked.
+	ancestors := make([]string, 0)
+	walkToRoot(root, func(name0 string, first bool) bool {
+		if !first {
+			ancestors = append(ancestors, name0)
+		}
+		return true
+	})
+
+	conditions := []predicate.FsLock{
+		fslock.Root(root),
+	}
+	if len(ancestors) > 0 {
+		conditions = append(conditions, fslock.And(fslock.RootIn(ancestors...), fslock.ZeroDepth(false)))
+	}
+	if !zeroDepth {
+		// The requested lock depth is infinite, descendants must not be locked.
+		if root == "/" {
+			conditions = append(conditions, fslock.RootHasPrefix("/"))
+		} else {
+			conditions = append(conditions, fslock.RootHasPrefix(root+"/"))
+		}
+	}
+
+	query := m.client.FsLock.Query().Where(fslock.Ns(ns), alive(now), fslock.Or(conditions...))
+	if self > 0 {
+		query.Where(fslock.IDNEQ(self))
+	}
+
+	locks, err := query.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query locks: %w", err)
+	}
+
+	return lo.Map(locks, func(item *ent.FsLock, _ int) *ConflictDetail {
+		return m.toConflictDetail(item, index)
+	}), nil
+}
+
+func (m *dbLS) insert(ctx context.Context, now time.Time, detail LockDetails) (*ent.FsLock, error) {
+	token := detail.Token
+	if token == "" {
+		token = uuid.Must(uuid.NewV4()).String()
+	}
+
+	owner, err := json.Marshal(detail.Owner)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal lock owner: %w", err)
+	}
+
+	stm := m.client.FsLock.Create().
+		SetToken(token).
+		SetNs(detail.Ns).
+		SetRoot(detail.Root).
+		SetZeroDepth(detail.ZeroDepth).
+		SetFileType(int(detail.Type)).
+		SetOwner(string(owner)).
+		SetDuration(int64(detail.Duration))
+	if detail.Duration >= 0 {
+		stm.SetExpireAt(now.Add(detail.Duration))
+	}
+
+	n, err := stm.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, fmt.Errorf("lock token %q already exists: %w", token, ErrLocked)
+		}
+		return nil, fmt.Errorf("failed to create lock: %w", err)
+	}
+
+	return n, nil
+}
+
+// collectExpired deletes expired locks that are not held, at most once per expiredCollectInterval.
+func (m *dbLS) collectExpired(ctx context.Context, now time.Time) {
+	if now.Sub(m.lastCollected) < expiredCollectInterval {
+		return
+	}
+
+	m.lastCollected = now
+	if _, err := m.client.FsLock.Delete().
+		Where(fslock.ExpireAtLT(now), notHeld(now)).
+		Exec(schema.SkipSoftDelete(ctx)); err != nil {
+		m.l.Warning("Failed to delete expired locks: %s", err)
+	}
+}
+
+func (m *dbLS) toConflictDetail(n *ent.FsLock, index int) *ConflictDetail {
+	details := toLockDetails(n)
+	return &ConflictDetail{
+		Path:  n.Root,
+		Owner: details.Owner,
+		Token: n.Token,
+		Index: index,
+		Type:  details.Type,
+	}
+}
+
+func toLockDetails(n *ent.FsLock) LockDetails {
+	res := LockDetails{
+		Root:      n.Root,
+		Ns:        n.Ns,
+		Duration:  time.Duration(n.Duration),
+		ZeroDepth: n.ZeroDepth,
+		Type:      types.FileType(n.FileType),
+		Token:     n.Token,
+	}
+
+	if n.Owner != "" {
+		// Owner is only used for display, ignore malformed value.
+		_ = json.Unmarshal([]byte(n.Owner), &res.Owner)
+	}
+
+	return res
+}
+
+// covers reports whether lock n covers resource `name`, like memLS.lookup.
+func covers(n *ent.FsLock, name string) bool {
+	if name == n.Root {
+		return true
+	}
+	if n.ZeroDepth {
+		return false
+	}
+
+	return n.Root == "/" || strings.HasPrefix(name, n.Root+"/")
+}
+
+func isHeld(n *ent.FsLock, now time.Time) bool {
+	return n.HeldUntil != nil && n.HeldUntil.After(now)
+}
+
+// alive matches locks that are not expired, or still held by an operation.
+func alive(now time.Time) predicate.FsLock {
+	return fslock.Or(
+		fslock.ExpireAtIsNil(),
+		fslock.ExpireAtGT(now),
+		fslock.HeldUntilGT(now),
+	)
+}
+
+func notHeld(now time.Time) predicate.FsLock {
+	return fslock.Or(fslock.HeldUntilIsNil(), fslock.HeldUntilLTE(now))
+}
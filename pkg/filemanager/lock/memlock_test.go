@@ -1 +1,236 @@
 package lock
+
+import (
+	"context"
+	stdsql "database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	_ "github.com/cloudreve/Cloudreve/v4/ent/runtime"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	_ "modernc.org/sqlite"
+)
+
+// lockSystems returns constructors of all LockSystem implementations, locks systems created by
+// the same constructor share their storage, like replicas sharing one database.
+func lockSystems(t *testing.T) map[string]func() LockSystem {
+	l := logging.NewConsoleLogger(logging.LevelError)
+	hasher, err := hashid.New("salt")
+	require.NoError(t, err)
+
+	mem := NewMemLS(hasher, l)
+	client := newTestDBClient(t)
+	return map[string]func() LockSystem{
+		"mem": func() LockSystem { return mem },
+		"db":  func() LockSystem { return NewDBLS(client, hasher, l) },
+	}
+}
+
+// newTestDBClient returns an ent client backed by a migrated in-memory SQLite database.
+func newTestDBClient(t *testing.T) *ent.Client {
+	db, err := stdsql.Open("sqlite", fmt.Sprintf("file:%s?mode=memory&_pragma=foreign_keys(1)", t.Name()))
+	require.NoError(t, err)
+	// In-memory database lives within a single connection.
+	db.SetMaxOpenConns(1)
+
+	client := ent.NewClient(ent.Driver(sql.OpenDB(dialect.SQLite, db)))
+	require.NoError(t, client.Schema.Create(context.Background()))
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+	return client
+}
+
+func runLockSystems(t *testing.T, f func(t *testing.T, newLS func() LockSystem)) {
+	for name, newLS := range lockSystems(t) {
+		t.Run(name, func(t *testing.T) {
+			f(t, newLS)
+		})
+	}
+}
+
+func detail(root string, zeroDepth bool) LockDetails {
+	return LockDetails{
+		Root:      root,
+		Ns:        "my/1",
+		Duration:  time.Minute,
+		ZeroDepth: zeroDepth,
+		Type:      types.FileTypeFile,
+		Owner:     Owner{Application: Application{Type: "test"}},
+	}
+}
+
+func TestLockSystem_CreateConflicts(t *testing.T) {
+	runLockSystems(t, func(t *testing.T, newLS func() LockSystem) {
+		a := assert.New(t)
+		ls := newLS()
+		now := time.Now()
+
+		tokens, err := ls.Create(now, detail("/a/b", false))
+		require.NoError(t, err)
+		require.Len(t, tokens, 1)
+
+		for _, d := range []LockDetails{
+			detail("/a/b", true),
+			detail("/a/b/c", true),
+			detail("/a", false),
+			detail("/", false),
+		} {
+			_, err := ls.Create(now, d)
+			var conflicts ConflictError
+			if a.ErrorAs(err, &conflicts, d.Root) {
+				a.Equal(tokens[0], conflicts[0].Token, d.Root)
+				a.Equal("/a/b", conflicts[0].Path, d.Root)
+				a.Equal("test", conflicts[0].Owner.Application.Type, d.Root)
+			}
+		}
+
+		// Siblings, zero depth ancestors and other namespaces are not affected.
+		_, err = ls.Create(now, detail("/a/c", false), detail("/a", true))
+		a.NoError(err)
+		other := detail("/a/b", false)
+		other.Ns = "my/2"
+		_, err = ls.Create(now, other)
+		a.NoError(err)
+	})
+}
+
+func TestLockSystem_CreateBatchRollback(t *testing.T) {
+	runLockSystems(t, func(t *testing.T, newLS func() LockSystem) {
+		a := assert.New(t)
+		ls := newLS()
+		now := time.Now()
+
+		_, err := ls.Create(now, detail("/x", false))
+		require.NoError(t, err)
+
+		_, err = ls.Create(now, detail("/y", false), detail("/x/z", false))
+		var conflicts ConflictError
+		require.ErrorAs(t, err, &conflicts)
+		a.Equal(1, conflicts[0].Index)
+
+		// Locks created before the conflict are released.
+		_, err = ls.Create(now, detail("/y", false))
+		a.NoError(err)
+	})
+}
+
+func TestLockSystem_ZeroDepth(t *testing.T) {
+	runLockSystems(t, func(t *testing.T, newLS func() LockSystem) {
+		a := assert.New(t)
+		ls := newLS()
+		now := time.Now()
+
+		_, err := ls.Create(now, detail("/dir", true))
+		require.NoError(t, err)
+		_, err = ls.Create(now, detail("/dir/file", false))
+		a.NoError(err, "zero depth lock does not cover children")
+	})
+}
+
+func TestLockSystem_ConfirmAndUnlock(t *testing.T) {
+	runLockSystems(t, func(t *testing.T, newLS func() LockSystem) {
+		a := assert.New(t)
+		ls := newLS()
+		now := time.Now()
+
+		tokens, err := ls.Create(now, detail("/a", false))
+		require.NoError(t, err)
+
+		_, _, err = ls.Confirm(now, LockInfo{Ns: "my/1", Root: "/a/b"})
+		a.ErrorIs(err, ErrConfirmationFailed, "no token")
+		_, _, err = ls.Confirm(now, LockInfo{Ns: "my/2", Root: "/a/b", Token: tokens})
+		a.ErrorIs(err, ErrConfirmationFailed, "other namespace")
+
+		release, token, err := ls.Confirm(now, LockInfo{Ns: "my/1", Root: "/a/b", Token: tokens})
+		require.NoError(t, err)
+		a.Equal(tokens[0], token)
+
+		_, _, err = ls.Confirm(now, LockInfo{Ns: "my/1", Root: "/a", Token: tokens})
+		a.ErrorIs(err, ErrConfirmationFailed, "lock is held")
+		_, err = ls.Refresh(now, time.Minute, tokens[0])
+		a.ErrorIs(err, ErrLocked)
+		var conflicts ConflictError
+		a.ErrorAs(ls.Unlock(now, tokens...), &conflicts)
+
+		release()
+		a.NoError(ls.Unlock(now, tokens...))
+		a.ErrorIs(ls.Unlock(now, tokens...), ErrNoSuchLock)
+		_, err = ls.Create(now, detail("/a", false))
+		a.NoError(err)
+	})
+}
+
+func TestLockSystem_Expiry(t *testing.T) {
+	runLockSystems(t, func(t *testing.T, newLS func() LockSystem) {
+		a := assert.New(t)
+		ls := newLS()
+		now := time.Now()
+
+		tokens, err := ls.Create(now, detail("/a", false))
+		require.NoError(t, err)
+
+		details, err := ls.Refresh(now.Add(30*time.Second), 2*time.Minute, tokens[0])
+		require.NoError(t, err)
+		a.Equal(2*time.Minute, details.Duration)
+
+		_, err = ls.Create(now.Add(2*time.Minute), detail("/a", false))
+		a.Error(err, "lock is refreshed")
+
+		later := now.Add(3 * time.Minute)
+		_, err = ls.Refresh(later, time.Minute, tokens[0])
+		a.ErrorIs(err, ErrNoSuchLock)
+		_, _, err = ls.Confirm(later, LockInfo{Ns: "my/1", Root: "/a", Token: tokens})
+		a.ErrorIs(err, ErrConfirmationFailed)
+		_, err = ls.Create(later, detail("/a", false))
+		a.NoError(err, "expired lock is released")
+	})
+}
+
+func TestLockSystem_InfiniteDuration(t *testing.T) {
+	runLockSystems(t, func(t *testing.T, newLS func() LockSystem) {
+		a := assert.New(t)
+		ls := newLS()
+		now := time.Now()
+
+		d := detail("/a", false)
+		d.Duration = -1
+		d.Token = "custom-token"
+		tokens, err := ls.Create(now, d)
+		require.NoError(t, err)
+		a.Equal([]string{"custom-token"}, tokens)
+
+		_, err = ls.Create(now.Add(24*time.Hour), detail("/a", false))
+		a.Error(err)
+	})
+}
+
+func TestDBLS_SharedByReplicas(t *testing.T) {
+	a := assert.New(t)
+	newLS := lockSystems(t)["db"]
+	replica1, replica2 := newLS(), newLS()
+	now := time.Now()
+
+	tokens, err := replica1.Create(now, detail("/a", false))
+	require.NoError(t, err)
+	_, err = replica2.Create(now, detail("/a/b", false))
+	a.Error(err)
+
+	release, _, err := replica2.Confirm(now, LockInfo{Ns: "my/1", Root: "/a", Token: tokens})
+	require.NoError(t, err)
+	_, _, err = replica1.Confirm(now, LockInfo{Ns: "my/1", Root: "/a", Token: tokens})
+	a.ErrorIs(err, ErrConfirmationFailed, "lock is held by another replica")
+	release()
+
+	a.NoError(replica2.Unlock(now, tokens...))
+	_, err = replica1.Create(now, detail("/a/b", false))
+	a.NoError(err)
+}
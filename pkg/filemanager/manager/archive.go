@@ -169,6 +169,14 @@ func (m *manager) CreateArchive(ctx context.Context, uris []*fs.URI, writer io.W
 		files = append(files, file)
 	}
 
+	if o.DryRun == nil {
+		for _, file := range files {
+			if err := m.fs.ExecuteNavigatorHooks(ctx, fs.HookTypeBeforeArchive, file); err != nil {
+				m.l.Warning("Failed to execute navigator hooks: %s", err)
+			}
+		}
+	}
+
 	zipWriter := zip.NewWriter(writer)
 	defer zipWriter.Close()
 
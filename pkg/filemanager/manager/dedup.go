@@ -0,0 +1,237 @@
+package manager
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/task"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
+)
+
+type (
+	// EntityChecksumTask calculates content checksum of a newly uploaded entity, and merges it
+	// into an existing entity with identical content if deduplication is enabled in its storage policy.
+	EntityChecksumTask struct {
+		*queue.DBTask
+	}
+
+	EntityChecksumTaskState struct {
+		EntityID int `json:"entity_id"`
+	}
+)
+
+func init() {
+	queue.RegisterResumableTaskFactory(queue.EntityChecksumTaskType, NewEntityChecksumTaskFromModel)
+}
+
+// NewEntityChecksumTask creates a new EntityChecksumTask for given entity.
+func NewEntityChecksumTask(ctx context.Context, entityID int, creator *ent.User) (*EntityChecksumTask, error) {
+	state := &EntityChecksumTaskState{
+		EntityID: entityID,
+	}
+	stateBytes, err := json.Marshal(state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal state: %w", err)
+	}
+
+	return &EntityChecksumTask{
+		DBTask: &queue.DBTask{
+			DirectOwner: creator,
+			Task: &ent.Task{
+				Type:          queue.EntityChecksumTaskType,
+				CorrelationID: logging.CorrelationID(ctx),
+				PrivateState:  string(stateBytes),
+				PublicState:   &types.TaskPublicState{},
+			},
+		},
+	}, nil
+}
+
+func NewEntityChecksumTaskFromModel(task *ent.Task) queue.Task {
+	return &EntityChecksumTask{
+		DBTask: &queue.DBTask{
+			Task: task,
+		},
+	}
+}
+
+func (m *EntityChecksumTask) Do(ctx context.Context) (task.Status, error) {
+	dep := dependency.FromContext(ctx)
+	fm := NewFileManager(dep, inventory.UserFromContext(ctx)).(*manager)
+
+	// unmarshal state
+	var state EntityChecksumTaskState
+	if err := json.Unmarshal([]byte(m.State()), &state); err != nil {
+		return task.StatusError, fmt.Errorf("failed to unmarshal state: %s (%w)", err, queue.CriticalErr)
+	}
+
+	if err := fm.DeduplicateEntity(ctx, state.EntityID); err != nil {
+		return task.StatusError, err
+	}
+
+	return task.StatusCompleted, nil
+}
+
+// DeduplicateEntity calculates checksum of given entity if not yet calculated, then merge it into
+// the oldest entity with the same content in the same storage policy if deduplication is enabled.
+// The merged entity will be recycled afterward. Checksum is recorded regardless of deduplication.
+func (m *manager) DeduplicateEntity(ctx context.Context, entityID int) error {
+	entity, err := m.fs.GetEntity(ctx, entityID)
+	if err != nil {
+		if errors.Is(err, fs.ErrEntityNotExist) {
+			m.l.Debug("Entity %d not found, skip deduplication.", entityID)
+			return nil
+		}
+		return fmt.Errorf("failed to get entity: %w", err)
+	}
+
+	if !entityChecksummable(entity) {
+		m.l.Debug("Entity %d is not eligible for checksum, skip.", entityID)
+		return nil
+	}
+
+	checksum := entity.Checksum()
+	if checksum == "" {
+		checksum, err = m.calculateEntityChecksum(ctx, entity)
+		if err != nil {
+			return err
+		}
+
+		if err := m.dep.FileClient().SetEntityChecksum(ctx, entity.ID(), checksum); err != nil {
+			return err
+		}
+
+		m.l.Debug("Checksum of entity %d calculated: %s", entity.ID(), checksum)
+	}
+
+	policy, err := m.policyClient.GetPolicyByID(ctx, entity.PolicyID())
+	if err != nil {
+		return fmt.Errorf("failed to get storage policy: %w", err)
+	}
+
+	if !policy.Settings.Deduplication || !entityDeduplicatable(entity) {
+		return nil
+	}
+
+	return m.mergeDuplicatedEntity(ctx, entity.Model(), checksum)
+}
+
+func (m *manager) mergeDuplicatedEntity(ctx context.Context, src *ent.Entity, checksum string) error {
+	existing, err := m.dep.FileClient().FindEntityByChecksum(ctx, src.StoragePolicyEntities, src.Size, checksum, src.ID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to find duplicated entity: %w", err)
+	}
+
+	if existing.ID > src.ID {
+		// Always merge newer entity into older one, the older one will handle the merge.
+		return nil
+	}
+
+	fc, tx, ctx, err := inventory.WithTx(ctx, m.dep.FileClient())
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	diff, err := fc.MergeEntity(ctx, src, existing)
+	if err != nil {
+		_ = inventory.Rollback(tx)
+		if errors.Is(err, inventory.ErrEntityNotMergeable) {
+			m.l.Debug("Entity %d is no longer mergeable, skip deduplication.", existing.ID)
+			return nil
+		}
+		return fmt.Errorf("failed to merge entity %d into %d: %w", src.ID, existing.ID, err)
+	}
+
+	tx.AppendStorageDiff(diff)
+	if err := inventory.CommitWithStorageDiff(ctx, tx, m.l, m.dep.UserClient()); err != nil {
+		return fmt.Errorf("failed to commit merge change: %w", err)
+	}
+
+	m.l.Info("Entity %d is merged into entity %d with the same content.", src.ID, existing.ID)
+
+	// Recycle the duplicated entity
+	t, err := newExplicitEntityRecycleTask(ctx, []int{src.ID})
+	if err != nil {
+		return fmt.Errorf("failed to create explicit entity recycle task: %w", err)
+	}
+
+	if err := m.dep.EntityRecycleQueue(ctx).QueueTask(ctx, t); err != nil {
+		return fmt.Errorf("failed to queue explicit entity recycle task: %w", err)
+	}
+
+	return nil
+}
+
+func (m *manager) calculateEntityChecksum(ctx context.Context, entity fs.Entity) (string, error) {
+	source, err := m.GetEntitySource(ctx, 0, fs.WithEntity(entity))
+	if err != nil {
+		return "", fmt.Errorf("failed to get entity source: %w", err)
+	}
+	defer source.Close()
+
+	hasher := sha256.New()
+	read, err := io.Copy(hasher, source)
+	if err != nil {
+		return "", fmt.Errorf("failed to read entity source: %w", err)
+	}
+
+	if read != entity.Size() {
+		return "", fmt.Errorf("entity size mismatch, expected %d, got %d (%w)", entity.Size(), read, queue.CriticalErr)
+	}
+
+	return hex.EncodeToString(hasher.Sum(nil)), nil
+}
+
+// checksumForNewEntity submits checksum task for newly uploaded entity. Calculating checksum reads the whole
+// blob back, so it is only done when the storage policy makes use of it.
+func (m *manager) checksumForNewEntity(ctx context.Context, session *fs.UploadSession) {
+	if session.Importing || session.RapidUploaded || session.EncryptMetadata != nil {
+		return
+	}
+
+	if session.Policy == nil || session.Policy.Settings == nil ||
+		(!session.Policy.Settings.Deduplication && !session.Policy.Settings.RapidUpload) {
+		return
+	}
+
+	if session.Props.EntityType != nil && *session.Props.EntityType != types.EntityTypeVersion {
+		return
+	}
+
+	t, err := NewEntityChecksumTask(ctx, session.EntityID, m.user)
+	if err != nil {
+		m.l.Warning("Failed to create entity checksum task: %s", err)
+		return
+	}
+
+	if err := m.dep.IoIntenseQueue(ctx).QueueTask(ctx, t); err != nil {
+		m.l.Warning("Failed to queue entity checksum task: %s", err)
+	}
+}
+
+// entityChecksummable returns true if checksum of given entity should be recorded. Checksum of
+// encrypted entities is not recorded, as it reveals the plain content.
+func entityChecksummable(e fs.Entity) bool {
+	return e.Type() == types.EntityTypeVersion &&
+		e.ReferenceCount() > 0 &&
+		e.UploadSessionID() == nil &&
+		!e.Encrypted()
+}
+
+func entityDeduplicatable(e fs.Entity) bool {
+	return entityChecksummable(e) && (e.Props() == nil || !e.Props().UnlinkOnly)
+}
@@ -0,0 +1,145 @@
+package manager
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/application/dependency/deptest"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/task"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/dbfs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func uploadFile(t *testing.T, ctx context.Context, fm FileManager, uri, content string) fs.File {
+	t.Helper()
+
+	dst, err := fs.NewUriFromString(uri)
+	require.NoError(t, err)
+
+	file, err := fm.Update(ctx, &fs.UploadRequest{
+		Props: &fs.UploadProps{Uri: dst, Size: int64(len(content))},
+		File:  io.NopCloser(strings.NewReader(content)),
+		Mode:  fs.ModeOverwrite,
+	})
+	require.NoError(t, err)
+	return file
+}
+
+func updatePolicySetting(t *testing.T, dep dependency.Dep, policyID int, f func(s *types.PolicySetting)) {
+	t.Helper()
+
+	policy, err := dep.DBClient().StoragePolicy.Get(context.Background(), policyID)
+	require.NoError(t, err)
+	f(policy.Settings)
+	require.NoError(t, dep.DBClient().StoragePolicy.UpdateOneID(policyID).SetSettings(policy.Settings).Exec(context.Background()))
+}
+
+func primaryEntity(t *testing.T, dep dependency.Dep, fileID int) *ent.Entity {
+	t.Helper()
+
+	f, err := dep.DBClient().File.Get(context.Background(), fileID)
+	require.NoError(t, err)
+	e, err := dep.DBClient().Entity.Get(context.Background(), f.PrimaryEntity)
+	require.NoError(t, err)
+	return e
+}
+
+func sha256Hex(content string) string {
+	h := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(h[:])
+}
+
+func TestDeduplicateEntity(t *testing.T) {
+	a := assert.New(t)
+	dep := deptest.New(t)
+	updatePolicySetting(t, dep, 1, func(s *types.PolicySetting) {
+		s.Deduplication = true
+	})
+
+	u1 := deptest.NewUser(t, dep, "u1@cloudreve.org", 2)
+	u2 := deptest.NewUser(t, dep, "u2@cloudreve.org", 2)
+	ctx1, ctx2 := deptest.Context(dep, u1), deptest.Context(dep, u2)
+	fm1 := NewFileManager(dep, u1).(*manager)
+	fm2 := NewFileManager(dep, u2).(*manager)
+
+	f1 := uploadFile(t, ctx1, fm1, "cloudreve://my/a.txt", "same content")
+	f2 := uploadFile(t, ctx2, fm2, "cloudreve://my/b.txt", "same content")
+	f3 := uploadFile(t, ctx2, fm2, "cloudreve://my/c.txt", "other content")
+	e1, e2 := primaryEntity(t, dep, f1.ID()), primaryEntity(t, dep, f2.ID())
+	a.NotEqual(e1.ID, e2.ID)
+
+	require.NoError(t, fm1.DeduplicateEntity(ctx1, e1.ID))
+	require.NoError(t, fm2.DeduplicateEntity(ctx2, e2.ID))
+	require.NoError(t, fm2.DeduplicateEntity(ctx2, primaryEntity(t, dep, f3.ID()).ID))
+
+	merged := primaryEntity(t, dep, f2.ID())
+	a.Equal(e1.ID, merged.ID, "newer entity should be merged into older one")
+	a.Equal(2, merged.ReferenceCount)
+	a.Equal(sha256Hex("same content"), merged.Checksum)
+
+	stale, err := dep.DBClient().Entity.Get(ctx2, e2.ID)
+	require.NoError(t, err)
+	a.Equal(0, stale.ReferenceCount)
+	a.NotEqual(e1.ID, primaryEntity(t, dep, f3.ID()).ID, "different content must not be merged")
+
+	// Merged file still reads original content
+	uri, _ := fs.NewUriFromString("cloudreve://my/b.txt")
+	file, err := fm2.Get(ctx2, uri, dbfs.WithFileEntities())
+	require.NoError(t, err)
+	source, err := fm2.GetEntitySource(ctx2, 0, fs.WithEntity(file.PrimaryEntity()))
+	require.NoError(t, err)
+	content, err := io.ReadAll(source)
+	source.Close()
+	require.NoError(t, err)
+	a.Equal("same content", string(content))
+}
+
+func TestDeduplicateEntityDisabled(t *testing.T) {
+	a := assert.New(t)
+	dep := deptest.New(t)
+	u := deptest.NewUser(t, dep, "u@cloudreve.org", 2)
+	ctx := deptest.Context(dep, u)
+	fm := NewFileManager(dep, u).(*manager)
+
+	f1 := uploadFile(t, ctx, fm, "cloudreve://my/a.txt", "same content")
+	f2 := uploadFile(t, ctx, fm, "cloudreve://my/b.txt", "same content")
+	e1, e2 := primaryEntity(t, dep, f1.ID()), primaryEntity(t, dep, f2.ID())
+
+	require.NoError(t, fm.DeduplicateEntity(ctx, e1.ID))
+	require.NoError(t, fm.DeduplicateEntity(ctx, e2.ID))
+
+	// Checksum is still recorded, but entities are kept apart
+	a.Equal(sha256Hex("same content"), primaryEntity(t, dep, f1.ID()).Checksum)
+	a.Equal(sha256Hex("same content"), primaryEntity(t, dep, f2.ID()).Checksum)
+	a.Equal(e2.ID, primaryEntity(t, dep, f2.ID()).ID)
+}
+
+func TestChecksumForNewEntity(t *testing.T) {
+	a := assert.New(t)
+	dep := deptest.New(t)
+	u := deptest.NewUser(t, dep, "u@cloudreve.org", 2)
+	ctx := deptest.Context(dep, u)
+	checksumTasks := func() int {
+		return dep.DBClient().Task.Query().Where(task.Type(queue.EntityChecksumTaskType)).CountX(context.Background())
+	}
+
+	// Blobs are not read back when no feature of the policy needs the checksum.
+	uploadFile(t, ctx, NewFileManager(dep, u), "cloudreve://my/a.txt", "a")
+	a.Equal(0, checksumTasks())
+
+	updatePolicySetting(t, dep, 1, func(s *types.PolicySetting) {
+		s.RapidUpload = true
+	})
+	uploadFile(t, ctx, NewFileManager(dep, u), "cloudreve://my/b.txt", "b")
+	a.Equal(1, checksumTasks())
+}
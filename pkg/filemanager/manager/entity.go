@@ -45,6 +45,9 @@ type (
 		ListPhysical(ctx context.Context, path string, policyID int, recursive bool, progress driver.ListProgressFunc) ([]fs.PhysicalObject, error)
 		// ImportPhysical imports a physical file to a Cloudreve file
 		ImportPhysical(ctx context.Context, dst *fs.URI, policyId int, src fs.PhysicalObject, completeHook bool) error
+		// DeduplicateEntity calculates checksum of given entity and merges it into an existing entity with
+		// the same content if deduplication is enabled in its storage policy.
+		DeduplicateEntity(ctx context.Context, entityID int) error
 	}
 	DirectLink struct {
 		File fs.File
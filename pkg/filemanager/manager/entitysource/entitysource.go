@@ -263,6 +263,12 @@ func (f *entitySource) IsLocal() bool {
 	return f.handler.Capabilities().StaticFeatures.Enabled(int(driver.HandlerCapabilityInboundGet))
 }
 
+// isStream returns true if content of the remote file is read by the handler directly.
+func (f *entitySource) isStream() bool {
+	_, ok := f.handler.(driver.StreamReader)
+	return ok
+}
+
 func (f *entitySource) LocalPath(ctx context.Context) string {
 	return f.handler.LocalPath(ctx, f.e.Source())
 }
@@ -276,8 +282,8 @@ func (f *entitySource) Serve(w http.ResponseWriter, r *http.Request, opts ...Ent
 		opt.Apply(f.o)
 	}
 
-	if f.IsLocal() {
-		// For local files, validate file existence by resetting rsc
+	if f.IsLocal() || f.isStream() {
+		// For local or streamed files, validate file existence by resetting rsc
 		if err := f.resetRequest(); err != nil {
 			f.l.Warning("Failed to serve local entity %q: %s", err, f.e.Source())
 			http.Error(w, "Entity data does not exist.", http.StatusNotFound)
@@ -311,7 +317,7 @@ func (f *entitySource) Serve(w http.ResponseWriter, r *http.Request, opts ...Ent
 		return
 	}
 
-	if !f.IsLocal() {
+	if !f.IsLocal() && !f.isStream() {
 		// for non-local file, reverse-proxy the request
 		expire := time.Now().Add(defaultUrlExpire)
 		u, err := f.Url(driver.WithForcePublicEndpoint(f.o.Ctx, false), WithNoInternalProxy(), WithExpire(&expire))
@@ -532,6 +538,7 @@ func (f *entitySource) ReadAt(p []byte, off int64) (n int, err error) {
 	if err != nil {
 		return 0, err
 	}
+	defer rsc.Close()
 	return io.ReadFull(rsc, p)
 }
 
@@ -697,12 +704,14 @@ func (f *entitySource) getRsc(pos int64) (io.ReadCloser, error) {
 			}
 		}
 
-		if f.o.SpeedLimit > 0 {
-			bucket := ratelimit.NewBucketWithRate(float64(f.o.SpeedLimit), f.o.SpeedLimit)
-			rsc = lrs{file, ratelimit.Reader(file, bucket)}
-		} else {
-			rsc = file
+		rsc = f.withSpeedLimit(file)
+	} else if sr, ok := f.handler.(driver.StreamReader); ok {
+		stream, err := sr.OpenStream(f.o.Ctx, f.e.Source(), pos)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open stream: %w", err)
 		}
+
+		rsc = f.withSpeedLimit(stream)
 	} else {
 		var urlStr string
 		now := time.Now()
@@ -748,6 +757,15 @@ func (f *entitySource) getRsc(pos int64) (io.ReadCloser, error) {
 	return rsc, nil
 }
 
+func (f *entitySource) withSpeedLimit(rc io.ReadCloser) io.ReadCloser {
+	if f.o.SpeedLimit <= 0 {
+		return rc
+	}
+
+	bucket := ratelimit.NewBucketWithRate(float64(f.o.SpeedLimit), f.o.SpeedLimit)
+	return lrs{rc, ratelimit.Reader(rc, bucket)}
+}
+
 func (f *entitySource) getDecryptedRsc(rsc io.ReadCloser, pos int64) (io.ReadCloser, error) {
 	props := f.e.Props()
 	if props != nil && props.EncryptMetadata != nil && !f.o.DisableCryptor {
@@ -0,0 +1,114 @@
+package manager
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/application/dependency/deptest"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setMaxFileCount(t *testing.T, dep dependency.Dep, groupID, max int) {
+	t.Helper()
+
+	group, err := dep.DBClient().Group.Get(context.Background(), groupID)
+	require.NoError(t, err)
+	if group.Settings == nil {
+		group.Settings = &types.GroupSetting{}
+	}
+	group.Settings.MaxFileCount = max
+	require.NoError(t, dep.DBClient().Group.UpdateOneID(groupID).SetSettings(group.Settings).Exec(context.Background()))
+}
+
+func TestFileCountQuota(t *testing.T) {
+	a := assert.New(t)
+	dep := deptest.New(t)
+	// Root folder, one folder and two files.
+	setMaxFileCount(t, dep, 2, 4)
+	// First user is always assigned to admin group.
+	deptest.NewUser(t, dep, "admin@cloudreve.org", 1)
+	uid := deptest.NewUser(t, dep, "u@cloudreve.org", 2).ID
+
+	// Each operation is performed with freshly loaded user, like in separated requests.
+	request := func() (context.Context, FileManager) {
+		u, err := dep.UserClient().GetLoginUserByID(context.Background(), uid)
+		require.NoError(t, err)
+		return deptest.Context(dep, u), NewFileManager(dep, u)
+	}
+	fileCount := func() int {
+		return dep.DBClient().User.GetX(context.Background(), uid).FileCount
+	}
+
+	dir, err := fs.NewUriFromString("cloudreve://my/dir")
+	require.NoError(t, err)
+	ctx, fm := request()
+	_, err = fm.Create(ctx, dir, types.FileTypeFolder)
+	require.NoError(t, err)
+	a.Equal(2, fileCount(), "root folder is counted")
+
+	ctx, fm = request()
+	uploadFile(t, ctx, fm, "cloudreve://my/dir/a.txt", "a")
+	ctx, fm = request()
+	uploadFile(t, ctx, fm, "cloudreve://my/b.txt", "b")
+	a.Equal(4, fileCount())
+
+	// Overwriting an existing file does not need more quota.
+	ctx, fm = request()
+	uploadFile(t, ctx, fm, "cloudreve://my/b.txt", "bb")
+	a.Equal(4, fileCount())
+
+	exceeded, _ := fs.NewUriFromString("cloudreve://my/c.txt")
+	ctx, fm = request()
+	_, err = fm.CreateUploadSession(ctx, &fs.UploadRequest{
+		Props: &fs.UploadProps{Uri: exceeded, Size: 1},
+	})
+	a.ErrorIs(err, fs.ErrInsufficientFileQuota)
+
+	exceededDir, _ := fs.NewUriFromString("cloudreve://my/dir2/sub")
+	ctx, fm = request()
+	_, err = fm.Create(ctx, exceededDir, types.FileTypeFolder)
+	a.ErrorIs(err, fs.ErrInsufficientFileQuota)
+	a.Equal(4, fileCount())
+
+	// Deleting a folder releases quota of all its descendants.
+	ctx, fm = request()
+	require.NoError(t, fm.Delete(ctx, []*fs.URI{dir}, fs.WithSkipSoftDelete(true)))
+	a.Equal(2, fileCount())
+	ctx, fm = request()
+	uploadFile(t, ctx, fm, "cloudreve://my/d.txt", "d")
+	a.Equal(3, fileCount())
+}
+
+func TestFileCountCalibration(t *testing.T) {
+	a := assert.New(t)
+	dep := deptest.New(t)
+	u := deptest.NewUser(t, dep, "u@cloudreve.org", 2)
+	ctx := deptest.Context(dep, u)
+	uploadFile(t, ctx, NewFileManager(dep, u), "cloudreve://my/a.txt", "a")
+
+	// Users created before file count is tracked.
+	require.NoError(t, dep.DBClient().User.UpdateOneID(u.ID).SetFileCount(0).Exec(context.Background()))
+	u, err := dep.UserClient().GetLoginUserByID(context.Background(), u.ID)
+	require.NoError(t, err)
+	ctx = deptest.Context(dep, u)
+	fm := NewFileManager(dep, u)
+
+	// Reading capacity never calibrates the count, it is done once by migration or admin.
+	capacity, err := fm.Capacity(ctx)
+	require.NoError(t, err)
+	a.Equal(0, capacity.FileCount)
+	count, err := dep.UserClient().CalculateFileCount(context.Background(), u.ID)
+	require.NoError(t, err)
+	a.Equal(2, count)
+	a.Equal(2, dep.DBClient().User.GetX(context.Background(), u.ID).FileCount)
+
+	// Count never goes below 0 even if it was drifted.
+	require.NoError(t, dep.DBClient().User.UpdateOneID(u.ID).SetFileCount(0).Exec(context.Background()))
+	a1, _ := fs.NewUriFromString("cloudreve://my/a.txt")
+	require.NoError(t, fm.Delete(ctx, []*fs.URI{a1}, fs.WithSkipSoftDelete(true)))
+	a.Equal(0, dep.DBClient().User.GetX(context.Background(), u.ID).FileCount)
+}
@@ -7,16 +7,21 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
 	"github.com/cloudreve/Cloudreve/v4/pkg/cluster"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver/azblob"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver/cos"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver/googledrive"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver/ks3"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver/local"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver/obs"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver/onedrive"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver/oss"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver/plugin"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver/qiniu"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver/remote"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver/s3"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver/sftp"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver/upyun"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver/webdav"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
 )
@@ -84,6 +89,16 @@ func (m *manager) GetStorageDriver(ctx context.Context, policy *ent.StoragePolic
 		return upyun.New(ctx, policy, m.settings, m.config, m.l, m.dep.MimeDetector(ctx))
 	case types.PolicyTypeOd:
 		return onedrive.New(ctx, policy, m.settings, m.config, m.l, m.dep.CredManager())
+	case types.PolicyTypeSftp:
+		return sftp.New(ctx, policy, m.settings, m.l)
+	case types.PolicyTypeWebdav:
+		return webdav.New(ctx, policy, m.settings, m.config, m.l)
+	case types.PolicyTypeAzblob:
+		return azblob.New(ctx, policy, m.settings, m.config, m.l, m.dep.MimeDetector(ctx))
+	case types.PolicyTypeGoogleDrive:
+		return googledrive.New(ctx, policy, m.settings, m.config, m.l, m.dep.CredManager())
+	case types.PolicyTypePlugin:
+		return plugin.New(ctx, policy, m.settings, m.config, m.l)
 	default:
 		return nil, ErrUnknownPolicyType
 	}
@@ -123,6 +123,9 @@ type (
 		Expire          *time.Time
 		ShareView       bool
 		ShowReadMe      bool
+		Mode            types.ShareMode
+		MaxUploadSize   int64
+		MaxUploads      int
 	}
 
 	FullTextSearchResults struct {
@@ -162,7 +165,7 @@ func NewFileManager(dep dependency.Dep, u *ent.User) FileManager {
 		settings: dep.SettingProvider(),
 		fs: dbfs.NewDatabaseFS(u, dep.FileClient(), dep.ShareClient(), dep.Logger(), dep.LockSystem(),
 			dep.SettingProvider(), dep.StoragePolicyClient(), dep.HashIDEncoder(), dep.UserClient(), dep.KV(), dep.NavigatorStateKV(),
-			dep.DirectLinkClient(), dep.EncryptorFactory(context.TODO()), dep.EventHub()),
+			dep.DirectLinkClient(), dep.TeamClient(), dep.EncryptorFactory(context.TODO()), dep.EventHub()),
 		kv:           dep.KV(),
 		config:       config,
 		auth:         dep.GeneralAuth(),
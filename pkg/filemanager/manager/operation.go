@@ -14,9 +14,11 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/dbfs"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/lock"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
 	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
 	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/cloudreve/Cloudreve/v4/pkg/webhook"
 	"github.com/samber/lo"
 )
 
@@ -204,6 +206,7 @@ func (m *manager) Delete(ctx context.Context, path []*fs.URI, opts ...fs.Option)
 	}
 
 	if !o.SkipSoftDelete && !o.SysSkipSoftDelete {
+		// Files deleted through writable shares or team folders are moved into trash bin of the owner.
 		return m.SoftDelete(ctx, path...)
 	}
 
@@ -321,9 +324,22 @@ func (l *manager) CreateOrUpdateShare(ctx context.Context, path *fs.URI, args *C
 		}
 	}
 
+	if args.Mode != types.ShareModeRead {
+		if args.Mode != types.ShareModeWrite && args.Mode != types.ShareModeFileDrop {
+			return nil, serializer.NewError(serializer.CodeParamErr, fmt.Sprintf("unknown share mode %q", args.Mode), nil)
+		}
+
+		if file.Type() != types.FileTypeFolder {
+			return nil, serializer.NewError(serializer.CodeParamErr, "only folder can be shared with write access", nil)
+		}
+	}
+
 	props := &types.ShareProps{
-		ShareView:  args.ShareView,
-		ShowReadMe: args.ShowReadMe,
+		ShareView:     args.ShareView,
+		ShowReadMe:    args.ShowReadMe,
+		Mode:          args.Mode,
+		MaxUploadSize: args.MaxUploadSize,
+		MaxUploads:    args.MaxUploads,
 	}
 
 	share, err := shareClient.Upsert(ctx, &inventory.CreateShareParams{
@@ -340,6 +356,13 @@ func (l *manager) CreateOrUpdateShare(ctx context.Context, path *fs.URI, args *C
 		return nil, serializer.NewError(serializer.CodeDBError, "failed to create share", err)
 	}
 
+	if existed == nil {
+		webhook.Dispatch(ctx, l.user, webhook.EventShareCreated, &webhook.ShareEventData{
+			ShareID: hashid.EncodeShareID(l.hasher, share.ID),
+			Uri:     file.Uri(false).String(),
+		})
+	}
+
 	return share, nil
 }
 
@@ -348,7 +371,8 @@ func (m *manager) TraverseFile(ctx context.Context, fileID int) (fs.File, error)
 }
 
 func (m *manager) PatchView(ctx context.Context, uri *fs.URI, view *types.ExplorerView) error {
-	if uri.PathTrimmed() == "" && uri.FileSystem() != constants.FileSystemMy && uri.FileSystem() != constants.FileSystemShare {
+	if uri.PathTrimmed() == "" && uri.FileSystem() != constants.FileSystemMy && uri.FileSystem() != constants.FileSystemShare &&
+		uri.FileSystem() != constants.FileSystemTeam {
 		if m.user.Settings.FsViewMap == nil {
 			m.user.Settings.FsViewMap = make(map[string]types.ExplorerView)
 		}
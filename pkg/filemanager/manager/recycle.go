@@ -56,6 +56,7 @@ func init() {
 		}
 	})
 	crontab.Register(setting.CronTypeTrashBinCollect, CronCollectTrashBin)
+	crontab.Register(setting.CronTypeShareAccessLog, CronCollectShareAccessLogs)
 }
 
 func NewExplicitEntityRecycleTaskFromModel(task *ent.Task) queue.Task {
@@ -385,3 +386,22 @@ func appendAe(errs *[][]RecycleError, err error) {
 		})
 	}
 }
+
+// CronCollectShareAccessLogs deletes share access logs older than the retention period.
+func CronCollectShareAccessLogs(ctx context.Context) {
+	dep := dependency.FromContext(ctx)
+	l := dep.Logger()
+
+	retention := dep.SettingProvider().ShareAccessLogRetention(ctx)
+	if retention <= 0 {
+		return
+	}
+
+	deleted, err := dep.ShareClient().DeleteAccessesBefore(ctx, time.Now().AddDate(0, 0, -retention))
+	if err != nil {
+		l.Error("Failed to delete expired share access logs: %s", err)
+		return
+	}
+
+	l.Info("Deleted %d share access logs older than %d days.", deleted, retention)
+}
@@ -0,0 +1,94 @@
+package manager
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency/deptest"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/shareaccess"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth/requestinfo"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShareAccessRecording(t *testing.T) {
+	a := assert.New(t)
+	dep := deptest.New(t)
+	owner := deptest.NewUser(t, dep, "owner@cloudreve.org", 1)
+	visitor := deptest.NewUser(t, dep, "visitor@cloudreve.org", 2)
+	share, _, shareUri := newShare(t, dep, owner, "cloudreve://my/shared", types.ShareModeRead)
+	uploadFile(t, deptest.Context(dep, owner), NewFileManager(dep, owner), "cloudreve://my/shared/a.txt", "hello")
+
+	anonymous, err := dep.UserClient().AnonymousUser(context.Background())
+	require.NoError(t, err)
+
+	for _, u := range []*ent.User{visitor, anonymous} {
+		ctx := context.WithValue(deptest.Context(dep, u), requestinfo.RequestInfoCtx{}, &requestinfo.RequestInfo{
+			IP:        "192.0.2.1",
+			UserAgent: "test-agent",
+		})
+		fm := NewFileManager(dep, u)
+		_, _, err := fm.GetEntityUrls(ctx, []GetEntityUrlArgs{{URI: mustUri(t, shareUri+"/a.txt")}})
+		require.NoError(t, err)
+		_, err = fm.CreateArchive(ctx, []*fs.URI{mustUri(t, shareUri+"/a.txt")}, io.Discard)
+		require.NoError(t, err)
+		fm.Recycle()
+	}
+
+	res, err := dep.ShareClient().ListAccesses(context.Background(), &inventory.ListShareAccessArgs{
+		PaginationArgs: &inventory.PaginationArgs{PageSize: 50},
+		ShareID:        share.ID,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Accesses, 4)
+
+	actions := make(map[int][]shareaccess.Action)
+	for _, access := range res.Accesses {
+		a.Equal("192.0.2.1", access.IP)
+		a.Equal("test-agent", access.UserAgent)
+		a.Equal("/a.txt", access.Path)
+		uid := 0
+		if access.UserID != nil {
+			uid = *access.UserID
+		}
+		actions[uid] = append(actions[uid], access.Action)
+	}
+
+	a.ElementsMatch([]shareaccess.Action{shareaccess.ActionDownload, shareaccess.ActionArchive}, actions[visitor.ID])
+	a.ElementsMatch([]shareaccess.Action{shareaccess.ActionDownload, shareaccess.ActionArchive}, actions[0], "anonymous visitor")
+
+	// Listing only returns records of given visitor.
+	res, err = dep.ShareClient().ListAccesses(context.Background(), &inventory.ListShareAccessArgs{
+		PaginationArgs: &inventory.PaginationArgs{PageSize: 50},
+		UserID:         visitor.ID,
+	})
+	require.NoError(t, err)
+	a.Len(res.Accesses, 2)
+}
+
+func TestCronCollectShareAccessLogs(t *testing.T) {
+	a := assert.New(t)
+	dep := deptest.New(t)
+	owner := deptest.NewUser(t, dep, "owner@cloudreve.org", 1)
+	share, _, _ := newShare(t, dep, owner, "cloudreve://my/shared", types.ShareModeRead)
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		require.NoError(t, dep.ShareClient().LogAccess(ctx, &inventory.ShareAccessArgs{ShareID: share.ID, Action: shareaccess.ActionView}))
+	}
+	expired := dep.DBClient().ShareAccess.Query().FirstIDX(ctx)
+	_, err := dep.DBClient().ExecContext(ctx, "UPDATE "+shareaccess.Table+" SET created_at = ? WHERE id = ?",
+		time.Now().AddDate(0, 0, -dep.SettingProvider().ShareAccessLogRetention(ctx)-1), expired)
+	require.NoError(t, err)
+
+	CronCollectShareAccessLogs(deptest.Context(dep, nil))
+	remaining := dep.DBClient().ShareAccess.Query().IDsX(ctx)
+	a.Len(remaining, 1)
+	a.NotContains(remaining, expired)
+}
@@ -0,0 +1,186 @@
+package manager
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/application/dependency/deptest"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/file"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/dbfs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newShare shares a new folder at given path of the owner, returns the share, ID of the shared
+// folder and URI of the share root.
+func newShare(t *testing.T, dep dependency.Dep, owner *ent.User, uri string, mode types.ShareMode) (*ent.Share, int, string) {
+	t.Helper()
+
+	ctx := deptest.Context(dep, owner)
+	folderUri, err := fs.NewUriFromString(uri)
+	require.NoError(t, err)
+	folder, err := NewFileManager(dep, owner).Create(ctx, folderUri, types.FileTypeFolder)
+	require.NoError(t, err)
+
+	share, err := dep.ShareClient().Upsert(ctx, &inventory.CreateShareParams{
+		OwnerID: owner.ID,
+		FileID:  folder.ID(),
+		Props:   &types.ShareProps{Mode: mode},
+	})
+	require.NoError(t, err)
+	return share, folder.ID(), fs.NewShareUri(hashid.EncodeShareID(dep.HashIDEncoder(), share.ID), "")
+}
+
+func childrenNames(t *testing.T, dep dependency.Dep, folderID int) []string {
+	t.Helper()
+
+	return dep.DBClient().File.Query().Where(file.FileChildren(folderID)).Select(file.FieldName).StringsX(context.Background())
+}
+
+func TestFileDropShare(t *testing.T) {
+	a := assert.New(t)
+	dep := deptest.New(t)
+	owner := deptest.NewUser(t, dep, "owner@cloudreve.org", 1)
+	visitor := deptest.NewUser(t, dep, "visitor@cloudreve.org", 2)
+	share, folderID, shareUri := newShare(t, dep, owner, "cloudreve://my/drop", types.ShareModeFileDrop)
+	existing := uploadFile(t, deptest.Context(dep, owner), NewFileManager(dep, owner), "cloudreve://my/drop/secret.txt", "secret")
+
+	ctx := deptest.Context(dep, visitor)
+	fm := NewFileManager(dep, visitor)
+	uploaded := uploadFile(t, ctx, fm, shareUri+"/new.txt", "new")
+	a.Equal(owner.ID, uploaded.OwnerID(), "uploaded file belongs to share owner")
+	a.Equal(1, dep.DBClient().Share.GetX(context.Background(), share.ID).Uploads)
+
+	// Upload conflicting with existing file is renamed without revealing the existing one.
+	renamed := uploadFile(t, ctx, fm, shareUri+"/secret.txt", "overwritten")
+	a.NotEqual(existing.ID(), renamed.ID())
+	a.True(strings.HasPrefix(renamed.Name(), "secret_") && strings.HasSuffix(renamed.Name(), ".txt"), renamed.Name())
+	a.Equal(int64(len("secret")), primaryEntity(t, dep, existing.ID()).Size, "existing file is untouched")
+	a.Len(childrenNames(t, dep, folderID), 3)
+
+	// Visitors can only upload new files into the drop folder.
+	nested, _ := fs.NewUriFromString(shareUri + "/sub/nested.txt")
+	_, err := fm.CreateUploadSession(ctx, &fs.UploadRequest{Props: &fs.UploadProps{Uri: nested, Size: 1}})
+	a.Error(err)
+	for _, target := range []struct {
+		uri      string
+		fileType types.FileType
+	}{
+		{shareUri + "/dir", types.FileTypeFolder},
+		{shareUri + "/empty.txt", types.FileTypeFile},
+		{shareUri + "/secret.txt", types.FileTypeFile},
+	} {
+		uri, _ := fs.NewUriFromString(target.uri)
+		_, err = fm.Create(ctx, uri, target.fileType)
+		a.ErrorContains(err, "file drop share only accepts new files", target.uri)
+	}
+
+	root, _ := fs.NewUriFromString(shareUri)
+	_, _, err = fm.List(ctx, root, &ListArgs{PageSize: 10})
+	a.Error(err, "file drop visitors cannot list files")
+	a.Len(childrenNames(t, dep, folderID), 3)
+}
+
+func TestWritableShareDelete(t *testing.T) {
+	a := assert.New(t)
+	dep := deptest.New(t)
+	owner := deptest.NewUser(t, dep, "owner@cloudreve.org", 1)
+	visitor := deptest.NewUser(t, dep, "visitor@cloudreve.org", 2)
+	_, _, shareUri := newShare(t, dep, owner, "cloudreve://my/shared", types.ShareModeWrite)
+	target := uploadFile(t, deptest.Context(dep, owner), NewFileManager(dep, owner), "cloudreve://my/shared/a.txt", "a")
+
+	ctx := deptest.Context(dep, visitor)
+	uri, _ := fs.NewUriFromString(shareUri + "/a.txt")
+	require.NoError(t, NewFileManager(dep, visitor).Delete(ctx, []*fs.URI{uri}))
+
+	// File is moved into trash bin of the owner instead of being deleted permanently.
+	deleted, err := dep.DBClient().File.Get(context.Background(), target.ID())
+	require.NoError(t, err)
+	a.Zero(deleted.FileChildren)
+
+	ownerCtx := deptest.Context(dep, owner)
+	trash, _ := fs.NewUriFromString("cloudreve://trash")
+	_, res, err := NewFileManager(dep, owner).List(ownerCtx, trash, &ListArgs{PageSize: 10})
+	require.NoError(t, err)
+	require.Len(t, res.Files, 1)
+	a.Equal(target.ID(), res.Files[0].ID())
+	a.Equal(fs.NewMyUri(hashid.EncodeUserID(dep.HashIDEncoder(), owner.ID))+"/shared/a.txt", res.Files[0].Metadata()[dbfs.MetadataRestoreUri])
+}
+
+func TestShareStateRestoreMode(t *testing.T) {
+	a := assert.New(t)
+	dep := deptest.New(t)
+	owner := deptest.NewUser(t, dep, "owner@cloudreve.org", 1)
+	visitor := deptest.NewUser(t, dep, "visitor@cloudreve.org", 2)
+	share, folderID, shareUri := newShare(t, dep, owner, "cloudreve://my/shared", types.ShareModeWrite)
+
+	// First request persists navigator state under the context hint.
+	ctx := context.WithValue(deptest.Context(dep, visitor), dbfs.ContextHintCtxKey{}, uuid.Must(uuid.NewV4()))
+	root, _ := fs.NewUriFromString(shareUri)
+	fm := NewFileManager(dep, visitor)
+	_, _, err := fm.List(ctx, root, &ListArgs{PageSize: 10})
+	require.NoError(t, err)
+	fm.Recycle()
+
+	// Owner revokes write access afterward.
+	require.NoError(t, dep.DBClient().Share.UpdateOneID(share.ID).SetProps(&types.ShareProps{}).Exec(context.Background()))
+
+	dir, _ := fs.NewUriFromString(shareUri + "/dir")
+	_, err = NewFileManager(dep, visitor).Create(ctx, dir, types.FileTypeFolder)
+	a.Error(err)
+	a.Empty(childrenNames(t, dep, folderID))
+}
+
+func TestShareUploadLimit(t *testing.T) {
+	a := assert.New(t)
+	dep := deptest.New(t)
+	owner := deptest.NewUser(t, dep, "owner@cloudreve.org", 1)
+	visitor := deptest.NewUser(t, dep, "visitor@cloudreve.org", 2)
+	share, _, shareUri := newShare(t, dep, owner, "cloudreve://my/shared", types.ShareModeWrite)
+	require.NoError(t, dep.DBClient().Share.UpdateOneID(share.ID).
+		SetProps(&types.ShareProps{Mode: types.ShareModeWrite, MaxUploads: 2}).Exec(context.Background()))
+	uploads := func() int {
+		return dep.DBClient().Share.GetX(context.Background(), share.ID).Uploads
+	}
+
+	ctx := deptest.Context(dep, visitor)
+	fm := NewFileManager(dep, visitor)
+	createSession := func(name string, size int64) (*fs.UploadCredential, error) {
+		return fm.CreateUploadSession(ctx, &fs.UploadRequest{Props: &fs.UploadProps{Uri: mustUri(t, shareUri+"/"+name), Size: size}})
+	}
+
+	// Slot is taken once upload session is created, before upload completes.
+	first, err := createSession("a.txt", 1)
+	require.NoError(t, err)
+	_, err = createSession("b.txt", 1)
+	require.NoError(t, err)
+	a.Equal(2, uploads())
+	_, err = createSession("c.txt", 1)
+	a.ErrorContains(err, dbfs.ErrShareUploadLimited.Msg)
+	a.Equal(2, uploads())
+
+	// Canceled upload gives back its slot.
+	require.NoError(t, fm.CancelUploadSession(ctx, mustUri(t, shareUri+"/a.txt"), first.SessionID))
+	a.Equal(1, uploads())
+
+	// Slot is given back if upload session is not created for other reasons.
+	_, err = createSession("huge.txt", 1<<40)
+	a.ErrorIs(err, fs.ErrInsufficientCapacity)
+	a.Equal(1, uploads())
+
+	_, err = createSession("c.txt", 1)
+	require.NoError(t, err)
+	a.Equal(2, uploads())
+
+	// Uploads of the owner are not limited.
+	uploadFile(t, deptest.Context(dep, owner), NewFileManager(dep, owner), shareUri+"/owner.txt", "owner")
+	a.Equal(2, uploads())
+}
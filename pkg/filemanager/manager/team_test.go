@@ -0,0 +1,170 @@
+package manager
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/application/dependency/deptest"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/dbfs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTeam creates a team with its account and root folder, returns the team account and URI of
+// the team root.
+func newTeam(t *testing.T, dep dependency.Dep, name string, members ...types.TeamMember) (*ent.User, string) {
+	t.Helper()
+
+	ctx := context.Background()
+	account := deptest.NewUser(t, dep, "team-"+name+"@cloudreve.org", 2)
+	_, err := dep.FileClient().CreateFolder(ctx, nil, &inventory.CreateFolderParameters{
+		Owner: account.ID,
+		Name:  inventory.RootFolderName,
+	})
+	require.NoError(t, err)
+
+	team, err := dep.TeamClient().Upsert(ctx, &ent.Team{
+		Name:     name,
+		OwnerID:  account.ID,
+		Settings: &types.TeamSetting{Members: members},
+	})
+	require.NoError(t, err)
+	return account, fs.NewTeamUri(hashid.EncodeTeamID(dep.HashIDEncoder(), team.ID))
+}
+
+func mustUri(t *testing.T, raw string) *fs.URI {
+	t.Helper()
+
+	uri, err := fs.NewUriFromString(raw)
+	require.NoError(t, err)
+	return uri
+}
+
+func listNames(t *testing.T, ctx context.Context, fm FileManager, uri string) []string {
+	t.Helper()
+
+	_, res, err := fm.List(ctx, mustUri(t, uri), &ListArgs{PageSize: 50})
+	require.NoError(t, err)
+	return lo.Map(res.Files, func(f fs.File, _ int) string { return f.Name() })
+}
+
+func TestTeamFolderAccess(t *testing.T) {
+	a := assert.New(t)
+	dep := deptest.New(t)
+	writer := deptest.NewUser(t, dep, "writer@cloudreve.org", 2)
+	reader := deptest.NewUser(t, dep, "reader@cloudreve.org", 2)
+	outsider := deptest.NewUser(t, dep, "outsider@cloudreve.org", 2)
+	account, teamUri := newTeam(t, dep, "dev",
+		types.TeamMember{UserID: writer.ID, Role: types.TeamRoleWriter},
+		types.TeamMember{UserID: reader.ID, Role: types.TeamRoleReader},
+	)
+
+	// Writers create files owned by the team account.
+	writerCtx, writerFm := deptest.Context(dep, writer), NewFileManager(dep, writer)
+	uploaded := uploadFile(t, writerCtx, writerFm, teamUri+"/docs/a.txt", "hello team")
+	a.Equal(account.ID, uploaded.OwnerID())
+	_, err := writerFm.Create(writerCtx, mustUri(t, teamUri+"/empty"), types.FileTypeFolder)
+	require.NoError(t, err)
+	_, err = writerFm.Rename(writerCtx, mustUri(t, teamUri+"/empty"), "renamed")
+	require.NoError(t, err)
+
+	// Readers can list and read, but not modify.
+	readerCtx, readerFm := deptest.Context(dep, reader), NewFileManager(dep, reader)
+	a.ElementsMatch([]string{"docs", "renamed"}, listNames(t, readerCtx, readerFm, teamUri))
+	_, res, err := readerFm.List(readerCtx, mustUri(t, teamUri), &ListArgs{PageSize: 50})
+	require.NoError(t, err)
+	a.True(res.Props.Capability.Enabled(int(dbfs.NavigatorCapabilityDownloadFile)))
+	for _, c := range []dbfs.NavigatorCapability{
+		dbfs.NavigatorCapabilityUploadFile,
+		dbfs.NavigatorCapabilityLockFile,
+		dbfs.NavigatorCapabilityVersionControl,
+		dbfs.NavigatorCapabilityDeleteFile,
+	} {
+		a.False(res.Props.Capability.Enabled(int(c)), "reader capability %d", c)
+	}
+
+	_, err = readerFm.Create(readerCtx, mustUri(t, teamUri+"/new"), types.FileTypeFolder)
+	a.Error(err)
+	_, err = readerFm.Rename(readerCtx, mustUri(t, teamUri+"/docs/a.txt"), "b.txt")
+	a.Error(err)
+	a.Error(readerFm.Delete(readerCtx, []*fs.URI{mustUri(t, teamUri+"/docs/a.txt")}))
+	_, err = readerFm.CreateUploadSession(readerCtx, &fs.UploadRequest{Props: &fs.UploadProps{Uri: mustUri(t, teamUri+"/b.txt"), Size: 1}})
+	a.Error(err)
+	a.ElementsMatch([]string{"a.txt"}, listNames(t, writerCtx, writerFm, teamUri+"/docs"))
+
+	// Non-members cannot access the team at all.
+	outsiderCtx, outsiderFm := deptest.Context(dep, outsider), NewFileManager(dep, outsider)
+	_, _, err = outsiderFm.List(outsiderCtx, mustUri(t, teamUri), &ListArgs{PageSize: 50})
+	a.ErrorIs(err, dbfs.ErrPermissionDenied)
+	_, err = outsiderFm.Get(outsiderCtx, mustUri(t, teamUri+"/docs/a.txt"))
+	a.ErrorIs(err, dbfs.ErrPermissionDenied)
+
+	// Files deleted by writers are moved into trash bin of the team account.
+	require.NoError(t, writerFm.Delete(writerCtx, []*fs.URI{mustUri(t, teamUri+"/docs/a.txt")}))
+	deleted, err := dep.DBClient().File.Get(context.Background(), uploaded.ID())
+	require.NoError(t, err)
+	a.Zero(deleted.FileChildren)
+
+	accountCtx := deptest.Context(dep, account)
+	_, trash, err := NewFileManager(dep, account).List(accountCtx, mustUri(t, "cloudreve://trash"), &ListArgs{PageSize: 50})
+	require.NoError(t, err)
+	require.Len(t, trash.Files, 1)
+	a.Equal(uploaded.ID(), trash.Files[0].ID())
+	a.Equal(teamUri+"/docs/a.txt", trash.Files[0].Metadata()[dbfs.MetadataRestoreUri])
+}
+
+func TestTeamFolderCopyAndMove(t *testing.T) {
+	a := assert.New(t)
+	dep := deptest.New(t)
+	writer := deptest.NewUser(t, dep, "writer@cloudreve.org", 2)
+	reader := deptest.NewUser(t, dep, "reader@cloudreve.org", 2)
+	account, teamUri := newTeam(t, dep, "dev",
+		types.TeamMember{UserID: writer.ID, Role: types.TeamRoleWriter},
+		types.TeamMember{UserID: reader.ID, Role: types.TeamRoleReader},
+	)
+
+	writerCtx, writerFm := deptest.Context(dep, writer), NewFileManager(dep, writer)
+	readerCtx, readerFm := deptest.Context(dep, reader), NewFileManager(dep, reader)
+	uploadFile(t, writerCtx, writerFm, "cloudreve://my/personal.txt", "personal")
+	uploadFile(t, writerCtx, writerFm, teamUri+"/team.txt", "team")
+	uploadFile(t, readerCtx, readerFm, "cloudreve://my/mine.txt", "mine")
+	_, err := writerFm.Create(writerCtx, mustUri(t, teamUri+"/sub"), types.FileTypeFolder)
+	require.NoError(t, err)
+
+	// Files copied into team folder are owned and charged to the team account.
+	require.NoError(t, writerFm.MoveOrCopy(writerCtx, []*fs.URI{mustUri(t, "cloudreve://my/personal.txt")}, mustUri(t, teamUri), true))
+	copied, err := writerFm.Get(writerCtx, mustUri(t, teamUri+"/personal.txt"))
+	require.NoError(t, err)
+	a.Equal(account.ID, copied.OwnerID())
+	a.ElementsMatch([]string{"personal.txt"}, listNames(t, writerCtx, writerFm, "cloudreve://my"), "source is kept")
+
+	// Readers can copy team files into their own file system, but not the other way around.
+	require.NoError(t, readerFm.MoveOrCopy(readerCtx, []*fs.URI{mustUri(t, teamUri+"/team.txt")}, mustUri(t, "cloudreve://my"), true))
+	copied, err = readerFm.Get(readerCtx, mustUri(t, "cloudreve://my/team.txt"))
+	require.NoError(t, err)
+	a.Equal(reader.ID, copied.OwnerID())
+	a.Error(readerFm.MoveOrCopy(readerCtx, []*fs.URI{mustUri(t, "cloudreve://my/mine.txt")}, mustUri(t, teamUri), true))
+	a.Error(readerFm.MoveOrCopy(readerCtx, []*fs.URI{mustUri(t, teamUri+"/team.txt")}, mustUri(t, teamUri+"/sub"), false))
+
+	// Files can only be moved within the same team.
+	a.Error(writerFm.MoveOrCopy(writerCtx, []*fs.URI{mustUri(t, "cloudreve://my/personal.txt")}, mustUri(t, teamUri), false))
+	a.Error(writerFm.MoveOrCopy(writerCtx, []*fs.URI{mustUri(t, teamUri+"/team.txt")}, mustUri(t, "cloudreve://my"), false))
+	require.NoError(t, writerFm.MoveOrCopy(writerCtx, []*fs.URI{mustUri(t, teamUri+"/team.txt")}, mustUri(t, teamUri+"/sub"), false))
+	a.ElementsMatch([]string{"team.txt"}, listNames(t, writerCtx, writerFm, teamUri+"/sub"))
+	a.ElementsMatch([]string{"personal.txt", "sub"}, listNames(t, writerCtx, writerFm, teamUri))
+
+	// Storage of copied files is charged to the new owner.
+	accountModel, err := dep.UserClient().GetByID(context.Background(), account.ID)
+	require.NoError(t, err)
+	a.Equal(int64(len("team")+len("personal")), accountModel.Storage)
+	readerModel, err := dep.UserClient().GetByID(context.Background(), reader.ID)
+	require.NoError(t, err)
+	a.Equal(int64(len("mine")+len("team")), readerModel.Storage)
+}
@@ -121,6 +121,10 @@ func (m *manager) CreateUploadSession(ctx context.Context, req *fs.UploadRequest
 		}
 	}
 
+	if uploadSession.RapidUploaded {
+		return m.completeRapidUpload(ctx, uploadSession), nil
+	}
+
 	d, err := m.GetStorageDriver(ctx, m.CastStoragePolicyOnSlave(ctx, uploadSession.Policy))
 	if err != nil {
 		m.OnUploadFailed(ctx, uploadSession)
@@ -183,6 +187,22 @@ func (m *manager) CreateUploadSession(ctx context.Context, req *fs.UploadRequest
 	return credential, nil
 }
 
+// completeRapidUpload builds a completed upload credential for session whose content is linked
+// to an existing entity, clients do not need to upload any chunk. The reused entity was already
+// post-processed when it was uploaded, only the new file is indexed for its owner.
+func (m *manager) completeRapidUpload(ctx context.Context, session *fs.UploadSession) *fs.UploadCredential {
+	m.l.Info("File %q is rapid uploaded with existing entity %d.", session.Props.Uri.String(), session.EntityID)
+	m.fullTextIndexForNewEntity(ctx, session, session.UID)
+
+	return &fs.UploadCredential{
+		SessionID:     session.Props.UploadSessionID,
+		Expires:       session.Props.ExpireAt.Unix(),
+		StoragePolicy: session.Policy,
+		Uri:           session.Props.Uri.String(),
+		Completed:     true,
+	}
+}
+
 func (m *manager) ConfirmUploadSession(ctx context.Context, session *fs.UploadSession, chunkIndex int) (fs.File, error) {
 	// Get placeholder file
 	file, err := m.fs.Get(ctx, session.Props.Uri)
@@ -536,6 +556,8 @@ func (m *manager) onNewEntityUploaded(ctx context.Context, session *fs.UploadSes
 		m.mediaMetaForNewEntity(ctx, session, d)
 		// Submit full text index task for new entity
 		m.fullTextIndexForNewEntity(ctx, session, owner)
+		// Submit checksum task for new entity
+		m.checksumForNewEntity(ctx, session)
 	}
 }
 
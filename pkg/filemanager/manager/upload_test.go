@@ -0,0 +1,73 @@
+package manager
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency/deptest"
+	"github.com/cloudreve/Cloudreve/v4/ent/task"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRapidUpload(t *testing.T) {
+	a := assert.New(t)
+	dep := deptest.New(t)
+	updatePolicySetting(t, dep, 1, func(s *types.PolicySetting) {
+		s.RapidUpload = true
+	})
+
+	u := deptest.NewUser(t, dep, "u@cloudreve.org", 2)
+	other := deptest.NewUser(t, dep, "other@cloudreve.org", 2)
+	ctx := deptest.Context(dep, u)
+	fm := NewFileManager(dep, u).(*manager)
+
+	content := "same content"
+	existing := uploadFile(t, ctx, fm, "cloudreve://my/a.jpg", content)
+	e := primaryEntity(t, dep, existing.ID())
+	require.NoError(t, fm.DeduplicateEntity(ctx, e.ID))
+	mediaMetaTasks := func() int {
+		return dep.DBClient().Task.Query().Where(task.Type(queue.MediaMetaTaskType)).CountX(context.Background())
+	}
+	tasksBefore := mediaMetaTasks()
+	a.Equal(1, tasksBefore, "media meta is extracted for the original upload")
+
+	dst, err := fs.NewUriFromString("cloudreve://my/b.jpg")
+	require.NoError(t, err)
+	credential, err := fm.CreateUploadSession(ctx, &fs.UploadRequest{
+		Props: &fs.UploadProps{Uri: dst, Size: int64(len(content)), Checksum: sha256Hex(content)},
+	})
+	require.NoError(t, err)
+	a.True(credential.Completed)
+
+	rapid, err := fm.Get(ctx, dst)
+	require.NoError(t, err)
+	reused := primaryEntity(t, dep, rapid.ID())
+	a.Equal(e.ID, reused.ID)
+	a.Equal(2, reused.ReferenceCount)
+	a.Equal(tasksBefore, mediaMetaTasks(), "reused entity must not be post-processed again")
+
+	owner, err := dep.UserClient().GetByID(context.Background(), u.ID)
+	require.NoError(t, err)
+	a.Equal(int64(2*len(content)), owner.Storage, "rapid uploaded file is still charged")
+
+	// Entities not readable by the user cannot be claimed by checksum
+	otherCtx := deptest.Context(dep, other)
+	otherDst, _ := fs.NewUriFromString("cloudreve://my/c.jpg")
+	credential, err = NewFileManager(dep, other).CreateUploadSession(otherCtx, &fs.UploadRequest{
+		Props: &fs.UploadProps{Uri: otherDst, Size: int64(len(content)), Checksum: sha256Hex(content)},
+	})
+	require.NoError(t, err)
+	a.False(credential.Completed)
+
+	// Mismatched size is not rapid uploaded
+	mismatchDst, _ := fs.NewUriFromString("cloudreve://my/d.jpg")
+	credential, err = fm.CreateUploadSession(ctx, &fs.UploadRequest{
+		Props: &fs.UploadProps{Uri: mismatchDst, Size: int64(len(content)) + 1, Checksum: sha256Hex(content)},
+	})
+	require.NoError(t, err)
+	a.False(credential.Completed)
+}
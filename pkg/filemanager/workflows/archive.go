@@ -126,12 +126,25 @@ func (m *CreateArchiveTask) Do(ctx context.Context) (task.Status, error) {
 	m.state = state
 
 	// select node
-	node, err := allocateNode(ctx, dep, &m.state.NodeState, types.NodeCapabilityCreateArchive)
+	node, previous, err := allocateNode(ctx, dep, &m.state.NodeState, types.NodeCapabilityCreateArchive)
 	if err != nil {
-		return task.StatusError, fmt.Errorf("failed to allocate node: %w", err)
+		return allocateNodeFailed(m, m.l, err)
 	}
 	m.node = node
 
+	if previous != 0 {
+		if m.state.SlaveCompressState != nil && m.state.SlaveCompressState.TempPath != "" {
+			cleanupPreviousNode(ctx, dep, m.l, previous, func(ctx context.Context, node cluster.Node) error {
+				return node.CleanupFolders(ctx, m.state.SlaveCompressState.TempPath)
+			})
+		}
+
+		m.state.Phase = CreateArchiveTaskPhaseNotStarted
+		m.state.SlaveArchiveTaskID = 0
+		m.state.SlaveUploadTaskID = 0
+		m.state.SlaveCompressState = nil
+	}
+
 	next := task.StatusCompleted
 
 	if m.node.IsMaster() {
@@ -495,6 +508,40 @@ func (m *CreateArchiveTask) Progress(ctx context.Context) queue.Progresses {
 	return m.progress
 }
 
+// ChainVars returns source files and the created archive file.
+func (m *CreateArchiveTask) ChainVars() *types.TaskChainVars {
+	if m.state == nil {
+		if err := json.Unmarshal([]byte(m.State()), &m.state); err != nil {
+			return nil
+		}
+	}
+
+	return &types.TaskChainVars{
+		Inputs:  m.state.Uris,
+		Outputs: []string{m.state.Dst},
+	}
+}
+
+// Priority implements queue.PrioritizedTask. Awaiting slave tasks only polls the node and runs first,
+// uploading is prioritized by the size of compressed archive.
+func (m *CreateArchiveTask) Priority() int {
+	state := &CreateArchiveTaskState{}
+	if err := json.Unmarshal([]byte(m.State()), state); err != nil {
+		return queue.PriorityNormal
+	}
+
+	switch state.Phase {
+	case CreateArchiveTaskPhaseAwaitSlaveCompressing, CreateArchiveTaskPhaseCreateAndAwaitSlaveUploading:
+		return queue.PriorityHigh
+	}
+
+	if state.SlaveCompressState != nil {
+		return sizePriority(state.SlaveCompressState.CompressedSize)
+	}
+
+	return queue.PriorityNormal
+}
+
 func (m *CreateArchiveTask) Summarize(hasher hashid.Encoder) *queue.Summary {
 	// unmarshal state
 	if m.state == nil {
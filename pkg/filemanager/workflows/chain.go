@@ -0,0 +1,196 @@
+package workflows
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/task"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
+	"github.com/samber/lo"
+)
+
+// maxChainSteps limits number of follow-up steps of one task.
+const maxChainSteps = 10
+
+func init() {
+	queue.RegisterTaskDoneHook(onChainedTaskDone)
+}
+
+// ValidateTaskChain checks follow-up steps against permissions of given user.
+func ValidateTaskChain(u *ent.User, steps []types.TaskChainStep) error {
+	if len(steps) > maxChainSteps {
+		return fmt.Errorf("too many follow-up steps, max %d", maxChainSteps)
+	}
+
+	for i, step := range steps {
+		switch step.Action {
+		case types.TaskChainActionExtract, types.TaskChainActionArchive:
+			if !u.Edges.Group.Permissions.Enabled(int(types.GroupPermissionArchiveTask)) {
+				return fmt.Errorf("step %d: group not allowed to create archive tasks", i)
+			}
+
+			if step.Dst == "" {
+				return fmt.Errorf("step %d: destination is required", i)
+			}
+		case types.TaskChainActionDelete:
+		default:
+			return fmt.Errorf("step %d: unknown action %q", i, step.Action)
+		}
+
+		if len(step.Src) == 0 {
+			return fmt.Errorf("step %d: source is required", i)
+		}
+	}
+
+	return nil
+}
+
+// onChainedTaskDone starts follow-up steps of a completed task.
+func onChainedTaskDone(ctx context.Context, t queue.Task, status task.Status) {
+	steps := t.Chain()
+	if status != task.StatusCompleted || len(steps) == 0 {
+		return
+	}
+
+	dep, ok := ctx.Value(dependency.DepCtx{}).(dependency.Dep)
+	if !ok {
+		return
+	}
+
+	l := logging.FromContext(ctx)
+	chainable, ok := t.(queue.ChainableTask)
+	if !ok {
+		l.Warning("Task type %q does not support follow-up steps, chain dropped.", t.Type())
+		return
+	}
+
+	vars := chainable.ChainVars()
+	if vars == nil {
+		l.Warning("Failed to get results of task %d, chain dropped.", t.ID())
+		return
+	}
+
+	if err := RunTaskChain(ctx, dep, t.Owner(), steps, vars); err != nil {
+		l.Warning("Failed to run follow-up steps of task %d: %s", t.ID(), err)
+	}
+}
+
+// RunTaskChain runs follow-up steps as given user. Steps are run one by one until a step creates
+// a task, remaining steps are attached to that task and run after it completes.
+func RunTaskChain(ctx context.Context, dep dependency.Dep, owner *ent.User, steps []types.TaskChainStep, vars *types.TaskChainVars) error {
+	if owner == nil {
+		return errors.New("task owner not found")
+	}
+
+	// Permissions might have changed since the chain is created.
+	u, err := dep.UserClient().GetLoginUserByID(ctx, owner.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get owner: %w", err)
+	}
+
+	if err := ValidateTaskChain(u, steps); err != nil {
+		return err
+	}
+
+	ctx = context.WithValue(ctx, inventory.UserCtx{}, u)
+	for len(steps) > 0 {
+		step, rest := steps[0], steps[1:]
+		src := expandChainSrc(step.Src, vars)
+		if len(src) == 0 {
+			return fmt.Errorf("step %q: no source files", step.Action)
+		}
+		dst := renderChainValue(step.Dst, vars)
+
+		var t queue.Task
+		switch step.Action {
+		case types.TaskChainActionDelete:
+			uris, err := fs.NewUriFromStrings(src...)
+			if err != nil {
+				return fmt.Errorf("step %q: invalid source: %w", step.Action, err)
+			}
+
+			m := manager.NewFileManager(dep, u)
+			err = m.Delete(ctx, uris)
+			m.Recycle()
+			if err != nil {
+				return fmt.Errorf("step %q: failed to delete files: %w", step.Action, err)
+			}
+
+			vars = &types.TaskChainVars{Inputs: src}
+			steps = rest
+			continue
+		case types.TaskChainActionExtract:
+			t, err = NewExtractArchiveTask(ctx, src[0], dst, step.Encoding, step.Password, step.FileMask)
+		case types.TaskChainActionArchive:
+			t, err = NewCreateArchiveTask(ctx, src, dst)
+		}
+
+		if err != nil {
+			return fmt.Errorf("step %q: failed to create task: %w", step.Action, err)
+		}
+
+		t.SetChain(rest)
+		if err := dep.IoIntenseQueue(ctx).QueueTask(ctx, t); err != nil {
+			return fmt.Errorf("step %q: failed to queue task: %w", step.Action, err)
+		}
+
+		return nil
+	}
+
+	return nil
+}
+
+// expandChainSrc renders source URIs of a step, {inputs} and {outputs} entries expand to all
+// input and output URIs of previous step.
+func expandChainSrc(src []string, vars *types.TaskChainVars) []string {
+	res := make([]string, 0, len(src))
+	for _, s := range src {
+		switch s {
+		case "{inputs}":
+			res = append(res, vars.Inputs...)
+		case "{outputs}":
+			res = append(res, vars.Outputs...)
+		default:
+			if rendered := renderChainValue(s, vars); rendered != "" {
+				res = append(res, rendered)
+			}
+		}
+	}
+
+	return lo.Uniq(res)
+}
+
+func renderChainValue(s string, vars *types.TaskChainVars) string {
+	if !strings.Contains(s, "{") {
+		return s
+	}
+
+	input, output, outputDir, outputName := "", "", "", ""
+	if len(vars.Inputs) > 0 {
+		input = vars.Inputs[0]
+	}
+	if len(vars.Outputs) > 0 {
+		output = vars.Outputs[0]
+	}
+	if uri, err := fs.NewUriFromString(output); err == nil && output != "" {
+		// Trailing slash of root folder is trimmed, so that "{output_dir}/name" is always valid.
+		outputDir = strings.TrimSuffix(uri.DirUri().String(), "/")
+		outputName = uri.Name()
+	}
+
+	return strings.NewReplacer(
+		"{input}", input,
+		"{output}", output,
+		"{output_dir}", outputDir,
+		"{output_name}", outputName,
+	).Replace(s)
+}
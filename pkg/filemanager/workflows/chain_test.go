@@ -0,0 +1,120 @@
+package workflows
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency/deptest"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	enttask "github.com/cloudreve/Cloudreve/v4/ent/task"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateTaskChain(t *testing.T) {
+	a := assert.New(t)
+	dep := deptest.New(t)
+	admin := deptest.NewUser(t, dep, "admin@cloudreve.org", 1)
+	u := deptest.NewUser(t, dep, "u@cloudreve.org", 2)
+
+	archive := types.TaskChainStep{Action: types.TaskChainActionArchive, Src: []string{"{outputs}"}, Dst: "cloudreve://my/a.zip"}
+	del := types.TaskChainStep{Action: types.TaskChainActionDelete, Src: []string{"{inputs}"}}
+	a.NoError(ValidateTaskChain(admin, nil))
+	a.NoError(ValidateTaskChain(admin, []types.TaskChainStep{archive, del}))
+	a.NoError(ValidateTaskChain(u, []types.TaskChainStep{del}))
+	a.Error(ValidateTaskChain(u, []types.TaskChainStep{del, archive}), "group not allowed to create archive tasks")
+	a.Error(ValidateTaskChain(admin, make([]types.TaskChainStep, maxChainSteps+1)))
+	for _, step := range []types.TaskChainStep{
+		{Action: "unknown", Src: []string{"{inputs}"}},
+		{Action: types.TaskChainActionExtract, Src: []string{"{output}"}},
+		{Action: types.TaskChainActionDelete},
+	} {
+		a.Error(ValidateTaskChain(admin, []types.TaskChainStep{step}), "step %+v", step)
+	}
+}
+
+func TestChainTemplating(t *testing.T) {
+	a := assert.New(t)
+	vars := &types.TaskChainVars{
+		Inputs:  []string{"cloudreve://my/a.txt", "cloudreve://my/b.txt"},
+		Outputs: []string{"cloudreve://my/dir/archive.zip"},
+	}
+
+	a.Equal("cloudreve://my/dir/archive.zip", renderChainValue("{output}", vars))
+	a.Equal("cloudreve://my/dir/extracted", renderChainValue("{output_dir}/extracted", vars))
+	a.Equal("cloudreve://my/backup/archive.zip", renderChainValue("cloudreve://my/backup/{output_name}", vars))
+	a.Equal("cloudreve://my/a.txt", renderChainValue("{input}", vars))
+	a.Equal("cloudreve://my/plain", renderChainValue("cloudreve://my/plain", vars))
+	a.Equal("", renderChainValue("{output}", &types.TaskChainVars{}))
+	a.Equal("cloudreve://my/extracted", renderChainValue("{output_dir}/extracted", &types.TaskChainVars{
+		Outputs: []string{"cloudreve://my/archive.zip"},
+	}))
+
+	a.Equal([]string{"cloudreve://my/a.txt", "cloudreve://my/b.txt", "cloudreve://my/dir/archive.zip"},
+		expandChainSrc([]string{"{inputs}", "{input}", "{outputs}"}, vars))
+	a.Empty(expandChainSrc([]string{"{outputs}", "{output}"}, &types.TaskChainVars{}))
+}
+
+func TestTaskChain(t *testing.T) {
+	a := assert.New(t)
+	dep := deptest.New(t)
+	admin := deptest.NewUser(t, dep, "admin@cloudreve.org", 1)
+	ctx := deptest.Context(dep, admin)
+	fm := manager.NewFileManager(dep, admin)
+	defer fm.Recycle()
+	uploadEntity(t, ctx, dep, fm, "cloudreve://my/a.txt", "a")
+	uploadEntity(t, ctx, dep, fm, "cloudreve://my/a.zip", "zip")
+
+	steps := []types.TaskChainStep{
+		{Action: types.TaskChainActionArchive, Src: []string{"{output}"}, Dst: "{output_dir}/backup/{output_name}"},
+		{Action: types.TaskChainActionDelete, Src: []string{"{inputs}"}},
+	}
+	archive, err := NewCreateArchiveTask(ctx, []string{"cloudreve://my/a.txt"}, "cloudreve://my/a.zip")
+	require.NoError(t, err)
+	archive.SetChain(steps)
+	require.NoError(t, dep.IoIntenseQueue(ctx).QueueTask(ctx, archive))
+
+	// Follow-up steps survive restarts as part of the persisted task.
+	reload := func(id int) queue.Task {
+		model, err := dep.TaskClient().GetTaskByID(context.WithValue(ctx, inventory.LoadTaskUser{}, true), id)
+		require.NoError(t, err)
+		reloaded, err := queue.NewTaskFromModel(model)
+		require.NoError(t, err)
+		return reloaded
+	}
+	reloaded := reload(archive.ID())
+	a.Equal(steps, reloaded.Chain())
+
+	// Nothing is dispatched for failed tasks.
+	onChainedTaskDone(ctx, reloaded, enttask.StatusError)
+	archives := func() []*ent.Task {
+		return dep.DBClient().Task.Query().Where(enttask.Type(queue.CreateArchiveTaskType)).AllX(context.Background())
+	}
+	a.Len(archives(), 1)
+
+	// First step creates a task, remaining steps are attached to it.
+	onChainedTaskDone(ctx, reloaded, enttask.StatusCompleted)
+	queued := archives()
+	require.Len(t, queued, 2)
+	next := reload(queued[1].ID)
+	state := &CreateArchiveTaskState{}
+	require.NoError(t, json.Unmarshal([]byte(next.State()), state))
+	a.Equal([]string{"cloudreve://my/a.zip"}, state.Uris)
+	a.Equal("cloudreve://my/backup/a.zip", state.Dst)
+	a.Equal(steps[1:], next.Chain())
+
+	// Steps without task run right away.
+	onChainedTaskDone(ctx, next, enttask.StatusCompleted)
+	_, err = fm.Get(ctx, lo.Must(fs.NewUriFromString("cloudreve://my/a.zip")))
+	a.ErrorContains(err, fs.ErrPathNotExist.Msg)
+	_, err = fm.Get(ctx, lo.Must(fs.NewUriFromString("cloudreve://my/a.txt")))
+	a.NoError(err)
+	a.Len(archives(), 2)
+}
@@ -3,6 +3,7 @@ package workflows
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -49,6 +50,7 @@ type (
 		SlaveTaskID     int      `json:"slave_task_id,omitempty"`
 		Password        string   `json:"password,omitempty"`
 		FileMask        []string `json:"file_mask,omitempty"`
+		ArchiveSize     int64    `json:"archive_size,omitempty"`
 		NodeState       `json:",inline"`
 		Phase           ExtractArchiveTaskPhase `json:"phase,omitempty"`
 	}
@@ -127,12 +129,17 @@ func (m *ExtractArchiveTask) Do(ctx context.Context) (task.Status, error) {
 	m.state = state
 
 	// select node
-	node, err := allocateNode(ctx, dep, &m.state.NodeState, types.NodeCapabilityExtractArchive)
+	node, previous, err := allocateNode(ctx, dep, &m.state.NodeState, types.NodeCapabilityExtractArchive)
 	if err != nil {
-		return task.StatusError, fmt.Errorf("failed to allocate node: %w", err)
+		return allocateNodeFailed(m, m.l, err)
 	}
 	m.node = node
 
+	if previous != 0 {
+		m.state.Phase = ExtractArchivePhaseNotStarted
+		m.state.SlaveTaskID = 0
+	}
+
 	next := task.StatusCompleted
 
 	if node.IsMaster() {
@@ -186,6 +193,7 @@ func (m *ExtractArchiveTask) createSlaveExtractTask(ctx context.Context, dep dep
 		return task.StatusError,
 			fmt.Errorf("file size %d exceeds the limit %d (%w)", archiveFile.Size(), user.Edges.Group.Settings.DecompressSize, queue.CriticalErr)
 	}
+	m.state.ArchiveSize = archiveFile.Size()
 
 	// Create slave task
 	storagePolicyClient := dep.StoragePolicyClient()
@@ -279,6 +287,7 @@ func (m *ExtractArchiveTask) masterExtractArchive(ctx context.Context, dep depen
 		return task.StatusError,
 			fmt.Errorf("file size %d exceeds the limit %d (%w)", archiveFile.Size(), user.Edges.Group.Settings.DecompressSize, queue.CriticalErr)
 	}
+	m.state.ArchiveSize = archiveFile.Size()
 
 	es, err := fm.GetEntitySource(ctx, 0, fs.WithEntity(archiveFile.PrimaryEntity()))
 	if err != nil {
@@ -397,6 +406,9 @@ func (m *ExtractArchiveTask) masterExtractArchive(ctx context.Context, dep depen
 		if f.FileInfo.IsDir() {
 			_, err := fm.Create(ctx, savePath, types.FileTypeFolder)
 			if err != nil {
+				if errors.Is(err, fs.ErrInsufficientFileQuota) {
+					return fmt.Errorf("failed to create directory %q: %w", rawPath, err)
+				}
 				m.l.Warning("Failed to create directory %q: %s, skipping...", rawPath, err)
 			}
 
@@ -507,6 +519,35 @@ func (m *ExtractArchiveTask) masterDownloadZip(ctx context.Context, dep dependen
 	return task.StatusSuspending, nil
 }
 
+// ChainVars returns the archive file and the folder it is extracted to.
+func (m *ExtractArchiveTask) ChainVars() *types.TaskChainVars {
+	if m.state == nil {
+		if err := json.Unmarshal([]byte(m.State()), &m.state); err != nil {
+			return nil
+		}
+	}
+
+	return &types.TaskChainVars{
+		Inputs:  []string{m.state.Uri},
+		Outputs: []string{m.state.Dst},
+	}
+}
+
+// Priority implements queue.PrioritizedTask. Awaiting slave task only polls the node and runs first,
+// otherwise tasks are prioritized by the size of archive file once it is known.
+func (m *ExtractArchiveTask) Priority() int {
+	state := &ExtractArchiveTaskState{}
+	if err := json.Unmarshal([]byte(m.State()), state); err != nil {
+		return queue.PriorityNormal
+	}
+
+	if state.Phase == ExtractArchivePhaseAwaitSlaveComplete {
+		return queue.PriorityHigh
+	}
+
+	return sizePriority(state.ArchiveSize)
+}
+
 func (m *ExtractArchiveTask) Summarize(hasher hashid.Encoder) *queue.Summary {
 	if m.state == nil {
 		if err := json.Unmarshal([]byte(m.State()), &m.state); err != nil {
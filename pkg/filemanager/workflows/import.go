@@ -156,8 +156,12 @@ func (m *ImportTask) processImport(ctx context.Context, dep dependency.Dep) (tas
 		batchEnd := min(batchStart+ImportBatchSize, totalFiles)
 
 		batch := physicalFiles[batchStart:batchEnd]
-		batchFailed := m.processBatch(ctx, dep, user, dst, batch)
+		batchFailed, err := m.processBatch(ctx, dep, user, dst, batch)
 		failed += batchFailed
+		if err != nil {
+			m.state.Failed = failed
+			return task.StatusError, fmt.Errorf("import aborted: %s (%w)", err, queue.CriticalErr)
+		}
 
 		// Clear batch elements to allow GC of individual items
 		for i := batchStart; i < batchEnd; i++ {
@@ -176,8 +180,9 @@ func (m *ImportTask) processImport(ctx context.Context, dep dependency.Dep) (tas
 	return task.StatusCompleted, nil
 }
 
-// processBatch processes a batch of physical files with a fresh file manager.
-func (m *ImportTask) processBatch(ctx context.Context, dep dependency.Dep, user *ent.User, dst *fs.URI, batch []fs.PhysicalObject) int {
+// processBatch processes a batch of physical files with a fresh file manager. Error is returned if
+// the rest of import cannot continue, e.g. file count quota of destination user is exceeded.
+func (m *ImportTask) processBatch(ctx context.Context, dep dependency.Dep, user *ent.User, dst *fs.URI, batch []fs.PhysicalObject) (int, error) {
 	fm := manager.NewFileManager(dep, user)
 	defer fm.Recycle()
 
@@ -190,6 +195,9 @@ func (m *ImportTask) processBatch(ctx context.Context, dep dependency.Dep, user
 			if err != nil {
 				m.l.Warning("Failed to create folder %s: %s", physicalFile.RelativePath, err)
 				failed++
+				if errors.Is(err, fs.ErrInsufficientFileQuota) {
+					return failed, err
+				}
 			}
 		} else {
 			m.l.Info("Importing file %s", physicalFile.RelativePath)
@@ -203,11 +211,14 @@ func (m *ImportTask) processBatch(ctx context.Context, dep dependency.Dep, user
 				}
 				m.l.Error("Failed to import file %s: %s, skipping", physicalFile.RelativePath, err)
 				failed++
+				if errors.Is(err, fs.ErrInsufficientFileQuota) {
+					return failed, err
+				}
 			}
 		}
 	}
 
-	return failed
+	return failed, nil
 }
 
 func (m *ImportTask) Progress(ctx context.Context) queue.Progresses {
@@ -0,0 +1,226 @@
+package workflows
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/crontab"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/samber/lo"
+	"golang.org/x/tools/container/intsets"
+)
+
+const (
+	lifecyclePolicyPageSize = 50
+)
+
+func init() {
+	crontab.Register(setting.CronTypeLifecycle, CronEvaluateLifecycleRules)
+}
+
+// CronEvaluateLifecycleRules evaluates lifecycle rules of all storage policies. Transition rules are
+// carried out by MigratePolicyTask, expire rules delete matched files directly.
+func CronEvaluateLifecycleRules(ctx context.Context) {
+	dep := dependency.FromContext(ctx)
+	l := dep.Logger()
+
+	for page := 0; ; page++ {
+		res, err := dep.StoragePolicyClient().ListPolicies(ctx, &inventory.ListPolicyParameters{
+			PaginationArgs: &inventory.PaginationArgs{
+				Page:     page,
+				PageSize: lifecyclePolicyPageSize,
+			},
+		})
+		if err != nil {
+			l.Error("Failed to list storage policies: %s", err)
+			return
+		}
+
+		for _, policy := range res.Policies {
+			evaluatePolicyLifecycle(ctx, dep, l, policy)
+		}
+
+		if (page+1)*lifecyclePolicyPageSize >= res.TotalItems {
+			break
+		}
+	}
+}
+
+func evaluatePolicyLifecycle(ctx context.Context, dep dependency.Dep, l logging.Logger, policy *ent.StoragePolicy) {
+	if policy.Settings == nil {
+		return
+	}
+
+	for i := range policy.Settings.LifecycleRules {
+		rule := &policy.Settings.LifecycleRules[i]
+		if rule.AgeDays <= 0 {
+			l.Warning("Lifecycle rule #%d of storage policy %q has no valid age, skipped.", i, policy.Name)
+			continue
+		}
+
+		var err error
+		switch rule.Action {
+		case types.LifecycleActionTransition:
+			err = transitionPolicyEntities(ctx, dep, l, policy, rule)
+		case types.LifecycleActionExpire:
+			err = expirePolicyFiles(ctx, dep, l, policy, rule)
+		default:
+			err = fmt.Errorf("unknown action %q", rule.Action)
+		}
+
+		if err != nil {
+			l.Warning("Failed to evaluate lifecycle rule #%d of storage policy %q: %s", i, policy.Name, err)
+		}
+	}
+}
+
+// transitionPolicyEntities queues a MigratePolicyTask for entities matched by given rule, unless
+// a previous one is still in progress.
+func transitionPolicyEntities(ctx context.Context, dep dependency.Dep, l logging.Logger, policy *ent.StoragePolicy, rule *types.LifecycleRule) error {
+	if rule.TargetPolicyID == 0 || rule.TargetPolicyID == policy.ID {
+		return fmt.Errorf("invalid target policy %d", rule.TargetPolicyID)
+	}
+
+	if _, err := dep.StoragePolicyClient().GetPolicyByID(ctx, rule.TargetPolicyID); err != nil {
+		return fmt.Errorf("failed to get target policy %d: %w", rule.TargetPolicyID, err)
+	}
+
+	pending, err := dep.TaskClient().GetPendingTasks(ctx, queue.MigratePolicyTaskType)
+	if err != nil {
+		return fmt.Errorf("failed to get pending migration tasks: %w", err)
+	}
+
+	for _, t := range pending {
+		state := &MigratePolicyTaskState{}
+		if err := json.Unmarshal([]byte(t.PrivateState), state); err != nil {
+			continue
+		}
+
+		if state.SrcPolicyID == policy.ID && state.DstPolicyID == rule.TargetPolicyID {
+			l.Info("Migration task %d from storage policy %q is still in progress, skip transition.", t.ID, policy.Name)
+			return nil
+		}
+	}
+
+	before := time.Now().AddDate(0, 0, -rule.AgeDays)
+	filter := &inventory.PolicyEntityFilter{
+		CreatedBefore: &before,
+		MinSize:       rule.MinSize,
+		MaxSize:       rule.MaxSize,
+		Exts:          lifecycleExts(rule),
+	}
+
+	total, err := dep.FileClient().CountPolicyEntities(ctx, policy.ID, filter)
+	if err != nil {
+		return fmt.Errorf("failed to count matched entities: %w", err)
+	}
+
+	if total == 0 {
+		return nil
+	}
+
+	t, err := NewMigratePolicyTask(ctx, inventory.UserFromContext(ctx), policy.ID, rule.TargetPolicyID, 0, 0, filter)
+	if err != nil {
+		return fmt.Errorf("failed to create migration task: %w", err)
+	}
+
+	if err := dep.IoIntenseQueue(ctx).QueueTask(ctx, t); err != nil {
+		return fmt.Errorf("failed to queue migration task: %w", err)
+	}
+
+	l.Info("%d entities in storage policy %q matched lifecycle rule, transition to policy %d queued.", total, policy.Name, rule.TargetPolicyID)
+	return nil
+}
+
+// expirePolicyFiles deletes files stored in given policy under the path prefix of each user's `my` file system.
+func expirePolicyFiles(ctx context.Context, dep dependency.Dep, l logging.Logger, policy *ent.StoragePolicy, rule *types.LifecycleRule) error {
+	elements := lo.Filter(strings.Split(rule.PathPrefix, fs.Separator), func(item string, index int) bool {
+		return item != ""
+	})
+	if len(elements) == 0 {
+		return fmt.Errorf("path prefix is required for expire action")
+	}
+
+	folders, err := dep.FileClient().ListFoldersByPath(ctx, policy.ID, elements)
+	if err != nil {
+		return fmt.Errorf("failed to list folders under %q: %w", rule.PathPrefix, err)
+	}
+
+	before := time.Now().AddDate(0, 0, -rule.AgeDays)
+	uc := dep.UserClient()
+	for _, folder := range folders {
+		userCtx := context.WithValue(ctx, inventory.LoadUserGroup{}, true)
+		user, err := uc.GetByID(userCtx, folder.OwnerID)
+		if err != nil {
+			l.Warning("Failed to get user %d: %s", folder.OwnerID, err)
+			continue
+		}
+
+		userCtx = context.WithValue(userCtx, inventory.UserCtx{}, user)
+		if err := expireUserFiles(userCtx, dep, l, user, policy, rule, elements, before); err != nil {
+			l.Warning("Failed to expire files for user %d: %s", user.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func expireUserFiles(ctx context.Context, dep dependency.Dep, l logging.Logger, user *ent.User, policy *ent.StoragePolicy,
+	rule *types.LifecycleRule, elements []string, before time.Time) error {
+	root, err := fs.NewUriFromString(fs.NewMyUri(hashid.EncodeUserID(dep.HashIDEncoder(), user.ID)))
+	if err != nil {
+		return fmt.Errorf("failed to parse root uri: %w", err)
+	}
+
+	fm := manager.NewFileManager(dep, user)
+	defer fm.Recycle()
+
+	exts := lifecycleExts(rule)
+	expired := make([]*fs.URI, 0)
+	if err := fm.Walk(ctx, root.Join(elements...), intsets.MaxInt, func(f fs.File, level int) error {
+		if f.Type() != types.FileTypeFile || f.PolicyID() != policy.ID || !f.CreatedAt().Before(before) {
+			return nil
+		}
+
+		if (rule.MinSize > 0 && f.Size() < rule.MinSize) || (rule.MaxSize > 0 && f.Size() > rule.MaxSize) {
+			return nil
+		}
+
+		if len(exts) > 0 && !lo.Contains(exts, f.Ext()) {
+			return nil
+		}
+
+		expired = append(expired, f.Uri(false))
+		return nil
+	}); err != nil {
+		// Walk might be interrupted by walked files limit, still expire files found so far.
+		l.Warning("Failed to walk %q for user %d: %s", rule.PathPrefix, user.ID, err)
+	}
+
+	if len(expired) == 0 {
+		return nil
+	}
+
+	l.Info("Expiring %d files in storage policy %q for user %d.", len(expired), policy.Name, user.ID)
+	return fm.Delete(ctx, expired, fs.WithSkipSoftDelete(true))
+}
+
+// lifecycleExts normalizes file extensions of given rule into lower case without leading dot.
+func lifecycleExts(rule *types.LifecycleRule) []string {
+	return lo.FilterMap(rule.Exts, func(ext string, index int) (string, bool) {
+		ext = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(ext), "."))
+		return ext, ext != ""
+	})
+}
@@ -0,0 +1,160 @@
+package workflows
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/application/dependency/deptest"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/entity"
+	"github.com/cloudreve/Cloudreve/v4/ent/file"
+	"github.com/cloudreve/Cloudreve/v4/ent/task"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// backdate moves creation time of given file and its entities days back.
+func backdate(t *testing.T, dep dependency.Dep, fileID int, days int) {
+	t.Helper()
+
+	ctx := context.Background()
+	created := time.Now().AddDate(0, 0, -days)
+	_, err := dep.DBClient().ExecContext(ctx, "UPDATE "+file.Table+" SET created_at = ? WHERE id = ?", created, fileID)
+	require.NoError(t, err)
+	f := dep.DBClient().File.GetX(ctx, fileID)
+	_, err = dep.DBClient().ExecContext(ctx, "UPDATE "+entity.Table+" SET created_at = ? WHERE id = ?", created, f.PrimaryEntity)
+	require.NoError(t, err)
+}
+
+func lifecyclePolicy(t *testing.T, dep dependency.Dep, rules ...types.LifecycleRule) *ent.StoragePolicy {
+	t.Helper()
+
+	policy, err := dep.DBClient().StoragePolicy.Get(context.Background(), 1)
+	require.NoError(t, err)
+	policy.Settings.LifecycleRules = rules
+	return policy
+}
+
+func TestLifecycleExts(t *testing.T) {
+	a := assert.New(t)
+	a.Equal([]string{"txt", "log"}, lifecycleExts(&types.LifecycleRule{Exts: []string{" .TXT", "log", ".", ""}}))
+	a.Empty(lifecycleExts(&types.LifecycleRule{}))
+}
+
+func TestLifecycleTransition(t *testing.T) {
+	a := assert.New(t)
+	dep := deptest.New(t)
+	admin := deptest.NewUser(t, dep, "admin@cloudreve.org", 1)
+	ctx := deptest.Context(dep, admin)
+	fm := manager.NewFileManager(dep, admin)
+	defer fm.Recycle()
+
+	cold, err := dep.StoragePolicyClient().Upsert(context.Background(), &ent.StoragePolicy{
+		Name:        "cold",
+		Type:        types.PolicyTypeLocal,
+		DirNameRule: "cold/{uid}/{path}",
+		Settings:    &types.PolicySetting{},
+	})
+	require.NoError(t, err)
+
+	old := uploadEntity(t, ctx, dep, fm, "cloudreve://my/old.txt", "old")
+	backdate(t, dep, dep.DBClient().File.Query().Where(file.PrimaryEntity(old.ID)).OnlyIDX(context.Background()), 30)
+	uploadEntity(t, ctx, dep, fm, "cloudreve://my/new.txt", "new")
+	migrations := func() []*ent.Task {
+		return dep.DBClient().Task.Query().Where(task.Type(queue.MigratePolicyTaskType)).AllX(context.Background())
+	}
+
+	// Rules not matching any entity queue nothing.
+	evaluatePolicyLifecycle(ctx, dep, dep.Logger(), lifecyclePolicy(t, dep,
+		types.LifecycleRule{Action: types.LifecycleActionTransition, AgeDays: 60, TargetPolicyID: cold.ID},
+		types.LifecycleRule{Action: types.LifecycleActionTransition, AgeDays: 7, Exts: []string{".log"}, TargetPolicyID: cold.ID},
+		types.LifecycleRule{Action: types.LifecycleActionTransition, AgeDays: 7, MinSize: 100, TargetPolicyID: cold.ID},
+	))
+	a.Empty(migrations())
+
+	rule := types.LifecycleRule{Action: types.LifecycleActionTransition, AgeDays: 7, Exts: []string{"TXT"}, TargetPolicyID: cold.ID}
+	evaluatePolicyLifecycle(ctx, dep, dep.Logger(), lifecyclePolicy(t, dep, rule))
+	queued := migrations()
+	require.Len(t, queued, 1)
+	state := &MigratePolicyTaskState{}
+	require.NoError(t, json.Unmarshal([]byte(queued[0].PrivateState), state))
+	a.Equal(1, state.SrcPolicyID)
+	a.Equal(cold.ID, state.DstPolicyID)
+	require.NotNil(t, state.Filter)
+	a.Equal([]string{"txt"}, state.Filter.Exts)
+	a.WithinDuration(time.Now().AddDate(0, 0, -7), *state.Filter.CreatedBefore, time.Minute)
+	count, err := dep.FileClient().CountPolicyEntities(context.Background(), 1, state.Filter)
+	require.NoError(t, err)
+	a.Equal(1, count)
+
+	// No new migration while the previous one is still pending.
+	evaluatePolicyLifecycle(ctx, dep, dep.Logger(), lifecyclePolicy(t, dep, rule))
+	a.Len(migrations(), 1)
+
+	// Transition to the same policy is rejected.
+	_, err = dep.DBClient().Task.Delete().Exec(context.Background())
+	require.NoError(t, err)
+	evaluatePolicyLifecycle(ctx, dep, dep.Logger(), lifecyclePolicy(t, dep,
+		types.LifecycleRule{Action: types.LifecycleActionTransition, AgeDays: 7, TargetPolicyID: 1}))
+	a.Empty(migrations())
+}
+
+func TestLifecycleExpire(t *testing.T) {
+	a := assert.New(t)
+	dep := deptest.New(t)
+	admin := deptest.NewUser(t, dep, "admin@cloudreve.org", 1)
+	u := deptest.NewUser(t, dep, "u@cloudreve.org", 2)
+	idle := deptest.NewUser(t, dep, "idle@cloudreve.org", 2)
+	ctx := deptest.Context(dep, admin)
+
+	upload := func(owner *ent.User, uri, content string, age int) {
+		fm := manager.NewFileManager(dep, owner)
+		defer fm.Recycle()
+		e := uploadEntity(t, deptest.Context(dep, owner), dep, fm, uri, content)
+		if age > 0 {
+			backdate(t, dep, dep.DBClient().File.Query().Where(file.PrimaryEntity(e.ID)).OnlyIDX(context.Background()), age)
+		}
+	}
+	upload(u, "cloudreve://my/tmp/old.txt", "old", 30)
+	upload(u, "cloudreve://my/tmp/sub/old.txt", "old", 30)
+	upload(u, "cloudreve://my/tmp/new.txt", "new", 0)
+	upload(u, "cloudreve://my/tmp/old.log", "old", 30)
+	upload(u, "cloudreve://my/keep/old.txt", "old", 30)
+
+	// Only users with files in the policy are looked into.
+	idleFm := manager.NewFileManager(dep, idle)
+	_, err := idleFm.Create(deptest.Context(dep, idle), lo.Must(fs.NewUriFromString("cloudreve://my/tmp")), types.FileTypeFolder)
+	require.NoError(t, err)
+	folders, err := dep.FileClient().ListFoldersByPath(context.Background(), 1, []string{"tmp"})
+	require.NoError(t, err)
+	a.Equal([]int{u.ID}, lo.Map(folders, func(f *ent.File, _ int) int { return f.OwnerID }))
+
+	evaluatePolicyLifecycle(ctx, dep, dep.Logger(), lifecyclePolicy(t, dep,
+		types.LifecycleRule{Action: types.LifecycleActionExpire, AgeDays: 7, Exts: []string{"txt"}, PathPrefix: "/tmp/"},
+		// Expire rules without path prefix are ignored.
+		types.LifecycleRule{Action: types.LifecycleActionExpire, AgeDays: 7},
+	))
+
+	names := func(uri string) []string {
+		fm := manager.NewFileManager(dep, u)
+		defer fm.Recycle()
+		_, res, err := fm.List(deptest.Context(dep, u), lo.Must(fs.NewUriFromString(uri)), &manager.ListArgs{PageSize: 50})
+		require.NoError(t, err)
+		return lo.Map(res.Files, func(f fs.File, _ int) string { return f.Name() })
+	}
+	a.ElementsMatch([]string{"new.txt", "old.log", "sub"}, names("cloudreve://my/tmp"))
+	a.Empty(names("cloudreve://my/tmp/sub"))
+	a.ElementsMatch([]string{"old.txt"}, names("cloudreve://my/keep"))
+
+	// Expired files are deleted permanently instead of being moved into trash bin.
+	a.Empty(names("cloudreve://trash"))
+	a.Equal(1, dep.DBClient().File.Query().Where(file.Name("old.txt"), file.OwnerID(u.ID)).CountX(context.Background()))
+}
@@ -0,0 +1,444 @@
+package workflows
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/task"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager/entitysource"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/juju/ratelimit"
+)
+
+type (
+	// MigratePolicyTask moves all entities from one storage policy to another. Each blob is copied
+	// into destination policy and verified before entity is repointed, old blob is deleted afterward.
+	MigratePolicyTask struct {
+		*queue.DBTask
+
+		l        logging.Logger
+		state    *MigratePolicyTaskState
+		progress queue.Progresses
+	}
+	MigratePolicyTaskPhase string
+	MigratePolicyTaskState struct {
+		SrcPolicyID  int                    `json:"src_policy_id"`
+		DstPolicyID  int                    `json:"dst_policy_id"`
+		SpeedLimit   int64                  `json:"speed_limit,omitempty"`
+		Interval     int                    `json:"interval,omitempty"`
+		Phase        MigratePolicyTaskPhase `json:"phase"`
+		Total        int                    `json:"total"`
+		Processed    int                    `json:"processed"`
+		Failed       int                    `json:"failed"`
+		LastEntityID int                    `json:"last_entity_id"`
+		// Filter limits entities to be migrated, all alive entities are migrated if nil.
+		Filter *inventory.PolicyEntityFilter `json:"filter,omitempty"`
+	}
+)
+
+const (
+	MigratePolicyPhaseCount   MigratePolicyTaskPhase = "count"
+	MigratePolicyPhaseMigrate MigratePolicyTaskPhase = "migrate"
+
+	MigratePolicyBatchSize = 20
+
+	ProgressTypeMigrated = "migrated"
+
+	SummaryKeySrcPolicyID = "src_policy_id"
+)
+
+func init() {
+	queue.RegisterResumableTaskFactory(queue.MigratePolicyTaskType, NewMigratePolicyTaskFromModel)
+}
+
+// NewMigratePolicyTask creates a new MigratePolicyTask. speedLimit is the max bytes per second to read from
+// source policy, interval is the seconds to wait between batches, 0 indicates no limit. filter is optional.
+func NewMigratePolicyTask(ctx context.Context, u *ent.User, src, dst int, speedLimit int64, interval int,
+	filter *inventory.PolicyEntityFilter) (queue.Task, error) {
+	state := &MigratePolicyTaskState{
+		SrcPolicyID: src,
+		DstPolicyID: dst,
+		SpeedLimit:  speedLimit,
+		Interval:    interval,
+		Phase:       MigratePolicyPhaseCount,
+		Filter:      filter,
+	}
+	stateBytes, err := json.Marshal(state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal state: %w", err)
+	}
+
+	return &MigratePolicyTask{
+		DBTask: &queue.DBTask{
+			Task: &ent.Task{
+				Type:          queue.MigratePolicyTaskType,
+				CorrelationID: logging.CorrelationID(ctx),
+				PrivateState:  string(stateBytes),
+				PublicState:   &types.TaskPublicState{},
+			},
+			DirectOwner: u,
+		},
+	}, nil
+}
+
+func NewMigratePolicyTaskFromModel(t *ent.Task) queue.Task {
+	return &MigratePolicyTask{
+		DBTask: &queue.DBTask{
+			Task: t,
+		},
+	}
+}
+
+func (m *MigratePolicyTask) Do(ctx context.Context) (task.Status, error) {
+	dep := dependency.FromContext(ctx)
+	m.l = dep.Logger()
+
+	m.Lock()
+	if m.progress == nil {
+		m.progress = make(queue.Progresses)
+	}
+	m.progress[ProgressTypeMigrated] = &queue.Progress{}
+	m.Unlock()
+
+	state := &MigratePolicyTaskState{}
+	if err := json.Unmarshal([]byte(m.State()), state); err != nil {
+		return task.StatusError, fmt.Errorf("failed to unmarshal state: %s (%w)", err, queue.CriticalErr)
+	}
+	m.state = state
+
+	var (
+		next = task.StatusCompleted
+		err  error
+	)
+	switch m.state.Phase {
+	case MigratePolicyPhaseCount, "":
+		next, err = m.count(ctx, dep)
+	case MigratePolicyPhaseMigrate:
+		next, err = m.migrate(ctx, dep)
+	default:
+		next, err = task.StatusError, fmt.Errorf("unknown phase %q: %w", m.state.Phase, queue.CriticalErr)
+	}
+
+	newStateStr, marshalErr := json.Marshal(m.state)
+	if marshalErr != nil {
+		return task.StatusError, fmt.Errorf("failed to marshal state: %w", marshalErr)
+	}
+
+	m.Lock()
+	m.Task.PrivateState = string(newStateStr)
+	m.Unlock()
+	return next, err
+}
+
+// count counts total entities to be migrated for progress tracking.
+func (m *MigratePolicyTask) count(ctx context.Context, dep dependency.Dep) (task.Status, error) {
+	total, err := dep.FileClient().CountPolicyEntities(ctx, m.state.SrcPolicyID, m.state.Filter)
+	if err != nil {
+		return task.StatusError, fmt.Errorf("failed to count entities: %w", err)
+	}
+
+	m.state.Total = total
+	m.state.Phase = MigratePolicyPhaseMigrate
+	m.state.LastEntityID = 0
+	m.state.Processed = 0
+
+	m.l.Info("Found %d entities in storage policy %d, start migrating to %d...", total, m.state.SrcPolicyID, m.state.DstPolicyID)
+	m.ResumeAfter(0)
+	return task.StatusSuspending, nil
+}
+
+// migrate processes a batch of entities and suspends for the next batch.
+func (m *MigratePolicyTask) migrate(ctx context.Context, dep dependency.Dep) (task.Status, error) {
+	atomic.StoreInt64(&m.progress[ProgressTypeMigrated].Total, int64(m.state.Total))
+	atomic.StoreInt64(&m.progress[ProgressTypeMigrated].Current, int64(m.state.Processed))
+
+	policyClient := dep.StoragePolicyClient()
+	srcPolicy, err := policyClient.GetPolicyByID(ctx, m.state.SrcPolicyID)
+	if err != nil {
+		return task.StatusError, fmt.Errorf("failed to get source policy: %s (%w)", err, queue.CriticalErr)
+	}
+
+	dstPolicy, err := policyClient.GetPolicyByID(ctx, m.state.DstPolicyID)
+	if err != nil {
+		return task.StatusError, fmt.Errorf("failed to get destination policy: %s (%w)", err, queue.CriticalErr)
+	}
+
+	entities, err := dep.FileClient().ListPolicyEntities(ctx, m.state.SrcPolicyID, m.state.LastEntityID, MigratePolicyBatchSize, m.state.Filter)
+	if err != nil {
+		return task.StatusError, fmt.Errorf("failed to list entities after ID %d: %w", m.state.LastEntityID, err)
+	}
+
+	if len(entities) == 0 {
+		m.l.Info("Migration complete. %d entities processed, %d failed.", m.state.Processed, m.state.Failed)
+		return task.StatusCompleted, nil
+	}
+
+	fm := manager.NewFileManager(dep, inventory.UserFromContext(ctx))
+	defer fm.Recycle()
+
+	srcHandler, err := fm.GetStorageDriver(ctx, srcPolicy)
+	if err != nil {
+		return task.StatusError, fmt.Errorf("failed to get source storage driver: %w", err)
+	}
+
+	dstHandler, err := fm.GetStorageDriver(ctx, dstPolicy)
+	if err != nil {
+		return task.StatusError, fmt.Errorf("failed to get destination storage driver: %w", err)
+	}
+
+	for _, e := range entities {
+		select {
+		case <-ctx.Done():
+			return task.StatusError, ctx.Err()
+		default:
+		}
+
+		if err := m.migrateEntity(ctx, dep, fm, srcPolicy, dstPolicy, srcHandler, dstHandler, e); err != nil {
+			m.l.Warning("Failed to migrate entity %d: %s", e.ID, err)
+			m.state.Failed++
+		}
+
+		m.state.Processed++
+		m.state.LastEntityID = e.ID
+		atomic.StoreInt64(&m.progress[ProgressTypeMigrated].Current, int64(m.state.Processed))
+	}
+
+	// Suspend and resume for next batch
+	m.ResumeAfter(time.Duration(m.state.Interval) * time.Second)
+	return task.StatusSuspending, nil
+}
+
+// migrateEntity copies blob of a single entity into destination policy, verifies it, repoints
+// the entity and finally deletes the old blob if no other entity is still using it.
+func (m *MigratePolicyTask) migrateEntity(ctx context.Context, dep dependency.Dep, fm manager.FileManager,
+	srcPolicy, dstPolicy *ent.StoragePolicy, srcHandler, dstHandler driver.Handler, e *ent.Entity) error {
+	if samePhysicalStorage(srcPolicy, dstPolicy) {
+		// Blob is already accessible from destination policy, only database record need to be updated.
+		if err := dep.FileClient().MigrateEntity(ctx, e, dstPolicy.ID, e.Source); err != nil && !errors.Is(err, inventory.ErrEntityChanged) {
+			return err
+		}
+
+		return nil
+	}
+
+	dstSource, err := destinationSource(srcPolicy, dstPolicy, e.Source)
+	if err != nil {
+		return err
+	}
+
+	if err := m.copyEntity(ctx, fm, dstHandler, e, dstSource); err != nil {
+		return err
+	}
+
+	dstEntity := *e
+	dstEntity.StoragePolicyEntities = dstPolicy.ID
+	dstEntity.Source = dstSource
+	if err := m.verifyEntity(ctx, fm, &dstEntity); err != nil {
+		m.deleteBlob(ctx, dstHandler, dstSource)
+		return fmt.Errorf("failed to verify copied blob: %w", err)
+	}
+
+	if err := dep.FileClient().MigrateEntity(ctx, e, dstPolicy.ID, dstSource); err != nil {
+		m.deleteBlob(ctx, dstHandler, dstSource)
+		if errors.Is(err, inventory.ErrEntityChanged) {
+			m.l.Debug("Entity %d changed during migration, skipped.", e.ID)
+			return nil
+		}
+
+		return err
+	}
+
+	// Deduplicated or not yet recycled entities might still point to the same blob in source policy.
+	shared, err := dep.FileClient().IsEntitySourceShared(ctx, e)
+	if err != nil {
+		m.l.Warning("Failed to check references of blob %q, old blob is kept: %s", e.Source, err)
+		return nil
+	}
+
+	if shared {
+		m.l.Debug("Blob %q is still used by other entities, old blob is kept.", e.Source)
+		return nil
+	}
+
+	m.deleteBlob(ctx, srcHandler, e.Source)
+	return nil
+}
+
+func (m *MigratePolicyTask) copyEntity(ctx context.Context, fm manager.FileManager, dstHandler driver.Handler, e *ent.Entity, dstSource string) error {
+	source, err := fm.GetEntitySource(ctx, 0, fs.WithEntity(fs.NewEntity(e)))
+	if err != nil {
+		return fmt.Errorf("failed to get entity source: %w", err)
+	}
+	defer source.Close()
+
+	// Blob should be copied as is, encrypted entity keeps its encrypt metadata.
+	source.Apply(entitysource.WithDisableCryptor())
+
+	req := &fs.UploadRequest{
+		Props: &fs.UploadProps{
+			Size:     e.Size,
+			SavePath: dstSource,
+		},
+		Mode:   fs.ModeOverwrite,
+		File:   source,
+		Seeker: source,
+	}
+
+	if m.state.SpeedLimit > 0 {
+		bucket := ratelimit.NewBucketWithRate(float64(m.state.SpeedLimit), m.state.SpeedLimit)
+		req.File = io.NopCloser(ratelimit.Reader(source, bucket))
+		req.Seeker = nil
+	}
+
+	if err := dstHandler.Put(ctx, req); err != nil {
+		return fmt.Errorf("failed to put blob into destination policy: %w", err)
+	}
+
+	return nil
+}
+
+// verifyEntity re-reads blob from the destination policy, compares its size and checksum (if recorded).
+func (m *MigratePolicyTask) verifyEntity(ctx context.Context, fm manager.FileManager, e *ent.Entity) error {
+	source, err := fm.GetEntitySource(ctx, 0, fs.WithEntity(fs.NewEntity(e)))
+	if err != nil {
+		return fmt.Errorf("failed to get entity source: %w", err)
+	}
+	defer source.Close()
+
+	var hasher hash.Hash
+	dst := io.Discard
+	if e.Checksum != "" && (e.Props == nil || e.Props.EncryptMetadata == nil) {
+		hasher = sha256.New()
+		dst = hasher
+	} else {
+		source.Apply(entitysource.WithDisableCryptor())
+	}
+
+	read, err := io.Copy(dst, source)
+	if err != nil {
+		return fmt.Errorf("failed to read blob: %w", err)
+	}
+
+	if read != e.Size {
+		return fmt.Errorf("size mismatch, expected %d, got %d", e.Size, read)
+	}
+
+	if hasher != nil {
+		if checksum := hex.EncodeToString(hasher.Sum(nil)); checksum != e.Checksum {
+			return fmt.Errorf("checksum mismatch, expected %s, got %s", e.Checksum, checksum)
+		}
+	}
+
+	return nil
+}
+
+func (m *MigratePolicyTask) deleteBlob(ctx context.Context, handler driver.Handler, source string) {
+	if _, err := handler.Delete(ctx, source); err != nil {
+		m.l.Warning("Failed to delete blob %q: %s", source, err)
+	}
+}
+
+func (m *MigratePolicyTask) Progress(ctx context.Context) queue.Progresses {
+	m.Lock()
+	defer m.Unlock()
+	return m.progress
+}
+
+func (m *MigratePolicyTask) Summarize(hasher hashid.Encoder) *queue.Summary {
+	if m.state == nil {
+		if err := json.Unmarshal([]byte(m.State()), &m.state); err != nil {
+			return nil
+		}
+	}
+
+	return &queue.Summary{
+		Phase: string(m.state.Phase),
+		Props: map[string]any{
+			SummaryKeySrcPolicyID:    m.state.SrcPolicyID,
+			SummaryKeySrcDstPolicyID: m.state.DstPolicyID,
+			SummaryKeyTotal:          m.state.Total,
+			SummaryKeyFailed:         m.state.Failed,
+		},
+	}
+}
+
+// samePhysicalStorage returns true if blobs in both policies are stored in the same place, e.g. two
+// local policies sharing the same base directory.
+func samePhysicalStorage(a, b *ent.StoragePolicy) bool {
+	if a.Type != b.Type {
+		return false
+	}
+
+	switch a.Type {
+	case types.PolicyTypeLocal:
+		return util.RelativePath(filepath.FromSlash(policyBasePath(a))) == util.RelativePath(filepath.FromSlash(policyBasePath(b)))
+	case types.PolicyTypeRemote:
+		return a.NodeID == b.NodeID && policyBasePath(a) == policyBasePath(b)
+	case types.PolicyTypeSftp, types.PolicyTypeWebdav:
+		return a.Server == b.Server && a.AccessKey == b.AccessKey
+	case types.PolicyTypePlugin:
+		return a.Settings.PluginAddress == b.Settings.PluginAddress && a.Settings.PluginConfig == b.Settings.PluginConfig
+	default:
+		return a.BucketName != "" && a.Server == b.Server && a.BucketName == b.BucketName
+	}
+}
+
+// destinationSource returns the blob path in destination policy. Blobs of path based policies are moved
+// from base directory of source policy into the one of destination policy, otherwise the path is kept.
+func destinationSource(src, dst *ent.StoragePolicy, source string) (string, error) {
+	if !isPathBasedPolicy(src) || !isPathBasedPolicy(dst) {
+		return source, nil
+	}
+
+	srcBase, dstBase := policyBasePath(src), policyBasePath(dst)
+	if srcBase == dstBase {
+		return source, nil
+	}
+
+	rel := strings.TrimPrefix(source, strings.TrimSuffix(srcBase, "/")+"/")
+	if srcBase == "." && !path.IsAbs(source) {
+		rel = source
+	}
+
+	if rel == source && srcBase != "." {
+		return "", fmt.Errorf("blob %q is not located under base directory %q of source policy", source, srcBase)
+	}
+
+	return path.Join(dstBase, rel), nil
+}
+
+func isPathBasedPolicy(p *ent.StoragePolicy) bool {
+	return p.Type == types.PolicyTypeLocal || p.Type == types.PolicyTypeRemote
+}
+
+// policyBasePath returns the static leading directory of DirNameRule, e.g. "/data/uploads"
+// for "/data/uploads/{uid}/{path}".
+func policyBasePath(p *ent.StoragePolicy) string {
+	rule := filepath.ToSlash(p.DirNameRule)
+	if i := strings.Index(rule, "{"); i >= 0 {
+		rule = rule[:strings.LastIndex(rule[:i], "/")+1]
+	}
+
+	return path.Clean(rule)
+}
@@ -0,0 +1,47 @@
+package workflows
+
+import (
+	"testing"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSamePhysicalStorage(t *testing.T) {
+	a := assert.New(t)
+	local := func(rule string) *ent.StoragePolicy {
+		return &ent.StoragePolicy{Type: types.PolicyTypeLocal, DirNameRule: rule}
+	}
+
+	a.True(samePhysicalStorage(local("uploads/{uid}/{path}"), local("uploads/{date}")))
+	a.False(samePhysicalStorage(local("uploads/{uid}/{path}"), local("/mnt/disk2/{uid}/{path}")))
+	a.False(samePhysicalStorage(local("uploads/{uid}"), &ent.StoragePolicy{Type: types.PolicyTypeRemote, DirNameRule: "uploads/{uid}"}))
+	a.True(samePhysicalStorage(
+		&ent.StoragePolicy{Type: types.PolicyTypeS3, Server: "s3", BucketName: "b"},
+		&ent.StoragePolicy{Type: types.PolicyTypeS3, Server: "s3", BucketName: "b"},
+	))
+}
+
+func TestDestinationSource(t *testing.T) {
+	a := assert.New(t)
+	local := func(rule string) *ent.StoragePolicy {
+		return &ent.StoragePolicy{Type: types.PolicyTypeLocal, DirNameRule: rule}
+	}
+
+	res, err := destinationSource(local("/data/uploads/{uid}/{path}"), local("/mnt/disk2/{uid}"), "/data/uploads/1/a/b.txt")
+	a.NoError(err)
+	a.Equal("/mnt/disk2/1/a/b.txt", res)
+
+	res, err = destinationSource(local("{uid}/{path}"), local("/mnt/disk2/{uid}"), "1/b.txt")
+	a.NoError(err)
+	a.Equal("/mnt/disk2/1/b.txt", res)
+
+	_, err = destinationSource(local("/data/uploads/{uid}"), local("/mnt/disk2/{uid}"), "/elsewhere/1/b.txt")
+	a.Error(err)
+
+	s3 := &ent.StoragePolicy{Type: types.PolicyTypeS3}
+	res, err = destinationSource(local("/data/uploads/{uid}"), s3, "/data/uploads/1/b.txt")
+	a.NoError(err)
+	a.Equal("/data/uploads/1/b.txt", res)
+}
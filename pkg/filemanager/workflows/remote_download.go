@@ -20,6 +20,7 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
 	"github.com/cloudreve/Cloudreve/v4/pkg/cluster"
 	"github.com/cloudreve/Cloudreve/v4/pkg/downloader"
+	"github.com/cloudreve/Cloudreve/v4/pkg/downloader/native"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
 	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
@@ -128,15 +129,38 @@ func (m *RemoteDownloadTask) Do(ctx context.Context) (task.Status, error) {
 	m.state = state
 
 	// select node
-	node, err := allocateNode(ctx, dep, &m.state.NodeState, types.NodeCapabilityRemoteDownload)
+	node, previous, err := allocateNode(ctx, dep, &m.state.NodeState, types.NodeCapabilityRemoteDownload)
 	if err != nil {
-		return task.StatusError, fmt.Errorf("failed to allocate node: %w", err)
+		return allocateNodeFailed(m, m.l, err)
 	}
 	m.node = node
 
+	if previous != 0 {
+		// Cancel the download left on previous node in case it comes back, then start over on new node.
+		if m.state.Handle != nil {
+			cleanupPreviousNode(ctx, dep, m.l, previous, func(ctx context.Context, node cluster.Node) error {
+				d, err := node.CreateDownloader(ctx, dep.RequestClient(), dep.SettingProvider(), m.downloaderProvider())
+				if err != nil {
+					return err
+				}
+
+				return d.Cancel(ctx, m.state.Handle)
+			})
+		}
+
+		m.d = nil
+		m.state.Handle = nil
+		m.state.Status = nil
+		m.state.Phase = RemoteDownloadTaskPhaseNotStarted
+		m.state.SlaveUploadTaskID = 0
+		m.state.SlaveUploadState = nil
+		m.state.GetTaskStatusTried = 0
+		m.state.Transferred = nil
+	}
+
 	// create downloader instance
 	if m.d == nil {
-		d, err := node.CreateDownloader(ctx, dep.RequestClient(), dep.SettingProvider())
+		d, err := node.CreateDownloader(ctx, dep.RequestClient(), dep.SettingProvider(), m.downloaderProvider())
 		if err != nil {
 			return task.StatusError, fmt.Errorf("failed to create downloader: %w", err)
 		}
@@ -169,6 +193,16 @@ func (m *RemoteDownloadTask) Do(ctx context.Context) (task.Status, error) {
 	return next, err
 }
 
+// downloaderProvider returns the downloader provider overriding the one configured in node settings.
+func (m *RemoteDownloadTask) downloaderProvider() types.DownloaderProvider {
+	// Share links and WebDAV folders can only be pulled by the built-in downloader.
+	if native.IsListingSource(m.state.SrcUri) {
+		return types.DownloaderProviderNative
+	}
+
+	return ""
+}
+
 func (m *RemoteDownloadTask) createDownloadTask(ctx context.Context, dep dependency.Dep) (task.Status, error) {
 	if m.state.Handle != nil {
 		m.state.Phase = RemoteDownloadTaskPhaseMonitor
@@ -185,7 +219,7 @@ func (m *RemoteDownloadTask) createDownloadTask(ctx context.Context, dep depende
 	// resolution because that's a Cloudreve-internal entity URL pointing at
 	// the user's own torrent file.
 	if m.state.SrcUri != "" {
-		opt := buildSSRFOptions(ctx, dep, m.node.Settings(ctx))
+		opt := cluster.SSRFOptions(ctx, dep.SettingProvider(), m.node.Settings(ctx))
 		if err := request.ValidateExternalURL(ctx, m.state.SrcUri, opt); err != nil {
 			return task.StatusError, fmt.Errorf("url rejected: %s (%w)", err, queue.CriticalErr)
 		}
@@ -225,24 +259,6 @@ func (m *RemoteDownloadTask) createDownloadTask(ctx context.Context, dep depende
 	return task.StatusSuspending, nil
 }
 
-// buildSSRFOptions composes the SSRF policy for a download: the assigned
-// node's URLValidation settings, plus the operator-configured site URL hosts
-// (always allowlisted so users can fetch files served by Cloudreve itself).
-func buildSSRFOptions(ctx context.Context, dep dependency.Dep, node *types.NodeSetting) request.SSRFOptions {
-	opt := request.SSRFOptions{}
-	if node != nil && node.URLValidation != nil {
-		opt.Disabled = node.URLValidation.Disabled
-		opt.AllowedHosts = append(opt.AllowedHosts, node.URLValidation.AllowedHosts...)
-		opt.AllowedCIDRs = append(opt.AllowedCIDRs, node.URLValidation.AllowedCIDRs...)
-	}
-	for _, u := range dep.SettingProvider().AllSiteURLs(ctx) {
-		if h := u.Hostname(); h != "" {
-			opt.AllowedHosts = append(opt.AllowedHosts, h)
-		}
-	}
-	return opt
-}
-
 func (m *RemoteDownloadTask) monitor(ctx context.Context, dep dependency.Dep) (task.Status, error) {
 	resumeAfter := time.Duration(m.node.Settings(ctx).Interval) * time.Second
 
@@ -626,6 +642,51 @@ func (m *RemoteDownloadTask) CancelDownload(ctx context.Context) error {
 	return m.d.Cancel(ctx, m.state.Handle)
 }
 
+// Priority implements queue.PrioritizedTask. Monitoring only polls the downloader and runs first,
+// transferring is prioritized by the size of downloaded files.
+func (m *RemoteDownloadTask) Priority() int {
+	state := &RemoteDownloadTaskState{}
+	if err := json.Unmarshal([]byte(m.State()), state); err != nil {
+		return queue.PriorityNormal
+	}
+
+	switch state.Phase {
+	case RemoteDownloadTaskPhaseMonitor, RemoteDownloadTaskPhaseAwaitSeeding:
+		return queue.PriorityHigh
+	case RemoteDownloadTaskPhaseTransfer:
+		if state.Status != nil {
+			return sizePriority(state.Status.Total)
+		}
+	}
+
+	return queue.PriorityNormal
+}
+
+// ChainVars returns the download source and URIs of transferred files.
+func (m *RemoteDownloadTask) ChainVars() *types.TaskChainVars {
+	if m.state == nil {
+		if err := json.Unmarshal([]byte(m.State()), &m.state); err != nil {
+			return nil
+		}
+	}
+
+	vars := &types.TaskChainVars{Inputs: lo.Filter([]string{m.state.SrcUri, m.state.SrcFileUri}, func(s string, _ int) bool {
+		return s != ""
+	})}
+	dstUri, err := fs.NewUriFromString(m.state.Dst)
+	if err != nil || m.state.Status == nil {
+		return vars
+	}
+
+	for _, f := range m.state.Status.Files {
+		if f.Selected {
+			vars.Outputs = append(vars.Outputs, dstUri.JoinRaw(sanitizeFileName(f.Name)).String())
+		}
+	}
+
+	return vars
+}
+
 func (m *RemoteDownloadTask) Summarize(hasher hashid.Encoder) *queue.Summary {
 	// unmarshal state
 	if m.state == nil {
@@ -0,0 +1,178 @@
+package workflows
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/schedule"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/crontab"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/gofrs/uuid"
+	"github.com/robfig/cron/v3"
+)
+
+const (
+	userSchedulePageSize = 100
+	// scheduleIntervalSamples number of consecutive runs checked against minimum interval.
+	scheduleIntervalSamples = 10
+)
+
+func init() {
+	crontab.Register(setting.CronTypeUserSchedule, CronRunUserSchedules)
+}
+
+// ParseScheduleSpec parses a cron expression of user schedule, and makes sure it does not run more
+// often than minInterval.
+func ParseScheduleSpec(spec string, minInterval time.Duration) (cron.Schedule, error) {
+	sched, err := cron.ParseStandard(spec)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cron expression: %w", err)
+	}
+
+	prev := sched.Next(time.Now())
+	if prev.IsZero() {
+		return nil, errors.New("cron expression never matches")
+	}
+
+	// Intervals of cron expressions might be irregular, so several runs are checked.
+	for i := 0; i < scheduleIntervalSamples; i++ {
+		next := sched.Next(prev)
+		if next.IsZero() {
+			break
+		}
+
+		if next.Sub(prev) < minInterval {
+			return nil, fmt.Errorf("schedule runs more often than every %s", minInterval)
+		}
+		prev = next
+	}
+
+	return sched, nil
+}
+
+// CronRunUserSchedules runs due schedules of users. Each due schedule is claimed in DB before
+// running, so that it runs only once when multiple instances share one database.
+func CronRunUserSchedules(ctx context.Context) {
+	dep := dependency.FromContext(ctx)
+	l := dep.Logger()
+	sc := dep.ScheduleClient()
+	settings := dep.SettingProvider().UserSchedule(ctx)
+
+	now := time.Now()
+	schedules, err := sc.ListDue(ctx, now, userSchedulePageSize)
+	if err != nil {
+		l.Error("Failed to list due schedules: %s", err)
+		return
+	}
+
+	for _, s := range schedules {
+		sched, err := cron.ParseStandard(s.Spec)
+		if err != nil {
+			l.Warning("Invalid cron expression %q of schedule %d, skipped: %s", s.Spec, s.ID, err)
+			continue
+		}
+
+		claimed, err := sc.Claim(ctx, s, now, sched.Next(now))
+		if err != nil {
+			l.Warning("Failed to claim schedule %d: %s", s.ID, err)
+			continue
+		}
+		if !claimed {
+			continue
+		}
+
+		args := &inventory.ScheduleRunArgs{ScheduleID: s.ID}
+		t, err := RunSchedule(ctx, dep, s, now)
+		if err != nil {
+			l.Warning("Failed to run schedule %d: %s", s.ID, err)
+			args.Error = err.Error()
+		} else {
+			args.TaskID = t.ID()
+		}
+
+		if err := sc.LogRun(ctx, args, settings.RunRetention); err != nil {
+			l.Warning("Failed to log run of schedule %d: %s", s.ID, err)
+		}
+	}
+}
+
+// RunSchedule creates and queues the task of given schedule as its owner, with owner's current permissions.
+func RunSchedule(ctx context.Context, dep dependency.Dep, s *ent.Schedule, now time.Time) (queue.Task, error) {
+	u, err := dep.UserClient().GetLoginUserByID(ctx, s.OwnerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get owner: %w", err)
+	}
+
+	cid := uuid.Must(uuid.NewV4())
+	l := dep.Logger().CopyWithPrefix(fmt.Sprintf("[Cid: %s Schedule: %d]", cid, s.ID))
+	ctx = dep.ForkWithLogger(ctx, l)
+	ctx = context.WithValue(ctx, logging.CorrelationIDCtx{}, cid)
+	ctx = context.WithValue(ctx, logging.LoggerCtx{}, l)
+	ctx = context.WithValue(ctx, inventory.UserCtx{}, u)
+
+	props := &types.ScheduleProps{}
+	if s.Props != nil {
+		props = s.Props
+	}
+	dst := util.ReplaceMagicVar(props.Dst, "/", true, false, now, u.ID, "", "", "")
+
+	var (
+		t queue.Task
+		q queue.Queue
+	)
+	switch s.Type {
+	case schedule.TypeArchive, schedule.TypeExtract:
+		if !u.Edges.Group.Permissions.Enabled(int(types.GroupPermissionArchiveTask)) {
+			return nil, errors.New("group not allowed to create archive tasks")
+		}
+
+		if len(props.Src) == 0 {
+			return nil, errors.New("no source files")
+		}
+
+		if s.Type == schedule.TypeArchive {
+			t, err = NewCreateArchiveTask(ctx, props.Src, dst)
+		} else {
+			t, err = NewExtractArchiveTask(ctx, props.Src[0], dst, props.Encoding, props.Password, props.FileMask)
+		}
+		q = dep.IoIntenseQueue(ctx)
+	case schedule.TypeDownload:
+		if !u.Edges.Group.Permissions.Enabled(int(types.GroupPermissionRemoteDownload)) {
+			return nil, errors.New("group not allowed to download files")
+		}
+
+		src := ""
+		if len(props.Src) > 0 {
+			src = props.Src[0]
+		}
+		t, err = NewRemoteDownloadTask(ctx, src, props.SrcFile, dst)
+		q = dep.RemoteDownloadQueue(ctx)
+	default:
+		return nil, fmt.Errorf("unknown schedule type %q", s.Type)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to create task: %w", err)
+	}
+
+	if err := ValidateTaskChain(u, props.Then); err != nil {
+		return nil, err
+	}
+
+	t.SetChain(props.Then)
+	if err := q.QueueTask(ctx, t); err != nil {
+		return nil, fmt.Errorf("failed to queue task: %w", err)
+	}
+
+	l.Info("Schedule %q queued task %d.", s.Name, t.ID())
+	return t, nil
+}
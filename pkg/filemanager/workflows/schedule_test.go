@@ -0,0 +1,154 @@
+package workflows
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency/deptest"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/schedule"
+	"github.com/cloudreve/Cloudreve/v4/ent/schedulerun"
+	"github.com/cloudreve/Cloudreve/v4/ent/task"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseScheduleSpec(t *testing.T) {
+	a := assert.New(t)
+	for _, tc := range []struct {
+		spec  string
+		valid bool
+	}{
+		{"0 * * * *", true},
+		{"30 2 * * 1", true},
+		{"@daily", true},
+		{"*/5 * * * *", false},
+		// Average interval is long enough, but two runs are only 5 minutes apart.
+		{"0,5 * * * *", false},
+		{"0 0 30 2 *", false},
+		{"invalid", false},
+		{"", false},
+	} {
+		_, err := ParseScheduleSpec(tc.spec, time.Hour)
+		a.Equal(tc.valid, err == nil, "spec %q: %v", tc.spec, err)
+	}
+}
+
+func TestCronRunUserSchedules(t *testing.T) {
+	a := assert.New(t)
+	dep := deptest.New(t)
+	admin := deptest.NewUser(t, dep, "admin@cloudreve.org", 1)
+	u := deptest.NewUser(t, dep, "u@cloudreve.org", 2)
+
+	ctx := context.Background()
+	due := time.Now().Add(-time.Minute).Truncate(time.Second)
+	newSchedule := func(owner *ent.User, t schedule.Type, props *types.ScheduleProps) *ent.Schedule {
+		return dep.DBClient().Schedule.Create().
+			SetName(string(t)).
+			SetType(t).
+			SetSpec("0 * * * *").
+			SetProps(props).
+			SetEnabled(true).
+			SetOwnerID(owner.ID).
+			SetNextRunAt(due).
+			SaveX(ctx)
+	}
+	archive := newSchedule(admin, schedule.TypeArchive, &types.ScheduleProps{
+		Src: []string{"cloudreve://my/a.txt"},
+		Dst: "cloudreve://my/{uid}.zip",
+	})
+	download := newSchedule(u, schedule.TypeDownload, &types.ScheduleProps{
+		Src: []string{"https://example.com/a.txt"},
+		Dst: "cloudreve://my",
+	})
+
+	// Schedules run once even if the cron job is triggered again, e.g. by another instance.
+	CronRunUserSchedules(deptest.Context(dep, nil))
+	CronRunUserSchedules(deptest.Context(dep, nil))
+
+	tasks := dep.DBClient().Task.Query().AllX(ctx)
+	require.Len(t, tasks, 1)
+	a.Equal(queue.CreateArchiveTaskType, tasks[0].Type)
+	state := &CreateArchiveTaskState{}
+	require.NoError(t, json.Unmarshal([]byte(tasks[0].PrivateState), state))
+	a.Equal([]string{"cloudreve://my/a.txt"}, state.Uris)
+	a.Equal("cloudreve://my/"+strconv.Itoa(admin.ID)+".zip", state.Dst)
+
+	for _, s := range []*ent.Schedule{archive, download} {
+		reloaded := dep.DBClient().Schedule.GetX(ctx, s.ID)
+		a.True(reloaded.NextRunAt.After(time.Now()), "next run of schedule %q", s.Name)
+		a.NotNil(reloaded.LastRunAt)
+	}
+
+	runs := dep.DBClient().ScheduleRun.Query().Where(schedulerun.ScheduleID(archive.ID)).AllX(ctx)
+	require.Len(t, runs, 1)
+	a.Equal(tasks[0].ID, *runs[0].TaskID)
+	a.Empty(runs[0].Error)
+
+	// Failed runs are recorded with the error.
+	runs = dep.DBClient().ScheduleRun.Query().Where(schedulerun.ScheduleID(download.ID)).AllX(ctx)
+	require.Len(t, runs, 1)
+	a.Nil(runs[0].TaskID)
+	a.Contains(runs[0].Error, "not allowed")
+}
+
+func TestRunSchedule(t *testing.T) {
+	a := assert.New(t)
+	dep := deptest.New(t)
+	u := deptest.NewUser(t, dep, "admin@cloudreve.org", 1)
+	ctx := deptest.Context(dep, u)
+
+	archive := &ent.Schedule{
+		Name:    "archive",
+		Type:    schedule.TypeArchive,
+		OwnerID: u.ID,
+		Props: &types.ScheduleProps{
+			Src: []string{"cloudreve://my/a.txt"},
+			Dst: "cloudreve://my/a.zip",
+			Then: []types.TaskChainStep{
+				{Action: types.TaskChainActionDelete, Src: []string{"cloudreve://my/a.txt"}},
+			},
+		},
+	}
+	tasks := func() int {
+		return dep.DBClient().Task.Query().Where(task.Type(queue.CreateArchiveTaskType)).CountX(context.Background())
+	}
+
+	queued, err := RunSchedule(ctx, dep, archive, time.Now())
+	require.NoError(t, err)
+	a.Equal(archive.Props.Then, queued.Chain())
+	a.Equal(1, tasks())
+
+	// Invalid follow-up steps are rejected before the task is queued.
+	invalid := *archive
+	invalid.Props = &types.ScheduleProps{
+		Src:  archive.Props.Src,
+		Dst:  archive.Props.Dst,
+		Then: []types.TaskChainStep{{Action: types.TaskChainActionExtract, Src: []string{"cloudreve://my/a.zip"}}},
+	}
+	_, err = RunSchedule(ctx, dep, &invalid, time.Now())
+	a.Error(err)
+	a.Equal(1, tasks())
+
+	noSrc := *archive
+	noSrc.Props = &types.ScheduleProps{Dst: "cloudreve://my/a.zip"}
+	_, err = RunSchedule(ctx, dep, &noSrc, time.Now())
+	a.Error(err)
+
+	// Schedules run with current permissions of the owner.
+	require.NoError(t, dep.DBClient().User.UpdateOneID(u.ID).SetGroupID(2).Exec(context.Background()))
+	_, err = RunSchedule(ctx, dep, archive, time.Now())
+	a.ErrorContains(err, "not allowed")
+	_, err = RunSchedule(ctx, dep, &ent.Schedule{
+		Type:    schedule.TypeDownload,
+		OwnerID: u.ID,
+		Props:   &types.ScheduleProps{Src: []string{"https://example.com/a.txt"}, Dst: "cloudreve://my"},
+	}, time.Now())
+	a.ErrorContains(err, "not allowed")
+	a.Equal(1, tasks())
+}
@@ -0,0 +1,357 @@
+package workflows
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"os"
+	"sync/atomic"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/task"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+)
+
+type (
+	// ScrubTask walks through all entities of a storage policy, re-reads each blob and
+	// compares it with what is recorded in database.
+	ScrubTask struct {
+		*queue.DBTask
+
+		l        logging.Logger
+		state    *ScrubTaskState
+		progress queue.Progresses
+	}
+	ScrubTaskPhase string
+	ScrubTaskState struct {
+		PolicyID     int            `json:"policy_id"`
+		MarkBroken   bool           `json:"mark_broken"`
+		Phase        ScrubTaskPhase `json:"phase"`
+		Total        int            `json:"total"`
+		Scanned      int            `json:"scanned"`
+		LastEntityID int            `json:"last_entity_id"`
+		Broken       int            `json:"broken"`
+		Skipped      []int          `json:"skipped,omitempty"`
+		Missing      []int          `json:"missing,omitempty"`
+		Truncated    []int          `json:"truncated,omitempty"`
+		Corrupted    []int          `json:"corrupted,omitempty"`
+	}
+
+	scrubResult int
+)
+
+const (
+	ScrubPhaseCount ScrubTaskPhase = "count"
+	ScrubPhaseScan  ScrubTaskPhase = "scan"
+
+	ScrubBatchSize = 100
+	// ScrubMaxReported is the max number of broken entity IDs recorded per category.
+	ScrubMaxReported = 1000
+
+	ProgressTypeScrub = "scrubbed"
+
+	SummaryKeySkipped   = "skipped"
+	SummaryKeyMissing   = "missing"
+	SummaryKeyTruncated = "truncated"
+	SummaryKeyCorrupted = "corrupted"
+	SummaryKeyPolicyID  = "policy_id"
+)
+
+const (
+	scrubResultHealthy scrubResult = iota
+	scrubResultMissing
+	scrubResultTruncated
+	scrubResultCorrupted
+	// scrubResultUnknown indicates the blob cannot be verified for now, e.g. the storage provider is
+	// temporarily unavailable. Such entities are skipped and can be verified again in the next scrub.
+	scrubResultUnknown
+)
+
+func init() {
+	queue.RegisterResumableTaskFactory(queue.ScrubTaskType, NewScrubTaskFromModel)
+}
+
+// NewScrubTask creates a new ScrubTask for given storage policy.
+func NewScrubTask(ctx context.Context, u *ent.User, policyID int, markBroken bool) (queue.Task, error) {
+	state := &ScrubTaskState{
+		PolicyID:   policyID,
+		MarkBroken: markBroken,
+		Phase:      ScrubPhaseCount,
+	}
+	stateBytes, err := json.Marshal(state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal state: %w", err)
+	}
+
+	return &ScrubTask{
+		DBTask: &queue.DBTask{
+			Task: &ent.Task{
+				Type:          queue.ScrubTaskType,
+				CorrelationID: logging.CorrelationID(ctx),
+				PrivateState:  string(stateBytes),
+				PublicState:   &types.TaskPublicState{},
+			},
+			DirectOwner: u,
+		},
+	}, nil
+}
+
+func NewScrubTaskFromModel(t *ent.Task) queue.Task {
+	return &ScrubTask{
+		DBTask: &queue.DBTask{
+			Task: t,
+		},
+	}
+}
+
+func (m *ScrubTask) Do(ctx context.Context) (task.Status, error) {
+	dep := dependency.FromContext(ctx)
+	m.l = dep.Logger()
+
+	m.Lock()
+	if m.progress == nil {
+		m.progress = make(queue.Progresses)
+	}
+	m.progress[ProgressTypeScrub] = &queue.Progress{}
+	m.Unlock()
+
+	state := &ScrubTaskState{}
+	if err := json.Unmarshal([]byte(m.State()), state); err != nil {
+		return task.StatusError, fmt.Errorf("failed to unmarshal state: %s (%w)", err, queue.CriticalErr)
+	}
+	m.state = state
+
+	var (
+		next = task.StatusCompleted
+		err  error
+	)
+	switch m.state.Phase {
+	case ScrubPhaseCount, "":
+		next, err = m.count(ctx, dep)
+	case ScrubPhaseScan:
+		next, err = m.scan(ctx, dep)
+	default:
+		next, err = task.StatusError, fmt.Errorf("unknown phase %q: %w", m.state.Phase, queue.CriticalErr)
+	}
+
+	newStateStr, marshalErr := json.Marshal(m.state)
+	if marshalErr != nil {
+		return task.StatusError, fmt.Errorf("failed to marshal state: %w", marshalErr)
+	}
+
+	m.Lock()
+	m.Task.PrivateState = string(newStateStr)
+	m.Unlock()
+	return next, err
+}
+
+// count counts total entities to be scrubbed for progress tracking.
+func (m *ScrubTask) count(ctx context.Context, dep dependency.Dep) (task.Status, error) {
+	if _, err := dep.StoragePolicyClient().GetPolicyByID(ctx, m.state.PolicyID); err != nil {
+		return task.StatusError, fmt.Errorf("failed to get storage policy %d: %s (%w)", m.state.PolicyID, err, queue.CriticalErr)
+	}
+
+	total, err := dep.FileClient().CountPolicyEntities(ctx, m.state.PolicyID, nil)
+	if err != nil {
+		return task.StatusError, fmt.Errorf("failed to count entities: %w", err)
+	}
+
+	m.state.Total = total
+	m.state.Phase = ScrubPhaseScan
+	m.state.LastEntityID = 0
+	m.state.Scanned = 0
+
+	m.l.Info("Found %d entities in storage policy %d, starting scrub...", total, m.state.PolicyID)
+	m.ResumeAfter(0)
+	return task.StatusSuspending, nil
+}
+
+// scan verifies a batch of entities and suspends for the next batch.
+func (m *ScrubTask) scan(ctx context.Context, dep dependency.Dep) (task.Status, error) {
+	atomic.StoreInt64(&m.progress[ProgressTypeScrub].Total, int64(m.state.Total))
+	atomic.StoreInt64(&m.progress[ProgressTypeScrub].Current, int64(m.state.Scanned))
+
+	entities, err := dep.FileClient().ListPolicyEntities(ctx, m.state.PolicyID, m.state.LastEntityID, ScrubBatchSize, nil)
+	if err != nil {
+		return task.StatusError, fmt.Errorf("failed to list entities after ID %d: %w", m.state.LastEntityID, err)
+	}
+
+	if len(entities) == 0 {
+		m.l.Info("Scrub complete. %d entities scanned, %d broken.", m.state.Scanned, m.state.Broken)
+		return task.StatusCompleted, nil
+	}
+
+	fm := manager.NewFileManager(dep, inventory.UserFromContext(ctx))
+	defer fm.Recycle()
+
+	for _, e := range entities {
+		select {
+		case <-ctx.Done():
+			return task.StatusError, ctx.Err()
+		default:
+		}
+
+		res := m.verifyEntity(ctx, fm, e)
+		switch res {
+		case scrubResultUnknown:
+			m.state.Skipped = appendReported(m.state.Skipped, e.ID)
+		case scrubResultMissing:
+			m.state.Missing = appendReported(m.state.Missing, e.ID)
+		case scrubResultTruncated:
+			m.state.Truncated = appendReported(m.state.Truncated, e.ID)
+		case scrubResultCorrupted:
+			m.state.Corrupted = appendReported(m.state.Corrupted, e.ID)
+		}
+
+		if res != scrubResultHealthy && res != scrubResultUnknown {
+			m.state.Broken++
+		}
+
+		if m.state.MarkBroken && res != scrubResultUnknown {
+			m.markEntity(ctx, dep, e, res != scrubResultHealthy)
+		}
+
+		m.state.Scanned++
+		m.state.LastEntityID = e.ID
+		atomic.StoreInt64(&m.progress[ProgressTypeScrub].Current, int64(m.state.Scanned))
+	}
+
+	// Suspend and resume for next batch
+	m.ResumeAfter(0)
+	return task.StatusSuspending, nil
+}
+
+// verifyEntity re-reads the blob of given entity, compares its size and checksum (if recorded).
+func (m *ScrubTask) verifyEntity(ctx context.Context, fm manager.FileManager, e *ent.Entity) scrubResult {
+	source, err := fm.GetEntitySource(ctx, 0, fs.WithEntity(fs.NewEntity(e)))
+	if err != nil {
+		m.l.Warning("Failed to get source of entity %d: %s", e.ID, err)
+		if isBlobNotFound(err) {
+			return scrubResultMissing
+		}
+		return scrubResultUnknown
+	}
+	defer source.Close()
+
+	var hasher hash.Hash
+	dst := io.Discard
+	if e.Checksum != "" {
+		hasher = sha256.New()
+		dst = hasher
+	}
+
+	read, err := io.Copy(dst, source)
+	if err != nil {
+		m.l.Warning("Failed to read entity %d after %d bytes: %s", e.ID, read, err)
+		if read == 0 && isBlobNotFound(err) {
+			return scrubResultMissing
+		}
+
+		// Read might be interrupted by network or storage provider errors, the blob is not
+		// necessarily broken.
+		return scrubResultUnknown
+	}
+
+	if read < e.Size {
+		m.l.Warning("Entity %d is truncated, expected %d bytes, got %d.", e.ID, e.Size, read)
+		return scrubResultTruncated
+	}
+
+	if read > e.Size {
+		m.l.Warning("Entity %d size mismatch, expected %d bytes, got %d.", e.ID, e.Size, read)
+		return scrubResultCorrupted
+	}
+
+	if hasher != nil {
+		if checksum := hex.EncodeToString(hasher.Sum(nil)); checksum != e.Checksum {
+			m.l.Warning("Entity %d checksum mismatch, expected %s, got %s.", e.ID, e.Checksum, checksum)
+			return scrubResultCorrupted
+		}
+	}
+
+	return scrubResultHealthy
+}
+
+// markEntity sets or clears the broken flag of given entity.
+func (m *ScrubTask) markEntity(ctx context.Context, dep dependency.Dep, e *ent.Entity, broken bool) {
+	props := &types.EntityProps{}
+	if e.Props != nil {
+		*props = *e.Props
+	}
+
+	if props.Broken == broken {
+		return
+	}
+
+	props.Broken = broken
+	if err := dep.FileClient().UpdateEntityProps(ctx, e.ID, props); err != nil {
+		m.l.Warning("Failed to mark entity %d: %s", e.ID, err)
+	}
+}
+
+func (m *ScrubTask) Progress(ctx context.Context) queue.Progresses {
+	m.Lock()
+	defer m.Unlock()
+	return m.progress
+}
+
+func (m *ScrubTask) Summarize(hasher hashid.Encoder) *queue.Summary {
+	if m.state == nil {
+		if err := json.Unmarshal([]byte(m.State()), &m.state); err != nil {
+			return nil
+		}
+	}
+
+	encode := func(ids []int) []string {
+		res := make([]string, 0, len(ids))
+		for _, id := range ids {
+			res = append(res, hashid.EncodeEntityID(hasher, id))
+		}
+		return res
+	}
+
+	return &queue.Summary{
+		Phase: string(m.state.Phase),
+		Props: map[string]any{
+			SummaryKeyPolicyID:  m.state.PolicyID,
+			SummaryKeyTotal:     m.state.Total,
+			SummaryKeyFailed:    m.state.Broken,
+			SummaryKeySkipped:   encode(m.state.Skipped),
+			SummaryKeyMissing:   encode(m.state.Missing),
+			SummaryKeyTruncated: encode(m.state.Truncated),
+			SummaryKeyCorrupted: encode(m.state.Corrupted),
+		},
+	}
+}
+
+// isBlobNotFound returns true if err indicates the blob does not exist in storage provider.
+func isBlobNotFound(err error) bool {
+	var statusErr *request.StatusCodeError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode == http.StatusNotFound
+	}
+
+	return errors.Is(err, os.ErrNotExist)
+}
+
+func appendReported(ids []int, id int) []int {
+	if len(ids) >= ScrubMaxReported {
+		return ids
+	}
+
+	return append(ids, id)
+}
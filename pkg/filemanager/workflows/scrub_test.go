@@ -0,0 +1,116 @@
+package workflows
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/application/dependency/deptest"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/task"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// uploadEntity uploads content to uri and returns the primary entity of the uploaded file.
+func uploadEntity(t *testing.T, ctx context.Context, dep dependency.Dep, fm manager.FileManager, uri, content string) *ent.Entity {
+	t.Helper()
+
+	dst, err := fs.NewUriFromString(uri)
+	require.NoError(t, err)
+	file, err := fm.Update(ctx, &fs.UploadRequest{
+		Props: &fs.UploadProps{Uri: dst, Size: int64(len(content))},
+		File:  io.NopCloser(strings.NewReader(content)),
+		Mode:  fs.ModeOverwrite,
+	})
+	require.NoError(t, err)
+
+	return dep.DBClient().Entity.GetX(context.Background(), file.PrimaryEntity().ID())
+}
+
+func TestScrubTask(t *testing.T) {
+	a := assert.New(t)
+	dep := deptest.New(t)
+	admin := deptest.NewUser(t, dep, "admin@cloudreve.org", 1)
+	ctx := deptest.Context(dep, admin)
+	fm := manager.NewFileManager(dep, admin)
+	defer fm.Recycle()
+
+	setEntity := func(e *ent.Entity, checksum string, broken bool) {
+		require.NoError(t, dep.DBClient().Entity.UpdateOneID(e.ID).
+			SetChecksum(checksum).
+			SetProps(&types.EntityProps{Broken: broken}).
+			Exec(context.Background()))
+	}
+	checksum := func(content string) string {
+		h := sha256.Sum256([]byte(content))
+		return hex.EncodeToString(h[:])
+	}
+
+	healthy := uploadEntity(t, ctx, dep, fm, "cloudreve://my/healthy.txt", "healthy")
+	// Flag set by previous scrub is cleared once the blob is verified.
+	setEntity(healthy, checksum("healthy"), true)
+	missing := uploadEntity(t, ctx, dep, fm, "cloudreve://my/missing.txt", "missing")
+	require.NoError(t, os.Remove(util.RelativePath(missing.Source)))
+	truncated := uploadEntity(t, ctx, dep, fm, "cloudreve://my/truncated.txt", "truncated")
+	require.NoError(t, os.WriteFile(util.RelativePath(truncated.Source), []byte("trunc"), 0644))
+	corrupted := uploadEntity(t, ctx, dep, fm, "cloudreve://my/corrupted.txt", "corrupted")
+	setEntity(corrupted, checksum("something else"), false)
+	// Blob that cannot be read for reasons other than not existing is neither reported as broken nor marked.
+	unreadable := uploadEntity(t, ctx, dep, fm, "cloudreve://my/unreadable.txt", "unreadable")
+	require.NoError(t, os.Remove(util.RelativePath(unreadable.Source)))
+	require.NoError(t, os.Mkdir(util.RelativePath(unreadable.Source), 0755))
+	setEntity(unreadable, "", true)
+
+	qt, err := NewScrubTask(ctx, admin, 1, true)
+	require.NoError(t, err)
+	scrub := qt.(*ScrubTask)
+	status := task.StatusSuspending
+	for i := 0; status == task.StatusSuspending && i < 10; i++ {
+		status, err = scrub.Do(ctx)
+		require.NoError(t, err)
+	}
+	require.Equal(t, task.StatusCompleted, status)
+
+	state := &ScrubTaskState{}
+	require.NoError(t, json.Unmarshal([]byte(scrub.State()), state))
+	a.Equal(5, state.Total)
+	a.Equal(5, state.Scanned)
+	a.Equal(3, state.Broken)
+	a.Equal([]int{missing.ID}, state.Missing)
+	a.Equal([]int{truncated.ID}, state.Truncated)
+	a.Equal([]int{corrupted.ID}, state.Corrupted)
+	a.Equal([]int{unreadable.ID}, state.Skipped)
+
+	for e, broken := range map[*ent.Entity]bool{
+		healthy:    false,
+		missing:    true,
+		truncated:  true,
+		corrupted:  true,
+		unreadable: true,
+	} {
+		props := dep.DBClient().Entity.GetX(context.Background(), e.ID).Props
+		require.NotNil(t, props)
+		a.Equal(broken, props.Broken, "entity %d", e.ID)
+	}
+}
+
+func TestIsBlobNotFound(t *testing.T) {
+	a := assert.New(t)
+	_, err := os.Open("not-exist")
+	a.True(isBlobNotFound(err))
+	a.True(isBlobNotFound(&request.StatusCodeError{StatusCode: 404}))
+	a.False(isBlobNotFound(&request.StatusCodeError{StatusCode: 503}))
+	a.False(isBlobNotFound(io.ErrUnexpectedEOF))
+}
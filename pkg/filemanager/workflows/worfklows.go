@@ -2,14 +2,17 @@ package workflows
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"path"
 	"strconv"
 	"time"
 
 	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent/task"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
 	"github.com/cloudreve/Cloudreve/v4/pkg/cluster"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
 	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
 	"github.com/cloudreve/Cloudreve/v4/pkg/util"
 )
@@ -17,6 +20,11 @@ import (
 const (
 	TaskTempPath                 = "fm_workflows"
 	slaveProgressRefreshInterval = 5 * time.Second
+	// unhealthyNodeRetryInterval is the interval to retry node allocation when no healthy node is available.
+	unhealthyNodeRetryInterval = time.Minute
+	// smallTaskSize and largeTaskSize are thresholds of data size to prioritize tasks in queue.
+	smallTaskSize = 64 << 20
+	largeTaskSize = 4 << 30
 )
 
 type NodeState struct {
@@ -25,20 +33,90 @@ type NodeState struct {
 	progress queue.Progresses
 }
 
-// allocateNode allocates a node for the task.
-func allocateNode(ctx context.Context, dep dependency.Dep, state *NodeState, capability types.NodeCapability) (cluster.Node, error) {
+// allocateNode allocates a node for the task. If the node previously allocated stays unhealthy longer than
+// the failover grace period or is no longer available, a new one is allocated and `previous` is the ID of
+// the old node, in which case the task should clean up on the old node and start over.
+func allocateNode(ctx context.Context, dep dependency.Dep, state *NodeState, capability types.NodeCapability) (node cluster.Node, previous int, err error) {
 	np, err := dep.NodePool(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get node pool: %w", err)
+		return nil, 0, fmt.Errorf("failed to get node pool: %w", err)
 	}
 
-	node, err := np.Get(ctx, capability, state.NodeID)
+	if state.NodeID != 0 {
+		grace := dep.SettingProvider().NodeHealthCheck(ctx).FailoverGracePeriod
+		if h := np.Health(state.NodeID); awaitingRecovery(h, grace, time.Now()) {
+			return nil, 0, fmt.Errorf("node %d is unhealthy since %s, wait for it to recover: %w",
+				state.NodeID, h.UnhealthySince.Format(time.RFC3339), cluster.ErrNoHealthyNode)
+		}
+	}
+
+	node, err = np.Get(ctx, capability, state.NodeID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get node: %w", err)
+		return nil, 0, fmt.Errorf("failed to get node: %w", err)
+	}
+
+	if state.NodeID != 0 && state.NodeID != node.ID() {
+		previous = state.NodeID
+		dep.Logger().Warning("Node %d is no longer available, task is re-dispatched to node %q.", state.NodeID, node.Name())
 	}
 
 	state.NodeID = node.ID()
-	return node, nil
+	return node, previous, nil
+}
+
+// awaitingRecovery returns true if the node is unhealthy but still within the failover grace period, so
+// that transient heartbeat failures do not cause tasks to start over on another node.
+func awaitingRecovery(h *cluster.NodeHealth, grace time.Duration, now time.Time) bool {
+	return h != nil && !h.Healthy && now.Sub(h.UnhealthySince) < grace
+}
+
+// sizePriority returns queue priority of a task processing given size of data, so that small tasks
+// are not stuck behind large ones. Size not greater than 0 is treated as unknown.
+func sizePriority(size int64) int {
+	switch {
+	case size <= 0:
+		return queue.PriorityNormal
+	case size <= smallTaskSize:
+		return queue.PriorityHigh
+	case size > largeTaskSize:
+		return queue.PriorityLow
+	default:
+		return queue.PriorityNormal
+	}
+}
+
+// cleanupPreviousNode runs cleanup against the node the task was dispatched to before reallocation. The
+// node is likely unreachable, so errors are only logged.
+func cleanupPreviousNode(ctx context.Context, dep dependency.Dep, l logging.Logger, id int, cleanup func(ctx context.Context, node cluster.Node) error) {
+	np, err := dep.NodePool(ctx)
+	if err != nil {
+		l.Warning("Failed to get node pool, skip cleanup on previous node %d: %s", id, err)
+		return
+	}
+
+	node, err := np.GetByID(ctx, id)
+	if err != nil {
+		l.Warning("Previous node %d not found, skip cleanup: %s", id, err)
+		return
+	}
+
+	cleanupCtx, cancel := context.WithTimeout(ctx, dep.SettingProvider().NodeHealthCheck(ctx).Timeout)
+	defer cancel()
+	if err := cleanup(cleanupCtx, node); err != nil {
+		l.Warning("Failed to clean up on previous node %q: %s", node.Name(), err)
+	}
+}
+
+// allocateNodeFailed suspends the task if allocation failed due to no healthy node, so that it can be
+// picked up again once any node recovers.
+func allocateNodeFailed(t queue.Task, l logging.Logger, err error) (task.Status, error) {
+	if errors.Is(err, cluster.ErrNoHealthyNode) {
+		l.Warning("No healthy node available, resume after %s: %s", unhealthyNodeRetryInterval, err)
+		t.ResumeAfter(unhealthyNodeRetryInterval)
+		return task.StatusSuspending, nil
+	}
+
+	return task.StatusError, fmt.Errorf("failed to allocate node: %w", err)
 }
 
 // prepareSlaveTaskCtx prepares the context for the slave task.
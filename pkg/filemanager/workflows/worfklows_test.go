@@ -0,0 +1,173 @@
+package workflows
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/application/dependency/deptest"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/task"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cluster"
+	"github.com/cloudreve/Cloudreve/v4/pkg/downloader"
+	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	// fakeNode is a node only providing its ID.
+	fakeNode struct {
+		cluster.Node
+		id int
+	}
+
+	// fakeNodePool selects the preferred node if healthy, otherwise the first healthy one.
+	fakeNodePool struct {
+		cluster.NodePool
+		nodes  []cluster.Node
+		health map[int]*cluster.NodeHealth
+	}
+)
+
+func (n *fakeNode) ID() int        { return n.id }
+func (n *fakeNode) Name() string   { return fmt.Sprintf("node-%d", n.id) }
+func (n *fakeNode) IsMaster() bool { return false }
+
+func (p *fakeNodePool) Get(ctx context.Context, capability types.NodeCapability, preferred int) (cluster.Node, error) {
+	var res cluster.Node
+	for _, n := range p.nodes {
+		if h := p.health[n.ID()]; h != nil && !h.Healthy {
+			continue
+		}
+		if res == nil || n.ID() == preferred {
+			res = n
+		}
+	}
+
+	if res == nil {
+		return nil, cluster.ErrNoHealthyNode
+	}
+	return res, nil
+}
+
+func (p *fakeNodePool) GetByID(ctx context.Context, id int) (cluster.Node, error) {
+	for _, n := range p.nodes {
+		if n.ID() == id {
+			return n, nil
+		}
+	}
+	return nil, cluster.ErrNoAvailableNode
+}
+
+func (p *fakeNodePool) Health(id int) *cluster.NodeHealth {
+	return p.health[id]
+}
+
+func TestAllocateNode(t *testing.T) {
+	a := assert.New(t)
+	np := &fakeNodePool{
+		nodes:  []cluster.Node{&fakeNode{id: 2}, &fakeNode{id: 3}},
+		health: map[int]*cluster.NodeHealth{},
+	}
+	dep := deptest.New(t, dependency.WithNodePool(np))
+	ctx := deptest.Context(dep, nil)
+	grace := dep.SettingProvider().NodeHealthCheck(ctx).FailoverGracePeriod
+
+	// Tasks stick to the allocated node while it is healthy.
+	state := &NodeState{}
+	n, previous, err := allocateNode(ctx, dep, state, types.NodeCapabilityRemoteDownload)
+	require.NoError(t, err)
+	a.Equal(2, n.ID())
+	a.Zero(previous)
+	np.nodes[0], np.nodes[1] = np.nodes[1], np.nodes[0]
+	n, previous, err = allocateNode(ctx, dep, state, types.NodeCapabilityRemoteDownload)
+	require.NoError(t, err)
+	a.Equal(2, n.ID())
+	a.Zero(previous)
+
+	// Unhealthy node within grace period is waited for.
+	np.health[2] = &cluster.NodeHealth{UnhealthySince: time.Now().Add(-grace / 2)}
+	_, _, err = allocateNode(ctx, dep, state, types.NodeCapabilityRemoteDownload)
+	a.ErrorIs(err, cluster.ErrNoHealthyNode)
+	a.Equal(2, state.NodeID)
+	qt := &ExtractArchiveTask{DBTask: &queue.DBTask{Task: &ent.Task{PublicState: &types.TaskPublicState{}}}}
+	status, err := allocateNodeFailed(qt, dep.Logger(), err)
+	a.NoError(err)
+	a.Equal(task.StatusSuspending, status)
+	a.Greater(qt.ResumeTime(), time.Now().Unix())
+
+	// Task is re-dispatched to another node once grace period passes.
+	np.health[2].UnhealthySince = time.Now().Add(-grace - time.Minute)
+	n, previous, err = allocateNode(ctx, dep, state, types.NodeCapabilityRemoteDownload)
+	require.NoError(t, err)
+	a.Equal(3, n.ID())
+	a.Equal(2, previous)
+	a.Equal(3, state.NodeID)
+
+	var cleaned int
+	cleanupPreviousNode(ctx, dep, dep.Logger(), previous, func(ctx context.Context, node cluster.Node) error {
+		cleaned = node.ID()
+		return errors.New("node is unreachable")
+	})
+	a.Equal(2, cleaned)
+
+	// No healthy node at all fails the allocation with the same error, other errors fail the task.
+	np.health[3] = &cluster.NodeHealth{UnhealthySince: time.Now().Add(-grace - time.Minute)}
+	_, _, err = allocateNode(ctx, dep, state, types.NodeCapabilityRemoteDownload)
+	a.ErrorIs(err, cluster.ErrNoHealthyNode)
+	status, err = allocateNodeFailed(qt, dep.Logger(), cluster.ErrNoAvailableNode)
+	a.Error(err)
+	a.Equal(task.StatusError, status)
+}
+
+func TestAwaitingRecovery(t *testing.T) {
+	a := assert.New(t)
+	now := time.Now()
+	grace := 5 * time.Minute
+
+	a.False(awaitingRecovery(nil, grace, now), "node not in pool")
+	a.False(awaitingRecovery(&cluster.NodeHealth{Healthy: true}, grace, now))
+	a.True(awaitingRecovery(&cluster.NodeHealth{UnhealthySince: now.Add(-time.Minute)}, grace, now))
+	a.False(awaitingRecovery(&cluster.NodeHealth{UnhealthySince: now.Add(-grace)}, grace, now))
+	a.False(awaitingRecovery(&cluster.NodeHealth{UnhealthySince: now.Add(-time.Minute)}, 0, now), "failover without grace period")
+}
+
+func TestTaskPriority(t *testing.T) {
+	a := assert.New(t)
+	withState := func(state any) *queue.DBTask {
+		stateBytes, err := json.Marshal(state)
+		require.NoError(t, err)
+		return &queue.DBTask{Task: &ent.Task{PrivateState: string(stateBytes)}}
+	}
+
+	a.Equal(queue.PriorityHigh, sizePriority(smallTaskSize))
+	a.Equal(queue.PriorityNormal, sizePriority(smallTaskSize+1))
+	a.Equal(queue.PriorityLow, sizePriority(largeTaskSize+1))
+	a.Equal(queue.PriorityNormal, sizePriority(0), "unknown size")
+
+	a.Equal(queue.PriorityHigh, (&RemoteDownloadTask{DBTask: withState(&RemoteDownloadTaskState{
+		Phase: RemoteDownloadTaskPhaseMonitor,
+	})}).Priority())
+	a.Equal(queue.PriorityLow, (&RemoteDownloadTask{DBTask: withState(&RemoteDownloadTaskState{
+		Phase:  RemoteDownloadTaskPhaseTransfer,
+		Status: &downloader.TaskStatus{Total: largeTaskSize + 1},
+	})}).Priority())
+
+	a.Equal(queue.PriorityHigh, (&CreateArchiveTask{DBTask: withState(&CreateArchiveTaskState{
+		Phase: CreateArchiveTaskPhaseAwaitSlaveCompressing,
+	})}).Priority())
+	a.Equal(queue.PriorityNormal, (&CreateArchiveTask{DBTask: withState(&CreateArchiveTaskState{
+		Phase: CreateArchiveTaskPhaseNotStarted,
+	})}).Priority())
+
+	a.Equal(queue.PriorityHigh, (&ExtractArchiveTask{DBTask: withState(&ExtractArchiveTaskState{
+		Phase: ExtractArchivePhaseDownloadZip, ArchiveSize: 1 << 20,
+	})}).Priority())
+	a.Equal(queue.PriorityNormal, (&ExtractArchiveTask{DBTask: withState(&ExtractArchiveTaskState{})}).Priority())
+}
@@ -22,6 +22,9 @@ const (
 	TaskID
 	DavAccountID
 	PaymentID
+	TeamID
+	WebhookID
+	ScheduleID
 )
 
 var (
@@ -141,6 +144,24 @@ func EncodeDavAccountID(encoder Encoder, id int) string {
 	return res
 }
 
+// EncodeTeamID encode team id to hash id
+func EncodeTeamID(encoder Encoder, id int) string {
+	res, _ := encoder.Encode([]int{id, TeamID})
+	return res
+}
+
+// EncodeWebhookID encode webhook id to hash id
+func EncodeWebhookID(encoder Encoder, id int) string {
+	res, _ := encoder.Encode([]int{id, WebhookID})
+	return res
+}
+
+// EncodeScheduleID encode schedule id to hash id
+func EncodeScheduleID(encoder Encoder, id int) string {
+	res, _ := encoder.Encode([]int{id, ScheduleID})
+	return res
+}
+
 // EncodeSourceLinkID encode source link id to hash id
 func EncodeSourceLinkID(encoder Encoder, id int) string {
 	res, _ := encoder.Encode([]int{id, SourceLinkID})
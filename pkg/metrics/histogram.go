@@ -0,0 +1,97 @@
+package metrics
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// DefBuckets are the default histogram buckets in seconds, suitable for HTTP request latency.
+var DefBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// HistogramVec is a histogram partitioned by label values.
+type HistogramVec struct {
+	name       string
+	help       string
+	labelNames []string
+	buckets    []float64
+
+	mu     sync.Mutex
+	series map[string]*histogram
+}
+
+type histogram struct {
+	labelValues []string
+	counts      []uint64
+	count       uint64
+	sum         float64
+}
+
+// NewHistogramVec creates a histogram with given label names. Buckets must be sorted in increasing order.
+func NewHistogramVec(name, help string, buckets []float64, labelNames ...string) *HistogramVec {
+	return &HistogramVec{
+		name:       name,
+		help:       help,
+		labelNames: labelNames,
+		buckets:    buckets,
+		series:     make(map[string]*histogram),
+	}
+}
+
+// Observe adds a single observation with given label values, in the same order as label names.
+func (h *HistogramVec) Observe(v float64, labelValues ...string) {
+	key := strings.Join(labelValues, "\xff")
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	s, ok := h.series[key]
+	if !ok {
+		s = &histogram{labelValues: labelValues, counts: make([]uint64, len(h.buckets))}
+		h.series[key] = s
+	}
+
+	for i, upper := range h.buckets {
+		if v <= upper {
+			s.counts[i]++
+		}
+	}
+	s.count++
+	s.sum += v
+}
+
+func (h *HistogramVec) Collect(ctx context.Context) []*Family {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	keys := make([]string, 0, len(h.series))
+	for k := range h.series {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	f := &Family{Name: h.name, Help: h.help, Type: TypeHistogram}
+	for _, k := range keys {
+		s := h.series[k]
+		labels := make(Labels, 0, len(h.labelNames)*2+2)
+		for i, name := range h.labelNames {
+			labels = append(labels, name, s.labelValues[i])
+		}
+
+		for i, upper := range h.buckets {
+			f.Samples = append(f.Samples, Sample{
+				Suffix: "_bucket",
+				Labels: append(labels[:len(labels):len(labels)], "le", formatValue(upper)),
+				Value:  float64(s.counts[i]),
+			})
+		}
+		f.Samples = append(f.Samples,
+			Sample{Suffix: "_bucket", Labels: append(labels[:len(labels):len(labels)], "le", "+Inf"), Value: float64(s.count)},
+			Sample{Suffix: "_sum", Labels: labels, Value: s.sum},
+			Sample{Suffix: "_count", Labels: labels, Value: float64(s.count)},
+		)
+	}
+
+	return []*Family{f}
+}
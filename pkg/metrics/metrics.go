@@ -0,0 +1,210 @@
+// Package metrics exposes internal state of Cloudreve in Prometheus text exposition format.
+package metrics
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// ContentType is the content type of the exposition format written by Registry.
+	ContentType = "text/plain; version=0.0.4; charset=utf-8"
+	// Namespace is the prefix of all metric names.
+	Namespace = "cloudreve"
+)
+
+type (
+	// Type is the type of a metric family.
+	Type string
+
+	// Family is a group of samples sharing the same name.
+	Family struct {
+		Name    string
+		Help    string
+		Type    Type
+		Samples []Sample
+	}
+
+	// Sample is a single value of a metric family.
+	Sample struct {
+		// Suffix is appended to family name, used by histogram samples.
+		Suffix string
+		Labels Labels
+		Value  float64
+	}
+
+	// Labels is a list of label name and value pairs, e.g. {"queue", "thumb"}.
+	Labels []string
+
+	// Collector collects metric families when scraped.
+	Collector interface {
+		Collect(ctx context.Context) []*Family
+	}
+
+	// CollectorFunc is an adapter to use ordinary functions as Collector.
+	CollectorFunc func(ctx context.Context) []*Family
+)
+
+const (
+	TypeCounter   Type = "counter"
+	TypeGauge     Type = "gauge"
+	TypeHistogram Type = "histogram"
+)
+
+func (f CollectorFunc) Collect(ctx context.Context) []*Family {
+	return f(ctx)
+}
+
+// NewGauge creates a gauge family with given samples.
+func NewGauge(name, help string, samples ...Sample) *Family {
+	return &Family{Name: name, Help: help, Type: TypeGauge, Samples: samples}
+}
+
+// NewCounter creates a counter family with given samples.
+func NewCounter(name, help string, samples ...Sample) *Family {
+	return &Family{Name: name, Help: help, Type: TypeCounter, Samples: samples}
+}
+
+// Registry holds collectors and writes their metrics.
+type Registry struct {
+	mu         sync.RWMutex
+	collectors []Collector
+}
+
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+// Register adds collectors to the registry.
+func (r *Registry) Register(c ...Collector) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.collectors = append(r.collectors, c...)
+}
+
+// Write collects all metrics and writes them to w. Families with the same name are merged.
+func (r *Registry) Write(ctx context.Context, w io.Writer) error {
+	r.mu.RLock()
+	collectors := make([]Collector, len(r.collectors))
+	copy(collectors, r.collectors)
+	r.mu.RUnlock()
+
+	families := make(map[string]*Family)
+	for _, c := range collectors {
+		for _, f := range c.Collect(ctx) {
+			if existing, ok := families[f.Name]; ok {
+				existing.Samples = append(existing.Samples, f.Samples...)
+				continue
+			}
+
+			// Samples are copied since families returned by cached collectors are reused.
+			families[f.Name] = &Family{Name: f.Name, Help: f.Help, Type: f.Type, Samples: append([]Sample(nil), f.Samples...)}
+		}
+	}
+
+	names := make([]string, 0, len(families))
+	for name := range families {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	bw := bufio.NewWriter(w)
+	for _, name := range names {
+		writeFamily(bw, families[name])
+	}
+
+	return bw.Flush()
+}
+
+func writeFamily(w *bufio.Writer, f *Family) {
+	name := Namespace + "_" + f.Name
+	fmt.Fprintf(w, "# HELP %s %s\n", name, escapeHelp(f.Help))
+	fmt.Fprintf(w, "# TYPE %s %s\n", name, f.Type)
+	for _, s := range f.Samples {
+		w.WriteString(name)
+		w.WriteString(s.Suffix)
+		writeLabels(w, s.Labels)
+		w.WriteByte(' ')
+		w.WriteString(formatValue(s.Value))
+		w.WriteByte('\n')
+	}
+}
+
+func writeLabels(w *bufio.Writer, labels Labels) {
+	if len(labels) < 2 {
+		return
+	}
+
+	w.WriteByte('{')
+	for i := 0; i+1 < len(labels); i += 2 {
+		if i > 0 {
+			w.WriteByte(',')
+		}
+		w.WriteString(labels[i])
+		w.WriteString(`="`)
+		w.WriteString(escapeLabel(labels[i+1]))
+		w.WriteByte('"')
+	}
+	w.WriteByte('}')
+}
+
+func formatValue(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	}
+
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+var (
+	helpEscaper  = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+	labelEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+)
+
+func escapeHelp(s string) string {
+	return helpEscaper.Replace(s)
+}
+
+func escapeLabel(s string) string {
+	return labelEscaper.Replace(s)
+}
+
+// Cached wraps a collector whose collection is expensive, e.g. database queries. Result is reused
+// until ttl passes.
+func Cached(ttl time.Duration, c Collector) Collector {
+	return &cachedCollector{ttl: ttl, c: c}
+}
+
+type cachedCollector struct {
+	ttl time.Duration
+	c   Collector
+
+	mu        sync.Mutex
+	families  []*Family
+	collected time.Time
+}
+
+func (c *cachedCollector) Collect(ctx context.Context) []*Family {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.families == nil || time.Since(c.collected) > c.ttl {
+		c.families = c.c.Collect(ctx)
+		c.collected = time.Now()
+	}
+
+	return c.families
+}
@@ -0,0 +1,93 @@
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistogramVec(t *testing.T) {
+	a := assert.New(t)
+	h := NewHistogramVec("latency_seconds", "Latency.", []float64{0.1, 1}, "route")
+	h.Observe(0.05, "file")
+	h.Observe(0.5, "file")
+	h.Observe(5, "file")
+	h.Observe(0.1, "share")
+
+	families := h.Collect(context.Background())
+	require.Len(t, families, 1)
+	a.Equal(TypeHistogram, families[0].Type)
+	a.Equal([]Sample{
+		// Buckets are cumulative, upper bounds are inclusive.
+		{Suffix: "_bucket", Labels: Labels{"route", "file", "le", "0.1"}, Value: 1},
+		{Suffix: "_bucket", Labels: Labels{"route", "file", "le", "1"}, Value: 2},
+		{Suffix: "_bucket", Labels: Labels{"route", "file", "le", "+Inf"}, Value: 3},
+		{Suffix: "_sum", Labels: Labels{"route", "file"}, Value: 5.55},
+		{Suffix: "_count", Labels: Labels{"route", "file"}, Value: 3},
+		{Suffix: "_bucket", Labels: Labels{"route", "share", "le", "0.1"}, Value: 1},
+		{Suffix: "_bucket", Labels: Labels{"route", "share", "le", "1"}, Value: 1},
+		{Suffix: "_bucket", Labels: Labels{"route", "share", "le", "+Inf"}, Value: 1},
+		{Suffix: "_sum", Labels: Labels{"route", "share"}, Value: 0.1},
+		{Suffix: "_count", Labels: Labels{"route", "share"}, Value: 1},
+	}, families[0].Samples)
+}
+
+func TestRegistryWrite(t *testing.T) {
+	a := assert.New(t)
+	r := NewRegistry()
+	r.Register(
+		CollectorFunc(func(ctx context.Context) []*Family {
+			return []*Family{
+				NewGauge("queue_tasks", "Tasks in queue.", Sample{Labels: Labels{"queue", "io"}, Value: 2}),
+				NewCounter("errors_total", "Errors,\nby \\ type.", Sample{Labels: Labels{"type", `say "hi"`}, Value: math.Inf(1)}),
+			}
+		}),
+		// Families with the same name from different collectors are merged.
+		CollectorFunc(func(ctx context.Context) []*Family {
+			return []*Family{
+				NewGauge("queue_tasks", "Tasks in queue.", Sample{Labels: Labels{"queue", "thumb"}, Value: 0.5}),
+				NewGauge("up", "Up.", Sample{Value: 1}),
+			}
+		}),
+	)
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, r.Write(context.Background(), buf))
+	a.Equal(`# HELP cloudreve_errors_total Errors,\nby \\ type.
+# TYPE cloudreve_errors_total counter
+cloudreve_errors_total{type="say \"hi\""} +Inf
+# HELP cloudreve_queue_tasks Tasks in queue.
+# TYPE cloudreve_queue_tasks gauge
+cloudreve_queue_tasks{queue="io"} 2
+cloudreve_queue_tasks{queue="thumb"} 0.5
+# HELP cloudreve_up Up.
+# TYPE cloudreve_up gauge
+cloudreve_up 1
+`, buf.String())
+
+	// Output is stable across scrapes.
+	again := &bytes.Buffer{}
+	require.NoError(t, r.Write(context.Background(), again))
+	a.Equal(buf.String(), again.String())
+}
+
+func TestCached(t *testing.T) {
+	a := assert.New(t)
+	calls := 0
+	c := Cached(time.Hour, CollectorFunc(func(ctx context.Context) []*Family {
+		calls++
+		return []*Family{NewGauge("up", "Up.", Sample{Value: 1})}
+	}))
+
+	r := NewRegistry()
+	r.Register(c, c)
+	require.NoError(t, r.Write(context.Background(), &bytes.Buffer{}))
+	a.Equal(1, calls)
+	// Merging families does not modify cached result.
+	a.Len(c.Collect(context.Background())[0].Samples, 1)
+}
@@ -0,0 +1,261 @@
+package queue
+
+import (
+	"container/heap"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+)
+
+const (
+	PriorityLow    = -10
+	PriorityNormal = 0
+	PriorityHigh   = 10
+)
+
+type (
+	// PrioritizedTask can be implemented by tasks that should run before or after others
+	// of the same queue, e.g. small tasks. Tasks with higher priority are requested first.
+	PrioritizedTask interface {
+		Priority() int
+	}
+
+	// fairScheduler picks the ready Task with the highest priority, and among tasks of the
+	// same priority, the one whose owner has the fewest running tasks, then the one served
+	// least recently. Number of running tasks of users in one group can be capped by
+	// GroupSetting.MaxRunningTasks. The cap is counted per queue within this process, it is
+	// neither shared among queues nor among instances sharing the same database.
+	fairScheduler struct {
+		sync.Mutex
+		users    map[int]*userTasks
+		delayed  taskHeap
+		running  map[int]int
+		inflight map[Task]fairCharge
+		capacity int
+		count    int
+		seq      uint64
+		logger   logging.Logger
+		stopFlag int32
+	}
+
+	userTasks struct {
+		ready   fairHeap
+		running int
+		served  uint64
+	}
+
+	fairCharge struct {
+		user  int
+		group int
+	}
+
+	fairItem struct {
+		task     Task
+		priority int
+		seq      uint64
+	}
+
+	fairHeap []*fairItem
+)
+
+// NewFairScheduler creates a Scheduler with per-task priority and per-user fair share.
+func NewFairScheduler(queueSize int, logger logging.Logger) Scheduler {
+	return &fairScheduler{
+		users:    make(map[int]*userTasks),
+		running:  make(map[int]int),
+		inflight: make(map[Task]fairCharge),
+		capacity: queueSize,
+		logger:   logger,
+	}
+}
+
+func (s *fairScheduler) Queue(task Task) error {
+	if atomic.LoadInt32(&s.stopFlag) == 1 {
+		return ErrQueueShutdown
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	if s.capacity > 0 && s.count >= s.capacity {
+		return ErrMaxCapacity
+	}
+
+	if task.ResumeTime() > time.Now().Unix() {
+		heap.Push(&s.delayed, task)
+	} else {
+		s.push(task)
+	}
+	s.count++
+
+	return nil
+}
+
+func (s *fairScheduler) Request() (Task, error) {
+	if atomic.LoadInt32(&s.stopFlag) == 1 {
+		return nil, ErrQueueShutdown
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	// Move suspended tasks that are due into ready queues.
+	now := time.Now().Unix()
+	for s.delayed.Len() > 0 && s.delayed[0].ResumeTime() <= now {
+		s.push(heap.Pop(&s.delayed).(Task))
+	}
+
+	var (
+		picked     *userTasks
+		pickedUser int
+	)
+	for uid, u := range s.users {
+		if u.ready.Len() == 0 {
+			continue
+		}
+
+		group, limit := taskGroupLimit(u.ready[0].task)
+		if limit > 0 && s.running[group] >= limit {
+			continue
+		}
+
+		if picked == nil || fairLess(u, picked) {
+			picked, pickedUser = u, uid
+		}
+	}
+
+	if picked == nil {
+		return nil, ErrNoTaskInQueue
+	}
+
+	item := heap.Pop(&picked.ready).(*fairItem)
+	group, _ := taskGroupLimit(item.task)
+	s.seq++
+	picked.served = s.seq
+	picked.running++
+	s.running[group]++
+	s.inflight[item.task] = fairCharge{user: pickedUser, group: group}
+	s.count--
+
+	return item.task, nil
+}
+
+func (s *fairScheduler) Done(task Task) {
+	s.Lock()
+	defer s.Unlock()
+
+	charge, ok := s.inflight[task]
+	if !ok {
+		return
+	}
+
+	delete(s.inflight, task)
+	if s.running[charge.group]--; s.running[charge.group] <= 0 {
+		delete(s.running, charge.group)
+	}
+
+	if u, ok := s.users[charge.user]; ok {
+		u.running--
+		if u.running <= 0 && u.ready.Len() == 0 {
+			delete(s.users, charge.user)
+		}
+	}
+}
+
+func (s *fairScheduler) Shutdown() error {
+	if !atomic.CompareAndSwapInt32(&s.stopFlag, 0, 1) {
+		return ErrQueueShutdown
+	}
+
+	return nil
+}
+
+func (s *fairScheduler) push(task Task) {
+	uid := 0
+	if task.Owner() != nil {
+		uid = task.Owner().ID
+	}
+
+	u, ok := s.users[uid]
+	if !ok {
+		u = &userTasks{}
+		s.users[uid] = u
+	}
+
+	s.seq++
+	heap.Push(&u.ready, &fairItem{task: task, priority: taskPriority(task), seq: s.seq})
+}
+
+// fairLess returns true if next task of user a should run before that of user b.
+func fairLess(a, b *userTasks) bool {
+	if a.ready[0].priority != b.ready[0].priority {
+		return a.ready[0].priority > b.ready[0].priority
+	}
+
+	if a.running != b.running {
+		return a.running < b.running
+	}
+
+	return a.served < b.served
+}
+
+// taskPriority returns the priority of given Task, tasks of admins are boosted.
+func taskPriority(t Task) int {
+	priority := PriorityNormal
+	if p, ok := t.(PrioritizedTask); ok {
+		priority = p.Priority()
+	}
+
+	if owner := t.Owner(); owner != nil && owner.Edges.Group != nil && owner.Edges.Group.Permissions != nil &&
+		owner.Edges.Group.Permissions.Enabled(int(types.GroupPermissionIsAdmin)) {
+		priority += PriorityHigh
+	}
+
+	return priority
+}
+
+// taskGroupLimit returns the group ID of Task owner and its cap of running tasks, 0 for no limit.
+func taskGroupLimit(t Task) (int, int) {
+	owner := t.Owner()
+	if owner == nil || owner.Edges.Group == nil {
+		return 0, 0
+	}
+
+	if owner.Edges.Group.Settings == nil {
+		return owner.Edges.Group.ID, 0
+	}
+
+	return owner.Edges.Group.ID, owner.Edges.Group.Settings.MaxRunningTasks
+}
+
+// Implement heap.Interface
+func (h fairHeap) Len() int {
+	return len(h)
+}
+
+func (h fairHeap) Less(i, j int) bool {
+	if h[i].priority != h[j].priority {
+		return h[i].priority > h[j].priority
+	}
+
+	return h[i].seq < h[j].seq
+}
+
+func (h fairHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+}
+
+func (h *fairHeap) Push(x any) {
+	*h = append(*h, x.(*fairItem))
+}
+
+func (h *fairHeap) Pop() any {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	old[n-1] = nil
+	*h = old[0 : n-1]
+	return x
+}
@@ -0,0 +1,180 @@
+package queue
+
+import (
+	"container/heap"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/task"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/boolset"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fairTestTask struct {
+	*DBTask
+	priority int
+}
+
+func (t *fairTestTask) Do(ctx context.Context) (task.Status, error) {
+	return task.StatusCompleted, nil
+}
+
+func (t *fairTestTask) Priority() int {
+	return t.priority
+}
+
+func newFairTestTask(id int, owner *ent.User, priority int) *fairTestTask {
+	return &fairTestTask{
+		DBTask:   &DBTask{DirectOwner: owner, Task: &ent.Task{ID: id, PublicState: &types.TaskPublicState{}}},
+		priority: priority,
+	}
+}
+
+func newFairTestUser(id, groupID, maxRunning int, admin bool) *ent.User {
+	permissions := &boolset.BooleanSet{}
+	boolset.Set(types.GroupPermissionIsAdmin, admin, permissions)
+	return &ent.User{
+		ID: id,
+		Edges: ent.UserEdges{Group: &ent.Group{
+			ID:          groupID,
+			Permissions: permissions,
+			Settings:    &types.GroupSetting{MaxRunningTasks: maxRunning},
+		}},
+	}
+}
+
+// requestIDs requests n tasks from the scheduler and returns their IDs, tasks are kept running.
+func requestIDs(t *testing.T, s Scheduler, n int) []int {
+	ids := make([]int, 0, n)
+	for i := 0; i < n; i++ {
+		picked, err := s.Request()
+		require.NoError(t, err)
+		ids = append(ids, picked.ID())
+	}
+	return ids
+}
+
+func TestFairHeap(t *testing.T) {
+	a := assert.New(t)
+	h := &fairHeap{}
+	for _, item := range []*fairItem{
+		{priority: PriorityNormal, seq: 1},
+		{priority: PriorityLow, seq: 2},
+		{priority: PriorityHigh, seq: 3},
+		{priority: PriorityNormal, seq: 4},
+		{priority: PriorityHigh, seq: 5},
+	} {
+		heap.Push(h, item)
+	}
+
+	var seqs []uint64
+	for h.Len() > 0 {
+		seqs = append(seqs, heap.Pop(h).(*fairItem).seq)
+	}
+	a.Equal([]uint64{3, 5, 1, 4, 2}, seqs, "higher priority first, then first in first out")
+}
+
+func TestFairScheduler_Priority(t *testing.T) {
+	a := assert.New(t)
+	s := NewFairScheduler(0, logging.NewConsoleLogger(logging.LevelError))
+	user := newFairTestUser(1, 2, 0, false)
+
+	require.NoError(t, s.Queue(newFairTestTask(1, user, PriorityLow)))
+	require.NoError(t, s.Queue(newFairTestTask(2, user, PriorityNormal)))
+	require.NoError(t, s.Queue(newFairTestTask(3, user, PriorityHigh)))
+	require.NoError(t, s.Queue(&leaseTestTask{DBTask: &DBTask{DirectOwner: user, Task: &ent.Task{ID: 4, PublicState: &types.TaskPublicState{}}}}))
+
+	a.Equal([]int{3, 2, 4, 1}, requestIDs(t, s, 4), "tasks without priority are normal")
+	_, err := s.Request()
+	a.ErrorIs(err, ErrNoTaskInQueue)
+}
+
+func TestFairScheduler_Fairness(t *testing.T) {
+	a := assert.New(t)
+	s := NewFairScheduler(0, logging.NewConsoleLogger(logging.LevelError))
+	heavy := newFairTestUser(1, 2, 0, false)
+	light := newFairTestUser(2, 2, 0, false)
+	admin := newFairTestUser(3, 1, 0, true)
+
+	// Heavy user floods the queue before others.
+	for i := 1; i <= 4; i++ {
+		require.NoError(t, s.Queue(newFairTestTask(i, heavy, PriorityNormal)))
+	}
+	a.Equal([]int{1}, requestIDs(t, s, 1))
+	require.NoError(t, s.Queue(newFairTestTask(10, light, PriorityNormal)))
+	require.NoError(t, s.Queue(newFairTestTask(11, light, PriorityNormal)))
+	a.Equal([]int{10, 2, 11, 3, 4}, requestIDs(t, s, 5), "users are served in turn")
+	_, err := s.Request()
+	a.ErrorIs(err, ErrNoTaskInQueue)
+
+	// Owner with fewer running tasks goes first.
+	require.NoError(t, s.Queue(newFairTestTask(5, heavy, PriorityNormal)))
+	require.NoError(t, s.Queue(newFairTestTask(12, light, PriorityNormal)))
+	a.Equal([]int{12, 5}, requestIDs(t, s, 2))
+
+	// Priority outweighs fairness, tasks of admins are boosted.
+	require.NoError(t, s.Queue(newFairTestTask(6, heavy, PriorityHigh)))
+	require.NoError(t, s.Queue(newFairTestTask(13, light, PriorityNormal)))
+	require.NoError(t, s.Queue(newFairTestTask(20, admin, PriorityNormal)))
+	require.NoError(t, s.Queue(newFairTestTask(21, admin, PriorityLow)))
+	a.Equal([]int{20, 6, 21, 13}, requestIDs(t, s, 4))
+}
+
+func TestFairScheduler_GroupLimit(t *testing.T) {
+	a := assert.New(t)
+	s := NewFairScheduler(0, logging.NewConsoleLogger(logging.LevelError))
+	limited1 := newFairTestUser(1, 3, 2, false)
+	limited2 := newFairTestUser(2, 3, 2, false)
+	other := newFairTestUser(3, 2, 0, false)
+
+	tasks := make(map[int]Task)
+	for _, queued := range []*fairTestTask{
+		newFairTestTask(1, limited1, PriorityNormal),
+		newFairTestTask(2, limited1, PriorityNormal),
+		newFairTestTask(3, limited2, PriorityHigh),
+		newFairTestTask(10, other, PriorityLow),
+	} {
+		tasks[queued.ID()] = queued
+		require.NoError(t, s.Queue(queued))
+	}
+
+	a.Equal([]int{3, 1, 10}, requestIDs(t, s, 3), "users of group 3 run at most 2 tasks")
+	_, err := s.Request()
+	a.ErrorIs(err, ErrNoTaskInQueue)
+
+	// Finished tasks release the cap.
+	s.Done(tasks[3])
+	s.Done(tasks[3])
+	a.Equal([]int{2}, requestIDs(t, s, 1))
+	_, err = s.Request()
+	a.ErrorIs(err, ErrNoTaskInQueue, "task done twice is only released once")
+}
+
+func TestFairScheduler_Delayed(t *testing.T) {
+	a := assert.New(t)
+	s := NewFairScheduler(2, logging.NewConsoleLogger(logging.LevelError))
+	user := newFairTestUser(1, 2, 0, false)
+
+	delayed := newFairTestTask(1, user, PriorityHigh)
+	delayed.OnSuspend(time.Now().Add(time.Hour).Unix())
+	require.NoError(t, s.Queue(delayed))
+	require.NoError(t, s.Queue(newFairTestTask(2, user, PriorityNormal)))
+	a.ErrorIs(s.Queue(newFairTestTask(3, user, PriorityNormal)), ErrMaxCapacity, "delayed tasks count towards capacity")
+
+	a.Equal([]int{2}, requestIDs(t, s, 1))
+	_, err := s.Request()
+	a.ErrorIs(err, ErrNoTaskInQueue, "task is not due")
+
+	delayed.OnSuspend(time.Now().Unix())
+	a.Equal([]int{1}, requestIDs(t, s, 1))
+
+	require.NoError(t, s.Shutdown())
+	a.ErrorIs(s.Queue(newFairTestTask(4, user, PriorityNormal)), ErrQueueShutdown)
+	_, err = s.Request()
+	a.ErrorIs(err, ErrQueueShutdown)
+}
@@ -28,6 +28,9 @@ type options struct {
 	resumeTaskType     []string
 	workerCount        int
 	name               string
+	leaseOwner         string
+	leaseDuration      time.Duration
+	scheduler          Scheduler
 }
 
 func newDefaultOptions() *options {
@@ -39,6 +42,7 @@ func newDefaultOptions() *options {
 		resumeTaskType:     []string{},
 		taskPullInterval:   1 * time.Second,
 		name:               "default",
+		leaseDuration:      time.Minute,
 	}
 }
 
@@ -107,3 +111,22 @@ func WithTaskPullInterval(d time.Duration) Option {
 		q.taskPullInterval = d
 	})
 }
+
+// WithLease enables lease based task claiming, so that multiple instances sharing one database
+// execute disjoint sets of persisted tasks. owner identifies this instance, leasing is disabled if it
+// is empty. d is the lease duration.
+func WithLease(owner string, d time.Duration) Option {
+	return OptionFunc(func(q *options) {
+		q.leaseOwner = owner
+		if d > 0 {
+			q.leaseDuration = d
+		}
+	})
+}
+
+// WithScheduler set the Scheduler deciding which Task runs next, defaults to a FIFO scheduler
+func WithScheduler(s Scheduler) Option {
+	return OptionFunc(func(q *options) {
+		q.scheduler = s
+	})
+}
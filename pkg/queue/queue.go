@@ -9,6 +9,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/cloudreve/Cloudreve/v4/ent"
 	"github.com/cloudreve/Cloudreve/v4/ent/task"
 	"github.com/cloudreve/Cloudreve/v4/inventory"
 	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
@@ -46,6 +47,12 @@ type (
 		rootCtx      context.Context
 		cancel       context.CancelFunc
 
+		// leased holds persisted tasks claimed by this instance, lost holds IDs of those
+		// whose lease is taken over by another instance.
+		leaseMu sync.Mutex
+		leased  map[int]Task
+		lost    map[int]struct{}
+
 		// Dependencies
 		logger     logging.Logger
 		taskClient inventory.TaskClient
@@ -72,10 +79,14 @@ func New(l logging.Logger, taskClient inventory.TaskClient, registry TaskRegistr
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
+	scheduler := o.scheduler
+	if scheduler == nil {
+		scheduler = NewFifoScheduler(0, l)
+	}
 
 	return &queue{
 		routineGroup: newRoutineGroup(),
-		scheduler:    NewFifoScheduler(0, l),
+		scheduler:    scheduler,
 		quit:         make(chan struct{}),
 		ready:        make(chan struct{}, 1),
 		metric:       &metric{},
@@ -86,6 +97,8 @@ func New(l logging.Logger, taskClient inventory.TaskClient, registry TaskRegistr
 		dep:          dep,
 		rootCtx:      ctx,
 		cancel:       cancel,
+		leased:       make(map[int]Task),
+		lost:         make(map[int]struct{}),
 	}
 }
 
@@ -94,35 +107,12 @@ func (q *queue) Start() {
 	q.routineGroup.Run(func() {
 		// Resume tasks in DB
 		if len(q.options.resumeTaskType) > 0 && q.taskClient != nil {
+			resumed := q.resume()
+			q.logger.Info("Resumed %d tasks from DB.", resumed)
 
-			ctx := context.TODO()
-			ctx = context.WithValue(ctx, inventory.LoadTaskUser{}, true)
-			ctx = context.WithValue(ctx, inventory.LoadUserGroup{}, true)
-			tasks, err := q.taskClient.GetPendingTasks(ctx, q.resumeTaskType...)
-			if err != nil {
-				q.logger.Warning("Failed to get pending tasks from DB for given type %v: %s", q.resumeTaskType, err)
-			}
-
-			resumed := 0
-			for _, t := range tasks {
-				resumedTask, err := NewTaskFromModel(t)
-				if err != nil {
-					q.logger.Warning("Failed to resume task %d: %s", t.ID, err)
-					continue
-				}
-
-				if resumedTask.Status() == task.StatusSuspending {
-					q.metric.IncSuspendingTask()
-					q.metric.IncSubmittedTask()
-				}
-
-				if err := q.QueueTask(ctx, resumedTask); err != nil {
-					q.logger.Warning("Failed to resume task %d: %s", t.ID, err)
-				}
-				resumed++
+			if q.leaseOwner != "" {
+				q.routineGroup.Run(q.maintainLeases)
 			}
-
-			q.logger.Info("Resumed %d tasks from DB.", resumed)
 		}
 
 		q.start()
@@ -196,6 +186,13 @@ func (q *queue) QueueTask(ctx context.Context, t Task) error {
 	if err := q.scheduler.Queue(t); err != nil {
 		return err
 	}
+
+	if q.leaseOwner != "" && t.Persisted() {
+		q.leaseMu.Lock()
+		q.leased[t.ID()] = t
+		q.leaseMu.Unlock()
+	}
+
 	owner := ""
 	if t.Owner() != nil {
 		owner = t.Owner().Email
@@ -235,9 +232,15 @@ func (q *queue) work(t Task) {
 
 			_ = q.transitStatus(ctx, t, task.StatusError)
 		}
+		q.scheduler.Done(t)
 		q.schedule()
 	}()
 
+	if q.leaseLost(t) {
+		l.Warning("Lease of task %d is taken over by another instance, skipped.", t.ID())
+		return
+	}
+
 	err = q.transitStatus(ctx, t, task.StatusProcessing)
 	if err != nil {
 		l.Error("failed to transit task %d to processing: %s", t.ID(), err.Error())
@@ -248,6 +251,12 @@ func (q *queue) work(t Task) {
 		timeIterationStart = time.Now()
 		var next task.Status
 		next, err = q.run(ctx, t)
+		if q.leaseLost(t) {
+			// Another instance has resumed the task from its last persisted state.
+			l.Warning("Lease of task %d is taken over by another instance, stop executing.", t.ID())
+			break
+		}
+
 		if err != nil {
 			t.OnError(err, time.Since(timeIterationStart))
 			l.Error("runtime error in queue %q: %s", q.name, err.Error())
@@ -437,3 +446,176 @@ func (q *queue) start() {
 		})
 	}
 }
+
+// resume loads pending tasks from DB and queues them on startup. With leasing enabled, only tasks
+// claimed by this instance are queued, including those previously leased by this instance.
+func (q *queue) resume() int {
+	ctx := resumeCtx()
+	var (
+		tasks []*ent.Task
+		err   error
+		now   = time.Now()
+	)
+	if q.leaseOwner != "" {
+		tasks, err = q.taskClient.GetClaimableTasks(ctx, q.leaseOwner, now, q.resumeTaskType...)
+	} else {
+		tasks, err = q.taskClient.GetPendingTasks(ctx, q.resumeTaskType...)
+	}
+	if err != nil {
+		q.logger.Warning("Failed to get pending tasks from DB for given type %v: %s", q.resumeTaskType, err)
+	}
+
+	resumed := 0
+	for _, t := range tasks {
+		if q.leaseOwner != "" && !q.claim(ctx, t.ID, now) {
+			continue
+		}
+
+		if q.queueModel(ctx, t) {
+			resumed++
+		}
+	}
+
+	return resumed
+}
+
+// takeOver claims and queues tasks whose lease has lapsed, e.g. their instance crashed. Only IDs
+// are queried in the first place, a task is loaded once it is claimed.
+func (q *queue) takeOver() int {
+	ctx := resumeCtx()
+	now := time.Now()
+	ids, err := q.taskClient.GetLapsedTaskIDs(ctx, now, q.resumeTaskType...)
+	if err != nil {
+		q.logger.Warning("Failed to get tasks with lapsed lease from DB for given type %v: %s", q.resumeTaskType, err)
+		return 0
+	}
+
+	resumed := 0
+	for _, id := range ids {
+		q.leaseMu.Lock()
+		_, tracked := q.leased[id]
+		q.leaseMu.Unlock()
+
+		// Leases of tracked tasks failed to be renewed, they are still being executed by this instance.
+		if tracked || !q.claim(ctx, id, now) {
+			continue
+		}
+
+		t, err := q.taskClient.GetTaskByID(ctx, id)
+		if err != nil {
+			q.logger.Warning("Failed to load task %d: %s", id, err)
+			continue
+		}
+
+		if q.queueModel(ctx, t) {
+			resumed++
+		}
+	}
+
+	return resumed
+}
+
+// claim leases the task to this instance, returns false if it is claimed by another instance.
+func (q *queue) claim(ctx context.Context, id int, now time.Time) bool {
+	claimed, err := q.taskClient.ClaimTask(ctx, id, q.leaseOwner, now, now.Add(q.leaseDuration))
+	if err != nil {
+		q.logger.Warning("Failed to claim task %d: %s", id, err)
+		return false
+	}
+
+	return claimed
+}
+
+// queueModel queues the task loaded from DB, returns false if it failed.
+func (q *queue) queueModel(ctx context.Context, t *ent.Task) bool {
+	resumedTask, err := NewTaskFromModel(t)
+	if err != nil {
+		q.logger.Warning("Failed to resume task %d: %s", t.ID, err)
+		return false
+	}
+
+	if resumedTask.Status() == task.StatusSuspending {
+		q.metric.IncSuspendingTask()
+		q.metric.IncSubmittedTask()
+	}
+
+	if err := q.QueueTask(ctx, resumedTask); err != nil {
+		q.logger.Warning("Failed to resume task %d: %s", t.ID, err)
+		return false
+	}
+
+	return true
+}
+
+// resumeCtx returns the context to load tasks with their owner and group.
+func resumeCtx() context.Context {
+	ctx := context.TODO()
+	ctx = context.WithValue(ctx, inventory.LoadTaskUser{}, true)
+	ctx = context.WithValue(ctx, inventory.LoadUserGroup{}, true)
+	return ctx
+}
+
+// maintainLeases periodically renews leases of tasks held by this instance, and takes over
+// tasks whose lease has lapsed, e.g. their instance crashed.
+func (q *queue) maintainLeases() {
+	ticker := time.NewTicker(q.leaseDuration / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-q.quit:
+			return
+		case <-ticker.C:
+			q.renewLeases()
+			if resumed := q.takeOver(); resumed > 0 {
+				q.logger.Info("Took over %d tasks with expired lease.", resumed)
+			}
+		}
+	}
+}
+
+func (q *queue) renewLeases() {
+	q.leaseMu.Lock()
+	ids := make([]int, 0, len(q.leased))
+	for id, t := range q.leased {
+		switch t.Status() {
+		case task.StatusCompleted, task.StatusError, task.StatusCanceled:
+			delete(q.leased, id)
+		default:
+			ids = append(ids, id)
+		}
+	}
+	q.leaseMu.Unlock()
+
+	lost, err := q.taskClient.RenewLeases(context.Background(), q.leaseOwner, time.Now().Add(q.leaseDuration), ids...)
+	if err != nil {
+		q.logger.Warning("Failed to renew task leases: %s", err)
+		return
+	}
+
+	if len(lost) > 0 {
+		q.logger.Warning("Lost lease of tasks %v to another instance.", lost)
+		q.leaseMu.Lock()
+		for _, id := range lost {
+			delete(q.leased, id)
+			q.lost[id] = struct{}{}
+		}
+		q.leaseMu.Unlock()
+	}
+}
+
+// leaseLost returns true if the lease of given task is taken over by another instance.
+func (q *queue) leaseLost(t Task) bool {
+	if q.leaseOwner == "" {
+		return false
+	}
+
+	q.leaseMu.Lock()
+	defer q.leaseMu.Unlock()
+	if _, ok := q.lost[t.ID()]; ok {
+		delete(q.lost, t.ID())
+		return true
+	}
+
+	return false
+}
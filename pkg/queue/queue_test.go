@@ -0,0 +1,152 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/application/constants"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/task"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cache"
+	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const leaseTestTaskType = "lease_test"
+
+type leaseTestTask struct {
+	*DBTask
+}
+
+func (t *leaseTestTask) Do(ctx context.Context) (task.Status, error) {
+	return task.StatusCompleted, nil
+}
+
+func init() {
+	RegisterResumableTaskFactory(leaseTestTaskType, func(model *ent.Task) Task {
+		return &leaseTestTask{DBTask: &DBTask{Task: model}}
+	})
+}
+
+// newTestDBClient returns an ent client backed by a migrated in-memory SQLite database.
+func newTestDBClient(t *testing.T) *ent.Client {
+	l := logging.NewConsoleLogger(logging.LevelError)
+	drv, err := entsql.Open(dialect.SQLite, fmt.Sprintf("file:%s?mode=memory", t.Name()))
+	require.NoError(t, err)
+	// In-memory database lives within a single connection.
+	drv.DB().SetMaxOpenConns(1)
+
+	client, err := inventory.InitializeDBClient(l, ent.NewClient(ent.Driver(drv)), cache.NewMemoStore("", l), constants.BackendVersion)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+	return client
+}
+
+func newLeaseQueue(taskClient inventory.TaskClient, owner string) *queue {
+	return New(logging.NewConsoleLogger(logging.LevelError), taskClient, nil, nil,
+		WithLease(owner, time.Minute),
+		WithResumeTaskType(leaseTestTaskType),
+	).(*queue)
+}
+
+func TestQueueLease(t *testing.T) {
+	a := assert.New(t)
+	ctx := context.Background()
+	client := newTestDBClient(t)
+	taskClient := inventory.NewTaskClient(client, conf.SQLiteDB, nil)
+	owner := client.User.Create().SetEmail("owner@cloudreve.org").SetNick("owner").SetGroupID(2).SaveX(ctx)
+
+	now := time.Now()
+	newTask := func(leaseOwner string, expireAt time.Time) int {
+		created, err := taskClient.New(ctx, &inventory.TaskArgs{
+			Status:        task.StatusQueued,
+			Type:          leaseTestTaskType,
+			PublicState:   &types.TaskPublicState{},
+			OwnerID:       owner.ID,
+			CorrelationID: uuid.Must(uuid.NewV4()),
+			LeaseOwner:    leaseOwner,
+			LeaseExpireAt: expireAt,
+		})
+		require.NoError(t, err)
+		return created.ID
+	}
+
+	mine := newTask("a", now.Add(time.Minute))
+	unleased := newTask("", time.Time{})
+	others := newTask("b", now.Add(time.Minute))
+
+	// On startup, tasks previously leased by this instance are resumed along with unleased ones.
+	qa := newLeaseQueue(taskClient, "a")
+	a.Equal(2, qa.resume())
+	a.ElementsMatch([]int{mine, unleased}, leasedIDs(qa))
+
+	// Alive leases are not taken over.
+	qb := newLeaseQueue(taskClient, "b")
+	a.Zero(qb.takeOver())
+	a.Empty(leasedIDs(qb), "tasks leased by itself are queued when they are created")
+
+	// Instance "a" stops renewing, its expired lease is taken over by "b".
+	client.Task.UpdateOneID(mine).SetLeaseExpireAt(now.Add(-time.Second)).ExecX(ctx)
+	a.Equal(1, qb.takeOver())
+	a.Equal([]int{mine}, leasedIDs(qb))
+	a.Equal("b", client.Task.GetX(ctx, mine).LeaseOwner)
+
+	// Instance "a" finds the lease lost on renewal and stops executing the task.
+	qa.renewLeases()
+	a.Equal([]int{unleased}, leasedIDs(qa))
+	a.True(qa.leaseLost(taskWithID(mine)))
+	a.False(qa.leaseLost(taskWithID(unleased)))
+	a.True(client.Task.GetX(ctx, unleased).LeaseExpireAt.After(now.Add(time.Minute)), "lease is renewed")
+	a.Equal("b", client.Task.GetX(ctx, others).LeaseOwner)
+}
+
+func TestQueueWithoutLease(t *testing.T) {
+	a := assert.New(t)
+	ctx := context.Background()
+	client := newTestDBClient(t)
+	taskClient := inventory.NewTaskClient(client, conf.SQLiteDB, nil)
+	owner := client.User.Create().SetEmail("owner@cloudreve.org").SetNick("owner").SetGroupID(2).SaveX(ctx)
+
+	created, err := taskClient.New(ctx, &inventory.TaskArgs{
+		Status:        task.StatusQueued,
+		Type:          leaseTestTaskType,
+		PublicState:   &types.TaskPublicState{},
+		OwnerID:       owner.ID,
+		CorrelationID: uuid.Must(uuid.NewV4()),
+		LeaseOwner:    "b",
+		LeaseExpireAt: time.Now().Add(time.Minute),
+	})
+	require.NoError(t, err)
+
+	// Leasing is disabled without an instance ID, all pending tasks are resumed.
+	q := newLeaseQueue(taskClient, "")
+	a.Equal(1, q.resume())
+	a.Empty(leasedIDs(q))
+	a.False(q.leaseLost(taskWithID(created.ID)))
+}
+
+func leasedIDs(q *queue) []int {
+	q.leaseMu.Lock()
+	defer q.leaseMu.Unlock()
+
+	ids := make([]int, 0, len(q.leased))
+	for id := range q.leased {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func taskWithID(id int) Task {
+	return &leaseTestTask{DBTask: &DBTask{Task: &ent.Task{ID: id}}}
+}
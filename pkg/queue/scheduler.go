@@ -23,6 +23,8 @@ type (
 		Queue(task Task) error
 		// Request get a new Task from the queue
 		Request() (Task, error)
+		// Done is called when a Task returned by Request stops executing
+		Done(task Task)
 		// Shutdown stop all worker
 		Shutdown() error
 	}
@@ -78,6 +80,9 @@ func (s *fifoScheduler) Request() (Task, error) {
 	return data.(Task), nil
 }
 
+// Done is a no-op for fifoScheduler
+func (s *fifoScheduler) Done(task Task) {}
+
 // Shutdown the worker
 func (s *fifoScheduler) Shutdown() error {
 	if !atomic.CompareAndSwapInt32(&s.stopFlag, 0, 1) {
@@ -70,12 +70,22 @@ type (
 
 		// Cleanup is called when the Task is done or error.
 		Cleanup(ctx context.Context) error
+		// Chain returns follow-up steps to run after the Task completes
+		Chain() []types.TaskChainStep
+		// SetChain sets follow-up steps to run after the Task completes
+		SetChain(steps []types.TaskChainStep)
 
 		Lock()
 		Unlock()
 	}
+	// ChainableTask is implemented by tasks whose results can be referenced by follow-up steps.
+	ChainableTask interface {
+		ChainVars() *types.TaskChainVars
+	}
 	ResumableTaskFactory func(model *ent.Task) Task
-	Progress             struct {
+	// TaskDoneHook is called after a task is completed or failed.
+	TaskDoneHook func(ctx context.Context, t Task, status task.Status)
+	Progress     struct {
 		Total      int64  `json:"total"`
 		Current    int64  `json:"current"`
 		Identifier string `json:"identifier"`
@@ -92,6 +102,9 @@ type (
 
 var (
 	taskFactories sync.Map
+
+	taskDoneHooksMu sync.RWMutex
+	taskDoneHooks   []TaskDoneHook
 )
 
 const (
@@ -104,6 +117,10 @@ const (
 	RelocateTaskType              = "relocate"
 	RemoteDownloadTaskType        = "remote_download"
 	ImportTaskType                = "import"
+	EntityChecksumTaskType        = "entity_checksum"
+	ScrubTaskType                 = "scrub"
+	MigratePolicyTaskType         = "migrate_policy"
+	WebhookDeliveryTaskType       = "webhook_delivery"
 
 	FullTextIndexTaskType       = "full_text_index"
 	FullTextCopyTaskType        = "full_text_copy"
@@ -125,6 +142,21 @@ func RegisterResumableTaskFactory(taskType string, factory ResumableTaskFactory)
 	taskFactories.Store(taskType, factory)
 }
 
+// RegisterTaskDoneHook registers a hook called after any task is completed or failed.
+func RegisterTaskDoneHook(hook TaskDoneHook) {
+	taskDoneHooksMu.Lock()
+	defer taskDoneHooksMu.Unlock()
+	taskDoneHooks = append(taskDoneHooks, hook)
+}
+
+func runTaskDoneHooks(ctx context.Context, t Task, status task.Status) {
+	taskDoneHooksMu.RLock()
+	defer taskDoneHooksMu.RUnlock()
+	for _, hook := range taskDoneHooks {
+		hook(ctx, t, status)
+	}
+}
+
 // NewTaskFromModel creates a Task from ent.Task model
 func NewTaskFromModel(model *ent.Task) (Task, error) {
 	if factory, ok := taskFactories.Load(model.Type); ok {
@@ -270,6 +302,25 @@ func (t *DBTask) Cleanup(ctx context.Context) error {
 	return nil
 }
 
+func (t *DBTask) Chain() []types.TaskChainStep {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.Task != nil && t.Task.PublicState != nil {
+		return t.Task.PublicState.Chain
+	}
+	return nil
+}
+
+func (t *DBTask) SetChain(steps []types.TaskChainStep) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.Task != nil && t.Task.PublicState != nil {
+		t.Task.PublicState.Chain = steps
+	}
+}
+
 func (t *DBTask) CorrelationID() uuid.UUID {
 	t.mu.Lock()
 	defer t.mu.Unlock()
@@ -391,7 +442,12 @@ func init() {
 			},
 			task.StatusError: func(ctx context.Context, task Task, newStatus task.Status, q *queue) error {
 				q.metric.IncFailureTask()
-				return persistTask(ctx, task, newStatus, q)
+				if err := persistTask(ctx, task, newStatus, q); err != nil {
+					return err
+				}
+
+				runTaskDoneHooks(ctx, task, newStatus)
+				return nil
 			},
 		},
 		task.StatusProcessing: {
@@ -411,6 +467,8 @@ func init() {
 				if err := persistTask(ctx, task, newStatus, q); err != nil {
 					return err
 				}
+
+				runTaskDoneHooks(ctx, task, newStatus)
 				return nil
 			},
 			task.StatusError: func(ctx context.Context, task Task, newStatus task.Status, q *queue) error {
@@ -429,6 +487,7 @@ func init() {
 					return err
 				}
 
+				runTaskDoneHooks(ctx, task, newStatus)
 				return nil
 			},
 			task.StatusCanceled: func(ctx context.Context, task Task, newStatus task.Status, q *queue) error {
@@ -466,7 +525,12 @@ func init() {
 			},
 			task.StatusError: func(ctx context.Context, task Task, newStatus task.Status, q *queue) error {
 				q.metric.IncFailureTask()
-				return persistTask(ctx, task, newStatus, q)
+				if err := persistTask(ctx, task, newStatus, q); err != nil {
+					return err
+				}
+
+				runTaskDoneHooks(ctx, task, newStatus)
+				return nil
 			},
 		},
 	}
@@ -508,6 +572,7 @@ func saveTaskToInventory(ctx context.Context, task Task, newStatus task.Status,
 			ErrorHistory:     errHistory,
 			Error:            errStr,
 			ResumeTime:       task.ResumeTime(),
+			Chain:            task.Chain(),
 		},
 		PrivateState:  task.State(),
 		OwnerID:       task.Owner().ID,
@@ -520,6 +585,10 @@ func saveTaskToInventory(ctx context.Context, task Task, newStatus task.Status,
 	)
 
 	if !task.Persisted() {
+		if q.leaseOwner != "" {
+			args.LeaseOwner = q.leaseOwner
+			args.LeaseExpireAt = time.Now().Add(q.leaseDuration)
+		}
 		res, err = q.taskClient.New(ctx, args)
 	} else {
 		res, err = q.taskClient.Update(ctx, task.Model(), args)
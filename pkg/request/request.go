@@ -253,11 +253,20 @@ func (resp *Response) CheckHTTPResponse(status ...int) *Response {
 
 	// 检查HTTP状态码
 	if !lo.Contains(status, resp.Response.StatusCode) {
-		resp.Err = fmt.Errorf("Remote returns unexpected status code: %d", resp.Response.StatusCode)
+		resp.Err = &StatusCodeError{StatusCode: resp.Response.StatusCode}
 	}
 	return resp
 }
 
+// StatusCodeError is returned by CheckHTTPResponse when remote returns an unexpected status code.
+type StatusCodeError struct {
+	StatusCode int
+}
+
+func (e *StatusCodeError) Error() string {
+	return fmt.Sprintf("Remote returns unexpected status code: %d", e.StatusCode)
+}
+
 // DecodeResponse 尝试解析为serializer.Response，并对状态码进行检查
 func (resp *Response) DecodeResponse() (*serializer.Response, error) {
 	if resp.Err != nil {
@@ -23,6 +23,10 @@ var downloadAllowedSchemes = map[string]struct{}{
 	"ftps":   {},
 	"sftp":   {},
 	"magnet": {},
+	// Folder sources pulled by the built-in downloader
+	"webdav":    {},
+	"webdavs":   {},
+	"cloudreve": {},
 }
 
 // trackerAllowedSchemes are schemes valid inside magnet tr=/ws=/xs=/as= params
@@ -288,3 +292,75 @@ func checkIP(ip net.IP) error {
 	}
 	return nil
 }
+
+// SafeDialContext returns a DialContext function enforcing the same policy as
+// ValidateExternalURL on every outgoing connection. Resolved IPs are checked
+// right before dialing, so redirects and DNS rebinding cannot reach an address
+// that a pre-flight URL check would have rejected.
+func SafeDialContext(opt SSRFOptions, dialer *net.Dialer) func(ctx context.Context, network, addr string) (net.Conn, error) {
+	if dialer == nil {
+		dialer = &net.Dialer{}
+	}
+
+	if opt.Disabled {
+		return dialer.DialContext
+	}
+
+	allowed := parseCIDRs(opt.AllowedCIDRs)
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		host, port, err := net.SplitHostPort(addr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid address %q: %w", addr, ErrUnsafeURL)
+		}
+
+		for _, allowedHost := range opt.AllowedHosts {
+			if strings.EqualFold(strings.TrimSpace(allowedHost), host) {
+				return dialer.DialContext(ctx, network, addr)
+			}
+		}
+
+		lowered := strings.ToLower(host)
+		if _, banned := bannedHostnames[lowered]; banned || strings.HasSuffix(lowered, ".localhost") {
+			return nil, fmt.Errorf("hostname %q is local: %w", host, ErrUnsafeURL)
+		}
+
+		var ips []net.IP
+		if ip := net.ParseIP(host); ip != nil {
+			ips = []net.IP{ip}
+		} else {
+			resolver := opt.Resolver
+			if resolver == nil {
+				resolver = net.DefaultResolver
+			}
+			addrs, err := resolver.LookupIPAddr(ctx, host)
+			if err != nil {
+				return nil, fmt.Errorf("resolve %q: %w", host, ErrUnsafeURL)
+			}
+			for _, a := range addrs {
+				ips = append(ips, a.IP)
+			}
+		}
+
+		if len(ips) == 0 {
+			return nil, fmt.Errorf("no addresses for %q: %w", host, ErrUnsafeURL)
+		}
+
+		// Connect to the checked IP directly instead of the hostname, so the
+		// address cannot change between the check and the dial.
+		lastErr := fmt.Errorf("no safe addresses for %q: %w", host, ErrUnsafeURL)
+		for _, ip := range ips {
+			if err := checkIPWithAllowlist(ip, allowed); err != nil {
+				lastErr = err
+				continue
+			}
+
+			conn, err := dialer.DialContext(ctx, network, net.JoinHostPort(ip.String(), port))
+			if err == nil {
+				return conn, nil
+			}
+			lastErr = err
+		}
+
+		return nil, lastErr
+	}
+}
@@ -5,6 +5,7 @@ package ssrftest
 
 import (
 	"context"
+	"net"
 	"testing"
 
 	"github.com/cloudreve/Cloudreve/v4/pkg/request"
@@ -161,3 +162,32 @@ func TestValidateExternalURL_Magnet(t *testing.T) {
 		"magnet:?xt=urn:btih:abc&tr=udp://tracker.opentrackr.org:1337/announce",
 		request.SSRFOptions{}))
 }
+
+func TestSafeDialContext(t *testing.T) {
+	ctx := context.Background()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Skipf("cannot listen on loopback: %s", err)
+	}
+	defer l.Close()
+	_, port, _ := net.SplitHostPort(l.Addr().String())
+
+	// Loopback is rejected at dial time.
+	dial := request.SafeDialContext(request.SSRFOptions{}, nil)
+	_, err = dial(ctx, "tcp", net.JoinHostPort("127.0.0.1", port))
+	assert.ErrorIs(t, err, request.ErrUnsafeURL)
+	_, err = dial(ctx, "tcp", net.JoinHostPort("localhost", port))
+	assert.ErrorIs(t, err, request.ErrUnsafeURL)
+
+	// Allowlisted CIDR, allowed host and disabled policy can connect.
+	for _, opt := range []request.SSRFOptions{
+		{AllowedCIDRs: []string{"127.0.0.0/8"}},
+		{AllowedHosts: []string{"127.0.0.1"}},
+		{Disabled: true},
+	} {
+		conn, err := request.SafeDialContext(opt, nil)(ctx, "tcp", net.JoinHostPort("127.0.0.1", port))
+		if assert.NoError(t, err) {
+			conn.Close()
+		}
+	}
+}
@@ -64,12 +64,18 @@ type (
 		DBFS(ctx context.Context) *DBFS
 		// MaxBatchedFile returns the maximum number of files in a batch operation.
 		MaxBatchedFile(ctx context.Context) int
+		// ShareAccessLogRetention returns the days share access logs are kept, 0 means forever.
+		ShareAccessLogRetention(ctx context.Context) int
 		// UploadSessionTTL returns the TTL of upload session.
 		UploadSessionTTL(ctx context.Context) time.Duration
 		// MaxOnlineEditSize returns the maximum size of online editing.
 		MaxOnlineEditSize(ctx context.Context) int64
 		// SlaveRequestSignTTL returns the TTL of slave request signature.
 		SlaveRequestSignTTL(ctx context.Context) int
+		// NodeHealthCheck returns the heartbeat settings of slave nodes.
+		NodeHealthCheck(ctx context.Context) *NodeHealthCheck
+		// UserSchedule returns the settings of scheduled workflows created by users.
+		UserSchedule(ctx context.Context) *UserSchedule
 		// ChunkRetryLimit returns the maximum number of chunk retries.
 		ChunkRetryLimit(ctx context.Context) int
 		// UseChunkBuffer returns true if chunk buffer is enabled.
@@ -669,6 +675,7 @@ func (s *settingProvider) Queue(ctx context.Context, queueType QueueType) *Queue
 		BackoffMaxDuration: time.Duration(s.getInt(ctx, "queue_"+queueTypeStr+"_backoff_max_duration", 3600)) * time.Second,
 		MaxRetry:           s.getInt(ctx, "queue_"+queueTypeStr+"_max_retry", 5),
 		RetryDelay:         time.Duration(s.getInt(ctx, "queue_"+queueTypeStr+"_retry_delay", 5)) * time.Second,
+		FairScheduling:     s.getBoolean(ctx, "queue_"+queueTypeStr+"_fair_scheduling", false),
 	}
 }
 
@@ -696,6 +703,27 @@ func (s *settingProvider) MaxBatchedFile(ctx context.Context) int {
 	return s.getInt(ctx, "max_batched_file", 3000)
 }
 
+func (s *settingProvider) NodeHealthCheck(ctx context.Context) *NodeHealthCheck {
+	return &NodeHealthCheck{
+		Interval:            time.Duration(s.getInt(ctx, "node_health_check_interval", 30)) * time.Second,
+		Timeout:             time.Duration(s.getInt(ctx, "node_health_check_timeout", 10)) * time.Second,
+		MaxFailures:         s.getInt(ctx, "node_health_check_max_failures", 3),
+		FailoverGracePeriod: time.Duration(s.getInt(ctx, "node_failover_grace_period", 300)) * time.Second,
+	}
+}
+
+func (s *settingProvider) UserSchedule(ctx context.Context) *UserSchedule {
+	return &UserSchedule{
+		MinInterval:  time.Duration(s.getInt(ctx, "schedule_min_interval", 3600)) * time.Second,
+		MaxPerUser:   s.getInt(ctx, "schedule_max_per_user", 20),
+		RunRetention: s.getInt(ctx, "schedule_run_retention", 50),
+	}
+}
+
+func (s *settingProvider) ShareAccessLogRetention(ctx context.Context) int {
+	return s.getInt(ctx, "share_access_log_retention", 90)
+}
+
 func (s *settingProvider) DBFS(ctx context.Context) *DBFS {
 	return &DBFS{
 		UseCursorPagination:        s.getBoolean(ctx, "use_cursor_pagination", true),
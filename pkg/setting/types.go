@@ -88,9 +88,31 @@ type (
 		BackoffMaxDuration time.Duration
 		MaxRetry           int
 		RetryDelay         time.Duration
+		// FairScheduling schedules tasks by priority and per-user fair share instead of FIFO.
+		FairScheduling bool
 	}
 )
 
+// NodeHealthCheck is the setting of slave node heartbeats.
+type NodeHealthCheck struct {
+	Interval time.Duration
+	Timeout  time.Duration
+	// MaxFailures is the number of consecutive failed heartbeats before a node is marked unhealthy.
+	MaxFailures int
+	// FailoverGracePeriod is how long tasks wait for an unhealthy node to recover before they are
+	// re-dispatched to another node.
+	FailoverGracePeriod time.Duration
+}
+
+// UserSchedule is the setting of scheduled workflows created by users.
+type UserSchedule struct {
+	// MinInterval is the minimum interval between two runs of a schedule.
+	MinInterval time.Duration
+	MaxPerUser  int
+	// RunRetention is the number of latest runs kept in history of each schedule.
+	RunRetention int
+}
+
 type ThumbEncode struct {
 	Quality int
 	Format  string
@@ -103,6 +125,7 @@ var (
 	QueueTypeEntityRecycle  = QueueType("recycle")
 	QueueTypeSlave          = QueueType("slave")
 	QueueTypeRemoteDownload = QueueType("remote_download")
+	QueueTypeWebhook        = QueueType("webhook")
 )
 
 type CronType string
@@ -111,6 +134,9 @@ var (
 	CronTypeEntityCollect    = CronType("entity_collect")
 	CronTypeTrashBinCollect  = CronType("trash_bin_collect")
 	CronTypeOauthCredRefresh = CronType("oauth_cred_refresh")
+	CronTypeLifecycle        = CronType("lifecycle")
+	CronTypeShareAccessLog   = CronType("share_access_log_collect")
+	CronTypeUserSchedule     = CronType("user_schedule")
 )
 
 type Theme struct {
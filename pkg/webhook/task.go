@@ -0,0 +1,228 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/constants"
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/task"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/gofrs/uuid"
+)
+
+type (
+	// DeliveryTask posts a signed event payload to a webhook endpoint, failed deliveries are
+	// retried by the queue with backoff.
+	DeliveryTask struct {
+		*queue.DBTask
+	}
+	DeliveryTaskState struct {
+		WebhookID  int       `json:"webhook_id"`
+		Event      EventType `json:"event"`
+		DeliveryID string    `json:"delivery_id"`
+		Payload    string    `json:"payload"`
+	}
+)
+
+const (
+	// maxResponseLogged is the max bytes of response body recorded in delivery log on failure.
+	maxResponseLogged = 1024
+	// maxRedirects is the max number of redirects followed by a delivery.
+	maxRedirects = 5
+)
+
+func init() {
+	queue.RegisterResumableTaskFactory(queue.WebhookDeliveryTaskType, NewDeliveryTaskFromModel)
+	queue.RegisterTaskDoneHook(onTaskDone)
+}
+
+// NewDeliveryTask creates a new DeliveryTask.
+func NewDeliveryTask(ctx context.Context, u *ent.User, webhookID int, event EventType, deliveryID, payload string) (queue.Task, error) {
+	state := &DeliveryTaskState{
+		WebhookID:  webhookID,
+		Event:      event,
+		DeliveryID: deliveryID,
+		Payload:    payload,
+	}
+	stateBytes, err := json.Marshal(state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal state: %w", err)
+	}
+
+	return &DeliveryTask{
+		DBTask: &queue.DBTask{
+			Task: &ent.Task{
+				Type:          queue.WebhookDeliveryTaskType,
+				CorrelationID: logging.CorrelationID(ctx),
+				PrivateState:  string(stateBytes),
+				PublicState:   &types.TaskPublicState{},
+			},
+			DirectOwner: u,
+		},
+	}, nil
+}
+
+func NewDeliveryTaskFromModel(t *ent.Task) queue.Task {
+	return &DeliveryTask{
+		DBTask: &queue.DBTask{
+			Task: t,
+		},
+	}
+}
+
+func (t *DeliveryTask) Do(ctx context.Context) (task.Status, error) {
+	dep := dependency.FromContext(ctx)
+	state := &DeliveryTaskState{}
+	if err := json.Unmarshal([]byte(t.State()), state); err != nil {
+		return task.StatusError, fmt.Errorf("failed to unmarshal state: %s (%w)", err, queue.CriticalErr)
+	}
+
+	webhookClient := dep.WebhookClient()
+	hook, err := webhookClient.GetByID(ctx, state.WebhookID)
+	if err != nil {
+		return task.StatusError, fmt.Errorf("failed to get webhook %d: %s (%w)", state.WebhookID, err, queue.CriticalErr)
+	}
+
+	if !hook.Enabled {
+		return task.StatusCanceled, nil
+	}
+
+	start := time.Now()
+	statusCode, err := t.deliver(ctx, dep, hook, state)
+	deliveryID, _ := uuid.FromString(state.DeliveryID)
+	log := &inventory.WebhookDeliveryArgs{
+		WebhookID:  hook.ID,
+		Event:      string(state.Event),
+		DeliveryID: deliveryID,
+		Payload:    state.Payload,
+		StatusCode: statusCode,
+		Attempt:    t.Retried() + 1,
+		Duration:   time.Since(start),
+	}
+	if err != nil {
+		log.Error = err.Error()
+	}
+
+	if logErr := webhookClient.LogDelivery(ctx, log); logErr != nil {
+		dep.Logger().Warning("Failed to log delivery of webhook %d: %s", hook.ID, logErr)
+	}
+
+	if err != nil {
+		return task.StatusError, err
+	}
+
+	return task.StatusCompleted, nil
+}
+
+func (t *DeliveryTask) deliver(ctx context.Context, dep dependency.Dep, hook *ent.Webhook, state *DeliveryTaskState) (int, error) {
+	external := hook.OwnerID != nil
+	if err := ValidateURL(ctx, dep, hook.URL, external); err != nil {
+		return 0, fmt.Errorf("invalid endpoint: %s (%w)", err, queue.CriticalErr)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.URL, strings.NewReader(state.Payload))
+	if err != nil {
+		return 0, fmt.Errorf("invalid endpoint: %s (%w)", err, queue.CriticalErr)
+	}
+
+	signer := auth.HMACAuth{SecretKey: []byte(hook.Secret)}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Cloudreve/"+constants.BackendVersion)
+	req.Header.Set(HeaderEvent, string(state.Event))
+	req.Header.Set(HeaderDelivery, state.DeliveryID)
+	req.Header.Set(HeaderSignature, signer.Sign(state.Payload, time.Now().Add(signatureTTL).Unix()))
+
+	resp, err := newDeliveryClient(ssrfOptions(ctx, dep, external)).Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to send request: %w", err)
+	}
+
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		// Logs of user webhooks are readable by their owner, response body is never recorded so
+		// that endpoints cannot be used to read internal services.
+		if external {
+			return resp.StatusCode, fmt.Errorf("endpoint returns unexpected status code %d", resp.StatusCode)
+		}
+
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseLogged))
+		return resp.StatusCode, fmt.Errorf("endpoint returns unexpected status code %d: %s", resp.StatusCode, string(body))
+	}
+
+	return resp.StatusCode, nil
+}
+
+// newDeliveryClient creates a HTTP client enforcing the given SSRF policy on every connection
+// and redirect, resolved addresses might change after the endpoint is validated.
+func newDeliveryClient(opt request.SSRFOptions) *http.Client {
+	transport := &http.Transport{
+		DialContext: request.SafeDialContext(opt, &net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}),
+		ForceAttemptHTTP2:   true,
+		TLSHandshakeTimeout: 10 * time.Second,
+	}
+	if opt.Disabled {
+		// Proxies would hide the real target from SSRF check, only use them when it is disabled.
+		transport.Proxy = http.ProxyFromEnvironment
+	}
+
+	return &http.Client{
+		Timeout:   deliveryTimeout,
+		Transport: transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= maxRedirects {
+				return fmt.Errorf("stopped after %d redirects", maxRedirects)
+			}
+
+			if scheme := strings.ToLower(req.URL.Scheme); scheme != "http" && scheme != "https" {
+				return fmt.Errorf("redirect to unsupported scheme %q", req.URL.Scheme)
+			}
+
+			return request.ValidateExternalURL(req.Context(), req.URL.String(), opt)
+		},
+	}
+}
+
+// onTaskDone dispatches events of completed or failed user tasks.
+func onTaskDone(ctx context.Context, t queue.Task, status task.Status) {
+	if t.Type() == queue.WebhookDeliveryTaskType || !t.ShouldPersist() {
+		return
+	}
+
+	dep, ok := ctx.Value(dependency.DepCtx{}).(dependency.Dep)
+	if !ok {
+		return
+	}
+
+	data := &TaskEventData{
+		TaskID: hashid.EncodeTaskID(dep.HashIDEncoder(), t.ID()),
+		Type:   t.Type(),
+		Status: string(status),
+	}
+
+	event := EventTaskCompleted
+	if status == task.StatusError {
+		event = EventTaskFailed
+		if err := t.Error(); err != nil {
+			data.Error = err.Error()
+		}
+	}
+
+	Dispatch(ctx, t.Owner(), event, data)
+}
@@ -0,0 +1,226 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/constants"
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/gofrs/uuid"
+	"github.com/samber/lo"
+)
+
+type (
+	EventType string
+
+	// Payload is the JSON body posted to webhook endpoints.
+	Payload struct {
+		ID    string    `json:"id"`
+		Event EventType `json:"event"`
+		Time  time.Time `json:"time"`
+		// User is the hashed ID of the user that the event belongs to.
+		User string `json:"user,omitempty"`
+		Data any    `json:"data,omitempty"`
+	}
+
+	FileEventData struct {
+		FileID string `json:"file_id"`
+		Uri    string `json:"uri"`
+		To     string `json:"to,omitempty"`
+	}
+
+	ShareEventData struct {
+		ShareID string `json:"share_id"`
+		Uri     string `json:"uri,omitempty"`
+		Action  string `json:"action,omitempty"`
+		IP      string `json:"ip,omitempty"`
+		Visitor string `json:"visitor,omitempty"`
+	}
+
+	TaskEventData struct {
+		TaskID string `json:"task_id"`
+		Type   string `json:"type"`
+		Status string `json:"status"`
+		Error  string `json:"error,omitempty"`
+	}
+
+	UserEventData struct {
+		UserID string `json:"user_id"`
+		Email  string `json:"email"`
+		Nick   string `json:"nick"`
+	}
+)
+
+const (
+	EventFileCreated     = EventType("file.created")
+	EventFileModified    = EventType("file.modified")
+	EventFileRenamed     = EventType("file.renamed")
+	EventFileDeleted     = EventType("file.deleted")
+	EventUploadCompleted = EventType("upload.completed")
+	EventShareCreated    = EventType("share.created")
+	EventShareAccessed   = EventType("share.accessed")
+	EventTaskCompleted   = EventType("task.completed")
+	EventTaskFailed      = EventType("task.failed")
+	EventUserRegistered  = EventType("user.registered")
+)
+
+const (
+	HeaderEvent     = constants.CrHeaderPrefix + "Webhook-Event"
+	HeaderDelivery  = constants.CrHeaderPrefix + "Webhook-Delivery"
+	HeaderSignature = constants.CrHeaderPrefix + "Webhook-Signature"
+
+	// signatureTTL is the validity period of delivery signature.
+	signatureTTL = 5 * time.Minute
+	// deliveryTimeout is the timeout of a single delivery attempt.
+	deliveryTimeout = 30 * time.Second
+)
+
+// Events lists all supported event types.
+var Events = []EventType{
+	EventFileCreated,
+	EventFileModified,
+	EventFileRenamed,
+	EventFileDeleted,
+	EventUploadCompleted,
+	EventShareCreated,
+	EventShareAccessed,
+	EventTaskCompleted,
+	EventTaskFailed,
+	EventUserRegistered,
+}
+
+// Dispatch queues delivery of the event of given user to all subscribed webhooks. Failures
+// are logged and never returned, so that webhooks cannot break the triggering operation.
+func Dispatch(ctx context.Context, u *ent.User, event EventType, data any) {
+	dep, ok := ctx.Value(dependency.DepCtx{}).(dependency.Dep)
+	if !ok || u == nil || inventory.IsAnonymousUser(u) {
+		return
+	}
+
+	// Subscriptions are cached, on cache miss they are loaded within the transaction of
+	// triggering operation if any.
+	l := dep.Logger()
+	webhookClient, tx := inventory.InheritTx(ctx, dep.WebhookClient())
+	hooks, err := webhookClient.ListSubscribed(ctx, u.ID, string(event))
+	if err != nil {
+		l.Warning("Failed to list webhooks for event %q: %s", event, err)
+		return
+	}
+
+	if len(hooks) == 0 {
+		return
+	}
+
+	payload := &Payload{
+		ID:    uuid.Must(uuid.NewV4()).String(),
+		Event: event,
+		Time:  time.Now(),
+		User:  hashid.EncodeUserID(dep.HashIDEncoder(), u.ID),
+		Data:  data,
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		l.Warning("Failed to marshal webhook payload for event %q: %s", event, err)
+		return
+	}
+
+	// Deliveries of operations within a transaction are queued once it is committed, so that
+	// rolled back operations never fire events.
+	ctx = context.WithoutCancel(ctx)
+	if tx != nil {
+		tx.AfterCommit(func() {
+			queueDeliveries(ctx, dep, u, hooks, event, payload.ID, string(body))
+		})
+		return
+	}
+
+	queueDeliveries(ctx, dep, u, hooks, event, payload.ID, string(body))
+}
+
+func queueDeliveries(ctx context.Context, dep dependency.Dep, u *ent.User, hooks []inventory.WebhookSubscription,
+	event EventType, deliveryID, body string) {
+	l := dep.Logger()
+	q := dep.WebhookQueue(ctx)
+	for _, hook := range hooks {
+		t, err := NewDeliveryTask(ctx, u, hook.ID, event, deliveryID, body)
+		if err != nil {
+			l.Warning("Failed to create delivery task for webhook %d: %s", hook.ID, err)
+			continue
+		}
+
+		if err := q.QueueTask(ctx, t); err != nil {
+			l.Warning("Failed to queue delivery task for webhook %d: %s", hook.ID, err)
+		}
+	}
+}
+
+// DispatchShareAccessed dispatches share access event to webhooks of share owner.
+func DispatchShareAccessed(ctx context.Context, owner *ent.User, args *inventory.ShareAccessArgs) {
+	dep, ok := ctx.Value(dependency.DepCtx{}).(dependency.Dep)
+	if !ok {
+		return
+	}
+
+	hasher := dep.HashIDEncoder()
+	data := &ShareEventData{
+		ShareID: hashid.EncodeShareID(hasher, args.ShareID),
+		Uri:     args.Path,
+		Action:  string(args.Action),
+		IP:      args.IP,
+	}
+	if args.UserID > 0 {
+		data.Visitor = hashid.EncodeUserID(hasher, args.UserID)
+	}
+
+	Dispatch(ctx, owner, EventShareAccessed, data)
+}
+
+// ValidateEvents returns error if any of given events is not supported.
+func ValidateEvents(events []string) error {
+	for _, e := range events {
+		if !lo.Contains(Events, EventType(e)) {
+			return fmt.Errorf("unknown event %q", e)
+		}
+	}
+
+	return nil
+}
+
+// ValidateURL checks the endpoint of webhook. Endpoints of user webhooks must be public addresses.
+func ValidateURL(ctx context.Context, dep dependency.Dep, raw string, external bool) error {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return fmt.Errorf("invalid url: %w", err)
+	}
+
+	if scheme := strings.ToLower(u.Scheme); scheme != "http" && scheme != "https" {
+		return fmt.Errorf("unsupported scheme %q", u.Scheme)
+	}
+
+	return request.ValidateExternalURL(ctx, raw, ssrfOptions(ctx, dep, external))
+}
+
+// ssrfOptions returns the SSRF policy of webhook endpoints, only endpoints of user webhooks
+// are restricted.
+func ssrfOptions(ctx context.Context, dep dependency.Dep, external bool) request.SSRFOptions {
+	if !external {
+		return request.SSRFOptions{Disabled: true}
+	}
+
+	opt := request.SSRFOptions{}
+	for _, site := range dep.SettingProvider().AllSiteURLs(ctx) {
+		if h := site.Hostname(); h != "" {
+			opt.AllowedHosts = append(opt.AllowedHosts, h)
+		}
+	}
+
+	return opt
+}
@@ -0,0 +1,266 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/application/dependency/deptest"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/task"
+	"github.com/cloudreve/Cloudreve/v4/ent/webhookdelivery"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth"
+	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type receivedDelivery struct {
+	header http.Header
+	body   string
+}
+
+// endpoint records deliveries, and fails the first `failures` of them.
+func endpoint(t *testing.T, failures int) (*httptest.Server, func() []receivedDelivery) {
+	var (
+		mu       sync.Mutex
+		received []receivedDelivery
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		received = append(received, receivedDelivery{header: r.Header.Clone(), body: string(body)})
+		n := len(received)
+		mu.Unlock()
+
+		if n <= failures {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = io.WriteString(w, "try again")
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv, func() []receivedDelivery {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]receivedDelivery(nil), received...)
+	}
+}
+
+func newWebhook(t *testing.T, dep dependency.Dep, url string, owner *int, events ...string) *ent.Webhook {
+	hook, err := dep.WebhookClient().Upsert(context.Background(), &ent.Webhook{
+		Name:    "test",
+		URL:     url,
+		Secret:  "secret",
+		Events:  events,
+		Enabled: true,
+		OwnerID: owner,
+	})
+	require.NoError(t, err)
+	return hook
+}
+
+func deliveries(t *testing.T, dep dependency.Dep, hookID int) []*ent.WebhookDelivery {
+	res, err := dep.DBClient().WebhookDelivery.Query().
+		Where(webhookdelivery.WebhookID(hookID)).
+		Order(ent.Asc(webhookdelivery.FieldID)).
+		All(context.Background())
+	require.NoError(t, err)
+	return res
+}
+
+// queuedDelivery waits for the delivery task queued in background by Dispatch.
+func queuedDelivery(t *testing.T, dep dependency.Dep) *DeliveryTask {
+	var tasks []*ent.Task
+	require.Eventually(t, func() bool {
+		tasks, _ = dep.DBClient().Task.Query().All(context.Background())
+		return len(tasks) > 0
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Len(t, tasks, 1)
+
+	return NewDeliveryTaskFromModel(tasks[0]).(*DeliveryTask)
+}
+
+func TestDeliverySignatureAndRetry(t *testing.T) {
+	a := assert.New(t)
+	dep := deptest.New(t)
+	u := deptest.NewUser(t, dep, "u@cloudreve.org", 2)
+	ctx := deptest.Context(dep, u)
+
+	srv, received := endpoint(t, 1)
+	hook := newWebhook(t, dep, srv.URL, nil, string(EventFileCreated))
+
+	Dispatch(ctx, u, EventFileCreated, &FileEventData{FileID: "f", Uri: "cloudreve://my/a.txt"})
+	dt := queuedDelivery(t, dep)
+
+	// First attempt fails with a retryable error
+	status, err := dt.Do(ctx)
+	a.Equal(task.StatusError, status)
+	require.Error(t, err)
+	a.NotErrorIs(err, queue.CriticalErr)
+	dt.OnRetry(err)
+
+	status, err = dt.Do(ctx)
+	require.NoError(t, err)
+	a.Equal(task.StatusCompleted, status)
+
+	reqs := received()
+	require.Len(t, reqs, 2)
+	a.Equal(reqs[0].body, reqs[1].body, "retries must post the same payload")
+	a.Equal(reqs[0].header.Get(HeaderDelivery), reqs[1].header.Get(HeaderDelivery))
+	for _, r := range reqs {
+		a.Equal(string(EventFileCreated), r.header.Get(HeaderEvent))
+		a.NoError(auth.HMACAuth{SecretKey: []byte("secret")}.Check(r.body, r.header.Get(HeaderSignature)))
+		a.Error(auth.HMACAuth{SecretKey: []byte("other")}.Check(r.body, r.header.Get(HeaderSignature)))
+	}
+
+	var payload Payload
+	require.NoError(t, json.Unmarshal([]byte(reqs[0].body), &payload))
+	a.Equal(EventFileCreated, payload.Event)
+	a.Equal(payload.ID, reqs[0].header.Get(HeaderDelivery))
+
+	logs := deliveries(t, dep, hook.ID)
+	require.Len(t, logs, 2)
+	a.Equal(http.StatusInternalServerError, logs[0].StatusCode)
+	a.Equal(1, logs[0].Attempt)
+	a.Contains(logs[0].Error, "try again")
+	a.Equal(http.StatusOK, logs[1].StatusCode)
+	a.Equal(2, logs[1].Attempt)
+	a.Empty(logs[1].Error)
+	a.Equal(reqs[0].body, logs[1].Payload)
+}
+
+func TestDispatchSubscription(t *testing.T) {
+	a := assert.New(t)
+	dep := deptest.New(t)
+	u1 := deptest.NewUser(t, dep, "u1@cloudreve.org", 2)
+	u2 := deptest.NewUser(t, dep, "u2@cloudreve.org", 2)
+
+	// No webhooks, nothing is queued
+	Dispatch(deptest.Context(dep, u1), u1, EventFileCreated, &FileEventData{})
+
+	// Subscription cache is invalidated once webhook is created
+	hook := newWebhook(t, dep, "http://127.0.0.1/hook", &u2.ID, string(EventFileDeleted))
+	Dispatch(deptest.Context(dep, u1), u1, EventFileDeleted, &FileEventData{})
+	Dispatch(deptest.Context(dep, u2), u2, EventFileCreated, &FileEventData{})
+	Dispatch(deptest.Context(dep, u2), u2, EventFileDeleted, &FileEventData{})
+
+	dt := queuedDelivery(t, dep)
+	var state DeliveryTaskState
+	require.NoError(t, json.Unmarshal([]byte(dt.State()), &state))
+	a.Equal(hook.ID, state.WebhookID)
+	a.Equal(EventFileDeleted, state.Event)
+	a.Equal(u2.ID, dt.Task.UserTasks)
+
+	// Endpoints of user webhooks must be public
+	status, err := dt.Do(deptest.Context(dep, u2))
+	a.Equal(task.StatusError, status)
+	a.ErrorIs(err, queue.CriticalErr)
+	logs := deliveries(t, dep, hook.ID)
+	require.Len(t, logs, 1)
+	a.Contains(logs[0].Error, "invalid endpoint")
+
+	// Disabled webhooks are no longer subscribed
+	hook.Enabled = false
+	_, err = dep.WebhookClient().Upsert(context.Background(), hook)
+	require.NoError(t, err)
+	subscribed, err := dep.WebhookClient().ListSubscribed(context.Background(), u2.ID, string(EventFileDeleted))
+	require.NoError(t, err)
+	a.Empty(subscribed)
+}
+
+func TestListSubscribedInTransaction(t *testing.T) {
+	dep := deptest.New(t)
+	u := deptest.NewUser(t, dep, "u@cloudreve.org", 2)
+	newWebhook(t, dep, "http://127.0.0.1/hook", nil)
+	dep.KV().Delete("", inventory.WebhookSubscriptionCacheKey)
+
+	// SQLite allows only one connection, dispatching within a transaction must not deadlock.
+	_, tx, ctx, err := inventory.WithTx(deptest.Context(dep, u), dep.FileClient())
+	require.NoError(t, err)
+	done := make(chan struct{})
+	go func() {
+		Dispatch(ctx, u, EventFileCreated, &FileEventData{})
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("dispatch blocked by transaction")
+	}
+	require.NoError(t, inventory.Commit(tx))
+	queuedDelivery(t, dep)
+}
+
+func TestDispatchRolledBack(t *testing.T) {
+	dep := deptest.New(t)
+	u := deptest.NewUser(t, dep, "u@cloudreve.org", 2)
+	newWebhook(t, dep, "http://127.0.0.1/hook", nil, string(EventFileCreated))
+
+	_, tx, ctx, err := inventory.WithTx(deptest.Context(dep, u), dep.FileClient())
+	require.NoError(t, err)
+	Dispatch(ctx, u, EventFileCreated, &FileEventData{})
+	require.NoError(t, inventory.Rollback(tx))
+
+	n, err := dep.DBClient().Task.Query().Count(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, n, "events of rolled back operations are dropped")
+}
+
+func TestDeliveryUserWebhook(t *testing.T) {
+	a := assert.New(t)
+	dep := deptest.New(t)
+	u := deptest.NewUser(t, dep, "u@cloudreve.org", 2)
+	ctx := deptest.Context(dep, u)
+
+	// Host of site URL is allowed for user webhooks, redirects are checked again.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/private":
+			http.Redirect(w, r, "http://10.0.0.1/hook", http.StatusFound)
+		case "/loopback":
+			http.Redirect(w, r, "http://127.0.0.1/hook", http.StatusTemporaryRedirect)
+		default:
+			w.WriteHeader(http.StatusBadGateway)
+			_, _ = io.WriteString(w, "internal response")
+		}
+	}))
+	t.Cleanup(srv.Close)
+	_, port, _ := net.SplitHostPort(srv.Listener.Addr().String())
+	base := "http://localhost:" + port
+
+	for i, path := range []string{"/failed", "/private", "/loopback"} {
+		hook := newWebhook(t, dep, base+path, &u.ID, string(EventFileCreated))
+		Dispatch(ctx, u, EventFileCreated, &FileEventData{})
+		dt := queuedDelivery(t, dep)
+		require.NoError(t, dep.DBClient().Task.DeleteOneID(dt.ID()).Exec(context.Background()))
+
+		status, err := dt.Do(ctx)
+		a.Equal(task.StatusError, status, path)
+		require.Error(t, err, path)
+		logs := deliveries(t, dep, hook.ID)
+		require.Len(t, logs, 1, path)
+
+		if i == 0 {
+			a.Equal(http.StatusBadGateway, logs[0].StatusCode)
+			a.NotContains(logs[0].Error, "internal response", "response of user webhooks is not logged")
+		} else {
+			a.ErrorIs(err, request.ErrUnsafeURL, path)
+			a.Zero(logs[0].StatusCode, path)
+		}
+
+		hook.Enabled = false
+		_, err = dep.WebhookClient().Upsert(context.Background(), hook)
+		require.NoError(t, err)
+	}
+}
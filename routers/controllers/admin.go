@@ -364,6 +364,118 @@ func AdminDeleteGroup(c *gin.Context) {
 	c.JSON(200, serializer.Response{})
 }
 
+func AdminListTeams(c *gin.Context) {
+	res, err := admin.ListTeams(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminGetTeam(c *gin.Context) {
+	service := ParametersFromContext[*admin.SingleTeamService](c, admin.SingleTeamParamCtx{})
+	res, err := service.Get(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminCreateTeam(c *gin.Context) {
+	service := ParametersFromContext[*admin.UpsertTeamService](c, admin.UpsertTeamParamCtx{})
+	res, err := service.Create(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminUpdateTeam(c *gin.Context) {
+	service := ParametersFromContext[*admin.UpsertTeamService](c, admin.UpsertTeamParamCtx{})
+	res, err := service.Update(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminDeleteTeam(c *gin.Context) {
+	service := ParametersFromContext[*admin.SingleTeamService](c, admin.SingleTeamParamCtx{})
+	err := service.Delete(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{})
+}
+
+func AdminListWebhooks(c *gin.Context) {
+	res, err := admin.ListWebhooks(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminGetWebhook(c *gin.Context) {
+	service := ParametersFromContext[*admin.SingleWebhookService](c, admin.SingleWebhookParamCtx{})
+	res, err := service.Get(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminCreateWebhook(c *gin.Context) {
+	service := ParametersFromContext[*admin.UpsertWebhookService](c, admin.UpsertWebhookParamCtx{})
+	res, err := service.Create(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminUpdateWebhook(c *gin.Context) {
+	service := ParametersFromContext[*admin.UpsertWebhookService](c, admin.UpsertWebhookParamCtx{})
+	res, err := service.Update(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminDeleteWebhook(c *gin.Context) {
+	service := ParametersFromContext[*admin.SingleWebhookService](c, admin.SingleWebhookParamCtx{})
+	err := service.Delete(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{})
+}
+
+func AdminListWebhookDeliveries(c *gin.Context) {
+	service := ParametersFromContext[*admin.AdminListService](c, admin.AdminListServiceParamsCtx{})
+	res, err := service.WebhookDeliveries(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
 // AdminTestSlave 测试从机可用性
 func AdminTestSlave(c *gin.Context) {
 	service := ParametersFromContext[*admin.TestNodeService](c, admin.TestNodeParamCtx{})
@@ -518,6 +630,16 @@ func AdminBatchDeleteEntity(c *gin.Context) {
 	}
 }
 
+func AdminScrubEntities(c *gin.Context) {
+	service := ParametersFromContext[*admin.ScrubEntityService](c, admin.ScrubEntityParamCtx{})
+	res, err := service.Scrub(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
 func AdminCleanupTask(c *gin.Context) {
 	service := ParametersFromContext[*admin.CleanupTaskService](c, admin.CleanupTaskParameterCtx{})
 	err := service.CleanupTask(c)
@@ -569,6 +691,16 @@ func AdminListShares(c *gin.Context) {
 	c.JSON(200, serializer.Response{Data: res})
 }
 
+func AdminListShareAccesses(c *gin.Context) {
+	service := ParametersFromContext[*admin.AdminListService](c, admin.AdminListServiceParamsCtx{})
+	res, err := service.ShareAccesses(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
 func AdminGetShare(c *gin.Context) {
 	service := ParametersFromContext[*admin.SingleShareService](c, admin.SingleShareParamCtx{})
 	res, err := service.Get(c)
@@ -599,6 +731,16 @@ func AdminCalibrateStorage(c *gin.Context) {
 	c.JSON(200, serializer.Response{Data: res})
 }
 
+func AdminCalibrateFileCount(c *gin.Context) {
+	service := ParametersFromContext[*admin.SingleUserService](c, admin.SingleUserParamCtx{})
+	res, err := service.CalibrateFileCount(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
 // AdminListOAuthClients lists OAuth clients
 func AdminListOAuthClients(c *gin.Context) {
 	service := ParametersFromContext[*admin.AdminListService](c, admin.AdminListServiceParamsCtx{})
@@ -2,6 +2,7 @@ package controllers
 
 import (
 	"fmt"
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver/upyun"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
@@ -11,6 +12,9 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/service/callback"
 	"github.com/gin-gonic/gin"
 	"github.com/qiniu/go-sdk/v7/auth/qbox"
+	"net/http"
+	"path"
+	"strconv"
 )
 
 // RemoteCallback process callback request to complete upload
@@ -109,18 +113,24 @@ func OneDriveOAuth(c *gin.Context) {
 
 // GoogleDriveOAuth Google Drive 授权回调
 func GoogleDriveOAuth(c *gin.Context) {
-	//var callbackBody callback.OauthService
-	//if err := c.ShouldBindQuery(&callbackBody); err == nil {
-	//	res := callbackBody.GDriveAuth(c)
-	//	redirect := model.GetSiteURL()
-	//	redirect.Path = path.Join(redirect.Path, "/admin/policy")
-	//	queries := redirect.Query()
-	//	queries.Add("code", strconv.Itoa(res.Code))
-	//	queries.Add("msg", res.Msg)
-	//	queries.Add("err", res.Error)
-	//	redirect.RawQuery = queries.Encode()
-	//	c.Redirect(303, redirect.String())
-	//} else {
-	//	c.JSON(200, ErrorResponse(err))
-	//}
+	var callbackBody callback.OauthService
+	if err := c.ShouldBindQuery(&callbackBody); err != nil {
+		c.JSON(200, ErrorResponse(err))
+		return
+	}
+
+	res := serializer.Response{}
+	if err := callbackBody.GDriveAuth(c); err != nil {
+		res = serializer.Err(c, err)
+	}
+
+	dep := dependency.FromContext(c)
+	redirect := dep.SettingProvider().SiteURL(c)
+	redirect.Path = path.Join(redirect.Path, "/admin/policy")
+	queries := redirect.Query()
+	queries.Add("code", strconv.Itoa(res.Code))
+	queries.Add("msg", res.Msg)
+	queries.Add("err", res.Error)
+	redirect.RawQuery = queries.Encode()
+	c.Redirect(http.StatusSeeOther, redirect.String())
 }
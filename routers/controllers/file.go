@@ -83,6 +83,21 @@ func RebuildFTSIndex(c *gin.Context) {
 	})
 }
 
+// MigratePolicy creates task to move all entities from one storage policy to another
+func MigratePolicy(c *gin.Context) {
+	service := ParametersFromContext[*explorer.MigratePolicyWorkflowService](c, explorer.CreateMigratePolicyParamCtx{})
+	resp, err := service.CreateMigratePolicyTask(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{
+		Data: resp,
+	})
+}
+
 // ExtractArchive creates extract archive task
 func ExtractArchive(c *gin.Context) {
 	service := ParametersFromContext[*explorer.ArchiveWorkflowService](c, explorer.CreateArchiveParamCtx{})
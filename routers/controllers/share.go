@@ -61,6 +61,18 @@ func ListShare(c *gin.Context) {
 	}
 }
 
+// ListShareAccesses lists access logs of a share
+func ListShareAccesses(c *gin.Context) {
+	service := ParametersFromContext[*share.ListShareAccessService](c, share.ListShareAccessParamCtx{})
+	resp, err := service.List(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: resp})
+}
+
 // DeleteShare 删除分享
 func DeleteShare(c *gin.Context) {
 	err := share.DeleteShare(c, hashid.FromContext(c))
@@ -3,6 +3,7 @@ package controllers
 import (
 	"github.com/cloudreve/Cloudreve/v4/application/constants"
 	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/pkg/metrics"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
 	"github.com/cloudreve/Cloudreve/v4/service/basic"
 	"github.com/gin-gonic/gin"
@@ -77,3 +78,12 @@ func Manifest(c *gin.Context) {
 		"background_color": pwaOpts.BackgroundColor,
 	})
 }
+
+// Metrics exposes metrics in Prometheus text format
+func Metrics(c *gin.Context) {
+	c.Header("Content-Type", metrics.ContentType)
+	c.Status(200)
+	if err := dependency.FromContext(c).Metrics().Write(c, c.Writer); err != nil {
+		dependency.FromContext(c).Logger().Warning("Failed to write metrics: %s", err)
+	}
+}
@@ -107,13 +107,14 @@ func SlaveDelete(c *gin.Context) {
 // SlavePing 从机测试
 func SlavePing(c *gin.Context) {
 	service := ParametersFromContext[*admin.SlavePingService](c, admin.SlavePingParameterCtx{})
-	if err := service.Test(c); err != nil {
+	status, err := service.Test(c)
+	if err != nil {
 		c.JSON(200, serializer.Err(c, err))
 		c.Abort()
 		return
 	}
 
-	c.JSON(200, serializer.Response{})
+	c.JSON(200, serializer.NewResponseWithGobData(c, status))
 }
 
 // SlaveList 从机列出文件
@@ -226,6 +226,73 @@ func UserStorage(c *gin.Context) {
 	})
 }
 
+// UserTeams lists teams of current user
+func UserTeams(c *gin.Context) {
+	res, err := user.ListTeams(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{
+		Data: res,
+	})
+}
+
+// UserWebhooks lists webhooks of current user
+func UserWebhooks(c *gin.Context) {
+	res, err := user.ListWebhooks(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func UserCreateWebhook(c *gin.Context) {
+	service := ParametersFromContext[*user.UpsertWebhookService](c, user.UpsertWebhookParamCtx{})
+	res, err := service.Create(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func UserUpdateWebhook(c *gin.Context) {
+	service := ParametersFromContext[*user.UpsertWebhookService](c, user.UpsertWebhookParamCtx{})
+	res, err := service.Update(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func UserDeleteWebhook(c *gin.Context) {
+	if err := user.DeleteWebhook(c); err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{})
+}
+
+func UserListWebhookDeliveries(c *gin.Context) {
+	service := ParametersFromContext[*user.ListWebhookDeliveryService](c, user.ListWebhookDeliveryParamCtx{})
+	res, err := service.List(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
 // UserSetting 获取用户设定
 func UserSetting(c *gin.Context) {
 	res, err := user.GetUserSettings(c)
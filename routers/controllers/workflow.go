@@ -66,3 +66,56 @@ func CancelDownloadTask(c *gin.Context) {
 
 	c.JSON(200, serializer.Response{})
 }
+
+// ListSchedules lists scheduled workflows of current user
+func ListSchedules(c *gin.Context) {
+	res, err := explorer.ListSchedules(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func CreateSchedule(c *gin.Context) {
+	service := ParametersFromContext[*explorer.UpsertScheduleService](c, explorer.UpsertScheduleParamCtx{})
+	res, err := service.Create(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func UpdateSchedule(c *gin.Context) {
+	service := ParametersFromContext[*explorer.UpsertScheduleService](c, explorer.UpsertScheduleParamCtx{})
+	res, err := service.Update(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func DeleteSchedule(c *gin.Context) {
+	if err := explorer.DeleteSchedule(c); err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{})
+}
+
+func ListScheduleRuns(c *gin.Context) {
+	service := ParametersFromContext[*explorer.ListScheduleRunService](c, explorer.ListScheduleRunParamCtx{})
+	res, err := service.List(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
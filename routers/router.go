@@ -5,6 +5,7 @@ import (
 
 	"github.com/cloudreve/Cloudreve/v4/application/constants"
 	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
 	"github.com/cloudreve/Cloudreve/v4/middleware"
 	"github.com/cloudreve/Cloudreve/v4/pkg/cluster"
@@ -49,6 +50,25 @@ func newGinEngine(dep dependency.Dep) *gin.Engine {
 		r.Use(middleware.InitializeHandlingSlave())
 	}
 	r.Use(middleware.Logging())
+
+	if m := dep.ConfigProvider().Metrics(); m.Listen != "" || m.Token != "" {
+		// Initialize registry before serving, dependencies are not concurrent safe.
+		dep.Metrics()
+		r.Use(middleware.RequestMetrics(dep.RequestMetrics()))
+		if m.Listen == "" {
+			r.GET(constants.APIPrefix+"/metrics", middleware.MetricsTokenRequired(m.Token), controllers.Metrics)
+		}
+	}
+	return r
+}
+
+// InitMetricsRouter initializes router of the separate metrics listener.
+func InitMetricsRouter(dep dependency.Dep) *gin.Engine {
+	r := gin.New()
+	r.ContextWithFallback = true
+	r.Use(gin.Recovery())
+	r.Use(middleware.InitializeHandling(dep))
+	r.GET("/metrics", middleware.MetricsTokenRequired(dep.ConfigProvider().Metrics().Token), controllers.Metrics)
 	return r
 }
 
@@ -524,6 +544,18 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 				middleware.UseUploadSession(types.PolicyTypeS3),
 				controllers.ProcessCallback(http.StatusBadRequest, false),
 			)
+			// Azure Blob upload callback
+			callback.GET(
+				"azblob/:sessionID/:key",
+				middleware.UseUploadSession(types.PolicyTypeAzblob),
+				controllers.ProcessCallback(http.StatusBadRequest, false),
+			)
+			// Storage plugin upload callback
+			callback.POST(
+				"plugin/:sessionID/:key",
+				middleware.UseUploadSession(types.PolicyTypePlugin),
+				controllers.ProcessCallback(http.StatusBadRequest, false),
+			)
 			// 金山 ks3策略上传回调
 			callback.GET(
 				"ks3/:sessionID/:key",
@@ -594,6 +626,33 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 					controllers.CancelDownloadTask,
 				)
 			}
+
+			// Scheduled workflows
+			schedule := wf.Group("schedule")
+			{
+				schedule.GET("", controllers.ListSchedules)
+				schedule.PUT("",
+					middleware.RequiredScopes(types.ScopeWorkflowWrite),
+					controllers.FromJSON[explorer.UpsertScheduleService](explorer.UpsertScheduleParamCtx{}),
+					controllers.CreateSchedule,
+				)
+				schedule.PUT(":id",
+					middleware.RequiredScopes(types.ScopeWorkflowWrite),
+					middleware.HashID(hashid.ScheduleID),
+					controllers.FromJSON[explorer.UpsertScheduleService](explorer.UpsertScheduleParamCtx{}),
+					controllers.UpdateSchedule,
+				)
+				schedule.DELETE(":id",
+					middleware.RequiredScopes(types.ScopeWorkflowWrite),
+					middleware.HashID(hashid.ScheduleID),
+					controllers.DeleteSchedule,
+				)
+				schedule.GET(":id/runs",
+					middleware.HashID(hashid.ScheduleID),
+					controllers.FromQuery[explorer.ListScheduleRunService](explorer.ListScheduleRunParamCtx{}),
+					controllers.ListScheduleRuns,
+				)
+			}
 		}
 
 		// 文件
@@ -833,6 +892,13 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 				controllers.FromQuery[sharesvc.ShareInfoService](sharesvc.ShareInfoParamCtx{}),
 				controllers.GetShare,
 			)
+			// List access logs of my share
+			share.GET("access/:id",
+				middleware.LoginRequired(),
+				middleware.HashID(hashid.ShareID),
+				controllers.FromQuery[sharesvc.ListShareAccessService](sharesvc.ListShareAccessParamCtx{}),
+				controllers.ListShareAccesses,
+			)
 			// List my shares
 			share.GET("",
 				middleware.LoginRequired(),
@@ -925,6 +991,65 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 					)
 				}
 
+				// Team folders
+				team := admin.Group("team")
+				{
+					team.GET("",
+						controllers.AdminListTeams,
+					)
+					team.GET(":id",
+						controllers.FromUri[adminsvc.SingleTeamService](adminsvc.SingleTeamParamCtx{}),
+						controllers.AdminGetTeam,
+					)
+					team.PUT("",
+						middleware.RequiredScopes(types.ScopeAdminWrite),
+						controllers.FromJSON[adminsvc.UpsertTeamService](adminsvc.UpsertTeamParamCtx{}),
+						controllers.AdminCreateTeam,
+					)
+					team.PUT(":id",
+						middleware.RequiredScopes(types.ScopeAdminWrite),
+						controllers.FromJSON[adminsvc.UpsertTeamService](adminsvc.UpsertTeamParamCtx{}),
+						controllers.AdminUpdateTeam,
+					)
+					team.DELETE(":id",
+						middleware.RequiredScopes(types.ScopeAdminWrite),
+						controllers.FromUri[adminsvc.SingleTeamService](adminsvc.SingleTeamParamCtx{}),
+						controllers.AdminDeleteTeam,
+					)
+				}
+
+				// Global webhooks
+				webhook := admin.Group("webhook")
+				{
+					webhook.GET("",
+						controllers.AdminListWebhooks,
+					)
+					webhook.GET(":id",
+						controllers.FromUri[adminsvc.SingleWebhookService](adminsvc.SingleWebhookParamCtx{}),
+						controllers.AdminGetWebhook,
+					)
+					webhook.PUT("",
+						middleware.RequiredScopes(types.ScopeAdminWrite),
+						controllers.FromJSON[adminsvc.UpsertWebhookService](adminsvc.UpsertWebhookParamCtx{}),
+						controllers.AdminCreateWebhook,
+					)
+					webhook.PUT(":id",
+						middleware.RequiredScopes(types.ScopeAdminWrite),
+						controllers.FromJSON[adminsvc.UpsertWebhookService](adminsvc.UpsertWebhookParamCtx{}),
+						controllers.AdminUpdateWebhook,
+					)
+					webhook.DELETE(":id",
+						middleware.RequiredScopes(types.ScopeAdminWrite),
+						controllers.FromUri[adminsvc.SingleWebhookService](adminsvc.SingleWebhookParamCtx{}),
+						controllers.AdminDeleteWebhook,
+					)
+					// List delivery logs
+					webhook.POST("deliveries",
+						controllers.FromJSON[adminsvc.AdminListService](adminsvc.AdminListServiceParamsCtx{}),
+						controllers.AdminListWebhookDeliveries,
+					)
+				}
+
 				tool := admin.Group("tool")
 				{
 					tool.GET("wopi",
@@ -1003,6 +1128,12 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 						controllers.FromJSON[adminsvc.UpdateStoragePolicyService](adminsvc.UpdateStoragePolicyParamCtx{}),
 						controllers.AdminUpdatePolicy,
 					)
+					// Create task to migrate entities between storage policies
+					policy.POST("migrate",
+						middleware.RequiredScopes(types.ScopeAdminWrite),
+						controllers.FromJSON[explorer.MigratePolicyWorkflowService](explorer.CreateMigratePolicyParamCtx{}),
+						controllers.MigratePolicy,
+					)
 					// 创建跨域策略
 					policy.POST("cors",
 						middleware.RequiredScopes(types.ScopeAdminWrite),
@@ -1158,6 +1289,11 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 						controllers.FromUri[adminsvc.SingleUserService](adminsvc.SingleUserParamCtx{}),
 						controllers.AdminCalibrateStorage,
 					)
+					user.POST(":id/calibrateFiles",
+						middleware.RequiredScopes(types.ScopeAdminWrite),
+						controllers.FromUri[adminsvc.SingleUserService](adminsvc.SingleUserParamCtx{}),
+						controllers.AdminCalibrateFileCount,
+					)
 				}
 
 				file := admin.Group("file")
@@ -1214,6 +1350,12 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 						controllers.FromUri[adminsvc.SingleEntityService](adminsvc.SingleEntityParamCtx{}),
 						controllers.AdminGetEntityUrl,
 					)
+					// Create task to verify integrity of stored entities
+					entity.POST("scrub",
+						middleware.RequiredScopes(types.ScopeAdminWrite),
+						controllers.FromJSON[adminsvc.ScrubEntityService](adminsvc.ScrubEntityParamCtx{}),
+						controllers.AdminScrubEntities,
+					)
 				}
 
 				share := admin.Group("share")
@@ -1223,6 +1365,11 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 						controllers.FromJSON[adminsvc.AdminListService](adminsvc.AdminListServiceParamsCtx{}),
 						controllers.AdminListShares,
 					)
+					// List share access logs
+					share.POST("access",
+						controllers.FromJSON[adminsvc.AdminListService](adminsvc.AdminListServiceParamsCtx{}),
+						controllers.AdminListShareAccesses,
+					)
 					// Get share
 					share.GET(":id",
 						controllers.FromUri[adminsvc.SingleShareService](adminsvc.SingleShareParamCtx{}),
@@ -1244,6 +1391,8 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 				user.GET("me", middleware.RequiredScopes(types.ScopeUserInfoRead), controllers.UserMe)
 				// 存储信息
 				user.GET("capacity", middleware.RequiredScopes(types.ScopeUserInfoRead), controllers.UserStorage)
+				// Teams of current user
+				user.GET("teams", middleware.RequiredScopes(types.ScopeUserInfoRead), controllers.UserTeams)
 				// Search user by keywords
 				user.GET("search",
 					middleware.RequiredScopes(types.ScopeUserInfoRead),
@@ -1286,6 +1435,38 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 					// 获得二步验证初始化信息
 					setting.GET("2fa", controllers.UserInit2FA)
 				}
+
+				// Outbound webhooks of current user
+				webhook := user.Group("webhooks",
+					middleware.IsFunctionEnabled(func(c *gin.Context) bool {
+						u := inventory.UserFromContext(c)
+						return u.Edges.Group.Permissions.Enabled(int(types.GroupPermissionWebhook))
+					}))
+				{
+					webhook.GET("", middleware.RequiredScopes(types.ScopeUserInfoRead), controllers.UserWebhooks)
+					webhook.PUT("",
+						middleware.RequiredScopes(types.ScopeUserInfoWrite),
+						controllers.FromJSON[usersvc.UpsertWebhookService](usersvc.UpsertWebhookParamCtx{}),
+						controllers.UserCreateWebhook,
+					)
+					webhook.PUT(":id",
+						middleware.RequiredScopes(types.ScopeUserInfoWrite),
+						middleware.HashID(hashid.WebhookID),
+						controllers.FromJSON[usersvc.UpsertWebhookService](usersvc.UpsertWebhookParamCtx{}),
+						controllers.UserUpdateWebhook,
+					)
+					webhook.DELETE(":id",
+						middleware.RequiredScopes(types.ScopeUserInfoWrite),
+						middleware.HashID(hashid.WebhookID),
+						controllers.UserDeleteWebhook,
+					)
+					webhook.GET(":id/deliveries",
+						middleware.RequiredScopes(types.ScopeUserInfoRead),
+						middleware.HashID(hashid.WebhookID),
+						controllers.FromQuery[usersvc.ListWebhookDeliveryService](usersvc.ListWebhookDeliveryParamCtx{}),
+						controllers.UserListWebhookDeliveries,
+					)
+				}
 			}
 
 			// WebDAV and devices
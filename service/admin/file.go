@@ -14,6 +14,7 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager/entitysource"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/workflows"
 	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
 	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
@@ -523,6 +524,41 @@ func (s *BatchEntityService) Delete(c *gin.Context) error {
 	return nil
 }
 
+type (
+	ScrubEntityService struct {
+		PolicyID   int  `json:"policy_id" binding:"required"`
+		MarkBroken bool `json:"mark_broken"`
+	}
+	ScrubEntityParamCtx struct{}
+)
+
+// Scrub creates a task to verify integrity of all entities in given storage policy.
+func (s *ScrubEntityService) Scrub(c *gin.Context) (*GetTaskResponse, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	hasher := dep.HashIDEncoder()
+
+	if _, err := dep.StoragePolicyClient().GetPolicyByID(c, s.PolicyID); err != nil {
+		return nil, serializer.NewError(serializer.CodePolicyNotExist, "", err)
+	}
+
+	t, err := workflows.NewScrubTask(c, user, s.PolicyID, s.MarkBroken)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeCreateTaskError, "Failed to create task", err)
+	}
+
+	if err := dep.IoIntenseQueue(c).QueueTask(c, t); err != nil {
+		return nil, serializer.NewError(serializer.CodeCreateTaskError, "Failed to queue task", err)
+	}
+
+	return &GetTaskResponse{
+		Task:       t.Model(),
+		Summary:    t.Summarize(hasher),
+		TaskHashID: hashid.EncodeTaskID(hasher, t.ID()),
+		UserHashID: hashid.EncodeUserID(hasher, user.ID),
+	}, nil
+}
+
 func (s *SingleEntityService) Url(c *gin.Context) (string, error) {
 	dep := dependency.FromContext(c)
 	fileClient := dep.FileClient()
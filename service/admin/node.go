@@ -48,7 +48,17 @@ func (service *AdminListService) Nodes(c *gin.Context) (*ListNodeResponse, error
 		return nil, serializer.NewError(serializer.CodeDBError, "Failed to list nodes", err)
 	}
 
-	return &ListNodeResponse{Nodes: res.Nodes, Pagination: res.PaginationResults}, nil
+	np, err := dep.NodePool(c)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeInternalSetting, "Failed to get node pool", err)
+	}
+
+	return &ListNodeResponse{
+		Pagination: res.PaginationResults,
+		Nodes: lo.Map(res.Nodes, func(n *ent.Node, _ int) GetNodeResponse {
+			return GetNodeResponse{Node: n, Health: np.Health(n.ID)}
+		}),
+	}, nil
 }
 
 type (
@@ -68,7 +78,12 @@ func (service *SingleNodeService) Get(c *gin.Context) (*GetNodeResponse, error)
 		return nil, serializer.NewError(serializer.CodeDBError, "Failed to get node", err)
 	}
 
-	return &GetNodeResponse{Node: node}, nil
+	np, err := dep.NodePool(c)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeInternalSetting, "Failed to get node pool", err)
+	}
+
+	return &GetNodeResponse{Node: node, Health: np.Health(node.ID)}, nil
 }
 
 type (
@@ -14,9 +14,12 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/ent"
 	"github.com/cloudreve/Cloudreve/v4/inventory"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cluster"
 	"github.com/cloudreve/Cloudreve/v4/pkg/cluster/routes"
 	"github.com/cloudreve/Cloudreve/v4/pkg/credmanager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver/azblob"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver/cos"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver/googledrive"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver/ks3"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver/obs"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver/onedrive"
@@ -28,6 +31,7 @@ import (
 
 	"github.com/cloudreve/Cloudreve/v4/pkg/request"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
 	"github.com/gin-gonic/gin"
 )
 
@@ -46,7 +50,8 @@ type (
 	SlavePingParameterCtx struct{}
 	// SlavePingService ping slave node
 	SlavePingService struct {
-		Callback string `json:"callback" binding:"required"`
+		// Callback is the master site URL to be tested, heartbeats from master leave it empty.
+		Callback string `json:"callback"`
 	}
 )
 
@@ -101,13 +106,27 @@ func (service *SingleStoragePolicyService) Delete(c *gin.Context) error {
 }
 
 // Test 从机响应ping
-func (service *SlavePingService) Test(c *gin.Context) error {
+func (service *SlavePingService) Test(c *gin.Context) (*cluster.NodeStatus, error) {
+	dep := dependency.FromContext(c)
+	if service.Callback != "" {
+		if err := service.testMaster(c, dep); err != nil {
+			return nil, err
+		}
+	}
+
+	return &cluster.NodeStatus{
+		BusyWorkers: dep.SlaveQueue(c).BusyWorkers(),
+		Workers:     dep.SettingProvider().Queue(c, setting.QueueTypeSlave).WorkerNum,
+	}, nil
+}
+
+// testMaster tests connectivity from slave to master.
+func (service *SlavePingService) testMaster(c *gin.Context, dep dependency.Dep) error {
 	master, err := url.Parse(service.Callback)
 	if err != nil {
 		return serializer.NewError(serializer.CodeParamErr, "Failed to parse callback url", err)
 	}
 
-	dep := dependency.FromContext(c)
 	r := dep.RequestClient()
 	res, err := r.Request(
 		"GET",
@@ -265,6 +284,8 @@ func (service *CreateStoragePolicyService) Create(c *gin.Context) (*GetStoragePo
 		service.Policy.DirNameRule = util.DataPath("uploads/{uid}/{path}")
 	}
 
+	forceRelay(service.Policy)
+
 	service.Policy.ID = 0
 	policy, err := storagePolicyClient.Upsert(c, service.Policy)
 	if err != nil {
@@ -295,6 +316,7 @@ func (service *UpdateStoragePolicyService) Update(c *gin.Context) (*GetStoragePo
 	}
 
 	service.Policy.ID = idInt
+	forceRelay(service.Policy)
 
 	sc, tx, ctx, err := inventory.WithTx(c, storagePolicyClient)
 	if err != nil {
@@ -317,6 +339,19 @@ func (service *UpdateStoragePolicyService) Update(c *gin.Context) (*GetStoragePo
 	return s.Get(c)
 }
 
+// forceRelay enables relayed upload for policies that cannot receive data from clients directly.
+func forceRelay(policy *ent.StoragePolicy) {
+	if policy.Type != types.PolicyTypeSftp && policy.Type != types.PolicyTypeWebdav &&
+		policy.Type != types.PolicyTypeGoogleDrive {
+		return
+	}
+
+	if policy.Settings == nil {
+		policy.Settings = &types.PolicySetting{}
+	}
+	policy.Settings.Relay = true
+}
+
 type (
 	CreateStoragePolicyCorsService struct {
 		Policy *ent.StoragePolicy `json:"policy" binding:"required"`
@@ -384,6 +419,17 @@ func (service *CreateStoragePolicyCorsService) Create(c *gin.Context) error {
 			return serializer.NewError(serializer.CodeInternalSetting, "Failed to create cors: "+err.Error(), err)
 		}
 
+		return nil
+	case types.PolicyTypeAzblob:
+		handler, err := azblob.New(c, service.Policy, dep.SettingProvider(), dep.ConfigProvider(), dep.Logger(), dep.MimeDetector(c))
+		if err != nil {
+			return serializer.NewError(serializer.CodeDBError, "Failed to create azure blob driver", err)
+		}
+
+		if err := handler.CORS(); err != nil {
+			return serializer.NewError(serializer.CodeInternalSetting, "Failed to create cors: "+err.Error(), err)
+		}
+
 		return nil
 	default:
 		return serializer.NewError(serializer.CodeParamErr, "Unsupported policy type", nil)
@@ -399,16 +445,20 @@ type (
 	GetOauthRedirectParamCtx struct{}
 )
 
-// GetOAuth 获取 OneDrive OAuth 地址
+// GetOAuth 获取 OneDrive / Google Drive OAuth 地址
 func (service *GetOauthRedirectService) GetOAuth(c *gin.Context) (string, error) {
 	dep := dependency.FromContext(c)
 	storagePolicyClient := dep.StoragePolicyClient()
 
 	policy, err := storagePolicyClient.GetPolicyByID(c, service.ID)
-	if err != nil || policy.Type != types.PolicyTypeOd {
+	if err != nil || (policy.Type != types.PolicyTypeOd && policy.Type != types.PolicyTypeGoogleDrive) {
 		return "", serializer.NewError(serializer.CodePolicyNotExist, "", nil)
 	}
 
+	if policy.Type == types.PolicyTypeGoogleDrive {
+		return service.getGoogleDriveOAuth(c, policy)
+	}
+
 	// Update to latest redirect url
 	policy.Settings.OauthRedirect = routes.MasterPolicyOAuthCallback(dep.SettingProvider().SiteURL(c)).String()
 	policy.SecretKey = service.Secret
@@ -427,8 +477,32 @@ func (service *GetOauthRedirectService) GetOAuth(c *gin.Context) (string, error)
 	return redirect, nil
 }
 
+// getGoogleDriveOAuth returns Google consent page URL, a one-time state is stored in KV and
+// verified in OAuth callback.
+func (service *GetOauthRedirectService) getGoogleDriveOAuth(c *gin.Context, policy *ent.StoragePolicy) (string, error) {
+	dep := dependency.FromContext(c)
+	policy.Settings.OauthRedirect = routes.MasterGoogleDriveOAuthCallback(dep.SettingProvider().SiteURL(c)).String()
+	policy.SecretKey = service.Secret
+	policy.BucketName = service.AppID
+	policy, err := dep.StoragePolicyClient().Upsert(c, policy)
+	if err != nil {
+		return "", serializer.NewError(serializer.CodeDBError, "Failed to update policy", err)
+	}
+
+	state := util.RandStringRunesCrypto(32)
+	if err := dep.KV().Set(googledrive.OAuthStateKey(state), policy.ID, googledrive.OAuthStateTTL); err != nil {
+		return "", serializer.NewError(serializer.CodeInternalSetting, "Failed to save OAuth state", err)
+	}
+
+	client := googledrive.NewClient(policy, dep.RequestClient(), dep.CredManager(), dep.Logger(), dep.SettingProvider(), 0)
+	return client.OAuthURL(c, state), nil
+}
+
 func GetPolicyOAuthURL(c *gin.Context) string {
 	dep := dependency.FromContext(c)
+	if c.Query("type") == types.PolicyTypeGoogleDrive {
+		return routes.MasterGoogleDriveOAuthCallback(dep.SettingProvider().SiteURL(c)).String()
+	}
 	return routes.MasterPolicyOAuthCallback(dep.SettingProvider().SiteURL(c)).String()
 }
 
@@ -438,7 +512,7 @@ func (service *SingleStoragePolicyService) GetOauthCredentialStatus(c *gin.Conte
 	storagePolicyClient := dep.StoragePolicyClient()
 
 	policy, err := storagePolicyClient.GetPolicyByID(c, service.ID)
-	if err != nil || policy.Type != types.PolicyTypeOd {
+	if err != nil || (policy.Type != types.PolicyTypeOd && policy.Type != types.PolicyTypeGoogleDrive) {
 		return nil, serializer.NewError(serializer.CodePolicyNotExist, "", nil)
 	}
 
@@ -446,7 +520,12 @@ func (service *SingleStoragePolicyService) GetOauthCredentialStatus(c *gin.Conte
 		return &OauthCredentialStatus{Valid: false}, nil
 	}
 
-	token, err := dep.CredManager().Obtain(c, onedrive.CredentialKey(policy.ID))
+	credKey := onedrive.CredentialKey(policy.ID)
+	if policy.Type == types.PolicyTypeGoogleDrive {
+		credKey = googledrive.CredentialKey(policy.ID)
+	}
+
+	token, err := dep.CredManager().Obtain(c, credKey)
 	if err != nil {
 		if errors.Is(err, credmanager.ErrNotFound) {
 			return &OauthCredentialStatus{Valid: false}, nil
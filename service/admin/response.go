@@ -6,6 +6,7 @@ import (
 
 	"github.com/cloudreve/Cloudreve/v4/ent"
 	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cluster"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
 	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
 	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
@@ -22,6 +23,26 @@ type GetShareResponse struct {
 	ShareLink  string `json:"share_link,omitempty"`
 }
 
+type ListShareAccessResponse struct {
+	Pagination *inventory.PaginationResults `json:"pagination"`
+	Accesses   []GetShareAccessResponse     `json:"accesses"`
+}
+
+type GetShareAccessResponse struct {
+	*ent.ShareAccess
+	UserHashID string `json:"user_hash_id,omitempty"`
+}
+
+type GetWebhookResponse struct {
+	*ent.Webhook
+	Secret string `json:"secret,omitempty"`
+}
+
+type ListWebhookDeliveryResponse struct {
+	Pagination *inventory.PaginationResults `json:"pagination"`
+	Deliveries []*ent.WebhookDelivery       `json:"deliveries"`
+}
+
 type ListTaskResponse struct {
 	Pagination *inventory.PaginationResults `json:"pagination"`
 	Tasks      []GetTaskResponse            `json:"tasks"`
@@ -70,8 +91,16 @@ type GetUserResponse struct {
 	Capacity     *fs.Capacity `json:"capacity,omitempty"`
 }
 
+type GetTeamResponse struct {
+	*ent.Team
+	HashID   string       `json:"hash_id,omitempty"`
+	Uri      string       `json:"uri,omitempty"`
+	Capacity *fs.Capacity `json:"capacity,omitempty"`
+}
+
 type GetNodeResponse struct {
 	*ent.Node
+	Health *cluster.NodeHealth `json:"health,omitempty"`
 }
 
 type GetGroupResponse struct {
@@ -92,7 +121,7 @@ type GetStoragePolicyResponse struct {
 
 type ListNodeResponse struct {
 	Pagination *inventory.PaginationResults `json:"pagination"`
-	Nodes      []*ent.Node                  `json:"nodes"`
+	Nodes      []GetNodeResponse            `json:"nodes"`
 }
 
 type ListPolicyResponse struct {
@@ -19,6 +19,9 @@ const (
 	shareUserIDCondition = "share_user_id"
 	shareFileIDCondition = "share_file_id"
 	shareIDCondition     = "share_id"
+
+	shareAccessShareIDCondition = "share_access_share_id"
+	shareAccessUserIDCondition  = "share_access_user_id"
 )
 
 func (s *AdminListService) Shares(c *gin.Context) (*ListShareResponse, error) {
@@ -159,3 +162,56 @@ func (s *BatchShareService) Delete(c *gin.Context) error {
 
 	return nil
 }
+
+// ShareAccesses lists share access logs.
+func (s *AdminListService) ShareAccesses(c *gin.Context) (*ListShareAccessResponse, error) {
+	dep := dependency.FromContext(c)
+	hasher := dep.HashIDEncoder()
+
+	var (
+		err     error
+		shareID int
+		userID  int
+	)
+
+	if s.Conditions[shareAccessShareIDCondition] != "" {
+		shareID, err = strconv.Atoi(s.Conditions[shareAccessShareIDCondition])
+		if err != nil {
+			return nil, serializer.NewError(serializer.CodeParamErr, "Invalid share ID", err)
+		}
+	}
+
+	if s.Conditions[shareAccessUserIDCondition] != "" {
+		userID, err = strconv.Atoi(s.Conditions[shareAccessUserIDCondition])
+		if err != nil {
+			return nil, serializer.NewError(serializer.CodeParamErr, "Invalid user ID", err)
+		}
+	}
+
+	res, err := dep.ShareClient().ListAccesses(c, &inventory.ListShareAccessArgs{
+		PaginationArgs: &inventory.PaginationArgs{
+			Page:     s.Page - 1,
+			PageSize: s.PageSize,
+		},
+		ShareID: shareID,
+		UserID:  userID,
+	})
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to list share access logs", err)
+	}
+
+	return &ListShareAccessResponse{
+		Pagination: res.PaginationResults,
+		Accesses: lo.Map(res.Accesses, func(a *ent.ShareAccess, _ int) GetShareAccessResponse {
+			var uid string
+			if a.UserID != nil {
+				uid = hashid.EncodeUserID(hasher, *a.UserID)
+			}
+
+			return GetShareAccessResponse{
+				ShareAccess: a,
+				UserHashID:  uid,
+			}
+		}),
+	}, nil
+}
@@ -0,0 +1,77 @@
+package admin
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency/deptest"
+	"github.com/cloudreve/Cloudreve/v4/ent/shareaccess"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShareAccesses(t *testing.T) {
+	a := assert.New(t)
+	dep := deptest.New(t)
+	admin := deptest.NewUser(t, dep, "admin@cloudreve.org", 1)
+	owner := deptest.NewUser(t, dep, "owner@cloudreve.org", 2)
+	visitor := deptest.NewUser(t, dep, "visitor@cloudreve.org", 2)
+
+	ctx := context.Background()
+	fm := manager.NewFileManager(dep, owner)
+	defer fm.Recycle()
+	shareIDs := make([]int, 0, 2)
+	for _, name := range []string{"a", "b"} {
+		folder, err := fm.Create(deptest.Context(dep, owner), lo.Must(fs.NewUriFromString("cloudreve://my/"+name)), types.FileTypeFolder)
+		require.NoError(t, err)
+		share, err := dep.ShareClient().Upsert(ctx, &inventory.CreateShareParams{OwnerID: owner.ID, FileID: folder.ID()})
+		require.NoError(t, err)
+		shareIDs = append(shareIDs, share.ID)
+	}
+
+	require.NoError(t, dep.ShareClient().LogAccess(ctx, &inventory.ShareAccessArgs{ShareID: shareIDs[0], UserID: visitor.ID, Action: shareaccess.ActionView}))
+	require.NoError(t, dep.ShareClient().LogAccess(ctx, &inventory.ShareAccessArgs{ShareID: shareIDs[0], Action: shareaccess.ActionDownload}))
+	require.NoError(t, dep.ShareClient().LogAccess(ctx, &inventory.ShareAccessArgs{ShareID: shareIDs[1], UserID: visitor.ID, Action: shareaccess.ActionArchive}))
+
+	list := func(conditions map[string]string) (*ListShareAccessResponse, error) {
+		return (&AdminListService{Page: 1, PageSize: 10, Conditions: conditions}).ShareAccesses(deptest.GinContext(deptest.Context(dep, admin)))
+	}
+
+	// Admins see access logs of all shares.
+	res, err := list(nil)
+	require.NoError(t, err)
+	a.Len(res.Accesses, 3)
+	a.Equal(3, res.Pagination.TotalItems)
+
+	res, err = list(map[string]string{shareAccessShareIDCondition: strconv.Itoa(shareIDs[0])})
+	require.NoError(t, err)
+	a.ElementsMatch([]shareaccess.Action{shareaccess.ActionView, shareaccess.ActionDownload},
+		lo.Map(res.Accesses, func(r GetShareAccessResponse, _ int) shareaccess.Action { return r.Action }))
+
+	res, err = list(map[string]string{shareAccessUserIDCondition: strconv.Itoa(visitor.ID)})
+	require.NoError(t, err)
+	require.Len(t, res.Accesses, 2)
+	for _, r := range res.Accesses {
+		a.NotEmpty(r.UserHashID)
+	}
+
+	res, err = list(map[string]string{
+		shareAccessShareIDCondition: strconv.Itoa(shareIDs[1]),
+		shareAccessUserIDCondition:  strconv.Itoa(visitor.ID),
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Accesses, 1)
+	a.Equal(shareaccess.ActionArchive, res.Accesses[0].Action)
+
+	_, err = list(map[string]string{shareAccessShareIDCondition: "invalid"})
+	var appErr serializer.AppError
+	require.ErrorAs(t, err, &appErr)
+	a.Equal(serializer.CodeParamErr, appErr.Code)
+}
@@ -262,6 +262,12 @@ var (
 		"queue_remote_download_backoff_max_duration": remoteDownloadQueuePostProcessor,
 		"queue_remote_download_max_retry":            remoteDownloadQueuePostProcessor,
 		"queue_remote_download_retry_delay":          remoteDownloadQueuePostProcessor,
+		"queue_webhook_worker_num":                   webhookQueuePostProcessor,
+		"queue_webhook_max_execution":                webhookQueuePostProcessor,
+		"queue_webhook_backoff_factor":               webhookQueuePostProcessor,
+		"queue_webhook_backoff_max_duration":         webhookQueuePostProcessor,
+		"queue_webhook_max_retry":                    webhookQueuePostProcessor,
+		"queue_webhook_retry_delay":                  webhookQueuePostProcessor,
 		"secret_key":                                 secretKeyPostProcessor,
 		"fts_meilisearch_embed_config":               meilisearchPostProcessor,
 		"fts_meilisearch_endpoint":                   meilisearchPostProcessor,
@@ -401,6 +407,12 @@ func remoteDownloadQueuePostProcessor(ctx context.Context, settings map[string]s
 	return nil
 }
 
+func webhookQueuePostProcessor(ctx context.Context, settings map[string]string) error {
+	dep := dependency.FromContext(ctx)
+	dep.WebhookQueue(context.WithValue(ctx, dependency.ReloadCtx{}, true)).Start()
+	return nil
+}
+
 func entityRecycleQueuePostProcessor(ctx context.Context, settings map[string]string) error {
 	dep := dependency.FromContext(ctx)
 	dep.EntityRecycleQueue(context.WithValue(ctx, dependency.ReloadCtx{}, true)).Start()
@@ -27,6 +27,7 @@ func GetQueueMetrics(c *gin.Context) ([]QueueMetric, error) {
 	ioIntense := dep.IoIntenseQueue(c)
 	remoteDownload := dep.RemoteDownloadQueue(c)
 	thumb := dep.ThumbQueue(c)
+	webhook := dep.WebhookQueue(c)
 
 	res = append(res, QueueMetric{
 		Name:            setting.QueueTypeMediaMeta,
@@ -68,6 +69,14 @@ func GetQueueMetrics(c *gin.Context) ([]QueueMetric, error) {
 		SubmittedTasks:  thumb.SubmittedTasks(),
 		SuspendingTasks: thumb.SuspendingTasks(),
 	})
+	res = append(res, QueueMetric{
+		Name:            setting.QueueTypeWebhook,
+		BusyWorkers:     webhook.BusyWorkers(),
+		SuccessTasks:    webhook.SuccessTasks(),
+		FailureTasks:    webhook.FailureTasks(),
+		SubmittedTasks:  webhook.SubmittedTasks(),
+		SuspendingTasks: webhook.SuspendingTasks(),
+	})
 
 	return res, nil
 }
@@ -0,0 +1,264 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/samber/lo"
+)
+
+const (
+	// teamAccountEmailSuffix is the email suffix of team accounts, which are not meant to be logged in.
+	teamAccountEmailSuffix = "@team.cloudreve.local"
+)
+
+// ListTeams lists all teams.
+func ListTeams(c *gin.Context) ([]GetTeamResponse, error) {
+	dep := dependency.FromContext(c)
+	hasher := dep.HashIDEncoder()
+
+	ctx := context.WithValue(c, inventory.LoadTeamOwner{}, true)
+	teams, err := dep.TeamClient().List(ctx)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to list teams", err)
+	}
+
+	return lo.Map(teams, func(t *ent.Team, _ int) GetTeamResponse {
+		return GetTeamResponse{
+			Team:   t,
+			HashID: hashid.EncodeTeamID(hasher, t.ID),
+			Uri:    fs.NewTeamUri(hashid.EncodeTeamID(hasher, t.ID)),
+		}
+	}), nil
+}
+
+type (
+	SingleTeamService struct {
+		ID int `uri:"id" json:"id" binding:"required"`
+	}
+	SingleTeamParamCtx struct{}
+)
+
+func (s *SingleTeamService) Get(c *gin.Context) (*GetTeamResponse, error) {
+	dep := dependency.FromContext(c)
+	hasher := dep.HashIDEncoder()
+
+	ctx := context.WithValue(c, inventory.LoadTeamOwner{}, true)
+	t, err := dep.TeamClient().GetByID(ctx, s.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeNotFound, "Team not found", err)
+	}
+
+	owner, err := t.Edges.OwnerOrErr()
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to get team account", err)
+	}
+
+	m := manager.NewFileManager(dep, owner)
+	defer m.Recycle()
+	capacity, err := m.Capacity(ctx)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeInternalSetting, "Failed to get team capacity", err)
+	}
+
+	return &GetTeamResponse{
+		Team:     t,
+		HashID:   hashid.EncodeTeamID(hasher, t.ID),
+		Uri:      fs.NewTeamUri(hashid.EncodeTeamID(hasher, t.ID)),
+		Capacity: capacity,
+	}, nil
+}
+
+// Delete deletes the team with all its files and the team account.
+func (s *SingleTeamService) Delete(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	t, err := dep.TeamClient().GetByID(c, s.ID)
+	if err != nil {
+		return serializer.NewError(serializer.CodeNotFound, "Team not found", err)
+	}
+
+	fc, tx, ctx, err := inventory.WithTx(c, dep.FileClient())
+	if err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to start transaction", err)
+	}
+
+	uc, _, ctx, err := inventory.WithTx(ctx, dep.UserClient())
+	if err != nil {
+		_ = inventory.Rollback(tx)
+		return serializer.NewError(serializer.CodeDBError, "Failed to start transaction", err)
+	}
+
+	tc, _, ctx, err := inventory.WithTx(ctx, dep.TeamClient())
+	if err != nil {
+		_ = inventory.Rollback(tx)
+		return serializer.NewError(serializer.CodeDBError, "Failed to start transaction", err)
+	}
+
+	if err := fc.DeleteByUser(ctx, t.OwnerID); err != nil {
+		_ = inventory.Rollback(tx)
+		return serializer.NewError(serializer.CodeDBError, "Failed to delete team files", err)
+	}
+
+	if err := tc.Delete(ctx, t.ID); err != nil {
+		_ = inventory.Rollback(tx)
+		return serializer.NewError(serializer.CodeDBError, "Failed to delete team", err)
+	}
+
+	if err := uc.Delete(ctx, t.OwnerID); err != nil {
+		_ = inventory.Rollback(tx)
+		return serializer.NewError(serializer.CodeDBError, "Failed to delete team account", err)
+	}
+
+	if err := inventory.Commit(tx); err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to commit transaction", err)
+	}
+
+	return nil
+}
+
+type (
+	UpsertTeamService struct {
+		Team *ent.Team `json:"team" binding:"required"`
+		// GroupID is the group of team account, which decides the capacity of the team.
+		GroupID int `json:"group_id" binding:"required"`
+	}
+	UpsertTeamParamCtx struct{}
+)
+
+func (s *UpsertTeamService) Create(c *gin.Context) (*GetTeamResponse, error) {
+	dep := dependency.FromContext(c)
+	if s.Team.ID != 0 {
+		return nil, serializer.NewError(serializer.CodeParamErr, "ID must be 0", nil)
+	}
+
+	if err := validateTeam(s.Team); err != nil {
+		return nil, err
+	}
+
+	uc, tx, ctx, err := inventory.WithTx(c, dep.UserClient())
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to start transaction", err)
+	}
+
+	fc, _, ctx, err := inventory.WithTx(ctx, dep.FileClient())
+	if err != nil {
+		_ = inventory.Rollback(tx)
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to start transaction", err)
+	}
+
+	tc, _, ctx, err := inventory.WithTx(ctx, dep.TeamClient())
+	if err != nil {
+		_ = inventory.Rollback(tx)
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to start transaction", err)
+	}
+
+	// Files of the team are owned by a dedicated account that cannot login.
+	account, err := uc.Create(ctx, &inventory.NewUserArgs{
+		Email:         fmt.Sprintf("team-%s%s", uuid.Must(uuid.NewV4()).String(), teamAccountEmailSuffix),
+		Nick:          s.Team.Name,
+		PlainPassword: util.RandStringRunesCrypto(32),
+		Status:        user.StatusSysBanned,
+		GroupID:       s.GroupID,
+	})
+	if err != nil {
+		_ = inventory.Rollback(tx)
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to create team account", err)
+	}
+
+	if _, err := fc.CreateFolder(ctx, nil, &inventory.CreateFolderParameters{
+		Owner: account.ID,
+		Name:  inventory.RootFolderName,
+	}); err != nil {
+		_ = inventory.Rollback(tx)
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to create team root folder", err)
+	}
+
+	s.Team.OwnerID = account.ID
+	t, err := tc.Upsert(ctx, s.Team)
+	if err != nil {
+		_ = inventory.Rollback(tx)
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to create team", err)
+	}
+
+	if err := inventory.Commit(tx); err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to commit transaction", err)
+	}
+
+	service := &SingleTeamService{ID: t.ID}
+	return service.Get(c)
+}
+
+func (s *UpsertTeamService) Update(c *gin.Context) (*GetTeamResponse, error) {
+	dep := dependency.FromContext(c)
+	if s.Team.ID == 0 {
+		return nil, serializer.NewError(serializer.CodeParamErr, "ID is required", nil)
+	}
+
+	if err := validateTeam(s.Team); err != nil {
+		return nil, err
+	}
+
+	existing, err := dep.TeamClient().GetByID(c, s.Team.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeNotFound, "Team not found", err)
+	}
+
+	account, err := dep.UserClient().GetByID(c, existing.OwnerID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to get team account", err)
+	}
+
+	if account.GroupUsers != s.GroupID || account.Nick != s.Team.Name {
+		account.GroupUsers = s.GroupID
+		account.Nick = s.Team.Name
+		if _, err := dep.UserClient().Upsert(c, account, "", ""); err != nil {
+			return nil, serializer.NewError(serializer.CodeDBError, "Failed to update team account", err)
+		}
+	}
+
+	t, err := dep.TeamClient().Upsert(c, s.Team)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to update team", err)
+	}
+
+	service := &SingleTeamService{ID: t.ID}
+	return service.Get(c)
+}
+
+func validateTeam(t *ent.Team) error {
+	if t.Name == "" {
+		return serializer.NewError(serializer.CodeParamErr, "Team name is required", nil)
+	}
+
+	if t.Settings == nil {
+		return nil
+	}
+
+	validRole := func(role types.TeamRole) bool {
+		return role == types.TeamRoleReader || role == types.TeamRoleWriter
+	}
+
+	if t.Settings.GroupID > 0 && !validRole(t.Settings.GroupRole) {
+		return serializer.NewError(serializer.CodeParamErr, fmt.Sprintf("Invalid group role %q", t.Settings.GroupRole), nil)
+	}
+
+	for _, m := range t.Settings.Members {
+		if !validRole(m.Role) {
+			return serializer.NewError(serializer.CodeParamErr, fmt.Sprintf("Invalid role %q for user %d", m.Role, m.UserID), nil)
+		}
+	}
+
+	return nil
+}
@@ -135,6 +135,18 @@ func (service *SingleUserService) CalibrateStorage(c *gin.Context) (*GetUserResp
 	return subService.Get(c)
 }
 
+func (service *SingleUserService) CalibrateFileCount(c *gin.Context) (*GetUserResponse, error) {
+	dep := dependency.FromContext(c)
+	userClient := dep.UserClient()
+
+	if _, err := userClient.CalculateFileCount(c, service.ID); err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to calculate file count", err)
+	}
+
+	subService := &SingleUserService{ID: service.ID}
+	return subService.Get(c)
+}
+
 type (
 	UpsertUserService struct {
 		User     *ent.User `json:"user" binding:"required"`
@@ -212,6 +224,11 @@ func (s *BatchUserService) Delete(c *gin.Context) error {
 	userClient := dep.UserClient()
 	fileClient := dep.FileClient()
 
+	teams, err := dep.TeamClient().List(c)
+	if err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to list teams", err)
+	}
+
 	current := inventory.UserFromContext(c)
 	ae := serializer.NewAggregateError()
 	for _, id := range s.IDs {
@@ -220,6 +237,12 @@ func (s *BatchUserService) Delete(c *gin.Context) error {
 			continue
 		}
 
+		// Team accounts can only be deleted along with its team.
+		if lo.ContainsBy(teams, func(t *ent.Team) bool { return t.OwnerID == id }) {
+			ae.Add(strconv.Itoa(id), serializer.NewError(serializer.CodeParamErr, "Cannot delete team account", nil))
+			continue
+		}
+
 		fc, tx, ctx, err := inventory.WithTx(c, fileClient)
 		if err != nil {
 			ae.Add(strconv.Itoa(id), serializer.NewError(serializer.CodeDBError, "Failed to start transaction", err))
@@ -232,12 +255,38 @@ func (s *BatchUserService) Delete(c *gin.Context) error {
 			continue
 		}
 
+		wc, _, ctx, err := inventory.WithTx(ctx, dep.WebhookClient())
+		if err != nil {
+			_ = inventory.Rollback(tx)
+			ae.Add(strconv.Itoa(id), serializer.NewError(serializer.CodeDBError, "Failed to start transaction", err))
+			continue
+		}
+
+		sc, _, ctx, err := inventory.WithTx(ctx, dep.ScheduleClient())
+		if err != nil {
+			_ = inventory.Rollback(tx)
+			ae.Add(strconv.Itoa(id), serializer.NewError(serializer.CodeDBError, "Failed to start transaction", err))
+			continue
+		}
+
 		if err := fc.DeleteByUser(ctx, id); err != nil {
 			_ = inventory.Rollback(tx)
 			ae.Add(strconv.Itoa(id), serializer.NewError(serializer.CodeDBError, "Failed to delete user files", err))
 			continue
 		}
 
+		if err := wc.DeleteByOwner(ctx, id); err != nil {
+			_ = inventory.Rollback(tx)
+			ae.Add(strconv.Itoa(id), serializer.NewError(serializer.CodeDBError, "Failed to delete user webhooks", err))
+			continue
+		}
+
+		if err := sc.DeleteByOwner(ctx, id); err != nil {
+			_ = inventory.Rollback(tx)
+			ae.Add(strconv.Itoa(id), serializer.NewError(serializer.CodeDBError, "Failed to delete user schedules", err))
+			continue
+		}
+
 		if err := uc.Delete(ctx, id); err != nil {
 			_ = inventory.Rollback(tx)
 			ae.Add(strconv.Itoa(id), serializer.NewError(serializer.CodeDBError, "Failed to delete user", err))
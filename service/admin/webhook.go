@@ -0,0 +1,162 @@
+package admin
+
+import (
+	"strconv"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/cloudreve/Cloudreve/v4/pkg/webhook"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	webhookDeliveryWebhookIDCondition = "webhook_delivery_webhook_id"
+
+	webhookSecretLength = 32
+)
+
+// ListWebhooks lists global webhooks.
+func ListWebhooks(c *gin.Context) ([]*ent.Webhook, error) {
+	dep := dependency.FromContext(c)
+	hooks, err := dep.WebhookClient().List(c, 0)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to list webhooks", err)
+	}
+
+	return hooks, nil
+}
+
+type (
+	SingleWebhookService struct {
+		ID int `uri:"id" json:"id" binding:"required"`
+	}
+	SingleWebhookParamCtx struct{}
+)
+
+func (s *SingleWebhookService) Get(c *gin.Context) (*GetWebhookResponse, error) {
+	dep := dependency.FromContext(c)
+	hook, err := dep.WebhookClient().GetByID(c, s.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeNotFound, "Webhook not found", err)
+	}
+
+	return &GetWebhookResponse{Webhook: hook}, nil
+}
+
+func (s *SingleWebhookService) Delete(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	if _, err := dep.WebhookClient().GetByID(c, s.ID); err != nil {
+		return serializer.NewError(serializer.CodeNotFound, "Webhook not found", err)
+	}
+
+	if err := dep.WebhookClient().Delete(c, s.ID); err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to delete webhook", err)
+	}
+
+	return nil
+}
+
+type (
+	UpsertWebhookService struct {
+		Webhook *ent.Webhook `json:"webhook" binding:"required"`
+		// Secret is the signing secret, leave empty to keep the current one or
+		// generate a random one for new webhooks.
+		Secret string `json:"secret"`
+	}
+	UpsertWebhookParamCtx struct{}
+)
+
+func (s *UpsertWebhookService) Create(c *gin.Context) (*GetWebhookResponse, error) {
+	dep := dependency.FromContext(c)
+	if s.Webhook.ID != 0 {
+		return nil, serializer.NewError(serializer.CodeParamErr, "ID must be 0", nil)
+	}
+
+	if err := s.validate(c, dep, false); err != nil {
+		return nil, err
+	}
+
+	// Webhooks created by admin are global.
+	s.Webhook.OwnerID = nil
+
+	s.Webhook.Secret = s.Secret
+	if s.Webhook.Secret == "" {
+		s.Webhook.Secret = util.RandStringRunesCrypto(webhookSecretLength)
+	}
+
+	hook, err := dep.WebhookClient().Upsert(c, s.Webhook)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to create webhook", err)
+	}
+
+	// Secret is only returned once on creation.
+	return &GetWebhookResponse{Webhook: hook, Secret: s.Webhook.Secret}, nil
+}
+
+func (s *UpsertWebhookService) Update(c *gin.Context) (*GetWebhookResponse, error) {
+	dep := dependency.FromContext(c)
+	if s.Webhook.ID == 0 {
+		return nil, serializer.NewError(serializer.CodeParamErr, "ID is required", nil)
+	}
+
+	existing, err := dep.WebhookClient().GetByID(c, s.Webhook.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeNotFound, "Webhook not found", err)
+	}
+
+	if err := s.validate(c, dep, existing.OwnerID != nil); err != nil {
+		return nil, err
+	}
+
+	s.Webhook.Secret = s.Secret
+	s.Webhook.OwnerID = existing.OwnerID
+	hook, err := dep.WebhookClient().Upsert(c, s.Webhook)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to update webhook", err)
+	}
+
+	return &GetWebhookResponse{Webhook: hook}, nil
+}
+
+func (s *UpsertWebhookService) validate(c *gin.Context, dep dependency.Dep, external bool) error {
+	if s.Webhook.Name == "" {
+		return serializer.NewError(serializer.CodeParamErr, "Webhook name is required", nil)
+	}
+
+	if err := webhook.ValidateURL(c, dep, s.Webhook.URL, external); err != nil {
+		return serializer.NewError(serializer.CodeParamErr, "Invalid webhook URL", err)
+	}
+
+	if err := webhook.ValidateEvents(s.Webhook.Events); err != nil {
+		return serializer.NewError(serializer.CodeParamErr, "Invalid webhook events", err)
+	}
+
+	return nil
+}
+
+func (s *AdminListService) WebhookDeliveries(c *gin.Context) (*ListWebhookDeliveryResponse, error) {
+	dep := dependency.FromContext(c)
+	webhookID, err := strconv.Atoi(s.Conditions[webhookDeliveryWebhookIDCondition])
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Invalid webhook ID", err)
+	}
+
+	res, err := dep.WebhookClient().ListDeliveries(c, &inventory.ListWebhookDeliveryArgs{
+		PaginationArgs: &inventory.PaginationArgs{
+			Page:     s.Page - 1,
+			PageSize: s.PageSize,
+		},
+		WebhookID: webhookID,
+	})
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to list webhook deliveries", err)
+	}
+
+	return &ListWebhookDeliveryResponse{
+		Pagination: res.PaginationResults,
+		Deliveries: res.Deliveries,
+	}, nil
+}
@@ -1,8 +1,15 @@
 package callback
 
 import (
+	"fmt"
+	"strings"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver/googledrive"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
 	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
 )
 
 // OauthService OAuth 存储策略授权回调服务
@@ -11,53 +18,62 @@ type OauthService struct {
 	Error    string `form:"error"`
 	ErrorMsg string `form:"error_description"`
 	Scope    string `form:"scope"`
+	State    string `form:"state"`
 }
 
-//
-//// GDriveAuth Google Drive 更新认证信息
-//func (service *OauthService) GDriveAuth(c *gin.Context) serializer.Response {
-//	if service.Error != "" {
-//		return serializer.ParamErrDeprecated(service.Error, nil)
-//	}
-//
-//	// validate required scope
-//	if missing, found := lo.Find[string](googledrive.RequiredScope, func(item string) bool {
-//		return !strings.Contains(service.Scope, item)
-//	}); found {
-//		return serializer.ParamErrDeprecated(fmt.Sprintf("Missing required scope: %s", missing), nil)
-//	}
-//
-//	policyID, ok := util.GetSession(c, "googledrive_oauth_policy").(uint)
-//	if !ok {
-//		return serializer.ErrDeprecated(serializer.CodeNotFound, "", nil)
-//	}
-//
-//	util.DeleteSession(c, "googledrive_oauth_policy")
-//
-//	policy, err := model.GetPolicyByID(policyID)
-//	if err != nil {
-//		return serializer.ErrDeprecated(serializer.CodePolicyNotExist, "", nil)
-//	}
-//
-//	client, err := googledrive.NewClient(&policy)
-//	if err != nil {
-//		return serializer.ErrDeprecated(serializer.CodeInternalSetting, "Failed to initialize Google Drive client", err)
-//	}
-//
-//	credential, err := client.ObtainToken(c, service.Code, "")
-//	if err != nil {
-//		return serializer.ErrDeprecated(serializer.CodeInternalSetting, "Failed to fetch AccessToken", err)
-//	}
-//
-//	// 更新存储策略的 RefreshToken
-//	client.Policy.AccessKey = credential.RefreshToken
-//	if err := client.Policy.SaveAndClearCache(); err != nil {
-//		return serializer.DBErrDeprecated("Failed to update RefreshToken", err)
-//	}
-//
-//	cache.Deletes([]string{client.Policy.AccessKey}, googledrive.TokenCachePrefix)
-//	return serializer.Response{}
-//}
+// GDriveAuth exchanges authorization code for the credential of Google Drive policy bound to
+// the OAuth state.
+func (service *OauthService) GDriveAuth(c *gin.Context) error {
+	if service.Error != "" {
+		return serializer.NewError(serializer.CodeParamErr, service.Error, nil)
+	}
+
+	// validate required scope
+	if missing, found := lo.Find[string](googledrive.RequiredScope, func(item string) bool {
+		return !strings.Contains(service.Scope, item)
+	}); found {
+		return serializer.NewError(serializer.CodeParamErr, fmt.Sprintf("Missing required scope: %s", missing), nil)
+	}
+
+	dep := dependency.FromContext(c)
+	kv := dep.KV()
+	policyID, ok := kv.Get(googledrive.OAuthStateKey(service.State))
+	if !ok || service.State == "" {
+		return serializer.NewError(serializer.CodeNotFound, "OAuth state not found or expired", nil)
+	}
+	_ = kv.Delete(googledrive.OAuthStateKeyPrefix, service.State)
+
+	storagePolicyClient := dep.StoragePolicyClient()
+	policy, err := storagePolicyClient.GetPolicyByID(c, policyID.(int))
+	if err != nil || policy.Type != types.PolicyTypeGoogleDrive {
+		return serializer.NewError(serializer.CodePolicyNotExist, "", err)
+	}
+
+	client := googledrive.NewClient(policy, dep.RequestClient(), dep.CredManager(), dep.Logger(), dep.SettingProvider(), 0)
+	credential, err := client.ObtainToken(c, service.Code, "")
+	if err != nil {
+		return serializer.NewError(serializer.CodeInternalSetting, "Failed to obtain token: "+err.Error(), err)
+	}
+
+	if credential.RefreshToken == "" {
+		return serializer.NewError(serializer.CodeInternalSetting, "No refresh token is issued, please revoke access of this app in Google account and try again", nil)
+	}
+
+	if err := storagePolicyClient.UpdateAccessKey(c, policy, credential.RefreshToken); err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to update refresh token", err)
+	}
+
+	credManager := dep.CredManager()
+	if err := credManager.Upsert(c, credential); err != nil {
+		return serializer.NewError(serializer.CodeInternalSetting, "Failed to upsert credential", err)
+	}
+
+	if _, err := credManager.Obtain(c, googledrive.CredentialKey(policy.ID)); err != nil {
+		return serializer.NewError(serializer.CodeInternalSetting, "Failed to obtain credential", err)
+	}
+
+	return nil
+}
 
 // OdAuth OneDrive 更新认证信息
 func (service *OauthService) OdAuth(c *gin.Context) serializer.Response {
@@ -163,6 +163,7 @@ type UploadSessionResponse struct {
 	MimeType        string                 `json:"mime_type,omitempty"`
 	UploadPolicy    string                 `json:"upload_policy,omitempty"`
 	EncryptMetadata *types.EncryptMetadata `json:"encrypt_metadata,omitempty"`
+	Completed       bool                   `json:"completed,omitempty"`
 }
 
 func BuildUploadSessionResponse(session *fs.UploadCredential, hasher hashid.Encoder) *UploadSessionResponse {
@@ -180,6 +181,7 @@ func BuildUploadSessionResponse(session *fs.UploadCredential, hasher hashid.Enco
 		MimeType:        session.MimeType,
 		UploadPolicy:    session.UploadPolicy,
 		EncryptMetadata: session.EncryptMetadata,
+		Completed:       session.Completed,
 	}
 
 	if session.EncryptMetadata != nil {
@@ -315,6 +317,8 @@ type Entity struct {
 	StoragePolicy *StoragePolicy   `json:"storage_policy,omitempty"`
 	CreatedBy     *user.User       `json:"created_by,omitempty"`
 	EncryptedWith types.Cipher     `json:"encrypted_with,omitempty"`
+	Checksum      string           `json:"checksum,omitempty"`
+	Broken        bool             `json:"broken,omitempty"`
 }
 
 type Share struct {
@@ -333,6 +337,10 @@ type Share struct {
 	Url               string          `json:"url"`
 	ShowReadMe        bool            `json:"show_readme,omitempty"`
 	Size              int64           `json:"size"`
+	Mode              types.ShareMode `json:"mode,omitempty"`
+	MaxUploadSize     int64           `json:"max_upload_size,omitempty"`
+	MaxUploads        int             `json:"max_uploads,omitempty"`
+	Uploaded          int             `json:"uploaded,omitempty"`
 
 	// Only viewable by owner
 	IsPrivate bool   `json:"is_private,omitempty"`
@@ -368,6 +376,12 @@ func BuildShare(ctx context.Context, s *ent.Share, base *url.URL, hasher hashid.
 		res.Expires = s.Expires
 		res.Password = s.Password
 		res.ShowReadMe = s.Props != nil && s.Props.ShowReadMe
+		res.Uploaded = s.Uploads
+		if s.Props != nil {
+			res.Mode = s.Props.Mode
+			res.MaxUploadSize = s.Props.MaxUploadSize
+			res.MaxUploads = s.Props.MaxUploads
+		}
 
 		if t == types.FileTypeFile && s.Edges.File != nil {
 			res.Size = s.Edges.File.Size
@@ -494,6 +508,8 @@ func BuildEntity(ctx context.Context, extendedInfo *fs.FileExtendedInfo, e fs.En
 		Size:          e.Size(),
 		CreatedBy:     u,
 		EncryptedWith: encryptedWith,
+		Checksum:      e.Checksum(),
+		Broken:        e.Props() != nil && e.Props().Broken,
 	}
 }
 
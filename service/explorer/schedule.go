@@ -0,0 +1,310 @@
+package explorer
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/schedule"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/dbfs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/workflows"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+type (
+	Schedule struct {
+		ID        string               `json:"id"`
+		Name      string               `json:"name"`
+		Type      string               `json:"type"`
+		Spec      string               `json:"spec"`
+		Props     *types.ScheduleProps `json:"props"`
+		Enabled   bool                 `json:"enabled"`
+		NextRunAt *time.Time           `json:"next_run_at,omitempty"`
+		LastRunAt *time.Time           `json:"last_run_at,omitempty"`
+		CreatedAt time.Time            `json:"created_at"`
+	}
+	ScheduleRun struct {
+		TaskID    string    `json:"task_id,omitempty"`
+		Error     string    `json:"error,omitempty"`
+		CreatedAt time.Time `json:"created_at"`
+	}
+	ListScheduleRunResponse struct {
+		Runs       []ScheduleRun                `json:"runs"`
+		Pagination *inventory.PaginationResults `json:"pagination"`
+	}
+)
+
+// ListSchedules lists schedules of current user.
+func ListSchedules(c *gin.Context) ([]Schedule, error) {
+	dep := dependency.FromContext(c)
+	u := inventory.UserFromContext(c)
+
+	schedules, err := dep.ScheduleClient().List(c, u.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to list schedules", err)
+	}
+
+	hasher := dep.HashIDEncoder()
+	return lo.Map(schedules, func(s *ent.Schedule, _ int) Schedule {
+		return BuildSchedule(s, hasher)
+	}), nil
+}
+
+type (
+	UpsertScheduleService struct {
+		Name    string              `json:"name" binding:"required,max=255"`
+		Type    string              `json:"type" binding:"required,eq=archive|eq=extract|eq=download"`
+		Spec    string              `json:"spec" binding:"required,max=255"`
+		Props   types.ScheduleProps `json:"props"`
+		Enabled bool                `json:"enabled"`
+	}
+	UpsertScheduleParamCtx struct{}
+)
+
+func (s *UpsertScheduleService) Create(c *gin.Context) (*Schedule, error) {
+	dep := dependency.FromContext(c)
+	u := inventory.UserFromContext(c)
+
+	count, err := dep.ScheduleClient().Count(c, u.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to count schedules", err)
+	}
+
+	if max := dep.SettingProvider().UserSchedule(c).MaxPerUser; max > 0 && count >= max {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Too many schedules", nil)
+	}
+
+	next, err := s.validate(c, dep, u)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := dep.ScheduleClient().Upsert(c, &ent.Schedule{
+		Name:      s.Name,
+		Type:      schedule.Type(s.Type),
+		Spec:      s.Spec,
+		Props:     &s.Props,
+		Enabled:   s.Enabled,
+		OwnerID:   u.ID,
+		NextRunAt: next,
+	})
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to create schedule", err)
+	}
+
+	sched := BuildSchedule(res, dep.HashIDEncoder())
+	return &sched, nil
+}
+
+func (s *UpsertScheduleService) Update(c *gin.Context) (*Schedule, error) {
+	dep := dependency.FromContext(c)
+	u := inventory.UserFromContext(c)
+	existing, err := getOwnedSchedule(c, dep)
+	if err != nil {
+		return nil, err
+	}
+
+	next, err := s.validate(c, dep, u)
+	if err != nil {
+		return nil, err
+	}
+
+	// Password is not sent to client, keep the current one if not changed.
+	if s.Props.Password == "" && existing.Props != nil {
+		s.Props.Password = existing.Props.Password
+	}
+
+	res, err := dep.ScheduleClient().Upsert(c, &ent.Schedule{
+		ID:        existing.ID,
+		Name:      s.Name,
+		Type:      schedule.Type(s.Type),
+		Spec:      s.Spec,
+		Props:     &s.Props,
+		Enabled:   s.Enabled,
+		OwnerID:   existing.OwnerID,
+		NextRunAt: next,
+	})
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to update schedule", err)
+	}
+
+	sched := BuildSchedule(res, dep.HashIDEncoder())
+	return &sched, nil
+}
+
+// validate checks the schedule against permissions of current user, returns next run time.
+func (s *UpsertScheduleService) validate(c *gin.Context, dep dependency.Dep, u *ent.User) (*time.Time, error) {
+	sched, err := workflows.ParseScheduleSpec(s.Spec, dep.SettingProvider().UserSchedule(c).MinInterval)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Invalid schedule", err)
+	}
+
+	m := manager.NewFileManager(dep, u)
+	defer m.Recycle()
+
+	switch schedule.Type(s.Type) {
+	case schedule.TypeArchive, schedule.TypeExtract:
+		if !u.Edges.Group.Permissions.Enabled(int(types.GroupPermissionArchiveTask)) {
+			return nil, serializer.NewError(serializer.CodeGroupNotAllowed, "Group not allowed to compress files", nil)
+		}
+
+		if len(s.Props.Src) == 0 {
+			return nil, serializer.NewError(serializer.CodeParamErr, "No source files", nil)
+		}
+		for _, src := range s.Props.Src {
+			if _, err := fs.NewUriFromString(src); err != nil {
+				return nil, serializer.NewError(serializer.CodeParamErr, "Invalid source file uri", err)
+			}
+		}
+	case schedule.TypeDownload:
+		if !u.Edges.Group.Permissions.Enabled(int(types.GroupPermissionRemoteDownload)) {
+			return nil, serializer.NewError(serializer.CodeGroupNotAllowed, "Group not allowed to download files", nil)
+		}
+
+		// Exactly one of src and src_file should be set
+		if (len(s.Props.Src) == 1) == (s.Props.SrcFile != "") || len(s.Props.Src) > 1 {
+			return nil, serializer.NewError(serializer.CodeParamErr, "Invalid source files", nil)
+		}
+
+		if s.Props.SrcFile != "" {
+			if _, err := fs.NewUriFromString(s.Props.SrcFile); err != nil {
+				return nil, serializer.NewError(serializer.CodeParamErr, "Invalid source file uri", err)
+			}
+		}
+	}
+
+	if err := workflows.ValidateTaskChain(u, s.Props.Then); err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Invalid follow-up steps", err)
+	}
+
+	if err := validateScheduleDst(c, m, u, schedule.Type(s.Type), s.Props.Dst); err != nil {
+		return nil, err
+	}
+
+	if !s.Enabled {
+		return nil, nil
+	}
+
+	next := sched.Next(time.Now())
+	return &next, nil
+}
+
+// validateScheduleDst makes sure the destination folder, or parent of the destination archive file
+// or of the folder named with magic variables, is writable.
+func validateScheduleDst(ctx context.Context, m manager.FileManager, u *ent.User, t schedule.Type, rawDst string) error {
+	resolved := util.ReplaceMagicVar(rawDst, "/", true, false, time.Now(), u.ID, "", "", "")
+	dst, err := fs.NewUriFromString(resolved)
+	if err != nil {
+		return serializer.NewError(serializer.CodeParamErr, "Invalid destination", err)
+	}
+
+	if t == schedule.TypeArchive || resolved != rawDst {
+		dst = dst.DirUri()
+	}
+
+	if _, err := m.Get(ctx, dst, dbfs.WithRequiredCapabilities(dbfs.NavigatorCapabilityCreateFile)); err != nil {
+		return serializer.NewError(serializer.CodeParamErr, "Invalid destination", err)
+	}
+
+	return nil
+}
+
+// DeleteSchedule deletes a schedule of current user.
+func DeleteSchedule(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	s, err := getOwnedSchedule(c, dep)
+	if err != nil {
+		return err
+	}
+
+	if err := dep.ScheduleClient().Delete(c, s.ID); err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to delete schedule", err)
+	}
+
+	return nil
+}
+
+type (
+	ListScheduleRunService struct {
+		Page     int `form:"page" binding:"min=0"`
+		PageSize int `form:"page_size" binding:"required,min=10,max=100"`
+	}
+	ListScheduleRunParamCtx struct{}
+)
+
+// List lists run history of a schedule owned by current user.
+func (s *ListScheduleRunService) List(c *gin.Context) (*ListScheduleRunResponse, error) {
+	dep := dependency.FromContext(c)
+	sched, err := getOwnedSchedule(c, dep)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := dep.ScheduleClient().ListRuns(c, &inventory.ListScheduleRunArgs{
+		PaginationArgs: &inventory.PaginationArgs{
+			Page:     s.Page,
+			PageSize: s.PageSize,
+		},
+		ScheduleID: sched.ID,
+	})
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to list schedule runs", err)
+	}
+
+	hasher := dep.HashIDEncoder()
+	return &ListScheduleRunResponse{
+		Pagination: res.PaginationResults,
+		Runs: lo.Map(res.Runs, func(r *ent.ScheduleRun, _ int) ScheduleRun {
+			run := ScheduleRun{
+				Error:     r.Error,
+				CreatedAt: r.CreatedAt,
+			}
+			if r.TaskID != nil {
+				run.TaskID = hashid.EncodeTaskID(hasher, *r.TaskID)
+			}
+			return run
+		}),
+	}, nil
+}
+
+// getOwnedSchedule gets the schedule specified by hash ID in path, which must be owned by current user.
+func getOwnedSchedule(c *gin.Context, dep dependency.Dep) (*ent.Schedule, error) {
+	u := inventory.UserFromContext(c)
+	s, err := dep.ScheduleClient().GetByID(c, hashid.FromContext(c))
+	if err != nil || s.OwnerID != u.ID {
+		return nil, serializer.NewError(serializer.CodeNotFound, "Schedule not found", err)
+	}
+
+	return s, nil
+}
+
+func BuildSchedule(s *ent.Schedule, hasher hashid.Encoder) Schedule {
+	props := s.Props
+	if props != nil && props.Password != "" {
+		// Do not send archive password back.
+		masked := *props
+		masked.Password = ""
+		props = &masked
+	}
+
+	return Schedule{
+		ID:        hashid.EncodeScheduleID(hasher, s.ID),
+		Name:      s.Name,
+		Type:      string(s.Type),
+		Spec:      s.Spec,
+		Props:     props,
+		Enabled:   s.Enabled,
+		NextRunAt: s.NextRunAt,
+		LastRunAt: s.LastRunAt,
+		CreatedAt: s.CreatedAt,
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/cloudreve/Cloudreve/v4/application/dependency"
@@ -31,6 +32,7 @@ type (
 		EntityType          string            `json:"entity_type" binding:"eq=|eq=live_photo|eq=version"`
 		EncryptionSupported []types.Cipher    `json:"encryption_supported"`
 		Previous            string            `form:"previous"`
+		Checksum            string            `json:"checksum" binding:"omitempty,len=64,hexadecimal"`
 	}
 )
 
@@ -77,6 +79,7 @@ func (service *CreateUploadSessionService) Create(c context.Context) (*UploadSes
 			PreferredStoragePolicy: policyId,
 			EncryptionSupported:    service.EncryptionSupported,
 			ClientSideEncrypted:    len(service.EncryptionSupported) > 0,
+			Checksum:               strings.ToLower(service.Checksum),
 		},
 	}
 
@@ -74,6 +74,8 @@ type (
 		Src     []string `json:"src"`
 		SrcFile string   `json:"src_file"`
 		Dst     string   `json:"dst" binding:"required"`
+		// Then is the follow-up steps run after download is completed.
+		Then []types.TaskChainStep `json:"then"`
 	}
 	CreateDownloadParamCtx struct{}
 )
@@ -99,6 +101,10 @@ func (service *DownloadWorkflowService) CreateDownloadTask(c *gin.Context) ([]*T
 		return nil, serializer.NewError(serializer.CodeParamErr, "Invalid source files", nil)
 	}
 
+	if err := workflows.ValidateTaskChain(user, service.Then); err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Invalid follow-up steps", err)
+	}
+
 	dst, err := fs.NewUriFromString(service.Dst)
 	if err != nil {
 		return nil, serializer.NewError(serializer.CodeParamErr, "Invalid destination", err)
@@ -143,6 +149,7 @@ func (service *DownloadWorkflowService) CreateDownloadTask(c *gin.Context) ([]*T
 			continue
 		}
 
+		t.SetChain(service.Then)
 		if err := dep.RemoteDownloadQueue(c).QueueTask(c, t); err != nil {
 			ae.Add(src, err)
 		}
@@ -154,8 +161,10 @@ func (service *DownloadWorkflowService) CreateDownloadTask(c *gin.Context) ([]*T
 		t, err := workflows.NewRemoteDownloadTask(c, "", service.SrcFile, service.Dst)
 		if err != nil {
 			ae.Add(service.SrcFile, err)
+			return nil, ae.Aggregate()
 		}
 
+		t.SetChain(service.Then)
 		if err := dep.RemoteDownloadQueue(c).QueueTask(c, t); err != nil {
 			ae.Add(service.SrcFile, err)
 		}
@@ -175,6 +184,8 @@ type (
 		Encoding string   `json:"encoding"`
 		Password string   `json:"password"`
 		FileMask []string `json:"file_mask"`
+		// Then is the follow-up steps run after the task is completed.
+		Then []types.TaskChainStep `json:"then"`
 	}
 	CreateArchiveParamCtx struct{}
 )
@@ -190,6 +201,10 @@ func (service *ArchiveWorkflowService) CreateExtractTask(c *gin.Context) (*TaskR
 		return nil, serializer.NewError(serializer.CodeGroupNotAllowed, "Group not allowed to compress files", nil)
 	}
 
+	if err := workflows.ValidateTaskChain(user, service.Then); err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Invalid follow-up steps", err)
+	}
+
 	dst, err := fs.NewUriFromString(service.Dst)
 	if err != nil {
 		return nil, serializer.NewError(serializer.CodeParamErr, "Invalid destination", err)
@@ -210,6 +225,7 @@ func (service *ArchiveWorkflowService) CreateExtractTask(c *gin.Context) (*TaskR
 		return nil, serializer.NewError(serializer.CodeCreateTaskError, "Failed to create task", err)
 	}
 
+	t.SetChain(service.Then)
 	if err := dep.IoIntenseQueue(c).QueueTask(c, t); err != nil {
 		return nil, serializer.NewError(serializer.CodeCreateTaskError, "Failed to queue task", err)
 	}
@@ -229,6 +245,10 @@ func (service *ArchiveWorkflowService) CreateCompressTask(c *gin.Context) (*Task
 		return nil, serializer.NewError(serializer.CodeGroupNotAllowed, "Group not allowed to compress files", nil)
 	}
 
+	if err := workflows.ValidateTaskChain(user, service.Then); err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Invalid follow-up steps", err)
+	}
+
 	dst, err := fs.NewUriFromString(service.Dst)
 	if err != nil {
 		return nil, serializer.NewError(serializer.CodeParamErr, "Invalid destination", err)
@@ -254,6 +274,7 @@ func (service *ArchiveWorkflowService) CreateCompressTask(c *gin.Context) (*Task
 		return nil, serializer.NewError(serializer.CodeCreateTaskError, "Failed to create task", err)
 	}
 
+	t.SetChain(service.Then)
 	if err := dep.IoIntenseQueue(c).QueueTask(c, t); err != nil {
 		return nil, serializer.NewError(serializer.CodeCreateTaskError, "Failed to queue task", err)
 	}
@@ -481,3 +502,41 @@ func (service *RebuildFTSIndexWorkflowService) CreateRebuildFTSIndexTask(c *gin.
 
 	return BuildTaskResponse(t, nil, hasher), nil
 }
+
+type (
+	MigratePolicyWorkflowService struct {
+		SrcPolicyID int   `json:"src_policy_id" binding:"required"`
+		DstPolicyID int   `json:"dst_policy_id" binding:"required,nefield=SrcPolicyID"`
+		SpeedLimit  int64 `json:"speed_limit" binding:"min=0"`
+		Interval    int   `json:"interval" binding:"min=0"`
+	}
+	CreateMigratePolicyParamCtx struct{}
+)
+
+func (service *MigratePolicyWorkflowService) CreateMigratePolicyTask(c *gin.Context) (*TaskResponse, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	hasher := dep.HashIDEncoder()
+
+	if !user.Edges.Group.Permissions.Enabled(int(types.GroupPermissionIsAdmin)) {
+		return nil, serializer.NewError(serializer.CodeGroupNotAllowed, "Only admin can migrate storage policy", nil)
+	}
+
+	for _, id := range []int{service.SrcPolicyID, service.DstPolicyID} {
+		if _, err := dep.StoragePolicyClient().GetPolicyByID(c, id); err != nil {
+			return nil, serializer.NewError(serializer.CodePolicyNotExist, "", err)
+		}
+	}
+
+	// Create task
+	t, err := workflows.NewMigratePolicyTask(c, user, service.SrcPolicyID, service.DstPolicyID, service.SpeedLimit, service.Interval, nil)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeCreateTaskError, "Failed to create task", err)
+	}
+
+	if err := dep.IoIntenseQueue(c).QueueTask(c, t); err != nil {
+		return nil, serializer.NewError(serializer.CodeCreateTaskError, "Failed to queue task", err)
+	}
+
+	return BuildTaskResponse(t, nil, hasher), nil
+}
@@ -0,0 +1,80 @@
+package share
+
+import (
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+type (
+	ListShareAccessService struct {
+		Page     int `form:"page" binding:"min=0"`
+		PageSize int `form:"page_size" binding:"required,min=10,max=100"`
+	}
+	ListShareAccessParamCtx struct{}
+)
+
+type (
+	ShareAccess struct {
+		Action    string    `json:"action"`
+		User      string    `json:"user,omitempty"`
+		IP        string    `json:"ip,omitempty"`
+		UserAgent string    `json:"user_agent,omitempty"`
+		Path      string    `json:"path,omitempty"`
+		CreatedAt time.Time `json:"created_at"`
+	}
+	ListShareAccessResponse struct {
+		Accesses   []ShareAccess                `json:"accesses"`
+		Pagination *inventory.PaginationResults `json:"pagination"`
+	}
+)
+
+// List lists access records of a share owned by current user.
+func (s *ListShareAccessService) List(c *gin.Context) (*ListShareAccessResponse, error) {
+	dep := dependency.FromContext(c)
+	u := inventory.UserFromContext(c)
+	shareClient := dep.ShareClient()
+
+	share, err := shareClient.GetByIDUser(c, hashid.FromContext(c), u.ID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, serializer.NewError(serializer.CodeNotFound, "Share not found", nil)
+		}
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to get share", err)
+	}
+
+	res, err := shareClient.ListAccesses(c, &inventory.ListShareAccessArgs{
+		PaginationArgs: &inventory.PaginationArgs{
+			Page:     s.Page,
+			PageSize: s.PageSize,
+		},
+		ShareID: share.ID,
+	})
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to list share access logs", err)
+	}
+
+	hasher := dep.HashIDEncoder()
+	return &ListShareAccessResponse{
+		Pagination: res.PaginationResults,
+		Accesses: lo.Map(res.Accesses, func(a *ent.ShareAccess, _ int) ShareAccess {
+			access := ShareAccess{
+				Action:    string(a.Action),
+				IP:        a.IP,
+				UserAgent: a.UserAgent,
+				Path:      a.Path,
+				CreatedAt: a.CreatedAt,
+			}
+			if a.UserID != nil {
+				access.User = hashid.EncodeUserID(hasher, *a.UserID)
+			}
+			return access
+		}),
+	}, nil
+}
@@ -0,0 +1,63 @@
+package share
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency/deptest"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/shareaccess"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth/requestinfo"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShareAccessLogs(t *testing.T) {
+	a := assert.New(t)
+	dep := deptest.New(t)
+	owner := deptest.NewUser(t, dep, "owner@cloudreve.org", 1)
+	visitor := deptest.NewUser(t, dep, "visitor@cloudreve.org", 2)
+
+	fm := manager.NewFileManager(dep, owner)
+	defer fm.Recycle()
+	folder, err := fm.Create(deptest.Context(dep, owner), lo.Must(fs.NewUriFromString("cloudreve://my/shared")), types.FileTypeFolder)
+	require.NoError(t, err)
+	share, err := dep.ShareClient().Upsert(context.Background(), &inventory.CreateShareParams{OwnerID: owner.ID, FileID: folder.ID()})
+	require.NoError(t, err)
+
+	request := func(u *ent.User) context.Context {
+		ctx := context.WithValue(deptest.Context(dep, u), hashid.ObjectIDCtx{}, share.ID)
+		return context.WithValue(ctx, requestinfo.RequestInfoCtx{}, &requestinfo.RequestInfo{IP: "192.0.2.1", UserAgent: "test-agent"})
+	}
+
+	// Views are only recorded when asked to.
+	_, err = (&ShareInfoService{}).Get(deptest.GinContext(request(visitor)))
+	require.NoError(t, err)
+	_, err = (&ShareInfoService{CountViews: true}).Get(deptest.GinContext(request(visitor)))
+	require.NoError(t, err)
+
+	res, err := (&ListShareAccessService{PageSize: 10}).List(deptest.GinContext(request(owner)))
+	require.NoError(t, err)
+	require.Len(t, res.Accesses, 1)
+	a.Equal(ShareAccess{
+		Action:    string(shareaccess.ActionView),
+		User:      hashid.EncodeUserID(dep.HashIDEncoder(), visitor.ID),
+		IP:        "192.0.2.1",
+		UserAgent: "test-agent",
+		Path:      "/",
+		CreatedAt: res.Accesses[0].CreatedAt,
+	}, res.Accesses[0])
+
+	// Only share owner can list access logs.
+	_, err = (&ListShareAccessService{PageSize: 10}).List(deptest.GinContext(request(visitor)))
+	var appErr serializer.AppError
+	require.ErrorAs(t, err, &appErr)
+	a.Equal(serializer.CodeNotFound, appErr.Code)
+}
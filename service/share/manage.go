@@ -27,6 +27,9 @@ type (
 		Expire          int    `json:"expire"`
 		ShareView       bool   `json:"share_view"`
 		ShowReadMe      bool   `json:"show_readme"`
+		Mode            string `json:"mode" binding:"omitempty,oneof=write file_drop"`
+		MaxUploadSize   int64  `json:"max_upload_size" binding:"min=0"`
+		MaxUploads      int    `json:"max_uploads" binding:"min=0"`
 	}
 	ShareCreateParamCtx struct{}
 
@@ -90,6 +93,9 @@ func (service *ShareCreateService) Upsert(c *gin.Context, existed int) (string,
 		ExistedShareID:  existed,
 		ShareView:       service.ShareView,
 		ShowReadMe:      service.ShowReadMe,
+		Mode:            types.ShareMode(service.Mode),
+		MaxUploadSize:   service.MaxUploadSize,
+		MaxUploads:      service.MaxUploads,
 	})
 	if err != nil {
 		return "", err
@@ -6,6 +6,7 @@ import (
 
 	"github.com/cloudreve/Cloudreve/v4/application/dependency"
 	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/shareaccess"
 	"github.com/cloudreve/Cloudreve/v4/inventory"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
 	"github.com/cloudreve/Cloudreve/v4/pkg/cluster/routes"
@@ -13,6 +14,7 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
 	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/webhook"
 	"github.com/cloudreve/Cloudreve/v4/service/explorer"
 	"github.com/gin-gonic/gin"
 )
@@ -78,6 +80,12 @@ func (s *ShareInfoService) Get(c *gin.Context) (*explorer.Share, error) {
 
 	if s.CountViews {
 		_ = shareClient.Viewed(c, share)
+		args := inventory.NewShareAccessArgs(c, share.ID, u, shareaccess.ActionView, "/")
+		if err := shareClient.LogAccess(c, args); err != nil {
+			dep.Logger().Warning("Failed to log access of share %d: %s", share.ID, err)
+		}
+
+		webhook.DispatchShareAccessed(c, share.Edges.User, args)
 	}
 
 	unlocked := true
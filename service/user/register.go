@@ -16,6 +16,7 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
 	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/cloudreve/Cloudreve/v4/pkg/webhook"
 	"github.com/gin-gonic/gin"
 )
 
@@ -78,6 +79,12 @@ func (service *UserRegisterService) Register(c *gin.Context) serializer.Response
 		return serializer.DBErr(c, "Failed to commit user row", err)
 	}
 
+	webhook.Dispatch(c, expectedUser, webhook.EventUserRegistered, &webhook.UserEventData{
+		UserID: hashid.EncodeUserID(dep.HashIDEncoder(), expectedUser.ID),
+		Email:  expectedUser.Email,
+		Nick:   expectedUser.Nick,
+	})
+
 	if isEmailRequired {
 		if err := sendActivationEmail(c, dep, expectedUser); err != nil {
 			return serializer.ErrWithDetails(c, serializer.CodeNotSet, "", err)
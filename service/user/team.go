@@ -0,0 +1,42 @@
+package user
+
+import (
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+type Team struct {
+	ID   string         `json:"id"`
+	Name string         `json:"name"`
+	Uri  string         `json:"uri"`
+	Role types.TeamRole `json:"role"`
+}
+
+// ListTeams lists teams that current user is a member of.
+func ListTeams(c *gin.Context) ([]Team, error) {
+	dep := dependency.FromContext(c)
+	u := inventory.UserFromContext(c)
+	hasher := dep.HashIDEncoder()
+
+	teams, err := dep.TeamClient().ListByMember(c, u)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to list teams", err)
+	}
+
+	return lo.Map(teams, func(t *ent.Team, _ int) Team {
+		id := hashid.EncodeTeamID(hasher, t.ID)
+		return Team{
+			ID:   id,
+			Name: t.Name,
+			Uri:  fs.NewTeamUri(id),
+			Role: inventory.TeamRoleOf(t, u),
+		}
+	}), nil
+}
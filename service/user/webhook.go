@@ -0,0 +1,224 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/cloudreve/Cloudreve/v4/pkg/webhook"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+const webhookSecretLength = 32
+
+type (
+	Webhook struct {
+		ID        string    `json:"id"`
+		Name      string    `json:"name"`
+		URL       string    `json:"url"`
+		Events    []string  `json:"events,omitempty"`
+		Enabled   bool      `json:"enabled"`
+		CreatedAt time.Time `json:"created_at"`
+		// Secret is only returned on creation.
+		Secret string `json:"secret,omitempty"`
+	}
+	WebhookDelivery struct {
+		Event      string    `json:"event"`
+		DeliveryID string    `json:"delivery_id"`
+		Payload    string    `json:"payload"`
+		StatusCode int       `json:"status_code"`
+		Error      string    `json:"error,omitempty"`
+		Attempt    int       `json:"attempt"`
+		Duration   int64     `json:"duration"`
+		CreatedAt  time.Time `json:"created_at"`
+	}
+	ListWebhookDeliveryResponse struct {
+		Deliveries []WebhookDelivery            `json:"deliveries"`
+		Pagination *inventory.PaginationResults `json:"pagination"`
+	}
+)
+
+// ListWebhooks lists webhooks of current user.
+func ListWebhooks(c *gin.Context) ([]Webhook, error) {
+	dep := dependency.FromContext(c)
+	u := inventory.UserFromContext(c)
+
+	hooks, err := dep.WebhookClient().List(c, u.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to list webhooks", err)
+	}
+
+	hasher := dep.HashIDEncoder()
+	return lo.Map(hooks, func(w *ent.Webhook, _ int) Webhook {
+		return BuildWebhook(w, hasher)
+	}), nil
+}
+
+type (
+	UpsertWebhookService struct {
+		Name    string   `json:"name" binding:"required,max=255"`
+		URL     string   `json:"url" binding:"required"`
+		Events  []string `json:"events"`
+		Enabled bool     `json:"enabled"`
+		// Secret is the signing secret, leave empty to keep the current one or
+		// generate a random one for new webhooks.
+		Secret string `json:"secret"`
+	}
+	UpsertWebhookParamCtx struct{}
+)
+
+func (s *UpsertWebhookService) Create(c *gin.Context) (*Webhook, error) {
+	dep := dependency.FromContext(c)
+	u := inventory.UserFromContext(c)
+	if err := s.validate(c, dep); err != nil {
+		return nil, err
+	}
+
+	secret := s.Secret
+	if secret == "" {
+		secret = util.RandStringRunesCrypto(webhookSecretLength)
+	}
+
+	hook, err := dep.WebhookClient().Upsert(c, &ent.Webhook{
+		Name:    s.Name,
+		URL:     s.URL,
+		Secret:  secret,
+		Events:  s.Events,
+		Enabled: s.Enabled,
+		OwnerID: &u.ID,
+	})
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to create webhook", err)
+	}
+
+	res := BuildWebhook(hook, dep.HashIDEncoder())
+	res.Secret = secret
+	return &res, nil
+}
+
+func (s *UpsertWebhookService) Update(c *gin.Context) (*Webhook, error) {
+	dep := dependency.FromContext(c)
+	existing, err := getOwnedWebhook(c, dep)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.validate(c, dep); err != nil {
+		return nil, err
+	}
+
+	hook, err := dep.WebhookClient().Upsert(c, &ent.Webhook{
+		ID:      existing.ID,
+		Name:    s.Name,
+		URL:     s.URL,
+		Secret:  s.Secret,
+		Events:  s.Events,
+		Enabled: s.Enabled,
+		OwnerID: existing.OwnerID,
+	})
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to update webhook", err)
+	}
+
+	res := BuildWebhook(hook, dep.HashIDEncoder())
+	return &res, nil
+}
+
+func (s *UpsertWebhookService) validate(ctx context.Context, dep dependency.Dep) error {
+	if err := webhook.ValidateURL(ctx, dep, s.URL, true); err != nil {
+		return serializer.NewError(serializer.CodeParamErr, "Invalid webhook URL", err)
+	}
+
+	if err := webhook.ValidateEvents(s.Events); err != nil {
+		return serializer.NewError(serializer.CodeParamErr, "Invalid webhook events", err)
+	}
+
+	return nil
+}
+
+// DeleteWebhook deletes a webhook of current user.
+func DeleteWebhook(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	hook, err := getOwnedWebhook(c, dep)
+	if err != nil {
+		return err
+	}
+
+	if err := dep.WebhookClient().Delete(c, hook.ID); err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to delete webhook", err)
+	}
+
+	return nil
+}
+
+type (
+	ListWebhookDeliveryService struct {
+		Page     int `form:"page" binding:"min=0"`
+		PageSize int `form:"page_size" binding:"required,min=10,max=100"`
+	}
+	ListWebhookDeliveryParamCtx struct{}
+)
+
+// List lists delivery logs of a webhook owned by current user.
+func (s *ListWebhookDeliveryService) List(c *gin.Context) (*ListWebhookDeliveryResponse, error) {
+	dep := dependency.FromContext(c)
+	hook, err := getOwnedWebhook(c, dep)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := dep.WebhookClient().ListDeliveries(c, &inventory.ListWebhookDeliveryArgs{
+		PaginationArgs: &inventory.PaginationArgs{
+			Page:     s.Page,
+			PageSize: s.PageSize,
+		},
+		WebhookID: hook.ID,
+	})
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to list webhook deliveries", err)
+	}
+
+	return &ListWebhookDeliveryResponse{
+		Pagination: res.PaginationResults,
+		Deliveries: lo.Map(res.Deliveries, func(d *ent.WebhookDelivery, _ int) WebhookDelivery {
+			return WebhookDelivery{
+				Event:      d.Event,
+				DeliveryID: d.DeliveryID.String(),
+				Payload:    d.Payload,
+				StatusCode: d.StatusCode,
+				Error:      d.Error,
+				Attempt:    d.Attempt,
+				Duration:   d.Duration,
+				CreatedAt:  d.CreatedAt,
+			}
+		}),
+	}, nil
+}
+
+// getOwnedWebhook gets the webhook specified by hash ID in path, which must be owned by current user.
+func getOwnedWebhook(c *gin.Context, dep dependency.Dep) (*ent.Webhook, error) {
+	u := inventory.UserFromContext(c)
+	hook, err := dep.WebhookClient().GetByID(c, hashid.FromContext(c))
+	if err != nil || hook.OwnerID == nil || *hook.OwnerID != u.ID {
+		return nil, serializer.NewError(serializer.CodeNotFound, "Webhook not found", err)
+	}
+
+	return hook, nil
+}
+
+func BuildWebhook(w *ent.Webhook, hasher hashid.Encoder) Webhook {
+	return Webhook{
+		ID:        hashid.EncodeWebhookID(hasher, w.ID),
+		Name:      w.Name,
+		URL:       w.URL,
+		Events:    w.Events,
+		Enabled:   w.Enabled,
+		CreatedAt: w.CreatedAt,
+	}
+}